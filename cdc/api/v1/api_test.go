@@ -110,6 +110,13 @@ func (p *mockStatusProvider) GetChangeFeedSyncedStatus(ctx context.Context,
 	return args.Get(0).(*model.ChangeFeedSyncedStatusForAPI), args.Error(1)
 }
 
+func (p *mockStatusProvider) GetChangeFeedDiagnosis(ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) (*model.ChangefeedDiagnosis, error) {
+	args := p.Called(ctx)
+	return args.Get(0).(*model.ChangefeedDiagnosis), args.Error(1)
+}
+
 func (p *mockStatusProvider) IsHealthy(ctx context.Context) (bool, error) {
 	args := p.Called(ctx)
 	return args.Get(0).(bool), args.Error(1)
@@ -54,6 +54,7 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup := v2.Group("/changefeeds")
 	changefeedGroup.GET("/:changefeed_id", ownerMiddleware, api.getChangeFeed)
 	changefeedGroup.POST("", ownerMiddleware, authenticateMiddleware, api.createChangefeed)
+	changefeedGroup.POST("/import", ownerMiddleware, authenticateMiddleware, api.importChangefeed)
 	changefeedGroup.GET("", ownerMiddleware, api.listChangeFeeds)
 	changefeedGroup.PUT("/:changefeed_id", ownerMiddleware, authenticateMiddleware, api.updateChangefeed)
 	changefeedGroup.DELETE("/:changefeed_id", ownerMiddleware, authenticateMiddleware, api.deleteChangefeed)
@@ -62,6 +63,16 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup.POST("/:changefeed_id/pause", ownerMiddleware, authenticateMiddleware, api.pauseChangefeed)
 	changefeedGroup.GET("/:changefeed_id/status", ownerMiddleware, api.status)
 	changefeedGroup.GET("/:changefeed_id/synced", ownerMiddleware, api.synced)
+	changefeedGroup.GET("/:changefeed_id/diagnose", ownerMiddleware, api.diagnoseChangefeed)
+	changefeedGroup.POST("/:changefeed_id/export", ownerMiddleware, authenticateMiddleware, api.exportChangefeed)
+
+	// upstream apis
+	upstreamGroup := v2.Group("/upstreams")
+	upstreamGroup.Use(ownerMiddleware)
+	upstreamGroup.GET("", api.listUpstreams)
+	upstreamGroup.GET("/:upstream_id", api.getUpstream)
+	upstreamGroup.POST("", authenticateMiddleware, api.createUpstream)
+	upstreamGroup.DELETE("/:upstream_id", authenticateMiddleware, api.deleteUpstream)
 
 	// capture apis
 	captureGroup := v2.Group("/captures")
@@ -72,6 +83,8 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	// processor apis
 	processorGroup := v2.Group("/processors")
 	processorGroup.GET("/:changefeed_id/:capture_id", ownerMiddleware, api.getProcessor)
+	// diagnose is served by the capture itself instead of the owner.
+	processorGroup.GET("/:changefeed_id/:capture_id/diagnose", api.diagnoseProcessor)
 	processorGroup.GET("", ownerMiddleware, api.listProcessors)
 
 	verifyTableGroup := v2.Group("/verify_table")
@@ -16,6 +16,9 @@ package v2
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -34,6 +37,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/config"
 	cerror "github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/filter"
+	"github.com/pingcap/tiflow/pkg/httputil"
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/pingcap/tiflow/pkg/sink"
 	"github.com/pingcap/tiflow/pkg/txnutil/gc"
@@ -129,6 +133,15 @@ type APIV2Helpers interface {
 	) (ineligibleTables,
 		eligibleTables []model.TableName, err error,
 	)
+
+	// getProcessorDiagnosis queries the diagnosis of a processor from the
+	// capture with the given advertise address
+	getProcessorDiagnosis(
+		ctx context.Context,
+		captureAddr string,
+		changefeedID model.ChangeFeedID,
+		captureID model.CaptureID,
+	) (*model.ProcessorDiagnosis, error)
 }
 
 // APIV2HelpersImpl is an implementation of AVIV2Helpers interface
@@ -594,3 +607,39 @@ func (h APIV2HelpersImpl) getVerifiedTables(
 
 	return ineligibleTables, eligibleTables, nil
 }
+
+func (APIV2HelpersImpl) getProcessorDiagnosis(
+	ctx context.Context,
+	captureAddr string,
+	changefeedID model.ChangeFeedID,
+	captureID model.CaptureID,
+) (*model.ProcessorDiagnosis, error) {
+	credential := config.GetGlobalServerConfig().Security
+	scheme := "http"
+	// we should check tls config instead of credential here because
+	// credential will never be nil
+	if tlsCfg, _ := credential.ToTLSConfigWithVerify(); tlsCfg != nil {
+		scheme = "https"
+	}
+	u := url.URL{
+		Scheme: scheme,
+		Host:   captureAddr,
+		Path: fmt.Sprintf("/api/v2/processors/%s/%s/diagnose",
+			url.PathEscape(changefeedID.ID), url.PathEscape(captureID)),
+		RawQuery: url.Values{"namespace": []string{changefeedID.Namespace}}.Encode(),
+	}
+	cli, err := httputil.NewClient(credential)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer cli.CloseIdleConnections()
+	content, err := cli.DoRequest(ctx, u.String(), http.MethodGet, nil, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ret := &model.ProcessorDiagnosis{}
+	if err := json.Unmarshal(content, ret); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return ret, nil
+}
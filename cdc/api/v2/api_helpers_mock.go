@@ -87,6 +87,21 @@ func (mr *MockAPIV2HelpersMockRecorder) getPDClient(ctx, pdAddrs, credential int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getPDClient", reflect.TypeOf((*MockAPIV2Helpers)(nil).getPDClient), ctx, pdAddrs, credential)
 }
 
+// getProcessorDiagnosis mocks base method.
+func (m *MockAPIV2Helpers) getProcessorDiagnosis(ctx context.Context, captureAddr string, changefeedID model.ChangeFeedID, captureID model.CaptureID) (*model.ProcessorDiagnosis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "getProcessorDiagnosis", ctx, captureAddr, changefeedID, captureID)
+	ret0, _ := ret[0].(*model.ProcessorDiagnosis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// getProcessorDiagnosis indicates an expected call of getProcessorDiagnosis.
+func (mr *MockAPIV2HelpersMockRecorder) getProcessorDiagnosis(ctx, captureAddr, changefeedID, captureID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getProcessorDiagnosis", reflect.TypeOf((*MockAPIV2Helpers)(nil).getProcessorDiagnosis), ctx, captureAddr, changefeedID, captureID)
+}
+
 // getVerifiedTables mocks base method.
 func (m *MockAPIV2Helpers) getVerifiedTables(ctx context.Context, replicaConfig *config.ReplicaConfig, storage kv.Storage, startTs uint64, scheme, topic string, protocol config.Protocol) ([]model.TableName, []model.TableName, error) {
 	m.ctrl.T.Helper()
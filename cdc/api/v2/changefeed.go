@@ -61,13 +61,34 @@ const (
 // @Failure 500,400 {object} model.HTTPError
 // @Router	/api/v2/changefeeds [post]
 func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
-	ctx := c.Request.Context()
 	cfg := &ChangefeedConfig{ReplicaConfig: GetDefaultReplicaConfig()}
 
 	if err := c.BindJSON(&cfg); err != nil {
 		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
 		return
 	}
+	info, ok := h.createChangefeedWithConfig(c, cfg, nil)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, toAPIModel(info,
+		info.StartTs, info.StartTs,
+		nil, true))
+}
+
+// createChangefeedWithConfig creates a changefeed with the given config.
+// If it fails, the error is attached to the gin context and false is returned.
+// onCreated is called with the pd client of the upstream after the changefeed
+// is created successfully, it can be nil.
+func (h *OpenAPIV2) createChangefeedWithConfig(
+	c *gin.Context, cfg *ChangefeedConfig,
+	onCreated func(ctx context.Context, pdClient pd.Client),
+) (*model.ChangeFeedInfo, bool) {
+	ctx := c.Request.Context()
+	if err := h.fillPDConfigFromUpstream(ctx, cfg); err != nil {
+		_ = c.Error(err)
+		return nil, false
+	}
 	var pdClient pd.Client
 	var kvStorage kv.Storage
 	// if PDAddrs is empty, use the default pdClient
@@ -75,7 +96,7 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		up, err := getCaptureDefaultUpstream(h.capture)
 		if err != nil {
 			_ = c.Error(err)
-			return
+			return nil, false
 		}
 		pdClient = up.PDClient
 		kvStorage = up.KVStorage
@@ -87,14 +108,14 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		pdClient, err = h.helpers.getPDClient(timeoutCtx, cfg.PDAddrs, credential)
 		if err != nil {
 			_ = c.Error(cerror.WrapError(cerror.ErrAPIGetPDClientFailed, err))
-			return
+			return nil, false
 		}
 		defer pdClient.Close()
 		// verify tables todo: del kvstore
 		kvStorage, err = h.helpers.createTiStore(ctx, cfg.PDAddrs, credential)
 		if err != nil {
 			_ = c.Error(cerror.WrapError(cerror.ErrNewStore, err))
-			return
+			return nil, false
 		}
 	}
 
@@ -102,7 +123,7 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 	owner, err := h.capture.GetOwner()
 	if err != nil {
 		_ = c.Error(err)
-		return
+		return nil, false
 	}
 	// We should not close kvStorage since all kvStorage in cdc is the same one.
 	// defer kvStorage.Close()
@@ -116,7 +137,7 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		kvStorage)
 	if err != nil {
 		_ = c.Error(err)
-		return
+		return nil, false
 	}
 	needRemoveGCSafePoint := false
 	defer func() {
@@ -134,6 +155,12 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 			return
 		}
 	}()
+	if cfg.UpstreamID != 0 && cfg.UpstreamID != info.UpstreamID {
+		needRemoveGCSafePoint = true
+		_ = c.Error(cerror.ErrUpstreamMissMatch.GenWithStackByArgs(
+			cfg.UpstreamID, info.UpstreamID))
+		return nil, false
+	}
 	upstreamInfo := &model.UpstreamInfo{
 		ID:            info.UpstreamID,
 		PDEndpoints:   strings.Join(cfg.PDAddrs, ","),
@@ -148,13 +175,13 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		ctx, pdClient, cfg.SinkURI, model.GenerateChangeFeedID(info.Namespace, info.ID), info.Config)
 	if err != nil {
 		_ = c.Error(err)
-		return
+		return nil, false
 	}
 	if !notSame {
 		_ = c.Error(cerror.ErrSameUpstreamDownstream.GenWithStack(
 			"TiCDC does not support creating a changefeed with the same TiDB cluster " +
 				"as both the source and the target for the changefeed."))
-		return
+		return nil, false
 	}
 
 	var etcdCli *clientv3.Client
@@ -166,12 +193,12 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 		tlsCfg, err := credential.ToTLSConfig()
 		if err != nil {
 			_ = c.Error(err)
-			return
+			return nil, false
 		}
 		etcdCli, err = h.helpers.getEtcdClient(ctx, cfg.PDAddrs, tlsCfg)
 		if err != nil {
 			_ = c.Error(err)
-			return
+			return nil, false
 		}
 	}
 	err = hasRunningImport(ctx, etcdCli)
@@ -181,7 +208,7 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 			cerror.ErrUpstreamHasRunningImport.Wrap(err).
 				FastGenByArgs(info.UpstreamID),
 		)
-		return
+		return nil, false
 	}
 
 	err = owner.CreateChangefeed(ctx,
@@ -190,15 +217,16 @@ func (h *OpenAPIV2) createChangefeed(c *gin.Context) {
 	if err != nil {
 		needRemoveGCSafePoint = true
 		_ = c.Error(err)
-		return
+		return nil, false
 	}
 
 	log.Info("Create changefeed successfully!",
 		zap.String("id", info.ID),
 		zap.String("changefeed", info.String()))
-	c.JSON(http.StatusOK, toAPIModel(info,
-		info.StartTs, info.StartTs,
-		nil, true))
+	if onCreated != nil {
+		onCreated(ctx, pdClient)
+	}
+	return info, true
 }
 
 // hasRunningImport checks if there is running import tasks on the
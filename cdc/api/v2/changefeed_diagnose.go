@@ -0,0 +1,335 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+const (
+	// diagnosisLagThreshold is the checkpoint lag below which a changefeed
+	// is considered not lagging.
+	diagnosisLagThreshold = 10 * time.Second
+	// processorDiagnosisTimeout is the timeout of collecting the diagnosis
+	// from a processor.
+	processorDiagnosisTimeout = 10 * time.Second
+)
+
+// diagnoseChangefeed diagnoses the resolved ts lag of a changefeed
+// @Summary Diagnose a changefeed
+// @Description walk through the pipeline of a changefeed and explain
+// @Description what holds back its resolved ts and checkpoint
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param namespace query string false "default"
+// @Success 200 {object} ChangefeedDiagnosis
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/diagnose [get]
+func (h *OpenAPIV2) diagnoseChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	changefeedID := model.ChangeFeedID{Namespace: namespace, ID: c.Param(api.APIOpVarChangefeedID)}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+
+	provider := h.capture.StatusProvider()
+	info, err := provider.GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ownerDiagnosis, err := provider.GetChangeFeedDiagnosis(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	up, ok := upManager.Get(info.UpstreamID)
+	if !ok {
+		_ = c.Error(cerror.ErrUpstreamNotFound.GenWithStackByArgs(info.UpstreamID))
+		return
+	}
+	pdTime := up.PDClock.CurrentTime()
+
+	ret := &ChangefeedDiagnosis{
+		Namespace:        changefeedID.Namespace,
+		ID:               changefeedID.ID,
+		State:            info.State,
+		CheckpointTs:     ownerDiagnosis.CheckpointTs,
+		ResolvedTs:       ownerDiagnosis.ResolvedTs,
+		PullerResolvedTs: ownerDiagnosis.PullerResolvedTs,
+		CheckpointLagMs:  tsLagMs(pdTime, ownerDiagnosis.CheckpointTs),
+		ResolvedTsLagMs:  tsLagMs(pdTime, ownerDiagnosis.ResolvedTs),
+		Barrier:          ownerDiagnosis.Barrier,
+		SlowestSpans:     ownerDiagnosis.SlowestSpans,
+	}
+	if info.State.IsRunning() {
+		ret.Processors, err = h.diagnoseProcessors(ctx, changefeedID)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+	ret.Bottleneck, ret.Reasons = diagnoseBottleneck(ret, pdTime)
+	c.JSON(http.StatusOK, ret)
+}
+
+// diagnoseProcessors collects the diagnoses from all processors that
+// replicate tables of the changefeed. A processor that fails to respond
+// does not fail the whole diagnosis.
+func (h *OpenAPIV2) diagnoseProcessors(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+) ([]ProcessorDiagnosis, error) {
+	provider := h.capture.StatusProvider()
+	statuses, err := provider.GetAllTaskStatuses(ctx, changefeedID)
+	if err != nil {
+		return nil, err
+	}
+	captures, err := provider.GetCaptures(ctx)
+	if err != nil {
+		return nil, err
+	}
+	self, err := h.capture.Info()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(captures, func(i, j int) bool {
+		return captures[i].ID < captures[j].ID
+	})
+
+	ret := make([]ProcessorDiagnosis, 0, len(statuses))
+	for _, capture := range captures {
+		if _, ok := statuses[capture.ID]; !ok {
+			continue
+		}
+		d := ProcessorDiagnosis{
+			CaptureID: capture.ID,
+			Address:   capture.AdvertiseAddr,
+		}
+		timeoutCtx, cancel := context.WithTimeout(ctx, processorDiagnosisTimeout)
+		if capture.ID == self.ID {
+			d.Diagnosis, err = h.capture.GetProcessorDiagnosis(timeoutCtx, changefeedID)
+		} else {
+			d.Diagnosis, err = h.helpers.getProcessorDiagnosis(
+				timeoutCtx, capture.AdvertiseAddr, changefeedID, capture.ID)
+		}
+		cancel()
+		if err != nil {
+			d.Diagnosis = nil
+			d.Error = err.Error()
+		}
+		ret = append(ret, d)
+	}
+	return ret, nil
+}
+
+// diagnoseProcessor returns the diagnosis of the processor on this capture
+// @Summary Diagnose a processor
+// @Description get the resolved ts lag diagnosis of the processor of
+// @Description a changefeed on the capture that serves the request
+// @Tags processor,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param capture_id  path  string  true  "capture_id"
+// @Param namespace query string false "default"
+// @Success 200 {object} model.ProcessorDiagnosis
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/processors/{changefeed_id}/{capture_id}/diagnose [get]
+func (h *OpenAPIV2) diagnoseProcessor(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	changefeedID := model.ChangeFeedID{Namespace: namespace, ID: c.Param(api.APIOpVarChangefeedID)}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+	self, err := h.capture.Info()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	captureID := c.Param(apiOpVarCaptureID)
+	if captureID != self.ID {
+		_ = c.Error(cerror.ErrCaptureNotExist.GenWithStackByArgs(captureID))
+		return
+	}
+
+	diagnosis, err := h.capture.GetProcessorDiagnosis(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, diagnosis)
+}
+
+// diagnoseBottleneck finds the stage that holds back the changefeed the most,
+// and explains why.
+func diagnoseBottleneck(d *ChangefeedDiagnosis, pdTime time.Time) (string, []string) {
+	if !d.State.IsRunning() {
+		return DiagnosisBottleneckNotRunning, []string{
+			fmt.Sprintf("changefeed is %s", d.State),
+		}
+	}
+	if time.Duration(d.CheckpointLagMs)*time.Millisecond < diagnosisLagThreshold {
+		return DiagnosisBottleneckNone, nil
+	}
+
+	barrier := d.Barrier
+	if barrier.ExecutingDDL != "" {
+		return DiagnosisBottleneckBarrier, []string{
+			fmt.Sprintf("DDL %q with commit ts %d is being executed",
+				barrier.ExecutingDDL, barrier.ExecutingDDLCommitTs),
+		}
+	}
+	// All tables have reached the global barrier, but it is not lifted.
+	if barrier.GlobalBarrierTs <= d.CheckpointTs &&
+		barrier.GlobalBarrierTs < d.PullerResolvedTs {
+		if barrier.DDLResolvedTs <= barrier.GlobalBarrierTs {
+			return DiagnosisBottleneckBarrier, []string{
+				fmt.Sprintf("DDL puller resolved ts %d lags %s behind",
+					barrier.DDLResolvedTs, msToDuration(tsLagMs(pdTime, barrier.DDLResolvedTs))),
+			}
+		}
+		return DiagnosisBottleneckBarrier, []string{
+			fmt.Sprintf("changefeed is waiting for the barrier at %d, "+
+				"which is a DDL or a syncpoint", barrier.GlobalBarrierTs),
+		}
+	}
+
+	span := findSlowestSpan(d)
+	if span == nil || span.PullerResolvedTs == 0 {
+		return DiagnosisBottleneckUnknown, []string{
+			"the statistics of table spans are not collected yet",
+		}
+	}
+	where := fmt.Sprintf("table %d span %s on capture %s",
+		span.TableID, span.Span, span.CaptureID)
+
+	flushableTs := span.SinkResolvedTs
+	if span.BarrierTs < flushableTs {
+		flushableTs = span.BarrierTs
+	}
+	pullerLagMs := tsLagMs(pdTime, span.PullerResolvedTs)
+	sorterLagMs := tsGapMs(span.PullerResolvedTs, span.SinkResolvedTs)
+	barrierLagMs := tsGapMs(span.SinkResolvedTs, flushableTs)
+	sinkLagMs := tsGapMs(flushableTs, span.CheckpointTs)
+
+	switch max(pullerLagMs, sorterLagMs, barrierLagMs, sinkLagMs) {
+	case pullerLagMs:
+		reasons := []string{fmt.Sprintf("puller resolved ts of %s lags %s behind",
+			where, msToDuration(pullerLagMs))}
+		if region := span.SlowestRegion; region != nil {
+			reasons = append(reasons, fmt.Sprintf(
+				"region %d has the slowest resolved ts %d, which lags %s behind, initialized: %t",
+				region.RegionID, region.ResolvedTs,
+				msToDuration(tsLagMs(pdTime, region.ResolvedTs)), region.Initialized))
+		}
+		if span.UnlockedRangeCount > 0 {
+			reasons = append(reasons, fmt.Sprintf(
+				"%d ranges are not covered by any region", span.UnlockedRangeCount))
+		}
+		return DiagnosisBottleneckPuller, reasons
+	case sorterLagMs:
+		return DiagnosisBottleneckSorter, []string{fmt.Sprintf(
+			"sorter resolved ts of %s lags %s behind puller",
+			where, msToDuration(sorterLagMs))}
+	case barrierLagMs:
+		return DiagnosisBottleneckBarrier, []string{fmt.Sprintf(
+			"barrier ts %d holds back the sink of %s for %s",
+			span.BarrierTs, where, msToDuration(barrierLagMs))}
+	default:
+		for _, p := range d.Processors {
+			if p.Diagnosis == nil || p.CaptureID != span.CaptureID {
+				continue
+			}
+			quota := p.Diagnosis.SinkMemoryQuota
+			if p.Diagnosis.RedoMemoryQuota != nil && p.Diagnosis.RedoMemoryQuota.Blocked {
+				quota = *p.Diagnosis.RedoMemoryQuota
+			}
+			if quota.Blocked {
+				return DiagnosisBottleneckMemoryQuota, []string{fmt.Sprintf(
+					"memory quota on capture %s is exhausted, %d of %d bytes are used",
+					p.CaptureID, quota.UsedBytes, quota.TotalBytes)}
+			}
+		}
+		reasons := []string{fmt.Sprintf("sink checkpoint of %s lags %s behind",
+			where, msToDuration(sinkLagMs))}
+		if span.SinkFlushLagMs > 0 {
+			reasons = append(reasons, fmt.Sprintf("sink has not flushed for %s",
+				msToDuration(span.SinkFlushLagMs)))
+		}
+		return DiagnosisBottleneckSink, reasons
+	}
+}
+
+// findSlowestSpan returns the slowest span reported by processors, and
+// falls back to the one reported by the owner.
+func findSlowestSpan(d *ChangefeedDiagnosis) *model.SpanDiagnosis {
+	var ret *model.SpanDiagnosis
+	for _, p := range d.Processors {
+		if p.Diagnosis == nil {
+			continue
+		}
+		for i := range p.Diagnosis.SlowestSpans {
+			span := &p.Diagnosis.SlowestSpans[i]
+			if ret == nil || span.CheckpointTs < ret.CheckpointTs {
+				ret = span
+			}
+		}
+	}
+	if ret == nil && len(d.SlowestSpans) > 0 {
+		ret = &d.SlowestSpans[0]
+	}
+	return ret
+}
+
+// tsLagMs returns how many milliseconds the ts lags behind now.
+func tsLagMs(now time.Time, ts uint64) int64 {
+	lag := oracle.GetPhysical(now) - oracle.ExtractPhysical(ts)
+	if lag < 0 {
+		return 0
+	}
+	return lag
+}
+
+// tsGapMs returns how many milliseconds the ts lags behind the ahead one.
+func tsGapMs(ahead, ts uint64) int64 {
+	if ahead <= ts {
+		return 0
+	}
+	return oracle.ExtractPhysical(ahead) - oracle.ExtractPhysical(ts)
+}
+
+func msToDuration(ms int64) time.Duration {
+	return time.Duration(ms) * time.Millisecond
+}
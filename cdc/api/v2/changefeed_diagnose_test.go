@@ -0,0 +1,219 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestDiagnoseChangefeed(t *testing.T) {
+	t.Parallel()
+
+	diagnose := testCase{
+		url:    "/api/v2/changefeeds/%s/diagnose?namespace=abc",
+		method: "GET",
+	}
+	pdClient := &mockPDClient{}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	provider := mock_owner.NewMockStatusProvider(gomock.NewController(t))
+	router := newRouter(NewOpenAPIV2ForTest(cp, helpers))
+
+	cp.EXPECT().GetUpstreamManager().Return(upstream.NewManager4Test(pdClient), nil).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(provider).AnyTimes()
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "capture-1"}, nil).AnyTimes()
+
+	now := time.Now()
+	checkpointTs := oracle.GoTimeToTS(now.Add(-time.Minute))
+	resolvedTs := oracle.GoTimeToTS(now.Add(-time.Second))
+	provider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+		Return(&model.ChangeFeedInfo{State: model.StateNormal}, nil).Times(1)
+	provider.EXPECT().GetChangeFeedDiagnosis(gomock.Any(), changeFeedID).
+		Return(&model.ChangefeedDiagnosis{
+			CheckpointTs:     checkpointTs,
+			ResolvedTs:       resolvedTs,
+			PullerResolvedTs: resolvedTs,
+			Barrier: model.BarrierDiagnosis{
+				DDLResolvedTs:     resolvedTs,
+				GlobalBarrierTs:   resolvedTs,
+				MinTableBarrierTs: resolvedTs,
+			},
+			SlowestSpans: []model.SpanDiagnosis{{TableID: 1, CaptureID: "capture-1"}},
+		}, nil).Times(1)
+	provider.EXPECT().GetAllTaskStatuses(gomock.Any(), changeFeedID).
+		Return(map[model.CaptureID]*model.TaskStatus{
+			"capture-1": {}, "capture-2": {},
+		}, nil).Times(1)
+	provider.EXPECT().GetCaptures(gomock.Any()).
+		Return([]*model.CaptureInfo{
+			{ID: "capture-3", AdvertiseAddr: "127.0.0.1:8302"},
+			{ID: "capture-2", AdvertiseAddr: "127.0.0.1:8301"},
+			{ID: "capture-1", AdvertiseAddr: "127.0.0.1:8300"},
+		}, nil).Times(1)
+	cp.EXPECT().GetProcessorDiagnosis(gomock.Any(), changeFeedID).
+		Return(&model.ProcessorDiagnosis{
+			CaptureID:   "capture-1",
+			Initialized: true,
+			SinkMemoryQuota: model.MemoryQuotaDiagnosis{
+				TotalBytes: 100, UsedBytes: 100, Blocked: true,
+			},
+			SlowestSpans: []model.SpanDiagnosis{{
+				TableID:          1,
+				CaptureID:        "capture-1",
+				PullerResolvedTs: resolvedTs,
+				SorterResolvedTs: resolvedTs,
+				SinkResolvedTs:   resolvedTs,
+				BarrierTs:        resolvedTs,
+				CheckpointTs:     checkpointTs,
+			}},
+		}, nil).Times(1)
+	helpers.EXPECT().getProcessorDiagnosis(gomock.Any(), "127.0.0.1:8301", changeFeedID, "capture-2").
+		Return(nil, errors.New("connection refused")).Times(1)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), diagnose.method,
+		fmt.Sprintf(diagnose.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangefeedDiagnosis{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, changeFeedID.ID, resp.ID)
+	require.Equal(t, checkpointTs, resp.CheckpointTs)
+	require.Greater(t, resp.CheckpointLagMs, int64(50*1000))
+	require.Equal(t, DiagnosisBottleneckMemoryQuota, resp.Bottleneck)
+	require.Len(t, resp.Reasons, 1)
+	require.Len(t, resp.Processors, 2)
+	require.Equal(t, "capture-1", resp.Processors[0].CaptureID)
+	require.NotNil(t, resp.Processors[0].Diagnosis)
+	require.Equal(t, "capture-2", resp.Processors[1].CaptureID)
+	require.Nil(t, resp.Processors[1].Diagnosis)
+	require.Contains(t, resp.Processors[1].Error, "connection refused")
+}
+
+func TestDiagnoseProcessor(t *testing.T) {
+	t.Parallel()
+
+	diagnose := testCase{
+		url:    "/api/v2/processors/%s/%s/diagnose?namespace=abc",
+		method: "GET",
+	}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	router := newRouter(NewOpenAPIV2ForTest(cp, NewMockAPIV2Helpers(gomock.NewController(t))))
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().Info().Return(model.CaptureInfo{ID: "capture-1"}, nil).AnyTimes()
+
+	// case 1: the processor is not on this capture
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), diagnose.method,
+		fmt.Sprintf(diagnose.url, changeFeedID.ID, "capture-2"), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: success
+	cp.EXPECT().GetProcessorDiagnosis(gomock.Any(), changeFeedID).
+		Return(&model.ProcessorDiagnosis{CaptureID: "capture-1", Initialized: true}, nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), diagnose.method,
+		fmt.Sprintf(diagnose.url, changeFeedID.ID, "capture-1"), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := model.ProcessorDiagnosis{}
+	require.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+	require.Equal(t, "capture-1", resp.CaptureID)
+	require.True(t, resp.Initialized)
+}
+
+func TestDiagnoseBottleneck(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	checkpointTs := oracle.GoTimeToTS(now.Add(-time.Minute))
+	resolvedTs := oracle.GoTimeToTS(now.Add(-time.Second))
+
+	// not running
+	bottleneck, _ := diagnoseBottleneck(&ChangefeedDiagnosis{State: model.StateStopped}, now)
+	require.Equal(t, DiagnosisBottleneckNotRunning, bottleneck)
+
+	// not lagging
+	bottleneck, reasons := diagnoseBottleneck(&ChangefeedDiagnosis{
+		State: model.StateNormal, CheckpointLagMs: 1000,
+	}, now)
+	require.Equal(t, DiagnosisBottleneckNone, bottleneck)
+	require.Empty(t, reasons)
+
+	// executing a DDL
+	bottleneck, _ = diagnoseBottleneck(&ChangefeedDiagnosis{
+		State:           model.StateNormal,
+		CheckpointLagMs: 60 * 1000,
+		Barrier:         model.BarrierDiagnosis{ExecutingDDL: "create table t(a int)"},
+	}, now)
+	require.Equal(t, DiagnosisBottleneckBarrier, bottleneck)
+
+	// a slow region
+	d := &ChangefeedDiagnosis{
+		State:            model.StateNormal,
+		CheckpointTs:     checkpointTs,
+		PullerResolvedTs: checkpointTs,
+		CheckpointLagMs:  60 * 1000,
+		Barrier:          model.BarrierDiagnosis{GlobalBarrierTs: resolvedTs},
+		Processors: []ProcessorDiagnosis{{
+			CaptureID: "capture-1",
+			Diagnosis: &model.ProcessorDiagnosis{
+				SlowestSpans: []model.SpanDiagnosis{{
+					TableID:            1,
+					CaptureID:          "capture-1",
+					PullerResolvedTs:   checkpointTs,
+					SorterResolvedTs:   checkpointTs,
+					SinkResolvedTs:     checkpointTs,
+					BarrierTs:          resolvedTs,
+					CheckpointTs:       checkpointTs,
+					SlowestRegion:      &model.RegionDiagnosis{RegionID: 42, ResolvedTs: checkpointTs},
+					UnlockedRangeCount: 1,
+				}},
+			},
+		}},
+	}
+	bottleneck, reasons = diagnoseBottleneck(d, now)
+	require.Equal(t, DiagnosisBottleneckPuller, bottleneck)
+	require.Len(t, reasons, 3)
+	require.Contains(t, reasons[1], "region 42")
+
+	// a slow sink
+	span := &d.Processors[0].Diagnosis.SlowestSpans[0]
+	span.PullerResolvedTs = resolvedTs
+	span.SorterResolvedTs = resolvedTs
+	span.SinkResolvedTs = resolvedTs
+	span.SinkFlushLagMs = 30 * 1000
+	d.PullerResolvedTs = resolvedTs
+	bottleneck, reasons = diagnoseBottleneck(d, now)
+	require.Equal(t, DiagnosisBottleneckSink, bottleneck)
+	require.Len(t, reasons, 2)
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/api"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/txnutil/gc"
+	pd "github.com/tikv/pd/client"
+	"go.uber.org/zap"
+)
+
+// defaultExportGCTTL is the default TTL of the service GC safepoint
+// set by a changefeed export, which is the same as the default gc-ttl.
+const defaultExportGCTTL = 24 * 60 * 60
+
+// exportChangefeed exports a stopped changefeed, so that it can be imported
+// into another TiCDC cluster.
+// @Summary Export a changefeed
+// @Description export the info, status and upstream of a stopped changefeed,
+// @Description and keep its checkpoint from being GC'ed until it is imported
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id path string true "changefeed_id"
+// @Param namespace query string false "default"
+// @Param exportConfig body ExportChangefeedConfig false "export config"
+// @Success 200 {object} ChangefeedExport
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/export [post]
+func (h *OpenAPIV2) exportChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	changefeedID := model.ChangeFeedID{Namespace: namespace, ID: c.Param(api.APIOpVarChangefeedID)}
+	if err := model.ValidateChangefeedID(changefeedID.ID); err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s",
+			changefeedID.ID))
+		return
+	}
+
+	cfg := &ExportChangefeedConfig{GCTTL: defaultExportGCTTL}
+	if c.Request.Body != nil && c.Request.ContentLength > 0 {
+		if err := c.BindJSON(cfg); err != nil {
+			_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+			return
+		}
+	}
+	if cfg.GCTTL <= 0 {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid gc_ttl %d, it must be positive", cfg.GCTTL))
+		return
+	}
+
+	provider := h.capture.StatusProvider()
+	info, err := provider.GetChangeFeedInfo(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// The changefeed must not make any progress after it is exported,
+	// otherwise the imported one replicates the same data again.
+	switch info.State {
+	case model.StateStopped, model.StateFailed:
+	default:
+		_ = c.Error(cerror.ErrChangefeedExportRefused.GenWithStackByArgs(
+			"can only export changefeed when it is stopped or failed"))
+		return
+	}
+	status, err := provider.GetChangeFeedStatus(ctx, changefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upInfo, err := h.capture.GetUpstreamInfo(ctx, info.UpstreamID, namespace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	up, ok := upManager.Get(info.UpstreamID)
+	if !ok {
+		_ = c.Error(cerror.ErrUpstreamNotFound.GenWithStackByArgs(info.UpstreamID))
+		return
+	}
+
+	gcServiceIDPrefix := h.capture.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceExporting)
+	if err := gc.EnsureChangefeedStartTsSafety(
+		ctx, up.PDClient, gcServiceIDPrefix, changefeedID,
+		cfg.GCTTL, status.CheckpointTs); err != nil {
+		if !cerror.ErrStartTsBeforeGC.Equal(err) {
+			_ = c.Error(cerror.ErrPDEtcdAPIError.Wrap(err))
+			return
+		}
+		_ = c.Error(err)
+		return
+	}
+
+	info.Namespace = changefeedID.Namespace
+	info.ID = changefeedID.ID
+	// runtime errors are meaningless to the imported changefeed
+	info.Error = nil
+	info.Warning = nil
+	log.Info("changefeed exported",
+		zap.String("namespace", changefeedID.Namespace),
+		zap.String("changefeed", changefeedID.ID),
+		zap.Uint64("checkpointTs", status.CheckpointTs),
+		zap.Int64("gcTTL", cfg.GCTTL))
+	c.JSON(http.StatusOK, &ChangefeedExport{
+		Version:     ChangefeedExportVersion,
+		ExportTime:  time.Now(),
+		Info:        info,
+		Status:      status,
+		Upstream:    upInfo,
+		GCServiceID: gcServiceIDPrefix + changefeedID.Namespace + "_" + changefeedID.ID,
+	})
+}
+
+// importChangefeed creates a changefeed from an export, it starts from
+// the exported checkpoint.
+// @Summary Import a changefeed
+// @Description create a changefeed from a changefeed export
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param importConfig body ImportChangefeedConfig true "import config"
+// @Success 200 {object} ChangeFeedInfo
+// @Failure 500,400 {object} model.HTTPError
+// @Router /api/v2/changefeeds/import [post]
+func (h *OpenAPIV2) importChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+	importCfg := &ImportChangefeedConfig{}
+	if err := c.BindJSON(importCfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	export := importCfg.Export
+	if export == nil || export.Info == nil ||
+		export.Info.Config == nil || export.Status == nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"changefeed export is incomplete"))
+		return
+	}
+	if export.Version != ChangefeedExportVersion {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"unsupported changefeed export version %d", export.Version))
+		return
+	}
+
+	cfg := &ChangefeedConfig{
+		Namespace:     export.Info.Namespace,
+		ID:            export.Info.ID,
+		StartTs:       export.Status.CheckpointTs,
+		TargetTs:      export.Info.TargetTs,
+		SinkURI:       export.Info.SinkURI,
+		ReplicaConfig: ToAPIReplicaConfig(export.Info.Config),
+		UpstreamID:    export.Info.UpstreamID,
+		PDConfig:      importCfg.PDConfig,
+	}
+	if importCfg.Namespace != "" {
+		cfg.Namespace = importCfg.Namespace
+	}
+	if importCfg.ID != "" {
+		cfg.ID = importCfg.ID
+	}
+	if len(cfg.PDAddrs) == 0 {
+		err := h.fillPDConfigFromUpstream(ctx, cfg)
+		// fallback to the exported upstream if it is not registered in this cluster
+		if cerror.ErrUpstreamNotFound.Equal(errors.Cause(err)) && export.Upstream != nil {
+			cfg.PDConfig = PDConfig{
+				PDAddrs:       strings.Split(export.Upstream.PDEndpoints, ","),
+				CAPath:        export.Upstream.CAPath,
+				CertPath:      export.Upstream.CertPath,
+				KeyPath:       export.Upstream.KeyPath,
+				CertAllowedCN: export.Upstream.CertAllowedCN,
+			}
+			err = nil
+		}
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+
+	info, ok := h.createChangefeedWithConfig(c, cfg,
+		func(ctx context.Context, pdClient pd.Client) {
+			if export.GCServiceID == "" {
+				return
+			}
+			// The imported changefeed holds the GC safepoint from now on.
+			if err := gc.RemoveServiceGCSafepoint(
+				ctx, pdClient, export.GCServiceID); err != nil {
+				log.Warn("failed to remove the GC safepoint of changefeed export",
+					zap.String("serviceID", export.GCServiceID),
+					zap.Error(err))
+			}
+		})
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, toAPIModel(info,
+		info.StartTs, info.StartTs,
+		nil, true))
+}
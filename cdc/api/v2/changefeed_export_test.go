@@ -0,0 +1,260 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	"github.com/pingcap/tiflow/pkg/check"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/pingcap/tiflow/pkg/txnutil/gc"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/tests/v3/integration"
+)
+
+// mockPDClient4GC records the service GC safepoints updated through it.
+type mockPDClient4GC struct {
+	mockPDClient
+	mu         sync.Mutex
+	safepoints map[string]uint64
+}
+
+func (c *mockPDClient4GC) UpdateServiceGCSafePoint(ctx context.Context,
+	serviceID string, ttl int64, safePoint uint64,
+) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ttl <= 0 {
+		delete(c.safepoints, serviceID)
+	} else {
+		c.safepoints[serviceID] = safePoint
+	}
+	return c.mockPDClient.UpdateServiceGCSafePoint(ctx, serviceID, ttl, safePoint)
+}
+
+func (c *mockPDClient4GC) getSafepoint(serviceID string) (uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ts, ok := c.safepoints[serviceID]
+	return ts, ok
+}
+
+func TestExportChangefeed(t *testing.T) {
+	t.Parallel()
+
+	export := testCase{
+		url:    "/api/v2/changefeeds/%s/export?namespace=abc",
+		method: "POST",
+	}
+	pdClient := &mockPDClient4GC{safepoints: make(map[string]uint64)}
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	provider := mock_owner.NewMockStatusProvider(gomock.NewController(t))
+	router := newRouter(NewOpenAPIV2ForTest(cp, NewMockAPIV2Helpers(gomock.NewController(t))))
+
+	etcdClient.EXPECT().GetEnsureGCServiceID(gc.EnsureGCServiceExporting).
+		Return(etcd.GcServiceIDForTest() + gc.EnsureGCServiceExporting).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().Return(upstream.NewManager4Test(pdClient), nil).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(provider).AnyTimes()
+
+	// case 1: invalid gc ttl
+	body, err := json.Marshal(&ExportChangefeedConfig{GCTTL: -1})
+	require.Nil(t, err)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), export.method,
+		fmt.Sprintf(export.url, changeFeedID.ID), bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: the changefeed is still running
+	provider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+		Return(&model.ChangeFeedInfo{State: model.StateNormal}, nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), export.method,
+		fmt.Sprintf(export.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrChangefeedExportRefused")
+
+	// case 3: success
+	provider.EXPECT().GetChangeFeedInfo(gomock.Any(), changeFeedID).
+		Return(&model.ChangeFeedInfo{
+			SinkURI: blackholeSink,
+			State:   model.StateStopped,
+			Config:  config.GetDefaultReplicaConfig(),
+			Error:   &model.RunningError{Message: "test"},
+		}, nil).Times(1)
+	provider.EXPECT().GetChangeFeedStatus(gomock.Any(), changeFeedID).
+		Return(&model.ChangeFeedStatusForAPI{CheckpointTs: 100, ResolvedTs: 200}, nil).Times(1)
+	cp.EXPECT().GetUpstreamInfo(gomock.Any(), uint64(0), changeFeedID.Namespace).
+		Return(&model.UpstreamInfo{PDEndpoints: "http://127.0.0.1:2379"}, nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), export.method,
+		fmt.Sprintf(export.url, changeFeedID.ID), nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangefeedExport{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, ChangefeedExportVersion, resp.Version)
+	require.Equal(t, changeFeedID.ID, resp.Info.ID)
+	require.Equal(t, changeFeedID.Namespace, resp.Info.Namespace)
+	require.Equal(t, blackholeSink, resp.Info.SinkURI)
+	require.Nil(t, resp.Info.Error)
+	require.Equal(t, uint64(100), resp.Status.CheckpointTs)
+	require.Equal(t, "http://127.0.0.1:2379", resp.Upstream.PDEndpoints)
+	safepoint, ok := pdClient.getSafepoint(resp.GCServiceID)
+	require.True(t, ok)
+	require.Equal(t, uint64(100), safepoint)
+}
+
+func TestImportChangefeed(t *testing.T) {
+	t.Parallel()
+
+	importCase := testCase{url: "/api/v2/changefeeds/import", method: "POST"}
+	pdClient := &mockPDClient4GC{safepoints: make(map[string]uint64)}
+	helpers := NewMockAPIV2Helpers(gomock.NewController(t))
+	cp := mock_capture.NewMockCapture(gomock.NewController(t))
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(gomock.NewController(t))
+	mo := mock_owner.NewMockOwner(gomock.NewController(t))
+	provider := mock_owner.NewMockStatusProvider(gomock.NewController(t))
+	router := newRouter(NewOpenAPIV2ForTest(cp, helpers))
+	integration.BeforeTestExternal(t)
+	testEtcdCluster := integration.NewClusterV3(
+		t, &integration.ClusterConfig{Size: 2},
+	)
+	defer testEtcdCluster.Terminate(t)
+
+	etcdClient.EXPECT().GetEnsureGCServiceID(gomock.Any()).
+		Return(etcd.GcServiceIDForTest()).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().Return(upstream.NewManager4Test(pdClient), nil).AnyTimes()
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetOwner().Return(mo, nil).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(provider).AnyTimes()
+
+	// Mock UpstreamDownstreamNotSame check
+	oldGetClusterID := check.GetGetClusterIDBySinkURIFn()
+	defer func() { check.SetGetClusterIDBySinkURIFnForTest(oldGetClusterID) }()
+	check.SetGetClusterIDBySinkURIFnForTest(
+		func(_ context.Context, _ string, _ model.ChangeFeedID, _ *config.ReplicaConfig) (uint64, bool, error) {
+			return 0, false, nil
+		})
+
+	// case 1: the export is incomplete
+	body, err := json.Marshal(&ImportChangefeedConfig{})
+	require.Nil(t, err)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(), importCase.method,
+		importCase.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: unsupported version
+	export := &ChangefeedExport{
+		Version: ChangefeedExportVersion + 1,
+		Info: &model.ChangeFeedInfo{
+			UpstreamID: 123,
+			Namespace:  changeFeedID.Namespace,
+			ID:         changeFeedID.ID,
+			SinkURI:    blackholeSink,
+			Config:     config.GetDefaultReplicaConfig(),
+		},
+		Status:      &model.ChangeFeedStatusForAPI{CheckpointTs: 100},
+		Upstream:    &model.UpstreamInfo{PDEndpoints: "http://127.0.0.1:2379"},
+		GCServiceID: "exporting-service",
+	}
+	body, err = json.Marshal(&ImportChangefeedConfig{Export: export})
+	require.Nil(t, err)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), importCase.method,
+		importCase.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Error, "unsupported changefeed export version")
+
+	// case 3: success, the changefeed starts from the exported checkpoint and
+	// the GC safepoint of the export is removed.
+	_, err = pdClient.UpdateServiceGCSafePoint(context.Background(),
+		export.GCServiceID, 3600, 100)
+	require.Nil(t, err)
+	export.Version = ChangefeedExportVersion
+	// the upstream is not registered, so the exported one is used
+	cp.EXPECT().GetUpstreamInfo(gomock.Any(), uint64(123), changeFeedID.Namespace).
+		Return(nil, cerrors.ErrUpstreamNotFound.GenWithStackByArgs(123)).Times(1)
+	helpers.EXPECT().getPDClient(gomock.Any(), []string{"http://127.0.0.1:2379"}, gomock.Any()).
+		Return(pdClient, nil).Times(1)
+	helpers.EXPECT().createTiStore(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).Times(1)
+	helpers.EXPECT().getEtcdClient(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(testEtcdCluster.RandClient(), nil).Times(1)
+	helpers.EXPECT().verifyCreateChangefeedConfig(gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, cfg *ChangefeedConfig,
+			_, _, _, _ interface{},
+		) (*model.ChangeFeedInfo, error) {
+			require.Equal(t, "new-changefeed", cfg.ID)
+			require.Equal(t, changeFeedID.Namespace, cfg.Namespace)
+			require.Equal(t, uint64(100), cfg.StartTs)
+			return &model.ChangeFeedInfo{
+				UpstreamID: 123,
+				ID:         cfg.ID,
+				Namespace:  cfg.Namespace,
+				SinkURI:    cfg.SinkURI,
+				StartTs:    cfg.StartTs,
+				Config:     config.GetDefaultReplicaConfig(),
+			}, nil
+		}).Times(1)
+	mo.EXPECT().CreateChangefeed(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).Times(1)
+	body, err = json.Marshal(&ImportChangefeedConfig{ID: "new-changefeed", Export: export})
+	require.Nil(t, err)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(), importCase.method,
+		importCase.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ChangeFeedInfo{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, "new-changefeed", resp.ID)
+	require.Equal(t, uint64(100), resp.CheckpointTs)
+	_, ok := pdClient.getSafepoint(export.GCServiceID)
+	require.False(t, ok)
+}
@@ -82,6 +82,40 @@ type ResumeChangefeedConfig struct {
 	OverwriteCheckpointTs uint64 `json:"overwrite_checkpoint_ts"`
 }
 
+// ExportChangefeedConfig is used by export changefeed api
+type ExportChangefeedConfig struct {
+	// GCTTL is the TTL in seconds of the service GC safepoint that keeps
+	// the checkpoint of the exported changefeed until it is imported.
+	GCTTL int64 `json:"gc_ttl"`
+}
+
+// ChangefeedExportVersion is the current version of ChangefeedExport.
+const ChangefeedExportVersion = 1
+
+// ChangefeedExport is a portable snapshot of a changefeed, which can be
+// imported into another TiCDC cluster to continue the replication from
+// the exported checkpoint.
+type ChangefeedExport struct {
+	Version    int                           `json:"version"`
+	ExportTime time.Time                     `json:"export_time"`
+	Info       *model.ChangeFeedInfo         `json:"info"`
+	Status     *model.ChangeFeedStatusForAPI `json:"status"`
+	Upstream   *model.UpstreamInfo           `json:"upstream"`
+	// GCServiceID is the ID of the service GC safepoint set by the export,
+	// it is removed once the changefeed is imported.
+	GCServiceID string `json:"gc_service_id"`
+}
+
+// ImportChangefeedConfig is used by import changefeed api
+type ImportChangefeedConfig struct {
+	// Namespace and ID override the ones in the export if they are not empty.
+	Namespace string            `json:"namespace"`
+	ID        string            `json:"changefeed_id"`
+	Export    *ChangefeedExport `json:"export"`
+	// PDConfig overrides the upstream in the export if PDAddrs is not empty.
+	PDConfig
+}
+
 // PDConfig is a configuration used to connect to pd
 type PDConfig struct {
 	PDAddrs       []string `json:"pd_addrs,omitempty"`
@@ -140,6 +174,9 @@ type ChangefeedConfig struct {
 	TargetTs      uint64         `json:"target_ts"`
 	SinkURI       string         `json:"sink_uri"`
 	ReplicaConfig *ReplicaConfig `json:"replica_config"`
+	// UpstreamID is the ID of a registered upstream, it is used
+	// to connect to the upstream if PDAddrs is empty.
+	UpstreamID uint64 `json:"upstream_id,omitempty"`
 	PDConfig
 }
 
@@ -1102,6 +1139,59 @@ type SyncedStatus struct {
 	Info             string         `json:"info"`
 }
 
+// The bottlenecks reported by a changefeed diagnosis.
+const (
+	// DiagnosisBottleneckNone means the changefeed is not lagging.
+	DiagnosisBottleneckNone = "none"
+	// DiagnosisBottleneckNotRunning means the changefeed is not running.
+	DiagnosisBottleneckNotRunning = "not-running"
+	// DiagnosisBottleneckPuller means the resolved ts of some regions
+	// does not advance in time.
+	DiagnosisBottleneckPuller = "puller"
+	// DiagnosisBottleneckSorter means the events are not sorted in time.
+	DiagnosisBottleneckSorter = "sorter"
+	// DiagnosisBottleneckSink means the sink does not flush events in time.
+	DiagnosisBottleneckSink = "sink"
+	// DiagnosisBottleneckMemoryQuota means the memory quota is exhausted,
+	// which stops events from being sent to the sink.
+	DiagnosisBottleneckMemoryQuota = "memory-quota"
+	// DiagnosisBottleneckBarrier means the changefeed is blocked by a
+	// DDL or syncpoint barrier.
+	DiagnosisBottleneckBarrier = "barrier"
+	// DiagnosisBottleneckUnknown means the bottleneck can not be found.
+	DiagnosisBottleneckUnknown = "unknown"
+)
+
+// ChangefeedDiagnosis is the resolved ts lag diagnosis of a changefeed.
+type ChangefeedDiagnosis struct {
+	Namespace        string          `json:"namespace"`
+	ID               string          `json:"id"`
+	State            model.FeedState `json:"state"`
+	CheckpointTs     uint64          `json:"checkpoint_ts"`
+	ResolvedTs       uint64          `json:"resolved_ts"`
+	PullerResolvedTs uint64          `json:"puller_resolved_ts"`
+	CheckpointLagMs  int64           `json:"checkpoint_lag_ms"`
+	ResolvedTsLagMs  int64           `json:"resolved_ts_lag_ms"`
+	// Bottleneck is the stage that holds back the changefeed the most.
+	Bottleneck string `json:"bottleneck"`
+	// Reasons explain the bottleneck.
+	Reasons []string `json:"reasons"`
+
+	Barrier model.BarrierDiagnosis `json:"barrier"`
+	// SlowestSpans are the slowest table spans known by the owner.
+	SlowestSpans []model.SpanDiagnosis `json:"slowest_spans"`
+	Processors   []ProcessorDiagnosis  `json:"processors"`
+}
+
+// ProcessorDiagnosis is the diagnosis of a processor of a changefeed.
+type ProcessorDiagnosis struct {
+	CaptureID string                    `json:"capture_id"`
+	Address   string                    `json:"address"`
+	Diagnosis *model.ProcessorDiagnosis `json:"diagnosis,omitempty"`
+	// Error is not empty if the diagnosis can not be collected.
+	Error string `json:"error,omitempty"`
+}
+
 // RunningError represents some running error from cdc components,
 // such as processor.
 type RunningError struct {
@@ -1156,6 +1246,15 @@ type UpstreamConfig struct {
 	PDConfig
 }
 
+// Upstream holds the information and health of an upstream TiDB cluster
+type Upstream struct {
+	ID        uint64 `json:"id"`
+	IsDefault bool   `json:"is_default"`
+	PDConfig
+	State string `json:"state"`
+	Error string `json:"error,omitempty"`
+}
+
 // ProcessorDetail holds the detail info of a processor
 type ProcessorDetail struct {
 	// All table ids that this processor are replicating.
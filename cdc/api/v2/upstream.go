@@ -0,0 +1,332 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/model"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"go.uber.org/zap"
+)
+
+const (
+	apiOpVarUpstreamID = "upstream_id"
+
+	// upstreamStateNormal means the upstream is initialized and its pd is reachable.
+	upstreamStateNormal = "normal"
+	// upstreamStateAbnormal means the upstream failed to initialize or its pd is unreachable.
+	upstreamStateAbnormal = "abnormal"
+	// upstreamStateIdle means the upstream is registered but not used by the owner.
+	upstreamStateIdle = "idle"
+)
+
+// createUpstream registers an upstream TiDB cluster
+// @Summary Create upstream
+// @Description register an upstream TiDB cluster, so that changefeeds can be created by its upstream ID
+// @Tags upstream,v2
+// @Accept json
+// @Produce json
+// @Param upstream body UpstreamConfig true "upstream config"
+// @Param namespace query string false "default"
+// @Success 200 {object} Upstream
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/upstreams [post]
+func (h *OpenAPIV2) createUpstream(c *gin.Context) {
+	ctx := c.Request.Context()
+	cfg := &UpstreamConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIInvalidParam, err))
+		return
+	}
+	if len(cfg.PDAddrs) == 0 {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"pd_addrs is empty, cannot register an upstream without pd_addrs"))
+		return
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	pdClient, err := h.helpers.getPDClient(timeoutCtx, cfg.PDAddrs, cfg.PDConfig.toCredential())
+	if err != nil {
+		_ = c.Error(cerror.WrapError(cerror.ErrAPIGetPDClientFailed, err))
+		return
+	}
+	defer pdClient.Close()
+
+	upstreamID := pdClient.GetClusterID(ctx)
+	if cfg.ID != 0 && cfg.ID != upstreamID {
+		_ = c.Error(cerror.ErrUpstreamMissMatch.GenWithStackByArgs(cfg.ID, upstreamID))
+		return
+	}
+	info := &model.UpstreamInfo{
+		ID:            upstreamID,
+		PDEndpoints:   strings.Join(cfg.PDAddrs, ","),
+		KeyPath:       cfg.KeyPath,
+		CertPath:      cfg.CertPath,
+		CAPath:        cfg.CAPath,
+		CertAllowedCN: cfg.CertAllowedCN,
+	}
+	namespace := getNamespaceValueWithDefault(c)
+	if err := h.capture.GetEtcdClient().SaveUpstreamInfo(ctx, info, namespace); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("Create upstream successfully!",
+		zap.Uint64("upstreamID", info.ID),
+		zap.String("namespace", namespace),
+		zap.String("pdEndpoints", info.PDEndpoints))
+
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, h.toAPIUpstream(ctx, upManager, info, false))
+}
+
+// listUpstreams lists all upstreams of a namespace
+// @Summary List upstreams
+// @Description list all upstreams of a namespace and their health
+// @Tags upstream,v2
+// @Produce json
+// @Param namespace query string false "default"
+// @Success 200 {array} Upstream
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/upstreams [get]
+func (h *OpenAPIV2) listUpstreams(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace := getNamespaceValueWithDefault(c)
+	infos, err := h.capture.GetEtcdClient().GetUpstreamInfos(ctx, namespace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	defaultUpstream, err := upManager.GetDefaultUpstream()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	upstreams := make([]Upstream, 0, len(infos)+1)
+	hasDefault := false
+	for _, info := range infos {
+		isDefault := info.ID == defaultUpstream.ID
+		hasDefault = hasDefault || isDefault
+		upstreams = append(upstreams, h.toAPIUpstream(ctx, upManager, info, isDefault))
+	}
+	// the default upstream is only stored in etcd after a changefeed is
+	// created on it, but it is always available.
+	if !hasDefault {
+		upstreams = append([]Upstream{
+			h.toAPIUpstream(ctx, upManager, defaultUpstreamInfo(defaultUpstream), true),
+		}, upstreams...)
+	}
+	c.JSON(http.StatusOK, &ListResponse[Upstream]{
+		Total: len(upstreams),
+		Items: upstreams,
+	})
+}
+
+// getUpstream gets the detail and health of an upstream
+// @Summary Get upstream
+// @Description get the detail and health of an upstream
+// @Tags upstream,v2
+// @Produce json
+// @Param upstream_id path string true "upstream_id"
+// @Param namespace query string false "default"
+// @Success 200 {object} Upstream
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/upstreams/{upstream_id} [get]
+func (h *OpenAPIV2) getUpstream(c *gin.Context) {
+	ctx := c.Request.Context()
+	upstreamID, err := strconv.ParseUint(c.Param(apiOpVarUpstreamID), 10, 64)
+	if err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid upstream_id: %s", c.Param(apiOpVarUpstreamID)))
+		return
+	}
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if defaultUpstream, err := upManager.GetDefaultUpstream(); err == nil &&
+		defaultUpstream.ID == upstreamID {
+		c.JSON(http.StatusOK,
+			h.toAPIUpstream(ctx, upManager, defaultUpstreamInfo(defaultUpstream), true))
+		return
+	}
+
+	namespace := getNamespaceValueWithDefault(c)
+	info, err := h.capture.GetUpstreamInfo(ctx, upstreamID, namespace)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, h.toAPIUpstream(ctx, upManager, info, false))
+}
+
+// deleteUpstream removes an upstream that is not used by any changefeed
+// @Summary Remove upstream
+// @Description remove an upstream that is not used by any changefeed
+// @Tags upstream,v2
+// @Produce json
+// @Param upstream_id path string true "upstream_id"
+// @Param namespace query string false "default"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} model.HTTPError
+// @Router	/api/v2/upstreams/{upstream_id} [delete]
+func (h *OpenAPIV2) deleteUpstream(c *gin.Context) {
+	ctx := c.Request.Context()
+	upstreamID, err := strconv.ParseUint(c.Param(apiOpVarUpstreamID), 10, 64)
+	if err != nil {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"invalid upstream_id: %s", c.Param(apiOpVarUpstreamID)))
+		return
+	}
+	upManager, err := h.capture.GetUpstreamManager()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if defaultUpstream, err := upManager.GetDefaultUpstream(); err == nil &&
+		defaultUpstream.ID == upstreamID {
+		_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+			"cannot remove the default upstream %d", upstreamID))
+		return
+	}
+
+	namespace := getNamespaceValueWithDefault(c)
+	infos, err := h.capture.StatusProvider().GetAllChangeFeedInfo(ctx)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	// The upstream info is stored per namespace, but the upstream manager is
+	// shared by all namespaces, so the upstream is only closed if it is not
+	// used by the changefeeds of any namespace.
+	usedByOtherNamespaces := false
+	for id, info := range infos {
+		if info.UpstreamID != upstreamID {
+			continue
+		}
+		if id.Namespace == namespace {
+			_ = c.Error(cerror.ErrAPIInvalidParam.GenWithStack(
+				"upstream %d is used by changefeed %s", upstreamID, id.ID))
+			return
+		}
+		usedByOtherNamespaces = true
+	}
+
+	if err := h.capture.GetEtcdClient().DeleteUpstreamInfo(ctx, upstreamID, namespace); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if !usedByOtherNamespaces {
+		upManager.Remove(upstreamID)
+	}
+	log.Info("Remove upstream successfully!",
+		zap.Uint64("upstreamID", upstreamID),
+		zap.String("namespace", namespace),
+		zap.Bool("closed", !usedByOtherNamespaces))
+	c.JSON(http.StatusOK, &EmptyResponse{})
+}
+
+// fillPDConfigFromUpstream fills the pd config of a changefeed config with
+// the registered upstream if only the upstream ID is specified.
+func (h *OpenAPIV2) fillPDConfigFromUpstream(
+	ctx context.Context, cfg *ChangefeedConfig,
+) error {
+	if cfg.UpstreamID == 0 || len(cfg.PDAddrs) != 0 {
+		return nil
+	}
+	up, err := getCaptureDefaultUpstream(h.capture)
+	if err != nil {
+		return err
+	}
+	// the default upstream is used if pd addresses are empty
+	if up.ID == cfg.UpstreamID {
+		return nil
+	}
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = model.DefaultNamespace
+	}
+	info, err := h.capture.GetUpstreamInfo(ctx, cfg.UpstreamID, namespace)
+	if err != nil {
+		return err
+	}
+	cfg.PDConfig = PDConfig{
+		PDAddrs:       strings.Split(info.PDEndpoints, ","),
+		CAPath:        info.CAPath,
+		CertPath:      info.CertPath,
+		KeyPath:       info.KeyPath,
+		CertAllowedCN: info.CertAllowedCN,
+	}
+	return nil
+}
+
+func (h *OpenAPIV2) toAPIUpstream(
+	ctx context.Context, upManager *upstream.Manager,
+	info *model.UpstreamInfo, isDefault bool,
+) Upstream {
+	res := Upstream{
+		ID:        info.ID,
+		IsDefault: isDefault,
+		PDConfig: PDConfig{
+			PDAddrs:       strings.Split(info.PDEndpoints, ","),
+			CAPath:        info.CAPath,
+			CertPath:      info.CertPath,
+			KeyPath:       info.KeyPath,
+			CertAllowedCN: info.CertAllowedCN,
+		},
+		State: upstreamStateIdle,
+	}
+	up, ok := upManager.Get(info.ID)
+	if !ok {
+		return res
+	}
+	res.State = upstreamStateNormal
+	if err := up.CheckHealth(ctx); err != nil {
+		res.State = upstreamStateAbnormal
+		res.Error = err.Error()
+	}
+	return res
+}
+
+func defaultUpstreamInfo(up *upstream.Upstream) *model.UpstreamInfo {
+	info := &model.UpstreamInfo{
+		ID:          up.ID,
+		PDEndpoints: strings.Join(up.PdEndpoints, ","),
+	}
+	if up.SecurityConfig != nil {
+		info.CAPath = up.SecurityConfig.CAPath
+		info.CertPath = up.SecurityConfig.CertPath
+		info.KeyPath = up.SecurityConfig.KeyPath
+		info.CertAllowedCN = up.SecurityConfig.CertAllowedCN
+	}
+	return info
+}
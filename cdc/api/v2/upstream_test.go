@@ -0,0 +1,306 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	mock_capture "github.com/pingcap/tiflow/cdc/capture/mock"
+	"github.com/pingcap/tiflow/cdc/model"
+	mock_owner "github.com/pingcap/tiflow/cdc/owner/mock"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	mock_etcd "github.com/pingcap/tiflow/pkg/etcd/mock"
+	"github.com/pingcap/tiflow/pkg/upstream"
+	"github.com/stretchr/testify/require"
+)
+
+func newUpstreamTestRouter(t *testing.T) (
+	*gin.Engine, *mock_capture.MockCapture,
+	*mock_etcd.MockCDCEtcdClient, *MockAPIV2Helpers,
+) {
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	helpers := NewMockAPIV2Helpers(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().
+		Return(upstream.NewManager4Test(&mockPDClient{}), nil).AnyTimes()
+	return newRouter(NewOpenAPIV2ForTest(cp, helpers)), cp, etcdClient, helpers
+}
+
+func TestCreateUpstream(t *testing.T) {
+	t.Parallel()
+
+	create := testCase{url: "/api/v2/upstreams", method: "POST"}
+	router, _, etcdClient, helpers := newUpstreamTestRouter(t)
+
+	// case 1: pd addresses are empty
+	body, err := json.Marshal(&UpstreamConfig{})
+	require.Nil(t, err)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		create.method, create.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIInvalidParam")
+
+	// case 2: get pd client failed
+	helpers.EXPECT().getPDClient(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, cerrors.ErrAPIGetPDClientFailed).Times(1)
+	body, err = json.Marshal(&UpstreamConfig{
+		PDConfig: PDConfig{PDAddrs: []string{"http://127.0.0.1:2379"}},
+	})
+	require.Nil(t, err)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		create.method, create.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrAPIGetPDClientFailed")
+
+	// case 3: upstream ID mismatches with the cluster ID of pd
+	helpers.EXPECT().getPDClient(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&mockPDClient{}, nil).AnyTimes()
+	body, err = json.Marshal(&UpstreamConfig{
+		ID:       1,
+		PDConfig: PDConfig{PDAddrs: []string{"http://127.0.0.1:2379"}},
+	})
+	require.Nil(t, err)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		create.method, create.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	respErr = model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrUpstreamMissMatch")
+
+	// case 4: success
+	etcdClient.EXPECT().SaveUpstreamInfo(gomock.Any(), gomock.Any(), model.DefaultNamespace).
+		DoAndReturn(func(_ context.Context, info *model.UpstreamInfo, _ string) error {
+			require.Equal(t, uint64(123), info.ID)
+			require.Equal(t, "http://127.0.0.1:2379,http://127.0.0.2:2379", info.PDEndpoints)
+			require.Equal(t, "ca.pem", info.CAPath)
+			return nil
+		}).Times(1)
+	body, err = json.Marshal(&UpstreamConfig{
+		PDConfig: PDConfig{
+			PDAddrs: []string{"http://127.0.0.1:2379", "http://127.0.0.2:2379"},
+			CAPath:  "ca.pem",
+		},
+	})
+	require.Nil(t, err)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		create.method, create.url, bytes.NewReader(body))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := Upstream{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, uint64(123), resp.ID)
+	require.False(t, resp.IsDefault)
+	require.Equal(t, upstreamStateIdle, resp.State)
+}
+
+func TestListUpstreams(t *testing.T) {
+	t.Parallel()
+
+	list := testCase{url: "/api/v2/upstreams", method: "GET"}
+	router, _, etcdClient, _ := newUpstreamTestRouter(t)
+
+	etcdClient.EXPECT().GetUpstreamInfos(gomock.Any(), model.DefaultNamespace).
+		Return([]*model.UpstreamInfo{{ID: 123, PDEndpoints: "http://127.0.0.1:2379"}}, nil)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		list.method, list.url, nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := ListResponse[Upstream]{}
+	err := json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, 2, resp.Total)
+	// the default upstream is always listed first
+	require.True(t, resp.Items[0].IsDefault)
+	require.Equal(t, upstreamStateNormal, resp.Items[0].State)
+	require.Equal(t, uint64(123), resp.Items[1].ID)
+	require.Equal(t, []string{"http://127.0.0.1:2379"}, resp.Items[1].PDAddrs)
+	require.Equal(t, upstreamStateIdle, resp.Items[1].State)
+}
+
+func TestGetUpstream(t *testing.T) {
+	t.Parallel()
+
+	router, cp, _, _ := newUpstreamTestRouter(t)
+
+	// case 1: invalid upstream ID
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		"GET", "/api/v2/upstreams/abc", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: the default upstream
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		"GET", "/api/v2/upstreams/0", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp := Upstream{}
+	err := json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.True(t, resp.IsDefault)
+	require.Equal(t, upstreamStateNormal, resp.State)
+
+	// case 3: upstream not found
+	cp.EXPECT().GetUpstreamInfo(gomock.Any(), uint64(1), model.DefaultNamespace).
+		Return(nil, cerrors.ErrUpstreamNotFound.GenWithStackByArgs(1))
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		"GET", "/api/v2/upstreams/1", nil)
+	router.ServeHTTP(w, req)
+	respErr := model.HTTPError{}
+	err = json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Code, "ErrUpstreamNotFound")
+
+	// case 4: a registered upstream
+	cp.EXPECT().GetUpstreamInfo(gomock.Any(), uint64(123), model.DefaultNamespace).
+		Return(&model.UpstreamInfo{ID: 123, PDEndpoints: "http://127.0.0.1:2379"}, nil)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		"GET", "/api/v2/upstreams/123", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	resp = Upstream{}
+	err = json.NewDecoder(w.Body).Decode(&resp)
+	require.Nil(t, err)
+	require.Equal(t, uint64(123), resp.ID)
+	require.False(t, resp.IsDefault)
+}
+
+func TestDeleteUpstream(t *testing.T) {
+	t.Parallel()
+
+	router, cp, etcdClient, _ := newUpstreamTestRouter(t)
+	provider := mock_owner.NewMockStatusProvider(gomock.NewController(t))
+	cp.EXPECT().StatusProvider().Return(provider).AnyTimes()
+
+	// case 1: the default upstream can not be removed
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		"DELETE", "/api/v2/upstreams/0", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// case 2: the upstream is used by a changefeed
+	provider.EXPECT().GetAllChangeFeedInfo(gomock.Any()).
+		Return(map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			model.DefaultChangeFeedID("cf"): {UpstreamID: 123},
+		}, nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		"DELETE", "/api/v2/upstreams/123", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	respErr := model.HTTPError{}
+	err := json.NewDecoder(w.Body).Decode(&respErr)
+	require.Nil(t, err)
+	require.Contains(t, respErr.Error, "is used by changefeed cf")
+
+	// case 3: success
+	provider.EXPECT().GetAllChangeFeedInfo(gomock.Any()).
+		Return(map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			model.DefaultChangeFeedID("cf"): {UpstreamID: 456},
+		}, nil).Times(1)
+	etcdClient.EXPECT().DeleteUpstreamInfo(gomock.Any(), uint64(123), model.DefaultNamespace).
+		Return(nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		"DELETE", "/api/v2/upstreams/123", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestDeleteUpstreamUsedByOtherNamespace(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	cp := mock_capture.NewMockCapture(ctrl)
+	etcdClient := mock_etcd.NewMockCDCEtcdClient(ctrl)
+	provider := mock_owner.NewMockStatusProvider(ctrl)
+	upManager := upstream.NewManager4Test(&mockPDClient{})
+	up := upManager.AddUpstream4Test(123, nil)
+	cp.EXPECT().IsReady().Return(true).AnyTimes()
+	cp.EXPECT().IsOwner().Return(true).AnyTimes()
+	cp.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cp.EXPECT().GetUpstreamManager().Return(upManager, nil).AnyTimes()
+	cp.EXPECT().StatusProvider().Return(provider).AnyTimes()
+	router := newRouter(NewOpenAPIV2ForTest(cp, NewMockAPIV2Helpers(ctrl)))
+
+	// The upstream info of ns1 is removed, but the upstream is still used
+	// by a changefeed of ns2, so it is kept in the manager.
+	provider.EXPECT().GetAllChangeFeedInfo(gomock.Any()).
+		Return(map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			{Namespace: "ns2", ID: "cf"}: {UpstreamID: 123},
+		}, nil).Times(1)
+	etcdClient.EXPECT().DeleteUpstreamInfo(gomock.Any(), uint64(123), "ns1").
+		Return(nil).Times(1)
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(context.Background(),
+		"DELETE", "/api/v2/upstreams/123?namespace=ns1", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	_, ok := upManager.Get(123)
+	require.True(t, ok)
+	require.False(t, up.IsClosed())
+
+	// The changefeed of ns2 blocks removing the upstream info of ns2.
+	provider.EXPECT().GetAllChangeFeedInfo(gomock.Any()).
+		Return(map[model.ChangeFeedID]*model.ChangeFeedInfo{
+			{Namespace: "ns2", ID: "cf"}: {UpstreamID: 123},
+		}, nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		"DELETE", "/api/v2/upstreams/123?namespace=ns2", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	// The upstream is removed once no changefeed uses it.
+	provider.EXPECT().GetAllChangeFeedInfo(gomock.Any()).
+		Return(map[model.ChangeFeedID]*model.ChangeFeedInfo{}, nil).Times(1)
+	etcdClient.EXPECT().DeleteUpstreamInfo(gomock.Any(), uint64(123), "ns2").
+		Return(nil).Times(1)
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequestWithContext(context.Background(),
+		"DELETE", "/api/v2/upstreams/123?namespace=ns2", nil)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	_, ok = upManager.Get(123)
+	require.False(t, ok)
+}
@@ -70,6 +70,9 @@ type Capture interface {
 	Info() (model.CaptureInfo, error)
 	StatusProvider() owner.StatusProvider
 	WriteDebugInfo(ctx context.Context, w io.Writer)
+	// GetProcessorDiagnosis returns the resolved ts lag diagnosis of the
+	// processor of the changefeed on this capture.
+	GetProcessorDiagnosis(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ProcessorDiagnosis, error)
 
 	GetUpstreamManager() (*upstream.Manager, error)
 	GetEtcdClient() etcd.CDCEtcdClient
@@ -750,6 +753,36 @@ func (c *captureImpl) WriteDebugInfo(ctx context.Context, w io.Writer) {
 	wait(doneM)
 }
 
+// GetProcessorDiagnosis returns the resolved ts lag diagnosis of the
+// processor of the changefeed on this capture.
+func (c *captureImpl) GetProcessorDiagnosis(
+	ctx context.Context, changefeedID model.ChangeFeedID,
+) (*model.ProcessorDiagnosis, error) {
+	query := &processor.DiagnosisQuery{ChangefeedID: changefeedID}
+	done := make(chan error, 1)
+	c.captureMu.Lock()
+	if c.processorManager == nil {
+		c.captureMu.Unlock()
+		return nil, cerror.ErrCaptureNotInitialized.GenWithStackByArgs()
+	}
+	c.processorManager.QueryDiagnosis(ctx, query, done)
+	// Release the lock before waiting, see WriteDebugInfo.
+	c.captureMu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.Trace(ctx.Err())
+	case err := <-done:
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if query.Resp == nil {
+		return nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(changefeedID)
+	}
+	return query.Resp, nil
+}
+
 // IsOwner returns whether the capture is an owner
 func (c *captureImpl) IsOwner() bool {
 	c.ownerMu.Lock()
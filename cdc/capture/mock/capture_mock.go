@@ -109,6 +109,21 @@ func (mr *MockCaptureMockRecorder) GetOwnerCaptureInfo(ctx interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerCaptureInfo", reflect.TypeOf((*MockCapture)(nil).GetOwnerCaptureInfo), ctx)
 }
 
+// GetProcessorDiagnosis mocks base method.
+func (m *MockCapture) GetProcessorDiagnosis(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ProcessorDiagnosis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessorDiagnosis", ctx, changefeedID)
+	ret0, _ := ret[0].(*model.ProcessorDiagnosis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessorDiagnosis indicates an expected call of GetProcessorDiagnosis.
+func (mr *MockCaptureMockRecorder) GetProcessorDiagnosis(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessorDiagnosis", reflect.TypeOf((*MockCapture)(nil).GetProcessorDiagnosis), ctx, changefeedID)
+}
+
 // GetUpstreamInfo mocks base method.
 func (m *MockCapture) GetUpstreamInfo(arg0 context.Context, arg1 model.UpstreamID, arg2 string) (*model.UpstreamInfo, error) {
 	m.ctrl.T.Helper()
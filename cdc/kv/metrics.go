@@ -165,6 +165,13 @@ var (
 			Name:      "slow_initialize_region_count",
 			Help:      "the number of slow initialize region",
 		}, []string{"namespace", "changefeed"})
+	regionStallRemediationCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "kvclient",
+			Name:      "region_stall_remediation_count",
+			Help:      "the number of remediation steps applied to stalled regions",
+		}, []string{"namespace", "changefeed", "step"})
 )
 
 // GetGlobalGrpcMetrics gets the global grpc metrics.
@@ -192,6 +199,7 @@ func InitMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(workerBusyRatio)
 	registry.MustRegister(workerChannelSize)
 	registry.MustRegister(slowInitializeRegion)
+	registry.MustRegister(regionStallRemediationCounter)
 
 	// Register client metrics to registry.
 	registry.MustRegister(grpcMetrics)
@@ -0,0 +1,231 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/cdc/kv/regionlock"
+	"github.com/pingcap/tiflow/pkg/config"
+	cerror "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/tikv/client-go/v2/oracle"
+	"github.com/tikv/client-go/v2/tikv"
+	"go.uber.org/zap"
+)
+
+const regionStallCheckInterval = 10 * time.Second
+
+// regionStallRemediator remediates regions whose resolved ts has been stuck
+// longer than the threshold. The configured steps are applied to a region
+// one by one, the next step is applied only if the region is still stuck
+// after another threshold.
+type regionStallRemediator struct {
+	threshold   time.Duration
+	steps       []string
+	pdAPIClient pdutil.PDAPIClient
+	warnCh      chan<- error
+
+	// stalls are the remediation progresses of stuck regions.
+	stalls map[stalledRegionKey]*regionStall
+}
+
+type stalledRegionKey struct {
+	subscriptionID SubscriptionID
+	regionID       uint64
+}
+
+type regionStall struct {
+	// nextStep is the index of the step to apply next time.
+	nextStep    int
+	lastApplied time.Time
+}
+
+type stalledRegion struct {
+	stalledRegionKey
+	table *subscribedTable
+	state *regionlock.LockedRangeState
+	lag   time.Duration
+}
+
+type regionRemediation struct {
+	stalledRegion
+	step string
+}
+
+func newRegionStallRemediator(
+	cfg *config.PullerConfig, pdAPIClient pdutil.PDAPIClient, warnCh chan<- error,
+) *regionStallRemediator {
+	return &regionStallRemediator{
+		threshold:   time.Duration(cfg.RegionStallThreshold),
+		steps:       cfg.RegionStallRemediationSteps,
+		pdAPIClient: pdAPIClient,
+		warnCh:      warnCh,
+		stalls:      make(map[stalledRegionKey]*regionStall),
+	}
+}
+
+// schedule returns the remediation steps that should be applied to the given
+// stuck regions now.
+func (r *regionStallRemediator) schedule(regions []stalledRegion, now time.Time) []regionRemediation {
+	stalls := make(map[stalledRegionKey]*regionStall, len(regions))
+	// A region is not stuck during it's being resubscribed, keep its progress
+	// for a while so that the next step can be applied if it's stuck again.
+	for key, stall := range r.stalls {
+		if now.Sub(stall.lastApplied) < 2*r.threshold {
+			stalls[key] = stall
+		}
+	}
+
+	var ret []regionRemediation
+	for _, region := range regions {
+		stall := stalls[region.stalledRegionKey]
+		if stall == nil {
+			stall = &regionStall{}
+			stalls[region.stalledRegionKey] = stall
+		} else if now.Sub(stall.lastApplied) < r.threshold {
+			// Wait for the last step to take effect.
+			continue
+		}
+		ret = append(ret, regionRemediation{
+			stalledRegion: region,
+			step:          r.steps[stall.nextStep%len(r.steps)],
+		})
+		stall.nextStep++
+		stall.lastApplied = now
+	}
+	r.stalls = stalls
+	return ret
+}
+
+// EnableRegionStallRemediation enables remediating regions whose resolved ts
+// is stuck, as configured in `debug.puller`. Applied remediation steps are
+// reported to warnCh. It must be called before Run.
+func (s *SharedClient) EnableRegionStallRemediation(pdAPIClient pdutil.PDAPIClient, warnCh chan<- error) {
+	s.stallRemediator = newRegionStallRemediator(s.config.Debug.Puller, pdAPIClient, warnCh)
+}
+
+func (s *SharedClient) remediateStalledRegions(ctx context.Context) error {
+	ticker := time.NewTicker(regionStallCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		currTime := s.pdClock.CurrentTime()
+		regions := s.findStalledRegions(currTime, s.stallRemediator.threshold)
+		for _, remediation := range s.stallRemediator.schedule(regions, time.Now()) {
+			s.applyRemediation(ctx, remediation, currTime)
+		}
+	}
+}
+
+// findStalledRegions returns initialized regions whose resolved ts lags behind
+// currTime for more than threshold. Uninitialized regions are not included,
+// because they are usually slow in incremental scan instead of being stuck.
+func (s *SharedClient) findStalledRegions(currTime time.Time, threshold time.Duration) []stalledRegion {
+	var regions []stalledRegion
+	s.totalSpans.RLock()
+	defer s.totalSpans.RUnlock()
+	for subscriptionID, rt := range s.totalSpans.v {
+		if rt.stopped.Load() {
+			continue
+		}
+		rt.rangeLock.IterAll(func(regionID uint64, state *regionlock.LockedRangeState) {
+			if !state.Initialzied.Load() {
+				return
+			}
+			lag := currTime.Sub(oracle.GetTimeFromTS(state.ResolvedTs.Load()))
+			if lag < threshold {
+				return
+			}
+			regions = append(regions, stalledRegion{
+				stalledRegionKey: stalledRegionKey{subscriptionID: subscriptionID, regionID: regionID},
+				table:            rt,
+				state:            state,
+				lag:              lag,
+			})
+		})
+	}
+	return regions
+}
+
+func (s *SharedClient) applyRemediation(ctx context.Context, r regionRemediation, currTime time.Time) {
+	var err error
+	switch r.step {
+	case config.RegionStallStepResolveLock:
+		s.resolveLockTaskCh.In() <- resolveLockTask{
+			regionID: r.regionID,
+			targetTs: oracle.GoTimeToTS(currTime.Add(-resolveLockMinInterval)),
+			state:    r.state,
+			create:   time.Now(),
+		}
+	case config.RegionStallStepResubscribe:
+		s.regionCh.In() <- regionInfo{
+			verID:            tikv.NewRegionVerID(r.regionID, 0, 0),
+			span:             r.table.span,
+			subscribedTable:  r.table,
+			lockedRangeState: r.state,
+			resubscribe:      true,
+		}
+	case config.RegionStallStepTransferLeader:
+		err = s.transferRegionLeader(ctx, r.regionID)
+	}
+
+	log.Warn("event feed remediates a stalled region",
+		zap.String("namespace", s.changefeed.Namespace),
+		zap.String("changefeed", s.changefeed.ID),
+		zap.Any("subscriptionID", r.subscriptionID),
+		zap.Int64("tableID", r.table.span.TableID),
+		zap.Uint64("regionID", r.regionID),
+		zap.Uint64("resolvedTs", r.state.ResolvedTs.Load()),
+		zap.Duration("lag", r.lag),
+		zap.String("step", r.step),
+		zap.Error(err))
+	regionStallRemediationCounter.
+		WithLabelValues(s.changefeed.Namespace, s.changefeed.ID, r.step).Inc()
+
+	warning := cerror.ErrRegionResolvedTsStalled.GenWithStackByArgs(
+		r.regionID, r.table.span.TableID, r.lag, r.step)
+	// Warnings are only for displaying, so they can be dropped if
+	// the previous ones are not consumed yet.
+	select {
+	case s.stallRemediator.warnCh <- warning:
+	default:
+	}
+}
+
+// transferRegionLeader asks PD to transfer the leader of the region to one of
+// its followers.
+func (s *SharedClient) transferRegionLeader(ctx context.Context, regionID uint64) error {
+	region, err := s.pd.GetRegionByID(ctx, regionID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if region == nil || region.Meta == nil || region.Leader == nil {
+		return errors.Errorf("region %d or its leader is not found", regionID)
+	}
+	for _, peer := range region.Meta.GetPeers() {
+		if peer.GetStoreId() != region.Leader.GetStoreId() && peer.GetRole() == metapb.PeerRole_Voter {
+			return s.stallRemediator.pdAPIClient.TransferRegionLeader(ctx, regionID, peer.GetStoreId())
+		}
+	}
+	return errors.Errorf("region %d has no follower to transfer leader to", regionID)
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/kv/regionlock"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestRegionStallRemediatorSchedule(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.NewDefaultPullerConfig()
+	cfg.RegionStallThreshold = config.TomlDuration(time.Minute)
+	cfg.RegionStallRemediationSteps = []string{
+		config.RegionStallStepResolveLock, config.RegionStallStepResubscribe,
+	}
+	r := newRegionStallRemediator(cfg, nil, nil)
+
+	region := stalledRegion{stalledRegionKey: stalledRegionKey{subscriptionID: 1, regionID: 2}}
+	now := time.Now()
+	steps := r.schedule([]stalledRegion{region}, now)
+	require.Len(t, steps, 1)
+	require.Equal(t, config.RegionStallStepResolveLock, steps[0].step)
+
+	// The next step is not applied until the threshold passes.
+	require.Empty(t, r.schedule([]stalledRegion{region}, now.Add(30*time.Second)))
+	steps = r.schedule([]stalledRegion{region}, now.Add(time.Minute))
+	require.Len(t, steps, 1)
+	require.Equal(t, config.RegionStallStepResubscribe, steps[0].step)
+
+	// The region is not stuck during being resubscribed, and it's stuck again.
+	require.Empty(t, r.schedule(nil, now.Add(90*time.Second)))
+	steps = r.schedule([]stalledRegion{region}, now.Add(2*time.Minute))
+	require.Len(t, steps, 1)
+	require.Equal(t, config.RegionStallStepResolveLock, steps[0].step)
+
+	// The progress is dropped after the region recovers for a long time.
+	require.Empty(t, r.schedule(nil, now.Add(5*time.Minute)))
+	require.Empty(t, r.stalls)
+	steps = r.schedule([]stalledRegion{region}, now.Add(6*time.Minute))
+	require.Len(t, steps, 1)
+	require.Equal(t, config.RegionStallStepResolveLock, steps[0].step)
+}
+
+func TestFindStalledRegions(t *testing.T) {
+	t.Parallel()
+
+	s := newSharedClientForTestSharedRegionWorker()
+	currTime := time.Now()
+	startTs := oracle.GoTimeToTS(currTime.Add(-time.Hour))
+	span := spanz.TableIDToComparableSpan(1)
+	rt := s.newSubscribedTable(SubscriptionID(1), span, startTs, nil)
+	s.totalSpans.v[rt.subscriptionID] = rt
+
+	res := rt.rangeLock.LockRange(context.Background(), span.StartKey, span.EndKey, 1, 1)
+	require.Equal(t, regionlock.LockRangeStatusSuccess, res.Status)
+	state := res.LockedRangeState
+
+	// Uninitialized regions are not stalled.
+	require.Empty(t, s.findStalledRegions(currTime, time.Minute))
+
+	state.Initialzied.Store(true)
+	regions := s.findStalledRegions(currTime, time.Minute)
+	require.Len(t, regions, 1)
+	require.Equal(t, uint64(1), regions[0].regionID)
+	require.Equal(t, rt.subscriptionID, regions[0].subscriptionID)
+
+	state.ResolvedTs.Store(oracle.GoTimeToTS(currTime))
+	require.Empty(t, s.findStalledRegions(currTime, time.Minute))
+}
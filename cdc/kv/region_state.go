@@ -43,6 +43,10 @@ type regionInfo struct {
 	subscribedTable *subscribedTable
 	// The state of the locked range of the region.
 	lockedRangeState *regionlock.LockedRangeState
+
+	// resubscribe is only set in the special task for resubscribing
+	// a stalled region.
+	resubscribe bool
 }
 
 func (s regionInfo) isStopped() bool {
@@ -50,6 +54,10 @@ func (s regionInfo) isStopped() bool {
 	return s.lockedRangeState == nil
 }
 
+func (s regionInfo) isResubscribe() bool {
+	return s.resubscribe
+}
+
 func newRegionInfo(
 	verID tikv.RegionVerID,
 	span tablepb.Span,
@@ -89,6 +89,7 @@ var (
 	metricStoreSendRequestErr         = eventFeedErrorCounter.WithLabelValues("SendRequestToStore")
 	metricKvIsBusyCounter             = eventFeedErrorCounter.WithLabelValues("KvIsBusy")
 	metricKvCongestedCounter          = eventFeedErrorCounter.WithLabelValues("KvCongested")
+	metricFeedRegionStalledCounter    = eventFeedErrorCounter.WithLabelValues("RegionStalled")
 )
 
 type eventError struct {
@@ -117,6 +118,10 @@ type sendRequestToStoreErr struct{}
 
 func (e *sendRequestToStoreErr) Error() string { return "send request to store error" }
 
+type regionStalledErr struct{}
+
+func (e *regionStalledErr) Error() string { return "region resolved ts is stalled" }
+
 // SubscriptionID comes from `SharedClient.AllocSubscriptionID`.
 type SubscriptionID uint64
 
@@ -174,6 +179,9 @@ type SharedClient struct {
 	resolveLockTaskCh *chann.DrainableChann[resolveLockTask]
 	errCh             *chann.DrainableChann[regionErrorInfo]
 
+	// stallRemediator is nil if region stall remediation is disabled.
+	stallRemediator *regionStallRemediator
+
 	logRegionDetails func(msg string, fields ...zap.Field)
 }
 
@@ -343,6 +351,17 @@ func (s *SharedClient) RegionCount(subID SubscriptionID) uint64 {
 	return 0
 }
 
+// RangeLockStats returns the statistics of the range lock of the given
+// subscription, the second return value is false if it's not found.
+func (s *SharedClient) RangeLockStats(subID SubscriptionID) (regionlock.RangeLockStatistics, bool) {
+	s.totalSpans.RLock()
+	defer s.totalSpans.RUnlock()
+	if rt := s.totalSpans.v[subID]; rt != nil {
+		return rt.rangeLock.IterAll(nil), true
+	}
+	return regionlock.RangeLockStatistics{}, false
+}
+
 // Run the client.
 func (s *SharedClient) Run(ctx context.Context) error {
 	s.clusterID = s.pd.GetClusterID(ctx)
@@ -360,6 +379,9 @@ func (s *SharedClient) Run(ctx context.Context) error {
 	g.Go(func() error { return s.handleErrors(ctx) })
 	g.Go(func() error { return s.handleResolveLockTasks(ctx) })
 	g.Go(func() error { return s.logSlowRegions(ctx) })
+	if s.stallRemediator != nil {
+		g.Go(func() error { return s.remediateStalledRegions(ctx) })
+	}
 
 	log.Info("event feed started",
 		zap.String("namespace", s.changefeed.Namespace),
@@ -427,7 +449,9 @@ func (s *SharedClient) handleRegions(ctx context.Context, eg *errgroup.Group) er
 		case <-ctx.Done():
 			return errors.Trace(ctx.Err())
 		case region := <-s.regionCh.Out():
-			if region.isStopped() {
+			// The stream which requested the region is unknown, so broadcast
+			// special tasks to all streams.
+			if region.isStopped() || region.isResubscribe() {
 				for _, rs := range s.stores {
 					s.broadcastRequest(rs, region)
 				}
@@ -748,6 +772,12 @@ func (s *SharedClient) doHandleError(ctx context.Context, errInfo regionErrorInf
 		s.regionCache.OnSendFail(bo, errInfo.rpcCtx, true, err)
 		s.scheduleRangeRequest(ctx, errInfo.span, errInfo.subscribedTable)
 		return nil
+	case *regionStalledErr:
+		metricFeedRegionStalledCounter.Inc()
+		// Reload the region from PD, its leader may have been transferred.
+		s.regionCache.InvalidateCachedRegion(errInfo.verID)
+		s.scheduleRangeRequest(ctx, errInfo.span, errInfo.subscribedTable)
+		return nil
 	case *sendRequestToStoreErr:
 		metricStoreSendRequestErr.Inc()
 		bo := tikv.NewBackoffer(ctx, tikvRequestMaxBackoff)
@@ -959,6 +989,9 @@ func (s *SharedClient) clearMetrics() {
 	lockResolveDuration.DeleteLabelValues(s.changefeed.Namespace, s.changefeed.ID, "run")
 
 	batchResolvedEventSize.DeleteLabelValues(s.changefeed.Namespace, s.changefeed.ID)
+	regionStallRemediationCounter.DeletePartialMatch(prometheus.Labels{
+		"namespace": s.changefeed.Namespace, "changefeed": s.changefeed.ID,
+	})
 }
 
 func hashRegionID(regionID uint64, slots int) int {
@@ -76,7 +76,7 @@ func newStream(ctx context.Context, c *SharedClient, g *errgroup.Group, r *reque
 			case <-ctx.Done():
 				return ctx.Err()
 			case region := <-stream.requests.Out():
-				if !region.isStopped() {
+				if !region.isStopped() && !region.isResubscribe() {
 					stream.preFetchForConnecting = new(regionInfo)
 					*stream.preFetchForConnecting = region
 					return nil
@@ -123,8 +123,9 @@ func newStream(ctx context.Context, c *SharedClient, g *errgroup.Group, r *reque
 			// Why we need to re-schedule pending regions? This because the store can
 			// fail forever, and all regions are scheduled to other stores.
 			for _, region := range stream.clearPendingRegions() {
-				if region.isStopped() {
-					// It means it's a special task for stopping the table.
+				if region.isStopped() || region.isResubscribe() {
+					// It means it's a special task for stopping the table
+					// or resubscribing a region.
 					continue
 				}
 				c.onRegionFail(newRegionErrorInfo(region, regionErr))
@@ -348,6 +349,14 @@ func (s *requestedStream) send(ctx context.Context, c *SharedClient, rs *request
 					return errors.Trace(err)
 				}
 			}
+		} else if region.isResubscribe() {
+			cc := s.multiplexing
+			if cc == nil {
+				cc = tableExclusives[subscriptionID]
+			}
+			if err = s.resubscribeRegion(ctx, c, rs, cc, region); err != nil {
+				return errors.Trace(err)
+			}
 		} else if region.subscribedTable.stopped.Load() {
 			// It can be skipped directly because there must be no pending states from
 			// the stopped subscribedTable, or the special singleRegionInfo for stopping
@@ -386,6 +395,48 @@ func (s *requestedStream) send(ctx context.Context, c *SharedClient, rs *request
 	}
 }
 
+// resubscribeRegion deregisters a stalled region from TiKV if it's requested
+// in the stream, then the region will be rescheduled by the region worker.
+func (s *requestedStream) resubscribeRegion(
+	ctx context.Context, c *SharedClient, rs *requestedStore,
+	cc *sharedconn.ConnAndClient, region regionInfo,
+) error {
+	subscriptionID := region.subscribedTable.subscriptionID
+	regionID := region.verID.GetID()
+	state := s.getState(subscriptionID, regionID)
+	// The region is not requested in the stream, or it has been rescheduled.
+	if state == nil || state.region.lockedRangeState != region.lockedRangeState {
+		return nil
+	}
+
+	if cc != nil {
+		req := &cdcpb.ChangeDataRequest{
+			Header:    &cdcpb.Header{ClusterId: c.clusterID, TicdcVersion: version.ReleaseSemver()},
+			RegionId:  regionID,
+			RequestId: uint64(subscriptionID),
+			Request: &cdcpb.ChangeDataRequest_Deregister_{
+				Deregister: &cdcpb.ChangeDataRequest_Deregister{},
+			},
+		}
+		if err := cc.Client().Send(req); err != nil {
+			log.Warn("event feed send deregister region request to grpc stream failed",
+				zap.String("namespace", c.changefeed.Namespace),
+				zap.String("changefeed", c.changefeed.ID),
+				zap.Uint64("streamID", s.streamID),
+				zap.Any("subscriptionID", subscriptionID),
+				zap.Int64("tableID", region.span.TableID),
+				zap.Uint64("regionID", regionID),
+				zap.String("addr", rs.storeAddr),
+				zap.Error(err))
+		}
+	}
+
+	state.markStopped(&regionStalledErr{})
+	sfEvent := newEventItem(nil, state, s)
+	slot := hashRegionID(regionID, len(c.workers))
+	return c.workers[slot].sendEvent(ctx, sfEvent)
+}
+
 func (s *requestedStream) countStates() (sum int) {
 	s.requestedRegions.Lock()
 	defer s.requestedRegions.Unlock()
@@ -0,0 +1,103 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// ChangefeedDiagnosis is the resolved ts lag diagnosis of a changefeed
+// collected by the owner.
+type ChangefeedDiagnosis struct {
+	CheckpointTs     Ts `json:"checkpoint_ts"`
+	ResolvedTs       Ts `json:"resolved_ts"`
+	PullerResolvedTs Ts `json:"puller_resolved_ts"`
+
+	Barrier BarrierDiagnosis `json:"barrier"`
+	// SlowestSpans are the table spans with the smallest checkpoint ts,
+	// the slowest one comes first.
+	SlowestSpans []SpanDiagnosis `json:"slowest_spans"`
+}
+
+// BarrierDiagnosis is the diagnosis of the barriers that hold back the
+// resolved ts of a changefeed.
+type BarrierDiagnosis struct {
+	// DDLResolvedTs is the resolved ts of the DDL puller.
+	DDLResolvedTs Ts `json:"ddl_resolved_ts"`
+	// GlobalBarrierTs is the commit ts of the next DDL that blocks all tables,
+	// or the DDL resolved ts if there is no such DDL.
+	GlobalBarrierTs Ts `json:"global_barrier_ts"`
+	// MinTableBarrierTs is the commit ts of the next DDL of any table.
+	MinTableBarrierTs Ts `json:"min_table_barrier_ts"`
+	// BlockedTableCount is the number of tables with a pending DDL.
+	BlockedTableCount int `json:"blocked_table_count"`
+	// ExecutingDDL is the query of the DDL being executed by the owner.
+	ExecutingDDL         string `json:"executing_ddl,omitempty"`
+	ExecutingDDLCommitTs Ts     `json:"executing_ddl_commit_ts,omitempty"`
+}
+
+// SpanDiagnosis is the diagnosis of a table span.
+type SpanDiagnosis struct {
+	TableID   TableID   `json:"table_id"`
+	Span      string    `json:"span"`
+	CaptureID CaptureID `json:"capture_id,omitempty"`
+	State     string    `json:"state"`
+
+	RegionCount      uint64 `json:"region_count"`
+	PullerResolvedTs Ts     `json:"puller_resolved_ts"`
+	SorterResolvedTs Ts     `json:"sorter_resolved_ts"`
+	SinkResolvedTs   Ts     `json:"sink_resolved_ts"`
+	CheckpointTs     Ts     `json:"checkpoint_ts"`
+	BarrierTs        Ts     `json:"barrier_ts"`
+
+	// The following fields are only reported by processors.
+
+	// SinkFlushLagMs is how long the table sink has not advanced its
+	// checkpoint while there are events not flushed yet.
+	SinkFlushLagMs int64 `json:"sink_flush_lag_ms,omitempty"`
+	// SlowestRegion is the region with the smallest resolved ts in the span.
+	SlowestRegion *RegionDiagnosis `json:"slowest_region,omitempty"`
+	// UnlockedRangeCount is the number of ranges in the span that are not
+	// covered by any region, they block the resolved ts until being locked.
+	UnlockedRangeCount int `json:"unlocked_range_count,omitempty"`
+}
+
+// RegionDiagnosis is the diagnosis of a region subscribed by a table span.
+type RegionDiagnosis struct {
+	RegionID    uint64    `json:"region_id"`
+	ResolvedTs  Ts        `json:"resolved_ts"`
+	Initialized bool      `json:"initialized"`
+	CreateTime  time.Time `json:"create_time"`
+}
+
+// ProcessorDiagnosis is the resolved ts lag diagnosis of a changefeed
+// collected by one of its processors.
+type ProcessorDiagnosis struct {
+	CaptureID   CaptureID `json:"capture_id"`
+	Initialized bool      `json:"initialized"`
+
+	SinkMemoryQuota MemoryQuotaDiagnosis `json:"sink_memory_quota"`
+	// RedoMemoryQuota is nil if redo log is disabled.
+	RedoMemoryQuota *MemoryQuotaDiagnosis `json:"redo_memory_quota,omitempty"`
+	// SlowestSpans are the table spans with the smallest checkpoint ts
+	// in the processor, the slowest one comes first.
+	SlowestSpans []SpanDiagnosis `json:"slowest_spans"`
+}
+
+// MemoryQuotaDiagnosis is the diagnosis of a memory quota.
+type MemoryQuotaDiagnosis struct {
+	TotalBytes uint64 `json:"total_bytes"`
+	UsedBytes  uint64 `json:"used_bytes"`
+	// Blocked is true if the quota has been exhausted recently, which
+	// stops new events from being sent to the sink.
+	Blocked bool `json:"blocked"`
+}
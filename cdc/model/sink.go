@@ -388,6 +388,20 @@ type RowChangedEvent struct {
 	//    tidb will make a hidden column called "_tidb_rowid" as the handle.
 	//    due to the type of "_tidb_rowid" is int, so we also use IntHandle to represent.
 	HandleKey kv.Handle
+	// BinlogPosition is the upstream binlog position of the event, it's only
+	// set when the event is replicated from MySQL by DM.
+	BinlogPosition *BinlogPosition
+}
+
+// BinlogPosition is the position of an event in the upstream MySQL binlog.
+//
+//msgp:ignore BinlogPosition
+type BinlogPosition struct {
+	File string
+	Pos  uint32
+	// GTID is the executed GTID set when the event is replicated, it's empty
+	// if GTID is not enabled.
+	GTID string
 }
 
 // RowChangedEventInRedoLog is used to store RowChangedEvent in redo log v2 format
@@ -1081,6 +1095,9 @@ type DDLEvent struct {
 	// Only used in the splited DDLEvent generated by a multi-table DDL,
 	// we need to keep the order of the original multi-table DDL
 	Seq uint64 `msg:"seq"`
+	// BinlogPosition is the upstream binlog position of the DDL, it's only
+	// set when the DDL is replicated from MySQL by DM.
+	BinlogPosition *BinlogPosition `msg:"-"`
 }
 
 // FromJob fills the values with DDLEvent from DDL job
@@ -107,6 +107,8 @@ type changefeed struct {
 	// because it will be updated in tick.
 	lastSyncedTs     model.Ts
 	pullerResolvedTs model.Ts
+	// lastBarrier is the diagnosis of the barrier handled in the last tick.
+	lastBarrier model.BarrierDiagnosis
 
 	// ddl related fields
 	ddlManager  *ddlManager
@@ -439,6 +441,7 @@ func (c *changefeed) tick(ctx context.Context,
 	if err != nil {
 		return 0, 0, errors.Trace(err)
 	}
+	c.lastBarrier = c.ddlManager.diagnoseBarrier(barrier)
 
 	log.Debug("owner handles barrier",
 		zap.String("namespace", c.id.Namespace),
@@ -504,6 +504,22 @@ func (m *ddlManager) getAllTableNextDDL() []*model.DDLEvent {
 	return res
 }
 
+// diagnoseBarrier returns the diagnosis of the given barrier, which is
+// used to explain why the resolved ts of the changefeed is held back.
+func (m *ddlManager) diagnoseBarrier(barrier *schedulepb.BarrierWithMinTs) model.BarrierDiagnosis {
+	ret := model.BarrierDiagnosis{
+		DDLResolvedTs:     m.ddlResolvedTs,
+		GlobalBarrierTs:   barrier.GlobalBarrierTs,
+		MinTableBarrierTs: barrier.MinTableBarrierTs,
+		BlockedTableCount: len(barrier.TableBarriers),
+	}
+	if m.executingDDL != nil {
+		ret.ExecutingDDL = m.executingDDL.Query
+		ret.ExecutingDDLCommitTs = m.executingDDL.CommitTs
+	}
+	return ret
+}
+
 // barrier returns ddlResolvedTs and tableBarrier
 func (m *ddlManager) barrier() *schedulepb.BarrierWithMinTs {
 	barrier := schedulepb.NewBarrierWithMinTs(m.ddlResolvedTs)
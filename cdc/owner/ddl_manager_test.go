@@ -146,6 +146,29 @@ func TestBarriers(t *testing.T) {
 	require.Equal(t, 256, len(barrier.TableBarriers))
 }
 
+func TestDiagnoseBarrier(t *testing.T) {
+	dm := createDDLManagerForTest(t, false)
+
+	tableID := int64(1)
+	tableName := model.TableName{Table: "test_1", TableID: tableID}
+	dm.pendingDDLs[tableName] = append(dm.pendingDDLs[tableName],
+		newFakeDDLEvent(tableID, tableName.Table, timodel.ActionAddColumn, 3))
+	dm.ddlResolvedTs = 6
+	diagnosis := dm.diagnoseBarrier(dm.barrier())
+	require.Equal(t, model.BarrierDiagnosis{
+		DDLResolvedTs:     6,
+		GlobalBarrierTs:   6,
+		MinTableBarrierTs: 3,
+		BlockedTableCount: 1,
+	}, diagnosis)
+
+	dm.executingDDL = newFakeDDLEvent(tableID, tableName.Table, timodel.ActionAddColumn, 3)
+	dm.executingDDL.Query = "alter table test_1 add column c int"
+	diagnosis = dm.diagnoseBarrier(dm.barrier())
+	require.Equal(t, "alter table test_1 add column c int", diagnosis.ExecutingDDL)
+	require.Equal(t, model.Ts(3), diagnosis.ExecutingDDLCommitTs)
+}
+
 func TestGetSnapshotTs(t *testing.T) {
 	dm := createDDLManagerForTest(t, false)
 	dm.startTs = 0
@@ -95,6 +95,21 @@ func (mr *MockStatusProviderMockRecorder) GetCaptures(ctx interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCaptures", reflect.TypeOf((*MockStatusProvider)(nil).GetCaptures), ctx)
 }
 
+// GetChangeFeedDiagnosis mocks base method.
+func (m *MockStatusProvider) GetChangeFeedDiagnosis(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedDiagnosis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangeFeedDiagnosis", ctx, changefeedID)
+	ret0, _ := ret[0].(*model.ChangefeedDiagnosis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangeFeedDiagnosis indicates an expected call of GetChangeFeedDiagnosis.
+func (mr *MockStatusProviderMockRecorder) GetChangeFeedDiagnosis(ctx, changefeedID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangeFeedDiagnosis", reflect.TypeOf((*MockStatusProvider)(nil).GetChangeFeedDiagnosis), ctx, changefeedID)
+}
+
 // GetChangeFeedInfo mocks base method.
 func (m *MockStatusProvider) GetChangeFeedInfo(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -53,6 +53,10 @@ const (
 // captures with versions different from that of the owner
 const versionInconsistentLogRate = 1
 
+// diagnosisSpanLimit is the max number of the slowest spans reported
+// in a changefeed diagnosis.
+const diagnosisSpanLimit = 10
+
 // Export field names for pretty printing.
 type ownerJob struct {
 	Tp           ownerJobType
@@ -692,6 +696,24 @@ func (o *ownerImpl) handleQueries(query *Query) error {
 			ret.SyncedCheckInterval = cfReactor.latestInfo.Config.SyncedStatus.SyncedCheckInterval
 		}
 		query.Data = ret
+	case QueryChangefeedDiagnosis:
+		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
+		if !ok {
+			query.Data = nil
+			return nil
+		}
+		ret := &model.ChangefeedDiagnosis{
+			ResolvedTs:       cfReactor.resolvedTs.Load(),
+			PullerResolvedTs: cfReactor.pullerResolvedTs,
+			Barrier:          cfReactor.lastBarrier,
+		}
+		if cfReactor.latestStatus != nil {
+			ret.CheckpointTs = cfReactor.latestStatus.CheckpointTs
+		}
+		if provider := cfReactor.GetInfoProvider(); provider != nil {
+			ret.SlowestSpans = provider.GetSlowestSpans(diagnosisSpanLimit)
+		}
+		query.Data = ret
 	case QueryChangefeedInfo:
 		cfReactor, ok := o.changefeeds[query.ChangeFeedID]
 		if !ok {
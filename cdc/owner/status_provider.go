@@ -41,6 +41,9 @@ type StatusProvider interface {
 	// GetChangeFeedInfo returns a changefeeds' info.
 	GetChangeFeedInfo(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangeFeedInfo, error)
 
+	// GetChangeFeedDiagnosis returns a changefeeds' resolved ts lag diagnosis.
+	GetChangeFeedDiagnosis(ctx context.Context, changefeedID model.ChangeFeedID) (*model.ChangefeedDiagnosis, error)
+
 	// GetAllTaskStatuses returns the task statuses for the specified changefeed.
 	GetAllTaskStatuses(ctx context.Context, changefeedID model.ChangeFeedID) (map[model.CaptureID]*model.TaskStatus, error)
 
@@ -82,6 +85,8 @@ const (
 	QueryAllChangeFeedSCheckpointTs
 	// QueryExists is the type of query check if a changefeed exists
 	QueryExists
+	// QueryChangefeedDiagnosis is the type of query changefeed diagnosis
+	QueryChangefeedDiagnosis
 )
 
 // Query wraps query command and return results.
@@ -149,6 +154,22 @@ func (p *ownerStatusProvider) GetChangeFeedInfo(ctx context.Context,
 	return query.Data.(*model.ChangeFeedInfo), nil
 }
 
+func (p *ownerStatusProvider) GetChangeFeedDiagnosis(ctx context.Context,
+	changefeedID model.ChangeFeedID,
+) (*model.ChangefeedDiagnosis, error) {
+	query := &Query{
+		Tp:           QueryChangefeedDiagnosis,
+		ChangeFeedID: changefeedID,
+	}
+	if err := p.sendQueryToOwner(ctx, query); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if query.Data == nil {
+		return nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(changefeedID)
+	}
+	return query.Data.(*model.ChangefeedDiagnosis), nil
+}
+
 func (p *ownerStatusProvider) GetAllTaskStatuses(ctx context.Context,
 	changefeedID model.ChangeFeedID,
 ) (map[model.CaptureID]*model.TaskStatus, error) {
@@ -0,0 +1,138 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processor
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/processor/memquota"
+	"github.com/pingcap/tiflow/cdc/processor/sinkmanager"
+	"github.com/pingcap/tiflow/cdc/processor/tablepb"
+)
+
+const (
+	// diagnosisSpanLimit is the max number of the slowest spans reported
+	// in a processor diagnosis.
+	diagnosisSpanLimit = 10
+	// memQuotaBlockedWindow is the window in which a memory quota is
+	// considered blocked if it has been exhausted.
+	memQuotaBlockedWindow = 10 * time.Second
+)
+
+// DiagnosisQuery is used to query the diagnosis of a processor.
+type DiagnosisQuery struct {
+	ChangefeedID model.ChangeFeedID
+
+	// Resp is nil if the processor does not exist.
+	Resp *model.ProcessorDiagnosis
+}
+
+// diagnose returns the resolved ts lag diagnosis of the processor.
+// It must be called in the goroutine that ticks the processor.
+func (p *processor) diagnose() *model.ProcessorDiagnosis {
+	ret := &model.ProcessorDiagnosis{CaptureID: p.captureInfo.ID}
+	if !p.initialized.Load() {
+		return ret
+	}
+	ret.Initialized = true
+
+	now := time.Now()
+	ret.SinkMemoryQuota = toMemoryQuotaDiagnosis(
+		p.sinkManager.r.GetSinkMemQuotaStats(), now)
+	if stats, ok := p.sinkManager.r.GetRedoMemQuotaStats(); ok {
+		redo := toMemoryQuotaDiagnosis(stats, now)
+		ret.RedoMemoryQuota = &redo
+	}
+
+	type tableStats struct {
+		span  tablepb.Span
+		stats sinkmanager.TableStats
+	}
+	spans := p.sinkManager.r.GetAllCurrentTableSpans()
+	tables := make([]tableStats, 0, len(spans))
+	for _, span := range spans {
+		tables = append(tables, tableStats{
+			span:  span,
+			stats: p.sinkManager.r.GetTableStats(span),
+		})
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].stats.CheckpointTs < tables[j].stats.CheckpointTs
+	})
+	if len(tables) > diagnosisSpanLimit {
+		tables = tables[:diagnosisSpanLimit]
+	}
+	ret.SlowestSpans = make([]model.SpanDiagnosis, 0, len(tables))
+	for _, table := range tables {
+		ret.SlowestSpans = append(ret.SlowestSpans,
+			p.diagnoseSpan(table.span, table.stats, now))
+	}
+	return ret
+}
+
+func (p *processor) diagnoseSpan(
+	span tablepb.Span, sinkStats sinkmanager.TableStats, now time.Time,
+) model.SpanDiagnosis {
+	state, _ := p.sinkManager.r.GetTableState(span)
+	pullerStats := p.sourceManager.r.GetTablePullerStats(span)
+	sorterStats := p.sourceManager.r.GetTableSorterStats(span)
+	ret := model.SpanDiagnosis{
+		TableID:          span.TableID,
+		Span:             span.String(),
+		CaptureID:        p.captureInfo.ID,
+		State:            state.String(),
+		RegionCount:      pullerStats.RegionCount,
+		PullerResolvedTs: pullerStats.ResolvedTsEgress,
+		SorterResolvedTs: sorterStats.ReceivedMaxResolvedTs,
+		SinkResolvedTs:   sinkStats.ResolvedTs,
+		CheckpointTs:     sinkStats.CheckpointTs,
+		BarrierTs:        sinkStats.BarrierTs,
+	}
+
+	// The table sink can not advance beyond the barrier ts, so it is only
+	// lagging behind when there are flushable events below the barrier.
+	flushableTs := sinkStats.ResolvedTs
+	if sinkStats.BarrierTs < flushableTs {
+		flushableTs = sinkStats.BarrierTs
+	}
+	if sinkStats.CheckpointTs < flushableTs {
+		if advanced, ok := p.sinkManager.r.GetTableSinkLastAdvanced(span); ok {
+			ret.SinkFlushLagMs = now.Sub(advanced).Milliseconds()
+		}
+	}
+
+	if lockStats, ok := p.sourceManager.r.GetTableRangeLockStats(span); ok {
+		ret.UnlockedRangeCount = len(lockStats.UnLockedRanges)
+		if lockStats.LockedRegionCount > 0 {
+			ret.SlowestRegion = &model.RegionDiagnosis{
+				RegionID:    lockStats.SlowestRegion.RegionID,
+				ResolvedTs:  lockStats.SlowestRegion.ResolvedTs,
+				Initialized: lockStats.SlowestRegion.Initialized,
+				CreateTime:  lockStats.SlowestRegion.Created,
+			}
+		}
+	}
+	return ret
+}
+
+func toMemoryQuotaDiagnosis(stats memquota.Stats, now time.Time) model.MemoryQuotaDiagnosis {
+	return model.MemoryQuotaDiagnosis{
+		TotalBytes: stats.TotalBytes,
+		UsedBytes:  stats.UsedBytes,
+		Blocked: !stats.LastBlocked.IsZero() &&
+			now.Sub(stats.LastBlocked) < memQuotaBlockedWindow,
+	}
+}
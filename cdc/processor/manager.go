@@ -38,6 +38,7 @@ type commandTp int
 const (
 	commandTpUnknown commandTp = iota
 	commandTpWriteDebugInfo
+	commandTpQueryDiagnosis
 	processorLogsWarnDuration = 1 * time.Second
 )
 
@@ -56,6 +57,9 @@ type Manager interface {
 	Close()
 
 	WriteDebugInfo(ctx context.Context, w io.Writer, done chan<- error)
+
+	// QueryDiagnosis queries the resolved ts lag diagnosis of a processor.
+	QueryDiagnosis(ctx context.Context, query *DiagnosisQuery, done chan<- error)
 }
 
 // managerImpl is a manager of processor, which maintains the state and behavior of processors
@@ -321,6 +325,16 @@ func (m *managerImpl) WriteDebugInfo(
 	}
 }
 
+// QueryDiagnosis queries the resolved ts lag diagnosis of a processor.
+func (m *managerImpl) QueryDiagnosis(
+	ctx context.Context, query *DiagnosisQuery, done chan<- error,
+) {
+	err := m.sendCommand(ctx, commandTpQueryDiagnosis, query, done)
+	if err != nil {
+		log.Warn("send command commandTpQueryDiagnosis failed", zap.Error(err))
+	}
+}
+
 // sendCommands sends command to manager.
 // `done` is closed upon command completion or sendCommand returns error.
 func (m *managerImpl) sendCommand(
@@ -352,6 +366,11 @@ func (m *managerImpl) handleCommand() {
 		if err != nil {
 			cmd.done <- err
 		}
+	case commandTpQueryDiagnosis:
+		query := cmd.payload.(*DiagnosisQuery)
+		if p, ok := m.processors[query.ChangefeedID]; ok {
+			query.Resp = p.diagnose()
+		}
 	default:
 		log.Warn("Unknown command in processor manager", zap.Any("command", cmd))
 	}
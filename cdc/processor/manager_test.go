@@ -194,6 +194,19 @@ func TestDebugInfo(t *testing.T) {
 	<-doneM
 	require.Greater(t, len(buf.String()), 0)
 
+	query := &DiagnosisQuery{ChangefeedID: changefeedID}
+	doneM = make(chan error, 1)
+	s.manager.QueryDiagnosis(ctx, query, doneM)
+	require.Nil(t, <-doneM)
+	require.NotNil(t, query.Resp)
+	require.Equal(t, s.manager.captureInfo.ID, query.Resp.CaptureID)
+
+	query = &DiagnosisQuery{ChangefeedID: model.DefaultChangeFeedID("not-exist")}
+	doneM = make(chan error, 1)
+	s.manager.QueryDiagnosis(ctx, query, doneM)
+	require.Nil(t, <-doneM)
+	require.Nil(t, query.Resp)
+
 	// Stop tick so that we can close manager safely.
 	cancel()
 	<-done
@@ -52,6 +52,9 @@ type MemQuota struct {
 
 	// blockAcquireCond is used to notify the blocked acquire.
 	blockAcquireCond *sync.Cond
+	// lastBlocked is the unix nano time of the last time an acquisition
+	// failed or blocked because the quota was exhausted.
+	lastBlocked atomic.Int64
 
 	metricTotal prometheus.Gauge
 	metricUsed  prometheus.Gauge
@@ -111,6 +114,7 @@ func (m *MemQuota) TryAcquire(nBytes uint64) bool {
 	for {
 		usedBytes := m.usedBytes.Load()
 		if usedBytes+nBytes > m.totalBytes {
+			m.lastBlocked.Store(time.Now().UnixNano())
 			return false
 		}
 		if m.usedBytes.CompareAndSwap(usedBytes, usedBytes+nBytes) {
@@ -132,6 +136,7 @@ func (m *MemQuota) BlockAcquire(nBytes uint64) error {
 		}
 		usedBytes := m.usedBytes.Load()
 		if usedBytes+nBytes > m.totalBytes {
+			m.lastBlocked.Store(time.Now().UnixNano())
 			m.blockAcquireCond.L.Lock()
 			m.blockAcquireCond.Wait()
 			m.blockAcquireCond.L.Unlock()
@@ -284,6 +289,27 @@ func (m *MemQuota) GetUsedBytes() uint64 {
 	return m.usedBytes.Load()
 }
 
+// Stats is the statistics of a MemQuota.
+type Stats struct {
+	TotalBytes uint64
+	UsedBytes  uint64
+	// LastBlocked is the last time an acquisition failed or blocked because
+	// the quota was exhausted, it is zero if that never happened.
+	LastBlocked time.Time
+}
+
+// GetStats returns the statistics of the memory quota.
+func (m *MemQuota) GetStats() Stats {
+	stats := Stats{
+		TotalBytes: m.totalBytes,
+		UsedBytes:  m.usedBytes.Load(),
+	}
+	if lastBlocked := m.lastBlocked.Load(); lastBlocked != 0 {
+		stats.LastBlocked = time.Unix(0, lastBlocked)
+	}
+	return stats
+}
+
 // hasAvailable returns true if the memory quota is available, otherwise returns false.
 func (m *MemQuota) hasAvailable(nBytes uint64) bool {
 	return m.usedBytes.Load()+nBytes <= m.totalBytes
@@ -34,6 +34,22 @@ func TestMemQuotaTryAcquire(t *testing.T) {
 	require.False(t, m.TryAcquire(1))
 }
 
+func TestMemQuotaGetStats(t *testing.T) {
+	t.Parallel()
+
+	m := NewMemQuota(model.DefaultChangeFeedID("1"), 100, "")
+	defer m.Close()
+
+	require.True(t, m.TryAcquire(60))
+	stats := m.GetStats()
+	require.Equal(t, uint64(100), stats.TotalBytes)
+	require.Equal(t, uint64(60), stats.UsedBytes)
+	require.True(t, stats.LastBlocked.IsZero())
+
+	require.False(t, m.TryAcquire(50))
+	require.False(t, m.GetStats().LastBlocked.IsZero())
+}
+
 func TestMemQuotaForceAcquire(t *testing.T) {
 	t.Parallel()
 
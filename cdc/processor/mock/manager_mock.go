@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	processor "github.com/pingcap/tiflow/cdc/processor"
 	orchestrator "github.com/pingcap/tiflow/pkg/orchestrator"
 )
 
@@ -48,6 +49,18 @@ func (mr *MockManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockManager)(nil).Close))
 }
 
+// QueryDiagnosis mocks base method.
+func (m *MockManager) QueryDiagnosis(ctx context.Context, query *processor.DiagnosisQuery, done chan<- error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "QueryDiagnosis", ctx, query, done)
+}
+
+// QueryDiagnosis indicates an expected call of QueryDiagnosis.
+func (mr *MockManagerMockRecorder) QueryDiagnosis(ctx, query, done interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDiagnosis", reflect.TypeOf((*MockManager)(nil).QueryDiagnosis), ctx, query, done)
+}
+
 // Tick mocks base method.
 func (m *MockManager) Tick(ctx context.Context, state orchestrator.ReactorState) (orchestrator.ReactorState, error) {
 	m.ctrl.T.Helper()
@@ -1028,6 +1028,30 @@ func (m *SinkManager) GetTableStats(span tablepb.Span) TableStats {
 	}
 }
 
+// GetTableSinkLastAdvanced returns the last time the table sink advanced its
+// checkpoint or caught up with the resolved ts.
+func (m *SinkManager) GetTableSinkLastAdvanced(span tablepb.Span) (time.Time, bool) {
+	value, ok := m.tableSinks.Load(span)
+	if !ok {
+		return time.Time{}, false
+	}
+	return value.(*tableSinkWrapper).getLastAdvanced(), true
+}
+
+// GetSinkMemQuotaStats returns the statistics of the sink memory quota.
+func (m *SinkManager) GetSinkMemQuotaStats() memquota.Stats {
+	return m.sinkMemQuota.GetStats()
+}
+
+// GetRedoMemQuotaStats returns the statistics of the redo memory quota,
+// the second return value is false if redo log is disabled.
+func (m *SinkManager) GetRedoMemQuotaStats() (memquota.Stats, bool) {
+	if m.redoDMLMgr == nil {
+		return memquota.Stats{}, false
+	}
+	return m.redoMemQuota.GetStats(), true
+}
+
 // WaitForReady implements pkg/util.Runnable.
 func (m *SinkManager) WaitForReady(ctx context.Context) {
 	select {
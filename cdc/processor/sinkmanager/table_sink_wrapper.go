@@ -247,6 +247,12 @@ func (t *tableSinkWrapper) getCheckpointTs() model.ResolvedTs {
 	return t.tableSink.checkpointTs
 }
 
+func (t *tableSinkWrapper) getLastAdvanced() time.Time {
+	t.tableSink.innerMu.Lock()
+	defer t.tableSink.innerMu.Unlock()
+	return t.tableSink.advanced
+}
+
 func (t *tableSinkWrapper) getReceivedSorterResolvedTs() model.Ts {
 	return t.receivedSorterResolvedTs.Load()
 }
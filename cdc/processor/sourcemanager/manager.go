@@ -17,9 +17,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/entry"
 	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/kv/regionlock"
 	"github.com/pingcap/tiflow/cdc/kv/sharedconn"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/memquota"
@@ -27,6 +29,7 @@ import (
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/puller"
 	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/pdutil"
 	"github.com/pingcap/tiflow/pkg/txnutil"
 	"github.com/pingcap/tiflow/pkg/upstream"
 	"github.com/tikv/client-go/v2/tikv"
@@ -65,6 +68,7 @@ type SourceManager struct {
 
 	enableTableMonitor bool
 	puller             *puller.MultiplexingPuller
+	client             *kv.SharedClient
 }
 
 // New creates a new source manager.
@@ -156,6 +160,7 @@ func newSourceManager(
 	}
 	slots, hasher := mgr.engine.SlotsAndHasher()
 
+	mgr.client = client
 	mgr.puller = puller.NewMultiplexingPuller(
 		mgr.changefeedID,
 		client,
@@ -227,18 +232,34 @@ func (m *SourceManager) GetTablePullerStats(span tablepb.Span) puller.Stats {
 	return m.puller.Stats(span)
 }
 
+// GetTableRangeLockStats returns the statistics of the regions subscribed by the table.
+func (m *SourceManager) GetTableRangeLockStats(span tablepb.Span) (regionlock.RangeLockStatistics, bool) {
+	if m.puller == nil {
+		return regionlock.RangeLockStatistics{}, false
+	}
+	return m.puller.RangeLockStats(span)
+}
+
 // GetTableSorterStats returns the sorter stats of the table.
 func (m *SourceManager) GetTableSorterStats(span tablepb.Span) sorter.TableStats {
 	return m.engine.GetStatsByTable(span)
 }
 
 // Run implements util.Runnable.
-func (m *SourceManager) Run(ctx context.Context, _ ...chan<- error) error {
+func (m *SourceManager) Run(ctx context.Context, warnings ...chan<- error) error {
 	close(m.ready)
 	// Only nil in unit tests.
 	if m.puller == nil {
 		return nil
 	}
+	if config.GetGlobalServerConfig().Debug.Puller.EnableRegionStallRemediation && len(warnings) > 0 {
+		pdAPIClient, err := pdutil.NewPDAPIClient(m.up.PDClient, m.up.SecurityConfig)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer pdAPIClient.Close()
+		m.client.EnableRegionStallRemediation(pdAPIClient, warnings[0])
+	}
 	return m.puller.Run(ctx)
 }
 
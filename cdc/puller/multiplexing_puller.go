@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/errors"
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/cdc/kv"
+	"github.com/pingcap/tiflow/cdc/kv/regionlock"
 	"github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/cdc/processor/tablepb"
 	"github.com/pingcap/tiflow/cdc/puller/frontier"
@@ -525,3 +526,14 @@ func (p *MultiplexingPuller) Stats(span tablepb.Span) Stats {
 		CheckpointTsEgress:  progress.resolvedTs.Load(),
 	}
 }
+
+// RangeLockStats returns the statistics of the regions subscribed by the span.
+func (p *MultiplexingPuller) RangeLockStats(span tablepb.Span) (regionlock.RangeLockStatistics, bool) {
+	p.subscriptions.RLock()
+	progress := p.subscriptions.n.GetV(span)
+	p.subscriptions.RUnlock()
+	if progress.tableProgress == nil {
+		return regionlock.RangeLockStatistics{}, false
+	}
+	return p.client.RangeLockStats(progress.subID)
+}
@@ -26,4 +26,8 @@ type InfoProvider interface {
 
 	// GetTaskStatuses returns the task statuses.
 	GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus, error)
+
+	// GetSlowestSpans returns the diagnoses of at most limit table spans
+	// with the smallest checkpoint ts, the slowest one comes first.
+	GetSlowestSpans(limit int) []model.SpanDiagnosis
 }
@@ -48,3 +48,28 @@ func (c *coordinator) GetTaskStatuses() (map[model.CaptureID]*model.TaskStatus,
 	}
 	return tasks, nil
 }
+
+// GetSlowestSpans returns the diagnoses of the slowest table spans.
+func (c *coordinator) GetSlowestSpans(limit int) []model.SpanDiagnosis {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sets := c.replicationM.SlowestReplicationSets(limit)
+	spans := make([]model.SpanDiagnosis, 0, len(sets))
+	for _, set := range sets {
+		stages := set.Stats.StageCheckpoints
+		spans = append(spans, model.SpanDiagnosis{
+			TableID:          set.Span.TableID,
+			Span:             set.Span.String(),
+			CaptureID:        set.Primary,
+			State:            set.State.String(),
+			RegionCount:      set.Stats.RegionCount,
+			PullerResolvedTs: stages["puller-egress"].ResolvedTs,
+			SorterResolvedTs: stages["sorter-ingress"].ResolvedTs,
+			SinkResolvedTs:   set.Checkpoint.ResolvedTs,
+			CheckpointTs:     set.Checkpoint.CheckpointTs,
+			BarrierTs:        set.Stats.BarrierTs,
+		})
+	}
+	return spans
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/pingcap/tiflow/cdc/scheduler/internal"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/keyspan"
 	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/member"
+	"github.com/pingcap/tiflow/cdc/scheduler/internal/v3/replication"
 	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/spanz"
 	"github.com/stretchr/testify/require"
 )
 
@@ -61,6 +63,35 @@ func TestInfoProvider(t *testing.T) {
 		}},
 		"b": {Tables: map[model.TableID]*model.TableReplicaInfo{}},
 	}, tasks)
+
+	for _, tableID := range []model.TableID{1, 2} {
+		span := spanz.TableIDToComparableSpan(tableID)
+		coord.replicationM.ReplicationSets().ReplaceOrInsert(span, &replication.ReplicationSet{
+			Span:    span,
+			Primary: "a",
+			State:   replication.ReplicationSetStateReplicating,
+			Checkpoint: tablepb.Checkpoint{
+				CheckpointTs: model.Ts(10 - tableID),
+				ResolvedTs:   20,
+			},
+			Stats: tablepb.Stats{
+				RegionCount: 3,
+				StageCheckpoints: map[string]tablepb.Checkpoint{
+					"puller-egress":  {ResolvedTs: 30},
+					"sorter-ingress": {ResolvedTs: 25},
+				},
+			},
+		})
+	}
+	spans := ip.GetSlowestSpans(1)
+	require.Len(t, spans, 1)
+	require.Equal(t, model.TableID(2), spans[0].TableID)
+	require.Equal(t, "a", spans[0].CaptureID)
+	require.Equal(t, model.Ts(8), spans[0].CheckpointTs)
+	require.Equal(t, model.Ts(20), spans[0].SinkResolvedTs)
+	require.Equal(t, model.Ts(25), spans[0].SorterResolvedTs)
+	require.Equal(t, model.Ts(30), spans[0].PullerResolvedTs)
+	require.Equal(t, uint64(3), spans[0].RegionCount)
 }
 
 func TestInfoProviderIsInitialized(t *testing.T) {
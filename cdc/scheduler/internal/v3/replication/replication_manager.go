@@ -547,6 +547,29 @@ func (r *Manager) ReplicationSets() *spanz.BtreeMap[*ReplicationSet] {
 	return r.spans
 }
 
+// SlowestReplicationSets returns at most limit replication sets with the
+// smallest checkpoint ts, the slowest one comes first.
+// Caller must not modify the returned replication sets.
+func (r *Manager) SlowestReplicationSets(limit int) []*ReplicationSet {
+	if limit <= 0 {
+		return nil
+	}
+	h := NewReplicationSetHeap(limit + 1)
+	r.spans.Ascend(func(_ tablepb.Span, table *ReplicationSet) bool {
+		heap.Push(&h, table)
+		if h.Len() > limit {
+			heap.Pop(&h)
+		}
+		return true
+	})
+	// h is a max-heap, so the fastest one is popped first.
+	ret := make([]*ReplicationSet, h.Len())
+	for i := len(ret) - 1; i >= 0; i-- {
+		ret[i] = heap.Pop(&h).(*ReplicationSet)
+	}
+	return ret
+}
+
 // RunningTasks return running tasks.
 // Caller must not modify the returned map.
 func (r *Manager) RunningTasks() *spanz.BtreeMap[*ScheduleTask] {
@@ -1068,3 +1068,26 @@ func TestLogSlowTableInfo(t *testing.T) {
 	// make sure the slowTableHeap's capacity will not extend
 	require.Equal(t, cap(r.slowTableHeap), 8)
 }
+
+func TestSlowestReplicationSets(t *testing.T) {
+	t.Parallel()
+	r := NewReplicationManager(1, model.ChangeFeedID{})
+	require.Empty(t, r.SlowestReplicationSets(3))
+
+	for _, ts := range []model.Ts{5, 2, 4, 1, 3} {
+		span := spanz.TableIDToComparableSpan(int64(ts))
+		r.spans.ReplaceOrInsert(span, &ReplicationSet{
+			Span:       span,
+			Checkpoint: tablepb.Checkpoint{CheckpointTs: ts},
+			State:      ReplicationSetStateReplicating,
+		})
+	}
+	require.Empty(t, r.SlowestReplicationSets(0))
+
+	sets := r.SlowestReplicationSets(3)
+	require.Len(t, sets, 3)
+	for i, set := range sets {
+		require.Equal(t, model.Ts(i+1), set.Checkpoint.CheckpointTs)
+	}
+	require.Len(t, r.SlowestReplicationSets(10), 5)
+}
@@ -39,6 +39,14 @@ func init() {
 
 // CheckSyncConfig checks synchronization configuration.
 func CheckSyncConfig(ctx context.Context, cfgs []*config.SubTaskConfig, errCnt, warnCnt int64) (string, error) {
+	// there is no upstream database to check for the sub tasks of offline sources.
+	onlineCfgs := make([]*config.SubTaskConfig, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		if !cfg.OfflineBinlog {
+			onlineCfgs = append(onlineCfgs, cfg)
+		}
+	}
+	cfgs = onlineCfgs
 	if len(cfgs) == 0 {
 		return "", nil
 	}
@@ -111,13 +111,20 @@ var (
 	// config because the command line arguments may be expected to take effect only once when failover.
 	// kv: Encode(task-name, source-id) -> TaskCliArgs.
 	TaskCliArgsKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/task-cli-args/")
+	// AuthUserKeyAdapter is used to store the users which can access DM-master when auth is enabled.
+	// k/v: Encode(user-name) -> ha.User.
+	AuthUserKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/auth/user/")
+	// AuthAPITokenKeyAdapter is used to store the API tokens, only the hash of the token is stored.
+	// k/v: Encode(token-name) -> ha.APIToken.
+	AuthAPITokenKeyAdapter KeyAdapter = keyHexEncoderDecoder("/dm-master/auth/api-token/")
 )
 
 func keyAdapterKeysLen(s KeyAdapter) int {
 	switch s {
 	case WorkerRegisterKeyAdapter, UpstreamConfigKeyAdapter, UpstreamBoundWorkerKeyAdapter,
 		WorkerKeepAliveKeyAdapter, StageRelayKeyAdapter,
-		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, OpenAPITaskTemplateKeyAdapter:
+		UpstreamLastBoundWorkerKeyAdapter, UpstreamRelayWorkerKeyAdapter, OpenAPITaskTemplateKeyAdapter,
+		AuthUserKeyAdapter, AuthAPITokenKeyAdapter:
 		return 1
 	case UpstreamSubTaskKeyAdapter, StageSubTaskKeyAdapter, StageValidatorKeyAdapter,
 		ShardDDLPessimismInfoKeyAdapter, ShardDDLPessimismOperationKeyAdapter,
@@ -27,6 +27,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/google/uuid"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
@@ -63,6 +64,21 @@ type PurgeConfig struct {
 	Interval    int64 `yaml:"interval" toml:"interval" json:"interval"`             // check whether need to purge at this @Interval (seconds)
 	Expires     int64 `yaml:"expires" toml:"expires" json:"expires"`                // if file's modified time is older than @Expires (hours), then it can be purged
 	RemainSpace int64 `yaml:"remain-space" toml:"remain-space" json:"remain-space"` // if remain space in @RelayBaseDir less than @RemainSpace (GB), then it can be purged
+	// ArchiveURI is a local directory or an external storage URI such as s3://bucket/prefix.
+	// if set, closed relay log files are uploaded to it before being purged, and are
+	// restored from it when a binlog reader needs them again.
+	ArchiveURI string `yaml:"archive-uri,omitempty" toml:"archive-uri" json:"archive-uri"`
+}
+
+// OfflineBinlogConfig is the configuration of an offline source, whose binlog files
+// are imported as relay logs.
+type OfflineBinlogConfig struct {
+	// Dir is a local directory or an external storage URI such as s3://bucket/prefix,
+	// which contains binlog files like mysql-bin.000001.
+	Dir string `yaml:"dir" toml:"dir" json:"dir"`
+	// ServerUUID is the server_uuid of the server which generated the binlog files,
+	// it's used to name the relay log subdirectory.
+	ServerUUID string `yaml:"server-uuid" toml:"server-uuid" json:"server-uuid"`
 }
 
 // SourceConfig is the configuration for source.
@@ -87,6 +103,9 @@ type SourceConfig struct {
 
 	SourceID string            `yaml:"source-id" toml:"source-id" json:"source-id"`
 	From     dbconfig.DBConfig `yaml:"from" toml:"from" json:"from"`
+	// OfflineBinlog makes the source read binlog files from a directory or an external
+	// storage instead of a running upstream server, `from` is not used in this case.
+	OfflineBinlog *OfflineBinlogConfig `yaml:"offline-binlog" toml:"offline-binlog" json:"offline-binlog"`
 
 	// config items for purger
 	Purge PurgeConfig `yaml:"purge" toml:"purge" json:"purge"`
@@ -133,6 +152,10 @@ func newSourceConfig() *SourceConfig {
 func (c *SourceConfig) Clone() *SourceConfig {
 	clone := &SourceConfig{}
 	*clone = *c
+	if c.OfflineBinlog != nil {
+		offlineBinlog := *c.OfflineBinlog
+		clone.OfflineBinlog = &offlineBinlog
+	}
 	return clone
 }
 
@@ -258,6 +281,15 @@ func (c *SourceConfig) Verify() error {
 		return terror.ErrConfigCheckerMaxTooSmall.Generate(c.Checker.BackoffMax.Duration, c.Checker.BackoffMin.Duration)
 	}
 
+	if c.OfflineBinlog != nil {
+		if len(c.OfflineBinlog.Dir) == 0 {
+			return terror.ErrConfigOfflineBinlogNotSupport.Generate("without `dir`")
+		}
+		if !c.EnableRelay {
+			return terror.ErrConfigOfflineBinlogNotSupport.Generate("without `enable-relay`")
+		}
+	}
+
 	return nil
 }
 
@@ -322,6 +354,38 @@ func (c *SourceConfig) Adjust(ctx context.Context, db *conn.BaseDB) (err error)
 	return nil
 }
 
+// AdjustOfflineBinlog adjusts the config of an offline source, which has no upstream
+// database to adjust from.
+func (c *SourceConfig) AdjustOfflineBinlog() error {
+	c.From.Adjust()
+	c.Checker.Adjust()
+
+	if len(c.OfflineBinlog.Dir) == 0 {
+		return terror.ErrConfigOfflineBinlogNotSupport.Generate("without `dir`")
+	}
+	switch c.Flavor {
+	case "":
+		c.Flavor = mysql.MySQLFlavor
+	case mysql.MariaDBFlavor, mysql.MySQLFlavor:
+	default:
+		return terror.ErrNotSupportedFlavor.Generate(c.Flavor)
+	}
+	if c.ServerID == 0 {
+		rand.Seed(time.Now().UnixNano())
+		c.ServerID = defaultBaseServerID + uint32(rand.Intn(100000))
+	}
+	if len(c.OfflineBinlog.ServerUUID) == 0 {
+		// keep the same relay log subdirectory for the same source.
+		c.OfflineBinlog.ServerUUID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(c.SourceID)).String()
+	}
+	// the binlog files are read as relay logs.
+	c.EnableRelay = true
+	if len(c.RelayDir) == 0 {
+		c.RelayDir = defaultRelayDir
+	}
+	return nil
+}
+
 // AdjustCaseSensitive adjust CaseSensitive from DB.
 func (c *SourceConfig) AdjustCaseSensitive(ctx context.Context, db *conn.BaseDB) (err error) {
 	caseSensitive, err2 := conn.GetDBCaseSensitive(ctx, db)
@@ -421,6 +485,7 @@ type SourceConfigForDowngrade struct {
 	// any new config item, we mark it omitempty
 	CaseSensitive bool                  `yaml:"case-sensitive,omitempty"`
 	Filters       []*bf.BinlogEventRule `yaml:"filters,omitempty"`
+	OfflineBinlog *OfflineBinlogConfig  `yaml:"offline-binlog,omitempty"`
 }
 
 // NewSourceConfigForDowngrade creates a new base config for downgrade.
@@ -443,6 +508,7 @@ func NewSourceConfigForDowngrade(sourceCfg *SourceConfig) *SourceConfigForDowngr
 		Tracer:          sourceCfg.Tracer,
 		CaseSensitive:   sourceCfg.CaseSensitive,
 		Filters:         sourceCfg.Filters,
+		OfflineBinlog:   sourceCfg.OfflineBinlog,
 	}
 }
 
@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
 	"github.com/pingcap/tiflow/dm/pkg/encrypt"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	bf "github.com/pingcap/tiflow/pkg/binlog-filter"
 	"github.com/stretchr/testify/require"
@@ -421,3 +422,33 @@ func TestSourceYamlForDowngrade(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, originCfg.From.Password, decryptedPass)
 }
+
+func TestAdjustOfflineBinlog(t *testing.T) {
+	cfg := NewSourceConfig()
+	cfg.SourceID = "mysql-replica-01"
+	cfg.OfflineBinlog = &OfflineBinlogConfig{}
+	require.True(t, terror.ErrConfigOfflineBinlogNotSupport.Equal(cfg.AdjustOfflineBinlog()))
+
+	cfg.OfflineBinlog.Dir = "s3://bucket/prefix"
+	require.NoError(t, cfg.AdjustOfflineBinlog())
+	require.Equal(t, mysql.MySQLFlavor, cfg.Flavor)
+	require.NotZero(t, cfg.ServerID)
+	require.True(t, cfg.EnableRelay)
+	require.Equal(t, defaultRelayDir, cfg.RelayDir)
+	serverUUID := cfg.OfflineBinlog.ServerUUID
+	require.NotEmpty(t, serverUUID)
+	require.NoError(t, cfg.Verify())
+
+	// the server uuid is stable for the same source.
+	cfg2 := cfg.Clone()
+	cfg2.OfflineBinlog.ServerUUID = ""
+	require.NoError(t, cfg2.AdjustOfflineBinlog())
+	require.Equal(t, serverUUID, cfg2.OfflineBinlog.ServerUUID)
+	require.Equal(t, serverUUID, cfg.OfflineBinlog.ServerUUID)
+
+	cfg.EnableRelay = false
+	require.True(t, terror.ErrConfigOfflineBinlogNotSupport.Equal(cfg.Verify()))
+
+	cfg.Flavor = "oracle"
+	require.True(t, terror.ErrNotSupportedFlavor.Equal(cfg.AdjustOfflineBinlog()))
+}
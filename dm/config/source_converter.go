@@ -33,6 +33,7 @@ func SourceCfgToOpenAPISource(cfg *SourceConfig) openapi.Source {
 			Expires:     &cfg.Purge.Expires,
 			Interval:    &cfg.Purge.Interval,
 			RemainSpace: &cfg.Purge.RemainSpace,
+			ArchiveUri:  &cfg.Purge.ArchiveURI,
 		},
 		RelayConfig: &openapi.RelayConfig{
 			EnableRelay:     &cfg.EnableRelay,
@@ -91,6 +92,9 @@ func OpenAPISourceToSourceCfg(source openapi.Source) *SourceConfig {
 		if purge.RemainSpace != nil {
 			cfg.Purge.RemainSpace = *purge.RemainSpace
 		}
+		if purge.ArchiveUri != nil {
+			cfg.Purge.ArchiveURI = *purge.ArchiveUri
+		}
 	}
 	if relayConfig := source.RelayConfig; relayConfig != nil {
 		if relayConfig.EnableRelay != nil {
@@ -96,6 +96,8 @@ type SubTaskConfig struct {
 	// pt/gh-ost name rule, support regex
 	ShadowTableRules []string `yaml:"shadow-table-rules" toml:"shadow-table-rules" json:"shadow-table-rules"`
 	TrashTableRules  []string `yaml:"trash-table-rules" toml:"trash-table-rules" json:"trash-table-rules"`
+	// DDLApproval pauses the subtask before executing the matched DDLs until they are handled manually.
+	DDLApproval *DDLApprovalConfig `yaml:"ddl-approval" toml:"ddl-approval" json:"ddl-approval"`
 
 	// deprecated
 	OnlineDDLScheme string `toml:"online-ddl-scheme" json:"online-ddl-scheme"`
@@ -131,9 +133,14 @@ type SubTaskConfig struct {
 	RelayDir string `toml:"relay-dir" json:"relay-dir"`
 
 	// UseRelay get value from dm-worker's relayEnabled
-	UseRelay bool              `toml:"use-relay" json:"use-relay"`
-	From     dbconfig.DBConfig `toml:"from" json:"from"`
-	To       dbconfig.DBConfig `toml:"to" json:"to"`
+	UseRelay bool `toml:"use-relay" json:"use-relay"`
+	// OfflineBinlog get value from source config, it's true when the source reads
+	// binlog files instead of a running upstream database, and `From` is not used.
+	OfflineBinlog bool              `toml:"offline-binlog" json:"offline-binlog"`
+	From          dbconfig.DBConfig `toml:"from" json:"from"`
+	To            dbconfig.DBConfig `toml:"to" json:"to"`
+	// TargetSink is set when binlog events are published to a TiCDC sink instead of `To`.
+	TargetSink *TargetSinkConfig `toml:"target-sink" json:"target-sink"`
 
 	RouteRules  []*router.TableRule   `toml:"route-rules" json:"route-rules"`
 	FilterRules []*bf.BinlogEventRule `toml:"filter-rules" json:"filter-rules"`
@@ -414,6 +421,9 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	} else if c.SyncerConfig.SafeMode && duration == 0 {
 		return terror.ErrConfigConfictSafeModeDurationAndSafeMode.Generate()
 	}
+	if err := c.SyncerConfig.adjustApplyDelay(); err != nil {
+		return err
+	}
 	if err := CheckForeignKeyChecksSyncerOptions(c.To.Session, c.SyncerConfig); err != nil {
 		return err
 	}
@@ -456,6 +466,16 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	if err := c.ValidatorCfg.Adjust(); err != nil {
 		return err
 	}
+	if c.TargetSink != nil {
+		if err := c.adjustTargetSink(); err != nil {
+			return err
+		}
+	}
+	if c.DDLApproval != nil {
+		if err := c.DDLApproval.adjust(); err != nil {
+			return err
+		}
+	}
 
 	// TODO: check every member
 	// TODO: since we checked here, we could remove other terror like ErrSyncerUnitGenBAList
@@ -464,6 +484,42 @@ func (c *SubTaskConfig) Adjust(verifyDecryptPassword bool) error {
 	return nil
 }
 
+// CheckOfflineBinlog checks whether the subtask can replicate from an offline source.
+// The features which need to query the upstream database are not supported.
+func (c *SubTaskConfig) CheckOfflineBinlog() error {
+	switch {
+	case c.Mode != ModeIncrement:
+		return terror.ErrConfigOfflineBinlogNotSupport.Generate(fmt.Sprintf("when `task-mode` is `%s`", c.Mode))
+	case c.ShardMode != "":
+		return terror.ErrConfigOfflineBinlogNotSupport.Generate("with `shard-mode`")
+	case c.ValidatorCfg.Mode != ValidationNone:
+		return terror.ErrConfigOfflineBinlogNotSupport.Generate("with `continuous-validator`")
+	case c.CollationCompatible == StrictCollationCompatible:
+		return terror.ErrConfigOfflineBinlogNotSupport.Generate("when `collation_compatible` is `strict`")
+	case c.TargetSink != nil:
+		return terror.ErrConfigOfflineBinlogNotSupport.Generate("with `target-sink`")
+	}
+	return nil
+}
+
+// adjustTargetSink checks the features that can't work with a TiCDC sink, because
+// the data and the DDLs are not written to `To` anymore.
+func (c *SubTaskConfig) adjustTargetSink() error {
+	if err := c.TargetSink.adjust(); err != nil {
+		return err
+	}
+	if c.Mode != ModeIncrement {
+		return terror.ErrConfigTargetSinkNotSupport.Generate(fmt.Sprintf("when `task-mode` is `%s`", c.Mode))
+	}
+	if c.ShardMode != "" {
+		return terror.ErrConfigTargetSinkNotSupport.Generate("with `shard-mode`")
+	}
+	if c.ValidatorCfg.Mode != ValidationNone {
+		return terror.ErrConfigTargetSinkNotSupport.Generate("with `continuous-validator`")
+	}
+	return nil
+}
+
 // Parse parses flag definitions from the argument list.
 func (c *SubTaskConfig) Parse(arguments []string, verifyDecryptPassword bool) error {
 	// Parse first to get config file.
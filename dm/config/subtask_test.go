@@ -552,3 +552,31 @@ func TestLoaderConfigImportModeImportInto(t *testing.T) {
 	err = cfg.Adjust(false)
 	require.ErrorContains(t, err, "invalid load mode")
 }
+
+func TestSubTaskAdjustTargetSink(t *testing.T) {
+	newCfg := func() *SubTaskConfig {
+		return &SubTaskConfig{
+			Name:       "test-task",
+			SourceID:   "mysql-instance-01",
+			Mode:       ModeIncrement,
+			TargetSink: &TargetSinkConfig{SinkURI: "kafka://127.0.0.1:9092/topic?protocol=canal-json"},
+		}
+	}
+	require.NoError(t, newCfg().Adjust(false))
+
+	cfg := newCfg()
+	cfg.TargetSink.SinkURI = ""
+	require.True(t, terror.ErrConfigTargetSinkNotSupport.Equal(cfg.Adjust(false)))
+
+	cfg = newCfg()
+	cfg.Mode = ModeAll
+	require.True(t, terror.ErrConfigTargetSinkNotSupport.Equal(cfg.Adjust(false)))
+
+	cfg = newCfg()
+	cfg.ShardMode = ShardOptimistic
+	require.True(t, terror.ErrConfigTargetSinkNotSupport.Equal(cfg.Adjust(false)))
+
+	cfg = newCfg()
+	cfg.ValidatorCfg.Mode = ValidationFast
+	require.True(t, terror.ErrConfigTargetSinkNotSupport.Equal(cfg.Adjust(false)))
+}
@@ -18,7 +18,9 @@ import (
 	"flag"
 	"fmt"
 	"math"
+	"net/url"
 	"os"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -76,6 +78,7 @@ const (
 	DefaultValidatorMetaFlushInterval = 5 * time.Minute
 	DefaultValidatorBatchQuerySize    = 100
 	DefaultValidatorMaxPendingRowSize = "500m"
+	DefaultValidatorRepairRateLimit   = 100
 
 	ValidatorMaxAccumulatedRow = 100000
 	// PendingRow is substantial in this version (in sysbench test)
@@ -106,6 +109,7 @@ var (
 	defaultQueueSize               = 1024 // do not give too large default value to avoid OOM
 	defaultCheckpointFlushInterval = 30   // in seconds
 	defaultSafeModeDuration        = strconv.Itoa(2*defaultCheckpointFlushInterval) + "s"
+	defaultApplyDelay              = "0s"
 
 	// TargetDBConfig.
 	defaultSessionCfg = []struct {
@@ -417,6 +421,10 @@ type SyncerConfig struct {
 	DisableCausality bool   `yaml:"disable-detect" toml:"disable-detect" json:"disable-detect"`
 	SafeMode         bool   `yaml:"safe-mode" toml:"safe-mode" json:"safe-mode"`
 	SafeModeDuration string `yaml:"safe-mode-duration" toml:"safe-mode-duration" json:"safe-mode-duration"`
+	// binlog events are applied to the downstream no earlier than apply-delay after they are executed in
+	// the upstream, like `SOURCE_DELAY` of MySQL. 0 means no delay. it's recommended to enable relay log
+	// for a long delay, otherwise the upstream may close the idle binlog dump connection.
+	ApplyDelay string `yaml:"apply-delay" toml:"apply-delay" json:"apply-delay"`
 	// deprecated, use `ansi-quotes` in top level config instead
 	EnableANSIQuotes bool `yaml:"enable-ansi-quotes" toml:"enable-ansi-quotes" json:"enable-ansi-quotes"`
 }
@@ -454,6 +462,7 @@ func DefaultSyncerConfig() SyncerConfig {
 		QueueSize:               defaultQueueSize,
 		CheckpointFlushInterval: defaultCheckpointFlushInterval,
 		SafeModeDuration:        defaultSafeModeDuration,
+		ApplyDelay:              defaultApplyDelay,
 	}
 }
 
@@ -470,6 +479,68 @@ func (m *SyncerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+func (m *SyncerConfig) adjustApplyDelay() error {
+	if m.ApplyDelay == "" {
+		m.ApplyDelay = defaultApplyDelay
+	}
+	if duration, err := time.ParseDuration(m.ApplyDelay); err != nil {
+		return terror.ErrConfigInvalidApplyDelay.Generate(m.ApplyDelay, err)
+	} else if duration < 0 {
+		return terror.ErrConfigInvalidApplyDelay.Generate(m.ApplyDelay, "should not be negative")
+	}
+	return nil
+}
+
+// ApplyDelayDuration returns the parsed apply-delay, the invalid value has been rejected when adjusting.
+func (m *SyncerConfig) ApplyDelayDuration() time.Duration {
+	duration, _ := time.ParseDuration(m.ApplyDelay)
+	if duration < 0 {
+		return 0
+	}
+	return duration
+}
+
+// TargetSinkConfig is the config of the TiCDC sink that binlog events are published to.
+type TargetSinkConfig struct {
+	// SinkURI is the same as the sink-uri of a TiCDC changefeed, such as
+	// kafka://127.0.0.1:9092/topic?protocol=canal-json&enable-tidb-extension=true.
+	// The binlog positions are encoded by canal-json and avro only when the TiDB
+	// extension is enabled, avro also requires avro-enable-binlog-position=true.
+	SinkURI string `yaml:"sink-uri" toml:"sink-uri" json:"sink-uri"`
+}
+
+func (t *TargetSinkConfig) adjust() error {
+	if t.SinkURI == "" {
+		return terror.ErrConfigTargetSinkNotSupport.Generate("with an empty `sink-uri`")
+	}
+	if _, err := url.Parse(t.SinkURI); err != nil {
+		return terror.ErrConfigTargetSinkNotSupport.Generate(fmt.Sprintf("with an invalid `sink-uri`: %v", err))
+	}
+	return nil
+}
+
+// DDLApprovalConfig is the config of DDL approval. the subtask is paused before executing the DDLs
+// matching the patterns, until they are approved, skipped or replaced by `binlog` commands.
+// in shard mode, the shard DDL lock of the DDLs is held by DM-master instead, until the DDLs are
+// approved or skipped by `binlog approve/skip` or the OpenAPI.
+type DDLApprovalConfig struct {
+	// SQLPatterns are case-insensitive regular expressions matched against the DDLs split from the
+	// upstream DDL, such as "^DROP TABLE", "^TRUNCATE TABLE" and "^ALTER TABLE .* DROP COLUMN".
+	SQLPatterns []string `yaml:"sql-patterns" toml:"sql-patterns" json:"sql-patterns"`
+}
+
+func (d *DDLApprovalConfig) adjust() error {
+	if len(d.SQLPatterns) == 0 {
+		return terror.ErrConfigInvalidDDLApproval.Generate("`sql-patterns` should not be empty")
+	}
+	for _, pattern := range d.SQLPatterns {
+		if _, err := regexp.Compile("(?i)" + pattern); err != nil {
+			return terror.ErrConfigInvalidDDLApproval.Generate(fmt.Sprintf("pattern %s is invalid: %v", pattern, err))
+		}
+	}
+	return nil
+}
+
 type ValidatorConfig struct {
 	Mode               string   `yaml:"mode" toml:"mode" json:"mode"`
 	WorkerCount        int      `yaml:"worker-count" toml:"worker-count" json:"worker-count"`
@@ -480,6 +551,8 @@ type ValidatorConfig struct {
 	BatchQuerySize     int      `yaml:"batch-query-size" toml:"batch-query-size" json:"batch-query-size"`
 	MaxPendingRowSize  string   `yaml:"max-pending-row-size" toml:"max-pending-row-size" json:"max-pending-row-size"`
 	MaxPendingRowCount int      `yaml:"max-pending-row-count" toml:"max-pending-row-count" json:"max-pending-row-count"`
+	AutoRepair         bool     `yaml:"auto-repair" toml:"auto-repair" json:"auto-repair"`                   // repair error rows automatically
+	RepairRateLimit    int      `yaml:"repair-rate-limit" toml:"repair-rate-limit" json:"repair-rate-limit"` // max error rows repaired per second
 	StartTime          string   `yaml:"-" toml:"start-time" json:"-"`
 }
 
@@ -519,6 +592,9 @@ func (v *ValidatorConfig) Adjust() error {
 	if v.MaxPendingRowCount == 0 {
 		v.MaxPendingRowCount = DefaultValidatorMaxPendingRow
 	}
+	if v.RepairRateLimit <= 0 {
+		v.RepairRateLimit = DefaultValidatorRepairRateLimit
+	}
 	return nil
 }
 
@@ -559,6 +635,9 @@ type TaskConfig struct {
 	CollationCompatible string `yaml:"collation_compatible" toml:"collation_compatible" json:"collation_compatible"`
 
 	TargetDB *dbconfig.DBConfig `yaml:"target-database" toml:"target-database" json:"target-database"`
+	// TargetSink publishes binlog events to a TiCDC sink instead of executing them in
+	// target-database, target-database is still used to store the checkpoint.
+	TargetSink *TargetSinkConfig `yaml:"target-sink,omitempty" toml:"target-sink" json:"target-sink"`
 
 	MySQLInstances []*MySQLInstance `yaml:"mysql-instances" toml:"mysql-instances" json:"mysql-instances"`
 
@@ -566,6 +645,8 @@ type TaskConfig struct {
 	// pt/gh-ost name rule,support regex
 	ShadowTableRules []string `yaml:"shadow-table-rules" toml:"shadow-table-rules" json:"shadow-table-rules"`
 	TrashTableRules  []string `yaml:"trash-table-rules" toml:"trash-table-rules" json:"trash-table-rules"`
+	// DDLApproval pauses the subtask before executing the matched DDLs until they are handled manually.
+	DDLApproval *DDLApprovalConfig `yaml:"ddl-approval,omitempty" toml:"ddl-approval" json:"ddl-approval"`
 
 	// deprecated
 	OnlineDDLScheme string `yaml:"online-ddl-scheme" toml:"online-ddl-scheme" json:"online-ddl-scheme"`
@@ -933,6 +1014,9 @@ func (c *TaskConfig) adjust() error {
 		} else if inst.Syncer.SafeMode && duration == 0 {
 			return terror.ErrConfigConfictSafeModeDurationAndSafeMode.Generate()
 		}
+		if err := inst.Syncer.adjustApplyDelay(); err != nil {
+			return err
+		}
 		if inst.SyncerThread != 0 {
 			inst.Syncer.WorkerCount = inst.SyncerThread
 		}
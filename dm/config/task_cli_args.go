@@ -17,6 +17,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 )
@@ -27,6 +29,12 @@ type TaskCliArgs struct {
 	StartTime        string `toml:"start-time" json:"start_time"`
 	SafeModeDuration string `toml:"safe-mode-duration" json:"safe_mode_duration"`
 	WaitTimeOnStop   string `toml:"wait-time-on-stop" json:"wait_time_on_stop"`
+
+	// the boundary where the incremental replication stops automatically and the subtask becomes Finished,
+	// at most one of them can be set.
+	StopTime      string `toml:"stop-time" json:"stop_time,omitempty"`
+	StopBinlogPos string `toml:"stop-binlog-pos" json:"stop_binlog_pos,omitempty"`
+	StopGTID      string `toml:"stop-gtid" json:"stop_gtid,omitempty"`
 }
 
 // ToJSON returns json marshal result.
@@ -65,5 +73,34 @@ func (t *TaskCliArgs) Verify() error {
 			return terror.Annotate(err, "error while parse stop_wait_timeout_duration, expected in the format like '1s' or '1h'")
 		}
 	}
+
+	boundaryCount := 0
+	if t.StopTime != "" {
+		boundaryCount++
+		if _, err := utils.ParseStartTime(t.StopTime); err != nil {
+			return terror.Annotatef(err, "error while parse stop-time, expected in the format like %s", utils.StartTimeFormatHint)
+		}
+	}
+	if t.StopBinlogPos != "" {
+		boundaryCount++
+		if _, err := binlog.PositionFromStr(t.StopBinlogPos); err != nil {
+			return terror.Annotate(err, "error while parse stop-binlog-pos, expected in the format like 'mysql-bin.000001:2345'")
+		}
+	}
+	if t.StopGTID != "" {
+		boundaryCount++
+		// the flavor is unknown here, so both MySQL and MariaDB GTID sets are accepted.
+		if _, err := gtid.ParserGTID("", t.StopGTID); err != nil {
+			return terror.Annotate(err, "error while parse stop-gtid")
+		}
+	}
+	if boundaryCount > 1 {
+		return terror.ErrConfigInvalidStopBoundary.Generate("at most one of stop-time, stop-binlog-pos and stop-gtid can be specified")
+	}
 	return nil
 }
+
+// HasStopBoundary returns whether the task stops automatically when reaching a boundary.
+func (t *TaskCliArgs) HasStopBoundary() bool {
+	return t.StopTime != "" || t.StopBinlogPos != "" || t.StopGTID != ""
+}
@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 
 	"github.com/pingcap/check"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 )
 
 type testStruct struct {
@@ -31,7 +32,7 @@ func (t *testStruct) ToJSON() string {
 
 func (t *testConfig) TestTaskCliArgsDowngrade(c *check.C) {
 	s := testStruct{
-		TaskCliArgs: TaskCliArgs{"123", "1s", "1s"},
+		TaskCliArgs: TaskCliArgs{StartTime: "123", SafeModeDuration: "1s", WaitTimeOnStop: "1s"},
 		FutureField: "456",
 	}
 	data := s.ToJSON()
@@ -71,4 +72,24 @@ func (t *testConfig) TestTaskCliArgsVerify(c *check.C) {
 	c.Assert(rightWaitTimeOnStop.Verify(), check.IsNil)
 	wrongWaitTimeOnStop := TaskCliArgs{WaitTimeOnStop: "1"}
 	c.Assert(wrongWaitTimeOnStop.Verify(), check.NotNil)
+
+	rightStop := TaskCliArgs{StopTime: "2006-01-02 15:04:05"}
+	c.Assert(rightStop.Verify(), check.IsNil)
+	c.Assert(rightStop.HasStopBoundary(), check.IsTrue)
+	rightStop = TaskCliArgs{StopBinlogPos: "mysql-bin.000001:2345"}
+	c.Assert(rightStop.Verify(), check.IsNil)
+	rightStop = TaskCliArgs{StopGTID: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14"}
+	c.Assert(rightStop.Verify(), check.IsNil)
+	rightStop = TaskCliArgs{StopGTID: "0-1-10"}
+	c.Assert(rightStop.Verify(), check.IsNil)
+	c.Assert(empty.HasStopBoundary(), check.IsFalse)
+
+	wrongStop := TaskCliArgs{StopTime: "15:04:05"}
+	c.Assert(wrongStop.Verify(), check.NotNil)
+	wrongStop = TaskCliArgs{StopBinlogPos: "mysql-bin.000001"}
+	c.Assert(wrongStop.Verify(), check.NotNil)
+	wrongStop = TaskCliArgs{StopGTID: "not-a-gtid"}
+	c.Assert(wrongStop.Verify(), check.NotNil)
+	wrongStop = TaskCliArgs{StopTime: "2006-01-02 15:04:05", StopBinlogPos: "mysql-bin.000001:2345"}
+	c.Assert(terror.ErrConfigInvalidStopBoundary.Equal(wrongStop.Verify()), check.IsTrue)
 }
@@ -46,6 +46,7 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 		cfg.OnlineDDL = c.OnlineDDL
 		cfg.TrashTableRules = c.TrashTableRules
 		cfg.ShadowTableRules = c.ShadowTableRules
+		cfg.DDLApproval = c.DDLApproval
 		cfg.IgnoreCheckingItems = c.IgnoreCheckingItems
 		cfg.Name = c.Name
 		cfg.Mode = c.TaskMode
@@ -69,6 +70,7 @@ func TaskConfigToSubTaskConfigs(c *TaskConfig, sources map[string]dbconfig.DBCon
 			return nil, terror.ErrConfigNeedTargetDB
 		}
 		cfg.To = *toClone
+		cfg.TargetSink = c.TargetSink
 
 		cfg.SourceID = inst.SourceID
 
@@ -385,6 +387,8 @@ func SubTaskConfigsToTaskConfig(stCfgs ...*SubTaskConfig) *TaskConfig {
 	c.Timezone = stCfg0.Timezone
 	c.CaseSensitive = stCfg0.CaseSensitive
 	c.TargetDB = &stCfg0.To // just ref
+	c.TargetSink = stCfg0.TargetSink
+	c.DDLApproval = stCfg0.DDLApproval
 	c.OnlineDDL = stCfg0.OnlineDDL
 	c.OnlineDDLScheme = stCfg0.OnlineDDLScheme
 	c.CleanDumpFile = stCfg0.CleanDumpFile
@@ -783,7 +787,8 @@ func genFilterRuleName(sourceName string, idx int) string {
 }
 
 func OpenAPIStartTaskReqToTaskCliArgs(req openapi.StartTaskRequest) (*TaskCliArgs, error) {
-	if req.StartTime == nil && req.SafeModeTimeDuration == nil {
+	if req.StartTime == nil && req.SafeModeTimeDuration == nil &&
+		req.StopTime == nil && req.StopBinlogPos == nil && req.StopGtid == nil {
 		return nil, nil
 	}
 	cliArgs := &TaskCliArgs{}
@@ -793,6 +798,15 @@ func OpenAPIStartTaskReqToTaskCliArgs(req openapi.StartTaskRequest) (*TaskCliArg
 	if req.SafeModeTimeDuration != nil {
 		cliArgs.SafeModeDuration = *req.SafeModeTimeDuration
 	}
+	if req.StopTime != nil {
+		cliArgs.StopTime = *req.StopTime
+	}
+	if req.StopBinlogPos != nil {
+		cliArgs.StopBinlogPos = *req.StopBinlogPos
+	}
+	if req.StopGtid != nil {
+		cliArgs.StopGTID = *req.StopGtid
+	}
 
 	if err := cliArgs.Verify(); err != nil {
 		return nil, err
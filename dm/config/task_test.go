@@ -21,6 +21,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/pingcap/tidb/pkg/util/filter"
@@ -1214,6 +1215,38 @@ func TestLoadConfigAdjust(t *testing.T) {
 	require.True(t, terror.ErrConfigInvalidPhysicalDuplicateResolution.Equal(err))
 }
 
+func TestSyncerConfigApplyDelay(t *testing.T) {
+	t.Parallel()
+
+	cfg := &SyncerConfig{}
+	require.NoError(t, cfg.adjustApplyDelay())
+	require.Equal(t, defaultApplyDelay, cfg.ApplyDelay)
+	require.Equal(t, time.Duration(0), cfg.ApplyDelayDuration())
+
+	cfg.ApplyDelay = "1h30m"
+	require.NoError(t, cfg.adjustApplyDelay())
+	require.Equal(t, 90*time.Minute, cfg.ApplyDelayDuration())
+
+	// test wrong value
+	cfg.ApplyDelay = "1"
+	require.True(t, terror.ErrConfigInvalidApplyDelay.Equal(cfg.adjustApplyDelay()))
+	cfg.ApplyDelay = "-1m"
+	require.True(t, terror.ErrConfigInvalidApplyDelay.Equal(cfg.adjustApplyDelay()))
+}
+
+func TestDDLApprovalConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := &DDLApprovalConfig{}
+	require.True(t, terror.ErrConfigInvalidDDLApproval.Equal(cfg.adjust()))
+
+	cfg.SQLPatterns = []string{"^DROP TABLE", "^ALTER TABLE .* DROP COLUMN"}
+	require.NoError(t, cfg.adjust())
+
+	cfg.SQLPatterns = append(cfg.SQLPatterns, "(")
+	require.True(t, terror.ErrConfigInvalidDDLApproval.Equal(cfg.adjust()))
+}
+
 func TestTaskYamlForDowngrade(t *testing.T) {
 	originCfg := TaskConfig{
 		Name:     "test",
@@ -14,6 +14,7 @@
 package common
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -49,7 +50,7 @@ const (
 	DefaultWarnCnt = 10
 )
 
-var argsNeedAdjust = [...]string{"-version", "-config", "-master-addr", "-rpc-timeout", "-ssl-ca", "-ssl-cert", "-ssl-key"}
+var argsNeedAdjust = [...]string{"-version", "-config", "-master-addr", "-rpc-timeout", "-ssl-ca", "-ssl-cert", "-ssl-key", "-user", "-password", "-token"}
 
 // NewConfig creates a new base config for dmctl.
 func NewConfig(fs *pflag.FlagSet) *Config {
@@ -67,6 +68,9 @@ func DefineConfigFlagSet(fs *pflag.FlagSet) {
 	fs.String("ssl-ca", "", "Path of file that contains list of trusted SSL CAs for connection.")
 	fs.String("ssl-cert", "", "Path of file that contains X509 certificate in PEM format for connection.")
 	fs.String("ssl-key", "", "Path of file that contains X509 key in PEM format for connection.")
+	fs.String("user", "", "User to access the dm-master when auth is enabled.")
+	fs.String("password", "", "Password of the user, you can also use environment variable 'DM_MASTER_PASSWORD' to specify the value.")
+	fs.String("token", "", "API token to access the dm-master when auth is enabled, you can also use environment variable 'DM_MASTER_TOKEN' to specify the value.")
 }
 
 // AdjustArgumentsForPflags adjust flag format args to pflags format.
@@ -107,6 +111,18 @@ func (c *Config) getConfigFromFlagSet() error {
 		return err
 	}
 	c.SSLKey, err = fs.GetString("ssl-key")
+	if err != nil {
+		return err
+	}
+	c.User, err = fs.GetString("user")
+	if err != nil {
+		return err
+	}
+	c.Password, err = fs.GetString("password")
+	if err != nil {
+		return err
+	}
+	c.Token, err = fs.GetString("token")
 	return err
 }
 
@@ -122,6 +138,10 @@ type Config struct {
 	ConfigFile string `json:"config-file"`
 
 	security.Security
+
+	User     string `toml:"user" json:"user"`
+	Password string `toml:"password" json:"-"`
+	Token    string `toml:"token" json:"-"`
 }
 
 func (c *Config) String() string {
@@ -161,6 +181,12 @@ func (c *Config) Adjust() error {
 	if c.MasterAddr == "" {
 		return errors.Errorf("--master-addr not provided, this parameter is required when interacting with the dm-master, you can also use environment variable 'DM_MASTER_ADDR' to specify the value. Use `dmctl --help` to see more help messages")
 	}
+	if c.Password == "" {
+		c.Password = os.Getenv("DM_MASTER_PASSWORD")
+	}
+	if c.Token == "" {
+		c.Token = os.Getenv("DM_MASTER_TOKEN")
+	}
 
 	return errors.Trace(c.adjust())
 }
@@ -176,6 +202,18 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// Authorization returns the value of authorization metadata sent to dm-master,
+// the API token is preferred if both the token and the user are specified.
+func (c *Config) Authorization() string {
+	if c.Token != "" {
+		return "Bearer " + c.Token
+	}
+	if c.User != "" {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(c.User+":"+c.Password))
+	}
+	return ""
+}
+
 // configFromFile loads config from file.
 func (c *Config) configFromFile(path string) error {
 	_, err := toml.DecodeFile(path, c)
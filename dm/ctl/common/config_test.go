@@ -51,3 +51,17 @@ func (t *testConfigSuite) TestInteractiveQuotes(c *check.C) {
 		c.Assert(got, check.DeepEquals, ca.expected)
 	}
 }
+
+func (t *testConfigSuite) TestAuthorization(c *check.C) {
+	cfg := &Config{}
+	c.Assert(cfg.Authorization(), check.Equals, "")
+
+	cfg.User = "admin"
+	cfg.Password = "123456"
+	c.Assert(cfg.Authorization(), check.Equals, "Basic YWRtaW46MTIzNDU2")
+	c.Assert(cfg.String(), check.Not(check.Matches), ".*123456.*")
+
+	// token is preferred
+	cfg.Token = "abc"
+	c.Assert(cfg.Authorization(), check.Equals, "Bearer abc")
+}
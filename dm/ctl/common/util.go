@@ -45,6 +45,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -63,6 +64,8 @@ type CtlClient struct {
 	conn         *grpc.ClientConn
 	MasterClient pb.MasterClient  // exposed to be used in test
 	EtcdClient   *clientv3.Client // exposed to be used in export config
+	// authorization is sent as gRPC metadata to authenticate with dm-master.
+	authorization string
 }
 
 func (c *CtlClient) updateMasterClient() error {
@@ -105,6 +108,9 @@ func (c *CtlClient) sendRequest(
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
+	if c.authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", c.authorization)
+	}
 	params := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)}
 	for _, o := range opts {
 		params = append(params, reflect.ValueOf(o))
@@ -159,7 +165,11 @@ func SendRequest(ctx context.Context, reqName string, req interface{}, respPoint
 // InitUtils inits necessary dmctl utils.
 func InitUtils(cfg *Config) error {
 	globalConfig = cfg
-	return errors.Trace(InitClient(cfg.MasterAddr, cfg.Security))
+	if err := InitClient(cfg.MasterAddr, cfg.Security); err != nil {
+		return errors.Trace(err)
+	}
+	GlobalCtlClient.authorization = cfg.Authorization()
+	return nil
 }
 
 // InitClient initializes dm-master client.
@@ -28,6 +28,7 @@ func NewBinlogCmd() *cobra.Command {
 	cmd.PersistentFlags().StringP("binlog-pos", "b", "", "position used to match binlog event if matched the binlog operation will be applied. The format like \"mysql-bin|000001.000003:3270\"")
 	cmd.AddCommand(
 		newBinlogSkipCmd(),
+		newBinlogApproveCmd(),
 		newBinlogReplaceCmd(),
 		newBinlogRevertCmd(),
 		newBinlogInjectCmd(),
@@ -40,7 +41,7 @@ func NewBinlogCmd() *cobra.Command {
 func newBinlogSkipCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "skip <task-name>",
-		Short: "skip the current error event or a specific binlog position (binlog-pos) event",
+		Short: "skip the current error event, the DDLs waiting for approval in shard DDL locks or a specific binlog position (binlog-pos) event",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return cmd.Help()
@@ -57,6 +58,26 @@ func newBinlogSkipCmd() *cobra.Command {
 	return cmd
 }
 
+func newBinlogApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve <task-name>",
+		Short: "approve the DDL event waiting for approval (in a paused subtask or shard DDL locks) or a specific binlog position (binlog-pos) DDL event",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return cmd.Help()
+			}
+			taskName := common.GetTaskNameFromArgOrFile(cmd.Flags().Arg(0))
+			request := &pb.HandleErrorRequest{
+				Op:   pb.ErrorOp_Approve,
+				Task: taskName,
+				Sqls: nil,
+			}
+			return sendHandleErrorRequest(cmd, request)
+		},
+	}
+	return cmd
+}
+
 func newBinlogListCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list <task-name>",
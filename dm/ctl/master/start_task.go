@@ -32,12 +32,15 @@ import (
 // NewStartTaskCmd creates a StartTask command.
 func NewStartTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "start-task [-s source ...] [--remove-meta] <config-file>",
+		Use:   "start-task [-s source ...] [--remove-meta] [--start-time time] [--stop-time time | --stop-binlog-pos pos | --stop-gtid gtid-set] <config-file>",
 		Short: "Starts a task as defined in the configuration file",
 		RunE:  startTaskFunc,
 	}
 	cmd.Flags().BoolP("remove-meta", "", false, "whether to remove task's meta data")
 	cmd.Flags().String("start-time", "", "specify the start time of binlog replication, e.g. '2021-10-21 00:01:00', '2021-10-21T00:01:00', or '2021-10-21T00:01:00+08:00'")
+	cmd.Flags().String("stop-time", "", "specify the time when binlog replication stops automatically and the subtask becomes Finished, e.g. '2021-10-21 00:01:00' or '2021-10-21T00:01:00+08:00'")
+	cmd.Flags().String("stop-binlog-pos", "", "specify the binlog position where binlog replication stops automatically and the subtask becomes Finished, e.g. 'mysql-bin.000001:2345'")
+	cmd.Flags().String("stop-gtid", "", "specify the GTID set after which binlog replication stops automatically and the subtask becomes Finished")
 	return cmd
 }
 
@@ -111,6 +114,21 @@ func startTaskFunc(cmd *cobra.Command, _ []string) error {
 		common.PrintLinesf("error in parse `--start-time`")
 		return err
 	}
+	stopTime, err := cmd.Flags().GetString("stop-time")
+	if err != nil {
+		common.PrintLinesf("error in parse `--stop-time`")
+		return err
+	}
+	stopBinlogPos, err := cmd.Flags().GetString("stop-binlog-pos")
+	if err != nil {
+		common.PrintLinesf("error in parse `--stop-binlog-pos`")
+		return err
+	}
+	stopGTID, err := cmd.Flags().GetString("stop-gtid")
+	if err != nil {
+		common.PrintLinesf("error in parse `--stop-gtid`")
+		return err
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -121,10 +139,13 @@ func startTaskFunc(cmd *cobra.Command, _ []string) error {
 		ctx,
 		"StartTask",
 		&pb.StartTaskRequest{
-			Task:       string(content),
-			Sources:    sources,
-			RemoveMeta: removeMeta,
-			StartTime:  startTime,
+			Task:          string(content),
+			Sources:       sources,
+			RemoveMeta:    removeMeta,
+			StartTime:     startTime,
+			StopTime:      stopTime,
+			StopBinlogPos: stopBinlogPos,
+			StopGTID:      stopGTID,
 		},
 		&resp,
 	)
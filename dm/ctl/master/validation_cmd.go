@@ -29,6 +29,7 @@ func NewValidationCmd() *cobra.Command {
 		NewIgnoreValidationErrorCmd(),
 		NewResolveValidationErrorCmd(),
 		NewClearValidationErrorCmd(),
+		NewRepairValidationErrorCmd(),
 	)
 	return cmd
 }
@@ -54,6 +54,22 @@ type Dumpling struct {
 	closed     atomic.Bool
 	core       *export.Dumper
 	mu         sync.RWMutex
+
+	rowSpeedRecorder *export.SpeedRecorder
+	// progress is refreshed in background by refreshProgress, because collecting
+	// the dumped files is too heavy for every Status call.
+	progressMu sync.Mutex
+	progress   dumpProgress
+}
+
+// progressInterval is the interval to refresh the progress of dumping.
+var progressInterval = 10 * time.Second
+
+// dumpProgress is the progress of dumping which is refreshed in background.
+type dumpProgress struct {
+	rps        int64
+	etaSeconds int64
+	tables     []*pb.TableProgress
 }
 
 // NewDumpling creates a new Dumpling.
@@ -63,8 +79,9 @@ func NewDumpling(cfg *config.SubTaskConfig) *Dumpling {
 		logger = log.Logger{Logger: cfg.FrameworkLogger}
 	}
 	m := &Dumpling{
-		cfg:    cfg,
-		logger: logger.WithFields(zap.String("task", cfg.Name), zap.String("unit", "dump")),
+		cfg:              cfg,
+		logger:           logger.WithFields(zap.String("task", cfg.Name), zap.String("unit", "dump")),
+		rowSpeedRecorder: export.NewSpeedRecorder(),
 	}
 	return m
 }
@@ -79,15 +96,7 @@ func (m *Dumpling) Init(ctx context.Context) error {
 		// this branch means dataflow engine has set a Factory, the Factory itself
 		// will register and deregister metrics, so we must use NoopRegistry
 		// to avoid duplicated registration.
-		m.metricProxies = &metricProxies{}
-		m.metricProxies.dumplingExitWithErrorCounter = m.cfg.MetricsFactory.NewCounterVec(
-			prometheus.CounterOpts{
-				Namespace: "dm",
-				Subsystem: "dumpling",
-				Name:      "exit_with_error_count",
-				Help:      "counter for dumpling exit with error",
-			}, []string{"task", "source_id", "resumable_err"},
-		)
+		m.metricProxies = newMetricProxies(m.cfg.MetricsFactory)
 		m.dumpConfig.PromFactory = promutil.NewWrappingFactory(
 			m.cfg.MetricsFactory,
 			"",
@@ -177,10 +186,26 @@ func (m *Dumpling) Process(ctx context.Context, pr chan pb.ProcessResult) {
 		err      error
 	)
 	if dumpling, err = export.NewDumper(newCtx, m.dumpConfig); err == nil {
+		tableEstimates := m.fetchTableEstimates(newCtx)
+		m.progressMu.Lock()
+		m.progress = dumpProgress{etaSeconds: -1}
+		m.progressMu.Unlock()
 		m.mu.Lock()
 		m.core = dumpling
 		m.mu.Unlock()
+
+		progressCtx, progressCancel := context.WithCancel(newCtx)
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.runProgressLoop(progressCtx, dumpling, tableEstimates)
+		}()
 		err = dumpling.Dump()
+		progressCancel()
+		wg.Wait()
+		// refresh once more to report the final progress
+		m.refreshProgress(dumpling, tableEstimates)
 		failpoint.Inject("SleepBeforeDumplingClose", func(val failpoint.Value) {
 			t := val.(int)
 			time.Sleep(time.Second * time.Duration(t))
@@ -291,6 +316,14 @@ func (m *Dumpling) status() *pb.DumpStatus {
 		Progress:          dumpStatus.Progress,
 		Bps:               int64(dumpStatus.CurrentSpeedBPS),
 	}
+	m.progressMu.Lock()
+	s.Rps = m.progress.rps
+	s.EtaSeconds = m.progress.etaSeconds
+	s.Tables = m.progress.tables
+	m.progressMu.Unlock()
+	for _, t := range s.Tables {
+		s.EstimateTotalBytes += t.EstimateBytes
+	}
 	var estimateProgress string
 	if s.FinishedRows >= s.EstimateTotalRows {
 		estimateProgress = "100.00%"
@@ -305,10 +338,113 @@ func (m *Dumpling) status() *pb.DumpStatus {
 		zap.String("estimated_progress", estimateProgress),
 		zap.String("new progress", s.Progress),
 		zap.Int64("bps", s.Bps),
+		zap.Int64("rps", s.Rps),
+		zap.Int64("eta_seconds", s.EtaSeconds),
 	)
 	return s
 }
 
+// runProgressLoop refreshes the progress of dumping periodically until ctx is done.
+func (m *Dumpling) runProgressLoop(ctx context.Context, core *export.Dumper, tableEstimates []conn.TableEstimate) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.refreshProgress(core, tableEstimates)
+		}
+	}
+}
+
+// refreshProgress computes the speed, ETA and per-table progress of dumping,
+// and updates the metrics.
+func (m *Dumpling) refreshProgress(core *export.Dumper, tableEstimates []conn.TableEstimate) {
+	dumpStatus := core.GetStatus()
+	rps := int64(m.rowSpeedRecorder.GetSpeed(dumpStatus.FinishedRows))
+	progress := dumpProgress{
+		rps:        rps,
+		etaSeconds: utils.EstimateRemainingSeconds(dumpStatus.FinishedRows, dumpStatus.EstimateTotalRows, float64(rps)),
+		tables:     m.tableProgresses(tableEstimates),
+	}
+	m.metricProxies.rowsPerSecondGauge.WithLabelValues(m.cfg.Name, m.cfg.SourceID).Set(float64(progress.rps))
+	m.metricProxies.bytesPerSecondGauge.WithLabelValues(m.cfg.Name, m.cfg.SourceID).Set(float64(dumpStatus.CurrentSpeedBPS))
+	m.metricProxies.etaSecondsGauge.WithLabelValues(m.cfg.Name, m.cfg.SourceID).Set(float64(progress.etaSeconds))
+
+	m.progressMu.Lock()
+	m.progress = progress
+	m.progressMu.Unlock()
+}
+
+// fetchTableEstimates fetches the estimated size of tables to dump from upstream.
+// The estimation is only used to report progress, so errors are ignored.
+func (m *Dumpling) fetchTableEstimates(ctx context.Context) []conn.TableEstimate {
+	baseDB, err := conn.GetUpstreamDB(&m.cfg.From)
+	if err != nil {
+		m.logger.Warn("set up db connect failed", zap.Error(err))
+		return nil
+	}
+	defer baseDB.Close()
+
+	estimates, err := conn.FetchTableEstimates(ctx, baseDB)
+	if err != nil {
+		m.logger.Warn("fetch estimated size of tables from upstream failed", zap.Error(err))
+		return nil
+	}
+	ret := make([]conn.TableEstimate, 0, len(estimates))
+	for _, e := range estimates {
+		if m.dumpConfig.TableFilter.MatchTable(e.Schema, e.Table) {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+// tableProgresses returns the progress of each table to dump. The finished bytes
+// of a table is the total size of its data files which are named as `schema.table.*`.
+func (m *Dumpling) tableProgresses(tableEstimates []conn.TableEstimate) []*pb.TableProgress {
+	if len(tableEstimates) == 0 {
+		return nil
+	}
+	ret := make([]*pb.TableProgress, 0, len(tableEstimates))
+	tables := make(map[string]*pb.TableProgress, len(tableEstimates))
+	for _, e := range tableEstimates {
+		t := &pb.TableProgress{
+			Schema:        e.Schema,
+			Table:         e.Table,
+			EstimateRows:  e.Rows,
+			EstimateBytes: e.Bytes,
+		}
+		tables[e.Schema+"."+e.Table] = t
+		ret = append(ret, t)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), conn.DefaultDBTimeout)
+	defer cancel()
+	fileSizes, err := storage.CollectDirFileSizes(ctx, m.cfg.Dir, m.cfg.ExtStorage)
+	if err != nil {
+		m.logger.Warn("fail to collect dumped files", zap.String("directory", m.cfg.Dir), log.ShortError(err))
+		return ret
+	}
+	for name, size := range fileSizes {
+		// strip the suffixes like `.000000000.sql.gz` until it matches a table,
+		// schema files like `schema.table-schema.sql` will never match.
+		for key := name; ; {
+			idx := strings.LastIndexByte(key, '.')
+			if idx <= 0 {
+				break
+			}
+			key = key[:idx]
+			if t, ok := tables[key]; ok {
+				t.FinishedBytes += size
+				break
+			}
+		}
+	}
+	return ret
+}
+
 // Type implements Unit.Type.
 func (m *Dumpling) Type() pb.UnitType {
 	return pb.UnitType_Dump
@@ -15,6 +15,8 @@ package dumpling
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -284,3 +286,36 @@ func TestBAlist(t *testing.T) {
 	tableFilter = tfilter.CaseInsensitive(tableFilter)
 	require.Equal(t, tableFilter, m.dumpConfig.TableFilter)
 }
+
+func TestTableProgresses(t *testing.T) {
+	cfg := genDumpCfg(t)
+	m := NewDumpling(cfg)
+	require.Nil(t, m.tableProgresses(nil))
+
+	files := map[string]int{
+		"db-schema-create.sql":      10,
+		"db.tb1-schema.sql":         20,
+		"db.tb1.000000000.sql":      100,
+		"db.tb1.000000001.sql.gz":   50,
+		"db.tb1.2.000000000.sql":    70,
+		"db.tb2.000000000.csv":      30,
+		"other.tb1.000000000.sql":   40,
+		"metadata":                  5,
+		"db.tb10.0000000000000.sql": 60,
+	}
+	for name, size := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(cfg.Dir, name), make([]byte, size), 0o644))
+	}
+	tableEstimates := []conn.TableEstimate{
+		{Schema: "db", Table: "tb1", Rows: 10, Bytes: 16384},
+		{Schema: "db", Table: "tb1.2", Rows: 20, Bytes: 32768},
+		{Schema: "db", Table: "tb2"},
+		{Schema: "db", Table: "tb3"},
+	}
+	require.Equal(t, []*pb.TableProgress{
+		{Schema: "db", Table: "tb1", EstimateRows: 10, EstimateBytes: 16384, FinishedBytes: 150},
+		{Schema: "db", Table: "tb1.2", EstimateRows: 20, EstimateBytes: 32768, FinishedBytes: 70},
+		{Schema: "db", Table: "tb2", FinishedBytes: 30},
+		{Schema: "db", Table: "tb3"},
+	}, m.tableProgresses(tableEstimates))
+}
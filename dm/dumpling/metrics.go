@@ -20,26 +20,58 @@ import (
 
 type metricProxies struct {
 	dumplingExitWithErrorCounter *prometheus.CounterVec
+	rowsPerSecondGauge           *prometheus.GaugeVec
+	bytesPerSecondGauge          *prometheus.GaugeVec
+	etaSecondsGauge              *prometheus.GaugeVec
 }
 
-var f = &promutil.PromFactory{}
-
-var defaultMetricProxies = &metricProxies{
-	dumplingExitWithErrorCounter: f.NewCounterVec(
-		prometheus.CounterOpts{
-			Namespace: "dm",
-			Subsystem: "dumpling",
-			Name:      "exit_with_error_count",
-			Help:      "counter for dumpling exit with error",
-		}, []string{"task", "source_id", "resumable_err"}),
+func newMetricProxies(factory promutil.Factory) *metricProxies {
+	return &metricProxies{
+		dumplingExitWithErrorCounter: factory.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: "dm",
+				Subsystem: "dumpling",
+				Name:      "exit_with_error_count",
+				Help:      "counter for dumpling exit with error",
+			}, []string{"task", "source_id", "resumable_err"}),
+		rowsPerSecondGauge: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "dm",
+				Subsystem: "dumpling",
+				Name:      "rows_per_second",
+				Help:      "current speed of dumpling in rows per second",
+			}, []string{"task", "source_id"}),
+		bytesPerSecondGauge: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "dm",
+				Subsystem: "dumpling",
+				Name:      "bytes_per_second",
+				Help:      "current speed of dumpling in bytes per second",
+			}, []string{"task", "source_id"}),
+		etaSecondsGauge: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "dm",
+				Subsystem: "dumpling",
+				Name:      "eta_seconds",
+				Help:      "estimated remaining seconds of dumpling, -1 means unknown",
+			}, []string{"task", "source_id"}),
+	}
 }
 
+var defaultMetricProxies = newMetricProxies(&promutil.PromFactory{})
+
 // RegisterMetrics registers metrics and saves the given registry for later use.
 func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(defaultMetricProxies.dumplingExitWithErrorCounter)
+	registry.MustRegister(defaultMetricProxies.rowsPerSecondGauge)
+	registry.MustRegister(defaultMetricProxies.bytesPerSecondGauge)
+	registry.MustRegister(defaultMetricProxies.etaSecondsGauge)
 }
 
 func (m *Dumpling) removeLabelValuesWithTaskInMetrics(task, source string) {
 	labels := prometheus.Labels{"task": task, "source_id": source}
 	m.metricProxies.dumplingExitWithErrorCounter.DeletePartialMatch(labels)
+	m.metricProxies.rowsPerSecondGauge.DeletePartialMatch(labels)
+	m.metricProxies.bytesPerSecondGauge.DeletePartialMatch(labels)
+	m.metricProxies.etaSecondsGauge.DeletePartialMatch(labels)
 }
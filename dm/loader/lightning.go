@@ -83,6 +83,12 @@ type LightningLoader struct {
 
 	speedRecorder *export.SpeedRecorder
 	metricProxies *metricProxies
+
+	// the fields below are only used by refreshProgress, except progress.
+	rowSpeedRecorder *export.SpeedRecorder
+	rowIDBases       map[string]int64
+	progressMu       sync.Mutex
+	progress         loadProgress
 }
 
 // NewLightning creates a new Loader importing data with lightning.
@@ -100,6 +106,9 @@ func NewLightning(cfg *config.SubTaskConfig, cli *clientv3.Client, workerName st
 		core:                  lserver.New(lightningCfg),
 		logger:                logger.WithFields(zap.String("task", cfg.Name), zap.String("unit", "lightning-load")),
 		speedRecorder:         export.NewSpeedRecorder(),
+		rowSpeedRecorder:      export.NewSpeedRecorder(),
+		rowIDBases:            make(map[string]int64),
+		progress:              loadProgress{etaSeconds: -1},
 	}
 	return loader
 }
@@ -303,7 +312,19 @@ func (l *LightningLoader) runLightning(ctx context.Context, cfg *lcfg.Config) (e
 	if l.cfg.LoaderConfig.ImportMode == config.LoadModePhysical {
 		opts = append(opts, lserver.WithDupIndicator(&hasDup))
 	}
+
+	progressCtx, progressCancel := context.WithCancel(taskCtx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		l.runProgressLoop(progressCtx, cfg)
+	}()
 	err = l.core.RunOnceWithOptions(taskCtx, cfg, opts...)
+	progressCancel()
+	wg.Wait()
+	// refresh once more to report the final progress
+	l.refreshProgress(ctx, cfg)
 	failpoint.Inject("LoadDataSlowDown", nil)
 	failpoint.Inject("LoadDataSlowDownByTask", func(val failpoint.Value) {
 		tasks := val.(string)
@@ -707,13 +728,20 @@ func (l *LightningLoader) Update(ctx context.Context, cfg *config.SubTaskConfig)
 func (l *LightningLoader) status() *pb.LoadStatus {
 	finished, total := l.core.Status()
 	progress := percent(finished, total, l.finish.Load())
-	currentSpeed := int64(l.speedRecorder.GetSpeed(float64(finished)))
+	l.progressMu.Lock()
+	loadProgress := l.progress
+	l.progressMu.Unlock()
+	if l.finish.Load() {
+		loadProgress.etaSeconds = 0
+	}
 
 	l.logger.Info("progress status of lightning",
 		zap.Int64("finished_bytes", finished),
 		zap.Int64("total_bytes", total),
 		zap.String("progress", progress),
-		zap.Int64("current speed (bytes / seconds)", currentSpeed),
+		zap.Int64("current speed (bytes / seconds)", loadProgress.bps),
+		zap.Int64("current speed (rows / seconds)", loadProgress.rps),
+		zap.Int64("eta_seconds", loadProgress.etaSeconds),
 	)
 	s := &pb.LoadStatus{
 		FinishedBytes:  finished,
@@ -721,7 +749,10 @@ func (l *LightningLoader) status() *pb.LoadStatus {
 		Progress:       progress,
 		MetaBinlog:     l.metaBinlog.Load(),
 		MetaBinlogGTID: l.metaBinlogGTID.Load(),
-		Bps:            currentSpeed,
+		Bps:            loadProgress.bps,
+		EtaSeconds:     loadProgress.etaSeconds,
+		Rps:            loadProgress.rps,
+		Tables:         loadProgress.tables,
 	}
 	return s
 }
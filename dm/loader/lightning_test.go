@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/config/security"
+	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/terror"
 	certificate "github.com/pingcap/tiflow/pkg/security"
 	"github.com/prometheus/client_golang/prometheus"
@@ -293,3 +294,31 @@ func TestMetricProxies(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, metricFamilies, 0)
 }
+
+func TestTableProgressesOfChunks(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, tableProgressesOfChunks(nil))
+
+	chunks := []chunkProgress{
+		{table: "`db2`.`t`", path: "db2.t.0.sql", offset: 0, endOffset: 100, pos: 100, prevRowIDMax: 10},
+		{table: "`db1`.`t2`", path: "db1.t2.0.sql", offset: 0, endOffset: 50, pos: 20, prevRowIDMax: 3},
+		{table: "`db1`.`t1`", path: "db1.t1.0.sql", offset: 0, endOffset: 40, pos: 40, prevRowIDMax: 5},
+		{table: "`db1`.`t1`", path: "db1.t1.0.sql", offset: 40, endOffset: 100, pos: 50, prevRowIDMax: 1005},
+	}
+	tables := tableProgressesOfChunks(chunks)
+	require.Len(t, tables, 3)
+	require.Equal(t, &pb.TableProgress{Schema: "db1", Table: "t1", EstimateBytes: 100, FinishedBytes: 50}, tables[0])
+	require.Equal(t, &pb.TableProgress{Schema: "db1", Table: "t2", EstimateBytes: 50, FinishedBytes: 20}, tables[1])
+	require.Equal(t, &pb.TableProgress{Schema: "db2", Table: "t", EstimateBytes: 100, FinishedBytes: 100}, tables[2])
+
+	l := &LightningLoader{rowIDBases: make(map[string]int64)}
+	// the first seen row IDs are used as bases
+	require.Equal(t, int64(0), l.loadedRows(chunks))
+	chunks[1].prevRowIDMax = 13
+	chunks[3].prevRowIDMax = 1025
+	require.Equal(t, int64(30), l.loadedRows(chunks))
+	// a new chunk only counts the rows after it's seen
+	chunks = append(chunks, chunkProgress{table: "`db3`.`t`", path: "db3.t.0.sql", prevRowIDMax: 100})
+	require.Equal(t, int64(30), l.loadedRows(chunks))
+}
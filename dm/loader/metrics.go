@@ -20,6 +20,9 @@ import (
 
 type metricProxies struct {
 	loaderExitWithErrorCounter *prometheus.CounterVec
+	bytesPerSecondGauge        *prometheus.GaugeVec
+	rowsPerSecondGauge         *prometheus.GaugeVec
+	etaSecondsGauge            *prometheus.GaugeVec
 }
 
 func newMetricProxies(factory promutil.Factory) *metricProxies {
@@ -31,6 +34,27 @@ func newMetricProxies(factory promutil.Factory) *metricProxies {
 				Name:      "exit_with_error_count",
 				Help:      "counter for loader exits with error",
 			}, []string{"task", "source_id", "resumable_err"}),
+		bytesPerSecondGauge: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "dm",
+				Subsystem: "loader",
+				Name:      "bytes_per_second",
+				Help:      "current speed of loader in bytes per second",
+			}, []string{"task", "source_id"}),
+		rowsPerSecondGauge: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "dm",
+				Subsystem: "loader",
+				Name:      "rows_per_second",
+				Help:      "current speed of loader in rows per second",
+			}, []string{"task", "source_id"}),
+		etaSecondsGauge: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "dm",
+				Subsystem: "loader",
+				Name:      "eta_seconds",
+				Help:      "estimated remaining seconds of loader, -1 means unknown",
+			}, []string{"task", "source_id"}),
 	}
 }
 
@@ -39,9 +63,15 @@ var defaultMetricProxies = newMetricProxies(&promutil.PromFactory{})
 // RegisterMetrics registers metrics.
 func RegisterMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(defaultMetricProxies.loaderExitWithErrorCounter)
+	registry.MustRegister(defaultMetricProxies.bytesPerSecondGauge)
+	registry.MustRegister(defaultMetricProxies.rowsPerSecondGauge)
+	registry.MustRegister(defaultMetricProxies.etaSecondsGauge)
 }
 
 func (l *LightningLoader) removeLabelValuesWithTaskInMetrics(task, source string) {
 	labels := prometheus.Labels{"task": task, "source_id": source}
 	l.metricProxies.loaderExitWithErrorCounter.DeletePartialMatch(labels)
+	l.metricProxies.bytesPerSecondGauge.DeletePartialMatch(labels)
+	l.metricProxies.rowsPerSecondGauge.DeletePartialMatch(labels)
+	l.metricProxies.etaSecondsGauge.DeletePartialMatch(labels)
 }
@@ -0,0 +1,223 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/lightning/pkg/checkpoints"
+	"github.com/pingcap/tidb/lightning/pkg/checkpoints/checkpointspb"
+	"github.com/pingcap/tidb/pkg/lightning/common"
+	lcfg "github.com/pingcap/tidb/pkg/lightning/config"
+	"github.com/pingcap/tiflow/dm/pb"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+// progressInterval is the interval to refresh the progress of loading.
+var progressInterval = 10 * time.Second
+
+// loadProgress is the progress of loading which is refreshed in background,
+// because reading the lightning checkpoint is too heavy for every Status call.
+type loadProgress struct {
+	bps        int64
+	rps        int64
+	etaSeconds int64
+	tables     []*pb.TableProgress
+}
+
+// chunkProgress is the progress of a chunk in the lightning checkpoint.
+type chunkProgress struct {
+	// table is the unique table name like "`db`.`tbl`".
+	table string
+	path  string
+	// offset and endOffset are the range of the chunk in the file, and pos is
+	// the position which has been loaded.
+	offset    int64
+	endOffset int64
+	pos       int64
+	// prevRowIDMax increases by one for each loaded row.
+	prevRowIDMax int64
+}
+
+func (c chunkProgress) key() string {
+	return fmt.Sprintf("%s:%s:%d", c.table, c.path, c.offset)
+}
+
+// runProgressLoop refreshes the progress of loading periodically until ctx is done.
+func (l *LightningLoader) runProgressLoop(ctx context.Context, cfg *lcfg.Config) {
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.refreshProgress(ctx, cfg)
+		}
+	}
+}
+
+// refreshProgress computes the speed, ETA and per-table progress of loading,
+// and updates the metrics.
+func (l *LightningLoader) refreshProgress(ctx context.Context, cfg *lcfg.Config) {
+	finished, total := l.core.Status()
+	progress := loadProgress{
+		bps: int64(l.speedRecorder.GetSpeed(float64(finished))),
+	}
+	progress.etaSeconds = utils.EstimateRemainingSeconds(float64(finished), float64(total), float64(progress.bps))
+
+	chunks, err := l.readChunkProgresses(ctx, cfg)
+	if err != nil {
+		// the checkpoint may be not created yet, the progress is only used to report.
+		l.logger.Debug("fail to read lightning checkpoint", log.ShortError(err))
+	} else {
+		progress.tables = tableProgressesOfChunks(chunks)
+		progress.rps = int64(l.rowSpeedRecorder.GetSpeed(float64(l.loadedRows(chunks))))
+	}
+
+	l.metricProxies.bytesPerSecondGauge.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Set(float64(progress.bps))
+	l.metricProxies.rowsPerSecondGauge.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Set(float64(progress.rps))
+	l.metricProxies.etaSecondsGauge.WithLabelValues(l.cfg.Name, l.cfg.SourceID).Set(float64(progress.etaSeconds))
+
+	l.progressMu.Lock()
+	l.progress = progress
+	l.progressMu.Unlock()
+}
+
+// loadedRows returns the rows loaded since the loader started. The row ID of
+// a chunk is not started from zero, so the first seen row ID of each chunk is
+// used as its base.
+func (l *LightningLoader) loadedRows(chunks []chunkProgress) int64 {
+	var rows int64
+	for _, c := range chunks {
+		key := c.key()
+		base, ok := l.rowIDBases[key]
+		if !ok {
+			base = c.prevRowIDMax
+			l.rowIDBases[key] = base
+		}
+		rows += c.prevRowIDMax - base
+	}
+	return rows
+}
+
+// readChunkProgresses reads the progress of all chunks from the lightning
+// checkpoint without modifying it. import-into mode has no chunk checkpoint.
+func (l *LightningLoader) readChunkProgresses(ctx context.Context, cfg *lcfg.Config) ([]chunkProgress, error) {
+	if cfg.TikvImporter.Backend == lcfg.BackendImportInto {
+		return nil, nil
+	}
+
+	switch cfg.Checkpoint.Driver {
+	case lcfg.CheckpointDriverMySQL:
+		return l.readChunkProgressesFromDB(ctx, cfg.Checkpoint.Schema)
+	case lcfg.CheckpointDriverFile:
+		// don't use checkpoints.OpenCheckpointsDB, FileCheckpointsDB saves the
+		// checkpoints it loaded when closing, which may overwrite the newer ones.
+		content, err := storage.ReadFile(ctx, l.cfg.LoaderConfig.Dir, lightningCheckpointFileName, nil)
+		if err != nil {
+			return nil, err
+		}
+		var model checkpointspb.CheckpointsModel
+		if err = model.Unmarshal(content); err != nil {
+			return nil, err
+		}
+		var chunks []chunkProgress
+		for table, tableCp := range model.Checkpoints {
+			for _, engineCp := range tableCp.Engines {
+				for _, chunkCp := range engineCp.Chunks {
+					chunks = append(chunks, chunkProgress{
+						table:        table,
+						path:         chunkCp.Path,
+						offset:       chunkCp.Offset,
+						endOffset:    chunkCp.EndOffset,
+						pos:          chunkCp.Pos,
+						prevRowIDMax: chunkCp.PrevRowidMax,
+					})
+				}
+			}
+		}
+		return chunks, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (l *LightningLoader) readChunkProgressesFromDB(ctx context.Context, schema string) ([]chunkProgress, error) {
+	query := fmt.Sprintf("SELECT table_name, path, `offset`, end_offset, pos, prev_rowid_max FROM %s",
+		common.UniqueTable(schema, checkpoints.CheckpointTableNameChunk))
+	tctx := tcontext.NewContext(ctx, l.logger)
+	rows, err := l.toDB.QueryContext(tctx, query)
+	if err != nil {
+		return nil, terror.DBErrorAdapt(err, l.toDB.Scope, terror.ErrDBDriverError)
+	}
+	defer rows.Close()
+
+	var chunks []chunkProgress
+	for rows.Next() {
+		var c chunkProgress
+		if err = rows.Scan(&c.table, &c.path, &c.offset, &c.endOffset, &c.pos, &c.prevRowIDMax); err != nil {
+			return nil, terror.DBErrorAdapt(err, l.toDB.Scope, terror.ErrDBDriverError)
+		}
+		chunks = append(chunks, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, terror.DBErrorAdapt(err, l.toDB.Scope, terror.ErrDBDriverError)
+	}
+	return chunks, nil
+}
+
+// tableProgressesOfChunks aggregates the progress of chunks by table, the
+// result is sorted by table name.
+func tableProgressesOfChunks(chunks []chunkProgress) []*pb.TableProgress {
+	if len(chunks) == 0 {
+		return nil
+	}
+	tables := make(map[string]*pb.TableProgress)
+	for _, c := range chunks {
+		t, ok := tables[c.table]
+		if !ok {
+			t = &pb.TableProgress{}
+			if strings.Contains(c.table, "`.`") {
+				table := utils.UnpackTableID(c.table)
+				t.Schema, t.Table = table.Schema, table.Name
+			} else {
+				t.Table = c.table
+			}
+			tables[c.table] = t
+		}
+		t.EstimateBytes += c.endOffset - c.offset
+		t.FinishedBytes += c.pos - c.offset
+	}
+
+	ret := make([]*pb.TableProgress, 0, len(tables))
+	for _, t := range tables {
+		ret = append(ret, t)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Schema != ret[j].Schema {
+			return ret[i].Schema < ret[j].Schema
+		}
+		return ret[i].Table < ret[j].Table
+	})
+	return ret
+}
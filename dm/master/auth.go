@@ -0,0 +1,437 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// authorizationKey is the key of HTTP header and gRPC metadata which carries the credential,
+	// the value is either `Basic base64(<user>:<password>)` or `Bearer <api-token>`.
+	authorizationKey = "authorization"
+	// builtinAdminUser is the user created by `auth.admin-password` in the config.
+	builtinAdminUser = "admin"
+	// apiTokenLength is the length of the random bytes of a generated API token.
+	apiTokenLength = 32
+	// principalKey is the key of the authenticated principal in gin.Context.
+	principalKey = "dm-principal"
+)
+
+// role is the role of a user or an API token, a role has all permissions of the lower roles.
+type role int
+
+const (
+	roleNone role = iota
+	// roleViewer can only query the cluster, sources and tasks.
+	roleViewer
+	// roleOperator can also operate tasks, relay logs and validators.
+	roleOperator
+	// roleAdmin can also manage sources, cluster members, users and API tokens.
+	roleAdmin
+)
+
+func parseRole(s string) (role, error) {
+	switch openapi.Role(s) {
+	case openapi.RoleViewer:
+		return roleViewer, nil
+	case openapi.RoleOperator:
+		return roleOperator, nil
+	case openapi.RoleAdmin:
+		return roleAdmin, nil
+	}
+	return roleNone, terror.ErrMasterAuthInvalidRole.Generate(s)
+}
+
+func (r role) String() string {
+	switch r {
+	case roleViewer:
+		return string(openapi.RoleViewer)
+	case roleOperator:
+		return string(openapi.RoleOperator)
+	case roleAdmin:
+		return string(openapi.RoleAdmin)
+	}
+	return "none"
+}
+
+// rpcRequiredRoles is the minimal role to call the gRPC methods of DM-master,
+// the methods not listed here require roleOperator.
+var rpcRequiredRoles = map[string]role{
+	"QueryStatus":         roleViewer,
+	"ShowDDLLocks":        roleViewer,
+	"ListMember":          roleViewer,
+	"GetSubTaskCfg":       roleViewer,
+	"GetCfg":              roleViewer,
+	"GetMasterCfg":        roleViewer,
+	"GetValidationStatus": roleViewer,
+	"GetValidationError":  roleViewer,
+	"ListTaskConfigs":     roleViewer,
+	"ListSourceConfigs":   roleViewer,
+
+	"OperateSource": roleAdmin,
+	"OfflineMember": roleAdmin,
+	"OperateLeader": roleAdmin,
+}
+
+// rpcWithoutAuth is the gRPC methods which are called by other DM components rather than users.
+var rpcWithoutAuth = map[string]struct{}{
+	"RegisterWorker": {},
+}
+
+// openAPIAdminRoutes is the OpenAPI routes which require roleAdmin, other routes require roleViewer
+// for GET and roleOperator for other methods.
+var openAPIAdminRoutes = map[string]struct{}{
+	"PUT /api/v1/cluster/info":                    {},
+	"DELETE /api/v1/cluster/masters/:master-name": {},
+	"DELETE /api/v1/cluster/workers/:worker-name": {},
+	"POST /api/v1/sources":                        {},
+	"PUT /api/v1/sources/:source-name":            {},
+	"DELETE /api/v1/sources/:source-name":         {},
+}
+
+// openAPIRoutesWithoutAuth is the OpenAPI routes which can be accessed without credential.
+var openAPIRoutesWithoutAuth = map[string]struct{}{
+	"/api/v1/docs":  {},
+	docJSONBasePath: {},
+}
+
+func openAPIRequiredRole(method, path string) role {
+	if _, ok := openAPIAdminRoutes[method+" "+path]; ok {
+		return roleAdmin
+	}
+	if strings.HasPrefix(path, "/api/v1/users") || strings.HasPrefix(path, "/api/v1/tokens") {
+		return roleAdmin
+	}
+	if method == http.MethodGet {
+		return roleViewer
+	}
+	return roleOperator
+}
+
+// principal is the authenticated identity of a request.
+type principal struct {
+	kind string // "user" or "token"
+	name string
+	role role
+}
+
+func (p *principal) String() string {
+	if p == nil {
+		return "anonymous"
+	}
+	return p.kind + ":" + p.name
+}
+
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// authenticate verifies the credential in the value of authorization header or metadata.
+func (s *Server) authenticate(authorization string) (*principal, error) {
+	scheme, credential, ok := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !ok || credential == "" {
+		return nil, terror.ErrMasterAuthUnauthenticated.Generate("missing credential")
+	}
+	if s.etcdClient == nil {
+		return nil, terror.ErrMasterAuthUnauthenticated.Generate("DM-master is not started")
+	}
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		raw, err := base64.StdEncoding.DecodeString(credential)
+		if err != nil {
+			return nil, terror.ErrMasterAuthUnauthenticated.Generate("malformed basic credential")
+		}
+		name, password, _ := strings.Cut(string(raw), ":")
+		user, err := ha.GetUser(s.etcdClient, name)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+			return nil, terror.ErrMasterAuthUnauthenticated.Generate("invalid user or password")
+		}
+		r, err := parseRole(user.Role)
+		if err != nil {
+			return nil, err
+		}
+		return &principal{kind: "user", name: user.Name, role: r}, nil
+	case "bearer":
+		tokens, err := ha.GetAllAPITokens(s.etcdClient)
+		if err != nil {
+			return nil, err
+		}
+		hash := []byte(hashAPIToken(credential))
+		for _, token := range tokens {
+			if subtle.ConstantTimeCompare([]byte(token.TokenHash), hash) == 1 {
+				r, err := parseRole(token.Role)
+				if err != nil {
+					return nil, err
+				}
+				return &principal{kind: "token", name: token.Name, role: r}, nil
+			}
+		}
+		return nil, terror.ErrMasterAuthUnauthenticated.Generate("invalid api token")
+	}
+	return nil, terror.ErrMasterAuthUnauthenticated.Generate("unsupported authorization scheme " + scheme)
+}
+
+// authorizeRPC checks whether the gRPC request is allowed to call the method, the returned
+// principal is nil if auth is disabled or the method does not require authentication.
+func (s *Server) authorizeRPC(ctx context.Context, method string) (*principal, error) {
+	if !s.cfg.Auth.Enable {
+		return nil, nil
+	}
+	if _, ok := rpcWithoutAuth[method]; ok {
+		return nil, nil
+	}
+	p, err := s.authenticate(incomingCredential(ctx))
+	if err != nil {
+		return nil, err
+	}
+	required := roleOperator
+	if r, ok := rpcRequiredRoles[method]; ok {
+		required = r
+	}
+	if p.role < required {
+		return p, terror.ErrMasterAuthPermissionDenied.Generate(p, p.role, method)
+	}
+	return p, nil
+}
+
+// canViewSecretsInRPC returns whether the caller of the gRPC request can see the passwords in
+// the configs, only roleAdmin can see them if auth is enabled.
+func (s *Server) canViewSecretsInRPC(ctx context.Context) bool {
+	if !s.cfg.Auth.Enable {
+		return true
+	}
+	p, err := s.authenticate(incomingCredential(ctx))
+	return err == nil && p.role >= roleAdmin
+}
+
+// canViewSecretsInOpenAPI is like canViewSecretsInRPC but for the OpenAPI requests
+// authenticated by authMW.
+func (s *Server) canViewSecretsInOpenAPI(c *gin.Context) bool {
+	if !s.cfg.Auth.Enable {
+		return true
+	}
+	if v, ok := c.Get(principalKey); ok {
+		if p, ok := v.(*principal); ok {
+			return p.role >= roleAdmin
+		}
+	}
+	return false
+}
+
+// incomingCredential returns the credential of the incoming gRPC request.
+func incomingCredential(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationKey); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// forwardCredential copies the credential of the incoming gRPC request to the outgoing context,
+// so the request forwarded to the leader can be authorized again.
+func forwardCredential(ctx context.Context) context.Context {
+	if credential := incomingCredential(ctx); credential != "" {
+		return metadata.AppendToOutgoingContext(ctx, authorizationKey, credential)
+	}
+	return ctx
+}
+
+// auditRPC writes the audit log for the gRPC requests which are not read-only.
+func (s *Server) auditRPC(p *principal, method string, req interface{}, err error) {
+	if !s.cfg.Auth.Enable {
+		return
+	}
+	if r, ok := rpcRequiredRoles[method]; ok && r == roleViewer && err == nil {
+		return
+	}
+	s.audit(p, method, err, zap.Any("payload", req))
+}
+
+// audit writes an audit log entry for the action done by the principal.
+func (s *Server) audit(p *principal, action string, err error, fields ...zap.Field) {
+	fields = append(fields, zap.Stringer("principal", p), zap.String("action", action))
+	if err != nil {
+		s.auditLogger.Warn("action rejected", append(fields, log.ShortError(err))...)
+		return
+	}
+	s.auditLogger.Info("action accepted", fields...)
+}
+
+// authMW authenticates and authorizes the OpenAPI requests, it's used after the requests are
+// reversed to the leader, so only the leader does the check.
+func (s *Server) authMW() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.FullPath()
+		if _, ok := openAPIRoutesWithoutAuth[path]; !s.cfg.Auth.Enable || ok {
+			c.Next()
+			return
+		}
+		method := c.Request.Method
+		action := method + " " + c.Request.URL.Path
+		p, err := s.authenticate(c.GetHeader(authorizationKey))
+		if err != nil {
+			s.audit(nil, action, err)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, openAPIError(err))
+			return
+		}
+		if p.role < openAPIRequiredRole(method, path) {
+			err = terror.ErrMasterAuthPermissionDenied.Generate(p, p.role, action)
+			s.audit(p, action, err)
+			c.AbortWithStatusJSON(http.StatusForbidden, openAPIError(err))
+			return
+		}
+		c.Set(principalKey, p)
+
+		c.Next()
+		if method != http.MethodGet {
+			var actionErr error
+			if gErr := c.Errors.Last(); gErr != nil {
+				actionErr = gErr.Err
+			}
+			s.audit(p, action, actionErr, zap.Int("status", c.Writer.Status()))
+		}
+	}
+}
+
+func openAPIError(err error) openapi.ErrorWithMessage {
+	resp := openapi.ErrorWithMessage{ErrorMsg: err.Error()}
+	if tErr, ok := err.(*terror.Error); ok {
+		resp.ErrorCode = int(tErr.Code())
+	}
+	return resp
+}
+
+// bootstrapAdminUser creates the built-in admin user by `auth.admin-password` if the user does not exist.
+func (s *Server) bootstrapAdminUser() error {
+	if !s.cfg.Auth.Enable || s.cfg.Auth.AdminPassword == "" {
+		return nil
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(s.cfg.Auth.AdminPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err // it should not happen, the length of password is checked in config.
+	}
+	created, err := ha.PutUserIfNotExist(s.etcdClient, ha.User{
+		Name:         builtinAdminUser,
+		Role:         string(openapi.RoleAdmin),
+		PasswordHash: string(hash),
+	})
+	if err != nil {
+		return err
+	}
+	if created {
+		log.L().Info("built-in admin user created", zap.String("user", builtinAdminUser))
+	}
+	return nil
+}
+
+func (s *Server) listUsers() ([]openapi.User, error) {
+	users, err := ha.GetAllUsers(s.etcdClient)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]openapi.User, len(users))
+	for i, user := range users {
+		ret[i] = openapi.User{Name: user.Name, Role: openapi.Role(user.Role)}
+	}
+	return ret, nil
+}
+
+func (s *Server) createUser(req openapi.CreateUserRequest) (*openapi.User, error) {
+	if _, err := parseRole(string(req.Role)); err != nil {
+		return nil, err
+	}
+	if req.Name == "" || req.Password == "" {
+		return nil, terror.ErrOpenAPICommonError.Generatef("user name and password should not be empty")
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, terror.ErrOpenAPICommonError.Delegate(err)
+	}
+	err = ha.PutUser(s.etcdClient, ha.User{Name: req.Name, Role: string(req.Role), PasswordHash: string(hash)})
+	if err != nil {
+		return nil, err
+	}
+	return &openapi.User{Name: req.Name, Role: req.Role}, nil
+}
+
+func (s *Server) listAPITokens() ([]openapi.APIToken, error) {
+	tokens, err := ha.GetAllAPITokens(s.etcdClient)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]openapi.APIToken, len(tokens))
+	for i, token := range tokens {
+		ret[i] = openapi.APIToken{
+			Name:       token.Name,
+			Role:       openapi.Role(token.Role),
+			CreateTime: token.CreateTime.Format(time.RFC3339),
+		}
+	}
+	return ret, nil
+}
+
+func (s *Server) createAPIToken(req openapi.CreateAPITokenRequest) (*openapi.CreateAPITokenResponse, error) {
+	if _, err := parseRole(string(req.Role)); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, terror.ErrOpenAPICommonError.Generatef("api token name should not be empty")
+	}
+	buf := make([]byte, apiTokenLength)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, terror.ErrOpenAPICommonError.Delegate(err)
+	}
+	token := hex.EncodeToString(buf)
+	err := ha.PutAPIToken(s.etcdClient, ha.APIToken{
+		Name:       req.Name,
+		Role:       string(req.Role),
+		TokenHash:  hashAPIToken(token),
+		CreateTime: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &openapi.CreateAPITokenResponse{Name: req.Name, Role: req.Role, Token: token}, nil
+}
+
+// obfuscateTaskPassword hides the password of the target database in the task, it's used for
+// the roles which can't view secrets.
+func obfuscateTaskPassword(task *openapi.Task) {
+	task.TargetConfig.Password = config.ObfuscatedPasswordForFeedback
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package master
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/openapi"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRole(t *testing.T) {
+	for _, r := range []role{roleViewer, roleOperator, roleAdmin} {
+		parsed, err := parseRole(r.String())
+		require.NoError(t, err)
+		require.Equal(t, r, parsed)
+	}
+	_, err := parseRole("root")
+	require.True(t, terror.ErrMasterAuthInvalidRole.Equal(err))
+	require.Less(t, roleViewer, roleOperator)
+	require.Less(t, roleOperator, roleAdmin)
+
+	cases := []struct {
+		method string
+		path   string
+		role   role
+	}{
+		{http.MethodGet, "/api/v1/tasks", roleViewer},
+		{http.MethodPost, "/api/v1/tasks/:task-name/start", roleOperator},
+		{http.MethodPost, "/api/v1/sources/:source-name/relay/enable", roleOperator},
+		{http.MethodPost, "/api/v1/sources", roleAdmin},
+		{http.MethodDelete, "/api/v1/sources/:source-name", roleAdmin},
+		{http.MethodDelete, "/api/v1/cluster/workers/:worker-name", roleAdmin},
+		{http.MethodGet, "/api/v1/users", roleAdmin},
+		{http.MethodGet, "/api/v1/tokens", roleAdmin},
+	}
+	for _, cs := range cases {
+		require.Equal(t, cs.role, openAPIRequiredRole(cs.method, cs.path), cs.method+" "+cs.path)
+	}
+}
+
+func TestAuthorizeRPC(t *testing.T) {
+	s := &Server{cfg: NewConfig()}
+	ctx := context.Background()
+
+	// auth is disabled.
+	p, err := s.authorizeRPC(ctx, "StartTask")
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	s.cfg.Auth.Enable = true
+	p, err = s.authorizeRPC(ctx, "RegisterWorker")
+	require.NoError(t, err)
+	require.Nil(t, p)
+	_, err = s.authorizeRPC(ctx, "StartTask")
+	require.True(t, terror.ErrMasterAuthUnauthenticated.Equal(err))
+	require.ErrorContains(t, err, "missing credential")
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationKey, "Digest abc"))
+	_, err = s.authorizeRPC(ctx, "StartTask")
+	require.True(t, terror.ErrMasterAuthUnauthenticated.Equal(err))
+
+	// the credential is forwarded to the leader.
+	md, ok := metadata.FromOutgoingContext(forwardCredential(ctx))
+	require.True(t, ok)
+	require.Equal(t, []string{"Digest abc"}, md.Get(authorizationKey))
+}
+
+func TestTomlHideAdminPassword(t *testing.T) {
+	cfg := NewConfig()
+	require.NoError(t, cfg.FromContent(SampleConfig))
+	cfg.Auth.Enable = true
+	cfg.Auth.AdminPassword = "123456"
+	content, err := cfg.Toml()
+	require.NoError(t, err)
+	require.NotContains(t, content, "123456")
+	require.Contains(t, content, "[auth]")
+	require.Equal(t, "123456", cfg.Auth.AdminPassword)
+	require.NotContains(t, cfg.String(), "123456")
+}
+
+func TestCanViewSecrets(t *testing.T) {
+	s := &Server{cfg: NewConfig()}
+	ctx := context.Background()
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	// auth is disabled.
+	require.True(t, s.canViewSecretsInRPC(ctx))
+	require.True(t, s.canViewSecretsInOpenAPI(c))
+
+	s.cfg.Auth.Enable = true
+	require.False(t, s.canViewSecretsInRPC(ctx))
+	require.False(t, s.canViewSecretsInOpenAPI(c))
+	c.Set(principalKey, &principal{kind: "user", name: "viewer", role: roleViewer})
+	require.False(t, s.canViewSecretsInOpenAPI(c))
+	c.Set(principalKey, &principal{kind: "token", name: "operator", role: roleOperator})
+	require.False(t, s.canViewSecretsInOpenAPI(c))
+	c.Set(principalKey, &principal{kind: "user", name: "admin", role: roleAdmin})
+	require.True(t, s.canViewSecretsInOpenAPI(c))
+
+	task := &openapi.Task{TargetConfig: openapi.TaskTargetDataBase{Password: "123456"}}
+	obfuscateTaskPassword(task)
+	require.Equal(t, config.ObfuscatedPasswordForFeedback, task.TargetConfig.Password)
+}
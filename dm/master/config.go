@@ -98,6 +98,15 @@ type ExperimentalFeatures struct {
 	OpenAPI bool `toml:"openapi,omitempty"` // OpenAPI is available in v5.4 as default.
 }
 
+// AuthConfig is the config of authentication and authorization for OpenAPI and dmctl.
+type AuthConfig struct {
+	Enable bool `toml:"enable" json:"enable"`
+	// AdminPassword is used to bootstrap the built-in "admin" user when the leader starts and the user does not exist.
+	AdminPassword string `toml:"admin-password,omitempty" json:"-"`
+	// AuditLogFile is the file which audit log is written to, it's written to the DM-master log if not set.
+	AuditLogFile string `toml:"audit-log-file" json:"audit-log-file"`
+}
+
 // Config is the configuration for dm-master.
 type Config struct {
 	flagSet *flag.FlagSet
@@ -147,6 +156,8 @@ type Config struct {
 	printSampleConfig bool
 
 	ExperimentalFeatures ExperimentalFeatures `toml:"experimental"`
+
+	Auth AuthConfig `toml:"auth" json:"auth"`
 }
 
 func (c *Config) String() string {
@@ -161,7 +172,10 @@ func (c *Config) String() string {
 func (c *Config) Toml() (string, error) {
 	var b bytes.Buffer
 
-	err := toml.NewEncoder(&b).Encode(c)
+	// don't expose the password of the built-in admin user.
+	cfg := *c
+	cfg.Auth.AdminPassword = ""
+	err := toml.NewEncoder(&b).Encode(&cfg)
 	if err != nil {
 		log.L().Error("fail to marshal config to toml", log.ShortError(err))
 	}
@@ -343,6 +357,11 @@ func (c *Config) adjust() error {
 		log.L().Warn("openapi is a GA feature and removed from experimental features, so this configuration may have no affect in feature release, please set openapi=true in dm-master config file")
 	}
 
+	// bcrypt only uses the first 72 bytes of the password.
+	if len(c.Auth.AdminPassword) > 72 {
+		return terror.ErrMasterConfigInvalidFlag.Generate("auth.admin-password")
+	}
+
 	return c.adjustSecretKeyPath()
 }
 
@@ -202,6 +202,10 @@ func (s *Server) startLeaderComponent(ctx context.Context) bool {
 		return false
 	}
 
+	if err = s.bootstrapAdminUser(); err != nil {
+		log.L().Error("bootstrap admin user failed", zap.Error(err))
+	}
+
 	failpoint.Inject("FailToStartLeader", func(val failpoint.Value) {
 		masterStrings := val.(string)
 		if strings.Contains(masterStrings, s.cfg.Name) {
@@ -577,6 +577,20 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string) ([]openapi.
 				MetaBinlogGtid: loadS.MetaBinlogGTID,
 				Progress:       loadS.Progress,
 				TotalBytes:     loadS.TotalBytes,
+				EtaSeconds:     &loadS.EtaSeconds,
+				Rps:            &loadS.Rps,
+			}
+			if len(loadS.Tables) > 0 {
+				tables := make([]openapi.LoadTableProgress, 0, len(loadS.Tables))
+				for _, t := range loadS.Tables {
+					tables = append(tables, openapi.LoadTableProgress{
+						Schema:        t.Schema,
+						Table:         t.Table,
+						EstimateBytes: t.EstimateBytes,
+						FinishedBytes: t.FinishedBytes,
+					})
+				}
+				openapiSubTaskStatus.LoadStatus.Tables = &tables
 			}
 		}
 		// add sync status
@@ -608,17 +622,38 @@ func (s *Server) getTaskStatus(ctx context.Context, taskName string) ([]openapi.
 					}
 				}
 			}
+			if pendingDDLs := syncerS.GetPendingApprovalDDLs(); len(pendingDDLs) > 0 {
+				pendingPosition := syncerS.GetPendingApprovalPosition()
+				openapiSubTaskStatus.SyncStatus.PendingApprovalDdls = &pendingDDLs
+				openapiSubTaskStatus.SyncStatus.PendingApprovalPosition = &pendingPosition
+			}
 		}
 		// add dump status
 		if dumpS := subTaskStatus.GetDump(); dumpS != nil {
 			openapiSubTaskStatus.DumpStatus = &openapi.DumpStatus{
-				Bps:               dumpS.Bps,
-				CompletedTables:   dumpS.CompletedTables,
-				EstimateTotalRows: dumpS.EstimateTotalRows,
-				FinishedBytes:     dumpS.FinishedBytes,
-				FinishedRows:      dumpS.FinishedRows,
-				Progress:          dumpS.Progress,
-				TotalTables:       dumpS.TotalTables,
+				Bps:                dumpS.Bps,
+				CompletedTables:    dumpS.CompletedTables,
+				EstimateTotalRows:  dumpS.EstimateTotalRows,
+				FinishedBytes:      dumpS.FinishedBytes,
+				FinishedRows:       dumpS.FinishedRows,
+				Progress:           dumpS.Progress,
+				TotalTables:        dumpS.TotalTables,
+				Rps:                &dumpS.Rps,
+				EstimateTotalBytes: &dumpS.EstimateTotalBytes,
+				EtaSeconds:         &dumpS.EtaSeconds,
+			}
+			if len(dumpS.Tables) > 0 {
+				tables := make([]openapi.DumpTableProgress, 0, len(dumpS.Tables))
+				for _, t := range dumpS.Tables {
+					tables = append(tables, openapi.DumpTableProgress{
+						Schema:        t.Schema,
+						Table:         t.Table,
+						EstimateRows:  t.EstimateRows,
+						EstimateBytes: t.EstimateBytes,
+						FinishedBytes: t.FinishedBytes,
+					})
+				}
+				openapiSubTaskStatus.DumpStatus.Tables = &tables
 			}
 		}
 		// add error if some error happens
@@ -770,6 +805,27 @@ func (s *Server) stopTask(ctx context.Context, taskName string, req openapi.Stop
 	return s.scheduler.UpdateExpectSubTaskStage(pb.Stage_Stopped, taskName, *req.SourceNameList...)
 }
 
+// operateTaskDDLApproval approves or skips the DDLs waiting for approval of a task.
+func (s *Server) operateTaskDDLApproval(ctx context.Context, taskName string, req openapi.DDLApprovalRequest, op pb.ErrorOp) error {
+	handleErrReq := &pb.HandleErrorRequest{Op: op, Task: taskName}
+	if req.BinlogPos != nil {
+		handleErrReq.BinlogPos = *req.BinlogPos
+	}
+	if req.SourceNameList != nil {
+		handleErrReq.Sources = *req.SourceNameList
+	}
+	resp := s.handleError(ctx, handleErrReq)
+	if !resp.Result {
+		return terror.ErrOpenAPICommonError.New(resp.Msg)
+	}
+	for _, sourceResp := range resp.Sources {
+		if !sourceResp.Result {
+			return terror.ErrOpenAPICommonError.Generatef("fail to operate DDL approval for source %s: %s", sourceResp.Source, sourceResp.Msg)
+		}
+	}
+	return nil
+}
+
 // handleCliArgs handles cli args.
 // it will try to delete args if cli args is nil.
 func handleCliArgs(cli *clientv3.Client, taskName string, sources []string, cliArgs *config.TaskCliArgs) error {
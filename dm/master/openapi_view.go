@@ -100,6 +100,7 @@ func (s *Server) InitOpenAPIHandles(tlsCfg *tls.Config) error {
 	r.Use(openapi.ZapLogger(log.L().WithFields(zap.String("component", "openapi")).Logger))
 	r.Use(s.reverseRequestToLeaderMW(tlsCfg))
 	r.Use(terrorHTTPErrorHandler())
+	r.Use(s.authMW())
 	// use validation middleware to check all requests against the OpenAPI schema.
 	r.Use(ginmiddleware.OapiRequestValidator(swagger))
 	// register handlers
@@ -504,6 +505,9 @@ func (s *Server) DMAPIGetTask(c *gin.Context, taskName string, params openapi.DM
 		_ = c.Error(err)
 		return
 	}
+	if !s.canViewSecretsInOpenAPI(c) {
+		obfuscateTaskPassword(task)
+	}
 	c.IndentedJSON(http.StatusOK, task)
 }
 
@@ -528,6 +532,11 @@ func (s *Server) DMAPIGetTaskList(c *gin.Context, params openapi.DMAPIGetTaskLis
 		_ = c.Error(err)
 		return
 	}
+	if !s.canViewSecretsInOpenAPI(c) {
+		for i := range taskList {
+			obfuscateTaskPassword(&taskList[i])
+		}
+	}
 	resp := openapi.GetTaskListResponse{Total: len(taskList), Data: taskList}
 	c.IndentedJSON(http.StatusOK, resp)
 }
@@ -560,6 +569,36 @@ func (s *Server) DMAPIStopTask(c *gin.Context, taskName string) {
 	c.Status(http.StatusOK)
 }
 
+// DMAPIApproveTaskDDL url is: (POST /api/v1/tasks/{task-name}/ddl-approval/approve).
+func (s *Server) DMAPIApproveTaskDDL(c *gin.Context, taskName string) {
+	var req openapi.DDLApprovalRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.operateTaskDDLApproval(ctx, taskName, req, pb.ErrorOp_Approve); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// DMAPISkipTaskDDL url is: (POST /api/v1/tasks/{task-name}/ddl-approval/skip).
+func (s *Server) DMAPISkipTaskDDL(c *gin.Context, taskName string) {
+	var req openapi.DDLApprovalRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ctx := c.Request.Context()
+	if err := s.operateTaskDDLApproval(ctx, taskName, req, pb.ErrorOp_Skip); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
 // DMAPIGetSchemaListByTaskAndSource get task source schema list url is: (GET /api/v1/tasks/{task-name}/sources/{source-name}/schemas).
 func (s *Server) DMAPIGetSchemaListByTaskAndSource(c *gin.Context, taskName string, sourceName string) {
 	worker := s.scheduler.GetWorkerBySource(sourceName)
@@ -856,9 +895,13 @@ func (s *Server) DMAPIGetTaskTemplateList(c *gin.Context) {
 		_ = c.Error(err)
 		return
 	}
+	canViewSecrets := s.canViewSecretsInOpenAPI(c)
 	taskList := make([]openapi.Task, len(TaskConfigList))
 	for i, TaskConfig := range TaskConfigList {
 		taskList[i] = *TaskConfig
+		if !canViewSecrets {
+			obfuscateTaskPassword(&taskList[i])
+		}
 	}
 	resp := openapi.GetTaskListResponse{Total: len(TaskConfigList), Data: taskList}
 	c.IndentedJSON(http.StatusOK, resp)
@@ -884,6 +927,9 @@ func (s *Server) DMAPIGetTaskTemplate(c *gin.Context, taskName string) {
 		_ = c.Error(terror.ErrOpenAPITaskConfigNotExist.Generate(taskName))
 		return
 	}
+	if !s.canViewSecretsInOpenAPI(c) {
+		obfuscateTaskPassword(task)
+	}
 	c.IndentedJSON(http.StatusOK, task)
 }
 
@@ -911,6 +957,76 @@ func (s *Server) DMAPUpdateTaskTemplate(c *gin.Context, taskName string) {
 	c.IndentedJSON(http.StatusOK, task)
 }
 
+// DMAPIGetUserList get user list url is: (GET /api/v1/users).
+func (s *Server) DMAPIGetUserList(c *gin.Context) {
+	users, err := s.listUsers()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := &openapi.GetUserListResponse{Total: len(users), Data: users}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPICreateUser create or update a user url is: (POST /api/v1/users).
+func (s *Server) DMAPICreateUser(c *gin.Context) {
+	var req openapi.CreateUserRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	user, err := s.createUser(req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusCreated, user)
+}
+
+// DMAPIDeleteUser delete a user url is: (DELETE /api/v1/users/{user-name}).
+func (s *Server) DMAPIDeleteUser(c *gin.Context, userName string) {
+	if err := ha.DeleteUser(s.etcdClient, userName); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// DMAPIGetAPITokenList get api token list url is: (GET /api/v1/tokens).
+func (s *Server) DMAPIGetAPITokenList(c *gin.Context) {
+	tokens, err := s.listAPITokens()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resp := &openapi.GetAPITokenListResponse{Total: len(tokens), Data: tokens}
+	c.IndentedJSON(http.StatusOK, resp)
+}
+
+// DMAPICreateAPIToken create an api token url is: (POST /api/v1/tokens).
+func (s *Server) DMAPICreateAPIToken(c *gin.Context) {
+	var req openapi.CreateAPITokenRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	token, err := s.createAPIToken(req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.IndentedJSON(http.StatusCreated, token)
+}
+
+// DMAPIDeleteAPIToken delete an api token url is: (DELETE /api/v1/tokens/{token-name}).
+func (s *Server) DMAPIDeleteAPIToken(c *gin.Context, tokenName string) {
+	if err := ha.DeleteAPIToken(s.etcdClient, tokenName); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
 func terrorHTTPErrorHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Next()
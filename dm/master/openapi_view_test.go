@@ -18,6 +18,7 @@ package master
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
@@ -1182,6 +1183,103 @@ func (s *OpenAPIViewSuite) TestTaskAPI() {
 	s.Equal(0, resultTaskList.Total)
 }
 
+func (s *OpenAPIViewSuite) TestAuthAPI() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s1 := setupTestServer(ctx, s.T())
+	defer func() {
+		cancel()
+		s1.Close()
+	}()
+	s1.cfg.Auth.Enable = true
+	s1.cfg.Auth.AdminPassword = "admin-pass"
+	s.NoError(s1.bootstrapAdminUser())
+
+	basicAuth := func(user, password string) string {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+	}
+	adminAuth := basicAuth(builtinAdminUser, "admin-pass")
+	sourceURL := "/api/v1/sources"
+	taskURL := "/api/v1/tasks"
+	userURL := "/api/v1/users"
+	tokenURL := "/api/v1/tokens"
+
+	// no credential or wrong password
+	result := testutil.NewRequest().Get(taskURL).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusUnauthorized, result.Code())
+	result = testutil.NewRequest().Get(taskURL).WithHeader("Authorization", basicAuth(builtinAdminUser, "wrong")).
+		GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusUnauthorized, result.Code())
+	var errResp openapi.ErrorWithMessage
+	s.NoError(result.UnmarshalBodyToObject(&errResp))
+	s.Contains(errResp.ErrorMsg, "invalid user or password")
+	// docs can be accessed without credential
+	result = testutil.NewRequest().Get(docJSONBasePath).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+
+	// admin creates a viewer user and an operator token
+	viewer := openapi.CreateUserRequest{Name: "viewer1", Password: "viewer-pass", Role: openapi.RoleViewer}
+	result = testutil.NewRequest().Post(userURL).WithHeader("Authorization", adminAuth).WithJsonBody(viewer).
+		GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusCreated, result.Code())
+	result = testutil.NewRequest().Get(userURL).WithHeader("Authorization", adminAuth).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	var users openapi.GetUserListResponse
+	s.NoError(result.UnmarshalBodyToObject(&users))
+	s.Equal(2, users.Total)
+	s.Equal(openapi.User{Name: builtinAdminUser, Role: openapi.RoleAdmin}, users.Data[0])
+	s.Equal(openapi.User{Name: viewer.Name, Role: viewer.Role}, users.Data[1])
+
+	tokenReq := openapi.CreateAPITokenRequest{Name: "ci", Role: openapi.RoleOperator}
+	result = testutil.NewRequest().Post(tokenURL).WithHeader("Authorization", adminAuth).WithJsonBody(tokenReq).
+		GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusCreated, result.Code())
+	var token openapi.CreateAPITokenResponse
+	s.NoError(result.UnmarshalBodyToObject(&token))
+	s.NotEmpty(token.Token)
+	tokenAuth := "Bearer " + token.Token
+	// token name is unique
+	result = testutil.NewRequest().Post(tokenURL).WithHeader("Authorization", adminAuth).WithJsonBody(tokenReq).
+		GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusBadRequest, result.Code())
+	result = testutil.NewRequest().Get(tokenURL).WithHeader("Authorization", adminAuth).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	var tokens openapi.GetAPITokenListResponse
+	s.NoError(result.UnmarshalBodyToObject(&tokens))
+	s.Equal(1, tokens.Total)
+	s.Equal(tokenReq.Name, tokens.Data[0].Name)
+	s.Equal(tokenReq.Role, tokens.Data[0].Role)
+
+	// viewer can only read
+	viewerAuth := basicAuth(viewer.Name, viewer.Password)
+	result = testutil.NewRequest().Get(taskURL).WithHeader("Authorization", viewerAuth).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+	result = testutil.NewRequest().Post(taskURL+"/test/stop").WithHeader("Authorization", viewerAuth).
+		WithJsonBody(openapi.StopTaskRequest{}).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusForbidden, result.Code())
+	s.NoError(result.UnmarshalBodyToObject(&errResp))
+	s.Equal(int(terror.ErrMasterAuthPermissionDenied.Code()), errResp.ErrorCode)
+
+	// operator can't manage sources and users
+	result = testutil.NewRequest().Get(userURL).WithHeader("Authorization", tokenAuth).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusForbidden, result.Code())
+	result = testutil.NewRequest().Delete(sourceURL+"/"+source1Name).WithHeader("Authorization", tokenAuth).
+		GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusForbidden, result.Code())
+	result = testutil.NewRequest().Get(sourceURL).WithHeader("Authorization", tokenAuth).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusOK, result.Code())
+
+	// deleted token and user can't be used anymore
+	result = testutil.NewRequest().Delete(tokenURL+"/"+tokenReq.Name).WithHeader("Authorization", adminAuth).
+		GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusNoContent, result.Code())
+	result = testutil.NewRequest().Get(sourceURL).WithHeader("Authorization", tokenAuth).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusUnauthorized, result.Code())
+	result = testutil.NewRequest().Delete(userURL+"/"+viewer.Name).WithHeader("Authorization", adminAuth).
+		GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusNoContent, result.Code())
+	result = testutil.NewRequest().Get(taskURL).WithHeader("Authorization", viewerAuth).GoWithHTTPHandler(s.T(), s1.openapiHandles)
+	s.Equal(http.StatusUnauthorized, result.Code())
+}
+
 func TestOpenAPIViewSuite(t *testing.T) {
 	suite.Run(t, new(OpenAPIViewSuite))
 }
@@ -136,6 +136,9 @@ type Server struct {
 	openapiHandles *gin.Engine // injected in `InitOpenAPIHandles`
 
 	clusterID atomic.Uint64
+
+	// auditLogger writes the audit log when auth is enabled.
+	auditLogger log.Logger
 }
 
 // NewServer creates a new Server.
@@ -145,6 +148,8 @@ func NewServer(cfg *Config) *Server {
 		cfg:       cfg,
 		scheduler: scheduler.NewScheduler(&logger, cfg.Security),
 		ap:        NewAgentPool(&RateLimitConfig{rate: cfg.RPCRateLimit, burst: cfg.RPCRateBurst}),
+
+		auditLogger: log.With(zap.String("component", "audit")),
 	}
 	server.pessimist = shardddl.NewPessimist(&logger, server.getTaskSourceNameList)
 	server.optimist = shardddl.NewOptimist(&logger, server.scheduler.GetDownstreamMetaByTask)
@@ -164,6 +169,15 @@ func (s *Server) Start(ctx context.Context) (err error) {
 	}
 	log.L().Info("config after join prepared", zap.Stringer("config", s.cfg))
 
+	if s.cfg.Auth.Enable && s.cfg.Auth.AuditLogFile != "" {
+		auditLogCfg := &log.Config{File: s.cfg.Auth.AuditLogFile, Format: s.cfg.LogFormat}
+		auditLogCfg.Adjust()
+		s.auditLogger, err = log.NewFileLogger(auditLogCfg)
+		if err != nil {
+			return err
+		}
+	}
+
 	// generates embed etcd config before any concurrent gRPC calls.
 	// potential concurrent gRPC calls:
 	//   - workerrpc.NewGRPCClient
@@ -503,7 +517,10 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 	}
 
 	cliArgs := config.TaskCliArgs{
-		StartTime: req.StartTime,
+		StartTime:     req.StartTime,
+		StopTime:      req.StopTime,
+		StopBinlogPos: req.StopBinlogPos,
+		StopGTID:      req.StopGTID,
 	}
 	if err := cliArgs.Verify(); err != nil {
 		return respWithErr(err), nil
@@ -579,7 +596,7 @@ func (s *Server) StartTask(ctx context.Context, req *pb.StartTaskRequest) (*pb.S
 			}
 		}
 
-		if req.StartTime == "" {
+		if req.StartTime == "" && !cliArgs.HasStopBoundary() {
 			err = ha.DeleteAllTaskCliArgs(s.etcdClient, cfg.Name)
 			if err != nil {
 				return respWithErr(terror.Annotate(err, "while removing task command line arguments")), nil
@@ -697,8 +714,15 @@ func (s *Server) GetSubTaskCfg(ctx context.Context, req *pb.GetSubTaskCfgRequest
 	}
 
 	cfgs := make([]string, 0, len(subCfgs))
+	canViewSecrets := s.canViewSecretsInRPC(ctx)
 
 	for _, cfg := range subCfgs {
+		if !canViewSecrets {
+			clone := *cfg
+			clone.From.Password = config.ObfuscatedPasswordForFeedback
+			clone.To.Password = config.ObfuscatedPasswordForFeedback
+			cfg = &clone
+		}
 		cfgBytes, err := cfg.Toml()
 		if err != nil {
 			// nolint:nilerr
@@ -1368,6 +1392,16 @@ func innerCheckAndAdjustSourceConfig(
 	cfg *config.SourceConfig,
 	hook func(sourceConfig *config.SourceConfig, ctx context.Context, db *conn.BaseDB) error,
 ) error {
+	if cfg.OfflineBinlog != nil {
+		// there is no upstream database for an offline source.
+		if err := cfg.AdjustOfflineBinlog(); err != nil {
+			return err
+		}
+		if _, err := cfg.Yaml(); err != nil {
+			return err
+		}
+		return cfg.Verify()
+	}
 	dbConfig := cfg.GenerateDBConfig()
 	fromDB, err := conn.GetUpstreamDB(dbConfig)
 	if err != nil {
@@ -1626,6 +1660,12 @@ func (s *Server) OperateSource(ctx context.Context, req *pb.OperateSourceRequest
 func (s *Server) OperateLeader(ctx context.Context, req *pb.OperateLeaderRequest) (*pb.OperateLeaderResponse, error) {
 	log.L().Info("", zap.Stringer("payload", req), zap.String("request", "OperateLeader"))
 
+	p, err := s.authorizeRPC(ctx, "OperateLeader")
+	s.auditRPC(p, "OperateLeader", req, err)
+	if err != nil {
+		return nil, err
+	}
+
 	switch req.Op {
 	case pb.LeaderOp_EvictLeaderOp:
 		s.election.EvictLeader()
@@ -1682,6 +1722,21 @@ func (s *Server) generateSubTask(
 		dbConfigs[sourceCfg.SourceID] = sourceCfg.From
 	}
 
+	for _, inst := range cfg.MySQLInstances {
+		sourceCfg, ok := sourceCfgs[inst.SourceID]
+		if !ok || sourceCfg.OfflineBinlog == nil {
+			continue
+		}
+		// the binlog of an offline source can only be replicated from the given location,
+		// there is no upstream database to get the latest location.
+		if cfg.TaskMode != config.ModeIncrement {
+			return nil, nil, terror.ErrConfigOfflineBinlogNotSupport.Generate(fmt.Sprintf("when `task-mode` is `%s`", cfg.TaskMode))
+		}
+		if inst.Meta == nil {
+			return nil, nil, terror.ErrConfigOfflineBinlogNotSupport.Generate(fmt.Sprintf("without `meta` of source %s", inst.SourceID))
+		}
+	}
+
 	if cfg.TaskMode == config.ModeIncrement && (cliArgs == nil || cliArgs.StartTime == "") {
 		for _, inst := range cfg.MySQLInstances {
 			if inst.Meta == nil {
@@ -1729,6 +1784,7 @@ func (s *Server) generateSubTasksForCheck(stCfgs []*config.SubTaskConfig) ([]*co
 			stCfgsForCheck[i].Flavor = sourceCfg.Flavor
 			stCfgsForCheck[i].ServerID = sourceCfg.ServerID
 			stCfgsForCheck[i].EnableGTID = sourceCfg.EnableGTID
+			stCfgsForCheck[i].OfflineBinlog = sourceCfg.OfflineBinlog != nil
 
 			if sourceCfg.EnableRelay {
 				stCfgsForCheck[i].UseRelay = true
@@ -1882,6 +1938,8 @@ func (s *Server) removeDownstreamMetaData(
 		dbutil.TableName(metaSchema, cputil.ValidatorErrorChange(taskName))))
 	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
 		dbutil.TableName(metaSchema, cputil.ValidatorTableStatus(taskName))))
+	sqls = append(sqls, fmt.Sprintf("DROP TABLE IF EXISTS %s",
+		dbutil.TableName(metaSchema, cputil.ValidatorRepairAction(taskName))))
 	// clear lightning error manager table
 	sqls = append(sqls, fmt.Sprintf("DROP DATABASE IF EXISTS %s",
 		dbutil.ColumnName(loader.GetTaskInfoSchemaName(metaSchema, taskName))))
@@ -2495,6 +2553,10 @@ func (s *Server) createMasterClientByName(ctx context.Context, name string) (pb.
 func (s *Server) GetMasterCfg(ctx context.Context, req *pb.GetMasterCfgRequest) (*pb.GetMasterCfgResponse, error) {
 	log.L().Info("", zap.Any("payload", req), zap.String("request", "GetMasterCfg"))
 
+	if _, err := s.authorizeRPC(ctx, "GetMasterCfg"); err != nil {
+		return nil, err
+	}
+
 	var err error
 	resp := &pb.GetMasterCfgResponse{}
 	resp.Cfg, err = s.cfg.Toml()
@@ -2593,7 +2655,7 @@ func (s *Server) GetCfg(ctx context.Context, req *pb.GetCfgRequest) (*pb.GetCfgR
 			return resp2, nil
 		}
 		defer grpcConn.Close()
-		masterResp, err := masterClient.GetMasterCfg(ctx, &pb.GetMasterCfgRequest{})
+		masterResp, err := masterClient.GetMasterCfg(forwardCredential(ctx), &pb.GetMasterCfgRequest{})
 		if err != nil {
 			resp2.Msg = err.Error()
 			// nolint:nilerr
@@ -2655,6 +2717,18 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*
 	if shouldRet {
 		return resp2, err2
 	}
+	return s.handleError(ctx, req), nil
+}
+
+// handleError handles the error (or the DDLs waiting for approval) of the task.
+func (s *Server) handleError(ctx context.Context, req *pb.HandleErrorRequest) *pb.HandleErrorResponse {
+	// the DDLs held in the shard DDL locks are approved or skipped in DM-master,
+	// they are not at any binlog position where the subtasks are paused.
+	if (req.Op == pb.ErrorOp_Approve || req.Op == pb.ErrorOp_Skip) && req.BinlogPos == "" {
+		if resp, ok := s.approveShardDDLLocks(req.Task, req.Op == pb.ErrorOp_Skip); ok {
+			return resp
+		}
+	}
 
 	sources := req.Sources
 	if len(sources) == 0 {
@@ -2664,7 +2738,7 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*
 			return &pb.HandleErrorResponse{
 				Result: false,
 				Msg:    fmt.Sprintf("task %s has no source or not exist, please check the task name and status", req.Task),
-			}, nil
+			}
 		}
 	}
 
@@ -2715,7 +2789,44 @@ func (s *Server) HandleError(ctx context.Context, req *pb.HandleErrorRequest) (*
 	return &pb.HandleErrorResponse{
 		Result:  true,
 		Sources: workerResps,
-	}, nil
+	}
+}
+
+// approveShardDDLLocks approves or skips the DDLs waiting for approval in the shard DDL locks of the task,
+// it returns false if no shard DDL lock of the task is waiting for approval.
+func (s *Server) approveShardDDLLocks(task string, skip bool) (*pb.HandleErrorResponse, bool) {
+	var (
+		shardMode string
+		lockIDs   []string
+		approve   func(id string, skip bool) error
+	)
+	// subtasks should have same ShardMode
+	for _, subtask := range s.scheduler.GetSubTaskCfgsByTask(task) {
+		shardMode = subtask.ShardMode
+	}
+	switch shardMode {
+	case config.ShardPessimistic:
+		lockIDs, approve = s.pessimist.WaitingApprovalLocks(task), s.pessimist.ApproveLock
+	case config.ShardOptimistic:
+		lockIDs, approve = s.optimist.WaitingApprovalLocks(task), s.optimist.ApproveLock
+	}
+	if len(lockIDs) == 0 {
+		return nil, false
+	}
+
+	for _, id := range lockIDs {
+		if err := approve(id, skip); err != nil {
+			return &pb.HandleErrorResponse{Msg: err.Error()}, true
+		}
+	}
+	action := "approved"
+	if skip {
+		action = "skipped"
+	}
+	return &pb.HandleErrorResponse{
+		Result: true,
+		Msg:    fmt.Sprintf("the DDLs in shard DDL locks %v are %s", lockIDs, action),
+	}, true
 }
 
 // TransferSource implements MasterServer.TransferSource.
@@ -2803,6 +2914,15 @@ func (s *Server) sharedLogic(ctx context.Context, req interface{}, respPointer i
 
 	log.L().Info("", zap.Any("payload", req), zap.String("request", methodName))
 
+	p, err := s.authorizeRPC(ctx, methodName)
+	if err != nil {
+		s.auditRPC(p, methodName, req, err)
+		respType := reflect.ValueOf(respPointer).Elem().Type()
+		reflect.ValueOf(respPointer).Elem().Set(reflect.Zero(respType))
+		*errPointer = err
+		return true
+	}
+
 	// origin code:
 	//  isLeader, needForward := s.isLeaderAndNeedForward()
 	//	if !isLeader {
@@ -2813,12 +2933,13 @@ func (s *Server) sharedLogic(ctx context.Context, req interface{}, respPointer i
 	//	}
 	isLeader, needForward := s.isLeaderAndNeedForward(ctx)
 	if isLeader {
+		s.auditRPC(p, methodName, req, nil)
 		return false
 	}
 	if needForward {
 		log.L().Info("will forward after a short interval", zap.String("from", s.cfg.Name), zap.String("to", s.leader.Load()), zap.String("request", methodName))
 		time.Sleep(100 * time.Millisecond)
-		params := []reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)}
+		params := []reflect.Value{reflect.ValueOf(forwardCredential(ctx)), reflect.ValueOf(req)}
 		results := reflect.ValueOf(s.leaderClient).MethodByName(methodName).Call(params)
 		// result's inner types should be (*pb.XXResponse, error), which is same as s.leaderClient.XXRPCMethod
 		reflect.ValueOf(respPointer).Elem().Set(results[0])
@@ -3413,6 +3534,7 @@ func (s *Server) ListTaskConfigs(ctx context.Context, req *emptypb.Empty) (*pb.L
 
 	subtaskCfgsOfTasks := s.scheduler.GetSubTaskCfgs()
 	contents := make(map[string]string, len(subtaskCfgsOfTasks))
+	canViewSecrets := s.canViewSecretsInRPC(ctx)
 	for taskName, subtaskCfgMap := range subtaskCfgsOfTasks {
 		subtaskCfgs := make([]*config.SubTaskConfig, 0, len(subtaskCfgMap))
 		for sourceID := range subtaskCfgMap {
@@ -3423,6 +3545,9 @@ func (s *Server) ListTaskConfigs(ctx context.Context, req *emptypb.Empty) (*pb.L
 			return subtaskCfgs[i].SourceID < subtaskCfgs[j].SourceID
 		})
 		taskCfg := config.SubTaskConfigsToTaskConfig(subtaskCfgs...)
+		if !canViewSecrets {
+			taskCfg.TargetDB.Password = config.ObfuscatedPasswordForFeedback
+		}
 		content, err := taskCfg.YamlForDowngrade()
 		if err != nil {
 			// nolint:nilerr
@@ -3451,7 +3576,12 @@ func (s *Server) ListSourceConfigs(ctx context.Context, req *emptypb.Empty) (*pb
 
 	sourceCfgs := s.scheduler.GetSourceCfgs()
 	contents := make(map[string]string, len(sourceCfgs))
+	canViewSecrets := s.canViewSecretsInRPC(ctx)
 	for sourceID, cfg := range sourceCfgs {
+		if !canViewSecrets {
+			cfg = cfg.Clone()
+			cfg.From.Password = config.ObfuscatedPasswordForFeedback
+		}
 		yamlContent, err := cfg.YamlForDowngrade()
 		if err != nil {
 			// nolint:nilerr
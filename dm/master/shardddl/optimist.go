@@ -50,6 +50,17 @@ type Optimist struct {
 	cli *clientv3.Client
 	lk  *optimism.LockKeeper
 	tk  *optimism.TableKeeper
+
+	// the shard DDL lock operations held until their DDLs are approved.
+	// lockID -> source -> upstream-schema-name -> upstream-table-name -> held operation.
+	heldOps map[string]map[string]map[string]map[string]heldOperation
+}
+
+// heldOperation is a shard DDL lock operation held until its DDLs are approved.
+type heldOperation struct {
+	op         optimism.Operation
+	info       optimism.Info
+	infoModRev int64
 }
 
 // NewOptimist creates a new Optimist instance.
@@ -61,6 +72,7 @@ func NewOptimist(pLogger *log.Logger, getDownstreamMetaFunc func(string) (*dbcon
 		closed:      true,
 		lk:          optimism.NewLockKeeper(getDownstreamMetaFunc),
 		tk:          optimism.NewTableKeeper(),
+		heldOps:     make(map[string]map[string]map[string]map[string]heldOperation),
 	}
 }
 
@@ -320,7 +332,9 @@ func (o *Optimist) RemoveMetaDataWithTask(task string) error {
 	}
 	for _, info := range infos {
 		o.lk.RemoveLockByInfo(info)
-		lockIDSet[utils.GenDDLLockID(info.Task, info.DownSchema, info.DownTable)] = struct{}{}
+		lockID := utils.GenDDLLockID(info.Task, info.DownSchema, info.DownTable)
+		lockIDSet[lockID] = struct{}{}
+		delete(o.heldOps, lockID)
 	}
 	for _, op := range ops {
 		o.lk.RemoveLock(op.ID)
@@ -399,6 +413,7 @@ func (o *Optimist) run(ctx context.Context, revSource, revInfo, revOperation int
 // rebuildLocks rebuilds shard DDL locks from etcd persistent data.
 func (o *Optimist) rebuildLocks() (revSource, revInfo, revOperation int64, err error) {
 	o.lk.Clear() // clear all previous locks to support re-Start.
+	o.heldOps = make(map[string]map[string]map[string]map[string]heldOperation)
 
 	// get the history & initial source tables.
 	stm, revSource, err := optimism.GetAllSourceTables(o.cli)
@@ -487,6 +502,10 @@ func (o *Optimist) recoverLocks(
 		if !o.tk.SourceTableExist(info.Task, info.Source, info.UpSchema, info.UpTable, info.DownSchema, info.DownTable) {
 			continue
 		}
+		// the operation putted after the info means the DDLs have been approved before.
+		if op, ok := opm[info.Task][info.Source][info.UpSchema][info.UpTable]; ok && op.Revision > info.Revision {
+			info.NeedApproval = false
+		}
 		// never mark the lock operation from `done` to `not-done` when recovering.
 		err := o.handleInfo(info, true)
 		if err != nil {
@@ -796,6 +815,11 @@ func (o *Optimist) handleLock(info optimism.Info, tts []optimism.TargetTable, sk
 	}
 
 	op := optimism.NewOperation(lockID, lock.Task, info.Source, info.UpSchema, info.UpTable, newDDLs, cfStage, cfMsg, false, cols)
+	if info.NeedApproval && cfStage == optimism.ConflictNone && len(newDDLs) > 0 {
+		o.holdOperation(op, info)
+		o.logger.Info("hold shard DDL lock operation until the DDLs are approved", zap.String("lock", lockID), zap.Stringer("operation", op))
+		return nil
+	}
 	rev, succ, err := optimism.PutOperation(o.cli, skipDone, op, info.Revision)
 	if err != nil {
 		return err
@@ -852,10 +876,89 @@ func (o *Optimist) removeLock(lock *optimism.Lock) (bool, error) {
 		return false, nil
 	}
 	o.lk.RemoveLock(lock.ID)
+	delete(o.heldOps, lock.ID)
 	metrics.ReportDDLPending(lock.Task, metrics.DDLPendingSynced, metrics.DDLPendingNone)
 	return true, nil
 }
 
+// holdOperation holds the shard DDL lock operation until its DDLs are approved.
+func (o *Optimist) holdOperation(op optimism.Operation, info optimism.Info) {
+	if _, ok := o.heldOps[op.ID]; !ok {
+		o.heldOps[op.ID] = make(map[string]map[string]map[string]heldOperation)
+	}
+	if _, ok := o.heldOps[op.ID][op.Source]; !ok {
+		o.heldOps[op.ID][op.Source] = make(map[string]map[string]heldOperation)
+	}
+	if _, ok := o.heldOps[op.ID][op.Source][op.UpSchema]; !ok {
+		o.heldOps[op.ID][op.Source][op.UpSchema] = make(map[string]heldOperation)
+	}
+	o.heldOps[op.ID][op.Source][op.UpSchema][op.UpTable] = heldOperation{op: op, info: info, infoModRev: info.Revision}
+}
+
+// WaitingApprovalLocks returns the IDs of the shard DDL locks of the task which are waiting for approval.
+func (o *Optimist) WaitingApprovalLocks(task string) []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var ids []string
+	for id := range o.heldOps {
+		if utils.ExtractTaskFromLockID(id) == task {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ApproveLock approves or skips the DDLs waiting for approval in a shard DDL lock.
+// the held operations are putted with the DDLs when approved, or without the DDLs when skipped.
+// the table infos of the skipped tables are reverted in the lock, because the DDLs are not
+// applied to the downstream.
+func (o *Optimist) ApproveLock(id string, skip bool) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return terror.ErrMasterOptimistNotStarted.Generate()
+	}
+	lock := o.lk.FindLock(id)
+	if lock == nil {
+		delete(o.heldOps, id)
+		return terror.ErrMasterLockNotFound.Generate(id)
+	}
+	held, ok := o.heldOps[id]
+	if !ok {
+		return terror.ErrMasterLockNotWaitApproval.Generate(id)
+	}
+
+	for source, schemaTables := range held {
+		for schema, tables := range schemaTables {
+			for table, h := range tables {
+				op := h.op
+				if skip {
+					op.DDLs = op.DDLs[:0]
+					op.Cols = nil
+					op.Skipped = true
+					lock.RevertTable(h.info)
+				}
+				rev, succ, err := optimism.PutOperation(o.cli, false, op, h.infoModRev)
+				if err != nil {
+					return err
+				}
+				delete(tables, table)
+				o.logger.Info("put approved shard DDL lock operation", zap.String("lock", id), zap.Bool("skip", skip),
+					zap.Stringer("operation", op), zap.Bool("already exist", !succ), zap.Int64("revision", rev))
+			}
+			if len(tables) == 0 {
+				delete(schemaTables, schema)
+			}
+		}
+		if len(schemaTables) == 0 {
+			delete(held, source)
+		}
+	}
+	delete(o.heldOps, id)
+	return nil
+}
+
 // deleteInfosOps DELETEs shard DDL lock info and operations.
 func (o *Optimist) deleteInfosOps(lock *optimism.Lock) (bool, error) {
 	infos := make([]optimism.Info, 0)
@@ -26,11 +26,13 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/util/dbutil"
 	"github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/pingcap/tidb/pkg/util/schemacmp"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pb"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/shardddl/optimism"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -786,6 +788,109 @@ func (t *testOptimistSuite) TestOptimistLockConflict() {
 	require.Equal(t.T(), 0, len(errCh))
 }
 
+func (t *testOptimistSuite) TestOptimistApproveLock() {
+	var (
+		watchTimeout       = 5 * time.Second
+		logger             = log.L()
+		o                  = NewOptimist(&logger, getDownstreamMeta)
+		task               = "task-test-optimist-approve"
+		source1            = "mysql-replica-1"
+		downSchema         = "foo"
+		downTable          = "bar"
+		ID                 = fmt.Sprintf("%s-`%s`.`%s`", task, downSchema, downTable)
+		st1                = optimism.NewSourceTables(task, source1)
+		p                  = parser.New()
+		se                 = mock.NewContext()
+		tblID        int64 = 222
+		DDLs1              = []string{"ALTER TABLE bar ADD COLUMN c1 TEXT"}
+		DDLs2              = []string{"ALTER TABLE bar ADD COLUMN c2 INT"}
+		ti0                = createTableInfo(t.T(), p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY)`)
+		ti1                = createTableInfo(t.T(), p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 TEXT)`)
+		DDLs3              = []string{"ALTER TABLE bar ADD COLUMN c3 INT"}
+		ti2                = createTableInfo(t.T(), p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 TEXT, c2 INT)`)
+		ti3                = createTableInfo(t.T(), p, se, tblID, `CREATE TABLE bar (id INT PRIMARY KEY, c1 TEXT, c3 INT)`)
+		i1                 = optimism.NewInfo(task, source1, "foo", "bar-1", downSchema, downTable, DDLs1, ti0, []*model.TableInfo{ti1})
+		i2                 = optimism.NewInfo(task, source1, "foo", "bar-1", downSchema, downTable, DDLs2, ti1, []*model.TableInfo{ti2})
+		// the DDLs in i2 are skipped, so the table info before the next DDL is ti1 rather than ti2.
+		i3 = optimism.NewInfo(task, source1, "foo", "bar-1", downSchema, downTable, DDLs3, ti1, []*model.TableInfo{ti3})
+	)
+	i1.NeedApproval = true
+	i2.NeedApproval = true
+
+	st1.AddTable("foo", "bar-1", downSchema, downTable)
+	_, err := optimism.PutSourceTables(t.etcdTestCli, st1)
+	require.NoError(t.T(), err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		o.Close()
+	}()
+
+	require.True(t.T(), terror.ErrMasterOptimistNotStarted.Equal(o.ApproveLock(ID, false)))
+	require.NoError(t.T(), o.Start(ctx, t.etcdTestCli))
+	require.True(t.T(), terror.ErrMasterLockNotFound.Equal(o.ApproveLock(ID, false)))
+
+	for _, c := range []struct {
+		info optimism.Info
+		skip bool
+		ddls []string
+	}{
+		{i1, false, DDLs1},
+		{i2, true, []string{}},
+	} {
+		// PUT the info, the operation is held until approved.
+		rev, err2 := optimism.PutInfo(t.etcdTestCli, c.info)
+		require.NoError(t.T(), err2)
+		require.Eventually(t.T(), func() bool {
+			return len(o.WaitingApprovalLocks(task)) == 1
+		}, watchTimeout, 100*time.Millisecond)
+		require.Equal(t.T(), []string{ID}, o.WaitingApprovalLocks(task))
+		op, _, err2 := optimism.GetOperation(t.etcdTestCli, task, source1, "foo", "bar-1")
+		require.NoError(t.T(), err2)
+		require.Less(t.T(), op.Revision, rev, "the operation should not be put before approved")
+
+		// approve (or skip) the DDLs, the held operation is put with (or without) the DDLs.
+		require.NoError(t.T(), o.ApproveLock(ID, c.skip))
+		require.Len(t.T(), o.WaitingApprovalLocks(task), 0)
+		require.True(t.T(), terror.ErrMasterLockNotWaitApproval.Equal(o.ApproveLock(ID, c.skip)))
+		ctx2, cancel2 := context.WithTimeout(ctx, watchTimeout)
+		op, err2 = watchExactOneOperation(ctx2, t.etcdTestCli, task, source1, "foo", "bar-1", rev)
+		cancel2()
+		require.NoError(t.T(), err2)
+		require.Equal(t.T(), c.ddls, op.DDLs)
+		require.Equal(t.T(), c.skip, op.Skipped)
+		require.Equal(t.T(), optimism.ConflictNone, op.ConflictStage)
+	}
+
+	// the table info of the skipped DDLs is reverted in the lock.
+	lock := o.Locks()[ID]
+	require.NotNil(t.T(), lock)
+	joined, err := lock.Joined()
+	require.NoError(t.T(), err)
+	cmp, err := joined.Compare(schemacmp.Encode(ti1))
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), 0, cmp)
+	synced, remain := lock.IsSynced()
+	require.True(t.T(), synced)
+	require.Equal(t.T(), 0, remain)
+
+	// a DDL after the skipped DDLs is computed against the reverted table info.
+	rev, err := optimism.PutInfo(t.etcdTestCli, i3)
+	require.NoError(t.T(), err)
+	ctx2, cancel2 := context.WithTimeout(ctx, watchTimeout)
+	op, err := watchExactOneOperation(ctx2, t.etcdTestCli, task, source1, "foo", "bar-1", rev)
+	cancel2()
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), DDLs3, op.DDLs)
+	require.False(t.T(), op.Skipped)
+	joined, err = lock.Joined()
+	require.NoError(t.T(), err)
+	cmp, err = joined.Compare(schemacmp.Encode(ti3))
+	require.NoError(t.T(), err)
+	require.Equal(t.T(), 0, cmp)
+}
+
 func (t *testOptimistSuite) TestOptimistLockMultipleTarget() {
 	var (
 		tick               = 100 * time.Millisecond
@@ -383,6 +383,45 @@ func (p *Pessimist) UnlockLock(ctx context.Context, id, replaceOwner string, for
 	return nil
 }
 
+// WaitingApprovalLocks returns the IDs of the synced shard DDL locks of the task which are waiting for approval.
+func (p *Pessimist) WaitingApprovalLocks(task string) []string {
+	var ids []string
+	for _, lock := range p.lk.Locks() {
+		if lock.Task != task || !lock.WaitingApproval() {
+			continue
+		}
+		if synced, _ := lock.IsSynced(); synced {
+			ids = append(ids, lock.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ApproveLock approves or skips the DDLs of a synced shard DDL lock which is waiting for approval.
+// when approved, the owner executes the DDLs, otherwise the owner also skips the DDLs,
+// and then the non-owner sources skip them as usual.
+func (p *Pessimist) ApproveLock(id string, skip bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return terror.ErrMasterPessimistNotStarted.Generate()
+	}
+
+	p.infoOpMu.Lock()
+	defer p.infoOpMu.Unlock()
+	lock := p.lk.FindLock(id)
+	if lock == nil {
+		return terror.ErrMasterLockNotFound.Generate(id)
+	}
+	if synced, _ := lock.IsSynced(); !synced || !lock.WaitingApproval() {
+		return terror.ErrMasterLockNotWaitApproval.Generate(id)
+	}
+
+	lock.Approve(skip)
+	return p.handleLock(id, "")
+}
+
 // RemoveMetaData removes meta data for a specified task
 // NOTE: this function can only be used when the specified task is not running.
 func (p *Pessimist) RemoveMetaData(task string) error {
@@ -424,6 +463,8 @@ func (p *Pessimist) recoverLocks(ifm map[string]map[string]pessimism.Info, opm m
 	}
 
 	// update locks based on the lock operation.
+	// lockID -> whether the DDLs are skipped after approval, the owner has no `exec` operation then.
+	skipped := make(map[string]bool)
 	for _, ops := range opm {
 		for source, op := range ops {
 			lock := p.lk.FindLock(op.ID)
@@ -438,7 +479,11 @@ func (p *Pessimist) recoverLocks(ifm map[string]map[string]pessimism.Info, opm m
 			if op.Done {
 				lock.MarkDone(source)
 			}
+			if _, ok := skipped[lock.ID]; !ok {
+				skipped[lock.ID] = true
+			}
 			if op.Exec {
+				skipped[lock.ID] = false
 				// restore the role of `owner` based on `exec` operation.
 				// This is needed because `TrySync` can only set `owner` for the first call of the lock.
 				p.logger.Info("restore the role of owner for the shard DDL lock", zap.String("lock", op.ID), zap.String("from", lock.Owner), zap.String("to", op.Source))
@@ -447,6 +492,11 @@ func (p *Pessimist) recoverLocks(ifm map[string]map[string]pessimism.Info, opm m
 		}
 	}
 
+	// the operations are putted after the DDLs are approved.
+	for lockID, skip := range skipped {
+		p.lk.FindLock(lockID).Approve(skip)
+	}
+
 	// try to handle locks.
 	for _, lock := range p.lk.Locks() {
 		synced, remain := lock.IsSynced()
@@ -600,18 +650,25 @@ func (p *Pessimist) handleLock(lockID, source string) error {
 		return nil
 	}
 
+	// hold the lock until the DDLs are approved or skipped.
+	if lock.WaitingApproval() {
+		p.logger.Info("the shard DDL lock is waiting for approval", zap.String("lock", lock.ID), zap.Strings("ddls", lock.DDLs))
+		return nil
+	}
+
 	// put `exec=true` for the owner and skip it if already existing.
 	return p.putOpForOwner(lock, lock.Owner, true)
 }
 
 // putOpForOwner PUTs the shard DDL lock operation for the owner into etcd.
 func (p *Pessimist) putOpForOwner(lock *pessimism.Lock, owner string, skipDone bool) error {
-	op := pessimism.NewOperation(lock.ID, lock.Task, owner, lock.DDLs, true, false)
+	// the owner skips the DDLs too if they are skipped after approval.
+	op := pessimism.NewOperation(lock.ID, lock.Task, owner, lock.DDLs, !lock.IsSkipped(), false)
 	rev, succ, err := pessimism.PutOperations(p.cli, skipDone, op)
 	if err != nil {
 		return err
 	}
-	p.logger.Info("put exec shard DDL lock operation for the owner", zap.String("lock", lock.ID), zap.String("owner", lock.Owner), zap.Bool("already done", !succ), zap.Int64("revision", rev))
+	p.logger.Info("put shard DDL lock operation for the owner", zap.String("lock", lock.ID), zap.String("owner", lock.Owner), zap.Bool("exec", op.Exec), zap.Bool("already done", !succ), zap.Int64("revision", rev))
 	return nil
 }
 
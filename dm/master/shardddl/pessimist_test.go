@@ -625,6 +625,78 @@ func (t *testPessimistSuite) TestUnlockSourceMissBeforeSynced() {
 	t.noLockExist(p)
 }
 
+func (t *testPessimistSuite) TestApproveLock() {
+	var (
+		watchTimeout  = 3 * time.Second
+		task          = "task-approve-lock"
+		source1       = "mysql-replica-1"
+		source2       = "mysql-replica-2"
+		schema, table = "foo", "bar"
+		DDLs          = []string{"ALTER TABLE bar DROP COLUMN c1"}
+		ID            = fmt.Sprintf("%s-`%s`.`%s`", task, schema, table)
+		i11           = pessimism.NewInfo(task, source1, schema, table, DDLs)
+		i12           = pessimism.NewInfo(task, source2, schema, table, DDLs)
+
+		sources = func(string) []string {
+			return []string{source1, source2}
+		}
+		logger = log.L()
+		p      = NewPessimist(&logger, sources)
+	)
+	i11.NeedApproval = true
+	i12.NeedApproval = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.True(t.T(), terror.ErrMasterPessimistNotStarted.Equal(p.ApproveLock(ID, false)))
+	require.NoError(t.T(), p.Start(ctx, t.etcdTestCli))
+	defer p.Close()
+	require.True(t.T(), terror.ErrMasterLockNotFound.Equal(p.ApproveLock(ID, false)))
+
+	for _, skip := range []bool{false, true} {
+		// 1. PUT i11, the lock is not synced and can't be approved.
+		_, err := pessimism.PutInfo(t.etcdTestCli, i11)
+		require.NoError(t.T(), err)
+		require.Eventually(t.T(), func() bool {
+			return len(p.Locks()) == 1
+		}, 3*time.Second, 100*time.Millisecond)
+		require.Len(t.T(), p.WaitingApprovalLocks(task), 0)
+		require.True(t.T(), terror.ErrMasterLockNotWaitApproval.Equal(p.ApproveLock(ID, skip)))
+
+		// 2. PUT i12, the lock is synced but no operation is putted before approved.
+		rev1, err := pessimism.PutInfo(t.etcdTestCli, i12)
+		require.NoError(t.T(), err)
+		require.Eventually(t.T(), func() bool {
+			return len(p.WaitingApprovalLocks(task)) == 1
+		}, 3*time.Second, 100*time.Millisecond)
+		require.Equal(t.T(), []string{ID}, p.WaitingApprovalLocks(task))
+		opm, _, err := pessimism.GetAllOperations(t.etcdTestCli)
+		require.NoError(t.T(), err)
+		require.Len(t.T(), opm, 0)
+
+		// 3. approve (or skip) the lock, the owner executes (or skips) the DDLs and the non-owner skips them.
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			t.putDoneForSource(ctx, task, source1, i11, !skip, rev1+1, watchTimeout)
+		}()
+		go func() {
+			defer wg.Done()
+			t.putDoneForSource(ctx, task, source2, i12, false, rev1+1, watchTimeout)
+		}()
+		require.NoError(t.T(), p.ApproveLock(ID, skip))
+		require.Len(t.T(), p.WaitingApprovalLocks(task), 0)
+		wg.Wait()
+
+		require.Eventually(t.T(), func() bool {
+			return len(p.Locks()) == 0
+		}, 3*time.Second, 100*time.Millisecond)
+		t.noLockExist(p)
+	}
+}
+
 func (t *testPessimistSuite) TestUnlockSourceInterrupt() {
 	// operations may be done but not be deleted, and then interrupted.
 
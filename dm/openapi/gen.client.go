@@ -248,6 +248,38 @@ type ClientInterface interface {
 	DMAPIStopTaskWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	DMAPIStopTask(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIApproveTaskDDL request with any body
+	DMAPIApproveTaskDDLWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPIApproveTaskDDL(ctx context.Context, taskName string, body DMAPIApproveTaskDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPISkipTaskDDL request with any body
+	DMAPISkipTaskDDLWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPISkipTaskDDL(ctx context.Context, taskName string, body DMAPISkipTaskDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetAPITokenList request
+	DMAPIGetAPITokenList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPICreateAPIToken request with any body
+	DMAPICreateAPITokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPICreateAPIToken(ctx context.Context, body DMAPICreateAPITokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIDeleteAPIToken request
+	DMAPIDeleteAPIToken(ctx context.Context, tokenName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIGetUserList request
+	DMAPIGetUserList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPICreateUser request with any body
+	DMAPICreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DMAPICreateUser(ctx context.Context, body DMAPICreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DMAPIDeleteUser request
+	DMAPIDeleteUser(ctx context.Context, userName string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) DMAPIGetClusterInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -946,6 +978,150 @@ func (c *Client) DMAPIStopTask(ctx context.Context, taskName string, body DMAPIS
 	return c.Client.Do(req)
 }
 
+func (c *Client) DMAPIApproveTaskDDLWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIApproveTaskDDLRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIApproveTaskDDL(ctx context.Context, taskName string, body DMAPIApproveTaskDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIApproveTaskDDLRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPISkipTaskDDLWithBody(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPISkipTaskDDLRequestWithBody(c.Server, taskName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPISkipTaskDDL(ctx context.Context, taskName string, body DMAPISkipTaskDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPISkipTaskDDLRequest(c.Server, taskName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetAPITokenList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetAPITokenListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPICreateAPITokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPICreateAPITokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPICreateAPIToken(ctx context.Context, body DMAPICreateAPITokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPICreateAPITokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIDeleteAPIToken(ctx context.Context, tokenName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIDeleteAPITokenRequest(c.Server, tokenName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIGetUserList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIGetUserListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPICreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPICreateUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPICreateUser(ctx context.Context, body DMAPICreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPICreateUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DMAPIDeleteUser(ctx context.Context, userName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDMAPIDeleteUserRequest(c.Server, userName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewDMAPIGetClusterInfoRequest generates requests for DMAPIGetClusterInfo
 func NewDMAPIGetClusterInfoRequest(server string) (*http.Request, error) {
 	var err error
@@ -2803,98 +2979,394 @@ func NewDMAPIStopTaskRequestWithBody(server string, taskName string, contentType
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewDMAPIApproveTaskDDLRequest calls the generic DMAPIApproveTaskDDL builder with application/json body
+func NewDMAPIApproveTaskDDLRequest(server string, taskName string, body DMAPIApproveTaskDDLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPIApproveTaskDDLRequestWithBody(server, taskName, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewDMAPIApproveTaskDDLRequestWithBody generates requests for DMAPIApproveTaskDDL with any type of body
+func NewDMAPIApproveTaskDDLRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// DMAPIGetClusterInfo request
-	DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error)
 
-	// DMAPIUpdateClusterInfo request with any body
-	DMAPIUpdateClusterInfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/ddl-approval/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	DMAPIUpdateClusterInfoWithResponse(ctx context.Context, body DMAPIUpdateClusterInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetClusterMasterList request
-	DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIOfflineMasterNode request
-	DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// DMAPIGetClusterWorkerList request
-	DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error)
+	return req, nil
+}
 
-	// DMAPIOfflineWorkerNode request
-	DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error)
+// NewDMAPISkipTaskDDLRequest calls the generic DMAPISkipTaskDDL builder with application/json body
+func NewDMAPISkipTaskDDLRequest(server string, taskName string, body DMAPISkipTaskDDLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPISkipTaskDDLRequestWithBody(server, taskName, "application/json", bodyReader)
+}
 
-	// GetDocJSON request
-	GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error)
+// NewDMAPISkipTaskDDLRequestWithBody generates requests for DMAPISkipTaskDDL with any type of body
+func NewDMAPISkipTaskDDLRequestWithBody(server string, taskName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetDocHTML request
-	GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error)
+	var pathParam0 string
 
-	// DMAPIGetSourceList request
-	DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "task-name", runtime.ParamLocationPath, taskName)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPICreateSource request with any body
-	DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/tasks/%s/ddl-approval/skip", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DMAPIDeleteSource request
-	DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIGetSource request
-	DMAPIGetSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIGetSourceParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// DMAPIUpdateSource request with any body
-	DMAPIUpdateSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	DMAPIUpdateSourceWithResponse(ctx context.Context, sourceName string, body DMAPIUpdateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error)
+	return req, nil
+}
 
-	// DMAPIDisableSource request
-	DMAPIDisableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIDisableSourceResponse, error)
+// NewDMAPIGetAPITokenListRequest generates requests for DMAPIGetAPITokenList
+func NewDMAPIGetAPITokenListRequest(server string) (*http.Request, error) {
+	var err error
 
-	// DMAPIEnableSource request
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPICreateAPITokenRequest calls the generic DMAPICreateAPIToken builder with application/json body
+func NewDMAPICreateAPITokenRequest(server string, body DMAPICreateAPITokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPICreateAPITokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDMAPICreateAPITokenRequestWithBody generates requests for DMAPICreateAPIToken with any type of body
+func NewDMAPICreateAPITokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIDeleteAPITokenRequest generates requests for DMAPIDeleteAPIToken
+func NewDMAPIDeleteAPITokenRequest(server string, tokenName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token-name", runtime.ParamLocationPath, tokenName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPIGetUserListRequest generates requests for DMAPIGetUserList
+func NewDMAPIGetUserListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDMAPICreateUserRequest calls the generic DMAPICreateUser builder with application/json body
+func NewDMAPICreateUserRequest(server string, body DMAPICreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDMAPICreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDMAPICreateUserRequestWithBody generates requests for DMAPICreateUser with any type of body
+func NewDMAPICreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDMAPIDeleteUserRequest generates requests for DMAPIDeleteUser
+func NewDMAPIDeleteUserRequest(server string, userName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "user-name", runtime.ParamLocationPath, userName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// DMAPIGetClusterInfo request
+	DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error)
+
+	// DMAPIUpdateClusterInfo request with any body
+	DMAPIUpdateClusterInfoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error)
+
+	DMAPIUpdateClusterInfoWithResponse(ctx context.Context, body DMAPIUpdateClusterInfoJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateClusterInfoResponse, error)
+
+	// DMAPIGetClusterMasterList request
+	DMAPIGetClusterMasterListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterMasterListResponse, error)
+
+	// DMAPIOfflineMasterNode request
+	DMAPIOfflineMasterNodeWithResponse(ctx context.Context, masterName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineMasterNodeResponse, error)
+
+	// DMAPIGetClusterWorkerList request
+	DMAPIGetClusterWorkerListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterWorkerListResponse, error)
+
+	// DMAPIOfflineWorkerNode request
+	DMAPIOfflineWorkerNodeWithResponse(ctx context.Context, workerName string, reqEditors ...RequestEditorFn) (*DMAPIOfflineWorkerNodeResponse, error)
+
+	// GetDocJSON request
+	GetDocJSONWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocJSONResponse, error)
+
+	// GetDocHTML request
+	GetDocHTMLWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDocHTMLResponse, error)
+
+	// DMAPIGetSourceList request
+	DMAPIGetSourceListWithResponse(ctx context.Context, params *DMAPIGetSourceListParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceListResponse, error)
+
+	// DMAPICreateSource request with any body
+	DMAPICreateSourceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+
+	DMAPICreateSourceWithResponse(ctx context.Context, body DMAPICreateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateSourceResponse, error)
+
+	// DMAPIDeleteSource request
+	DMAPIDeleteSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIDeleteSourceParams, reqEditors ...RequestEditorFn) (*DMAPIDeleteSourceResponse, error)
+
+	// DMAPIGetSource request
+	DMAPIGetSourceWithResponse(ctx context.Context, sourceName string, params *DMAPIGetSourceParams, reqEditors ...RequestEditorFn) (*DMAPIGetSourceResponse, error)
+
+	// DMAPIUpdateSource request with any body
+	DMAPIUpdateSourceWithBodyWithResponse(ctx context.Context, sourceName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error)
+
+	DMAPIUpdateSourceWithResponse(ctx context.Context, sourceName string, body DMAPIUpdateSourceJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateSourceResponse, error)
+
+	// DMAPIDisableSource request
+	DMAPIDisableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIDisableSourceResponse, error)
+
+	// DMAPIEnableSource request
 	DMAPIEnableSourceWithResponse(ctx context.Context, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIEnableSourceResponse, error)
 
 	// DMAPIDisableRelay request with any body
@@ -3004,6 +3476,38 @@ type ClientWithResponsesInterface interface {
 	DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
 
 	DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error)
+
+	// DMAPIApproveTaskDDL request with any body
+	DMAPIApproveTaskDDLWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIApproveTaskDDLResponse, error)
+
+	DMAPIApproveTaskDDLWithResponse(ctx context.Context, taskName string, body DMAPIApproveTaskDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIApproveTaskDDLResponse, error)
+
+	// DMAPISkipTaskDDL request with any body
+	DMAPISkipTaskDDLWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPISkipTaskDDLResponse, error)
+
+	DMAPISkipTaskDDLWithResponse(ctx context.Context, taskName string, body DMAPISkipTaskDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPISkipTaskDDLResponse, error)
+
+	// DMAPIGetAPITokenList request
+	DMAPIGetAPITokenListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetAPITokenListResponse, error)
+
+	// DMAPICreateAPIToken request with any body
+	DMAPICreateAPITokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateAPITokenResponse, error)
+
+	DMAPICreateAPITokenWithResponse(ctx context.Context, body DMAPICreateAPITokenJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateAPITokenResponse, error)
+
+	// DMAPIDeleteAPIToken request
+	DMAPIDeleteAPITokenWithResponse(ctx context.Context, tokenName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteAPITokenResponse, error)
+
+	// DMAPIGetUserList request
+	DMAPIGetUserListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetUserListResponse, error)
+
+	// DMAPICreateUser request with any body
+	DMAPICreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateUserResponse, error)
+
+	DMAPICreateUserWithResponse(ctx context.Context, body DMAPICreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateUserResponse, error)
+
+	// DMAPIDeleteUser request
+	DMAPIDeleteUserWithResponse(ctx context.Context, userName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteUserResponse, error)
 }
 
 type DMAPIGetClusterInfoResponse struct {
@@ -3971,6 +4475,186 @@ func (r DMAPIStopTaskResponse) StatusCode() int {
 	return 0
 }
 
+type DMAPIApproveTaskDDLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIApproveTaskDDLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIApproveTaskDDLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPISkipTaskDDLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPISkipTaskDDLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPISkipTaskDDLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetAPITokenListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetAPITokenListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetAPITokenListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetAPITokenListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPICreateAPITokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateAPITokenResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPICreateAPITokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPICreateAPITokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDeleteAPITokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIDeleteAPITokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDeleteAPITokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIGetUserListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetUserListResponse
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIGetUserListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIGetUserListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPICreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPICreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPICreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DMAPIDeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorWithMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DMAPIDeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DMAPIDeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // DMAPIGetClusterInfoWithResponse request returning *DMAPIGetClusterInfoResponse
 func (c *ClientWithResponses) DMAPIGetClusterInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetClusterInfoResponse, error) {
 	rsp, err := c.DMAPIGetClusterInfo(ctx, reqEditors...)
@@ -4365,117 +5049,221 @@ func (c *ClientWithResponses) DMAPIUpdateTaskWithBodyWithResponse(ctx context.Co
 	return ParseDMAPIUpdateTaskResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
-	rsp, err := c.DMAPIUpdateTask(ctx, taskName, body, reqEditors...)
+func (c *ClientWithResponses) DMAPIUpdateTaskWithResponse(ctx context.Context, taskName string, body DMAPIUpdateTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIUpdateTaskResponse, error) {
+	rsp, err := c.DMAPIUpdateTask(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIUpdateTaskResponse(rsp)
+}
+
+// DMAPIGetTaskMigrateTargetsWithResponse request returning *DMAPIGetTaskMigrateTargetsResponse
+func (c *ClientWithResponses) DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error) {
+	rsp, err := c.DMAPIGetTaskMigrateTargets(ctx, taskName, sourceName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskMigrateTargetsResponse(rsp)
+}
+
+// DMAPIGetSchemaListByTaskAndSourceWithResponse request returning *DMAPIGetSchemaListByTaskAndSourceResponse
+func (c *ClientWithResponses) DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
+	rsp, err := c.DMAPIGetSchemaListByTaskAndSource(ctx, taskName, sourceName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp)
+}
+
+// DMAPIGetTableListByTaskAndSourceWithResponse request returning *DMAPIGetTableListByTaskAndSourceResponse
+func (c *ClientWithResponses) DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
+	rsp, err := c.DMAPIGetTableListByTaskAndSource(ctx, taskName, sourceName, schemaName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTableListByTaskAndSourceResponse(rsp)
+}
+
+// DMAPIDeleteTableStructureWithResponse request returning *DMAPIDeleteTableStructureResponse
+func (c *ClientWithResponses) DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error) {
+	rsp, err := c.DMAPIDeleteTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIDeleteTableStructureResponse(rsp)
+}
+
+// DMAPIGetTableStructureWithResponse request returning *DMAPIGetTableStructureResponse
+func (c *ClientWithResponses) DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error) {
+	rsp, err := c.DMAPIGetTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTableStructureResponse(rsp)
+}
+
+// DMAPIOperateTableStructureWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTableStructureResponse
+func (c *ClientWithResponses) DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
+	rsp, err := c.DMAPIOperateTableStructureWithBody(ctx, taskName, sourceName, schemaName, tableName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIOperateTableStructureResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
+	rsp, err := c.DMAPIOperateTableStructure(ctx, taskName, sourceName, schemaName, tableName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIOperateTableStructureResponse(rsp)
+}
+
+// DMAPIStartTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStartTaskResponse
+func (c *ClientWithResponses) DMAPIStartTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
+	rsp, err := c.DMAPIStartTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIStartTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIStartTaskWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
+	rsp, err := c.DMAPIStartTask(ctx, taskName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIStartTaskResponse(rsp)
+}
+
+// DMAPIGetTaskStatusWithResponse request returning *DMAPIGetTaskStatusResponse
+func (c *ClientWithResponses) DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error) {
+	rsp, err := c.DMAPIGetTaskStatus(ctx, taskName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIGetTaskStatusResponse(rsp)
+}
+
+// DMAPIStopTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStopTaskResponse
+func (c *ClientWithResponses) DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
+	rsp, err := c.DMAPIStopTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDMAPIStopTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
+	rsp, err := c.DMAPIStopTask(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIUpdateTaskResponse(rsp)
+	return ParseDMAPIStopTaskResponse(rsp)
 }
 
-// DMAPIGetTaskMigrateTargetsWithResponse request returning *DMAPIGetTaskMigrateTargetsResponse
-func (c *ClientWithResponses) DMAPIGetTaskMigrateTargetsWithResponse(ctx context.Context, taskName string, sourceName string, params *DMAPIGetTaskMigrateTargetsParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskMigrateTargetsResponse, error) {
-	rsp, err := c.DMAPIGetTaskMigrateTargets(ctx, taskName, sourceName, params, reqEditors...)
+// DMAPIApproveTaskDDLWithBodyWithResponse request with arbitrary body returning *DMAPIApproveTaskDDLResponse
+func (c *ClientWithResponses) DMAPIApproveTaskDDLWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIApproveTaskDDLResponse, error) {
+	rsp, err := c.DMAPIApproveTaskDDLWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskMigrateTargetsResponse(rsp)
+	return ParseDMAPIApproveTaskDDLResponse(rsp)
 }
 
-// DMAPIGetSchemaListByTaskAndSourceWithResponse request returning *DMAPIGetSchemaListByTaskAndSourceResponse
-func (c *ClientWithResponses) DMAPIGetSchemaListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, reqEditors ...RequestEditorFn) (*DMAPIGetSchemaListByTaskAndSourceResponse, error) {
-	rsp, err := c.DMAPIGetSchemaListByTaskAndSource(ctx, taskName, sourceName, reqEditors...)
+func (c *ClientWithResponses) DMAPIApproveTaskDDLWithResponse(ctx context.Context, taskName string, body DMAPIApproveTaskDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIApproveTaskDDLResponse, error) {
+	rsp, err := c.DMAPIApproveTaskDDL(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetSchemaListByTaskAndSourceResponse(rsp)
+	return ParseDMAPIApproveTaskDDLResponse(rsp)
 }
 
-// DMAPIGetTableListByTaskAndSourceWithResponse request returning *DMAPIGetTableListByTaskAndSourceResponse
-func (c *ClientWithResponses) DMAPIGetTableListByTaskAndSourceWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableListByTaskAndSourceResponse, error) {
-	rsp, err := c.DMAPIGetTableListByTaskAndSource(ctx, taskName, sourceName, schemaName, reqEditors...)
+// DMAPISkipTaskDDLWithBodyWithResponse request with arbitrary body returning *DMAPISkipTaskDDLResponse
+func (c *ClientWithResponses) DMAPISkipTaskDDLWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPISkipTaskDDLResponse, error) {
+	rsp, err := c.DMAPISkipTaskDDLWithBody(ctx, taskName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTableListByTaskAndSourceResponse(rsp)
+	return ParseDMAPISkipTaskDDLResponse(rsp)
 }
 
-// DMAPIDeleteTableStructureWithResponse request returning *DMAPIDeleteTableStructureResponse
-func (c *ClientWithResponses) DMAPIDeleteTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteTableStructureResponse, error) {
-	rsp, err := c.DMAPIDeleteTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
+func (c *ClientWithResponses) DMAPISkipTaskDDLWithResponse(ctx context.Context, taskName string, body DMAPISkipTaskDDLJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPISkipTaskDDLResponse, error) {
+	rsp, err := c.DMAPISkipTaskDDL(ctx, taskName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIDeleteTableStructureResponse(rsp)
+	return ParseDMAPISkipTaskDDLResponse(rsp)
 }
 
-// DMAPIGetTableStructureWithResponse request returning *DMAPIGetTableStructureResponse
-func (c *ClientWithResponses) DMAPIGetTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, reqEditors ...RequestEditorFn) (*DMAPIGetTableStructureResponse, error) {
-	rsp, err := c.DMAPIGetTableStructure(ctx, taskName, sourceName, schemaName, tableName, reqEditors...)
+// DMAPIGetAPITokenListWithResponse request returning *DMAPIGetAPITokenListResponse
+func (c *ClientWithResponses) DMAPIGetAPITokenListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetAPITokenListResponse, error) {
+	rsp, err := c.DMAPIGetAPITokenList(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTableStructureResponse(rsp)
+	return ParseDMAPIGetAPITokenListResponse(rsp)
 }
 
-// DMAPIOperateTableStructureWithBodyWithResponse request with arbitrary body returning *DMAPIOperateTableStructureResponse
-func (c *ClientWithResponses) DMAPIOperateTableStructureWithBodyWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
-	rsp, err := c.DMAPIOperateTableStructureWithBody(ctx, taskName, sourceName, schemaName, tableName, contentType, body, reqEditors...)
+// DMAPICreateAPITokenWithBodyWithResponse request with arbitrary body returning *DMAPICreateAPITokenResponse
+func (c *ClientWithResponses) DMAPICreateAPITokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateAPITokenResponse, error) {
+	rsp, err := c.DMAPICreateAPITokenWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIOperateTableStructureResponse(rsp)
+	return ParseDMAPICreateAPITokenResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIOperateTableStructureWithResponse(ctx context.Context, taskName string, sourceName string, schemaName string, tableName string, body DMAPIOperateTableStructureJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIOperateTableStructureResponse, error) {
-	rsp, err := c.DMAPIOperateTableStructure(ctx, taskName, sourceName, schemaName, tableName, body, reqEditors...)
+func (c *ClientWithResponses) DMAPICreateAPITokenWithResponse(ctx context.Context, body DMAPICreateAPITokenJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateAPITokenResponse, error) {
+	rsp, err := c.DMAPICreateAPIToken(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIOperateTableStructureResponse(rsp)
+	return ParseDMAPICreateAPITokenResponse(rsp)
 }
 
-// DMAPIStartTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStartTaskResponse
-func (c *ClientWithResponses) DMAPIStartTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
-	rsp, err := c.DMAPIStartTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+// DMAPIDeleteAPITokenWithResponse request returning *DMAPIDeleteAPITokenResponse
+func (c *ClientWithResponses) DMAPIDeleteAPITokenWithResponse(ctx context.Context, tokenName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteAPITokenResponse, error) {
+	rsp, err := c.DMAPIDeleteAPIToken(ctx, tokenName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIStartTaskResponse(rsp)
+	return ParseDMAPIDeleteAPITokenResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIStartTaskWithResponse(ctx context.Context, taskName string, body DMAPIStartTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStartTaskResponse, error) {
-	rsp, err := c.DMAPIStartTask(ctx, taskName, body, reqEditors...)
+// DMAPIGetUserListWithResponse request returning *DMAPIGetUserListResponse
+func (c *ClientWithResponses) DMAPIGetUserListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DMAPIGetUserListResponse, error) {
+	rsp, err := c.DMAPIGetUserList(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIStartTaskResponse(rsp)
+	return ParseDMAPIGetUserListResponse(rsp)
 }
 
-// DMAPIGetTaskStatusWithResponse request returning *DMAPIGetTaskStatusResponse
-func (c *ClientWithResponses) DMAPIGetTaskStatusWithResponse(ctx context.Context, taskName string, params *DMAPIGetTaskStatusParams, reqEditors ...RequestEditorFn) (*DMAPIGetTaskStatusResponse, error) {
-	rsp, err := c.DMAPIGetTaskStatus(ctx, taskName, params, reqEditors...)
+// DMAPICreateUserWithBodyWithResponse request with arbitrary body returning *DMAPICreateUserResponse
+func (c *ClientWithResponses) DMAPICreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPICreateUserResponse, error) {
+	rsp, err := c.DMAPICreateUserWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIGetTaskStatusResponse(rsp)
+	return ParseDMAPICreateUserResponse(rsp)
 }
 
-// DMAPIStopTaskWithBodyWithResponse request with arbitrary body returning *DMAPIStopTaskResponse
-func (c *ClientWithResponses) DMAPIStopTaskWithBodyWithResponse(ctx context.Context, taskName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
-	rsp, err := c.DMAPIStopTaskWithBody(ctx, taskName, contentType, body, reqEditors...)
+func (c *ClientWithResponses) DMAPICreateUserWithResponse(ctx context.Context, body DMAPICreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPICreateUserResponse, error) {
+	rsp, err := c.DMAPICreateUser(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIStopTaskResponse(rsp)
+	return ParseDMAPICreateUserResponse(rsp)
 }
 
-func (c *ClientWithResponses) DMAPIStopTaskWithResponse(ctx context.Context, taskName string, body DMAPIStopTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*DMAPIStopTaskResponse, error) {
-	rsp, err := c.DMAPIStopTask(ctx, taskName, body, reqEditors...)
+// DMAPIDeleteUserWithResponse request returning *DMAPIDeleteUserResponse
+func (c *ClientWithResponses) DMAPIDeleteUserWithResponse(ctx context.Context, userName string, reqEditors ...RequestEditorFn) (*DMAPIDeleteUserResponse, error) {
+	rsp, err := c.DMAPIDeleteUser(ctx, userName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDMAPIStopTaskResponse(rsp)
+	return ParseDMAPIDeleteUserResponse(rsp)
 }
 
 // ParseDMAPIGetClusterInfoResponse parses an HTTP response from a DMAPIGetClusterInfoWithResponse call
@@ -5702,3 +6490,235 @@ func ParseDMAPIStopTaskResponse(rsp *http.Response) (*DMAPIStopTaskResponse, err
 
 	return response, nil
 }
+
+// ParseDMAPIApproveTaskDDLResponse parses an HTTP response from a DMAPIApproveTaskDDLWithResponse call
+func ParseDMAPIApproveTaskDDLResponse(rsp *http.Response) (*DMAPIApproveTaskDDLResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIApproveTaskDDLResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseDMAPISkipTaskDDLResponse parses an HTTP response from a DMAPISkipTaskDDLWithResponse call
+func ParseDMAPISkipTaskDDLResponse(rsp *http.Response) (*DMAPISkipTaskDDLResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPISkipTaskDDLResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIGetAPITokenListResponse parses an HTTP response from a DMAPIGetAPITokenListWithResponse call
+func ParseDMAPIGetAPITokenListResponse(rsp *http.Response) (*DMAPIGetAPITokenListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIGetAPITokenListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAPITokenListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPICreateAPITokenResponse parses an HTTP response from a DMAPICreateAPITokenWithResponse call
+func ParseDMAPICreateAPITokenResponse(rsp *http.Response) (*DMAPICreateAPITokenResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPICreateAPITokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateAPITokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIDeleteAPITokenResponse parses an HTTP response from a DMAPIDeleteAPITokenWithResponse call
+func ParseDMAPIDeleteAPITokenResponse(rsp *http.Response) (*DMAPIDeleteAPITokenResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIDeleteAPITokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIGetUserListResponse parses an HTTP response from a DMAPIGetUserListWithResponse call
+func ParseDMAPIGetUserListResponse(rsp *http.Response) (*DMAPIGetUserListResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIGetUserListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetUserListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPICreateUserResponse parses an HTTP response from a DMAPICreateUserWithResponse call
+func ParseDMAPICreateUserResponse(rsp *http.Response) (*DMAPICreateUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPICreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDMAPIDeleteUserResponse parses an HTTP response from a DMAPIDeleteUserWithResponse call
+func ParseDMAPIDeleteUserResponse(rsp *http.Response) (*DMAPIDeleteUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DMAPIDeleteUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorWithMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
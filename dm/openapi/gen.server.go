@@ -149,6 +149,30 @@ type ServerInterface interface {
 	// stop a task
 	// (POST /api/v1/tasks/{task-name}/stop)
 	DMAPIStopTask(c *gin.Context, taskName string)
+	// approve the DDLs waiting for approval of a task
+	// (POST /api/v1/tasks/{task-name}/ddl-approval/approve)
+	DMAPIApproveTaskDDL(c *gin.Context, taskName string)
+	// skip the DDLs waiting for approval of a task
+	// (POST /api/v1/tasks/{task-name}/ddl-approval/skip)
+	DMAPISkipTaskDDL(c *gin.Context, taskName string)
+	// get api token list
+	// (GET /api/v1/tokens)
+	DMAPIGetAPITokenList(c *gin.Context)
+	// create an api token, the token is only returned once
+	// (POST /api/v1/tokens)
+	DMAPICreateAPIToken(c *gin.Context)
+	// delete an api token
+	// (DELETE /api/v1/tokens/{token-name})
+	DMAPIDeleteAPIToken(c *gin.Context, tokenName string)
+	// get user list
+	// (GET /api/v1/users)
+	DMAPIGetUserList(c *gin.Context)
+	// create or update a user
+	// (POST /api/v1/users)
+	DMAPICreateUser(c *gin.Context)
+	// delete a user
+	// (DELETE /api/v1/users/{user-name})
+	DMAPIDeleteUser(c *gin.Context, userName string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -1139,6 +1163,122 @@ func (siw *ServerInterfaceWrapper) DMAPIStopTask(c *gin.Context) {
 	siw.Handler.DMAPIStopTask(c, taskName)
 }
 
+// DMAPIApproveTaskDDL operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIApproveTaskDDL(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIApproveTaskDDL(c, taskName)
+}
+
+// DMAPISkipTaskDDL operation middleware
+func (siw *ServerInterfaceWrapper) DMAPISkipTaskDDL(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "task-name" -------------
+	var taskName string
+
+	err = runtime.BindStyledParameter("simple", false, "task-name", c.Param("task-name"), &taskName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter task-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPISkipTaskDDL(c, taskName)
+}
+
+// DMAPIGetAPITokenList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetAPITokenList(c *gin.Context) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetAPITokenList(c)
+}
+
+// DMAPICreateAPIToken operation middleware
+func (siw *ServerInterfaceWrapper) DMAPICreateAPIToken(c *gin.Context) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPICreateAPIToken(c)
+}
+
+// DMAPIDeleteAPIToken operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIDeleteAPIToken(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "token-name" -------------
+	var tokenName string
+
+	err = runtime.BindStyledParameter("simple", false, "token-name", c.Param("token-name"), &tokenName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter token-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIDeleteAPIToken(c, tokenName)
+}
+
+// DMAPIGetUserList operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIGetUserList(c *gin.Context) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIGetUserList(c)
+}
+
+// DMAPICreateUser operation middleware
+func (siw *ServerInterfaceWrapper) DMAPICreateUser(c *gin.Context) {
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPICreateUser(c)
+}
+
+// DMAPIDeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DMAPIDeleteUser(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "user-name" -------------
+	var userName string
+
+	err = runtime.BindStyledParameter("simple", false, "user-name", c.Param("user-name"), &userName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter user-name: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.DMAPIDeleteUser(c, userName)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL     string
@@ -1242,121 +1382,157 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 	router.GET(options.BaseURL+"/api/v1/tasks/:task-name/status", wrapper.DMAPIGetTaskStatus)
 
 	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/stop", wrapper.DMAPIStopTask)
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/ddl-approval/approve", wrapper.DMAPIApproveTaskDDL)
+	router.POST(options.BaseURL+"/api/v1/tasks/:task-name/ddl-approval/skip", wrapper.DMAPISkipTaskDDL)
+
+	router.GET(options.BaseURL+"/api/v1/tokens", wrapper.DMAPIGetAPITokenList)
+
+	router.POST(options.BaseURL+"/api/v1/tokens", wrapper.DMAPICreateAPIToken)
+
+	router.DELETE(options.BaseURL+"/api/v1/tokens/:token-name", wrapper.DMAPIDeleteAPIToken)
+
+	router.GET(options.BaseURL+"/api/v1/users", wrapper.DMAPIGetUserList)
+
+	router.POST(options.BaseURL+"/api/v1/users", wrapper.DMAPICreateUser)
+
+	router.DELETE(options.BaseURL+"/api/v1/users/:user-name", wrapper.DMAPIDeleteUser)
 
 	return router
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-	"H4sIAAAAAAAC/+x9bXPbOJLwX8Gj5z7sTEmWZDtO4qv9kMSerO+cl4o9tbe1lWMgEpSwJgEGAO3Rpvzf",
-	"r/BCEiQBkrItx5pkP+w4Igh0N/odjea3UUjTjBJEBB8dfxvxcIVSqP58lSAm3kECl4hd0owmdLmWv2eM",
-	"ZogJjNSoFeVC/hf9AdMsQaPj0Xz/+d5sb7Y3H41HYp3Jn7hgmCxHt+NRRll9+MvZy4NyHCYCLREb3d6O",
-	"Rwx9zTFD0ej4n3oR8/LncjRd/AuFQs76Jsm5QOwdlP/fhhFGkfo1QjxkOBOYktGx+hVxDmgMxAqBMGcM",
-	"EQFSNQkgNEKjsQut4xf7R07cYIKvUXsdShJMEOACityshrlZxl5BsByVsy4oTRAkctoEwQg54Mfcnknh",
-	"YIYOmJTAFNW3TU/jQKyxF+rNAtkSurEmcsfm+FkISkYLUs1pgbDG/QdD8eh49P+nFZNODYdOnex5Ox4t",
-	"GYwhgYPneavH21NoUpQzBAnWPI4FSnnffJoJ7ekMRSBjUP07YzRFYoVyPhjIj+Ur9sQ3lF3dGc6/q5f9",
-	"cN76t1K/+t3kbEFzEgWc5ixEQcHI9TX1QyAfAjUcCKqlRdOsvWy65l+TyaxrQQGXjqX09OphKdy+RdRY",
-	"1wptcdRTDBdHSfo6pC5COeWTkmvEJM9CfvUJfc2R5qL63grIr/pYSk6gGAnyqyCkJMbLIMaJg2j6IZAP",
-	"ASZgDdMExJSlUICVEBk/nk4jGvK9DJNlCLO9kKbTf6+mAkeLKRdwkaCpXGSi58kZlPNO5HSTOE+SPSfZ",
-	"+jDnGSUc/SlRtzlGoeOA1MkbDEGBLhQHeVlDM1gfhfQkltry8fykn+nNin6IH4iVXZRzLXqCudyYTyiB",
-	"a2vZhh4M5R9SEXFBMwABk8MBM+PHDSgtKpWKvV+fv4cpOpejnQx/kqfZhfJDHCqz9E+iPM1ATnAbpkWm",
-	"/qPZVftrR4ejtvs2Vl5lggSKAsWy9dcimi8SVL1H8nShX0Nc4BQKFAgqYBIwejP0zRgTzFcoChZrgTZ+",
-	"aYOFMkaX0p4pTmrqcQ22A2UfpZocZr/voGILzyYObhKO1c5ZsLuY+JRsxsOQiV4mVk+DBSYJXQZLgSMn",
-	"3zGByRK8vTw7KZyEPOOCIZgC/WrNiKKXcB6H+/sTFM5eTOZz9HKy2IfhZLZ/uA/D+Xw2mx0czyfPXxy+",
-	"HI1HJE8SiVfDFa62rAaix5soQJR6UvkUA8DUDsUCk72Z/N/+cFgibLyoGOaJZJ69qX6gl6jDJsGIMEOh",
-	"oGwNblaIIQWa3peELgHmUuFIBhsAwTa0ziljlP0di9U7xLnTh5Iso+wYQHJsi43Ur0Eo3anWu+oZCLWr",
-	"1VZE+tWUL31vpgaoPptTTTS24XFJ0lskjKd8RmLqdyxCPShwiYV5BrDctlKN5H6NOzSUaIZjTTwtoLpx",
-	"04GO3HY/hhEUcHBEUo/iHYGT0miW3u3UolJS5OrdSGj+fXgkTIi0ZSS0T/WA0FdO2vbB1o7IgwJufJst",
-	"gy99wwekeRk6bBnkd3jJlGvMlkjwBwS+NvFjYPKwnJMvqjkfA/pLaYAvBMtDkTPkx0IDGIQqoAn416Qe",
-	"LL35dPrq8hRcvnp9fgq+iPkX8JcvOPoCMBF/mc9/Ae8/XIL3v5+fg1e/X34Izt6/+XT67vT95fjjp7N3",
-	"rz79A/z36T/0G7+A6a+X/++fRu+jKMAkQn98Bm/Of7+4PP10egJ+nf4CTt+/PXt/+tczQujJa3By+tur",
-	"388vwZu/vfp0cXr511zEL9LFIXjz4fz81eVp8W/pVrnSHQa1dgQYLZwJGOX9Ooar3+cDIt7y9WIui6rO",
-	"rWokBR887X0wm83unfY+pzDqD+cSCqP7hnMd0ZX/pRQJaBxrZ7BkPS9jgzbl+sOt4TA16NuKpez5rKXr",
-	"qDgA1/GVa4sa2eD78pEvbT+I517sH817aWKkpI/1PiiPHXUnzsIVCq8ChrgKY5ocmjE0USOAGWFHT9VD",
-	"zEEGOUfRHnCrhvskc8Z1GHswbWru3iBZxzUIKJ3jDZLjJOerWsSng7P6rH9nWCCuYjuNl05pI6AwyCgm",
-	"AnD5CxTg5B0IIdGSjwWAsYwkGCrjWPlakQZsHQ3xr0kQUiIQceDGvyZgTXNwA4mwMKztncMygS/hvDJN",
-	"hfWQ5mkMvoT7/kcH7kf3sEf/6TRIaxK2kf09i2BBc5oJnGIucAj4CrJIklHqAWntwQ0WK535N1tDSbIG",
-	"OUeRjMgJgCawBTQMc8YBJt45T07OQVoLZsutaSZBrX1yMa7jzGgbp7f3N2Mfc+ZKClQZjFDin2cgowkO",
-	"16CW+W7nCv7IMDN+YCFPs6YwqUE64yCwzueUy9nxdmFIPHkTy9jJP9m1dhTLdQ+OZq2lL1cIFIOlBGWI",
-	"YRrhECbJGhiVF7dTOBqtaAzM5OAaJjk6BmoJyVAchZRE/G7QM5RCTAKewRDVMJg/a8L/DhOc5imIGUIg",
-	"wvwKqLcUDG9f32X5Wx9PPGg+/RHzfH15vdqaGQpxvDbA83xhZfNiykAL7D1wFgNCBdBvYskT6uxfqioB",
-	"KEHgBicJWCClgPbAhYLUnDEdg32Inh8dHhxO4ucv48l8jl5MFhHaL9Kn0jF9oVGZ9ycMG5LeprFL3tW2",
-	"vlFC3KaHsmj6iKwQyraIq0x1oB9WfqFlw37mnXcq73zr45L+6MZW23UuMVUcVQBSn6JBw+JAVouJNiwV",
-	"Uf/SoOp8DOYvn7/8xSXstXU9zOfiuXswWzdzuUHQhCuqMSRADw9ACEW4CvIsSMvKrDoQNyvpoTCpxNVY",
-	"kGfamSp3xwrCfGLu1Kub8WeF996U5ws1pctNdJeAFETUXFmb7lNOiHy5T3PWmdXJRDa6rh32Eb0A26WK",
-	"L5S7Wh7ftOVMu7NK96jjoHGVVOvP2jQSaRcozBkW6/Yyyok2NTucJ3UPT5u3GKMkKi3bCkcRItq5XiJR",
-	"BjX2RLVJQMxoqoYo3yuWfk5bLTXCV8REAJOE3qAoCEkb7Dc0TSkB741mvrg4B/IdHOMQ6hRCSaxe4nCe",
-	"BCH0B17WxFpVFSNtbnPyrJxYYuKd+jdrOonHx9N3xluY/s+z2cuiOqWBWv+qV2jtX/RNtZ7clYzha4na",
-	"FVqXpTHW4j3rNSOjOi0dNGgD6JQOE5S9ZTTPHGnmKGmX3PVudIwZF0FCQ21lXK/IaBRFm00rdPbdNTQn",
-	"m0/YSpao2ccVzi1ESrCtBZ1ELauFXCV7Hl+v5pfEMOGt9EhpSVQUrjWADJvU6zUVb15vWxPjVlbmctB6",
-	"VLrZ2omU0VwuFZTSylzrHJd594IQJ/CaOqyZ/r2sLyxp1XD7XJJYhPjOAklTm+kuwHRmACDnN5RF3hnL",
-	"AfUpDw6fHQ3xRIsMg3tu+dCa9+BgduSKZrMiodBZUqsGVa5KGY90vWSHLlJQLYvWecZUjJPvDKxbHVyd",
-	"qr2OzYp/e49LIb8aXgRyCflVVQIyHuXc5esZ3OTDFn6MUjGw6i9wZKjNknURLv7VoYU6HB+rgNjv+OhR",
-	"k2Hej01y33qlB+mqf+kvYtEOEVd5P+kS3TDq8j0LnuclML08X7HKPfiXoSzBIfTwcaMstJ01M1XVxttO",
-	"1nZlN3LpxA3rSQvOsgFx8o4MyzsrTBlK6TUKUqTPoQdbEv2eyisrV3YBufKEInpDTDxU/OxO3cMYBSmN",
-	"UCBwioKoyJG2oyOcIlA8lmZFvlnknS29PeNOjVORa5B+aAib1llMKCAdsEF+ZcoL1QAboP3Z7Ggym09m",
-	"+2D+7Hh2eDx7NqzU+0LQrHPL7o+TBJbmYjDVbyDWcYvGl2Z10j/jAzGr1S+0ndQ8zQYKulUdvEHh3GCd",
-	"k1AYDYTEOti2Dj0dbFKc6XdwaJ+S8gf5fSbvQg00/vpAzC7WJKwwU6fybszkI6Bgs7lCnVONXS4+Q5wm",
-	"1ygKlIdOw6vAc6DeqWaLiytO0rhPiv26syClwdOpSitydOT4JNaeCgad/9DzOpBdSEpgspRUcS1hn7rd",
-	"rHC4KhNimIPi5Y3ieCVvmAa+0oSOCs67vdVKcw5MSDp8ghAREYjBRSHmxClYoBUmkZXjG/JuGZE6rJh8",
-	"1olRbYQfI01NdF1cbh0AlynAH0wDS/CWjOZZF5PpAQ0+gwyBnEyKWWxe69QjtdREb/huE8JGsrbr42FZ",
-	"yPr2ODejKXguOln5AluKfWzVEg+3qLmUjCrbuG+O01dq1tYAl6Yipa3UfeorxokkM8t1ogNGEZZvweRj",
-	"bXSfPXqNyTld/qYm+yTncrkLiKwgCVGgbyUHRZHhCpIl6q1BsVxVHVsBnmcyAlNHlaqkQV92jqIEZEm+",
-	"xGTIZWS8JJShQB1+S54pyd+48KyGgYwhc0yuhjl36xoxrpNSvdulirk0GeqnX1E6UQ58kwgOZ1yhzwVl",
-	"RVWI9yCpmtRb2+V3c2xu5FfusJOSIMpVmCUcs63ojdy8FSSRzvnGCQ4FihQmKmLOU32QmyU6RV5cyNDE",
-	"t+TL0sZSF6mww30McwPX6oCHUqmyoEDS3FqLZYhzUwczGo+qohj3YtrdGJauUV6aesHK2dwlXdJXIyzh",
-	"C0VQwR40iTIwAizESs3XKhBqRWg+idJFrqkuzC4VS5Oz5EpmDFBjxsOLvpVSNZXfDWXTyElvsFe6hPwE",
-	"CvhahrhFIsrNWgXkBU0MN8V5kkhESMhQioiuyYbqN2kwRjoQkbxViZN+PMjdrQDq0aUNUWxSw7lHTfZ2",
-	"WzOHpned6Qik1JGcmAMoinPuBF2jpGWJjApWLoIj4JM/F9GIRzvXxtRIC6I0GaKJDQym0r1dd5hBIRBT",
-	"FT/aYvqB8Q2v4PrfE6Yi7v5zEOcO/JYnieF+qVp817ytDIvky1LaJBe103yQwGT9b5eoUnU+x2iiK8R4",
-	"nsops9Wa4xAmAKdFarzU34ZxtT6VvoT8M47rfG89a9GhWOiJQEPTjCHOJ1fXkwxixrvBMqPB1TVQo93w",
-	"OVYhHHOBSLjunL+wapgYb16dO+sqPcqkPY3VncRyNgA5z5lUFnXhyAV1wSGn85SLCcrgUpU2tL2CvWmx",
-	"fmDseXtmzK+CrzktEpM1xwbzK6CeKfAd+1mu9GL21jW7Xj4QK4ZgVC/BPGwaPSUP+gW5OyElJiRyx8YK",
-	"Bp+TUe2MHqdsQil0LX5M6FIiJuXP4DgqVphgImidLavRLXwNVC5850dOhA18/QjbxiMoQOjjyeINqXJU",
-	"vJXrTOMKEZAiJMoBCDB6w9U2m7ldUuv3Aa2Tm3JUpysalJTeBg5+1UMoQSqQJTlM6pCbR+0jyCjobWST",
-	"Obu4MGn4g3JjCyXiK0K2EFdvAuvNToVVz8Hc4QRSVRV6FIx+WCqYXlWwN5WvuCumfNbzjIRsM+tpuXMe",
-	"4ykZMVhAEdavUszbFdj2XHxNwhWjBP+7XErNAdAfKNScJ32JrzkkAqul3OXTWTJQCzQR6VUFPhrWb126",
-	"48bK3VB3Pls0M95mFf321nSZN0RRlGGFpL6re8rr3WAJ88bQJdxHeWa9BsBNcBqL+dxtf+6ojM47M0f8",
-	"anDiqIpW20c5jXRntcLsIA5n+0cHk/0X4fPJfI6eT+DRs4PJUThbvDiMnr2MD2bH88nz2eH8cP9gPHt2",
-	"+PwwOgit4S8Onu1P9mcH0WL/8CiKDqLj+WT+fOZshlWvWLaaW6kHVem4782M1gl06NRr2zll7jj39W1+",
-	"LX/gAWXCUAKl29d9NUV6AGXAF5o97ouJm5HGrY5tN56nqXPruRQvkZsYDU4QWJzcl5624fBuQ3EqV1j2",
-	"C0GzTMUUVY3tb+ZC52g8+ghzXqtAq/jQmb7w14frPImg9im8nTXhA9O6DW9QPVQTFIzs0B3y8bDykmFN",
-	"uFyoWz0fSluy6RzF3f/hfbo6ILmfkNmpXE+afwxucBKFkEVF/rqeo11Mfr3noXKrRMh32Cyq6sZ2bm4A",
-	"rMIJa2d5i2X7lNHzbIbPsaik4CE3JKKI61tN5kChwJo3tmZ+RyoOXMDnYnj4t5au7CBVdVLQTasnVay5",
-	"neLMu0QsWyoodJYQljTxai2UZpLvvaVE9BqxG4bFZpn/8i0dFgizSvlH/4Xgat1+0H1X9mOIE9Vujl+1",
-	"j0g6ihKd9/JLVdnfkLJQTtWkLhxaRi8PQ8S5B9zNStzbc43b1HABpW+JP2iPzOFmVC/+yO0uG03fuqqI",
-	"OuIif3Vme6OrFb3Xgc29Xw4KqySoqRjlXb01+2qg7lBN2lc/2ui8/PA9Qby9g7faFORWJYCFVMbJCQ0d",
-	"GfqTd+BDhsirj2fg5MMbqXJZMjoe9bW9nUjjOdEuN6bEdMHVgVBMFYtjoRBvLVDUARyPjiQBVVYyQwRm",
-	"eHQ8OlA/SY0vVgraKczw9Ho+Na2QpsX0xg8quxSeRWqtVx/P6p3+VDmN1qxqvv3ZTN0jq+5AwaxMbk7/",
-	"xXWNaOUfdbYpd/cUVFRvmEWtyNQm8jxNIVuPjiUOoOwpSGIKeB6uAOSg1mhQwCW3mgAq5zDLfdhr5dMk",
-	"gBLD1zRaPxju7ZaFLaTNsmAh1719wvuQK5rVtmLPSfjbcYsfdSkUH8qSVYPGx2FMR0PILrKMR4cPCEar",
-	"yahjaW3OOwTD6klfGK5NNmb6Tf+hor1brf8SpP1Ax059iOMEE6TJ9l6XFGSQwRTpXf5nq+LBAq/IGahO",
-	"SlCsRoUhGFkwjGw1rmtFXIlY/6cfPrcY59Dhhz+xHaWaro0vDAzayMJhGChhVffQx5EwR7fSHZMw68sI",
-	"G0mY2ZjpN+OFbSRhxnscIGE2eH4Js2D4sSWs/p2Lzo2M0r0COKdkvUXihIb/dfHhvUeU6mDJucor8G12",
-	"i2gI1HIVVBENGxAZH7UDnL9dvjsfBI4c2APOSugqKB84OsjrVz1Vz98+ZpbyVVyFVk01ytuFiqe/5oit",
-	"LabGYhWUIxxM7K41vB07vne0BgyJnOkuZ7qkcWIaHBW39Fwg1Pr6bALD5+1qX0ebZYek2L0nkqIZeoMP",
-	"mkMqfihifBWjcd/+29/j2Jaz7fjkx+YO9/zB4ClzIk/ezumesgCSqCjjhYCgG3vXXRve1gHTb9apQb+V",
-	"O1EPS6bo1AnLhC5Up7mc4K95vWGK3+DVDzEGGTzvhfW2woipvvpMswISmHDT1a1o2aMSOqbuw6U61Bz3",
-	"1Bk7YHg1HwDYx1PjITZkF3nlcWzaNu1Jhz4rD2IPnbxoKE8FiNV3xNr2pYsh+tI4O8MTn7dj91xp/Nt6",
-	"IlSCe/t9WOOJ6SGTxYL3tW3TSH85SyXB/W6P+b7WbrFoX8zw5GyLJvIDbGrVs6ljT/Xnpn5u6Ta3tHRD",
-	"77ujKiTbTFg/Fa1bf0xz4vok4K2xJ7uqGaremXFOdPfl4t7vwzDYBorjB2cvx8f6dpW7jJLaOnOVXeE6",
-	"eKtqO/7jsla79fpwN/hpc5rigFrH6M15yfpA/oAQW/fXHZKs3QLr+LvTbTfArfcU3pEDqqIBny5K9SVn",
-	"h7LH9Jv+o8rgDWAWVYH79Hhl3FG461m+wn3g8s663q1yab0pzG4xqa5rvjuPlg23hmiwsiPl07GGnTd8",
-	"HuUsqPEFwh1hH/VtjVqv+qL99n09LMEg4bEu0u5wry7NsB8919guZ/2zuFgFI5SqigKoPxWkawV6uEsf",
-	"8fRppuIDrL0MJHke8qvHPP0297oW66Kvp+6A6FqzeDbUYJUdJ7tWdchHc9lmp9PxRulpy2ZuWdW2vrPr",
-	"YEJF5MR0YH06iraEqmJ3XU0/5Hj/UreK297hvn1d4Hse7bs+IrlD5/zlJxTrO9xUZ9OQkmvEisrdru3X",
-	"A7e5/wUoPSyAY83DmANMslzozw4YXao/wVJgpRtwQ35lmlbpz3dQBq5xiMA1YhxulYkaKO0OG12qAilF",
-	"ZWJ6mJsvrdAYwObna1pE3RvAecXdsWEmtbgd9gj1rDuu2svLeffS8ZfVzb5tyLq50/X91LsPgCeqz2s7",
-	"u4lwTU1XnG7lfqYGPdK+N++obs4G+1uCZ3f0s2nVdXe2+Ka6tG5Sw9fgjo2iY7tRrCMsLmEZGBT7Oszu",
-	"dN2c/2Z1U4EPNpa7s02zH06xt+1115Z7C+SqO9Y/N31nStOG7ntLf99Naz9VjugqtlYwoGtEAI7VB1wA",
-	"zxdF2MfKpko/y619kf4AM7EzfPEIudLvoZ0aQeShr4VfR1G1f/f7SqqfMgNstYr6fgnG2Y+eYCyrqwcm",
-	"GC2T5TmfK5oFFo1Ah6SDag1G+c4oskcvjnCesehPAZgW9CNf0cOvw2fUXwvonlCN+fXxz8Tb3LJzJ+Pq",
-	"rM6uroAkMm1zzQ+M5sLcRcO1i8V3l8rBtWRlFdnrtaT1KxLd7QT9BxHKn9VtXfztLnG7NxdvWPJWFrv9",
-	"ZOmfRXg7K0vOSrwHFiX53iJBG6YkFgm6ECwPRc5+ytRTk6mxv1Otj+QFBwymuftzhbufvq9JHrdYfNPk",
-	"zE8J+Skh8+8TLNWZb/eDpU4x9GfJyvTMT1HcePEfRRAfPkVpJQWbcvjnqsXWEreh2ez2WgXsrXO5kGN+",
-	"wMx3ifeu38dVm3zH5POwm0XWt3R3UNmXLc13vbZ+Ry8xmWsVmns2406a9Sovmv2Qukujvfuqi2Z+zaU+",
-	"PsKuix2tN59f03wvoinERLWeH0lSmwncumDU1+0+ouHgFvemp/30a47Dq4nSwBNdljqpuoLVdMzI5Zkp",
-	"tLcL1Q0Wq0mUWvCoZdvQFF1gy3HFD7efb/8vAAD//6rG0op2vgAA",
+	"H4sIAAAAAAACA+09a3PjOHJ/BXFSdbcby5Jsj+eRug8zY++cL55HjT11uVxNtBQJWTxLJJcPe7xT/u/p",
+	"xoMESQAEbUlje52kMl4Rj0aj3+gGvm/58TKJIxrl2dYr8n0r8+d06fG/X386PosvaMT+I0njhKZ5SPk3",
+	"P6VeTid5uKTsv+k3b5ks8O+t3dHu7mA0hv87G41esf/7361tspVfJ+x7lqdhdL51Az9FXrt7jlOODR3S",
+	"eME7/EdKZ/jt34fVAoYC+OFnbHXD2tPfijClATT9J59NDgL/qmv4qswXT/9F/ZzN93oBa37vRd45Tc/i",
+	"JF7E59c6dMzjLG8uZLz7fGcE/2taSxKnzT4vRy/3lMZhlFOYWLMUNp8cwwD720WR5TR97+H/1wHtBQH/",
+	"PaCZn4ZJHsa41+x3mmUknpF8TolfpCmglyzZQCSKA4Y93Upfvdg9MC3XW4SXVDddHC3CiJIs9/JCTBpm",
+	"Yrb6RHlaUGXwaQwb6UVsdPgjoNrVwFjKgGxForHr2Doq5aNp12qkO46BCthtjuqO/bORnYfkOVly+pzk",
+	"aksrg2jJGuc8T70Z/NxjqHe8R20UjpxykMkiFNwR5nSZdY/JSbY2pMSPl6Ye/wVQsaSwm0XWA9pPZafa",
+	"6FdxenEXgP/O+tsAvrHuMe//43l0GhdRMMniIvXppCT7xtT8M8HPhHUgecxZjKNRN/vyOvttARrBPm8O",
+	"9Kibkc/CPpfywTwXa91D4fCRerIybksDcC3+TLwdR5ewy0AzXnbxGWagguAa25/D527yw0E40cEfEz+O",
+	"ZuH5ZBYutMjknwl+JmFErr3lgszidOnlZJ7nSfZqOAxiP9tJAAO+l+zAfMPf58M8DKZDWOh0QYc4zYCP",
+	"U6QeDjzA4QazYrHYMeGxGwsZrCujjxwNNXJi69IBbCIbZrFIu8xCNz/QsHIE3bzZa4V9W4ylIwkUpMnC",
+	"Q6vrW05Ys20S5sT3oj/lZErJeZwT7xwa9JIV0tzk81rRc8okh2VfuWjpXiwfSNVtZsk3cBN9Ymor/CuU",
+	"Znpesc7+JaNpT54oMoPkRwPDyzLAUMBBXxPLlJN0sM/h4cnrBBZ06S3UJTatAh//Qn3sscagLlOSXYQJ",
+	"MxJgDNCbXpjDClDWiUbegqRiyPbc3NBS0TgNIzByJkmc6RCjU/vQY2eE/zN+tY8NmtqdDUhgwJBBL0wa",
+	"M7Qgrj2SeLB3YBQUUyQM5NM/ZaxfQqMAukxk80k5MPTDBtheeBsMtYq6rgw/B+76AB1OsP0N297DMEOt",
+	"8JkuvGunLcrA3ISFpNhB3YEGvhX+dQSPW5MKeAaSKpbJKUeDzsor3bEA2pEiCrXEkPB/ueYUPuvB/pbO",
+	"iUWPO0bKyGkwYRq02TeIi6mQlaJzVCynoi9gJ1wyfz3OYVen1zntNXejfxpf9Zs+9yYZBR0daNElRw9g",
+	"J5egIZBoRfNtMhiTJTiTGWDxIoqvmPpwhHoGQ2VzQJhuvVaAy559VwpbfI6+hVHu9dtzZacdvSkkzDPs",
+	"9UlCovcA+UZqKckMUVuvqKPoaLS9Cy3sGsgLPYIk21Jx+tXMi/Ula2iMMaIcCTnTIwzEbSbWBAAoXGAJ",
+	"NCMz8HVB5nGkwM8Tjl8NF5ew356pNER2e7q29hSrMBAnQ4j2Y9ugKdHBO7WWo/7Q3nj+i2E/j6K+usBL",
+	"cwdlwL5PhAo+B2fEILpTpjXfnR0fSnVaJIAI6i0J79xQ1PSlN575u7sD6o9eDMZj+nIw3fX8wWh3H/4Z",
+	"j0F5770aD56/2H+JPSPwcASiG3EzVU6osBqDCRJWdHtYSMEJ3oZhsdsTqCCUQZWZVywYze0M+Scxl8Y1",
+	"gE6wvXF6Ta7mNKUMSr5faL6EGSp0pC1XUNam2I/SNE7/Hubz9yAnDCEVpCouLCi21gkF/B2c0kDbn30l",
+	"voi+aEUD67/Mzs3dlwI+F/ejGq4cm81uYMB3NJe+JuLK5m8GXu71UU/lsYhFK9UkUIfuYeSGMJiXIsKE",
+	"xyDMbSvxebOJXiyIryQM6vZHYVffzrHVZry6vVwFvs618hjwqveufiay8Q3kDLymRYkg8uYWxd2gVa9G",
+	"CV1schncE1r9Qk4rR3Mzy8Hwyar3pIqsbmwJ78PzlEWU0nOaZ6teTG30Da9sDZRWTKuBN7wa5rWcgpXj",
+	"50VKbavi0E7EqTvYb81g3NvPR6/PjsjZ6zcnR+TXfPwr+fOvYfAr+DD5n8fjn8iHj2fkw5eTE/L6y9nH",
+	"yfEHaP/+6MPZ9qfPx+9ff/4H+e+jf/AeP5Hhz2f/9k+hbsBWD6OAfvtK3p58OT07+nx0SH4e/kSOPrw7",
+	"/nD0l+Moig/fkMOjX15/OTkjb//6+vPp0dlfinz2YjndJ28/npwAVPK/0Z41xAvF+nRhxmBqCjIy10Pb",
+	"h30ZOx4jlIOUQypoNm8gRkxXLStwzI3RYOPweX2ZGXvgZawsM+Mk9gKXyNsC2q0o8nYfw1fWnkuEWPh+",
+	"Bl9faVL5wrrt/NEBLdxv94BWP1S1CLAdq1KHVdHRwLIOoyKCZaHjzqgVI+KeUSv8koW/Uxl+BrrE+EC2",
+	"0tjVPQ9B9Yw4NXJmViQJzalP7qIT802cKFcqMBcR+pHFL2hX4gDg17+YAOXxKE+LOJOUDlgbItrUw0zV",
+	"5zAjeFRHgx1i1OR3PdzE4LMKb/fSm8aXQ6SRB34o50FLpHEGxtO8ESQTsazG2H9PQQzysze+Sp4PRAlb",
+	"TBLDhpMMf/FycvgeT9O5Ygtz4s0wIAHLlVFAeT63ZUjFA3MG8yRywKlWdf62INdxQa68KFcW29hVjZ1J",
+	"fvXHlaEpbUE0Nrfh0675057+0x2sy/8ymZfXka9b85cELCSB/xh+XYI9F/okm3spnoMSlOloQ5GrMJ/z",
+	"3CmxTXG0uCbsFPVqTiPiiVggiX2/SDN5XKob8/DwhCwbsb9ym9pSTtk0A01r8vLWmmW7OlvuU5HqI6xV",
+	"aNhHvBQJgYWF/jWpZQxpOM9L/Xl4SSdFGur1qe8tlGA0bBj9BmwUwa8YgcYkuS+fj0lW+HPiwWbvvRoO",
+	"p4V/QfMhyLNZ+G0bIIozZutJEJl2JV5KiZidJfWFmPUCOpHCP7jtCa7VPcBNvyUAZdYQIqO2BGENuRGA",
+	"ydio9UvQDMamGQBVUePf6aXwOSoQ9g5GGijO5pgJxjvg6mFPwjgIAdvAJkILzNpRf77KYJuI8Ql0L6ic",
+	"BJhImt13WQi3yidZ4vm0sZjxs/ZS3oPJsCyWYExRPLfAfAfsycB59+b2kNxYeGDliQ+bPj9yOC2qT51Q",
+	"P5xdi3VkxVRhSsxXacG/Q45nJIpBG7KeIRINS0dH+Z2DOKYgoxcLzDQrmKVxyuAVaYmvyK5Hnx/s7+0P",
+	"Zs9fzvCQ7sVgGtBdeUiHLuoLkWjjxqMtgddGuUHqsc1+ywSZKZNOJFhKXtaZ7uyQdMI/q/ayqvGfTjwf",
+	"yYnnjYWSXIIhNY3QoCRRcKCECxrDNHAr037L3IgGsv/cTFzbJuOXz1/+ZBATtemNRKqjzbsRZRcJmiDh",
+	"qJQ1AwjXmuDwvdyfT4pksqyKkBqwAGUBQlLUC6w1oIZbquWWqfEKs4QwCOmeZKzgYGcIIp0Na7DHTdUK",
+	"Eq8ixbA+6uciikSeZKc0rlO1ns5q69fuv3EzyjWYZHysz5zHNFUex8HsWbQ/wXPwklBmTF+G9Ap+Rz+P",
+	"eRiwiaDouCsW89+9RaZ6otlFtk28YAnWCX4NYkIvaXoNvorIKI2KJcMIHxp/ksPxYgzoWVuFsk2nzNcp",
+	"cyZ0IoZ7Q0wUszwMNBzLwJ5bFL8VyjulQAZhfq3N40JvTFTRZNmi7hBwkwCMg0VQWgPzMAjAQWNe2jnN",
+	"S09ZHag2SBVFYwbtDK1GrZBuhkuwnAzs3fiKBhNfmyT/Nl4uYYIPQnGdnp4Q7AXmjO+JgFWFPCdUAQqA",
+	"Nm1uvTIBl9yybZ23TIyK4+PCLDP8ooyKy/p09F6YXcP/eTZ6KStDmit1m/yCXtvmfltNy/KX0/ASFwq9",
+	"yuoUBQaHadsOeB3DOpxoQDWIhVMRBHiXxkWiPTAKFroCOidamIVplk/QxRXY+W4KhdDgNuPn/MDX0L6I",
+	"bjmyJp7H5kGzSmKjvbpqJercJrRXlR/aejyjfd0w+2YgezVBvFINs/gQFyno3LIhGqpRDqFTxsKmVywP",
+	"14ljVCfcgEfnu0DRx9SYOBPQm0w2WGYL7zLWWgX8S1lLWGGwaXAbuLoMROlLI0VxpqkC06XeRD+wWi1S",
+	"G3lv/9mBs0dQhsQMk+Dn2gR7e6MDQ2AiKcNf9rpb1kwxDCvX0V49o/iZjPNVtWrPiZAtGyUeHRWt/epW",
+	"uYnXu1zYJTMIKwJ7pIbiaUCVGLrNK5ssa8XPmvWmcZz3qAabaI9s5OwNcVD+p13EWU01pfTYaqrxhoMe",
+	"9pq6JeaZK5temy3rku3KzbiMxbvRkLtKY70/UDJLVkHVzSyn2sKm21B9SpMFmDpm6m9WFmriqaJKW7hE",
+	"4AwoBeNUL3dvUZRYkqEKkYnIMPzSUaeY0mV8SSd4ctJbifG+7NSF2edTL2MmXBBfRcKhlT8bD7m8GUwe",
+	"B/xqkkkgjwp07i2Gy2UD1GfYtzyaUVXEKDPJsDuXv22LoFZ1G0wTSlFxl+Ysvt8AbXc0OmD3xeyS8bNX",
+	"o/1Xo2dGcRsn1STWysNq0DM56H+OXrwajQyhrjDyYedgmawakhE+QylOmRGvyGMMFPGzAH5q6SXJ4hoZ",
+	"2CstFXRbAVHy0ITZFgguOLdI9EjxvFwRWvBsh1/E2f4O+YiuMoaAcRPlOrf5n1XJJRuI/YYilXnL4B+W",
+	"8eSdCk13KtNEe2JliAKa9+fcZQV8NKo9HVBTrcmU5FNfzZ7v+/vPn00HsIo9DF49H0zp7nhw4I+mL/aD",
+	"Zy9ne6NX48F4f3WkABSQp16U8TMOcXQKi2XWbEZzfrIGBMNi/g4rvjEJrzjpkF0rYWekvbjIe4geWagr",
+	"WD1OmvLnWdbjYoh6QqvOxyyWibNeVApd+xWm9FHVmNLgDJGSAFjLstHJzTKt0yq6HRS8NYbZaVyesqbS",
+	"+3Ze6Sk0VlbKkhlNK8WPhEFZpx2W+aBfQBGBoxUvLmkwYY527F9MzImAHQaLvFLGgC9jvpLFCClxLNdu",
+	"skoqNFkPQRAVxoxQLvHF6FoETBFBWBwPyNLPpGZ4XM1Df14eE6DsFt1vEeRjDBvGluJtexnSHbq2D4fc",
+	"j3B03nPzfgETLo0XGNwCfcZLDbTXJfW8UMHofPiw75O8X3asyHaYTCmIzEA9+HEdoArBaY1j/Ny1m7VG",
+	"1t3kRMXttl5JwLxqvB9uFGl1jtFTOwvyJg0uREOiiAZynDop2SVxLW7rFMRUcVNbc506WjxmPKqqb55+",
+	"o1piSou4WuxUlXxGEmwLEYNYMoholmu5knMkc2WHlvfPZGKpRmea1cAsXOAGpIWIAHtBwKSBt/hUb9+p",
+	"+d+E0Ul8/gsb73OxqJfmKQii0dyDHZnw+xwnstwHfjynTomkihfNA0VgIScYUWLSiuUi8qsigSxIsijO",
+	"w8j5DsfwPAKXbMLSuJCyqo1pXhfJGpIE6JunfLGGpq28pGkmQvlOe8kS7JX0cSUdI1gOWMChjRdN6IBh",
+	"BI+tZYanJZlBGdiW4W2zPWvEm10Y42pxBC4D95i0g87jK9xdIImAn7fNoC1WnbAiH/WkFx0vcWpZFqjz",
+	"rTEd9DK5ycImpjyAK++apRnEMQpCPGMDlVibNQG7XyS78kNmmfpqnJSbfq7hbGZIsy5qTPuWQWSHoj+E",
+	"1M8n1TomLSy5R7YkT7IxW2nBmqCThR15jdqSV2FWQqpFgzihaEVYK3d9V+bHi1rPluhqngr22kVeOXoI",
+	"ZPsGI3llyN5Ef3IRJZYkyeFlgWxVVdSB3xW7kPppS3iXjAYVThRNnN2UCr4uYd3k5BaK9DvY4gejJtUo",
+	"Ff2hPHxFCYfDZ8TLZfRoAUbJQqP7hJRnJovWu8cPpYtp1AH1ZnWUk2C5cBb3Ah5Z66qpVki8HHO3kb+4",
+	"xrYCZuyhwPh/hymPvrgdWxv25xcgS8E4KKrMN2gqsWck5ZJbWVaPLrsdLJDr3/XsHrMUjDRe8HTrrFji",
+	"sMn8OsNwGwmX5dFkpSYEoQtpjfYN+3s2a7KL+lmDGjnj/QMMBBCeJg8uLgeJF6ZZF4SiPbm4JKy9AVTt",
+	"VFEGIp1G/nXHJFKdhpHwT1gqEs+Gh/GxXo9dD1OOR7wsA1KJWsc9GE81gINjGpOvRaUDfNZZKTtDCchE",
+	"mha6CUKQiL8VcXnMUze7MG+efWWL0e12NeGL0bstYxVEjGcjc8yDaxZD7Lc1LuMi3gl3DnAofD9jaITB",
+	"Y7Z8ql3jLZkOKvlVQ7sgYXGhjHnForfKeQYwddwkYaWHBgMCQD0GxgcGFAhgnVCgaquJBKabgmUflF/M",
+	"wSx4LBujvktK87IBGB/xVcbIQIyu53mrzaoes1ctO6zoSbkD61mOTYZFYPdwXz4qvEVzDfKrLkQWTBzu",
+	"LU9Ml3SnaIRMyl0vhZGlgkjBBOtNlN4d8q8RvLpdegnL6DfKKv65lFUO4mRniJ1M2ccWvX0MZmRfva0e",
+	"eJnUNlLsZIoZxE3+1ZVSqSNimGaexlH4ezkhGwdkHOCQn6yBWfNb4UV5yOYz1kABCO5CpLkqB0liwWv9",
+	"whiTv1wZP+zWGg0ehYGshACcEoxFt6q0XPXILZeLMKO972SiW5/JTIkZVYF7bQUt2JqzWjwHWwiuDFp0",
+	"BeCyi37xt8pp151GNuPMylSjvZk/2j3YG+y+8J/zw2jv4Nle8zD6+Wh/vL+7tz16tv98P9jzleYv9p7t",
+	"DnZHe8F0d/8gCPYCPLt+PjK9pNAoPlLfRWCflAIxc3eZNVD13jdJzHXmGFnTfSwkUguxGKEaYHYVmqpd",
+	"BblojpSeri9poDNE0HShboSXf4uhmlK9EXqyYL61th7RE4XoXY4LVIBsm1OeOZeGBqY1JNxXUupjZDoE",
+	"/v2J3fZtisJpQz22KjAeWAKjQQmtqmGmzD2u3jRd2Wc2SEn1WhmELXrkJ7pe/K9DhXqjXqW/eg8jL0vr",
+	"+TKABaK7s2YtlG46jdnGQprAxyClOFBoRsing59XkmLRzkC1JF/kSna+JuzpBHlugLwrUbJ58cxX006Z",
+	"DZ6KdVa9W0FMM14nLY5/JBKy1r6N74Jb53ksRo+R4GvhYSv6qkOdLvzd27KDNRcZ3NItW3MKvCnnvcSW",
+	"TSBSmA5ZzJzIF1/S9Aqv1ul9UFP25H5PLuYq/3C7taWa32kd5juYZl64YLfpZxe6Qy5rIr3hnqVKHjs9",
+	"sVSKvWpww5o0irfwfWAqI/i3KA5rD4nFSk0sGSDk1/2s/KGgnkqdQ/Gjnvv5kukfy+v7ws/aH71qXNhu",
+	"zzm0+6y2sggt4VZzWy5lEbevZESqcBAffKbM/hSNQ/7kbWs6HIo4Gu8trvGyO8vjgJu67e6GnyLwK54O",
+	"Y1+fbPiefExo9PrTMTn8+Japo3TBUi46HrMboPUx4M4PjCXethPO6izmjBzmHBvtWWQCDHw8QOTyADa0",
+	"8ZKQJeOzH5lWzOcc8iF8Gl6Oh+J63mE5jTQ0y7cJjgM+KcxXvwSfJ6BxlcMH3R2NeAW7UmfNEu55PHz4",
+	"r0zkSio2qP1dU/2t+3w3mgYFF+h8k7NiufRSJEpcEClv3YdRyju56lfxg0Ocqffjc2s8KczY4OK3hRDG",
+	"y2/i4Hq1qGhf7K/BgZidTHH6m4exPwVDY22Ldgwbgj2bhMsTC7MetFs9arBRCta8pWDHE3zaXzU4rVdJ",
+	"tCBwM8jKScobt6XK7Ldjw+/8D+ad3whxim8+Gbfw42yGeTIckR9EDk3ipdBfkMA/2yk/CpxlKIjfOgqy",
+	"cKtSOFsKNFt1NSFSqPQRfNsb1F819LWvdYbu7Z7HHOfNN40dt1paMe7MWT3OsVHm1LwJ8oCZU3mWuS9z",
+	"ih0bfhfWYl/mFAavG3OqcNqZU4HmiTlbzNl4hrtjq4PlTgmmgSmBMcDG/dvpxw9mLmyAiEOWVwLpiBNs",
+	"X8LmVSGEH5vQSdvaCtpfz96fOIOGjTtBm+cipdAMGvfGnaRZ9SqPAxsgi8rrXtita9UFB5wbwKhLr2vs",
+	"AK0mVSst8ZsSf2FF7YfI+BVheZHy64Z5lvFAXKpZXhBggKZ2hWRvcL5uRM5rnknScpp6m9eifN+tSS/N",
+	"RirdyJgNd00zM5mor02v13XQPGt9O/dhvFrAyrjXg9K0/FEXVkAusvE9EtErlSr0BKGRJcPvyhGVk549",
+	"ZJ8rqumQLeeLeMqK5IsohM2tka5d5TbOzpxVruWyHp3omcX8Spc4kWCxewnZD/KOSBYTkzlRBiHExlmN",
+	"9HlgVgAnGOJ1E+A2cdNbj4GuNqxUN6DFrNKyyi7YN9Cv2JsYC/mKSO9AdBBPdzTsgdLP13WqX91h0U0z",
+	"FI2w3/xgGrq3Ek6ECL0VqNhhEGZlWoLVPDvkDR80RTs4R/dYr/ENWM22K9dwWnedvxn+tOk/atNLi3oF",
+	"e84c0t4M/7l8KOFJg8m7mxTMKBrs5vHIGOURniLib6TI6w5WRoj9RNATGbZ3OnqkVCiE3iaIsLoq2EqD",
+	"1WNCTyTYukK5SYF9LPkHQ5OMUOrvvdyG6gQs7jFz/lSEY+R8nURmu714I+5+/c2MB3gcKa9r5mnl5kC5",
+	"MxXBr+wPJVrqRFMsn/6+k9S2NSHfAkuFkj6wGNL2N0HZ9Uu8Hi5h8zKGO9F1ddukm3Csbj6/lyq5o5Jw",
+	"k0d9HFUPOZuDvd9Xe8ZKXiK5AmuQ3ZQ8kxncNlPwTLR8Cu2297id3v1YzUFJL6Xsi4nHXzblySfddMgP",
+	"8BxEHdYiOCprxiZYXvNj0iZEnen0Wt64LS4ZNkxffndXlOWVz/bptdzVnr95K3kPUJr3k29IlktasMtx",
+	"hv2FvDf9HkryEj6VS3hxjWOayJm4Z3TNSSJqMdG9SBH5yN8n5HA9TF0u80WIvCu2SQEtKQkgRJc0LfPa",
+	"7fTB266fQCRMnTSCD3QjxYf4BkNS5OINDi6n+WOKcoH8FRostxMvFbPH9uKUXIYgz7CuxdsElTXW9jDp",
+	"7Ixl7zHER+JtH/FiInsntPE2ZQvPO26kKetXnVW5rE7dXE73I1IaZdnw3bXHmVJ4vDYhIepI74HiMENy",
+	"7zVFbed7cuVQ3GTWqTaOWbuNEkaz3v52dLK7TsAepuQXtzTeiW6+szvFe6ahNsmnZ2Sgdr25PiRQQtUn",
+	"IGC+Hv0R5XtarpVo64geevrh7uPoSYO0DYcOwrCkdFbXSzyRxiMgDZFE6U4dbW1xax3xMAjHXp3AAMJL",
+	"6Qn4t/gcXfl0IDi3aXVj3lN9gqk+wRT/cNNPD5aGNhmk/qGyr+lR75tvh7VWIVgppbsG4eEQywbKDu4e",
+	"0B09BXRN5QjuAV1FcxoOZOV9tPLWasfoWu1K7OzBysgfmnVjOi7jz/GIp162LJk0P/cdmr/b0zkya/bz",
+	"D0ylaFPYg06oYIe0au4OewSaLUz8kMZFLmpOw8ZVBXfg7T5JkGX645trxP7rKLh14sUfnLWfcjR78oQp",
+	"UfPulN8/cbNM2Xxig6e80j9KXmlbQa2dF7EfXvXSO6iDVZJACH5epE9M+VCZctt2N7ttZyTJ9Nsa04vL",
+	"j+1UpsbCmcoltwl9PXHaE6etldPW4zfWCfdx+Y1dPG0LUpYBsCe+Xi0kf3iuXlMgWYnYNnn6cdc8cO7t",
+	"rdM7bPPcc0nPOsVmTycZPMoicfG4qv4ZJdz+FMG5dFBUkdyucPDeaZnqgY5HVeTyCMoVRQmUoLO+1Bwn",
+	"LkIxTp5kopCJHBWPTSTGiatEjC9o5CT/sIYVG2+uAEGd8SGzNKCaMDy345BeAczjWn4g8bGBAjY51b0q",
+	"YmuC9rDr2KKKLrZZlREnkTAjyjXiNID/apSnC5ppczGoAvy3bxBaJasOdVBRcocOKOHopQSw12OPbKr7",
+	"3rWt+N6bk2zGR+Q2J5flbA9ZJiNq7yiOv4g3MtcsinGaeyWG2bofptCNU1JmncknTjsZcPgd/+krVb+U",
+	"L6haJSqjQ7swLWfvI0uNT0U+qlRolz1se0lBsBgA8Gl86S2G/I/GXX4Ni7k+u+jBdPbh4UlGrrwwB9yy",
+	"WwbluLxYWFrgzeCm7Dmni4CE/LGQbI5PY8PPZBH7FxnxUipGo6zN4fsBf4dnm8T4Ou5VmHEYEvaevEyj",
+	"zwj9Rn1Mv4Jvy61toqXR13xgdH1gRh2dat25OoH28RWtdNug0caD1dw11DuCyrDyLeHVXdd6ePJa7Oaq",
+	"3EM9ea8SaB1fCagdGSK7CJMe3IDNN8EKOE/SkxMkbEY2OIUGTzzwR+EB/vx6elnub+NZ2eu42AnipRdG",
+	"7FHZLYZtSfyGYOqWw3u2+ASW+yO24tXaIWyqfzFg0e0BN18GyvMU9Tjtlv4IjXPc+sHDWptBsKwBxmbX",
+	"gSVfU1Payp90zZkJxjKMS7cp2+YusncJtMMOkpi4CZZhpI7KbIGbrzf/D/zY9AYY8AAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
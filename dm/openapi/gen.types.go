@@ -8,6 +8,15 @@ import (
 	"fmt"
 )
 
+// Defines values for Role.
+const (
+	RoleAdmin Role = "admin"
+
+	RoleOperator Role = "operator"
+
+	RoleViewer Role = "viewer"
+)
+
 // Defines values for TaskOnDuplicate.
 const (
 	TaskOnDuplicateError TaskOnDuplicate = "error"
@@ -91,6 +100,15 @@ const (
 	TaskStageStopped TaskStage = "Stopped"
 )
 
+// APIToken defines model for APIToken.
+type APIToken struct {
+	CreateTime string `json:"create_time"`
+	Name       string `json:"name"`
+
+	// role of a user or an api token, viewer can only read, operator can also operate tasks, admin can do everything
+	Role Role `json:"role"`
+}
+
 // AlertManagerTopology defines model for AlertManagerTopology.
 type AlertManagerTopology struct {
 	Host string `json:"host"`
@@ -150,6 +168,25 @@ type ConverterTaskResponse struct {
 	TaskConfigFile string `json:"task_config_file"`
 }
 
+// CreateAPITokenRequest defines model for CreateAPITokenRequest.
+type CreateAPITokenRequest struct {
+	Name string `json:"name"`
+
+	// role of a user or an api token, viewer can only read, operator can also operate tasks, admin can do everything
+	Role Role `json:"role"`
+}
+
+// CreateAPITokenResponse defines model for CreateAPITokenResponse.
+type CreateAPITokenResponse struct {
+	Name string `json:"name"`
+
+	// role of a user or an api token, viewer can only read, operator can also operate tasks, admin can do everything
+	Role Role `json:"role"`
+
+	// the plaintext token, it can't be got again
+	Token string `json:"token"`
+}
+
 // CreateSourceRequest defines model for CreateSourceRequest.
 type CreateSourceRequest struct {
 	// source
@@ -163,6 +200,24 @@ type CreateTaskRequest struct {
 	Task Task `json:"task"`
 }
 
+// CreateUserRequest defines model for CreateUserRequest.
+type CreateUserRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+
+	// role of a user or an api token, viewer can only read, operator can also operate tasks, admin can do everything
+	Role Role `json:"role"`
+}
+
+// action to approve or skip the DDLs waiting for approval request
+type DDLApprovalRequest struct {
+	// binlog position of the DDLs waiting for approval in a paused subtask, it's the pending_approval_position in the task status
+	BinlogPos *string `json:"binlog_pos,omitempty"`
+
+	// source name list
+	SourceNameList *SourceNameList `json:"source_name_list,omitempty"`
+}
+
 // action to stop a relay request
 type DisableRelayRequest struct {
 	// worker name list
@@ -171,13 +226,28 @@ type DisableRelayRequest struct {
 
 // status of dump unit
 type DumpStatus struct {
-	Bps               int64   `json:"bps"`
-	CompletedTables   float64 `json:"completed_tables"`
-	EstimateTotalRows float64 `json:"estimate_total_rows"`
-	FinishedBytes     float64 `json:"finished_bytes"`
-	FinishedRows      float64 `json:"finished_rows"`
-	Progress          string  `json:"progress"`
-	TotalTables       int64   `json:"total_tables"`
+	Bps                int64   `json:"bps"`
+	CompletedTables    float64 `json:"completed_tables"`
+	EstimateTotalBytes *int64  `json:"estimate_total_bytes,omitempty"`
+	EstimateTotalRows  float64 `json:"estimate_total_rows"`
+
+	// estimated remaining seconds, -1 means unknown
+	EtaSeconds    *int64               `json:"eta_seconds,omitempty"`
+	FinishedBytes float64              `json:"finished_bytes"`
+	FinishedRows  float64              `json:"finished_rows"`
+	Progress      string               `json:"progress"`
+	Rps           *int64               `json:"rps,omitempty"`
+	Tables        *[]DumpTableProgress `json:"tables,omitempty"`
+	TotalTables   int64                `json:"total_tables"`
+}
+
+// dump progress of a table, the estimation comes from information_schema
+type DumpTableProgress struct {
+	EstimateBytes int64  `json:"estimate_bytes"`
+	EstimateRows  int64  `json:"estimate_rows"`
+	FinishedBytes int64  `json:"finished_bytes"`
+	Schema        string `json:"schema"`
+	Table         string `json:"table"`
 }
 
 // action to start a relay request
@@ -204,6 +274,12 @@ type ErrorWithMessage struct {
 	ErrorMsg string `json:"error_msg"`
 }
 
+// GetAPITokenListResponse defines model for GetAPITokenListResponse.
+type GetAPITokenListResponse struct {
+	Data  []APIToken `json:"data"`
+	Total int        `json:"total"`
+}
+
 // GetClusterInfoResponse defines model for GetClusterInfoResponse.
 type GetClusterInfoResponse struct {
 	// cluster id
@@ -260,6 +336,12 @@ type GetTaskTableStructureResponse struct {
 	TableName       string  `json:"table_name"`
 }
 
+// GetUserListResponse defines model for GetUserListResponse.
+type GetUserListResponse struct {
+	Data  []User `json:"data"`
+	Total int    `json:"total"`
+}
+
 // GrafanaTopology defines model for GrafanaTopology.
 type GrafanaTopology struct {
 	Host string `json:"host"`
@@ -268,12 +350,25 @@ type GrafanaTopology struct {
 
 // status of load unit
 type LoadStatus struct {
-	Bps            int64  `json:"bps"`
-	FinishedBytes  int64  `json:"finished_bytes"`
-	MetaBinlog     string `json:"meta_binlog"`
-	MetaBinlogGtid string `json:"meta_binlog_gtid"`
-	Progress       string `json:"progress"`
-	TotalBytes     int64  `json:"total_bytes"`
+	Bps int64 `json:"bps"`
+
+	// estimated remaining seconds, -1 means unknown
+	EtaSeconds     *int64               `json:"eta_seconds,omitempty"`
+	FinishedBytes  int64                `json:"finished_bytes"`
+	MetaBinlog     string               `json:"meta_binlog"`
+	MetaBinlogGtid string               `json:"meta_binlog_gtid"`
+	Progress       string               `json:"progress"`
+	Rps            *int64               `json:"rps,omitempty"`
+	Tables         *[]LoadTableProgress `json:"tables,omitempty"`
+	TotalBytes     int64                `json:"total_bytes"`
+}
+
+// load progress of a table, the estimation comes from the size of dumped files
+type LoadTableProgress struct {
+	EstimateBytes int64  `json:"estimate_bytes"`
+	FinishedBytes int64  `json:"finished_bytes"`
+	Schema        string `json:"schema"`
+	Table         string `json:"table"`
 }
 
 // MasterTopology defines model for MasterTopology.
@@ -312,6 +407,9 @@ type PrometheusTopology struct {
 
 // relay log cleanup policy configuration
 type Purge struct {
+	// local directory or external storage URI such as s3://bucket/prefix, closed relay log files are archived to it before being purged
+	ArchiveUri *string `json:"archive_uri"`
+
 	// expiration time of relay log
 	Expires *int64 `json:"expires"`
 
@@ -366,6 +464,9 @@ type RelayStatus struct {
 	Stage string `json:"stage"`
 }
 
+// role of a user or an api token, viewer can only read, operator can also operate tasks, admin can do everything
+type Role string
+
 // schema name list
 type SchemaNameList []string
 
@@ -464,6 +565,15 @@ type StartTaskRequest struct {
 
 	// task start time. Prefer RFC3339-like values with timezone offset (+08:00 or +0800). Legacy values without timezone are interpreted in upstream timezone.
 	StartTime *string `json:"start_time,omitempty"`
+
+	// the incremental replication stops automatically after reaching this binlog position, and the subtask becomes Finished.
+	StopBinlogPos *string `json:"stop_binlog_pos,omitempty"`
+
+	// the incremental replication stops automatically after all transactions in this GTID set are applied, and the subtask becomes Finished.
+	StopGtid *string `json:"stop_gtid,omitempty"`
+
+	// the incremental replication stops automatically after applying all binlog events before this time, and the subtask becomes Finished. Only one of stop_time, stop_binlog_pos and stop_gtid can be specified.
+	StopTime *string `json:"stop_time,omitempty"`
 }
 
 // StopTaskRequest defines model for StopTaskRequest.
@@ -509,18 +619,24 @@ type SyncStatus struct {
 	BinlogType string `json:"binlog_type"`
 
 	// sharding DDL which current is blocking
-	BlockingDdls        []string `json:"blocking_ddls"`
-	DumpIoTotalBytes    uint64   `json:"dump_io_total_bytes"`
-	IoTotalBytes        uint64   `json:"io_total_bytes"`
-	MasterBinlog        string   `json:"master_binlog"`
-	MasterBinlogGtid    string   `json:"master_binlog_gtid"`
-	RecentTps           int64    `json:"recent_tps"`
-	SecondsBehindMaster int64    `json:"seconds_behind_master"`
-	Synced              bool     `json:"synced"`
-	SyncerBinlog        string   `json:"syncer_binlog"`
-	SyncerBinlogGtid    string   `json:"syncer_binlog_gtid"`
-	TotalEvents         int64    `json:"total_events"`
-	TotalTps            int64    `json:"total_tps"`
+	BlockingDdls     []string `json:"blocking_ddls"`
+	DumpIoTotalBytes uint64   `json:"dump_io_total_bytes"`
+	IoTotalBytes     uint64   `json:"io_total_bytes"`
+	MasterBinlog     string   `json:"master_binlog"`
+	MasterBinlogGtid string   `json:"master_binlog_gtid"`
+
+	// DDLs waiting for approval
+	PendingApprovalDdls *[]string `json:"pending_approval_ddls,omitempty"`
+
+	// binlog position of the DDLs waiting for approval
+	PendingApprovalPosition *string `json:"pending_approval_position,omitempty"`
+	RecentTps               int64   `json:"recent_tps"`
+	SecondsBehindMaster     int64   `json:"seconds_behind_master"`
+	Synced                  bool    `json:"synced"`
+	SyncerBinlog            string  `json:"syncer_binlog"`
+	SyncerBinlogGtid        string  `json:"syncer_binlog_gtid"`
+	TotalEvents             int64   `json:"total_events"`
+	TotalTps                int64   `json:"total_tps"`
 
 	// sharding groups which current are un-resolved
 	UnresolvedGroups []ShardingGroup `json:"unresolved_groups"`
@@ -777,6 +893,14 @@ type UpdateTaskRequest struct {
 	Task Task `json:"task"`
 }
 
+// User defines model for User.
+type User struct {
+	Name string `json:"name"`
+
+	// role of a user or an api token, viewer can only read, operator can also operate tasks, admin can do everything
+	Role Role `json:"role"`
+}
+
 // worker name list
 type WorkerNameList []string
 
@@ -895,6 +1019,18 @@ type DMAPIGetTaskStatusParams struct {
 // DMAPIStopTaskJSONBody defines parameters for DMAPIStopTask.
 type DMAPIStopTaskJSONBody StopTaskRequest
 
+// DMAPIApproveTaskDDLJSONBody defines parameters for DMAPIApproveTaskDDL.
+type DMAPIApproveTaskDDLJSONBody DDLApprovalRequest
+
+// DMAPISkipTaskDDLJSONBody defines parameters for DMAPISkipTaskDDL.
+type DMAPISkipTaskDDLJSONBody DDLApprovalRequest
+
+// DMAPICreateAPITokenJSONBody defines parameters for DMAPICreateAPIToken.
+type DMAPICreateAPITokenJSONBody CreateAPITokenRequest
+
+// DMAPICreateUserJSONBody defines parameters for DMAPICreateUser.
+type DMAPICreateUserJSONBody CreateUserRequest
+
 // DMAPIUpdateClusterInfoJSONRequestBody defines body for DMAPIUpdateClusterInfo for application/json ContentType.
 type DMAPIUpdateClusterInfoJSONRequestBody DMAPIUpdateClusterInfoJSONBody
 
@@ -940,6 +1076,18 @@ type DMAPIStartTaskJSONRequestBody DMAPIStartTaskJSONBody
 // DMAPIStopTaskJSONRequestBody defines body for DMAPIStopTask for application/json ContentType.
 type DMAPIStopTaskJSONRequestBody DMAPIStopTaskJSONBody
 
+// DMAPIApproveTaskDDLJSONRequestBody defines body for DMAPIApproveTaskDDL for application/json ContentType.
+type DMAPIApproveTaskDDLJSONRequestBody DMAPIApproveTaskDDLJSONBody
+
+// DMAPISkipTaskDDLJSONRequestBody defines body for DMAPISkipTaskDDL for application/json ContentType.
+type DMAPISkipTaskDDLJSONRequestBody DMAPISkipTaskDDLJSONBody
+
+// DMAPICreateAPITokenJSONRequestBody defines body for DMAPICreateAPIToken for application/json ContentType.
+type DMAPICreateAPITokenJSONRequestBody DMAPICreateAPITokenJSONBody
+
+// DMAPICreateUserJSONRequestBody defines body for DMAPICreateUser for application/json ContentType.
+type DMAPICreateUserJSONRequestBody DMAPICreateUserJSONBody
+
 // Getter for additional properties for Task_BinlogFilterRule. Returns the specified
 // element and whether it was found
 func (a Task_BinlogFilterRule) Get(fieldName string) (value TaskBinLogFilterRule, found bool) {
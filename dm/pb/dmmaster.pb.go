@@ -184,10 +184,13 @@ func (RelayOpV2) EnumDescriptor() ([]byte, []int) {
 }
 
 type StartTaskRequest struct {
-	Task       string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
-	Sources    []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
-	RemoveMeta bool     `protobuf:"varint,3,opt,name=removeMeta,proto3" json:"removeMeta,omitempty"`
-	StartTime  string   `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	Task          string   `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Sources       []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	RemoveMeta    bool     `protobuf:"varint,3,opt,name=removeMeta,proto3" json:"removeMeta,omitempty"`
+	StartTime     string   `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	StopTime      string   `protobuf:"bytes,5,opt,name=stopTime,proto3" json:"stopTime,omitempty"`
+	StopBinlogPos string   `protobuf:"bytes,6,opt,name=stopBinlogPos,proto3" json:"stopBinlogPos,omitempty"`
+	StopGTID      string   `protobuf:"bytes,7,opt,name=stopGTID,proto3" json:"stopGTID,omitempty"`
 }
 
 func (m *StartTaskRequest) Reset()         { *m = StartTaskRequest{} }
@@ -251,6 +254,27 @@ func (m *StartTaskRequest) GetStartTime() string {
 	return ""
 }
 
+func (m *StartTaskRequest) GetStopTime() string {
+	if m != nil {
+		return m.StopTime
+	}
+	return ""
+}
+
+func (m *StartTaskRequest) GetStopBinlogPos() string {
+	if m != nil {
+		return m.StopBinlogPos
+	}
+	return ""
+}
+
+func (m *StartTaskRequest) GetStopGTID() string {
+	if m != nil {
+		return m.StopGTID
+	}
+	return ""
+}
+
 type StartTaskResponse struct {
 	Result      bool                    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	Msg         string                  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -3960,174 +3984,174 @@ func init() {
 func init() { proto.RegisterFile("dmmaster.proto", fileDescriptor_f9bef11f2a341f03) }
 
 var fileDescriptor_f9bef11f2a341f03 = []byte{
-	// 2658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0xcd, 0x6f, 0x1b, 0xc7,
-	0xf5, 0x5c, 0x52, 0x1f, 0xd4, 0x93, 0x44, 0x51, 0x23, 0x89, 0xa2, 0xd7, 0x32, 0x2d, 0x4f, 0x3e,
-	0x20, 0x08, 0x81, 0xf4, 0xb3, 0x7e, 0x39, 0x14, 0x06, 0x12, 0x24, 0x16, 0x15, 0x5b, 0x88, 0x6c,
-	0xa7, 0x2b, 0xd9, 0x6d, 0x10, 0xa0, 0xc9, 0x92, 0x1c, 0x52, 0x84, 0x96, 0xbb, 0xeb, 0xdd, 0xa5,
-	0x64, 0xc2, 0x48, 0x0f, 0x3d, 0xf5, 0xd2, 0xb4, 0x45, 0x8a, 0xe6, 0xd8, 0x43, 0xff, 0x81, 0xfe,
-	0x19, 0x3d, 0x06, 0xc8, 0xa5, 0x97, 0xa2, 0x85, 0xdd, 0x3f, 0xa4, 0x98, 0x37, 0xb3, 0xbb, 0xb3,
-	0x1f, 0x64, 0x4a, 0x03, 0x15, 0x7a, 0x9b, 0xf7, 0xde, 0xf0, 0x7d, 0xcd, 0x9b, 0x79, 0x1f, 0x4b,
-	0xa8, 0x74, 0x06, 0x03, 0xd3, 0x0f, 0x98, 0xb7, 0xe7, 0x7a, 0x4e, 0xe0, 0x90, 0xa2, 0xdb, 0xd2,
-	0x2b, 0x9d, 0xc1, 0x95, 0xe3, 0x5d, 0x84, 0x38, 0x7d, 0xab, 0xe7, 0x38, 0x3d, 0x8b, 0xed, 0x9b,
-	0x6e, 0x7f, 0xdf, 0xb4, 0x6d, 0x27, 0x30, 0x83, 0xbe, 0x63, 0xfb, 0x92, 0x7a, 0x53, 0x52, 0x11,
-	0x6a, 0x0d, 0xbb, 0xfb, 0x6c, 0xe0, 0x06, 0x23, 0x41, 0xa4, 0xbf, 0x84, 0xea, 0x69, 0x60, 0x7a,
-	0xc1, 0x99, 0xe9, 0x5f, 0x18, 0xec, 0xf9, 0x90, 0xf9, 0x01, 0x21, 0x30, 0x13, 0x98, 0xfe, 0x45,
-	0x5d, 0xdb, 0xd6, 0x76, 0x16, 0x0c, 0x5c, 0x93, 0x3a, 0xcc, 0xfb, 0xce, 0xd0, 0x6b, 0x33, 0xbf,
-	0x5e, 0xdc, 0x2e, 0xed, 0x2c, 0x18, 0x21, 0x48, 0x1a, 0x00, 0x1e, 0x1b, 0x38, 0x97, 0xec, 0x11,
-	0x0b, 0xcc, 0x7a, 0x69, 0x5b, 0xdb, 0x29, 0x1b, 0x0a, 0x86, 0x6c, 0xc1, 0x82, 0x8f, 0x12, 0xfa,
-	0x03, 0x56, 0x9f, 0x41, 0x96, 0x31, 0x82, 0x7e, 0xab, 0xc1, 0xaa, 0xa2, 0x80, 0xef, 0x3a, 0xb6,
-	0xcf, 0x48, 0x0d, 0xe6, 0x3c, 0xe6, 0x0f, 0xad, 0x00, 0x75, 0x28, 0x1b, 0x12, 0x22, 0x55, 0x28,
-	0x0d, 0xfc, 0x5e, 0xbd, 0x88, 0x5c, 0xf8, 0x92, 0x1c, 0xc4, 0x7a, 0x95, 0xb6, 0x4b, 0x3b, 0x8b,
-	0x07, 0xf5, 0x3d, 0xb7, 0xb5, 0x77, 0xe8, 0x0c, 0x06, 0x8e, 0xfd, 0x33, 0xf4, 0x51, 0xc8, 0x34,
-	0xd6, 0x78, 0x1b, 0x16, 0xdb, 0xe7, 0xac, 0xcd, 0xc5, 0x71, 0x11, 0x42, 0x27, 0x15, 0x45, 0x7f,
-	0x01, 0xe4, 0x89, 0xcb, 0x3c, 0x33, 0x60, 0xaa, 0x5f, 0x74, 0x28, 0x3a, 0x2e, 0x6a, 0x54, 0x39,
-	0x00, 0x2e, 0x86, 0x13, 0x9f, 0xb8, 0x46, 0xd1, 0x71, 0xb9, 0xcf, 0x6c, 0x73, 0xc0, 0xa4, 0x6a,
-	0xb8, 0x56, 0x7d, 0x56, 0x4a, 0xf8, 0x8c, 0xfe, 0x56, 0x83, 0xb5, 0x84, 0x00, 0x69, 0xf7, 0x24,
-	0x09, 0xb1, 0x4f, 0x8a, 0x79, 0x3e, 0x29, 0xe5, 0xfa, 0x64, 0xe6, 0x3f, 0xf4, 0x09, 0xfd, 0x18,
-	0x56, 0x9f, 0xba, 0x9d, 0x94, 0xc1, 0x53, 0x05, 0x02, 0xfd, 0x83, 0x06, 0x44, 0xe5, 0xf1, 0x3f,
-	0x72, 0x96, 0x9f, 0x40, 0xed, 0xa7, 0x43, 0xe6, 0x8d, 0x4e, 0x03, 0x33, 0x18, 0xfa, 0x27, 0x7d,
-	0x3f, 0x50, 0xcc, 0xc3, 0x33, 0xd3, 0xf2, 0xcf, 0x2c, 0x65, 0xde, 0x25, 0x6c, 0x66, 0xf8, 0x4c,
-	0x6d, 0xe2, 0xdd, 0xb4, 0x89, 0x9b, 0xdc, 0x44, 0x85, 0x6f, 0xf6, 0x64, 0x0e, 0x61, 0xed, 0xf4,
-	0xdc, 0xb9, 0x6a, 0x36, 0x4f, 0x4e, 0x9c, 0xf6, 0x85, 0xff, 0x66, 0x67, 0xf3, 0x27, 0x0d, 0xe6,
-	0x25, 0x07, 0x52, 0x81, 0xe2, 0x71, 0x53, 0xfe, 0xae, 0x78, 0xdc, 0x8c, 0x38, 0x15, 0x15, 0x4e,
-	0x04, 0x66, 0x06, 0x4e, 0x87, 0xc9, 0xa8, 0xc2, 0x35, 0x59, 0x87, 0x59, 0xe7, 0xca, 0x66, 0x9e,
-	0x74, 0xb2, 0x00, 0xf8, 0xce, 0x66, 0xf3, 0xc4, 0xaf, 0xcf, 0xa2, 0x40, 0x5c, 0x73, 0x7f, 0xf8,
-	0x23, 0xbb, 0xcd, 0x3a, 0xf5, 0x39, 0xc4, 0x4a, 0x88, 0xe8, 0x50, 0x1e, 0xda, 0x92, 0x32, 0x8f,
-	0x94, 0x08, 0xa6, 0x6d, 0x58, 0x4f, 0x9a, 0x39, 0xb5, 0x6f, 0xef, 0xc0, 0xac, 0xc5, 0x7f, 0x2a,
-	0x3d, 0xbb, 0xc8, 0x3d, 0x2b, 0xd9, 0x19, 0x82, 0x42, 0xff, 0xae, 0xc1, 0xfa, 0x53, 0x9b, 0xaf,
-	0x43, 0x82, 0xf4, 0x66, 0xda, 0x27, 0x14, 0x96, 0x3c, 0xe6, 0x5a, 0x66, 0x9b, 0x3d, 0x41, 0x93,
-	0x85, 0x98, 0x04, 0x8e, 0x87, 0x5e, 0xd7, 0xf1, 0xda, 0xcc, 0xc0, 0xb7, 0x4e, 0xbe, 0x7c, 0x2a,
-	0x8a, 0xbc, 0x85, 0xd7, 0x79, 0x06, 0xaf, 0xf3, 0x1a, 0x57, 0x27, 0x21, 0x5b, 0xde, 0x6b, 0xe5,
-	0xd0, 0x66, 0x93, 0x2f, 0xab, 0x0e, 0xe5, 0x8e, 0x19, 0x98, 0x2d, 0xd3, 0x67, 0xf5, 0x39, 0x54,
-	0x20, 0x82, 0xf9, 0x61, 0x04, 0x66, 0xcb, 0x62, 0xf5, 0x79, 0x71, 0x18, 0x08, 0xd0, 0x8f, 0x61,
-	0x23, 0x65, 0xde, 0xb4, 0x5e, 0xa4, 0x06, 0xdc, 0x90, 0x2f, 0x53, 0x78, 0xe5, 0x2c, 0x73, 0x14,
-	0xba, 0xe9, 0xa6, 0xf2, 0x3e, 0xa1, 0x7f, 0x91, 0x9a, 0x35, 0x24, 0x15, 0x7d, 0xdf, 0x69, 0xa0,
-	0xe7, 0x31, 0x95, 0xca, 0x4d, 0xe4, 0xfa, 0xdf, 0x7d, 0xf6, 0xbe, 0xd3, 0x60, 0xf3, 0xb3, 0xa1,
-	0xd7, 0xcb, 0x33, 0x56, 0xb1, 0x47, 0xcb, 0x1c, 0x4c, 0xdf, 0x36, 0xdb, 0x41, 0xff, 0x92, 0x49,
-	0xad, 0x22, 0x18, 0x6f, 0x13, 0xcf, 0x74, 0x5c, 0xb1, 0x92, 0x81, 0x6b, 0xbe, 0xbf, 0xdb, 0xb7,
-	0x18, 0x3e, 0x36, 0xe2, 0xf2, 0x44, 0x30, 0xde, 0x95, 0x61, 0xab, 0xd9, 0xf7, 0xea, 0xb3, 0x48,
-	0x91, 0x10, 0x7d, 0x01, 0xf5, 0xac, 0x62, 0xd7, 0xf1, 0xa4, 0xd2, 0x4b, 0xa8, 0x1e, 0xf2, 0xf7,
-	0xf3, 0xc7, 0x32, 0x41, 0x0d, 0xe6, 0x98, 0xe7, 0x1d, 0xda, 0xe2, 0x64, 0x4a, 0x86, 0x84, 0xb8,
-	0xdf, 0xae, 0x4c, 0xcf, 0xe6, 0x04, 0xe1, 0x84, 0x10, 0xfc, 0x91, 0x52, 0xe0, 0x03, 0x58, 0x55,
-	0xe4, 0x4e, 0x1d, 0xb8, 0xbf, 0xd6, 0x60, 0x5d, 0x06, 0xd9, 0x29, 0x5a, 0x12, 0xea, 0xbe, 0xa5,
-	0x84, 0xd7, 0x12, 0x37, 0x5f, 0x90, 0xe3, 0xf8, 0x6a, 0x3b, 0x76, 0xb7, 0xdf, 0x93, 0x41, 0x2b,
-	0x21, 0x7e, 0x66, 0xc2, 0x21, 0xc7, 0x4d, 0x99, 0xbd, 0x23, 0x98, 0x97, 0x3c, 0xa2, 0xfe, 0x7a,
-	0x1c, 0x9f, 0xa8, 0x82, 0xa1, 0x43, 0xd8, 0x48, 0x69, 0x72, 0x2d, 0x07, 0x77, 0x04, 0x1b, 0x06,
-	0xeb, 0xf5, 0x79, 0xb1, 0x18, 0x6e, 0x99, 0x98, 0xe8, 0xcc, 0x4e, 0xc7, 0x63, 0xbe, 0x2f, 0xc5,
-	0x86, 0x20, 0xfd, 0x0a, 0x6a, 0x69, 0x36, 0x53, 0xab, 0xcf, 0x4f, 0x9a, 0xb5, 0x3d, 0x16, 0x7c,
-	0xca, 0x46, 0x18, 0x05, 0x4b, 0x46, 0x8c, 0xa0, 0x1f, 0xc2, 0xfa, 0x93, 0x6e, 0xd7, 0xea, 0xdb,
-	0xec, 0x11, 0x1b, 0xb4, 0x12, 0x7a, 0x06, 0x23, 0x37, 0xd2, 0x93, 0xaf, 0xf3, 0x0a, 0x2b, 0xfe,
-	0xcc, 0xa5, 0x7e, 0x3f, 0x75, 0xb4, 0xbc, 0x1f, 0x05, 0xcb, 0x09, 0x33, 0x3b, 0xb1, 0x0a, 0x99,
-	0x60, 0x11, 0x64, 0x11, 0x2c, 0x28, 0x38, 0xf9, 0xab, 0xa9, 0x05, 0x7f, 0xa3, 0x01, 0x3c, 0xc2,
-	0x82, 0xfe, 0xd8, 0xee, 0x3a, 0xb9, 0x47, 0xa3, 0x43, 0x79, 0x80, 0x76, 0x1d, 0x37, 0xf1, 0x97,
-	0x33, 0x46, 0x04, 0xf3, 0x77, 0xdf, 0xb4, 0xfa, 0x51, 0xba, 0x11, 0x00, 0xff, 0x85, 0xcb, 0x98,
-	0xf7, 0xd4, 0x38, 0x11, 0x6f, 0xdf, 0x82, 0x11, 0xc1, 0x3c, 0x58, 0xdb, 0x56, 0x9f, 0xd9, 0x01,
-	0x52, 0x45, 0x8a, 0x51, 0x30, 0xb4, 0x05, 0x20, 0x8e, 0x79, 0xac, 0x3e, 0x04, 0x66, 0x78, 0x6c,
-	0x84, 0x47, 0xc0, 0xd7, 0x5c, 0x0f, 0x3f, 0x30, 0x7b, 0x61, 0x85, 0x20, 0x00, 0x7c, 0xcc, 0x30,
-	0x18, 0xe5, 0xa5, 0x90, 0x10, 0x3d, 0x81, 0x2a, 0x2f, 0x98, 0x84, 0xd3, 0xc4, 0x99, 0x85, 0xae,
-	0xd1, 0xe2, 0xa0, 0xc9, 0xab, 0xa1, 0x43, 0xd9, 0xa5, 0x58, 0x36, 0x7d, 0x2c, 0xb8, 0x09, 0x2f,
-	0x8e, 0xe5, 0xb6, 0x03, 0xf3, 0xa2, 0x71, 0x12, 0xe9, 0x68, 0xf1, 0xa0, 0xc2, 0x8f, 0x33, 0x76,
-	0xbd, 0x11, 0x92, 0x43, 0x7e, 0xc2, 0x0b, 0x93, 0xf8, 0x89, 0x2b, 0x9e, 0xe0, 0x17, 0xbb, 0xce,
-	0x08, 0xc9, 0xf4, 0xcf, 0x1a, 0xcc, 0x0b, 0x36, 0x3e, 0xd9, 0x83, 0x39, 0x0b, 0xad, 0x46, 0x56,
-	0x8b, 0x07, 0xeb, 0x18, 0x53, 0x29, 0x5f, 0x3c, 0x2c, 0x18, 0x72, 0x17, 0xdf, 0x2f, 0xd4, 0x42,
-	0x2f, 0x28, 0xfb, 0x55, 0x6b, 0xf9, 0x7e, 0xb1, 0x8b, 0xef, 0x17, 0x62, 0xd1, 0x43, 0xca, 0x7e,
-	0xd5, 0x1a, 0xbe, 0x5f, 0xec, 0xba, 0x5f, 0x86, 0x39, 0x11, 0x4b, 0xf4, 0x39, 0xac, 0x22, 0xdf,
-	0xc4, 0x0d, 0xac, 0x25, 0xd4, 0x2d, 0x47, 0x6a, 0xd5, 0x12, 0x6a, 0x95, 0x23, 0xf1, 0xb5, 0x84,
-	0xf8, 0x72, 0x28, 0x86, 0x87, 0x07, 0x3f, 0xbe, 0x30, 0x1a, 0x05, 0x40, 0x19, 0x10, 0x55, 0xe4,
-	0xd4, 0xaf, 0xca, 0x3b, 0x30, 0x2f, 0x94, 0x4f, 0xd4, 0x78, 0xd2, 0xd5, 0x46, 0x48, 0xa3, 0x7f,
-	0x2c, 0xc6, 0x99, 0xa0, 0x7d, 0xce, 0x06, 0xe6, 0xf8, 0x4c, 0x80, 0xe4, 0xb8, 0x85, 0xcb, 0xd4,
-	0xc1, 0x63, 0x5b, 0xb8, 0x44, 0x71, 0x36, 0x33, 0xae, 0x38, 0x9b, 0x55, 0x8a, 0x33, 0xbc, 0x1c,
-	0x28, 0x4f, 0x16, 0x73, 0x12, 0xe2, 0xbb, 0xbb, 0xd6, 0xd0, 0x3f, 0xc7, 0x52, 0xae, 0x6c, 0x08,
-	0x80, 0x6b, 0xc3, 0x2b, 0xe3, 0x7a, 0x19, 0x91, 0xb8, 0xe6, 0x57, 0xb9, 0xeb, 0x39, 0x03, 0x91,
-	0x54, 0xea, 0x0b, 0xa2, 0xd5, 0x8e, 0x31, 0x21, 0xfd, 0xcc, 0xf4, 0x7a, 0x2c, 0xa8, 0x43, 0x4c,
-	0x17, 0x18, 0x35, 0x2f, 0x49, 0xbf, 0x5c, 0x4b, 0x5e, 0xda, 0x85, 0xf5, 0x07, 0x2c, 0x38, 0x1d,
-	0xb6, 0x78, 0x66, 0x3f, 0xec, 0xf6, 0x26, 0xa4, 0x25, 0xfa, 0x14, 0x36, 0x52, 0x7b, 0xa7, 0x56,
-	0x91, 0xc0, 0x4c, 0xbb, 0xdb, 0x0b, 0x0f, 0x0c, 0xd7, 0xb4, 0x09, 0xcb, 0x0f, 0x58, 0xa0, 0xc8,
-	0xbe, 0xad, 0xa4, 0x1a, 0x59, 0x75, 0x1e, 0x76, 0x7b, 0x67, 0x23, 0x97, 0x4d, 0xc8, 0x3b, 0x27,
-	0x50, 0x09, 0xb9, 0x4c, 0xad, 0x55, 0x15, 0x4a, 0xed, 0x6e, 0x54, 0xaf, 0xb6, 0xbb, 0x3d, 0xba,
-	0x01, 0x6b, 0x0f, 0x98, 0xbc, 0xd7, 0xb1, 0x66, 0x74, 0x07, 0xbd, 0xa5, 0xa0, 0xa5, 0x28, 0xc9,
-	0x40, 0x8b, 0x19, 0xfc, 0x5e, 0x03, 0xf2, 0xd0, 0xb4, 0x3b, 0x16, 0x3b, 0xf2, 0x3c, 0xc7, 0x1b,
-	0x5b, 0xa4, 0x23, 0xf5, 0x8d, 0x82, 0x7c, 0x0b, 0x16, 0x5a, 0x7d, 0xdb, 0x72, 0x7a, 0x9f, 0x39,
-	0x7e, 0x58, 0xb0, 0x45, 0x08, 0x0c, 0xd1, 0xe7, 0x56, 0xd4, 0xfa, 0xf1, 0x35, 0xf5, 0x61, 0x2d,
-	0xa1, 0xd2, 0xb5, 0x04, 0xd8, 0x03, 0xd8, 0x38, 0xf3, 0x4c, 0xdb, 0xef, 0x32, 0x2f, 0x59, 0xfa,
-	0xc5, 0xf9, 0x48, 0x53, 0xf3, 0x91, 0xf2, 0x6c, 0x09, 0xc9, 0x12, 0xa2, 0xf7, 0xa1, 0x96, 0x66,
-	0x34, 0x75, 0x82, 0xef, 0x44, 0xa3, 0x9d, 0x44, 0x37, 0x71, 0x4b, 0x39, 0x95, 0x65, 0xa5, 0xc9,
-	0x79, 0x76, 0x10, 0x96, 0xa1, 0x52, 0xd3, 0xe2, 0x18, 0x4d, 0xc5, 0xd1, 0x84, 0x9a, 0x06, 0xd1,
-	0x13, 0x77, 0x9d, 0xad, 0xc1, 0x5f, 0x34, 0xa8, 0xe1, 0xb4, 0xee, 0x99, 0x69, 0xf5, 0x3b, 0x38,
-	0x65, 0x8c, 0x2f, 0x14, 0x0c, 0x9c, 0x0e, 0xfb, 0xf2, 0xd2, 0xb4, 0x86, 0xd2, 0xdd, 0x0f, 0x0b,
-	0xc6, 0x02, 0xc7, 0x3d, 0xe3, 0x28, 0xb2, 0x0b, 0x55, 0xac, 0xf5, 0xbf, 0xe4, 0x2d, 0x91, 0xdc,
-	0x86, 0xea, 0x3c, 0xd4, 0x8c, 0x4a, 0xd4, 0x05, 0x88, 0xbd, 0x13, 0x9f, 0x5d, 0x1e, 0xb3, 0x4a,
-	0xe1, 0x1d, 0xc1, 0xf7, 0xe7, 0xc4, 0xd0, 0xe2, 0xfe, 0xa2, 0xd2, 0x66, 0xd0, 0x2b, 0xd8, 0xcc,
-	0x68, 0x7c, 0x2d, 0xbe, 0x7a, 0x04, 0x1b, 0xa7, 0x81, 0xe3, 0x66, 0x3d, 0x35, 0xb1, 0xaf, 0x8c,
-	0x8c, 0x2b, 0x26, 0x8d, 0xa3, 0x97, 0xdc, 0xf3, 0x49, 0x76, 0xd7, 0x62, 0xc6, 0x6f, 0x34, 0xd8,
-	0x14, 0x53, 0xbd, 0xac, 0x25, 0xaa, 0xbe, 0x5a, 0x52, 0xdf, 0x09, 0x03, 0xe3, 0xc4, 0xa3, 0x52,
-	0x4a, 0x3f, 0x2a, 0x0d, 0x00, 0x01, 0x3c, 0x38, 0x3b, 0x6e, 0x86, 0xbd, 0x55, 0x8c, 0xe1, 0x7d,
-	0x71, 0x56, 0x9d, 0x6b, 0xf1, 0xc4, 0x1e, 0x54, 0x8e, 0xec, 0xb6, 0x37, 0x72, 0x83, 0xb8, 0x9e,
-	0x58, 0x70, 0x2d, 0xb3, 0x6f, 0x07, 0xec, 0x45, 0x20, 0x1d, 0x10, 0x23, 0xe8, 0x17, 0xb0, 0x12,
-	0xed, 0x9f, 0x5a, 0x41, 0x5e, 0xb5, 0xf7, 0xdd, 0x73, 0xe6, 0x21, 0x6f, 0xe1, 0x25, 0x05, 0x43,
-	0x7f, 0xd0, 0x60, 0x93, 0xd7, 0x52, 0x98, 0x26, 0xb1, 0x63, 0x7d, 0x93, 0x91, 0xd9, 0x63, 0x58,
-	0x0c, 0x62, 0x06, 0xd2, 0x15, 0xef, 0x85, 0x25, 0x64, 0x0e, 0xef, 0x3d, 0x05, 0x77, 0x64, 0x07,
-	0xde, 0xc8, 0x50, 0x19, 0xe8, 0x1f, 0x42, 0x35, 0xbd, 0x81, 0x4b, 0xbd, 0x60, 0xa3, 0x30, 0x6f,
-	0x5d, 0xb0, 0x11, 0x2f, 0x78, 0x94, 0xeb, 0x6f, 0x08, 0xe0, 0x5e, 0xf1, 0x27, 0x1a, 0xfd, 0x87,
-	0x06, 0x37, 0xb8, 0x64, 0xf1, 0xf8, 0xbe, 0xb9, 0x5d, 0xcf, 0x60, 0xd9, 0x57, 0x59, 0x48, 0xcb,
-	0xfe, 0x2f, 0xb4, 0x2c, 0x97, 0xff, 0x5e, 0x02, 0x2b, 0xac, 0x4b, 0xb2, 0xd1, 0x3f, 0x02, 0x92,
-	0xdd, 0x34, 0x8d, 0x85, 0xbb, 0x1f, 0xc1, 0x4a, 0x6a, 0x08, 0x48, 0x56, 0x61, 0xf9, 0xd8, 0xbe,
-	0xe4, 0xd1, 0x2c, 0x10, 0xd5, 0x02, 0x59, 0x82, 0xf2, 0xe9, 0x45, 0xdf, 0xe5, 0x70, 0x55, 0xe3,
-	0xd0, 0xd1, 0x0b, 0xd6, 0x46, 0xa8, 0xb8, 0xdb, 0x82, 0x72, 0x38, 0xc0, 0x20, 0x6b, 0xb0, 0x22,
-	0x7f, 0x1a, 0xa2, 0xaa, 0x05, 0xb2, 0x02, 0x8b, 0xf8, 0xe2, 0x09, 0x54, 0x55, 0x23, 0x55, 0x58,
-	0x12, 0x57, 0x46, 0x62, 0x8a, 0xa4, 0x02, 0xc0, 0x1f, 0x13, 0x09, 0x97, 0x10, 0x3e, 0x77, 0xae,
-	0x24, 0x3c, 0xb3, 0xfb, 0x29, 0x94, 0xc3, 0xbe, 0x57, 0x91, 0x11, 0xa2, 0xaa, 0x05, 0xae, 0xf3,
-	0xd1, 0x65, 0xbf, 0x1d, 0x44, 0x28, 0x8d, 0x6c, 0xc2, 0xda, 0xa1, 0x69, 0xb7, 0x99, 0x95, 0x24,
-	0x14, 0x77, 0x6d, 0x98, 0x97, 0xa5, 0x15, 0x57, 0x4d, 0xf2, 0xe2, 0xa0, 0x30, 0x94, 0x07, 0x0c,
-	0x42, 0x1a, 0x57, 0x43, 0xd4, 0x3d, 0x08, 0xa3, 0x9a, 0xe2, 0x32, 0x22, 0x2c, 0xd4, 0x44, 0x15,
-	0x11, 0x9e, 0x21, 0xeb, 0x22, 0xdc, 0xce, 0xd8, 0xc0, 0xb5, 0xcc, 0x40, 0x60, 0x67, 0x77, 0x9b,
-	0xb0, 0x10, 0xe5, 0x56, 0xbe, 0x45, 0x4a, 0x8c, 0x70, 0xd5, 0x02, 0xf7, 0x08, 0xba, 0x08, 0x71,
-	0xcf, 0x0e, 0xaa, 0x9a, 0x70, 0x9a, 0xe3, 0x86, 0x88, 0xe2, 0xc1, 0x37, 0xeb, 0x30, 0x27, 0x94,
-	0x21, 0x9f, 0xc3, 0x42, 0xf4, 0x89, 0x8a, 0x60, 0x83, 0x95, 0xfe, 0x64, 0xa6, 0x6f, 0xa4, 0xb0,
-	0x22, 0xa2, 0xe8, 0xed, 0x5f, 0xfd, 0xf0, 0xaf, 0x6f, 0x8b, 0x37, 0xe8, 0xfa, 0xbe, 0xe9, 0xf6,
-	0xfd, 0xfd, 0xcb, 0xbb, 0xa6, 0xe5, 0x9e, 0x9b, 0x77, 0xf7, 0xf9, 0x9d, 0xf1, 0xef, 0x69, 0xbb,
-	0xa4, 0x0b, 0x8b, 0xca, 0x77, 0x20, 0x52, 0xe3, 0x6c, 0xb2, 0x5f, 0x9e, 0xf4, 0xcd, 0x0c, 0x5e,
-	0x0a, 0x78, 0x17, 0x05, 0x6c, 0xeb, 0x37, 0xf3, 0x04, 0xec, 0xbf, 0xe4, 0x55, 0xeb, 0xd7, 0x5c,
-	0xce, 0x07, 0x00, 0xf1, 0xa7, 0x19, 0x82, 0xda, 0x66, 0x3e, 0xf7, 0xe8, 0xb5, 0x34, 0x5a, 0x0a,
-	0x29, 0x10, 0x0b, 0x16, 0x95, 0x6f, 0x14, 0x44, 0x4f, 0x7d, 0xb4, 0x50, 0x3e, 0xaa, 0xe8, 0x37,
-	0x73, 0x69, 0x92, 0xd3, 0xdb, 0xa8, 0x6e, 0x83, 0x6c, 0xa5, 0xd4, 0xf5, 0x71, 0xab, 0xd4, 0x97,
-	0x1c, 0xc2, 0x92, 0xfa, 0x29, 0x80, 0xa0, 0xf5, 0x39, 0xdf, 0x40, 0xf4, 0x7a, 0x96, 0x10, 0xa9,
-	0xfc, 0x09, 0x2c, 0x27, 0x2e, 0x1a, 0xa9, 0x67, 0x06, 0xf0, 0x21, 0x9b, 0x1b, 0x39, 0x94, 0x88,
-	0xcf, 0xe7, 0x50, 0xcb, 0x8e, 0xae, 0xd1, 0x8b, 0xb7, 0x94, 0x43, 0xc9, 0x8e, 0x8f, 0xf5, 0xc6,
-	0x38, 0x72, 0xc4, 0xfa, 0x09, 0x54, 0xd3, 0x23, 0x5e, 0x82, 0xee, 0x1b, 0x33, 0x91, 0xd6, 0xb7,
-	0xf2, 0x89, 0x11, 0xc3, 0x7b, 0xb0, 0x10, 0x4d, 0x50, 0x45, 0xa0, 0xa6, 0x07, 0xb9, 0x22, 0x50,
-	0x33, 0x63, 0x56, 0x5a, 0x20, 0x3d, 0x58, 0x4e, 0xcc, 0x2c, 0x85, 0xbf, 0xf2, 0x06, 0xaa, 0xc2,
-	0x5f, 0xb9, 0x03, 0x4e, 0x7a, 0x07, 0x0f, 0xf8, 0xa6, 0x5e, 0x4b, 0x1f, 0xb0, 0xc8, 0xa1, 0x3c,
-	0x14, 0x8f, 0xa1, 0x92, 0x1c, 0x2f, 0x92, 0x1b, 0xa2, 0x1c, 0xce, 0x99, 0x5c, 0xea, 0x7a, 0x1e,
-	0x29, 0xd2, 0xd9, 0x83, 0xe5, 0xc4, 0x1c, 0x50, 0xea, 0x9c, 0x33, 0x5a, 0x94, 0x3a, 0xe7, 0x0d,
-	0x0d, 0xe9, 0x7b, 0xa8, 0xf3, 0xbb, 0xbb, 0x6f, 0xa7, 0x74, 0x96, 0xe3, 0x84, 0xfd, 0x97, 0xbc,
-	0x1f, 0xfc, 0x3a, 0x0c, 0xce, 0x8b, 0xc8, 0x4f, 0xe2, 0x89, 0x4b, 0xf8, 0x29, 0x31, 0x4b, 0x4c,
-	0xf8, 0x29, 0x39, 0x2f, 0xa4, 0xef, 0xa0, 0xcc, 0xdb, 0xba, 0x9e, 0x92, 0x29, 0xc6, 0x2d, 0xfb,
-	0x2f, 0x1d, 0x17, 0xaf, 0xed, 0x17, 0x00, 0xf1, 0xc0, 0x44, 0x5c, 0xdb, 0xcc, 0xcc, 0x46, 0x5c,
-	0xdb, 0xec, 0x5c, 0x85, 0x36, 0x50, 0x46, 0x9d, 0xd4, 0xf2, 0xed, 0x22, 0xdd, 0xf8, 0xc4, 0xc5,
-	0x20, 0x22, 0x71, 0xe2, 0xea, 0xe0, 0x24, 0x79, 0xe2, 0x89, 0xd1, 0x01, 0xdd, 0x46, 0x29, 0xba,
-	0xbe, 0x91, 0x3e, 0x71, 0xdc, 0xc6, 0x8d, 0xb0, 0xb0, 0xf7, 0x8e, 0x5b, 0x7a, 0x21, 0x27, 0x6f,
-	0x22, 0x20, 0xe4, 0xe4, 0xf6, 0xff, 0xe1, 0x4b, 0x47, 0x1a, 0x69, 0x39, 0xc3, 0x96, 0xfa, 0xd8,
-	0x91, 0x33, 0x98, 0x13, 0x3d, 0x3a, 0x59, 0x95, 0xcc, 0x14, 0xfe, 0x44, 0x45, 0x49, 0xc6, 0x6f,
-	0x21, 0xe3, 0x5b, 0x64, 0xd2, 0x13, 0x4a, 0xbe, 0x82, 0x45, 0xa5, 0xad, 0x15, 0xef, 0x74, 0xb6,
-	0xf5, 0x16, 0xef, 0x74, 0x4e, 0xff, 0x3b, 0xd6, 0x4b, 0x8c, 0xef, 0xc2, 0x6b, 0x71, 0x08, 0x4b,
-	0x6a, 0xdb, 0x2f, 0x1e, 0xbd, 0x9c, 0xf9, 0x80, 0x5e, 0xcf, 0x12, 0xa2, 0x0b, 0x71, 0x0c, 0x95,
-	0x64, 0xff, 0x2a, 0xee, 0x56, 0x6e, 0x73, 0x2c, 0xee, 0x56, 0x7e, 0xbb, 0x4b, 0x0b, 0x5c, 0x1f,
-	0xb5, 0xc1, 0x24, 0x6a, 0x0a, 0x4a, 0x3c, 0x4a, 0xf5, 0x2c, 0x21, 0x62, 0x72, 0x02, 0x2b, 0xa9,
-	0xe6, 0x4b, 0xe4, 0x8e, 0xfc, 0x1e, 0x52, 0xe4, 0x8e, 0x31, 0xdd, 0x9a, 0xb0, 0x2e, 0xd9, 0x02,
-	0x09, 0xeb, 0x72, 0xbb, 0x2c, 0x5d, 0xcf, 0x23, 0x45, 0xac, 0x7e, 0x8e, 0xb3, 0x97, 0x98, 0x24,
-	0x13, 0x5b, 0x43, 0xfa, 0x36, 0x4d, 0x08, 0x99, 0xde, 0x1e, 0x4b, 0x8f, 0x38, 0x3f, 0x05, 0x92,
-	0xd8, 0x20, 0x02, 0xe6, 0x56, 0xe6, 0x87, 0x89, 0xb8, 0x69, 0x8c, 0x23, 0x47, 0x6c, 0xcd, 0x28,
-	0x0d, 0xa5, 0x59, 0xdf, 0x51, 0xfc, 0x3f, 0x86, 0x3d, 0x9d, 0xb4, 0x45, 0x4d, 0x47, 0xe9, 0xce,
-	0x4a, 0xa4, 0xa3, 0x31, 0xed, 0x9f, 0x48, 0x47, 0xe3, 0x9a, 0x31, 0x5a, 0x20, 0xef, 0xc3, 0xbc,
-	0x6c, 0x80, 0x08, 0x5e, 0xbc, 0x64, 0xf7, 0xa4, 0xaf, 0x25, 0x70, 0xd1, 0xaf, 0x1e, 0xc2, 0x4a,
-	0xaa, 0xf9, 0x20, 0xb5, 0x3d, 0xf1, 0x17, 0xa6, 0xbd, 0xf0, 0x2f, 0x4c, 0x7b, 0x47, 0x03, 0x37,
-	0x18, 0x89, 0x78, 0x19, 0xd3, 0xa9, 0x60, 0xf4, 0xad, 0x66, 0x8a, 0xfd, 0xb1, 0xbc, 0x6e, 0x4d,
-	0xec, 0x0d, 0x68, 0xe1, 0x7e, 0xfd, 0xaf, 0xaf, 0x1a, 0xda, 0xf7, 0xaf, 0x1a, 0xda, 0x3f, 0x5f,
-	0x35, 0xb4, 0xdf, 0xbd, 0x6e, 0x14, 0xbe, 0x7f, 0xdd, 0x28, 0xfc, 0xed, 0x75, 0xa3, 0xd0, 0x9a,
-	0x43, 0x56, 0xff, 0xff, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x57, 0xbf, 0xa1, 0xab, 0x25,
-	0x00, 0x00,
+	// 2667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x1a, 0xcb, 0x6e, 0x1c, 0xc7,
+	0x51, 0xb3, 0xcb, 0x67, 0xad, 0xf8, 0x6a, 0x92, 0xcb, 0xd5, 0x48, 0xa2, 0xa4, 0xb1, 0x6c, 0x08,
+	0x84, 0x41, 0x46, 0x8c, 0x0f, 0x81, 0x00, 0x1b, 0x0e, 0x1f, 0x96, 0x04, 0x53, 0x92, 0x3d, 0xa4,
+	0x94, 0x18, 0x06, 0x62, 0xcf, 0x2e, 0x7b, 0x97, 0x0b, 0xee, 0xce, 0x8c, 0x66, 0x66, 0x49, 0x11,
+	0x82, 0x2f, 0x39, 0xe5, 0x12, 0x27, 0x81, 0x83, 0xe4, 0x98, 0x43, 0x7e, 0x20, 0x9f, 0x91, 0xa3,
+	0x01, 0x5f, 0x72, 0x48, 0x90, 0x20, 0xc9, 0x87, 0xb8, 0xbb, 0xaa, 0x67, 0xa6, 0xe7, 0xb1, 0x9b,
+	0xac, 0x80, 0x10, 0x39, 0x2c, 0x30, 0x55, 0xd5, 0x53, 0xaf, 0xae, 0xae, 0xae, 0xaa, 0x59, 0x98,
+	0x3f, 0xee, 0xf7, 0x9d, 0x30, 0xe2, 0xc1, 0xa6, 0x1f, 0x78, 0x91, 0xc7, 0x2a, 0x7e, 0xd3, 0x14,
+	0xb8, 0x73, 0x2f, 0x38, 0x8d, 0x71, 0xe6, 0x8d, 0x8e, 0xe7, 0x75, 0x7a, 0x7c, 0xcb, 0xf1, 0xbb,
+	0x5b, 0x8e, 0xeb, 0x7a, 0x91, 0x13, 0x75, 0x3d, 0x37, 0x54, 0xd4, 0xeb, 0x8a, 0x8a, 0x50, 0x73,
+	0xd0, 0xde, 0xe2, 0x7d, 0x3f, 0xba, 0x20, 0xa2, 0xf5, 0x57, 0x03, 0x16, 0x0f, 0x23, 0x27, 0x88,
+	0x8e, 0x9c, 0xf0, 0xd4, 0xe6, 0x2f, 0x07, 0x3c, 0x8c, 0x18, 0x83, 0x89, 0x48, 0x80, 0x0d, 0xe3,
+	0xb6, 0x71, 0x6f, 0xd6, 0xc6, 0x67, 0xd6, 0x80, 0xe9, 0xd0, 0x1b, 0x04, 0x2d, 0x1e, 0x36, 0x2a,
+	0xb7, 0xab, 0x02, 0x1d, 0x83, 0x6c, 0x1d, 0x20, 0xe0, 0x7d, 0xef, 0x8c, 0x3f, 0xe1, 0x91, 0xd3,
+	0xa8, 0x8a, 0x77, 0x66, 0x6c, 0x0d, 0xc3, 0x6e, 0xc0, 0x6c, 0x88, 0x12, 0xba, 0x7d, 0xde, 0x98,
+	0x40, 0x96, 0x29, 0x82, 0x99, 0x30, 0x13, 0x46, 0x9e, 0x8f, 0xc4, 0x49, 0x24, 0x26, 0x30, 0xbb,
+	0x0b, 0x73, 0xf2, 0x79, 0xa7, 0xeb, 0xf6, 0xbc, 0xce, 0x27, 0x5e, 0xd8, 0x98, 0xc2, 0x05, 0x59,
+	0x64, 0xcc, 0xe1, 0xe1, 0xd1, 0xe3, 0xbd, 0xc6, 0x74, 0xca, 0x41, 0xc2, 0xd6, 0x37, 0x06, 0x2c,
+	0x69, 0xe6, 0x85, 0xbe, 0x70, 0x0b, 0x67, 0x75, 0x98, 0x0a, 0x78, 0x38, 0xe8, 0x45, 0x68, 0xe1,
+	0x8c, 0xad, 0x20, 0xb6, 0x08, 0xd5, 0x7e, 0xd8, 0x11, 0xf6, 0x49, 0x26, 0xf2, 0x91, 0x6d, 0xa7,
+	0x56, 0x57, 0x85, 0xd5, 0xb5, 0xed, 0xc6, 0xa6, 0xdf, 0xdc, 0xdc, 0xf5, 0xfa, 0x7d, 0xcf, 0xfd,
+	0x09, 0x6e, 0x41, 0xcc, 0x34, 0xf5, 0xc7, 0x6d, 0xa8, 0xb5, 0x4e, 0x78, 0x4b, 0x8a, 0x93, 0x22,
+	0xc8, 0x62, 0x1d, 0x65, 0xfd, 0x0c, 0xd8, 0x33, 0x9f, 0x07, 0x4e, 0xc4, 0x75, 0xaf, 0x9b, 0x50,
+	0xf1, 0x7c, 0xd4, 0x68, 0x7e, 0x1b, 0xa4, 0x18, 0x49, 0x7c, 0xe6, 0xdb, 0x02, 0x2b, 0x77, 0xc4,
+	0x75, 0x84, 0x87, 0x48, 0x35, 0x7c, 0xd6, 0x77, 0xa4, 0x9a, 0xd9, 0x11, 0xeb, 0x57, 0x06, 0x2c,
+	0x67, 0x04, 0x28, 0xbb, 0x47, 0x49, 0x48, 0x7d, 0x52, 0x29, 0xf3, 0x49, 0xb5, 0xd4, 0x27, 0x13,
+	0xff, 0xa5, 0x4f, 0xac, 0x1f, 0xc3, 0xd2, 0x73, 0xff, 0x38, 0x67, 0xf0, 0x58, 0x61, 0x66, 0xfd,
+	0xd6, 0x00, 0xa6, 0xf3, 0xf8, 0x3f, 0xd9, 0xcb, 0x8f, 0xa0, 0xfe, 0xe9, 0x80, 0x07, 0x17, 0x22,
+	0xca, 0xa2, 0x41, 0x78, 0xd0, 0x0d, 0x23, 0xcd, 0x3c, 0xdc, 0x33, 0xa3, 0x7c, 0xcf, 0x72, 0xe6,
+	0x9d, 0xc1, 0x5a, 0x81, 0xcf, 0xd8, 0x26, 0xde, 0xcf, 0x9b, 0xb8, 0x26, 0x4d, 0xd4, 0xf8, 0x16,
+	0x77, 0x66, 0x17, 0x96, 0x0f, 0x4f, 0xbc, 0xf3, 0xbd, 0xbd, 0x83, 0x03, 0xaf, 0x75, 0x1a, 0xbe,
+	0xd9, 0xde, 0xfc, 0xc1, 0x80, 0x69, 0xc5, 0x81, 0xcd, 0x43, 0x45, 0x1c, 0x44, 0x7a, 0x4f, 0x3c,
+	0x25, 0x9c, 0x2a, 0x1a, 0x27, 0x81, 0xeb, 0x7b, 0xc7, 0x5c, 0x45, 0x15, 0x3e, 0xb3, 0x15, 0x98,
+	0xf4, 0xce, 0x5d, 0x1e, 0x28, 0x27, 0x13, 0x20, 0x57, 0x0a, 0xc6, 0xa1, 0x48, 0x0d, 0x52, 0x20,
+	0x3e, 0x4b, 0x7f, 0x84, 0x17, 0x6e, 0x8b, 0x1f, 0x8b, 0x7c, 0x20, 0xb1, 0x0a, 0x92, 0x89, 0x60,
+	0xe0, 0x2a, 0xca, 0x34, 0x52, 0x12, 0xd8, 0x6a, 0xc1, 0x4a, 0xd6, 0xcc, 0xb1, 0x7d, 0x7b, 0x07,
+	0x26, 0x7b, 0xf2, 0x55, 0xe5, 0xd9, 0x9a, 0xf4, 0xac, 0x62, 0x67, 0x13, 0xc5, 0xfa, 0x9b, 0x01,
+	0x2b, 0xcf, 0x5d, 0xf9, 0x1c, 0x13, 0x94, 0x37, 0xf3, 0x3e, 0xb1, 0xe0, 0x6a, 0xc0, 0xfd, 0x9e,
+	0xd3, 0xe2, 0xcf, 0xd0, 0x64, 0x12, 0x93, 0xc1, 0xc9, 0xd0, 0x6b, 0x7b, 0xc2, 0xbb, 0x36, 0x66,
+	0x52, 0x95, 0x57, 0x75, 0x14, 0x7b, 0x0b, 0x8f, 0xf3, 0x04, 0x1e, 0xe7, 0x65, 0xa9, 0x4e, 0x46,
+	0xb6, 0x3a, 0xd7, 0xda, 0xa6, 0x4d, 0x66, 0xf3, 0xb6, 0x70, 0x97, 0x38, 0x4d, 0x4e, 0xd3, 0x09,
+	0xb9, 0x4a, 0xac, 0x09, 0x2c, 0x37, 0x43, 0x3c, 0xf5, 0xb8, 0x4a, 0xa8, 0x04, 0x88, 0x53, 0xbc,
+	0x9a, 0x33, 0x6f, 0x5c, 0x2f, 0x5a, 0x36, 0x5c, 0x53, 0x99, 0x29, 0x3e, 0x72, 0x3d, 0xe7, 0x22,
+	0x76, 0xd3, 0x75, 0x2d, 0x3f, 0xa1, 0x7f, 0x91, 0x5a, 0x34, 0x24, 0x17, 0x7d, 0xbf, 0x37, 0xc0,
+	0x2c, 0x63, 0xaa, 0x94, 0x1b, 0xc9, 0xf5, 0x7f, 0x9b, 0xf6, 0x84, 0x66, 0x6b, 0x9f, 0x0c, 0x82,
+	0x4e, 0x99, 0xb1, 0x9a, 0x3d, 0x46, 0x61, 0x63, 0xba, 0xae, 0xd3, 0x8a, 0xba, 0x67, 0x5c, 0x69,
+	0x95, 0xc0, 0x78, 0x9a, 0xe4, 0x55, 0x29, 0x15, 0xab, 0xda, 0xf8, 0x2c, 0xd7, 0xb7, 0xbb, 0x3d,
+	0x8e, 0xc9, 0x86, 0x0e, 0x4f, 0x02, 0xe3, 0x59, 0x19, 0x34, 0xf7, 0xba, 0x81, 0xba, 0x5c, 0x15,
+	0x64, 0xbd, 0x82, 0x46, 0x51, 0xb1, 0xcb, 0x48, 0xa9, 0x22, 0xd1, 0x2d, 0xee, 0xca, 0xfc, 0xf9,
+	0x9f, 0x6e, 0x02, 0xa1, 0x05, 0x0f, 0x82, 0x5d, 0x97, 0x76, 0xa6, 0x6a, 0x2b, 0x48, 0xfa, 0xed,
+	0xdc, 0x09, 0x5c, 0x49, 0x20, 0x27, 0xc4, 0xe0, 0xe8, 0x42, 0xc3, 0x7a, 0x1f, 0x96, 0x34, 0xb9,
+	0x63, 0x07, 0xee, 0x2f, 0xc4, 0xd9, 0x56, 0x41, 0x76, 0x88, 0x96, 0xc4, 0xba, 0xdf, 0xd0, 0xc2,
+	0xeb, 0xaa, 0x34, 0x9f, 0xc8, 0x69, 0x7c, 0xb5, 0x3c, 0xb7, 0xdd, 0xed, 0xa8, 0xa0, 0x55, 0x10,
+	0x16, 0x2d, 0xb8, 0x4e, 0xe4, 0x05, 0xba, 0xbd, 0x13, 0x58, 0x16, 0x54, 0x54, 0xde, 0x3d, 0x4d,
+	0x77, 0x54, 0xc3, 0x58, 0x03, 0x58, 0xcd, 0x69, 0x72, 0x29, 0x1b, 0xb7, 0x0f, 0xab, 0x36, 0xef,
+	0x74, 0x65, 0x2d, 0x1a, 0x2f, 0x19, 0x79, 0xd1, 0x39, 0xc7, 0xc7, 0x42, 0x7e, 0xa8, 0xc4, 0xc6,
+	0xa0, 0xf5, 0x25, 0xd4, 0xf3, 0x6c, 0xc6, 0x56, 0x5f, 0xee, 0x34, 0x6f, 0x05, 0x3c, 0xfa, 0x98,
+	0x5f, 0x60, 0x14, 0x5c, 0xb5, 0x53, 0x84, 0xf5, 0x81, 0xd8, 0xa9, 0x76, 0xbb, 0xd7, 0x75, 0x45,
+	0xfd, 0xd9, 0x6f, 0x66, 0xf4, 0x8c, 0x2e, 0xfc, 0x44, 0x4f, 0xf9, 0x5c, 0x56, 0x58, 0xc9, 0x34,
+	0x97, 0x7b, 0x7f, 0xec, 0x68, 0x79, 0x2f, 0x09, 0x96, 0x03, 0xee, 0x1c, 0xa7, 0x2a, 0x14, 0x82,
+	0x85, 0xc8, 0x14, 0x2c, 0x28, 0x38, 0xfb, 0xd6, 0xd8, 0x82, 0xbf, 0x36, 0x00, 0x9e, 0x60, 0xbf,
+	0xf0, 0xd8, 0x6d, 0x7b, 0xa5, 0x5b, 0x23, 0x42, 0xaf, 0x8f, 0x76, 0x89, 0xd0, 0x93, 0x6f, 0x4e,
+	0xd8, 0x09, 0x2c, 0xf3, 0xbe, 0xd3, 0xeb, 0x26, 0xd7, 0x0d, 0x01, 0xf2, 0x0d, 0x9f, 0xf3, 0xe0,
+	0xb9, 0x7d, 0x40, 0xb9, 0x4f, 0x04, 0x6b, 0x0c, 0xcb, 0x60, 0x6d, 0xf5, 0xba, 0xdc, 0x8d, 0x90,
+	0x4a, 0x57, 0x8c, 0x86, 0xb1, 0x9a, 0x00, 0xb4, 0xcd, 0x43, 0xf5, 0x11, 0x38, 0x19, 0x1b, 0xf1,
+	0x16, 0xc8, 0x67, 0xa9, 0x87, 0x38, 0xb9, 0x9d, 0xb8, 0x42, 0x20, 0x00, 0x93, 0x19, 0x06, 0xa3,
+	0x3a, 0x14, 0x0a, 0xb2, 0x0e, 0x60, 0x51, 0x16, 0x4c, 0xe4, 0x34, 0xda, 0xb3, 0xd8, 0x35, 0x46,
+	0x1a, 0x34, 0x65, 0x35, 0x74, 0x2c, 0xbb, 0x9a, 0xca, 0xb6, 0x9e, 0x12, 0x37, 0xf2, 0xe2, 0x50,
+	0x6e, 0xf7, 0x60, 0x9a, 0xfa, 0x32, 0xba, 0x8e, 0x6a, 0xdb, 0xf3, 0x72, 0x3b, 0x53, 0xd7, 0xdb,
+	0x31, 0x39, 0xe6, 0x47, 0x5e, 0x18, 0xc5, 0x8f, 0x8e, 0x78, 0x86, 0x5f, 0xea, 0x3a, 0x3b, 0x26,
+	0x5b, 0x7f, 0x14, 0xc5, 0x16, 0xb1, 0x09, 0xd9, 0x26, 0x4c, 0xf5, 0xd0, 0x6a, 0x64, 0x55, 0xdb,
+	0x5e, 0xc1, 0x98, 0xca, 0xf9, 0xe2, 0xd1, 0x15, 0x5b, 0xad, 0x92, 0xeb, 0x49, 0x2d, 0xf4, 0x82,
+	0xb6, 0x5e, 0xb7, 0x56, 0xae, 0xa7, 0x55, 0x72, 0x3d, 0x89, 0x45, 0x0f, 0x69, 0xeb, 0x75, 0x6b,
+	0xe4, 0x7a, 0x5a, 0xb5, 0x33, 0x23, 0xf8, 0x23, 0xce, 0x7a, 0x09, 0x4b, 0xc8, 0x37, 0x73, 0x02,
+	0xeb, 0x19, 0x75, 0x67, 0x12, 0xb5, 0xea, 0x19, 0xb5, 0x66, 0x12, 0xf1, 0xf5, 0x8c, 0xf8, 0x99,
+	0x58, 0x8c, 0x0c, 0x0f, 0xb9, 0x7d, 0x71, 0x34, 0x12, 0x60, 0x71, 0x60, 0xba, 0xc8, 0xb1, 0xb3,
+	0xca, 0xdb, 0x62, 0x4b, 0xc9, 0xaf, 0x7a, 0x8d, 0xa7, 0x5c, 0x6d, 0xc7, 0x34, 0xeb, 0x77, 0x95,
+	0xf4, 0x26, 0x10, 0x9d, 0x40, 0xdf, 0x19, 0x7e, 0x13, 0x20, 0x39, 0x6d, 0xe1, 0x0a, 0x75, 0xf0,
+	0xd0, 0x16, 0x2e, 0x53, 0x9c, 0x4d, 0x0c, 0x2b, 0xce, 0x26, 0xb5, 0xe2, 0x0c, 0x0f, 0x07, 0xca,
+	0x53, 0xc5, 0x9c, 0x82, 0xe4, 0xea, 0x76, 0x6f, 0x10, 0x9e, 0x60, 0x29, 0x27, 0x8e, 0x34, 0x02,
+	0x52, 0x1b, 0x59, 0x19, 0x37, 0x66, 0x10, 0x89, 0xcf, 0xf2, 0x28, 0xb7, 0x03, 0xaf, 0x4f, 0x97,
+	0x4a, 0x63, 0x96, 0x1a, 0xf9, 0x14, 0x13, 0xd3, 0x8f, 0x1c, 0x51, 0x37, 0x44, 0x0d, 0x48, 0xe9,
+	0x84, 0xd1, 0xef, 0x25, 0xe5, 0x97, 0x4b, 0xb9, 0x97, 0x36, 0x60, 0xe5, 0x21, 0x8f, 0x0e, 0x07,
+	0x4d, 0x79, 0xb3, 0xef, 0xb6, 0x3b, 0x23, 0xae, 0x25, 0xeb, 0x39, 0xac, 0xe6, 0xd6, 0x8e, 0xad,
+	0xa2, 0x60, 0xdb, 0x6a, 0x77, 0xe2, 0x0d, 0xc3, 0x67, 0x6b, 0x0f, 0xe6, 0x04, 0x5b, 0x4d, 0xf6,
+	0x2d, 0xed, 0xaa, 0x51, 0x55, 0xa7, 0xa0, 0x1e, 0x09, 0xd4, 0x88, 0x7b, 0xe7, 0x00, 0xe6, 0x63,
+	0x2e, 0x63, 0x6b, 0x25, 0x30, 0x42, 0x93, 0xb8, 0x5e, 0x15, 0x8f, 0xd6, 0x2a, 0x2c, 0x0b, 0x6e,
+	0x74, 0xae, 0x53, 0xcd, 0xac, 0x7b, 0xe8, 0x2d, 0x0d, 0xad, 0x44, 0x29, 0x06, 0x46, 0xca, 0xe0,
+	0x37, 0xa2, 0xe1, 0x7e, 0xe4, 0xb8, 0xc7, 0x3d, 0xbe, 0x1f, 0x04, 0x5e, 0x30, 0xb4, 0x48, 0x47,
+	0xea, 0x1b, 0x05, 0xb9, 0xb8, 0xc6, 0x9b, 0xc9, 0x6c, 0x47, 0x15, 0x6c, 0x09, 0x02, 0x43, 0xf4,
+	0x65, 0x2f, 0x69, 0xfd, 0xe4, 0xb3, 0x15, 0xc2, 0x72, 0x46, 0xa5, 0x4b, 0x09, 0xb0, 0x87, 0xb0,
+	0x7a, 0x14, 0x38, 0x6e, 0xd8, 0xe6, 0x41, 0xb6, 0xf4, 0x4b, 0xef, 0x23, 0x43, 0xbf, 0x8f, 0xb4,
+	0xb4, 0x45, 0x92, 0x15, 0x64, 0xed, 0x40, 0x3d, 0xcf, 0x68, 0xec, 0x0b, 0xfe, 0x38, 0x19, 0xed,
+	0x64, 0xba, 0x89, 0x9b, 0xda, 0xae, 0xcc, 0x69, 0x4d, 0xce, 0x8b, 0xed, 0xb8, 0x0c, 0x55, 0x9a,
+	0x56, 0x86, 0x68, 0x4a, 0x5b, 0x13, 0x6b, 0x1a, 0x25, 0x29, 0xee, 0x32, 0x5b, 0x83, 0x3f, 0x19,
+	0x50, 0xc7, 0x69, 0xdd, 0x0b, 0x51, 0x77, 0x1c, 0xe3, 0x10, 0x33, 0x3d, 0x50, 0x20, 0xa7, 0x04,
+	0x5f, 0x9c, 0x39, 0xbd, 0x81, 0x72, 0xb7, 0xb8, 0x76, 0x66, 0x25, 0xee, 0x85, 0x44, 0xb1, 0x0d,
+	0x58, 0xc4, 0x5a, 0xff, 0x0b, 0xd9, 0x12, 0xa9, 0x65, 0xa8, 0xce, 0x23, 0xc3, 0x9e, 0x4f, 0xba,
+	0x00, 0x5a, 0x3b, 0x32, 0xed, 0xca, 0x98, 0xd5, 0x0a, 0xef, 0x04, 0xde, 0x99, 0xa2, 0xa1, 0xc5,
+	0x4e, 0x4d, 0x6b, 0x33, 0xac, 0x73, 0x58, 0x2b, 0x68, 0x7c, 0x29, 0xbe, 0x7a, 0x02, 0xab, 0x87,
+	0x91, 0xe7, 0x17, 0x3d, 0x35, 0xb2, 0xaf, 0x4c, 0x8c, 0xab, 0x64, 0x8d, 0x13, 0x5d, 0x59, 0x3d,
+	0xcf, 0xee, 0x52, 0xcc, 0xf8, 0xa5, 0xe8, 0x90, 0x69, 0xaa, 0x57, 0xb4, 0x44, 0xd7, 0xd7, 0xc8,
+	0xea, 0x3b, 0x62, 0x1c, 0x9d, 0x49, 0x2a, 0xd5, 0x7c, 0x52, 0x11, 0x77, 0x18, 0x01, 0x38, 0x2e,
+	0x56, 0xbd, 0x55, 0x8a, 0x91, 0x7d, 0x71, 0x51, 0x9d, 0x4b, 0xf1, 0xc4, 0x26, 0xcc, 0xef, 0xbb,
+	0xad, 0xe0, 0xc2, 0x8f, 0xd2, 0x7a, 0x62, 0xd6, 0xef, 0x39, 0x5d, 0x37, 0xe2, 0xaf, 0x22, 0xe5,
+	0x80, 0x14, 0x61, 0x7d, 0x0e, 0x0b, 0xc9, 0xfa, 0xb1, 0x15, 0x94, 0x55, 0x7b, 0xd7, 0x3f, 0xe1,
+	0x01, 0xf2, 0x26, 0x2f, 0x69, 0x18, 0xeb, 0x3b, 0xb1, 0x2d, 0xb2, 0x96, 0xc2, 0x6b, 0x12, 0x3b,
+	0xd6, 0x37, 0x19, 0x99, 0x3d, 0x85, 0x5a, 0x94, 0x32, 0x50, 0xae, 0x78, 0x37, 0x2e, 0x21, 0x4b,
+	0x78, 0x6f, 0x6a, 0xb8, 0x7d, 0x37, 0x0a, 0x2e, 0x6c, 0x9d, 0x81, 0xf9, 0x01, 0x2c, 0xe6, 0x17,
+	0x48, 0xa9, 0xa7, 0xa2, 0x09, 0x54, 0xf7, 0x96, 0x78, 0x94, 0x05, 0x8f, 0x76, 0xfc, 0x6d, 0x02,
+	0x1e, 0x54, 0x7e, 0x64, 0x58, 0x7f, 0x37, 0xe0, 0x9a, 0x94, 0x4c, 0xc9, 0xf7, 0xcd, 0xed, 0x7a,
+	0x01, 0x73, 0xa1, 0xce, 0x42, 0x59, 0xf6, 0x83, 0xd8, 0xb2, 0x52, 0xfe, 0x9b, 0x19, 0x2c, 0x59,
+	0x97, 0x65, 0x63, 0x7e, 0x08, 0xac, 0xb8, 0x68, 0x1c, 0x0b, 0x37, 0x3e, 0x84, 0x85, 0xdc, 0x10,
+	0x90, 0x2d, 0xc1, 0xdc, 0x63, 0xf7, 0x4c, 0x46, 0x33, 0x21, 0x16, 0xaf, 0xb0, 0xab, 0x30, 0x73,
+	0x78, 0xda, 0xf5, 0x25, 0xbc, 0x68, 0x48, 0x68, 0xff, 0x15, 0x6f, 0x21, 0x54, 0xd9, 0x68, 0x0a,
+	0x9a, 0x1a, 0x60, 0xb0, 0x65, 0x58, 0x50, 0xaf, 0xc6, 0x28, 0xf1, 0xf2, 0x02, 0xd4, 0x30, 0xe3,
+	0x11, 0x4a, 0xbc, 0xbf, 0x08, 0x57, 0xe9, 0xc8, 0x28, 0x4c, 0x85, 0xcd, 0x03, 0xc8, 0x64, 0xa2,
+	0xe0, 0x2a, 0xc2, 0x27, 0xde, 0xb9, 0x82, 0x27, 0x36, 0x3e, 0x86, 0x99, 0xb8, 0xef, 0xd5, 0x64,
+	0xc4, 0x28, 0x21, 0x43, 0xe8, 0xbc, 0x7f, 0xd6, 0x6d, 0x45, 0x09, 0xca, 0x60, 0x6b, 0xb0, 0xbc,
+	0xeb, 0xb8, 0x2d, 0xde, 0xcb, 0x12, 0x2a, 0x1b, 0x2e, 0x4c, 0xab, 0xd2, 0x4a, 0xaa, 0xa6, 0x78,
+	0x49, 0x90, 0x0c, 0x95, 0x01, 0x83, 0x90, 0x21, 0xd5, 0xa0, 0xba, 0x07, 0x61, 0x54, 0x93, 0x0e,
+	0x23, 0xc2, 0xa4, 0x26, 0xaa, 0x88, 0xf0, 0x84, 0x70, 0x33, 0x86, 0xdb, 0x11, 0xef, 0x8b, 0x53,
+	0x17, 0x11, 0x76, 0x72, 0x63, 0x0f, 0x66, 0x93, 0xbb, 0x55, 0x2e, 0x51, 0x12, 0x13, 0x9c, 0x10,
+	0x2b, 0x3c, 0x82, 0x2e, 0x42, 0x9c, 0xc0, 0x18, 0xe4, 0x34, 0xcf, 0x8f, 0x11, 0x95, 0xed, 0xaf,
+	0x57, 0x60, 0x8a, 0x94, 0x61, 0x9f, 0xc1, 0x6c, 0xf2, 0x89, 0x8a, 0x61, 0x83, 0x95, 0xff, 0x20,
+	0x67, 0xae, 0xe6, 0xb0, 0x14, 0x51, 0xd6, 0xad, 0x9f, 0x7f, 0xf7, 0xef, 0x6f, 0x2a, 0xd7, 0xac,
+	0x15, 0xf9, 0xe5, 0x2f, 0xdc, 0x3a, 0xbb, 0xef, 0xf4, 0xfc, 0x13, 0xe7, 0xfe, 0x96, 0x3c, 0x33,
+	0xe1, 0x03, 0x63, 0x83, 0xb5, 0xa1, 0xa6, 0x7d, 0x07, 0x62, 0x75, 0xc9, 0xa6, 0xf8, 0xe5, 0xc9,
+	0x5c, 0x2b, 0xe0, 0x95, 0x80, 0x77, 0x50, 0xc0, 0x6d, 0xf3, 0x7a, 0x99, 0x80, 0xad, 0xd7, 0xb2,
+	0x6a, 0xfd, 0x4a, 0xca, 0x79, 0x1f, 0x20, 0xfd, 0x34, 0xc3, 0x50, 0xdb, 0xc2, 0xe7, 0x1e, 0xb3,
+	0x9e, 0x47, 0x2b, 0x21, 0x57, 0x58, 0x0f, 0x6a, 0xda, 0x37, 0x0a, 0x66, 0xe6, 0x3e, 0x5a, 0x68,
+	0x1f, 0x55, 0xcc, 0xeb, 0xa5, 0x34, 0xc5, 0xe9, 0x2e, 0xaa, 0xbb, 0xce, 0x6e, 0xe4, 0xd4, 0x0d,
+	0x71, 0xa9, 0xd2, 0x97, 0xed, 0x8a, 0xdd, 0xd1, 0x3e, 0x05, 0x30, 0xb4, 0xbe, 0xe4, 0x1b, 0x88,
+	0xd9, 0x28, 0x12, 0x12, 0x95, 0x3f, 0x82, 0xb9, 0xcc, 0x41, 0x63, 0x8d, 0xc2, 0x00, 0x3e, 0x66,
+	0x73, 0xad, 0x84, 0x92, 0xf0, 0xf9, 0x0c, 0xea, 0xc5, 0xd1, 0x35, 0x7a, 0xf1, 0xa6, 0xb6, 0x29,
+	0xc5, 0xf1, 0xb1, 0xb9, 0x3e, 0x8c, 0x9c, 0xb0, 0x7e, 0x06, 0x8b, 0xf9, 0x11, 0x2f, 0x43, 0xf7,
+	0x0d, 0x99, 0x48, 0x9b, 0x37, 0xca, 0x89, 0x09, 0xc3, 0x07, 0x30, 0x9b, 0x4c, 0x50, 0x29, 0x50,
+	0xf3, 0x83, 0x5c, 0x0a, 0xd4, 0xc2, 0x98, 0x55, 0xbc, 0xdb, 0x81, 0xb9, 0xcc, 0xcc, 0x92, 0xfc,
+	0x55, 0x36, 0x50, 0x25, 0x7f, 0x95, 0x0e, 0x38, 0xad, 0x3b, 0xb8, 0xc1, 0xd7, 0xcd, 0x7a, 0x7e,
+	0x83, 0xe9, 0x0e, 0x95, 0xa1, 0xf8, 0x18, 0xe6, 0xb3, 0xe3, 0x45, 0x76, 0x8d, 0xca, 0xe1, 0x92,
+	0xc9, 0xa5, 0x69, 0x96, 0x91, 0x12, 0x9d, 0x03, 0xa1, 0xb3, 0x3e, 0x07, 0x54, 0x3a, 0x97, 0x8c,
+	0x16, 0x95, 0xce, 0x65, 0x43, 0x43, 0xeb, 0x5d, 0xd4, 0xf9, 0x9d, 0x8d, 0xbb, 0x39, 0x9d, 0xd5,
+	0x38, 0x61, 0xeb, 0xb5, 0xec, 0x07, 0xbf, 0x8a, 0x83, 0xf3, 0x34, 0xf1, 0x13, 0xa5, 0xb8, 0x8c,
+	0x9f, 0x32, 0xb3, 0xc4, 0x8c, 0x9f, 0xb2, 0xf3, 0x42, 0xeb, 0x6d, 0x94, 0x79, 0xcb, 0x34, 0x73,
+	0x32, 0x69, 0xdc, 0xb2, 0xf5, 0xda, 0xf3, 0xf1, 0xd8, 0x7e, 0x0e, 0x90, 0x0e, 0x4c, 0xe8, 0xd8,
+	0x16, 0x66, 0x36, 0x74, 0x6c, 0x8b, 0x73, 0x15, 0x6b, 0x1d, 0x65, 0x34, 0x58, 0xbd, 0xdc, 0x2e,
+	0x91, 0x7b, 0xe6, 0x32, 0xd3, 0x80, 0xec, 0x8e, 0xeb, 0x83, 0x93, 0xec, 0x8e, 0x67, 0x46, 0x07,
+	0xd6, 0x6d, 0x94, 0x62, 0x9a, 0xab, 0xf9, 0x1d, 0xc7, 0x65, 0xd2, 0x88, 0x1e, 0xf6, 0xde, 0x69,
+	0x4b, 0x4f, 0x72, 0xca, 0x26, 0x02, 0x24, 0xa7, 0xb4, 0xff, 0x8f, 0x33, 0x1d, 0x5b, 0xcf, 0xcb,
+	0x19, 0x34, 0xf5, 0x64, 0xc7, 0x8e, 0x60, 0x8a, 0x7a, 0x74, 0xb6, 0xa4, 0x98, 0x69, 0xfc, 0x99,
+	0x8e, 0x52, 0x8c, 0xdf, 0x42, 0xc6, 0x37, 0xd9, 0xa8, 0x14, 0xca, 0xbe, 0x84, 0x9a, 0xd6, 0xd6,
+	0x52, 0x9e, 0x2e, 0xb6, 0xde, 0x94, 0xa7, 0x4b, 0xfa, 0xdf, 0xa1, 0x5e, 0xe2, 0x72, 0x15, 0x1e,
+	0x0b, 0x91, 0xf4, 0xf4, 0xb6, 0x9f, 0x92, 0x5e, 0xc9, 0x7c, 0xc0, 0x6c, 0x14, 0x09, 0xc9, 0x81,
+	0x10, 0x67, 0x2b, 0xdb, 0xbf, 0xd2, 0xd9, 0x2a, 0x6d, 0x8e, 0xe9, 0x6c, 0x95, 0xb7, 0xbb, 0x82,
+	0x95, 0xd0, 0x47, 0x6f, 0x30, 0x99, 0x7e, 0x05, 0x65, 0x92, 0x52, 0xa3, 0x48, 0x48, 0x98, 0x1c,
+	0xc0, 0x42, 0xae, 0xf9, 0xa2, 0xbb, 0xa3, 0xbc, 0x87, 0xa4, 0xbb, 0x63, 0x48, 0xb7, 0x46, 0xd6,
+	0x65, 0x5b, 0x20, 0xb2, 0xae, 0xb4, 0xcb, 0x32, 0xcd, 0x32, 0x52, 0xc2, 0xea, 0xa7, 0x38, 0x7b,
+	0x49, 0x49, 0xea, 0x62, 0x5b, 0x57, 0xbe, 0xcd, 0x13, 0x62, 0xa6, 0xb7, 0x86, 0xd2, 0x13, 0xce,
+	0xcf, 0x81, 0x65, 0x16, 0x50, 0xc0, 0xdc, 0x2c, 0xbc, 0x98, 0x89, 0x9b, 0xf5, 0x61, 0xe4, 0x84,
+	0xad, 0x93, 0x5c, 0x43, 0x79, 0xd6, 0x77, 0x34, 0xff, 0x0f, 0x61, 0x6f, 0x8d, 0x5a, 0xa2, 0x5f,
+	0x47, 0xf9, 0xce, 0x8a, 0xae, 0xa3, 0x21, 0xed, 0x1f, 0x5d, 0x47, 0xc3, 0x9a, 0x31, 0xc1, 0xf0,
+	0x3d, 0x98, 0x56, 0x0d, 0x10, 0xc3, 0x83, 0x97, 0xed, 0x9e, 0xcc, 0xe5, 0x0c, 0x2e, 0x79, 0xeb,
+	0x11, 0x2c, 0xe4, 0x9a, 0x0f, 0x71, 0xdc, 0xe8, 0x1f, 0x52, 0x9b, 0xf1, 0x3f, 0xa4, 0x36, 0xf7,
+	0xe5, 0x3f, 0xa4, 0x28, 0x5e, 0x86, 0x74, 0x2a, 0x18, 0x7d, 0x4b, 0x85, 0x62, 0x7f, 0x28, 0xaf,
+	0x9b, 0x23, 0x7b, 0x03, 0xeb, 0xca, 0x4e, 0xe3, 0xcf, 0xff, 0x5c, 0x37, 0xbe, 0x15, 0xbf, 0x7f,
+	0x88, 0xdf, 0xaf, 0xff, 0xb5, 0x7e, 0xe5, 0x5b, 0xf1, 0xfb, 0x8b, 0xf8, 0x35, 0xa7, 0x90, 0xd5,
+	0x0f, 0xbf, 0x07, 0x87, 0x44, 0x3a, 0x50, 0x0a, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5302,6 +5326,27 @@ func (m *StartTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StopGTID) > 0 {
+		i -= len(m.StopGTID)
+		copy(dAtA[i:], m.StopGTID)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StopGTID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StopBinlogPos) > 0 {
+		i -= len(m.StopBinlogPos)
+		copy(dAtA[i:], m.StopBinlogPos)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StopBinlogPos)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StopTime) > 0 {
+		i -= len(m.StopTime)
+		copy(dAtA[i:], m.StopTime)
+		i = encodeVarintDmmaster(dAtA, i, uint64(len(m.StopTime)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.StartTime) > 0 {
 		i -= len(m.StartTime)
 		copy(dAtA[i:], m.StartTime)
@@ -8302,6 +8347,18 @@ func (m *StartTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmmaster(uint64(l))
 	}
+	l = len(m.StopTime)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.StopBinlogPos)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
+	l = len(m.StopGTID)
+	if l > 0 {
+		n += 1 + l + sovDmmaster(uint64(l))
+	}
 	return n
 }
 
@@ -9772,6 +9829,102 @@ func (m *StartTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.StartTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopBinlogPos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopBinlogPos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopGTID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmmaster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmmaster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopGTID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmmaster(dAtA[iNdEx:])
@@ -294,6 +294,7 @@ const (
 	ErrorOp_Revert         ErrorOp = 3
 	ErrorOp_Inject         ErrorOp = 4
 	ErrorOp_List           ErrorOp = 5
+	ErrorOp_Approve        ErrorOp = 6
 )
 
 var ErrorOp_name = map[int32]string{
@@ -303,6 +304,7 @@ var ErrorOp_name = map[int32]string{
 	3: "Revert",
 	4: "Inject",
 	5: "List",
+	6: "Approve",
 }
 
 var ErrorOp_value = map[string]int32{
@@ -312,6 +314,7 @@ var ErrorOp_value = map[string]int32{
 	"Revert":         3,
 	"Inject":         4,
 	"List":           5,
+	"Approve":        6,
 }
 
 func (x ErrorOp) String() string {
@@ -388,6 +391,7 @@ const (
 	ValidationErrOp_IgnoreErrOp  ValidationErrOp = 1
 	ValidationErrOp_ResolveErrOp ValidationErrOp = 2
 	ValidationErrOp_ClearErrOp   ValidationErrOp = 3
+	ValidationErrOp_RepairErrOp  ValidationErrOp = 4
 )
 
 var ValidationErrOp_name = map[int32]string{
@@ -395,6 +399,7 @@ var ValidationErrOp_name = map[int32]string{
 	1: "IgnoreErrOp",
 	2: "ResolveErrOp",
 	3: "ClearErrOp",
+	4: "RepairErrOp",
 }
 
 var ValidationErrOp_value = map[string]int32{
@@ -402,6 +407,7 @@ var ValidationErrOp_value = map[string]int32{
 	"IgnoreErrOp":  1,
 	"ResolveErrOp": 2,
 	"ClearErrOp":   3,
+	"RepairErrOp":  4,
 }
 
 func (x ValidationErrOp) String() string {
@@ -680,23 +686,106 @@ func (m *CheckStatus) GetDetail() []byte {
 	return nil
 }
 
+// TableProgress represents the dump or load progress of a single table
+// estimateRows is unknown for load unit
+type TableProgress struct {
+	Schema        string `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Table         string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	EstimateRows  int64  `protobuf:"varint,3,opt,name=estimateRows,proto3" json:"estimateRows,omitempty"`
+	EstimateBytes int64  `protobuf:"varint,4,opt,name=estimateBytes,proto3" json:"estimateBytes,omitempty"`
+	FinishedBytes int64  `protobuf:"varint,5,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
+}
+
+func (m *TableProgress) Reset()         { *m = TableProgress{} }
+func (m *TableProgress) String() string { return proto.CompactTextString(m) }
+func (*TableProgress) ProtoMessage()    {}
+func (*TableProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a1b9e17fd67b10, []int{4}
+}
+func (m *TableProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TableProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TableProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TableProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableProgress.Merge(m, src)
+}
+func (m *TableProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *TableProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableProgress proto.InternalMessageInfo
+
+func (m *TableProgress) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
+func (m *TableProgress) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *TableProgress) GetEstimateRows() int64 {
+	if m != nil {
+		return m.EstimateRows
+	}
+	return 0
+}
+
+func (m *TableProgress) GetEstimateBytes() int64 {
+	if m != nil {
+		return m.EstimateBytes
+	}
+	return 0
+}
+
+func (m *TableProgress) GetFinishedBytes() int64 {
+	if m != nil {
+		return m.FinishedBytes
+	}
+	return 0
+}
+
 // DumpStatus represents status for dump unit
 // add fields later
+// etaSeconds is the estimated remaining seconds, -1 means unknown
 type DumpStatus struct {
-	TotalTables       int64   `protobuf:"varint,1,opt,name=totalTables,proto3" json:"totalTables,omitempty"`
-	CompletedTables   float64 `protobuf:"fixed64,2,opt,name=completedTables,proto3" json:"completedTables,omitempty"`
-	FinishedBytes     float64 `protobuf:"fixed64,3,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
-	FinishedRows      float64 `protobuf:"fixed64,4,opt,name=finishedRows,proto3" json:"finishedRows,omitempty"`
-	EstimateTotalRows float64 `protobuf:"fixed64,5,opt,name=estimateTotalRows,proto3" json:"estimateTotalRows,omitempty"`
-	Bps               int64   `protobuf:"varint,6,opt,name=bps,proto3" json:"bps,omitempty"`
-	Progress          string  `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	TotalTables        int64            `protobuf:"varint,1,opt,name=totalTables,proto3" json:"totalTables,omitempty"`
+	CompletedTables    float64          `protobuf:"fixed64,2,opt,name=completedTables,proto3" json:"completedTables,omitempty"`
+	FinishedBytes      float64          `protobuf:"fixed64,3,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
+	FinishedRows       float64          `protobuf:"fixed64,4,opt,name=finishedRows,proto3" json:"finishedRows,omitempty"`
+	EstimateTotalRows  float64          `protobuf:"fixed64,5,opt,name=estimateTotalRows,proto3" json:"estimateTotalRows,omitempty"`
+	Bps                int64            `protobuf:"varint,6,opt,name=bps,proto3" json:"bps,omitempty"`
+	Progress           string           `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	Rps                int64            `protobuf:"varint,8,opt,name=rps,proto3" json:"rps,omitempty"`
+	EstimateTotalBytes int64            `protobuf:"varint,9,opt,name=estimateTotalBytes,proto3" json:"estimateTotalBytes,omitempty"`
+	EtaSeconds         int64            `protobuf:"varint,10,opt,name=etaSeconds,proto3" json:"etaSeconds,omitempty"`
+	Tables             []*TableProgress `protobuf:"bytes,11,rep,name=tables,proto3" json:"tables,omitempty"`
 }
 
 func (m *DumpStatus) Reset()         { *m = DumpStatus{} }
 func (m *DumpStatus) String() string { return proto.CompactTextString(m) }
 func (*DumpStatus) ProtoMessage()    {}
 func (*DumpStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{4}
+	return fileDescriptor_51a1b9e17fd67b10, []int{5}
 }
 func (m *DumpStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,21 +863,52 @@ func (m *DumpStatus) GetProgress() string {
 	return ""
 }
 
+func (m *DumpStatus) GetRps() int64 {
+	if m != nil {
+		return m.Rps
+	}
+	return 0
+}
+
+func (m *DumpStatus) GetEstimateTotalBytes() int64 {
+	if m != nil {
+		return m.EstimateTotalBytes
+	}
+	return 0
+}
+
+func (m *DumpStatus) GetEtaSeconds() int64 {
+	if m != nil {
+		return m.EtaSeconds
+	}
+	return 0
+}
+
+func (m *DumpStatus) GetTables() []*TableProgress {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
 // LoadStatus represents status for load unit
 type LoadStatus struct {
-	FinishedBytes  int64  `protobuf:"varint,1,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
-	TotalBytes     int64  `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
-	Progress       string `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
-	MetaBinlog     string `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
-	MetaBinlogGTID string `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
-	Bps            int64  `protobuf:"varint,6,opt,name=bps,proto3" json:"bps,omitempty"`
+	FinishedBytes  int64            `protobuf:"varint,1,opt,name=finishedBytes,proto3" json:"finishedBytes,omitempty"`
+	TotalBytes     int64            `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	Progress       string           `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	MetaBinlog     string           `protobuf:"bytes,4,opt,name=metaBinlog,proto3" json:"metaBinlog,omitempty"`
+	MetaBinlogGTID string           `protobuf:"bytes,5,opt,name=metaBinlogGTID,proto3" json:"metaBinlogGTID,omitempty"`
+	Bps            int64            `protobuf:"varint,6,opt,name=bps,proto3" json:"bps,omitempty"`
+	EtaSeconds     int64            `protobuf:"varint,7,opt,name=etaSeconds,proto3" json:"etaSeconds,omitempty"`
+	Rps            int64            `protobuf:"varint,8,opt,name=rps,proto3" json:"rps,omitempty"`
+	Tables         []*TableProgress `protobuf:"bytes,9,rep,name=tables,proto3" json:"tables,omitempty"`
 }
 
 func (m *LoadStatus) Reset()         { *m = LoadStatus{} }
 func (m *LoadStatus) String() string { return proto.CompactTextString(m) }
 func (*LoadStatus) ProtoMessage()    {}
 func (*LoadStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{5}
+	return fileDescriptor_51a1b9e17fd67b10, []int{6}
 }
 func (m *LoadStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,6 +979,27 @@ func (m *LoadStatus) GetBps() int64 {
 	return 0
 }
 
+func (m *LoadStatus) GetEtaSeconds() int64 {
+	if m != nil {
+		return m.EtaSeconds
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetRps() int64 {
+	if m != nil {
+		return m.Rps
+	}
+	return 0
+}
+
+func (m *LoadStatus) GetTables() []*TableProgress {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
 // ShardingGroup represents a DDL sharding group, this is used by SyncStatus, and is differ from ShardingGroup in syncer pkg
 // target: target table name
 // DDL: in syncing DDL
@@ -877,7 +1018,7 @@ func (m *ShardingGroup) Reset()         { *m = ShardingGroup{} }
 func (m *ShardingGroup) String() string { return proto.CompactTextString(m) }
 func (*ShardingGroup) ProtoMessage()    {}
 func (*ShardingGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{6}
+	return fileDescriptor_51a1b9e17fd67b10, []int{7}
 }
 func (m *ShardingGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -965,13 +1106,21 @@ type SyncStatus struct {
 	IoTotalBytes uint64 `protobuf:"varint,18,opt,name=ioTotalBytes,proto3" json:"ioTotalBytes,omitempty"`
 	// meter TCP io from upstream of the subtask
 	DumpIOTotalBytes uint64 `protobuf:"varint,19,opt,name=dumpIOTotalBytes,proto3" json:"dumpIOTotalBytes,omitempty"`
+	// configured delay seconds of applying binlog events, see `apply-delay`
+	ApplyDelay int64 `protobuf:"varint,20,opt,name=applyDelay,proto3" json:"applyDelay,omitempty"`
+	// seconds the current binlog event is still held for apply-delay
+	DelayRemaining int64 `protobuf:"varint,21,opt,name=delayRemaining,proto3" json:"delayRemaining,omitempty"`
+	// DDLs waiting for approval, see `ddl-approval`
+	PendingApprovalDDLs []string `protobuf:"bytes,22,rep,name=pendingApprovalDDLs,proto3" json:"pendingApprovalDDLs,omitempty"`
+	// binlog position of the DDLs waiting for approval, which can be used as `--binlog-pos` of `binlog` commands
+	PendingApprovalPosition string `protobuf:"bytes,23,opt,name=pendingApprovalPosition,proto3" json:"pendingApprovalPosition,omitempty"`
 }
 
 func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
 func (m *SyncStatus) String() string { return proto.CompactTextString(m) }
 func (*SyncStatus) ProtoMessage()    {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{7}
+	return fileDescriptor_51a1b9e17fd67b10, []int{8}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,6 +1282,34 @@ func (m *SyncStatus) GetDumpIOTotalBytes() uint64 {
 	return 0
 }
 
+func (m *SyncStatus) GetApplyDelay() int64 {
+	if m != nil {
+		return m.ApplyDelay
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetDelayRemaining() int64 {
+	if m != nil {
+		return m.DelayRemaining
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetPendingApprovalDDLs() []string {
+	if m != nil {
+		return m.PendingApprovalDDLs
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetPendingApprovalPosition() string {
+	if m != nil {
+		return m.PendingApprovalPosition
+	}
+	return ""
+}
+
 // SourceStatus represents status for source runing on dm-worker
 type SourceStatus struct {
 	Source      string         `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
@@ -1145,7 +1322,7 @@ func (m *SourceStatus) Reset()         { *m = SourceStatus{} }
 func (m *SourceStatus) String() string { return proto.CompactTextString(m) }
 func (*SourceStatus) ProtoMessage()    {}
 func (*SourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{8}
+	return fileDescriptor_51a1b9e17fd67b10, []int{9}
 }
 func (m *SourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1218,7 +1395,7 @@ func (m *RelayStatus) Reset()         { *m = RelayStatus{} }
 func (m *RelayStatus) String() string { return proto.CompactTextString(m) }
 func (*RelayStatus) ProtoMessage()    {}
 func (*RelayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{9}
+	return fileDescriptor_51a1b9e17fd67b10, []int{10}
 }
 func (m *RelayStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,7 +1514,7 @@ func (m *SubTaskStatus) Reset()         { *m = SubTaskStatus{} }
 func (m *SubTaskStatus) String() string { return proto.CompactTextString(m) }
 func (*SubTaskStatus) ProtoMessage()    {}
 func (*SubTaskStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{10}
+	return fileDescriptor_51a1b9e17fd67b10, []int{11}
 }
 func (m *SubTaskStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1498,7 +1675,7 @@ func (m *SubTaskStatusList) Reset()         { *m = SubTaskStatusList{} }
 func (m *SubTaskStatusList) String() string { return proto.CompactTextString(m) }
 func (*SubTaskStatusList) ProtoMessage()    {}
 func (*SubTaskStatusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{11}
+	return fileDescriptor_51a1b9e17fd67b10, []int{12}
 }
 func (m *SubTaskStatusList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1544,7 +1721,7 @@ func (m *CheckError) Reset()         { *m = CheckError{} }
 func (m *CheckError) String() string { return proto.CompactTextString(m) }
 func (*CheckError) ProtoMessage()    {}
 func (*CheckError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{12}
+	return fileDescriptor_51a1b9e17fd67b10, []int{13}
 }
 func (m *CheckError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1590,7 +1767,7 @@ func (m *DumpError) Reset()         { *m = DumpError{} }
 func (m *DumpError) String() string { return proto.CompactTextString(m) }
 func (*DumpError) ProtoMessage()    {}
 func (*DumpError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{13}
+	return fileDescriptor_51a1b9e17fd67b10, []int{14}
 }
 func (m *DumpError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1812,7 @@ func (m *LoadError) Reset()         { *m = LoadError{} }
 func (m *LoadError) String() string { return proto.CompactTextString(m) }
 func (*LoadError) ProtoMessage()    {}
 func (*LoadError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{14}
+	return fileDescriptor_51a1b9e17fd67b10, []int{15}
 }
 func (m *LoadError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +1859,7 @@ func (m *SyncSQLError) Reset()         { *m = SyncSQLError{} }
 func (m *SyncSQLError) String() string { return proto.CompactTextString(m) }
 func (*SyncSQLError) ProtoMessage()    {}
 func (*SyncSQLError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{15}
+	return fileDescriptor_51a1b9e17fd67b10, []int{16}
 }
 func (m *SyncSQLError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1741,7 +1918,7 @@ func (m *SyncError) Reset()         { *m = SyncError{} }
 func (m *SyncError) String() string { return proto.CompactTextString(m) }
 func (*SyncError) ProtoMessage()    {}
 func (*SyncError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{16}
+	return fileDescriptor_51a1b9e17fd67b10, []int{17}
 }
 func (m *SyncError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1789,7 +1966,7 @@ func (m *SourceError) Reset()         { *m = SourceError{} }
 func (m *SourceError) String() string { return proto.CompactTextString(m) }
 func (*SourceError) ProtoMessage()    {}
 func (*SourceError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{17}
+	return fileDescriptor_51a1b9e17fd67b10, []int{18}
 }
 func (m *SourceError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1855,7 +2032,7 @@ func (m *RelayError) Reset()         { *m = RelayError{} }
 func (m *RelayError) String() string { return proto.CompactTextString(m) }
 func (*RelayError) ProtoMessage()    {}
 func (*RelayError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{18}
+	return fileDescriptor_51a1b9e17fd67b10, []int{19}
 }
 func (m *RelayError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1916,7 +2093,7 @@ func (m *SubTaskError) Reset()         { *m = SubTaskError{} }
 func (m *SubTaskError) String() string { return proto.CompactTextString(m) }
 func (*SubTaskError) ProtoMessage()    {}
 func (*SubTaskError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{19}
+	return fileDescriptor_51a1b9e17fd67b10, []int{20}
 }
 func (m *SubTaskError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2056,7 +2233,7 @@ func (m *SubTaskErrorList) Reset()         { *m = SubTaskErrorList{} }
 func (m *SubTaskErrorList) String() string { return proto.CompactTextString(m) }
 func (*SubTaskErrorList) ProtoMessage()    {}
 func (*SubTaskErrorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{20}
+	return fileDescriptor_51a1b9e17fd67b10, []int{21}
 }
 func (m *SubTaskErrorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2108,7 +2285,7 @@ func (m *ProcessResult) Reset()         { *m = ProcessResult{} }
 func (m *ProcessResult) String() string { return proto.CompactTextString(m) }
 func (*ProcessResult) ProtoMessage()    {}
 func (*ProcessResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{21}
+	return fileDescriptor_51a1b9e17fd67b10, []int{22}
 }
 func (m *ProcessResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2175,7 +2352,7 @@ func (m *ProcessError) Reset()         { *m = ProcessError{} }
 func (m *ProcessError) String() string { return proto.CompactTextString(m) }
 func (*ProcessError) ProtoMessage()    {}
 func (*ProcessError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{22}
+	return fileDescriptor_51a1b9e17fd67b10, []int{23}
 }
 func (m *ProcessError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2269,7 +2446,7 @@ func (m *PurgeRelayRequest) Reset()         { *m = PurgeRelayRequest{} }
 func (m *PurgeRelayRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeRelayRequest) ProtoMessage()    {}
 func (*PurgeRelayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{23}
+	return fileDescriptor_51a1b9e17fd67b10, []int{24}
 }
 func (m *PurgeRelayRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2343,7 +2520,7 @@ func (m *OperateWorkerSchemaRequest) Reset()         { *m = OperateWorkerSchemaR
 func (m *OperateWorkerSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateWorkerSchemaRequest) ProtoMessage()    {}
 func (*OperateWorkerSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{24}
+	return fileDescriptor_51a1b9e17fd67b10, []int{25}
 }
 func (m *OperateWorkerSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2454,7 +2631,7 @@ func (m *V1SubTaskMeta) Reset()         { *m = V1SubTaskMeta{} }
 func (m *V1SubTaskMeta) String() string { return proto.CompactTextString(m) }
 func (*V1SubTaskMeta) ProtoMessage()    {}
 func (*V1SubTaskMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{25}
+	return fileDescriptor_51a1b9e17fd67b10, []int{26}
 }
 func (m *V1SubTaskMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2519,7 +2696,7 @@ func (m *OperateV1MetaRequest) Reset()         { *m = OperateV1MetaRequest{} }
 func (m *OperateV1MetaRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateV1MetaRequest) ProtoMessage()    {}
 func (*OperateV1MetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{26}
+	return fileDescriptor_51a1b9e17fd67b10, []int{27}
 }
 func (m *OperateV1MetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2565,7 +2742,7 @@ func (m *OperateV1MetaResponse) Reset()         { *m = OperateV1MetaResponse{} }
 func (m *OperateV1MetaResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateV1MetaResponse) ProtoMessage()    {}
 func (*OperateV1MetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{27}
+	return fileDescriptor_51a1b9e17fd67b10, []int{28}
 }
 func (m *OperateV1MetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2626,7 +2803,7 @@ func (m *HandleWorkerErrorRequest) Reset()         { *m = HandleWorkerErrorReque
 func (m *HandleWorkerErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*HandleWorkerErrorRequest) ProtoMessage()    {}
 func (*HandleWorkerErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{28}
+	return fileDescriptor_51a1b9e17fd67b10, []int{29}
 }
 func (m *HandleWorkerErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2690,7 +2867,7 @@ func (m *GetWorkerCfgRequest) Reset()         { *m = GetWorkerCfgRequest{} }
 func (m *GetWorkerCfgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkerCfgRequest) ProtoMessage()    {}
 func (*GetWorkerCfgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{29}
+	return fileDescriptor_51a1b9e17fd67b10, []int{30}
 }
 func (m *GetWorkerCfgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +2904,7 @@ func (m *GetWorkerCfgResponse) Reset()         { *m = GetWorkerCfgResponse{} }
 func (m *GetWorkerCfgResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkerCfgResponse) ProtoMessage()    {}
 func (*GetWorkerCfgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{30}
+	return fileDescriptor_51a1b9e17fd67b10, []int{31}
 }
 func (m *GetWorkerCfgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2771,7 +2948,7 @@ func (m *CheckSubtasksCanUpdateRequest) Reset()         { *m = CheckSubtasksCanU
 func (m *CheckSubtasksCanUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckSubtasksCanUpdateRequest) ProtoMessage()    {}
 func (*CheckSubtasksCanUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{31}
+	return fileDescriptor_51a1b9e17fd67b10, []int{32}
 }
 func (m *CheckSubtasksCanUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2993,7 @@ func (m *CheckSubtasksCanUpdateResponse) Reset()         { *m = CheckSubtasksCan
 func (m *CheckSubtasksCanUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckSubtasksCanUpdateResponse) ProtoMessage()    {}
 func (*CheckSubtasksCanUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{32}
+	return fileDescriptor_51a1b9e17fd67b10, []int{33}
 }
 func (m *CheckSubtasksCanUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2868,7 +3045,7 @@ func (m *GetValidationStatusRequest) Reset()         { *m = GetValidationStatusR
 func (m *GetValidationStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidationStatusRequest) ProtoMessage()    {}
 func (*GetValidationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{33}
+	return fileDescriptor_51a1b9e17fd67b10, []int{34}
 }
 func (m *GetValidationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2930,7 +3107,7 @@ func (m *ValidationStatus) Reset()         { *m = ValidationStatus{} }
 func (m *ValidationStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidationStatus) ProtoMessage()    {}
 func (*ValidationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{34}
+	return fileDescriptor_51a1b9e17fd67b10, []int{35}
 }
 func (m *ValidationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3055,7 +3232,7 @@ func (m *ValidationTableStatus) Reset()         { *m = ValidationTableStatus{} }
 func (m *ValidationTableStatus) String() string { return proto.CompactTextString(m) }
 func (*ValidationTableStatus) ProtoMessage()    {}
 func (*ValidationTableStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{35}
+	return fileDescriptor_51a1b9e17fd67b10, []int{36}
 }
 func (m *ValidationTableStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3130,7 +3307,7 @@ func (m *GetValidationStatusResponse) Reset()         { *m = GetValidationStatus
 func (m *GetValidationStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*GetValidationStatusResponse) ProtoMessage()    {}
 func (*GetValidationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{36}
+	return fileDescriptor_51a1b9e17fd67b10, []int{37}
 }
 func (m *GetValidationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3196,7 +3373,7 @@ func (m *GetValidationErrorRequest) Reset()         { *m = GetValidationErrorReq
 func (m *GetValidationErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidationErrorRequest) ProtoMessage()    {}
 func (*GetValidationErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{37}
+	return fileDescriptor_51a1b9e17fd67b10, []int{38}
 }
 func (m *GetValidationErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3256,7 +3433,7 @@ func (m *ValidationError) Reset()         { *m = ValidationError{} }
 func (m *ValidationError) String() string { return proto.CompactTextString(m) }
 func (*ValidationError) ProtoMessage()    {}
 func (*ValidationError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{38}
+	return fileDescriptor_51a1b9e17fd67b10, []int{39}
 }
 func (m *ValidationError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3365,7 +3542,7 @@ func (m *GetValidationErrorResponse) Reset()         { *m = GetValidationErrorRe
 func (m *GetValidationErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*GetValidationErrorResponse) ProtoMessage()    {}
 func (*GetValidationErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{39}
+	return fileDescriptor_51a1b9e17fd67b10, []int{40}
 }
 func (m *GetValidationErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3426,7 +3603,7 @@ func (m *OperateValidationErrorRequest) Reset()         { *m = OperateValidation
 func (m *OperateValidationErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*OperateValidationErrorRequest) ProtoMessage()    {}
 func (*OperateValidationErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{40}
+	return fileDescriptor_51a1b9e17fd67b10, []int{41}
 }
 func (m *OperateValidationErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3492,7 +3669,7 @@ func (m *OperateValidationErrorResponse) Reset()         { *m = OperateValidatio
 func (m *OperateValidationErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*OperateValidationErrorResponse) ProtoMessage()    {}
 func (*OperateValidationErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{41}
+	return fileDescriptor_51a1b9e17fd67b10, []int{42}
 }
 func (m *OperateValidationErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3545,7 +3722,7 @@ func (m *UpdateValidationWorkerRequest) Reset()         { *m = UpdateValidationW
 func (m *UpdateValidationWorkerRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateValidationWorkerRequest) ProtoMessage()    {}
 func (*UpdateValidationWorkerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51a1b9e17fd67b10, []int{42}
+	return fileDescriptor_51a1b9e17fd67b10, []int{43}
 }
 func (m *UpdateValidationWorkerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3610,6 +3787,7 @@ func init() {
 	proto.RegisterType((*CommonWorkerResponse)(nil), "pb.CommonWorkerResponse")
 	proto.RegisterType((*QueryStatusResponse)(nil), "pb.QueryStatusResponse")
 	proto.RegisterType((*CheckStatus)(nil), "pb.CheckStatus")
+	proto.RegisterType((*TableProgress)(nil), "pb.TableProgress")
 	proto.RegisterType((*DumpStatus)(nil), "pb.DumpStatus")
 	proto.RegisterType((*LoadStatus)(nil), "pb.LoadStatus")
 	proto.RegisterType((*ShardingGroup)(nil), "pb.ShardingGroup")
@@ -3655,193 +3833,203 @@ func init() {
 func init() { proto.RegisterFile("dmworker.proto", fileDescriptor_51a1b9e17fd67b10) }
 
 var fileDescriptor_51a1b9e17fd67b10 = []byte{
-	// 2972 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4d, 0x6f, 0xdc, 0xd6,
-	0xb5, 0x43, 0x72, 0x3e, 0xcf, 0x8c, 0x24, 0xea, 0x4a, 0xf6, 0x9b, 0x28, 0xf6, 0x44, 0xa1, 0x83,
-	0x3c, 0x45, 0xc8, 0x13, 0x12, 0xbd, 0x3c, 0xe4, 0x21, 0x40, 0x9b, 0xc4, 0x92, 0x23, 0x3b, 0x95,
-	0x23, 0x9b, 0x52, 0xdc, 0x55, 0x81, 0x52, 0x9c, 0xab, 0x11, 0x2b, 0x0e, 0x49, 0x93, 0x1c, 0x09,
-	0x5a, 0x14, 0xdd, 0x75, 0xdb, 0x6e, 0x5a, 0xa0, 0x45, 0x37, 0x2d, 0xd0, 0x6d, 0x17, 0xfd, 0x01,
-	0xdd, 0xb5, 0xcd, 0x32, 0xe8, 0xaa, 0xab, 0xa2, 0x48, 0xfe, 0x45, 0x17, 0x45, 0x71, 0xce, 0xbd,
-	0x97, 0xbc, 0x9c, 0x0f, 0x39, 0x2e, 0xd0, 0x1d, 0xcf, 0xc7, 0x3d, 0xf7, 0xf0, 0x7c, 0x1f, 0xce,
-	0xc0, 0xf2, 0x70, 0x7c, 0x15, 0xa7, 0x17, 0x3c, 0xdd, 0x49, 0xd2, 0x38, 0x8f, 0x99, 0x99, 0x9c,
-	0x3a, 0x5b, 0xc0, 0x9e, 0x4e, 0x78, 0x7a, 0x7d, 0x9c, 0x7b, 0xf9, 0x24, 0x73, 0xf9, 0xf3, 0x09,
-	0xcf, 0x72, 0xc6, 0xa0, 0x1e, 0x79, 0x63, 0xde, 0x37, 0x36, 0x8d, 0xad, 0x8e, 0x4b, 0xcf, 0x4e,
-	0x02, 0xeb, 0x7b, 0xf1, 0x78, 0x1c, 0x47, 0xdf, 0x25, 0x19, 0x2e, 0xcf, 0x92, 0x38, 0xca, 0x38,
-	0xbb, 0x0d, 0xcd, 0x94, 0x67, 0x93, 0x30, 0x27, 0xee, 0xb6, 0x2b, 0x21, 0x66, 0x83, 0x35, 0xce,
-	0x46, 0x7d, 0x93, 0x44, 0xe0, 0x23, 0x72, 0x66, 0xf1, 0x24, 0xf5, 0x79, 0xdf, 0x22, 0xa4, 0x84,
-	0x10, 0x2f, 0xf4, 0xea, 0xd7, 0x05, 0x5e, 0x40, 0xce, 0xef, 0x0c, 0x58, 0xab, 0x28, 0xf7, 0xd2,
-	0x37, 0xbe, 0x07, 0x3d, 0x71, 0x87, 0x90, 0x40, 0xf7, 0x76, 0x77, 0xed, 0x9d, 0xe4, 0x74, 0xe7,
-	0x58, 0xc3, 0xbb, 0x15, 0x2e, 0xf6, 0x3e, 0x2c, 0x65, 0x93, 0xd3, 0x13, 0x2f, 0xbb, 0x90, 0xc7,
-	0xea, 0x9b, 0xd6, 0x56, 0x77, 0x77, 0x95, 0x8e, 0xe9, 0x04, 0xb7, 0xca, 0xe7, 0xfc, 0xd6, 0x80,
-	0xee, 0xde, 0x39, 0xf7, 0x25, 0x8c, 0x8a, 0x26, 0x5e, 0x96, 0xf1, 0xa1, 0x52, 0x54, 0x40, 0x6c,
-	0x1d, 0x1a, 0x79, 0x9c, 0x7b, 0x21, 0xa9, 0xda, 0x70, 0x05, 0xc0, 0x06, 0x00, 0xd9, 0xc4, 0xf7,
-	0x79, 0x96, 0x9d, 0x4d, 0x42, 0x52, 0xb5, 0xe1, 0x6a, 0x18, 0x94, 0x76, 0xe6, 0x05, 0x21, 0x1f,
-	0x92, 0x99, 0x1a, 0xae, 0x84, 0x58, 0x1f, 0x5a, 0x57, 0x5e, 0x1a, 0x05, 0xd1, 0xa8, 0xdf, 0x20,
-	0x82, 0x02, 0xf1, 0xc4, 0x90, 0xe7, 0x5e, 0x10, 0xf6, 0x9b, 0x9b, 0xc6, 0x56, 0xcf, 0x95, 0x90,
-	0xf3, 0x4f, 0x03, 0x60, 0x7f, 0x32, 0x4e, 0xa4, 0x9a, 0x9b, 0xd0, 0x25, 0x0d, 0x4e, 0xbc, 0xd3,
-	0x90, 0x67, 0xa4, 0xab, 0xe5, 0xea, 0x28, 0xb6, 0x05, 0x2b, 0x7e, 0x3c, 0x4e, 0x42, 0x9e, 0xf3,
-	0xa1, 0xe4, 0x42, 0xd5, 0x0d, 0x77, 0x1a, 0xcd, 0xde, 0x80, 0xa5, 0xb3, 0x20, 0x0a, 0xb2, 0x73,
-	0x3e, 0xbc, 0x7f, 0x9d, 0x73, 0x61, 0x72, 0xc3, 0xad, 0x22, 0x99, 0x03, 0x3d, 0x85, 0x70, 0xe3,
-	0xab, 0x8c, 0x5e, 0xc8, 0x70, 0x2b, 0x38, 0xf6, 0x36, 0xac, 0xf2, 0x2c, 0x0f, 0xc6, 0x5e, 0xce,
-	0x4f, 0x50, 0x15, 0x62, 0x6c, 0x10, 0xe3, 0x2c, 0x01, 0x7d, 0x7f, 0x9a, 0x64, 0xf4, 0x9e, 0x96,
-	0x8b, 0x8f, 0x6c, 0x03, 0xda, 0x49, 0x1a, 0x8f, 0x52, 0x9e, 0x65, 0xfd, 0x16, 0x85, 0x44, 0x01,
-	0x3b, 0x5f, 0x18, 0x00, 0x87, 0xb1, 0x37, 0x94, 0x06, 0x98, 0x51, 0x5a, 0x98, 0x60, 0x4a, 0xe9,
-	0x01, 0x00, 0xd9, 0x44, 0xb0, 0x98, 0xc4, 0xa2, 0x61, 0x2a, 0x17, 0x5a, 0xd5, 0x0b, 0xf1, 0xec,
-	0x98, 0xe7, 0xde, 0xfd, 0x20, 0x0a, 0xe3, 0x91, 0x0c, 0x73, 0x0d, 0xc3, 0xde, 0x84, 0xe5, 0x12,
-	0x3a, 0x38, 0x79, 0xb4, 0x4f, 0x6f, 0xda, 0x71, 0xa7, 0xb0, 0xb3, 0xaf, 0xe9, 0xfc, 0xcc, 0x80,
-	0xa5, 0xe3, 0x73, 0x2f, 0x1d, 0x06, 0xd1, 0xe8, 0x20, 0x8d, 0x27, 0x09, 0x7a, 0x3d, 0xf7, 0xd2,
-	0x11, 0xcf, 0x65, 0xfa, 0x4a, 0x08, 0x93, 0x7a, 0x7f, 0xff, 0x10, 0x35, 0xb7, 0x30, 0xa9, 0xf1,
-	0x59, 0xbc, 0x79, 0x9a, 0xe5, 0x87, 0xb1, 0xef, 0xe5, 0x41, 0x1c, 0x49, 0xc5, 0xab, 0x48, 0x4a,
-	0xdc, 0xeb, 0xc8, 0xa7, 0xc8, 0xb3, 0x28, 0x71, 0x09, 0xc2, 0x37, 0x9e, 0x44, 0x92, 0xd2, 0x20,
-	0x4a, 0x01, 0x3b, 0x7f, 0x6c, 0x00, 0x1c, 0x5f, 0x47, 0xfe, 0x54, 0x8c, 0x3d, 0xb8, 0xe4, 0x51,
-	0x5e, 0x8d, 0x31, 0x81, 0x42, 0x61, 0x22, 0xe4, 0x12, 0x65, 0xdc, 0x02, 0x66, 0x77, 0xa0, 0x93,
-	0x72, 0x9f, 0x47, 0x39, 0x12, 0x2d, 0x22, 0x96, 0x08, 0x8c, 0xa6, 0xb1, 0x97, 0xe5, 0x3c, 0xad,
-	0x98, 0xb7, 0x82, 0x63, 0xdb, 0x60, 0xeb, 0xf0, 0x41, 0x1e, 0x0c, 0xa5, 0x89, 0x67, 0xf0, 0x28,
-	0x8f, 0x5e, 0x42, 0xc9, 0x6b, 0x0a, 0x79, 0x3a, 0x0e, 0xe5, 0xe9, 0x30, 0xc9, 0x13, 0x51, 0x36,
-	0x83, 0x47, 0x79, 0xa7, 0x61, 0xec, 0x5f, 0x04, 0xd1, 0x88, 0x1c, 0xd0, 0x26, 0x53, 0x55, 0x70,
-	0xec, 0x5b, 0x60, 0x4f, 0xa2, 0x94, 0x67, 0x71, 0x78, 0xc9, 0x87, 0xe4, 0xc7, 0xac, 0xdf, 0xd1,
-	0xca, 0x8e, 0xee, 0x61, 0x77, 0x86, 0x55, 0xf3, 0x10, 0x88, 0x4a, 0x23, 0x3d, 0x34, 0x00, 0x38,
-	0x25, 0x45, 0x4e, 0xae, 0x13, 0xde, 0xef, 0x8a, 0xb8, 0x2b, 0x31, 0xec, 0x1d, 0x58, 0xcb, 0xb8,
-	0x1f, 0x47, 0xc3, 0xec, 0x3e, 0x3f, 0x0f, 0xa2, 0xe1, 0x63, 0xb2, 0x45, 0xbf, 0x47, 0x26, 0x9e,
-	0x47, 0xc2, 0x88, 0x21, 0xc5, 0xf7, 0xf7, 0x0f, 0x8f, 0xae, 0x22, 0x9e, 0xf6, 0x97, 0x44, 0xc4,
-	0x54, 0x90, 0xe8, 0x6e, 0x3f, 0x8e, 0xce, 0xc2, 0xc0, 0xcf, 0x1f, 0x67, 0xa3, 0xfe, 0x32, 0xf1,
-	0xe8, 0x28, 0x74, 0x69, 0x5e, 0xa4, 0xf5, 0x8a, 0x70, 0x69, 0x81, 0x28, 0x82, 0xc1, 0x4d, 0xb2,
-	0xbe, 0xad, 0x05, 0x83, 0xab, 0x07, 0x03, 0x12, 0x57, 0xf5, 0x60, 0x70, 0x45, 0x30, 0x04, 0xf1,
-	0x49, 0x99, 0xa7, 0x6c, 0xd3, 0xd8, 0xaa, 0xbb, 0x15, 0x1c, 0x3a, 0x6f, 0x38, 0x19, 0x27, 0x8f,
-	0x8e, 0x34, 0xbe, 0x35, 0xe2, 0x9b, 0xc1, 0x3b, 0xbf, 0x32, 0xa0, 0xa7, 0xf7, 0x0a, 0xad, 0x8b,
-	0x19, 0x0b, 0xba, 0x98, 0xa9, 0x77, 0x31, 0xf6, 0x56, 0xd1, 0xad, 0x44, 0xf7, 0x21, 0x7f, 0x3e,
-	0x49, 0x63, 0x2c, 0xeb, 0x2e, 0x11, 0x8a, 0x06, 0xf6, 0x2e, 0x74, 0x53, 0x1e, 0x7a, 0xd7, 0x45,
-	0xdb, 0x41, 0xfe, 0x15, 0xe4, 0x77, 0x4b, 0xb4, 0xab, 0xf3, 0x38, 0x7f, 0x36, 0xa1, 0xab, 0x11,
-	0x67, 0x72, 0xc1, 0xf8, 0x86, 0xb9, 0x60, 0x2e, 0xc8, 0x85, 0x4d, 0xa5, 0xd2, 0xe4, 0x74, 0x3f,
-	0x48, 0x65, 0x79, 0xd0, 0x51, 0x05, 0x47, 0x25, 0xf9, 0x74, 0x14, 0x76, 0x0f, 0x0d, 0xd4, 0x52,
-	0x6f, 0x1a, 0xcd, 0x76, 0x80, 0x11, 0x6a, 0xcf, 0xcb, 0xfd, 0xf3, 0xcf, 0x13, 0x19, 0x8d, 0x4d,
-	0x0a, 0xe9, 0x39, 0x14, 0xf6, 0x1a, 0x34, 0xb2, 0xdc, 0x1b, 0x71, 0x4a, 0xbd, 0xe5, 0xdd, 0x0e,
-	0xa5, 0x0a, 0x22, 0x5c, 0x81, 0xd7, 0x8c, 0xdf, 0x7e, 0x81, 0xf1, 0x9d, 0xdf, 0x5b, 0xb0, 0x54,
-	0xe9, 0xee, 0xf3, 0xa6, 0xa0, 0xf2, 0x46, 0x73, 0xc1, 0x8d, 0x9b, 0x50, 0x9f, 0x44, 0x81, 0x70,
-	0xf6, 0xf2, 0x6e, 0x0f, 0xe9, 0x9f, 0x47, 0x41, 0x8e, 0xd9, 0xe6, 0x12, 0x45, 0xd3, 0xa9, 0xfe,
-	0xa2, 0x80, 0x78, 0x07, 0xd6, 0xca, 0x54, 0xdf, 0xdf, 0x3f, 0x3c, 0x8c, 0xfd, 0x8b, 0xa2, 0x37,
-	0xcc, 0x23, 0x31, 0x26, 0x66, 0x20, 0x2a, 0x59, 0x0f, 0x6b, 0x62, 0x0a, 0xfa, 0x6f, 0x68, 0xf8,
-	0x38, 0x95, 0x90, 0x95, 0x64, 0x40, 0x69, 0x63, 0xca, 0xc3, 0x9a, 0x2b, 0xe8, 0xec, 0x0d, 0xa8,
-	0x63, 0xfc, 0x4b, 0x5b, 0x2d, 0x23, 0x5f, 0x39, 0x26, 0x3c, 0xac, 0xb9, 0x44, 0x45, 0xae, 0x30,
-	0xf6, 0x86, 0xfd, 0x4e, 0xc9, 0x55, 0xf6, 0x52, 0xe4, 0x42, 0x2a, 0x72, 0x61, 0x0d, 0xa2, 0x7a,
-	0x24, 0xb9, 0xca, 0x76, 0x80, 0x5c, 0x48, 0x65, 0xef, 0x01, 0x5c, 0x7a, 0x61, 0x30, 0x14, 0xcd,
-	0xa7, 0x4b, 0xbc, 0xeb, 0xc8, 0xfb, 0xac, 0xc0, 0xca, 0xa8, 0xd7, 0xf8, 0xee, 0xb7, 0xa1, 0x99,
-	0x89, 0xf0, 0xff, 0x36, 0xac, 0x56, 0x7c, 0x76, 0x18, 0x64, 0x64, 0x60, 0x41, 0xee, 0x1b, 0x8b,
-	0x06, 0x37, 0x75, 0x7e, 0x00, 0x40, 0x96, 0x78, 0x90, 0xa6, 0x71, 0xaa, 0x06, 0x48, 0xa3, 0x18,
-	0x20, 0x9d, 0xbb, 0xd0, 0x41, 0x0b, 0xdc, 0x40, 0xc6, 0x57, 0x5f, 0x44, 0x4e, 0xa0, 0x47, 0xef,
-	0xfc, 0xf4, 0x70, 0x01, 0x07, 0xdb, 0x85, 0x75, 0x31, 0xc5, 0x89, 0x24, 0x78, 0x12, 0x67, 0x01,
-	0x59, 0x42, 0xa4, 0xe3, 0x5c, 0x1a, 0xd6, 0x46, 0x8e, 0xe2, 0x8e, 0x9f, 0x1e, 0xaa, 0x39, 0x43,
-	0xc1, 0xce, 0xff, 0x41, 0x07, 0x6f, 0x14, 0xd7, 0x6d, 0x41, 0x93, 0x08, 0xca, 0x0e, 0x76, 0xe1,
-	0x04, 0xa9, 0x90, 0x2b, 0xe9, 0xce, 0x4f, 0x0c, 0xe8, 0x8a, 0x22, 0x27, 0x4e, 0xbe, 0x6c, 0x8d,
-	0xdb, 0xac, 0x1c, 0x57, 0x55, 0x42, 0x97, 0xb8, 0x03, 0x40, 0x65, 0x4a, 0x30, 0xd4, 0xcb, 0xa0,
-	0x28, 0xb1, 0xae, 0xc6, 0x81, 0x8e, 0x29, 0xa1, 0x39, 0xa6, 0xfd, 0x85, 0x09, 0x3d, 0xe9, 0x52,
-	0xc1, 0xf2, 0x1f, 0x4a, 0x56, 0x99, 0x4f, 0x75, 0x3d, 0x9f, 0xde, 0x54, 0xf9, 0xd4, 0x28, 0x5f,
-	0xa3, 0x8c, 0xa2, 0x32, 0x9d, 0xee, 0xc9, 0x74, 0x6a, 0x12, 0xdb, 0x92, 0x4a, 0x27, 0xc5, 0x25,
-	0xb2, 0xe9, 0x9e, 0xcc, 0xa6, 0x56, 0xc9, 0x54, 0x84, 0x54, 0x91, 0x4c, 0xf7, 0x64, 0x32, 0xb5,
-	0x4b, 0xa6, 0xc2, 0xcd, 0x2a, 0x97, 0xee, 0xb7, 0xa0, 0x41, 0xee, 0x74, 0x3e, 0x00, 0x5b, 0x37,
-	0x0d, 0xe5, 0xc4, 0x9b, 0x92, 0x58, 0x09, 0x05, 0x8d, 0xc9, 0x95, 0x67, 0x9f, 0xc3, 0x52, 0xa5,
-	0x14, 0xe1, 0x04, 0x11, 0x64, 0x7b, 0x5e, 0xe4, 0xf3, 0xb0, 0xd8, 0x63, 0x34, 0x8c, 0x16, 0x64,
-	0x66, 0x29, 0x59, 0x8a, 0xa8, 0x04, 0x99, 0xb6, 0x8d, 0x58, 0x95, 0x6d, 0xe4, 0x2f, 0x06, 0xf4,
-	0xf4, 0x03, 0xb8, 0xd0, 0x3c, 0x48, 0xd3, 0xbd, 0x78, 0x28, 0xbc, 0xd9, 0x70, 0x15, 0x88, 0xa1,
-	0x8f, 0x8f, 0xa1, 0x97, 0x65, 0x32, 0x02, 0x0b, 0x58, 0xd2, 0x8e, 0xfd, 0x38, 0x51, 0xfb, 0x65,
-	0x01, 0x4b, 0xda, 0x21, 0xbf, 0xe4, 0xa1, 0x6c, 0x50, 0x05, 0x8c, 0xb7, 0x3d, 0xe6, 0x59, 0x86,
-	0x61, 0x22, 0xea, 0xaa, 0x02, 0xf1, 0x94, 0xeb, 0x5d, 0xed, 0x79, 0x93, 0x8c, 0xcb, 0x19, 0xb0,
-	0x80, 0xd1, 0x2c, 0xb8, 0x07, 0x7b, 0x69, 0x3c, 0x89, 0xd4, 0xe4, 0xa7, 0x61, 0x9c, 0x2b, 0x58,
-	0x7d, 0x32, 0x49, 0x47, 0x9c, 0x82, 0x58, 0xad, 0xd5, 0x1b, 0xd0, 0x0e, 0x22, 0xcf, 0xcf, 0x83,
-	0x4b, 0x2e, 0x2d, 0x59, 0xc0, 0x18, 0xbf, 0x79, 0x30, 0xe6, 0x72, 0xf4, 0xa5, 0x67, 0xe4, 0x3f,
-	0x0b, 0x42, 0x4e, 0x71, 0x2d, 0x5f, 0x49, 0xc1, 0x94, 0xa2, 0xa2, 0x27, 0xcb, 0xa5, 0x59, 0x40,
-	0xce, 0x2f, 0x4d, 0xd8, 0x38, 0x4a, 0x78, 0xea, 0xe5, 0x5c, 0x2c, 0xea, 0xc7, 0xfe, 0x39, 0x1f,
-	0x7b, 0x4a, 0x85, 0x3b, 0x60, 0xc6, 0x09, 0x5d, 0x2e, 0xe3, 0x5d, 0x90, 0x8f, 0x12, 0xd7, 0x8c,
-	0x13, 0x52, 0xc2, 0xcb, 0x2e, 0xa4, 0x6d, 0xe9, 0x79, 0xe1, 0xd6, 0xbe, 0x01, 0xed, 0xa1, 0x97,
-	0x7b, 0xa7, 0x5e, 0xc6, 0x95, 0x4d, 0x15, 0x4c, 0x0b, 0x2e, 0xee, 0x83, 0xd2, 0xa2, 0x02, 0x20,
-	0x49, 0x74, 0x9b, 0xb4, 0xa6, 0x84, 0x90, 0xfb, 0x2c, 0x9c, 0x64, 0xe7, 0x64, 0xc6, 0xb6, 0x2b,
-	0x00, 0xd4, 0xa5, 0x88, 0xf9, 0xb6, 0x6c, 0x17, 0x03, 0x80, 0xb3, 0x34, 0x1e, 0x8b, 0xc2, 0x42,
-	0x0d, 0xa8, 0xed, 0x6a, 0x18, 0x45, 0x3f, 0x11, 0xeb, 0x0f, 0x94, 0x74, 0x81, 0x71, 0x72, 0x58,
-	0x7a, 0xf6, 0xae, 0x0c, 0xfb, 0xc7, 0x3c, 0xf7, 0xd8, 0x86, 0x66, 0x0e, 0x40, 0x73, 0x20, 0x45,
-	0x1a, 0xe3, 0x85, 0xd5, 0x43, 0x95, 0x1c, 0x4b, 0x2b, 0x39, 0xca, 0x82, 0x75, 0x0a, 0x71, 0x7a,
-	0x76, 0xde, 0x83, 0x75, 0xe9, 0x91, 0x67, 0xef, 0xe2, 0xad, 0x0b, 0x7d, 0x21, 0xc8, 0xe2, 0x7a,
-	0xe7, 0x4f, 0x06, 0xdc, 0x9a, 0x3a, 0xf6, 0xd2, 0xdf, 0x3f, 0xde, 0x87, 0x3a, 0x2e, 0x90, 0x7d,
-	0x8b, 0x52, 0xf3, 0x1e, 0xde, 0x31, 0x57, 0xe4, 0x0e, 0x02, 0x0f, 0xa2, 0x3c, 0xbd, 0x76, 0xe9,
-	0xc0, 0xc6, 0xa7, 0xd0, 0x29, 0x50, 0x28, 0xf7, 0x82, 0x5f, 0xab, 0xea, 0x7b, 0xc1, 0xaf, 0x71,
-	0xa2, 0xb8, 0xf4, 0xc2, 0x89, 0x30, 0x8d, 0x6c, 0xb0, 0x15, 0xc3, 0xba, 0x82, 0xfe, 0x81, 0xf9,
-	0xff, 0x86, 0xf3, 0x43, 0xe8, 0x3f, 0xf4, 0xa2, 0x61, 0x28, 0xe3, 0x51, 0x14, 0x05, 0x69, 0x82,
-	0x57, 0x35, 0x13, 0x74, 0x51, 0x0a, 0x51, 0x6f, 0x88, 0xc6, 0x3b, 0xd0, 0x39, 0x55, 0xed, 0x50,
-	0x1a, 0xbe, 0x44, 0x50, 0xcc, 0x3c, 0x0f, 0x33, 0xb9, 0xa6, 0xd2, 0xb3, 0x73, 0x0b, 0xd6, 0x0e,
-	0x78, 0x2e, 0xee, 0xde, 0x3b, 0x1b, 0xc9, 0x9b, 0x9d, 0x2d, 0x58, 0xaf, 0xa2, 0xa5, 0x71, 0x6d,
-	0xb0, 0xfc, 0xb3, 0xa2, 0xd5, 0xf8, 0x67, 0x23, 0xe7, 0x18, 0xee, 0x8a, 0x69, 0x69, 0x72, 0x8a,
-	0x2a, 0x60, 0xe9, 0xfb, 0x3c, 0x19, 0x7a, 0x39, 0x57, 0x2f, 0xb1, 0x0b, 0xeb, 0x99, 0xa0, 0xed,
-	0x9d, 0x8d, 0x4e, 0xe2, 0x71, 0x78, 0x9c, 0xa7, 0x41, 0xa4, 0x64, 0xcc, 0xa5, 0x39, 0x87, 0x30,
-	0x58, 0x24, 0x54, 0x2a, 0xd2, 0x87, 0x96, 0xfc, 0xf8, 0x23, 0xdd, 0xac, 0xc0, 0x59, 0x3f, 0x3b,
-	0x23, 0xd8, 0x38, 0xe0, 0xf9, 0xcc, 0xcc, 0x54, 0x96, 0x1d, 0xbc, 0xe3, 0xb3, 0xb2, 0x3d, 0x16,
-	0x30, 0xfb, 0x1f, 0xe8, 0x9d, 0x05, 0x61, 0xce, 0x53, 0xb9, 0x73, 0xcc, 0xc4, 0x7a, 0x85, 0xec,
-	0xfc, 0xcd, 0x02, 0x7b, 0xfa, 0x9a, 0xc2, 0x4f, 0xc6, 0xdc, 0xaa, 0x61, 0x56, 0xaa, 0x06, 0x83,
-	0xfa, 0x18, 0x0b, 0xbb, 0xcc, 0x19, 0x7c, 0x2e, 0x13, 0xad, 0xbe, 0x20, 0xd1, 0xb6, 0x60, 0x45,
-	0x4e, 0x7f, 0xb1, 0xda, 0x6b, 0xe4, 0x02, 0x31, 0x85, 0xc6, 0x81, 0x79, 0x0a, 0x45, 0xeb, 0x86,
-	0xa8, 0x37, 0xf3, 0x48, 0xda, 0x34, 0xde, 0xfa, 0x06, 0xd3, 0x78, 0x22, 0x08, 0xe2, 0x13, 0x95,
-	0x34, 0x59, 0x5b, 0x08, 0x9f, 0x43, 0x62, 0x6f, 0xc3, 0x6a, 0xc2, 0x23, 0x5c, 0xdc, 0x35, 0xfe,
-	0x0e, 0xf1, 0xcf, 0x12, 0xf0, 0x35, 0xa9, 0x55, 0x6a, 0xbc, 0x20, 0x5e, 0x73, 0x0a, 0x8d, 0x1b,
-	0x9c, 0x3f, 0xc9, 0xe3, 0x4b, 0xb5, 0xaa, 0x61, 0x32, 0x88, 0xe5, 0x7e, 0x06, 0x8f, 0x3a, 0x54,
-	0x70, 0x64, 0x90, 0x9e, 0xd0, 0x61, 0x86, 0xe0, 0xfc, 0xc6, 0x80, 0x5b, 0xa5, 0x83, 0xe9, 0xa3,
-	0xde, 0x0b, 0xf6, 0xde, 0x0d, 0x68, 0x67, 0xa9, 0x4f, 0x9c, 0xaa, 0x27, 0x2b, 0x98, 0x7a, 0x44,
-	0x96, 0x0b, 0x9a, 0x6c, 0x60, 0x0a, 0x7e, 0xb1, 0xd7, 0xfb, 0xd0, 0x1a, 0x57, 0x1b, 0xb3, 0x04,
-	0x9d, 0x3f, 0x18, 0xf0, 0xea, 0xdc, 0x78, 0xff, 0x37, 0x3e, 0x10, 0x43, 0x11, 0x14, 0x99, 0x2c,
-	0x93, 0x37, 0xef, 0x1f, 0x38, 0xc9, 0x7c, 0x08, 0x4b, 0x79, 0x69, 0x19, 0xae, 0x3e, 0x10, 0xbf,
-	0x52, 0x3d, 0xa8, 0x19, 0xcf, 0xad, 0xf2, 0x3b, 0x17, 0xf0, 0x4a, 0x45, 0xff, 0x4a, 0x4d, 0xdc,
-	0xa5, 0xf9, 0x1e, 0x79, 0xb9, 0xac, 0x8c, 0xb7, 0x35, 0xc1, 0x62, 0x9e, 0x26, 0xaa, 0x5b, 0xf0,
-	0x55, 0x52, 0xdc, 0xac, 0xa6, 0xb8, 0xf3, 0x6b, 0x13, 0x56, 0xa6, 0xae, 0x62, 0xcb, 0x60, 0x06,
-	0x43, 0xe9, 0x48, 0x33, 0x18, 0x2e, 0x4c, 0x57, 0xdd, 0xb9, 0xd6, 0x94, 0x73, 0xb1, 0x40, 0xa5,
-	0xfe, 0xbe, 0x97, 0x7b, 0xb2, 0xff, 0x2b, 0xb0, 0xe2, 0xf6, 0xc6, 0x94, 0xdb, 0xfb, 0xd0, 0x1a,
-	0x66, 0x39, 0x9d, 0x12, 0x59, 0xa9, 0x40, 0x2c, 0xed, 0x14, 0xe7, 0xf4, 0xa9, 0x4a, 0x4c, 0x54,
-	0x25, 0x82, 0xed, 0x14, 0x4b, 0x5d, 0xfb, 0x46, 0x9b, 0x48, 0xae, 0x62, 0x9e, 0xea, 0xc8, 0xa2,
-	0x84, 0xf3, 0x94, 0x16, 0x51, 0x50, 0x8d, 0xa8, 0xe7, 0x53, 0x05, 0x54, 0x3a, 0xe4, 0xa5, 0xe3,
-	0xe9, 0x2d, 0x35, 0x66, 0x8b, 0x50, 0x5a, 0xab, 0x46, 0x44, 0x65, 0xd2, 0xfe, 0xb9, 0x01, 0x77,
-	0x55, 0x33, 0x9e, 0x1f, 0x08, 0xf7, 0xb4, 0xe6, 0x38, 0x2b, 0x49, 0x36, 0x49, 0x9a, 0xcf, 0x3f,
-	0x0e, 0x43, 0xb1, 0x58, 0x99, 0x6a, 0x3e, 0x57, 0x98, 0x4a, 0x64, 0x58, 0x53, 0xc5, 0x7f, 0x9d,
-	0xb4, 0x7d, 0x24, 0x7e, 0x50, 0xa8, 0xbb, 0x02, 0x70, 0x3e, 0x85, 0xc1, 0x22, 0xbd, 0x5e, 0xd6,
-	0x1e, 0xce, 0x35, 0xdc, 0x15, 0x6d, 0xad, 0x14, 0xa5, 0x7e, 0x3e, 0x7a, 0x71, 0x6f, 0xaa, 0xf4,
-	0x7a, 0x73, 0xba, 0xd7, 0x17, 0x9f, 0x36, 0xe9, 0x73, 0xb9, 0xa5, 0x7f, 0xda, 0x44, 0xcc, 0xf6,
-	0x05, 0x34, 0xc5, 0x30, 0xc7, 0x96, 0xa0, 0xf3, 0x28, 0xa2, 0xf4, 0x3d, 0x4a, 0xec, 0x1a, 0x6b,
-	0x43, 0xfd, 0x38, 0x8f, 0x13, 0xdb, 0x60, 0x1d, 0x68, 0x3c, 0xc1, 0x69, 0xde, 0x36, 0x19, 0x40,
-	0x13, 0xab, 0xfd, 0x98, 0xdb, 0x16, 0xa2, 0x8f, 0x73, 0x2f, 0xcd, 0xed, 0x3a, 0xa2, 0x85, 0xfe,
-	0x76, 0x83, 0x2d, 0x03, 0x7c, 0x3c, 0xc9, 0x63, 0xc9, 0xd6, 0x44, 0xda, 0x3e, 0x0f, 0x79, 0xce,
-	0xed, 0xd6, 0xf6, 0x8f, 0xe8, 0xc8, 0x08, 0xc7, 0x87, 0x9e, 0xbc, 0x8b, 0x60, 0xbb, 0xc6, 0x5a,
-	0x60, 0x7d, 0xc6, 0xaf, 0x6c, 0x83, 0x75, 0xa1, 0xe5, 0x4e, 0xa2, 0x28, 0x88, 0x46, 0xe2, 0x3e,
-	0xba, 0x7a, 0x68, 0x5b, 0x48, 0x40, 0x85, 0x12, 0x3e, 0xb4, 0xeb, 0xac, 0x07, 0xed, 0x4f, 0xe4,
-	0xcf, 0x0e, 0x76, 0x03, 0x49, 0xc8, 0x86, 0x67, 0x9a, 0x48, 0xa2, 0xcb, 0x11, 0x6a, 0x21, 0x44,
-	0xa7, 0x10, 0x6a, 0x6f, 0x1f, 0x41, 0x5b, 0x6d, 0xae, 0x6c, 0x05, 0xba, 0x52, 0x07, 0x44, 0xd9,
-	0x35, 0x7c, 0x21, 0x1a, 0x36, 0x6c, 0x03, 0x5f, 0x1e, 0x77, 0x50, 0xdb, 0xc4, 0x27, 0x5c, 0x34,
-	0x6d, 0x8b, 0x0c, 0x72, 0x1d, 0xf9, 0x76, 0x1d, 0x19, 0x69, 0x61, 0xb1, 0x87, 0xdb, 0x8f, 0xa1,
-	0x45, 0x8f, 0x47, 0x38, 0x87, 0x2d, 0x4b, 0x79, 0x12, 0x63, 0xd7, 0xd0, 0xa6, 0x78, 0xbb, 0xe0,
-	0x36, 0xd0, 0x36, 0xf4, 0x3a, 0x02, 0x36, 0x51, 0x05, 0x61, 0x27, 0x81, 0xb0, 0xb6, 0x7f, 0x6c,
-	0x40, 0x5b, 0xad, 0x1a, 0x6c, 0x0d, 0x56, 0x94, 0x91, 0x24, 0x4a, 0x48, 0x3c, 0xe0, 0xb9, 0x40,
-	0xd8, 0x06, 0x5d, 0x50, 0x80, 0x26, 0xda, 0xd5, 0xe5, 0xe3, 0xf8, 0x92, 0x4b, 0x8c, 0x85, 0x57,
-	0xe2, 0x66, 0x2b, 0xe1, 0x3a, 0x1e, 0x40, 0x98, 0xaa, 0x8c, 0xdd, 0x60, 0xb7, 0x81, 0x21, 0xf8,
-	0x38, 0x18, 0x61, 0x24, 0x8b, 0xf9, 0x3f, 0xb3, 0x9b, 0xdb, 0x1f, 0x41, 0x5b, 0x8d, 0xd9, 0x9a,
-	0x1e, 0x0a, 0x55, 0xe8, 0x21, 0x10, 0xb6, 0x51, 0x5e, 0x2c, 0x31, 0xe6, 0xf6, 0x33, 0x5a, 0x4f,
-	0x71, 0x4a, 0xd5, 0x2c, 0x23, 0x31, 0x32, 0xbc, 0x2e, 0x82, 0x44, 0x3a, 0x9c, 0x27, 0xa1, 0xe7,
-	0x17, 0x01, 0x76, 0xc9, 0xd3, 0xdc, 0xb6, 0xf0, 0xf9, 0x51, 0xf4, 0x03, 0xee, 0x63, 0x84, 0xa1,
-	0x1b, 0x82, 0x2c, 0xb7, 0x1b, 0xdb, 0x87, 0xd0, 0x7d, 0xa6, 0x7a, 0xcc, 0x51, 0x82, 0x2f, 0xa0,
-	0x94, 0x2b, 0xb1, 0x76, 0x0d, 0xef, 0xa4, 0xe8, 0x2c, 0xb0, 0xb6, 0xc1, 0x56, 0x61, 0x09, 0xbd,
-	0x51, 0xa2, 0xcc, 0xed, 0xa7, 0xc0, 0x66, 0xab, 0x23, 0x1a, 0xad, 0x54, 0xd8, 0xae, 0xa1, 0x26,
-	0x9f, 0xf1, 0x2b, 0x7c, 0x26, 0x1f, 0x3e, 0x1a, 0x45, 0x71, 0xca, 0x89, 0xa6, 0x7c, 0x48, 0xdf,
-	0x17, 0x11, 0x61, 0x6d, 0x3f, 0x9b, 0xea, 0x23, 0x47, 0x89, 0x16, 0xee, 0x04, 0xdb, 0x35, 0x0a,
-	0x3e, 0x92, 0x22, 0x10, 0xd2, 0x80, 0x24, 0x46, 0x60, 0x4c, 0xbc, 0x68, 0x2f, 0xe4, 0x5e, 0x2a,
-	0x60, 0x6b, 0xf7, 0x1f, 0x4d, 0x68, 0x8a, 0xaa, 0xc0, 0x3e, 0x82, 0xae, 0xf6, 0x8b, 0x2f, 0xa3,
-	0x22, 0x3f, 0xfb, 0xfb, 0xf4, 0xc6, 0x7f, 0xcd, 0xe0, 0x45, 0x65, 0x72, 0x6a, 0xec, 0x43, 0x80,
-	0x72, 0xf1, 0x66, 0xb7, 0x68, 0x9a, 0x9b, 0x5e, 0xc4, 0x37, 0xfa, 0xf4, 0xc9, 0x66, 0xce, 0xaf,
-	0xd9, 0x4e, 0x8d, 0x7d, 0x07, 0x96, 0x64, 0xf9, 0x13, 0xa1, 0xc5, 0x06, 0xda, 0xda, 0x34, 0x67,
-	0xa5, 0xbe, 0x51, 0xd8, 0x27, 0x85, 0x30, 0x11, 0x3e, 0xac, 0x3f, 0x67, 0x07, 0x13, 0x62, 0x5e,
-	0x59, 0xb8, 0x9d, 0x39, 0x35, 0x76, 0x00, 0x5d, 0xb1, 0x43, 0x89, 0xa2, 0x7e, 0x07, 0x79, 0x17,
-	0x2d, 0x55, 0x37, 0x2a, 0xb4, 0x07, 0x3d, 0x7d, 0xed, 0x61, 0x64, 0xc9, 0x39, 0xfb, 0x91, 0x10,
-	0x32, 0x6f, 0x43, 0x72, 0x6a, 0xcc, 0x83, 0xdb, 0xf3, 0x97, 0x17, 0xf6, 0x7a, 0xf9, 0x6d, 0x79,
-	0xc1, 0xb6, 0xb4, 0xe1, 0xdc, 0xc4, 0x52, 0x5c, 0xf1, 0x3d, 0xe8, 0x17, 0x97, 0x17, 0x61, 0x2d,
-	0xa3, 0x62, 0x20, 0x55, 0x5b, 0xb0, 0xef, 0x6c, 0xbc, 0xb6, 0x90, 0x5e, 0x88, 0x3f, 0x81, 0xd5,
-	0x92, 0x21, 0x16, 0xe6, 0x63, 0x77, 0x67, 0xce, 0x55, 0xcc, 0x3a, 0x58, 0x44, 0x2e, 0xa4, 0x7e,
-	0xbf, 0xdc, 0xd8, 0xab, 0x92, 0x5f, 0xd7, 0x7d, 0x3b, 0x5f, 0xba, 0x73, 0x13, 0x4b, 0x71, 0xc3,
-	0x13, 0x58, 0xa9, 0xf4, 0x53, 0x25, 0xfb, 0xc6, 0x26, 0x7b, 0x53, 0x40, 0xdc, 0xef, 0x7f, 0xf1,
-	0xd5, 0xc0, 0xf8, 0xf2, 0xab, 0x81, 0xf1, 0xf7, 0xaf, 0x06, 0xc6, 0x4f, 0xbf, 0x1e, 0xd4, 0xbe,
-	0xfc, 0x7a, 0x50, 0xfb, 0xeb, 0xd7, 0x83, 0xda, 0x69, 0x93, 0xfe, 0x25, 0xf2, 0xbf, 0xff, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0x07, 0x2d, 0x0f, 0x37, 0x22, 0x00, 0x00,
+	// 3123 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x1a, 0x4d, 0x6f, 0x1c, 0x59,
+	0x31, 0xd3, 0x3d, 0x33, 0x9e, 0xa9, 0xf1, 0xc7, 0xb8, 0xe3, 0x24, 0x13, 0x6f, 0xe2, 0xcd, 0x76,
+	0xd0, 0x92, 0xb5, 0x20, 0x22, 0x61, 0xd1, 0xae, 0x56, 0x02, 0x36, 0xb1, 0x77, 0xb3, 0x59, 0x9c,
+	0x75, 0xb6, 0xed, 0x84, 0x13, 0x12, 0xed, 0x99, 0xb6, 0x3d, 0xb8, 0xa7, 0xbb, 0xd3, 0xdd, 0x13,
+	0xcb, 0x07, 0xc4, 0x8d, 0x0b, 0x07, 0xb8, 0x80, 0x04, 0xe2, 0x02, 0xd2, 0x1e, 0xe1, 0xc0, 0x0f,
+	0xe0, 0x08, 0x1c, 0x57, 0x9c, 0x38, 0x21, 0x04, 0xbf, 0x02, 0x4e, 0xd4, 0xc7, 0x7b, 0xdd, 0xaf,
+	0xe7, 0xc3, 0xde, 0x20, 0x71, 0xb0, 0xf4, 0xea, 0xa3, 0xeb, 0xd5, 0xab, 0xaf, 0x57, 0xf5, 0xc6,
+	0xb0, 0x3c, 0x18, 0x9d, 0xc6, 0xe9, 0x49, 0x90, 0xde, 0x4d, 0xd2, 0x38, 0x8f, 0x1d, 0x2b, 0x39,
+	0x70, 0xef, 0x80, 0xf3, 0xe9, 0x38, 0x48, 0xcf, 0xf6, 0x72, 0x3f, 0x1f, 0x67, 0x5e, 0xf0, 0x62,
+	0x1c, 0x64, 0xb9, 0xe3, 0x40, 0x3d, 0xf2, 0x47, 0x41, 0xaf, 0x76, 0xab, 0x76, 0xa7, 0xed, 0xf1,
+	0xda, 0x4d, 0x60, 0x6d, 0x2b, 0x1e, 0x8d, 0xe2, 0xe8, 0xbb, 0x2c, 0xc3, 0x0b, 0xb2, 0x24, 0x8e,
+	0xb2, 0xc0, 0xb9, 0x0a, 0xcd, 0x34, 0xc8, 0xc6, 0x61, 0xce, 0xdc, 0x2d, 0x4f, 0x41, 0x4e, 0x17,
+	0xec, 0x51, 0x76, 0xd4, 0xb3, 0x58, 0x04, 0x2d, 0x89, 0x33, 0x8b, 0xc7, 0x69, 0x3f, 0xe8, 0xd9,
+	0x8c, 0x54, 0x10, 0xe1, 0x45, 0xaf, 0x5e, 0x5d, 0xf0, 0x02, 0xb9, 0xbf, 0xaf, 0xc1, 0xe5, 0x8a,
+	0x72, 0xaf, 0xbc, 0xe3, 0xdb, 0xb0, 0x28, 0x7b, 0x88, 0x04, 0xde, 0xb7, 0x73, 0xbf, 0x7b, 0x37,
+	0x39, 0xb8, 0xbb, 0x67, 0xe0, 0xbd, 0x0a, 0x97, 0xf3, 0x0e, 0x2c, 0x65, 0xe3, 0x83, 0x7d, 0x3f,
+	0x3b, 0x51, 0x9f, 0xd5, 0x6f, 0xd9, 0xf8, 0xd9, 0x2a, 0x7f, 0x66, 0x12, 0xbc, 0x2a, 0x9f, 0xfb,
+	0x59, 0x0d, 0x3a, 0x5b, 0xc7, 0x41, 0x5f, 0xc1, 0xa4, 0x68, 0xe2, 0x67, 0x59, 0x30, 0xd0, 0x8a,
+	0x0a, 0xe4, 0xac, 0x41, 0x23, 0x8f, 0x73, 0x3f, 0x64, 0x55, 0x1b, 0x9e, 0x00, 0xce, 0x06, 0x40,
+	0x36, 0xee, 0xf7, 0x83, 0x2c, 0x3b, 0x1c, 0x87, 0xac, 0x6a, 0xc3, 0x33, 0x30, 0x24, 0xed, 0xd0,
+	0x1f, 0x86, 0x28, 0xad, 0xce, 0x34, 0x05, 0x39, 0x3d, 0x58, 0x38, 0xf5, 0xd3, 0x68, 0x18, 0x1d,
+	0xf5, 0x1a, 0x4c, 0xd0, 0x20, 0x7d, 0x31, 0x08, 0x72, 0xe4, 0xea, 0x35, 0x91, 0xb0, 0xe8, 0x29,
+	0xc8, 0xfd, 0x5d, 0x0d, 0x96, 0xf6, 0xfd, 0x83, 0x30, 0x78, 0x9a, 0xc6, 0x47, 0x68, 0x3c, 0xd6,
+	0x34, 0xeb, 0x1f, 0x07, 0x23, 0x5f, 0xb9, 0x5c, 0x41, 0xac, 0x29, 0x31, 0x2a, 0xa3, 0x0a, 0xe0,
+	0xb8, 0xb0, 0x88, 0x51, 0x32, 0x1c, 0xf9, 0x79, 0xe0, 0xc5, 0xa7, 0x62, 0x56, 0xdb, 0xab, 0xe0,
+	0x9c, 0x2f, 0xc1, 0x92, 0x86, 0x1f, 0x9e, 0xe5, 0x41, 0xc6, 0x4a, 0xdb, 0x5e, 0x15, 0x49, 0x5c,
+	0x87, 0xc3, 0x68, 0x98, 0x1d, 0x07, 0x03, 0xe1, 0x6a, 0x08, 0x57, 0x05, 0xe9, 0xfe, 0xc4, 0x06,
+	0xd8, 0x1e, 0x8f, 0x12, 0x65, 0xd6, 0x5b, 0xd0, 0x61, 0x8b, 0xf1, 0x11, 0x32, 0xd6, 0xd8, 0xf6,
+	0x4c, 0x94, 0x73, 0x07, 0x56, 0xfa, 0xf1, 0x28, 0x09, 0x83, 0x3c, 0x18, 0x28, 0x2e, 0x3a, 0x40,
+	0xcd, 0x9b, 0x44, 0x4f, 0x2b, 0x60, 0x33, 0x5f, 0x15, 0x49, 0x07, 0xd6, 0x08, 0x3e, 0x70, 0x9d,
+	0x99, 0x2a, 0x38, 0xe7, 0x2b, 0xb0, 0xaa, 0xcf, 0xb6, 0x4f, 0xaa, 0x30, 0x63, 0x83, 0x19, 0xa7,
+	0x09, 0x14, 0xab, 0x07, 0x49, 0xc6, 0x7e, 0xb1, 0x3d, 0x5a, 0x3a, 0xeb, 0xd0, 0x4a, 0x94, 0x3b,
+	0x7a, 0x0b, 0x6c, 0xed, 0x02, 0x26, 0xee, 0x14, 0xb9, 0x5b, 0xc2, 0x8d, 0x4b, 0xe7, 0x2e, 0x38,
+	0x15, 0xa1, 0xa2, 0x7c, 0x9b, 0x19, 0x66, 0x50, 0x28, 0xb8, 0xd0, 0xf7, 0x7b, 0x41, 0x3f, 0x8e,
+	0x06, 0x59, 0x0f, 0x98, 0xcf, 0xc0, 0x38, 0x6f, 0x41, 0x33, 0x17, 0x43, 0x75, 0xca, 0x60, 0xaf,
+	0xc4, 0x88, 0xa7, 0x18, 0xdc, 0xcf, 0x2c, 0x80, 0x9d, 0xd8, 0x1f, 0x28, 0x6f, 0x4c, 0x59, 0xb0,
+	0x36, 0xc3, 0x85, 0xb4, 0x7f, 0x5e, 0xea, 0x69, 0xc9, 0xfe, 0x25, 0xa6, 0x72, 0x7a, 0x7b, 0xe2,
+	0xf4, 0xf8, 0xed, 0x08, 0x55, 0x7d, 0x38, 0x8c, 0xc2, 0xf8, 0x48, 0xd5, 0x08, 0x03, 0xe3, 0xbc,
+	0x09, 0xcb, 0x25, 0xf4, 0x68, 0xff, 0xf1, 0x36, 0x9b, 0xbd, 0xed, 0x4d, 0x60, 0x67, 0xd8, 0xbc,
+	0x6a, 0x95, 0x85, 0x29, 0xab, 0x4c, 0xdb, 0xbd, 0xb4, 0x53, 0xfb, 0x22, 0x3b, 0xfd, 0x1c, 0xb3,
+	0x6c, 0xef, 0xd8, 0x4f, 0x07, 0x98, 0x8a, 0x8f, 0xd2, 0x78, 0x9c, 0x50, 0x96, 0xe5, 0x7e, 0x7a,
+	0x14, 0xe4, 0x3a, 0xcb, 0x04, 0xa2, 0x72, 0xbb, 0xbd, 0xbd, 0x43, 0x66, 0xb1, 0xa9, 0xdc, 0xd2,
+	0x5a, 0xcc, 0x9a, 0x66, 0xf9, 0x4e, 0xdc, 0xf7, 0xf3, 0x61, 0x1c, 0x29, 0xab, 0x54, 0x91, 0x9c,
+	0xb7, 0x67, 0x51, 0x9f, 0x6b, 0x82, 0xcd, 0x79, 0xcb, 0x10, 0x99, 0x73, 0x1c, 0x29, 0x4a, 0x83,
+	0x29, 0x05, 0xec, 0xfe, 0xbb, 0x09, 0xb0, 0x87, 0xcb, 0x89, 0x6c, 0xfa, 0xe0, 0x65, 0x10, 0xe5,
+	0xd5, 0x6c, 0x12, 0x14, 0x09, 0x93, 0xe4, 0x4a, 0xb4, 0xe7, 0x0a, 0xd8, 0xb9, 0x01, 0xed, 0x34,
+	0xe8, 0x23, 0x1b, 0x11, 0xa5, 0x0e, 0x94, 0x08, 0xca, 0x9b, 0x91, 0x9f, 0xe5, 0x41, 0x5a, 0xf1,
+	0x5d, 0x05, 0xe7, 0x6c, 0x42, 0xd7, 0x84, 0x1f, 0xe5, 0xc3, 0x81, 0xf2, 0xdf, 0x14, 0x9e, 0xe4,
+	0xf1, 0x21, 0xb4, 0xbc, 0xa6, 0xc8, 0x33, 0x71, 0x24, 0xcf, 0x84, 0x59, 0x9e, 0xe4, 0xd3, 0x14,
+	0x9e, 0xe4, 0x1d, 0x84, 0x71, 0xff, 0x04, 0x3d, 0xc4, 0x0e, 0x68, 0xb1, 0xa9, 0x2a, 0x38, 0xe7,
+	0x9b, 0xd0, 0x1d, 0x47, 0xe8, 0xd8, 0x38, 0x7c, 0x19, 0x0c, 0xd8, 0x8f, 0x15, 0xdf, 0x57, 0x3c,
+	0xec, 0x4d, 0xb1, 0x1a, 0x1e, 0x02, 0xb9, 0x03, 0x94, 0x87, 0x30, 0xf4, 0x0e, 0x58, 0x91, 0xfd,
+	0xb3, 0x24, 0xc0, 0xa4, 0xe3, 0xa0, 0x2e, 0x31, 0xce, 0xd7, 0xe0, 0x72, 0x26, 0x51, 0xf8, 0x30,
+	0x38, 0x1e, 0x46, 0x83, 0x27, 0x6c, 0x8b, 0xde, 0x22, 0x9b, 0x78, 0x16, 0x89, 0x22, 0x86, 0x15,
+	0x47, 0xad, 0x77, 0x4f, 0x23, 0xe4, 0x5d, 0x92, 0x88, 0xa9, 0x20, 0xc9, 0xdd, 0xf8, 0xe9, 0x61,
+	0x38, 0xec, 0xe7, 0x4f, 0xf0, 0xb2, 0x5c, 0x66, 0x1e, 0x13, 0x45, 0x2e, 0xcd, 0x8b, 0x02, 0xb6,
+	0x22, 0x2e, 0x2d, 0x10, 0x45, 0x30, 0x78, 0x68, 0x86, 0xae, 0x11, 0x0c, 0x9e, 0x19, 0x0c, 0x44,
+	0x5c, 0x35, 0x83, 0xc1, 0x93, 0x60, 0x18, 0xc6, 0x46, 0xb1, 0x72, 0x90, 0xa1, 0xee, 0x55, 0x70,
+	0xe4, 0xbc, 0x01, 0x16, 0xfa, 0xc7, 0xbb, 0x06, 0xdf, 0x65, 0xe6, 0x9b, 0xc2, 0x93, 0x05, 0xfd,
+	0x24, 0x09, 0xcf, 0xb6, 0x83, 0xd0, 0x3f, 0xeb, 0xad, 0x49, 0xf2, 0x96, 0x18, 0x2a, 0x0b, 0x03,
+	0x5a, 0x78, 0x78, 0x91, 0x0d, 0xf9, 0x7a, 0xbc, 0xc2, 0x3c, 0x13, 0x58, 0xb2, 0x74, 0x12, 0x44,
+	0xe4, 0xc3, 0x07, 0x09, 0xd6, 0x9c, 0x97, 0x7e, 0xc8, 0xb1, 0x70, 0x95, 0x63, 0x61, 0x16, 0xc9,
+	0x79, 0x17, 0xae, 0x4d, 0xa0, 0x9f, 0xc6, 0xd9, 0x90, 0xb3, 0xf4, 0x1a, 0xdb, 0x73, 0x1e, 0xd9,
+	0xfd, 0x75, 0x0d, 0x16, 0xcd, 0xce, 0xc3, 0xe8, 0x89, 0x6a, 0x73, 0x7a, 0x22, 0xcb, 0xec, 0x89,
+	0xa8, 0xfe, 0xa8, 0xde, 0x47, 0x7a, 0x19, 0x8e, 0x41, 0x2c, 0x3d, 0xd4, 0x24, 0x78, 0x4c, 0x28,
+	0xda, 0xa1, 0x7b, 0xd0, 0x49, 0xe9, 0xa4, 0x45, 0x13, 0x43, 0xfc, 0x2b, 0xc4, 0xef, 0x95, 0x68,
+	0xcf, 0xe4, 0x71, 0xff, 0x6c, 0x41, 0xc7, 0x20, 0x4e, 0xe5, 0x6f, 0xed, 0x0b, 0xe6, 0xaf, 0x35,
+	0x27, 0x7f, 0x6f, 0x69, 0x95, 0xc6, 0x07, 0xdb, 0xc3, 0x54, 0x95, 0x34, 0x13, 0x55, 0x70, 0x54,
+	0x0a, 0x86, 0x89, 0xa2, 0xbb, 0xdd, 0x00, 0x8d, 0x72, 0x31, 0x89, 0xa6, 0x3b, 0x92, 0x51, 0x5b,
+	0x7e, 0xde, 0x3f, 0x7e, 0x96, 0xa8, 0x0c, 0x6a, 0x72, 0x1a, 0xce, 0xa0, 0x38, 0xaf, 0x43, 0x23,
+	0xcb, 0xfd, 0xa3, 0x80, 0xcb, 0xc5, 0xf2, 0xfd, 0x36, 0xa7, 0x37, 0x21, 0x3c, 0xc1, 0x1b, 0xc6,
+	0x6f, 0x5d, 0x60, 0x7c, 0xf7, 0x0f, 0x36, 0x16, 0x7f, 0xb3, 0x39, 0x9c, 0xd5, 0x53, 0x97, 0x3b,
+	0x5a, 0x73, 0x76, 0xbc, 0x05, 0xf5, 0x71, 0x34, 0x14, 0x67, 0x2f, 0xdf, 0x5f, 0x24, 0xfa, 0x33,
+	0x84, 0xa9, 0x42, 0x78, 0x4c, 0x31, 0x74, 0xaa, 0x5f, 0x14, 0x10, 0x18, 0xe8, 0x65, 0x79, 0xc2,
+	0x40, 0xc6, 0x5b, 0xe4, 0xa4, 0xb8, 0x2c, 0x67, 0x91, 0x50, 0x67, 0xee, 0xa8, 0xb9, 0xcc, 0x7e,
+	0x74, 0x49, 0x7a, 0xea, 0x2f, 0x43, 0xa3, 0x4f, 0x3d, 0x2e, 0x5b, 0x49, 0x05, 0x94, 0xd1, 0xf4,
+	0x22, 0x9b, 0xd0, 0xb1, 0x1e, 0xd5, 0x29, 0x67, 0x95, 0xad, 0x96, 0x89, 0xaf, 0x6c, 0xe2, 0x90,
+	0x8d, 0xa9, 0xc4, 0x15, 0x62, 0x33, 0xc1, 0xad, 0x8b, 0xe2, 0x2a, 0x9b, 0x0b, 0xe2, 0x22, 0x2a,
+	0x71, 0x51, 0xdd, 0xe4, 0x1a, 0xaa, 0xb8, 0xca, 0x2b, 0x8c, 0xb8, 0x88, 0x8a, 0xed, 0x3e, 0x60,
+	0xb2, 0x0d, 0x07, 0x72, 0x61, 0x76, 0x98, 0x77, 0x8d, 0x78, 0x9f, 0x17, 0x58, 0x15, 0xf5, 0x06,
+	0xdf, 0xc3, 0x16, 0xa6, 0xa0, 0x84, 0xff, 0xb7, 0x60, 0xb5, 0xe2, 0xb3, 0x9d, 0x61, 0xc6, 0x06,
+	0x16, 0x32, 0x7a, 0x6e, 0xce, 0x18, 0xa0, 0xbf, 0xc7, 0x8a, 0xc4, 0x96, 0xf8, 0x20, 0x4d, 0xe3,
+	0x54, 0x8f, 0x23, 0xb5, 0x62, 0x1c, 0x71, 0x6f, 0x42, 0x9b, 0x2c, 0x70, 0x0e, 0x99, 0x8e, 0x3e,
+	0x8f, 0x9c, 0x60, 0xe9, 0xa0, 0x33, 0x7f, 0xba, 0x33, 0x87, 0xc3, 0xb9, 0x0f, 0x6b, 0x32, 0x13,
+	0x48, 0x12, 0x14, 0x45, 0x49, 0xd2, 0x71, 0x26, 0x8d, 0xea, 0x79, 0x40, 0xe2, 0x50, 0xac, 0x6e,
+	0xbc, 0x34, 0xec, 0x7e, 0x03, 0xda, 0xb4, 0xa3, 0x6c, 0x77, 0x07, 0x9a, 0x4c, 0xd0, 0x76, 0xe8,
+	0x16, 0x4e, 0x50, 0x0a, 0x79, 0x8a, 0xee, 0xfe, 0x14, 0xc7, 0x20, 0x29, 0x72, 0xf2, 0xe5, 0xab,
+	0xd6, 0xb8, 0x5b, 0x95, 0xcf, 0x75, 0x95, 0x30, 0x25, 0xde, 0x05, 0xe0, 0x32, 0x25, 0x0c, 0xf5,
+	0x32, 0x28, 0x4a, 0xac, 0x67, 0x70, 0x90, 0x63, 0x4a, 0x68, 0x86, 0x69, 0x7f, 0x69, 0xa1, 0x6d,
+	0xc5, 0xa5, 0xc2, 0xf2, 0x7f, 0x4a, 0x56, 0x95, 0x4f, 0x75, 0x33, 0x9f, 0xde, 0xd4, 0xf9, 0xd4,
+	0x28, 0x8f, 0x51, 0x46, 0x51, 0x99, 0x4e, 0xb7, 0x55, 0x3a, 0x35, 0x99, 0x6d, 0x49, 0xa7, 0x93,
+	0xe6, 0x92, 0x6c, 0xba, 0xad, 0xb2, 0x69, 0xa1, 0x64, 0x2a, 0x42, 0xaa, 0x48, 0xa6, 0xdb, 0x2a,
+	0x99, 0x5a, 0x25, 0x53, 0xe1, 0x66, 0x9d, 0x4b, 0x0f, 0x17, 0xa0, 0xc1, 0xee, 0x74, 0xdf, 0x83,
+	0xae, 0x69, 0x1a, 0xce, 0x89, 0x37, 0x15, 0xb1, 0x12, 0x0a, 0x06, 0x93, 0xa7, 0xbe, 0x7d, 0x01,
+	0x4b, 0x95, 0x52, 0x44, 0x77, 0xf6, 0x30, 0xdb, 0xf2, 0xb1, 0x03, 0x0a, 0x8b, 0xa9, 0xd8, 0xc0,
+	0x18, 0x41, 0x66, 0x95, 0x92, 0x95, 0x88, 0x4a, 0x90, 0x19, 0xb3, 0xad, 0x5d, 0x99, 0x6d, 0xff,
+	0x8a, 0x37, 0xac, 0xf9, 0x01, 0x8d, 0xc7, 0xb8, 0xd8, 0x8a, 0x07, 0xe2, 0x4d, 0x1c, 0x8f, 0x15,
+	0x48, 0xa1, 0x4f, 0xcb, 0x10, 0x87, 0x72, 0x15, 0x81, 0x05, 0xac, 0x68, 0x7b, 0xfd, 0x38, 0xd1,
+	0xaf, 0x15, 0x05, 0xac, 0x68, 0x3b, 0xc1, 0xcb, 0x20, 0x54, 0x17, 0x54, 0x01, 0xd3, 0x6e, 0x4f,
+	0x70, 0x6b, 0x0a, 0x13, 0xa9, 0xab, 0x1a, 0xa4, 0xaf, 0x3c, 0xff, 0x74, 0xcb, 0x1f, 0x67, 0x81,
+	0xea, 0x5b, 0x0b, 0x98, 0xcc, 0x42, 0xaf, 0x2a, 0x3e, 0xb6, 0x8c, 0x91, 0xee, 0x56, 0x0d, 0x8c,
+	0x7b, 0x0a, 0xab, 0x4f, 0xc7, 0x38, 0x2a, 0x78, 0xd2, 0xb9, 0xc8, 0x23, 0x0d, 0x0a, 0x1c, 0x46,
+	0x7e, 0x3f, 0x1f, 0xbe, 0x0c, 0x94, 0x25, 0x0b, 0x98, 0xe2, 0x17, 0x47, 0xc0, 0x40, 0xb5, 0xeb,
+	0xbc, 0x26, 0xfe, 0x43, 0x2c, 0x00, 0x1c, 0xd7, 0xea, 0x48, 0x1a, 0xe6, 0x14, 0x95, 0x3b, 0x59,
+	0x3d, 0xc1, 0x08, 0xe4, 0xfe, 0xca, 0x82, 0xf5, 0xdd, 0x24, 0x48, 0x71, 0x98, 0x94, 0x67, 0x9f,
+	0x3d, 0x7e, 0x17, 0xd0, 0x2a, 0xdc, 0x00, 0x2b, 0x4e, 0x78, 0x73, 0x15, 0xef, 0x42, 0xde, 0x4d,
+	0x3c, 0xc4, 0xb3, 0x12, 0x18, 0x11, 0xca, 0xb6, 0xbc, 0x9e, 0xfb, 0x06, 0x84, 0xca, 0x61, 0x39,
+	0xf6, 0x0f, 0x7c, 0xb4, 0x8e, 0xb2, 0xa9, 0x86, 0xcb, 0x47, 0x88, 0x86, 0xf9, 0x08, 0x51, 0x3e,
+	0x59, 0x34, 0x27, 0x9f, 0x2c, 0x0e, 0xc3, 0x71, 0x76, 0xcc, 0x66, 0x6c, 0x79, 0x02, 0x90, 0x2e,
+	0x45, 0xcc, 0xb7, 0xd4, 0x75, 0x81, 0x56, 0x3f, 0x4c, 0xe3, 0x91, 0x14, 0x16, 0xbe, 0x80, 0x30,
+	0x18, 0x4b, 0x8c, 0xa6, 0xef, 0xcb, 0xc8, 0x06, 0x25, 0x5d, 0x30, 0x6e, 0x0e, 0x4b, 0xcf, 0xef,
+	0xa9, 0xb0, 0x7f, 0x82, 0xd1, 0x87, 0x87, 0x28, 0xcd, 0x01, 0x32, 0x18, 0x66, 0x27, 0xca, 0x18,
+	0x17, 0x56, 0x0f, 0x5d, 0x72, 0x6c, 0xa3, 0xe4, 0x68, 0x0b, 0xd6, 0x39, 0xc4, 0x79, 0xed, 0xbe,
+	0x0d, 0x6b, 0xca, 0x23, 0xcf, 0xef, 0xd1, 0xae, 0x73, 0x7d, 0x21, 0x64, 0xd9, 0xde, 0xfd, 0x53,
+	0x0d, 0xae, 0x4c, 0x7c, 0xf6, 0xca, 0xaf, 0x69, 0xef, 0x40, 0x9d, 0x26, 0x6a, 0xd4, 0x90, 0x52,
+	0xf3, 0x36, 0xed, 0x31, 0x53, 0xe4, 0x5d, 0x02, 0x3e, 0x88, 0xf2, 0xf4, 0xcc, 0xe3, 0x0f, 0xd6,
+	0x3f, 0x86, 0x76, 0x81, 0x22, 0xb9, 0x27, 0xc1, 0x99, 0xae, 0xbe, 0xb8, 0xa4, 0x8e, 0x02, 0xaf,
+	0xe3, 0xb1, 0x98, 0x46, 0x5d, 0xb0, 0x15, 0xc3, 0x7a, 0x42, 0x7f, 0xcf, 0x7a, 0xb7, 0xe6, 0xfe,
+	0x10, 0x7a, 0x1f, 0xf9, 0xd1, 0x20, 0x54, 0xf1, 0x28, 0x45, 0x41, 0x99, 0xe0, 0x35, 0xc3, 0x04,
+	0x1d, 0x92, 0xc2, 0xd4, 0x73, 0xa2, 0x11, 0x07, 0x96, 0x03, 0x7d, 0x1d, 0x2a, 0xc3, 0x97, 0x08,
+	0x8e, 0x99, 0x17, 0x61, 0xa6, 0x46, 0x6b, 0x5e, 0xbb, 0x57, 0xe0, 0xf2, 0xa3, 0x20, 0x97, 0xbd,
+	0xb7, 0x0e, 0x8f, 0xd4, 0xce, 0xee, 0x1d, 0x58, 0xab, 0xa2, 0x95, 0x71, 0xf1, 0xb0, 0xfd, 0xc3,
+	0xe2, 0xaa, 0xc1, 0xa5, 0xbb, 0x07, 0x37, 0xa5, 0x5b, 0x1a, 0x1f, 0x90, 0x0a, 0x54, 0xfa, 0x9e,
+	0x25, 0x03, 0x7a, 0x35, 0x53, 0x87, 0xc0, 0x4b, 0x3c, 0x13, 0x1a, 0x0a, 0xda, 0x8f, 0x47, 0xe1,
+	0x5e, 0x9e, 0xd2, 0xf0, 0x22, 0x32, 0x66, 0xd2, 0xdc, 0x1d, 0xd8, 0x98, 0x27, 0x54, 0x29, 0x82,
+	0x75, 0x49, 0x3d, 0x25, 0x2a, 0x37, 0x6b, 0x70, 0xda, 0xcf, 0xee, 0x11, 0xac, 0xe3, 0x61, 0xa6,
+	0x7a, 0xa6, 0xb2, 0xec, 0xd0, 0x1e, 0x9f, 0x94, 0xd7, 0x63, 0x01, 0x3b, 0x5f, 0xa5, 0x77, 0xb2,
+	0x10, 0x7b, 0x69, 0x35, 0x73, 0x4c, 0xc5, 0x7a, 0x85, 0xec, 0xfe, 0xdd, 0x86, 0xee, 0xe4, 0x36,
+	0x85, 0x9f, 0x6a, 0x33, 0xab, 0x86, 0x55, 0xa9, 0x1a, 0xc8, 0x3b, 0xa2, 0xc2, 0xae, 0x72, 0x86,
+	0xd6, 0x65, 0xa2, 0xd5, 0xe7, 0x24, 0x1a, 0x0e, 0x10, 0xaa, 0xfb, 0x8b, 0xf5, 0x5c, 0xa3, 0x06,
+	0x88, 0x09, 0x34, 0x35, 0xcc, 0x13, 0x28, 0x1e, 0x37, 0xa4, 0xde, 0xcc, 0x22, 0x19, 0xdd, 0xf8,
+	0xc2, 0x17, 0xe8, 0xc6, 0x13, 0x21, 0xc8, 0x03, 0xa2, 0x32, 0x59, 0x4b, 0x84, 0xcf, 0x20, 0xd1,
+	0x0b, 0xa3, 0x9a, 0x2b, 0x0d, 0xfe, 0x36, 0xf3, 0x4f, 0x13, 0xe8, 0x98, 0x7c, 0x55, 0x1a, 0xbc,
+	0x20, 0xc7, 0x9c, 0x40, 0xd3, 0x04, 0xd7, 0x1f, 0xe7, 0xf1, 0x4b, 0x3d, 0xaa, 0x51, 0x32, 0xc8,
+	0x83, 0xc4, 0x14, 0x9e, 0x74, 0xa8, 0xe0, 0xd8, 0x20, 0x8b, 0xa2, 0xc3, 0x14, 0xc1, 0xfd, 0x2d,
+	0x56, 0x9d, 0xd2, 0xc1, 0xfc, 0x4c, 0x76, 0xc1, 0xdc, 0x8b, 0xd1, 0x95, 0xa5, 0xfd, 0x7d, 0xe3,
+	0xcd, 0xb9, 0x80, 0xf9, 0x8e, 0xc8, 0x72, 0xa1, 0xa9, 0x0b, 0x4c, 0xc3, 0x17, 0x7b, 0x1d, 0x13,
+	0x60, 0x54, 0xbd, 0x98, 0x15, 0xe8, 0xfe, 0xb1, 0x06, 0xaf, 0xcd, 0x8c, 0xf7, 0xff, 0xe1, 0xe7,
+	0x06, 0x28, 0x82, 0x22, 0x53, 0x65, 0xf2, 0xfc, 0xf9, 0x83, 0x3a, 0x99, 0x6f, 0xc3, 0x52, 0x5e,
+	0x5a, 0x26, 0xd0, 0x3f, 0x37, 0x5c, 0xaf, 0x7e, 0x68, 0x18, 0xcf, 0xab, 0xf2, 0xbb, 0x27, 0x70,
+	0xbd, 0xa2, 0x7f, 0xa5, 0x26, 0xde, 0xe7, 0xfe, 0x9e, 0x78, 0x03, 0x55, 0x19, 0xaf, 0x1a, 0x82,
+	0xa5, 0x9f, 0x66, 0xaa, 0x57, 0xf0, 0x55, 0x52, 0xdc, 0xaa, 0xa6, 0xb8, 0xfb, 0x1b, 0x0b, 0x56,
+	0x26, 0xb6, 0x72, 0x96, 0xc1, 0x1a, 0x0e, 0x94, 0x23, 0x71, 0x35, 0x37, 0x5d, 0x4d, 0xe7, 0xda,
+	0x13, 0xce, 0xa5, 0x02, 0x95, 0xf6, 0xb7, 0xf1, 0xce, 0x57, 0xf7, 0xbf, 0x06, 0x2b, 0x6e, 0x6f,
+	0x4c, 0xb8, 0x1d, 0xbf, 0xc2, 0x35, 0x7f, 0x25, 0x59, 0xa9, 0x41, 0x2a, 0xed, 0x1c, 0xe7, 0xfc,
+	0xbc, 0x26, 0x1d, 0x55, 0x89, 0xc0, 0x01, 0x42, 0x0f, 0x75, 0xad, 0x73, 0x6d, 0xa2, 0xb8, 0x8a,
+	0x7e, 0xaa, 0xad, 0x8a, 0x12, 0xf5, 0x53, 0x46, 0x44, 0x41, 0x35, 0xa2, 0x5e, 0x4c, 0x14, 0x50,
+	0xe5, 0x90, 0x57, 0x8e, 0xa7, 0xb7, 0x74, 0x9b, 0x2d, 0xa1, 0x74, 0xb9, 0x1a, 0x11, 0x95, 0x4e,
+	0xfb, 0x17, 0x35, 0xb8, 0xa9, 0x2f, 0xe3, 0xd9, 0x81, 0x70, 0xdb, 0xb8, 0x1c, 0xa7, 0x25, 0xa9,
+	0x4b, 0x92, 0xfb, 0xf3, 0x07, 0x61, 0x28, 0x83, 0x95, 0xa5, 0xfb, 0x73, 0x8d, 0xa9, 0x44, 0x86,
+	0x3d, 0x51, 0xfc, 0xd7, 0x58, 0xdb, 0xc7, 0xf2, 0xf3, 0x54, 0xdd, 0x13, 0xc0, 0xfd, 0x18, 0x36,
+	0xe6, 0xe9, 0xf5, 0xaa, 0xf6, 0x70, 0xcf, 0xe0, 0xa6, 0x5c, 0x6b, 0xa5, 0x28, 0xfd, 0x63, 0xe4,
+	0xc5, 0x77, 0x53, 0xe5, 0xae, 0xb7, 0x26, 0xef, 0xfa, 0xe2, 0x39, 0x96, 0x7f, 0x3f, 0xb0, 0xcd,
+	0xe7, 0x58, 0xc2, 0x6c, 0x9e, 0x40, 0x53, 0x9a, 0x39, 0x67, 0x09, 0xda, 0x8f, 0x23, 0x4e, 0xdf,
+	0xdd, 0xa4, 0x7b, 0xc9, 0x69, 0x41, 0x7d, 0x2f, 0x8f, 0x93, 0x6e, 0xcd, 0x69, 0x43, 0xe3, 0x29,
+	0x75, 0xf3, 0x5d, 0xcb, 0x01, 0x68, 0x52, 0xb5, 0x1f, 0x05, 0x5d, 0x9b, 0xd0, 0x18, 0x4b, 0x69,
+	0xde, 0xad, 0x13, 0x5a, 0xf4, 0xef, 0x36, 0x30, 0x67, 0xe0, 0x01, 0xd6, 0x4b, 0xc5, 0xd6, 0x24,
+	0xda, 0x76, 0x40, 0xbf, 0x4c, 0x75, 0x17, 0x36, 0x7f, 0xc4, 0x9f, 0x1c, 0x51, 0xfb, 0xb0, 0xa8,
+	0xf6, 0x62, 0x18, 0xb7, 0x5b, 0x00, 0xfb, 0x93, 0xe0, 0x14, 0x77, 0xeb, 0xc0, 0x82, 0x37, 0x8e,
+	0xe8, 0x01, 0x53, 0xf6, 0xe3, 0xad, 0x07, 0xb8, 0x1f, 0x12, 0x48, 0xa1, 0x04, 0x81, 0xba, 0xb3,
+	0x08, 0xad, 0x0f, 0xd5, 0xef, 0x30, 0xb8, 0x27, 0x92, 0x88, 0x8d, 0xbe, 0x69, 0x12, 0x89, 0x37,
+	0x27, 0x68, 0x81, 0x20, 0xfe, 0x8a, 0xa0, 0xd6, 0xe6, 0x2e, 0xb4, 0xf4, 0xe4, 0xea, 0xac, 0x40,
+	0x47, 0xe9, 0x40, 0x28, 0x54, 0x01, 0x0f, 0xc4, 0xcd, 0x06, 0x2a, 0x81, 0x87, 0xa7, 0x19, 0x14,
+	0x35, 0xc0, 0x15, 0x0d, 0x9a, 0xb8, 0x3f, 0x19, 0x04, 0xbb, 0x6b, 0xdc, 0x1c, 0x19, 0x79, 0x60,
+	0xe9, 0x0e, 0x36, 0x9f, 0xa0, 0xb6, 0xb4, 0xdc, 0xa5, 0x3e, 0x6c, 0x59, 0xc9, 0x53, 0x18, 0x14,
+	0x89, 0x36, 0xa5, 0xdd, 0x85, 0xbb, 0x46, 0xb6, 0xe1, 0xe3, 0x08, 0x6c, 0x91, 0x0a, 0x62, 0x27,
+	0x41, 0xd8, 0x9b, 0x3f, 0xae, 0xa1, 0xba, 0x6a, 0xd4, 0x70, 0x2e, 0xc3, 0x8a, 0x36, 0x92, 0x42,
+	0x89, 0x44, 0x4c, 0x41, 0x41, 0xa0, 0x44, 0xda, 0xa0, 0x00, 0x2d, 0xb2, 0xab, 0x17, 0x8c, 0xf0,
+	0xb2, 0x52, 0x18, 0x9b, 0xb6, 0xa4, 0xc9, 0x56, 0xc1, 0x75, 0xfa, 0x80, 0x60, 0xae, 0x32, 0x68,
+	0xb9, 0xab, 0xe0, 0x10, 0xf8, 0x64, 0x78, 0x44, 0x91, 0x2c, 0xfd, 0x7f, 0xd6, 0x6d, 0x6e, 0xbe,
+	0x0f, 0x2d, 0xdd, 0x66, 0x1b, 0x7a, 0x68, 0x54, 0xa1, 0x87, 0x20, 0x50, 0x8f, 0x62, 0x63, 0x85,
+	0xb1, 0x36, 0xfb, 0x3c, 0x9e, 0x52, 0x97, 0x6a, 0x58, 0x46, 0x61, 0x54, 0x78, 0x9d, 0x0c, 0x13,
+	0xe5, 0xf0, 0x20, 0x09, 0xfd, 0x7e, 0x11, 0x60, 0x78, 0xd7, 0xe6, 0xa8, 0x3a, 0xae, 0x1f, 0x47,
+	0x3f, 0x08, 0xfa, 0x14, 0x61, 0xe4, 0x06, 0xd4, 0x53, 0x7c, 0x2d, 0xaf, 0xcf, 0x18, 0x5c, 0x9b,
+	0x3b, 0xd0, 0x79, 0xae, 0x2f, 0x9c, 0x5d, 0xfa, 0x1d, 0xca, 0xd1, 0x9a, 0x96, 0x58, 0xdc, 0x0c,
+	0x15, 0xe0, 0x50, 0x2d, 0xb0, 0xb8, 0xed, 0x2a, 0x2c, 0x91, 0x6b, 0x4a, 0x94, 0xb5, 0xf9, 0x29,
+	0x38, 0xd3, 0xa5, 0x92, 0x2c, 0x58, 0x6a, 0x8f, 0xc2, 0x50, 0x2d, 0x8c, 0x54, 0x5a, 0xb3, 0x43,
+	0x1f, 0x1f, 0x45, 0x71, 0x1a, 0x30, 0x4d, 0x3b, 0x94, 0x1f, 0x1b, 0x09, 0x61, 0x6f, 0x1e, 0x4d,
+	0x5c, 0x2a, 0xa8, 0x64, 0x19, 0xfb, 0x0c, 0xa3, 0x44, 0x8a, 0x44, 0x96, 0x22, 0x08, 0x65, 0x4d,
+	0x16, 0x23, 0x18, 0x8b, 0x36, 0xda, 0x0a, 0x03, 0x3f, 0x15, 0xd8, 0x96, 0x8d, 0x12, 0x7f, 0xa8,
+	0x10, 0xf5, 0xfb, 0xff, 0x69, 0x42, 0x53, 0x6a, 0x86, 0xf3, 0x3e, 0x74, 0x8c, 0xff, 0x2e, 0x70,
+	0xf8, 0x0a, 0x98, 0xfe, 0x5f, 0x88, 0xf5, 0x6b, 0x53, 0x78, 0xa9, 0x5b, 0xee, 0x25, 0xbc, 0xb9,
+	0xa1, 0x1c, 0xcb, 0x9d, 0x2b, 0xdc, 0xeb, 0x4d, 0x8e, 0xe9, 0xeb, 0x3d, 0x7e, 0xd0, 0x99, 0xf1,
+	0x9f, 0x13, 0x28, 0xe0, 0x3b, 0xb0, 0xa4, 0x8a, 0xa3, 0x04, 0x9e, 0xb3, 0x61, 0x0c, 0x55, 0x33,
+	0x06, 0xee, 0x73, 0x85, 0x7d, 0x58, 0x08, 0x93, 0xe0, 0x72, 0x7a, 0x33, 0x26, 0x34, 0x11, 0x73,
+	0x7d, 0xee, 0xec, 0x86, 0x72, 0x1e, 0x41, 0x47, 0x26, 0x2c, 0x29, 0xf9, 0x37, 0x88, 0x77, 0xde,
+	0xc8, 0x75, 0xae, 0x42, 0x5b, 0xb0, 0x68, 0x0e, 0x45, 0x0e, 0x5b, 0x72, 0xc6, 0xf4, 0x24, 0x42,
+	0x66, 0xcd, 0x4f, 0x28, 0xc4, 0x87, 0xab, 0xb3, 0x47, 0x1b, 0xe7, 0x8d, 0xf2, 0xe5, 0x79, 0xce,
+	0x2c, 0xb5, 0xee, 0x9e, 0xc7, 0x52, 0x6c, 0xf1, 0x3d, 0xe8, 0x15, 0x9b, 0x17, 0x71, 0xae, 0xa2,
+	0x62, 0x43, 0xa9, 0x36, 0x67, 0x1a, 0x5a, 0x7f, 0x7d, 0x2e, 0xbd, 0x10, 0xbf, 0x0f, 0xab, 0x25,
+	0x43, 0x2c, 0xe6, 0x73, 0x6e, 0x4e, 0x7d, 0x57, 0x31, 0xeb, 0xc6, 0x3c, 0x72, 0x21, 0xf5, 0xfb,
+	0xe5, 0x3c, 0x5f, 0x95, 0xfc, 0x86, 0xe9, 0xdb, 0xd9, 0xd2, 0xdd, 0xf3, 0x58, 0x8a, 0x1d, 0x9e,
+	0xc2, 0x4a, 0xe5, 0xb6, 0xd5, 0xb2, 0xcf, 0xbd, 0x82, 0xcf, 0x0b, 0x88, 0x87, 0xbd, 0xbf, 0xfc,
+	0x73, 0xa3, 0xf6, 0x39, 0xfe, 0xfd, 0x03, 0xff, 0x7e, 0xf6, 0xaf, 0x8d, 0x4b, 0x9f, 0xe3, 0xdf,
+	0xdf, 0xf0, 0xef, 0xa0, 0xc9, 0xff, 0x91, 0xf4, 0xf5, 0xff, 0x02, 0xf2, 0xe4, 0x6f, 0xea, 0xa3,
+	0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4504,6 +4692,58 @@ func (m *CheckStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TableProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinishedBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.FinishedBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EstimateBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.EstimateBytes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EstimateRows != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.EstimateRows))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DumpStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4524,6 +4764,35 @@ func (m *DumpStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.EtaSeconds != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.EtaSeconds))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EstimateTotalBytes != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.EstimateTotalBytes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Rps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Progress) > 0 {
 		i -= len(m.Progress)
 		copy(dAtA[i:], m.Progress)
@@ -4588,16 +4857,40 @@ func (m *LoadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Bps != 0 {
-		i = encodeVarintDmworker(dAtA, i, uint64(m.Bps))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.MetaBinlogGTID) > 0 {
-		i -= len(m.MetaBinlogGTID)
-		copy(dAtA[i:], m.MetaBinlogGTID)
-		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlogGTID)))
-		i--
+	if len(m.Tables) > 0 {
+		for iNdEx := len(m.Tables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDmworker(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Rps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EtaSeconds != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.EtaSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Bps != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MetaBinlogGTID) > 0 {
+		i -= len(m.MetaBinlogGTID)
+		copy(dAtA[i:], m.MetaBinlogGTID)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.MetaBinlogGTID)))
+		i--
 		dAtA[i] = 0x2a
 	}
 	if len(m.MetaBinlog) > 0 {
@@ -4711,6 +5004,40 @@ func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingApprovalPosition) > 0 {
+		i -= len(m.PendingApprovalPosition)
+		copy(dAtA[i:], m.PendingApprovalPosition)
+		i = encodeVarintDmworker(dAtA, i, uint64(len(m.PendingApprovalPosition)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.PendingApprovalDDLs) > 0 {
+		for iNdEx := len(m.PendingApprovalDDLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingApprovalDDLs[iNdEx])
+			copy(dAtA[i:], m.PendingApprovalDDLs[iNdEx])
+			i = encodeVarintDmworker(dAtA, i, uint64(len(m.PendingApprovalDDLs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.DelayRemaining != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.DelayRemaining))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.ApplyDelay != 0 {
+		i = encodeVarintDmworker(dAtA, i, uint64(m.ApplyDelay))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.DumpIOTotalBytes != 0 {
 		i = encodeVarintDmworker(dAtA, i, uint64(m.DumpIOTotalBytes))
 		i--
@@ -6930,6 +7257,32 @@ func (m *CheckStatus) Size() (n int) {
 	return n
 }
 
+func (m *TableProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovDmworker(uint64(l))
+	}
+	if m.EstimateRows != 0 {
+		n += 1 + sovDmworker(uint64(m.EstimateRows))
+	}
+	if m.EstimateBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.EstimateBytes))
+	}
+	if m.FinishedBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.FinishedBytes))
+	}
+	return n
+}
+
 func (m *DumpStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6958,6 +7311,21 @@ func (m *DumpStatus) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDmworker(uint64(l))
 	}
+	if m.Rps != 0 {
+		n += 1 + sovDmworker(uint64(m.Rps))
+	}
+	if m.EstimateTotalBytes != 0 {
+		n += 1 + sovDmworker(uint64(m.EstimateTotalBytes))
+	}
+	if m.EtaSeconds != 0 {
+		n += 1 + sovDmworker(uint64(m.EtaSeconds))
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6988,6 +7356,18 @@ func (m *LoadStatus) Size() (n int) {
 	if m.Bps != 0 {
 		n += 1 + sovDmworker(uint64(m.Bps))
 	}
+	if m.EtaSeconds != 0 {
+		n += 1 + sovDmworker(uint64(m.EtaSeconds))
+	}
+	if m.Rps != 0 {
+		n += 1 + sovDmworker(uint64(m.Rps))
+	}
+	if len(m.Tables) > 0 {
+		for _, e := range m.Tables {
+			l = e.Size()
+			n += 1 + l + sovDmworker(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7102,6 +7482,22 @@ func (m *SyncStatus) Size() (n int) {
 	if m.DumpIOTotalBytes != 0 {
 		n += 2 + sovDmworker(uint64(m.DumpIOTotalBytes))
 	}
+	if m.ApplyDelay != 0 {
+		n += 2 + sovDmworker(uint64(m.ApplyDelay))
+	}
+	if m.DelayRemaining != 0 {
+		n += 2 + sovDmworker(uint64(m.DelayRemaining))
+	}
+	if len(m.PendingApprovalDDLs) > 0 {
+		for _, s := range m.PendingApprovalDDLs {
+			l = len(s)
+			n += 2 + l + sovDmworker(uint64(l))
+		}
+	}
+	l = len(m.PendingApprovalPosition)
+	if l > 0 {
+		n += 2 + l + sovDmworker(uint64(l))
+	}
 	return n
 }
 
@@ -8629,6 +9025,177 @@ func (m *CheckStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TableProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDmworker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimateRows", wireType)
+			}
+			m.EstimateRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimateRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimateBytes", wireType)
+			}
+			m.EstimateBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimateBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedBytes", wireType)
+			}
+			m.FinishedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDmworker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DumpStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8772,6 +9339,97 @@ func (m *DumpStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Progress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
+			}
+			m.Rps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimateTotalBytes", wireType)
+			}
+			m.EstimateTotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimateTotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtaSeconds", wireType)
+			}
+			m.EtaSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EtaSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, &TableProgress{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -8975,6 +9633,78 @@ func (m *LoadStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtaSeconds", wireType)
+			}
+			m.EtaSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EtaSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
+			}
+			m.Rps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tables = append(m.Tables, &TableProgress{})
+			if err := m.Tables[len(m.Tables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -9716,6 +10446,108 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyDelay", wireType)
+			}
+			m.ApplyDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayRemaining", wireType)
+			}
+			m.DelayRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApprovalDDLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingApprovalDDLs = append(m.PendingApprovalDDLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApprovalPosition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDmworker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDmworker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingApprovalPosition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDmworker(dAtA[iNdEx:])
@@ -0,0 +1,263 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"encoding/binary"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/errors"
+)
+
+// partialJSONUpdates is the bit of binlog_row_value_options which means JSON
+// columns may be logged as JSON diffs in the after image.
+const partialJSONUpdates = 1
+
+// DecodeJSONDiffs decodes all the JSON diffs of the partial JSON columns in the
+// after images of a PARTIAL_UPDATE_ROWS_EVENT. go-mysql only decodes the first
+// diff of the Json_diff_vector of a column into *replication.JsonDiff, this
+// function replaces it with []*replication.JsonDiff which contains all the
+// diffs in order.
+// pos is the position of the rows returned by RowsEvent.DecodeHeader, and the
+// rows should have been decoded by RowsEvent.DecodeData.
+func DecodeJSONDiffs(re *replication.RowsEvent, pos int, data []byte) (err error) {
+	if !hasJSONDiff(re) {
+		return nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("decode JSON diffs panic %v, data %q", r, data)
+		}
+	}()
+
+	// the rows of an update event are before image, after image, before image, ...
+	for i := 0; i+1 < len(re.Rows); i += 2 {
+		n, err2 := decodeRowImage(re, data[pos:], re.ColumnBitmap1, false, nil)
+		if err2 != nil {
+			return err2
+		}
+		pos += n
+		n, err2 = decodeRowImage(re, data[pos:], re.ColumnBitmap2, true, re.Rows[i+1])
+		if err2 != nil {
+			return err2
+		}
+		pos += n
+	}
+	return nil
+}
+
+func hasJSONDiff(re *replication.RowsEvent) bool {
+	for i := 1; i < len(re.Rows); i += 2 {
+		for _, v := range re.Rows[i] {
+			if _, ok := v.(*replication.JsonDiff); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// decodeRowImage walks through a row image and returns its length. When row is
+// not nil, the values of the partial JSON columns in row are replaced by the
+// decoded JSON diffs. see Rows_log_event::print_verbose_one_row() in MySQL.
+func decodeRowImage(re *replication.RowsEvent, data []byte, bitmap []byte, isAfterImage bool, row []interface{}) (int, error) {
+	pos := 0
+	var partialBitmap []byte
+	if isAfterImage {
+		valueOptions, _, n := mysql.LengthEncodedInt(data)
+		pos += n
+		if valueOptions&partialJSONUpdates != 0 {
+			byteCount := bitmapByteSize(int(re.Table.JsonColumnCount()))
+			partialBitmap = data[pos : pos+byteCount]
+			pos += byteCount
+		}
+	}
+
+	columnCount := int(re.ColumnCount)
+	loggedCount := 0
+	for i := 0; i < columnCount; i++ {
+		if isBitSet(bitmap, i) {
+			loggedCount++
+		}
+	}
+	nullBitmap := data[pos : pos+bitmapByteSize(loggedCount)]
+	pos += len(nullBitmap)
+
+	jsonIndex, nullIndex := 0, 0
+	for i := 0; i < columnCount; i++ {
+		tp, meta := re.Table.ColumnType[i], re.Table.ColumnMeta[i]
+		// the partial bitmap has a bit for every JSON column, no matter it's logged or not.
+		isPartial := false
+		if partialBitmap != nil && tp == mysql.MYSQL_TYPE_JSON {
+			isPartial = isBitSet(partialBitmap, jsonIndex)
+			jsonIndex++
+		}
+		if !isBitSet(bitmap, i) {
+			continue
+		}
+		isNull := isBitSet(nullBitmap, nullIndex)
+		nullIndex++
+		if isNull {
+			continue
+		}
+
+		n, err := columnValueSize(data[pos:], tp, meta)
+		if err != nil {
+			return 0, err
+		}
+		if isPartial && row != nil && n > int(meta) {
+			diffs, err := decodeJSONDiffVector(data[pos+int(meta) : pos+n])
+			if err != nil {
+				return 0, err
+			}
+			row[i] = diffs
+		}
+		pos += n
+	}
+	return pos, nil
+}
+
+// decodeJSONDiffVector decodes a Json_diff_vector, see
+// Json_diff_vector::read_binary() in MySQL.
+func decodeJSONDiffVector(data []byte) ([]*replication.JsonDiff, error) {
+	var diffs []*replication.JsonDiff
+	for len(data) > 0 {
+		op := replication.JsonDiffOperation(data[0])
+		switch op {
+		case replication.JsonDiffOperationReplace, replication.JsonDiffOperationInsert, replication.JsonDiffOperationRemove:
+		default:
+			return nil, errors.Trace(replication.ErrCorruptedJSONDiff)
+		}
+		data = data[1:]
+
+		pathLength, _, n := mysql.LengthEncodedInt(data)
+		data = data[n:]
+		diff := &replication.JsonDiff{Op: op, Path: string(data[:pathLength])}
+		data = data[pathLength:]
+
+		if op != replication.JsonDiffOperationRemove {
+			valueLength, _, n := mysql.LengthEncodedInt(data)
+			data = data[n:]
+			value, err := decodeJSONBinary(data[:valueLength])
+			if err != nil {
+				return nil, errors.Annotatef(err, "cannot read json diff for field %q", diff.Path)
+			}
+			diff.Value = value
+			data = data[valueLength:]
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+// decodeJSONBinary decodes a value in MySQL JSON binary format to JSON text, by
+// decoding a row image which has only one JSON column.
+func decodeJSONBinary(data []byte) (string, error) {
+	// null bitmap + the length of the value in 4 bytes + the value
+	image := make([]byte, 5+len(data))
+	binary.LittleEndian.PutUint32(image[1:], uint32(len(data)))
+	copy(image[5:], data)
+
+	re := &replication.RowsEvent{
+		ColumnCount:   1,
+		ColumnBitmap1: []byte{1},
+		Table: &replication.TableMapEvent{
+			ColumnCount: 1,
+			ColumnType:  []byte{mysql.MYSQL_TYPE_JSON},
+			ColumnMeta:  []uint16{4},
+		},
+	}
+	if err := re.DecodeData(0, image); err != nil {
+		return "", err
+	}
+	value, _ := re.Rows[0][0].(string)
+	return value, nil
+}
+
+// compressedBytes is the bytes count of the leftover digits of a decimal.
+var compressedBytes = []int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// columnValueSize returns the length of a column value in a row image, see
+// log_event_print_value() in MySQL.
+func columnValueSize(data []byte, tp byte, meta uint16) (int, error) {
+	length := int(meta)
+	if tp == mysql.MYSQL_TYPE_STRING && meta >= 256 {
+		b0, b1 := uint8(meta>>8), uint8(meta&0xFF)
+		if b0&0x30 != 0x30 {
+			length = int(uint16(b1) | (uint16((b0&0x30)^0x30) << 4))
+			tp = b0 | 0x30
+		} else {
+			length = int(meta & 0xFF)
+			tp = b0
+		}
+	}
+
+	switch tp {
+	case mysql.MYSQL_TYPE_NULL:
+		return 0, nil
+	case mysql.MYSQL_TYPE_TINY, mysql.MYSQL_TYPE_YEAR:
+		return 1, nil
+	case mysql.MYSQL_TYPE_SHORT:
+		return 2, nil
+	case mysql.MYSQL_TYPE_INT24, mysql.MYSQL_TYPE_TIME, mysql.MYSQL_TYPE_DATE:
+		return 3, nil
+	case mysql.MYSQL_TYPE_LONG, mysql.MYSQL_TYPE_FLOAT, mysql.MYSQL_TYPE_TIMESTAMP:
+		return 4, nil
+	case mysql.MYSQL_TYPE_LONGLONG, mysql.MYSQL_TYPE_DOUBLE, mysql.MYSQL_TYPE_DATETIME:
+		return 8, nil
+	case mysql.MYSQL_TYPE_NEWDECIMAL:
+		precision, decimals := int(meta>>8), int(meta&0xFF)
+		integral := precision - decimals
+		return integral/9*4 + compressedBytes[integral%9] + decimals/9*4 + compressedBytes[decimals%9], nil
+	case mysql.MYSQL_TYPE_BIT:
+		nbits := (meta>>8)*8 + meta&0xFF
+		return int(nbits+7) / 8, nil
+	case mysql.MYSQL_TYPE_TIMESTAMP2:
+		return int(4 + (meta+1)/2), nil
+	case mysql.MYSQL_TYPE_DATETIME2:
+		return int(5 + (meta+1)/2), nil
+	case mysql.MYSQL_TYPE_TIME2:
+		return int(3 + (meta+1)/2), nil
+	case mysql.MYSQL_TYPE_ENUM:
+		switch meta & 0xFF {
+		case 1, 2:
+			return int(meta & 0xFF), nil
+		default:
+			return 0, errors.Errorf("unknown ENUM packlen=%d", meta&0xFF)
+		}
+	case mysql.MYSQL_TYPE_SET:
+		return int(meta & 0xFF), nil
+	case mysql.MYSQL_TYPE_BLOB, mysql.MYSQL_TYPE_GEOMETRY, mysql.MYSQL_TYPE_JSON, mysql.MYSQL_TYPE_VECTOR:
+		if meta < 1 || meta > 4 {
+			return 0, errors.Errorf("invalid blob packlen = %d", meta)
+		}
+		return int(meta) + int(mysql.FixedLengthInt(data[:meta])), nil
+	case mysql.MYSQL_TYPE_VARCHAR, mysql.MYSQL_TYPE_VAR_STRING, mysql.MYSQL_TYPE_STRING:
+		if length < 256 {
+			return int(data[0]) + 1, nil
+		}
+		return int(binary.LittleEndian.Uint16(data)) + 2, nil
+	default:
+		return 0, errors.Errorf("unsupport type %d in binlog and don't know how to handle", tp)
+	}
+}
+
+func bitmapByteSize(columnCount int) int {
+	return (columnCount + 7) / 8
+}
+
+func isBitSet(bitmap []byte, i int) bool {
+	return bitmap[i>>3]&(1<<(uint(i)&7)) > 0
+}
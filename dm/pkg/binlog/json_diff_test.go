@@ -0,0 +1,136 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlog
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJSONDiffs(t *testing.T) {
+	t.Parallel()
+
+	// CREATE TABLE t (id INT PRIMARY KEY, d DECIMAL(10,2), j JSON, c VARCHAR(20), dt DATETIME(3))
+	re := &replication.RowsEvent{
+		ColumnCount:   5,
+		ColumnBitmap1: []byte{0x1f},
+		ColumnBitmap2: []byte{0x1f},
+		Table: &replication.TableMapEvent{
+			ColumnCount: 5,
+			ColumnType: []byte{
+				mysql.MYSQL_TYPE_LONG, mysql.MYSQL_TYPE_NEWDECIMAL, mysql.MYSQL_TYPE_JSON,
+				mysql.MYSQL_TYPE_VARCHAR, mysql.MYSQL_TYPE_DATETIME2,
+			},
+			ColumnMeta: []uint16{0, 10<<8 | 2, 4, 20, 3},
+		},
+	}
+
+	jsonValue := func(v []byte) []byte {
+		b := make([]byte, 4, 4+len(v))
+		binary.LittleEndian.PutUint32(b, uint32(len(v)))
+		return append(b, v...)
+	}
+	otherColumns := func(j []byte) []byte {
+		b := []byte{1, 0, 0, 0}              // id
+		b = append(b, make([]byte, 5)...)    // d
+		b = append(b, j...)                  // j
+		b = append(b, 1, 'a')                // c
+		return append(b, make([]byte, 7)...) // dt
+	}
+	jsonNull := []byte{0x04, 0x00}
+	diffVector := []byte{
+		0x00, 3, '$', '.', 'a', 3, 0x05, 0x01, 0x00, // REPLACE $.a 1
+		0x01, 3, '$', '.', 'b', 3, 0x0c, 0x01, 'x', // INSERT $.b "x"
+		0x02, 3, '$', '.', 'c', // REMOVE $.c
+	}
+
+	var data []byte
+	// the first row, j is a partial JSON column
+	data = append(data, 0x00)
+	data = append(data, otherColumns(jsonValue(jsonNull))...)
+	data = append(data, 0x01, 0x01, 0x00)
+	data = append(data, otherColumns(jsonValue(diffVector))...)
+	// the second row, j is logged as a full JSON value
+	data = append(data, 0x00)
+	data = append(data, otherColumns(jsonValue(jsonNull))...)
+	data = append(data, 0x01, 0x00, 0x00)
+	data = append(data, otherColumns(jsonValue(jsonNull))...)
+	// the third row, j is NULL
+	data = append(data, 0x00)
+	data = append(data, otherColumns(jsonValue(jsonNull))...)
+	data = append(data, 0x01, 0x01, 0x04)
+	data = append(data, otherColumns(nil)...)
+
+	// only the first diff is decoded by go-mysql.
+	firstDiff := &replication.JsonDiff{Op: replication.JsonDiffOperationReplace, Path: "$.a", Value: "1"}
+	re.Rows = [][]interface{}{
+		{int32(1), "0.00", "null", "a", "0000-00-00 00:00:00.000"},
+		{int32(1), "0.00", firstDiff, "a", "0000-00-00 00:00:00.000"},
+		{int32(1), "0.00", "null", "a", "0000-00-00 00:00:00.000"},
+		{int32(1), "0.00", "null", "a", "0000-00-00 00:00:00.000"},
+		{int32(1), "0.00", "null", "a", "0000-00-00 00:00:00.000"},
+		{int32(1), "0.00", nil, "a", "0000-00-00 00:00:00.000"},
+	}
+	require.NoError(t, DecodeJSONDiffs(re, 0, data))
+	require.Equal(t, []*replication.JsonDiff{
+		firstDiff,
+		{Op: replication.JsonDiffOperationInsert, Path: "$.b", Value: `"x"`},
+		{Op: replication.JsonDiffOperationRemove, Path: "$.c"},
+	}, re.Rows[1][2])
+	require.Equal(t, "null", re.Rows[3][2])
+	require.Nil(t, re.Rows[5][2])
+
+	// nothing to do without JSON diffs
+	re.Rows[1][2] = "null"
+	require.NoError(t, DecodeJSONDiffs(re, 0, nil))
+
+	// corrupted JSON diff
+	re.Rows[1][2] = firstDiff
+	data[len(otherColumns(jsonValue(jsonNull)))+4+4+5+4] = 0x03
+	require.ErrorIs(t, DecodeJSONDiffs(re, 0, data), replication.ErrCorruptedJSONDiff)
+}
+
+func TestColumnValueSize(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		tp   byte
+		meta uint16
+		data []byte
+		size int
+	}{
+		{mysql.MYSQL_TYPE_TINY, 0, nil, 1},
+		{mysql.MYSQL_TYPE_LONGLONG, 0, nil, 8},
+		{mysql.MYSQL_TYPE_NEWDECIMAL, 20<<8 | 5, nil, 10},
+		{mysql.MYSQL_TYPE_BIT, 1<<8 | 1, nil, 2},
+		{mysql.MYSQL_TYPE_TIMESTAMP2, 6, nil, 7},
+		{mysql.MYSQL_TYPE_TIME2, 0, nil, 3},
+		{mysql.MYSQL_TYPE_ENUM, uint16(mysql.MYSQL_TYPE_ENUM)<<8 | 2, nil, 2},
+		{mysql.MYSQL_TYPE_BLOB, 2, []byte{3, 0}, 5},
+		{mysql.MYSQL_TYPE_VARCHAR, 300, []byte{3, 0}, 5},
+		{mysql.MYSQL_TYPE_STRING, uint16(mysql.MYSQL_TYPE_STRING)<<8 | 10, []byte{3}, 4},
+	}
+	for _, c := range cases {
+		size, err := columnValueSize(c.data, c.tp, c.meta)
+		require.NoError(t, err)
+		require.Equal(t, c.size, size, "type %d", c.tp)
+	}
+
+	_, err := columnValueSize(nil, mysql.MYSQL_TYPE_BLOB, 5)
+	require.Error(t, err)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/filter"
 	"github.com/pingcap/tiflow/dm/config"
 	"github.com/pingcap/tiflow/dm/config/dbconfig"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
@@ -127,13 +128,14 @@ var (
 
 // MySQLBinlogRowImageChecker checks mysql binlog_row_image.
 type MySQLBinlogRowImageChecker struct {
-	db     *sql.DB
-	dbinfo *dbutil.DBConfig
+	db          *sql.DB
+	dbinfo      *dbutil.DBConfig
+	checkTables []filter.Table
 }
 
 // NewMySQLBinlogRowImageChecker returns a RealChecker.
-func NewMySQLBinlogRowImageChecker(db *sql.DB, dbinfo *dbutil.DBConfig) RealChecker {
-	return &MySQLBinlogRowImageChecker{db: db, dbinfo: dbinfo}
+func NewMySQLBinlogRowImageChecker(db *sql.DB, dbinfo *dbutil.DBConfig, checkTables []filter.Table) RealChecker {
+	return &MySQLBinlogRowImageChecker{db: db, dbinfo: dbinfo, checkTables: checkTables}
 }
 
 // Check implements the RealChecker interface.
@@ -143,10 +145,12 @@ func NewMySQLBinlogRowImageChecker(db *sql.DB, dbinfo *dbutil.DBConfig) RealChec
 // ref:
 // - https://dev.mysql.com/doc/refman/5.6/en/replication-options-binary-log.html#sysvar_binlog_row_image
 // - https://mariadb.com/kb/en/library/replication-and-binary-log-server-system-variables/#binlog_row_image
+// MINIMAL and NOBLOB are also allowed when all the migrated tables have a primary key, because the
+// before image always contains the primary key, which is enough for DM to identify the changed rows.
 func (pc *MySQLBinlogRowImageChecker) Check(ctx context.Context) *Result {
 	result := &Result{
 		Name:  pc.Name(),
-		Desc:  "check whether mysql binlog_row_image is FULL",
+		Desc:  "check whether mysql binlog_row_image is FULL, or MINIMAL/NOBLOB with primary keys",
 		State: StateFailure,
 		Extra: fmt.Sprintf("address of db instance - %s:%d", pc.dbinfo.Host, pc.dbinfo.Port),
 	}
@@ -181,7 +185,20 @@ func (pc *MySQLBinlogRowImageChecker) Check(ctx context.Context) *Result {
 		markCheckError(result, err)
 		return result
 	}
-	if strings.ToUpper(value) != "FULL" {
+	switch strings.ToUpper(value) {
+	case "FULL":
+	case "MINIMAL", "NOBLOB":
+		noPKTables, err2 := pc.tablesWithoutPK(ctx)
+		if err2 != nil {
+			markCheckError(result, err2)
+			return result
+		}
+		if len(noPKTables) > 0 {
+			result.Errors = append(result.Errors, NewError("binlog_row_image is %s, and tables %s have no primary key", value, strings.Join(noPKTables, ", ")))
+			result.Instruction = "please add primary keys for these tables, or set binlog_row_image = FULL"
+			return result
+		}
+	default:
 		result.Errors = append(result.Errors, NewError("binlog_row_image is %s, and should be FULL", value))
 		result.Instruction = "MySQL as source: please execute 'set global binlog_row_image = FULL;'; AWS Aurora (MySQL)/RDS MySQL as source: please refer to the document to create a new DB parameter group and set the binlog_row_image = FULL: https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/USER_WorkingWithDBInstanceParamGroups.html Then modify the instance to use the new DB parameter group and restart the instance to take effect."
 		return result
@@ -190,6 +207,49 @@ func (pc *MySQLBinlogRowImageChecker) Check(ctx context.Context) *Result {
 	return result
 }
 
+// tablesWithoutPK returns the quoted names of the check tables which have no primary key.
+func (pc *MySQLBinlogRowImageChecker) tablesWithoutPK(ctx context.Context) ([]string, error) {
+	if len(pc.checkTables) == 0 {
+		return nil, nil
+	}
+
+	schemas := make(map[string]struct{})
+	args := make([]interface{}, 0, len(pc.checkTables))
+	for _, table := range pc.checkTables {
+		if _, ok := schemas[table.Schema]; !ok {
+			schemas[table.Schema] = struct{}{}
+			args = append(args, table.Schema)
+		}
+	}
+	query := "SELECT TABLE_SCHEMA, TABLE_NAME FROM information_schema.TABLE_CONSTRAINTS WHERE CONSTRAINT_TYPE = 'PRIMARY KEY' AND TABLE_SCHEMA IN (" +
+		strings.TrimSuffix(strings.Repeat("?,", len(args)), ",") + ")"
+	rows, err := pc.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pkTables := make(map[filter.Table]struct{})
+	for rows.Next() {
+		var table filter.Table
+		if err = rows.Scan(&table.Schema, &table.Name); err != nil {
+			return nil, err
+		}
+		pkTables[table] = struct{}{}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var noPKTables []string
+	for _, table := range pc.checkTables {
+		if _, ok := pkTables[table]; !ok {
+			noPKTables = append(noPKTables, dbutil.TableName(table.Schema, table.Name))
+		}
+	}
+	return noPKTables, nil
+}
+
 // Name implements the RealChecker interface.
 func (pc *MySQLBinlogRowImageChecker) Name() string {
 	return "mysql_binlog_row_image"
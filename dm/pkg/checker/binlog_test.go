@@ -18,6 +18,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/filter"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	"github.com/stretchr/testify/require"
 )
@@ -138,11 +139,14 @@ func TestMySQLBinlogRowImageChecker(t *testing.T) {
 	require.Nil(t, err)
 	ctx := context.Background()
 
+	tables := []filter.Table{{Schema: "db", Name: "t1"}, {Schema: "db", Name: "t2"}}
+
 	cases := []struct {
 		version   string
 		state     State
 		needCheck bool
 		rowImage  string
+		pkTables  []string // nil means no need to check primary keys
 	}{
 		// mysql < 5.6.2 don't need check
 		{
@@ -164,6 +168,15 @@ func TestMySQLBinlogRowImageChecker(t *testing.T) {
 			state:     StateFailure,
 			needCheck: true,
 			rowImage:  "NOBLOB",
+			pkTables:  []string{"t1"},
+		},
+		// mysql >= 5.6.2  need check - all tables have primary key
+		{
+			version:   "5.6.2-log",
+			state:     StateSuccess,
+			needCheck: true,
+			rowImage:  "MINIMAL",
+			pkTables:  []string{"t1", "t2"},
 		},
 
 		// mariadb < 10.1.6 don't need check
@@ -187,17 +200,25 @@ func TestMySQLBinlogRowImageChecker(t *testing.T) {
 			state:     StateFailure,
 			needCheck: true,
 			rowImage:  "NOBLOB",
+			pkTables:  []string{},
 		},
 	}
 
 	for _, cs := range cases {
-		binlogDBChecker := NewMySQLBinlogRowImageChecker(db, &dbutil.DBConfig{})
+		binlogDBChecker := NewMySQLBinlogRowImageChecker(db, &dbutil.DBConfig{}, tables)
 		versionRow := sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", cs.version)
 		mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'version'").WillReturnRows(versionRow)
 		if cs.needCheck {
 			binlogRowImageRow := sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("binlog_row_image", cs.rowImage)
 			mock.ExpectQuery("SHOW GLOBAL VARIABLES LIKE 'binlog_row_image'").WillReturnRows(binlogRowImageRow)
 		}
+		if cs.pkTables != nil {
+			pkRows := sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME"})
+			for _, table := range cs.pkTables {
+				pkRows.AddRow("db", table)
+			}
+			mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME FROM information_schema.TABLE_CONSTRAINTS").WithArgs("db").WillReturnRows(pkRows)
+		}
 		r := binlogDBChecker.Check(ctx)
 		require.Nil(t, mock.ExpectationsWereMet())
 		require.Equal(t, cs.state, r.State)
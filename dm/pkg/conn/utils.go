@@ -15,6 +15,7 @@ package conn
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math"
 	"strconv"
@@ -322,3 +323,40 @@ func FetchTableEstimatedBytes(ctx context.Context, db *BaseDB, schema string, ta
 	}
 	return size, nil
 }
+
+// TableEstimate is the estimated rows and data size of a table, which comes from
+// the statistics in information_schema and may be inaccurate.
+type TableEstimate struct {
+	Schema string
+	Table  string
+	Rows   int64
+	Bytes  int64
+}
+
+// FetchTableEstimates returns the estimated rows and data size in bytes of all
+// base tables in the database.
+func FetchTableEstimates(ctx context.Context, db *BaseDB) ([]TableEstimate, error) {
+	query := "SELECT TABLE_SCHEMA, TABLE_NAME, TABLE_ROWS, DATA_LENGTH FROM information_schema.TABLES WHERE TABLE_TYPE = 'BASE TABLE'"
+	rows, err := db.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, terror.DBErrorAdapt(err, db.Scope, terror.ErrDBDriverError)
+	}
+	defer rows.Close()
+
+	var estimates []TableEstimate
+	for rows.Next() {
+		var (
+			e            TableEstimate
+			rowCnt, size sql.NullInt64
+		)
+		if err = rows.Scan(&e.Schema, &e.Table, &rowCnt, &size); err != nil {
+			return nil, terror.DBErrorAdapt(err, db.Scope, terror.ErrDBDriverError)
+		}
+		e.Rows, e.Bytes = rowCnt.Int64, size.Int64
+		estimates = append(estimates, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, terror.DBErrorAdapt(err, db.Scope, terror.ErrDBDriverError)
+	}
+	return estimates, nil
+}
@@ -158,3 +158,22 @@ func TestGetMasterStatus(t *testing.T) {
 		require.NoError(t, mock.ExpectationsWereMet())
 	}
 }
+
+func TestFetchTableEstimates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	baseDB := NewBaseDBForTest(db)
+
+	rows := mock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME", "TABLE_ROWS", "DATA_LENGTH"}).
+		AddRow("db1", "tb1", 100, 16384).
+		AddRow("db1", "tb2", nil, nil)
+	mock.ExpectQuery("SELECT TABLE_SCHEMA, TABLE_NAME, TABLE_ROWS, DATA_LENGTH FROM information_schema.TABLES").WillReturnRows(rows)
+
+	estimates, err := FetchTableEstimates(context.Background(), baseDB)
+	require.NoError(t, err)
+	require.Equal(t, []TableEstimate{
+		{Schema: "db1", Table: "tb1", Rows: 100, Bytes: 16384},
+		{Schema: "db1", Table: "tb2"},
+	}, estimates)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -71,3 +71,7 @@ func ValidatorErrorChange(task string) string {
 func ValidatorTableStatus(task string) string {
 	return task + "_validator_table_status"
 }
+
+func ValidatorRepairAction(task string) string {
+	return task + "_validator_repair_action"
+}
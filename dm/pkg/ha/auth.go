@@ -0,0 +1,185 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/tiflow/dm/common"
+	"github.com/pingcap/tiflow/dm/pkg/etcdutil"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/clientv3util"
+)
+
+// User represents a user which can access DM-master when auth is enabled.
+type User struct {
+	Name         string `json:"name"`
+	Role         string `json:"role"`
+	PasswordHash string `json:"password-hash"` // bcrypt hash of the password.
+}
+
+// APIToken represents a named API token which can access DM-master when auth is enabled.
+// only the hash of the token is stored, the token itself is only returned when it is created.
+type APIToken struct {
+	Name       string    `json:"name"`
+	Role       string    `json:"role"`
+	TokenHash  string    `json:"token-hash"` // hex encoded SHA-256 of the token.
+	CreateTime time.Time `json:"create-time"`
+}
+
+// PutUser puts the user into etcd, an existing user with the same name will be overwritten.
+func PutUser(cli *clientv3.Client, user User) error {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	value, err := json.Marshal(user)
+	if err != nil {
+		return terror.ErrHAInvalidItem.Delegate(err, "fail to marshal user "+user.Name)
+	}
+	if _, err = cli.Put(ctx, common.AuthUserKeyAdapter.Encode(user.Name), string(value)); err != nil {
+		return terror.ErrHAFailTxnOperation.Delegate(err, "put user")
+	}
+	return nil
+}
+
+// PutUserIfNotExist puts the user into etcd if no user with the same name exists,
+// it returns whether the user is put.
+func PutUserIfNotExist(cli *clientv3.Client, user User) (bool, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	value, err := json.Marshal(user)
+	if err != nil {
+		return false, terror.ErrHAInvalidItem.Delegate(err, "fail to marshal user "+user.Name)
+	}
+	key := common.AuthUserKeyAdapter.Encode(user.Name)
+	resp, err := cli.Txn(ctx).If(clientv3util.KeyMissing(key)).Then(clientv3.OpPut(key, string(value))).Commit()
+	if err != nil {
+		return false, terror.ErrHAFailTxnOperation.Delegate(err, "put user")
+	}
+	return resp.Succeeded, nil
+}
+
+// GetUser gets the user by name, nil will be returned if the user does not exist.
+func GetUser(cli *clientv3.Client, name string) (*User, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.AuthUserKeyAdapter.Encode(name))
+	if err != nil {
+		return nil, terror.ErrHAFailTxnOperation.Delegate(err, "get user")
+	}
+	if resp.Count == 0 {
+		return nil, nil
+	} else if resp.Count > 1 {
+		// this should not happen.
+		return nil, terror.ErrConfigMoreThanOne.Generate(resp.Count, "User", "name: "+name)
+	}
+	user := &User{}
+	if err = json.Unmarshal(resp.Kvs[0].Value, user); err != nil {
+		return nil, terror.ErrHAInvalidItem.Delegate(err, "fail to unmarshal user")
+	}
+	return user, nil
+}
+
+// GetAllUsers gets all users.
+func GetAllUsers(cli *clientv3.Client) ([]User, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.AuthUserKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, terror.ErrHAFailTxnOperation.Delegate(err, "get all users")
+	}
+	users := make([]User, resp.Count)
+	for i, kv := range resp.Kvs {
+		if err = json.Unmarshal(kv.Value, &users[i]); err != nil {
+			return nil, terror.ErrHAInvalidItem.Delegate(err, "fail to unmarshal user")
+		}
+	}
+	return users, nil
+}
+
+// DeleteUser deletes the user by name.
+func DeleteUser(cli *clientv3.Client, name string) error {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	key := common.AuthUserKeyAdapter.Encode(name)
+	resp, err := cli.Txn(ctx).If(clientv3util.KeyExists(key)).Then(clientv3.OpDelete(key)).Commit()
+	if err != nil {
+		return terror.ErrHAFailTxnOperation.Delegate(err, "delete user")
+	}
+	if !resp.Succeeded {
+		return terror.ErrMasterAuthUserNotExist.Generate(name)
+	}
+	return nil
+}
+
+// PutAPIToken puts the API token into etcd, it fails if a token with the same name already exists.
+func PutAPIToken(cli *clientv3.Client, token APIToken) error {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	value, err := json.Marshal(token)
+	if err != nil {
+		return terror.ErrHAInvalidItem.Delegate(err, "fail to marshal api token "+token.Name)
+	}
+	key := common.AuthAPITokenKeyAdapter.Encode(token.Name)
+	resp, err := cli.Txn(ctx).If(clientv3util.KeyMissing(key)).Then(clientv3.OpPut(key, string(value))).Commit()
+	if err != nil {
+		return terror.ErrHAFailTxnOperation.Delegate(err, "put api token")
+	}
+	if !resp.Succeeded {
+		return terror.ErrMasterAuthTokenExist.Generate(token.Name)
+	}
+	return nil
+}
+
+// GetAllAPITokens gets all API tokens.
+func GetAllAPITokens(cli *clientv3.Client) ([]APIToken, error) {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.Get(ctx, common.AuthAPITokenKeyAdapter.Path(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, terror.ErrHAFailTxnOperation.Delegate(err, "get all api tokens")
+	}
+	tokens := make([]APIToken, resp.Count)
+	for i, kv := range resp.Kvs {
+		if err = json.Unmarshal(kv.Value, &tokens[i]); err != nil {
+			return nil, terror.ErrHAInvalidItem.Delegate(err, "fail to unmarshal api token")
+		}
+	}
+	return tokens, nil
+}
+
+// DeleteAPIToken deletes the API token by name.
+func DeleteAPIToken(cli *clientv3.Client, name string) error {
+	ctx, cancel := context.WithTimeout(cli.Ctx(), etcdutil.DefaultRequestTimeout)
+	defer cancel()
+
+	key := common.AuthAPITokenKeyAdapter.Encode(name)
+	resp, err := cli.Txn(ctx).If(clientv3util.KeyExists(key)).Then(clientv3.OpDelete(key)).Commit()
+	if err != nil {
+		return terror.ErrHAFailTxnOperation.Delegate(err, "delete api token")
+	}
+	if !resp.Succeeded {
+		return terror.ErrMasterAuthTokenNotExist.Generate(name)
+	}
+	return nil
+}
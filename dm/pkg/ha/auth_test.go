@@ -0,0 +1,77 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ha
+
+import (
+	"time"
+
+	"github.com/pingcap/check"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+func (t *testForEtcd) TestUserEtcd(c *check.C) {
+	defer clearTestInfoOperation(c)
+
+	user, err := GetUser(etcdTestCli, "admin")
+	c.Assert(err, check.IsNil)
+	c.Assert(user, check.IsNil)
+
+	admin := User{Name: "admin", Role: "admin", PasswordHash: "hash1"}
+	put, err := PutUserIfNotExist(etcdTestCli, admin)
+	c.Assert(err, check.IsNil)
+	c.Assert(put, check.IsTrue)
+	// the existing user is not overwritten.
+	put, err = PutUserIfNotExist(etcdTestCli, User{Name: "admin", Role: "viewer"})
+	c.Assert(err, check.IsNil)
+	c.Assert(put, check.IsFalse)
+	user, err = GetUser(etcdTestCli, "admin")
+	c.Assert(err, check.IsNil)
+	c.Assert(*user, check.DeepEquals, admin)
+
+	viewer := User{Name: "viewer", Role: "viewer", PasswordHash: "hash2"}
+	c.Assert(PutUser(etcdTestCli, viewer), check.IsNil)
+	viewer.Role = "operator"
+	c.Assert(PutUser(etcdTestCli, viewer), check.IsNil)
+	users, err := GetAllUsers(etcdTestCli)
+	c.Assert(err, check.IsNil)
+	c.Assert(users, check.DeepEquals, []User{admin, viewer})
+
+	c.Assert(DeleteUser(etcdTestCli, "viewer"), check.IsNil)
+	err = DeleteUser(etcdTestCli, "viewer")
+	c.Assert(terror.ErrMasterAuthUserNotExist.Equal(err), check.IsTrue)
+	c.Assert(DeleteUser(etcdTestCli, "admin"), check.IsNil)
+	users, err = GetAllUsers(etcdTestCli)
+	c.Assert(err, check.IsNil)
+	c.Assert(users, check.HasLen, 0)
+}
+
+func (t *testForEtcd) TestAPITokenEtcd(c *check.C) {
+	defer clearTestInfoOperation(c)
+
+	token := APIToken{Name: "ci", Role: "operator", TokenHash: "hash", CreateTime: time.Unix(1650000000, 0).UTC()}
+	c.Assert(PutAPIToken(etcdTestCli, token), check.IsNil)
+	err := PutAPIToken(etcdTestCli, token)
+	c.Assert(terror.ErrMasterAuthTokenExist.Equal(err), check.IsTrue)
+
+	tokens, err := GetAllAPITokens(etcdTestCli)
+	c.Assert(err, check.IsNil)
+	c.Assert(tokens, check.HasLen, 1)
+	c.Assert(tokens[0].CreateTime.Equal(token.CreateTime), check.IsTrue)
+	tokens[0].CreateTime = token.CreateTime
+	c.Assert(tokens[0], check.DeepEquals, token)
+
+	c.Assert(DeleteAPIToken(etcdTestCli, "ci"), check.IsNil)
+	err = DeleteAPIToken(etcdTestCli, "ci")
+	c.Assert(terror.ErrMasterAuthTokenNotExist.Equal(err), check.IsTrue)
+}
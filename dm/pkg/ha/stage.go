@@ -122,6 +122,27 @@ func PutSubTaskStage(cli *clientv3.Client, stages ...Stage) (int64, error) {
 	return rev, err
 }
 
+// FinishSubTaskStage puts the Finished stage of the subtask into etcd when the subtask finishes by itself, e.g. a
+// bounded task reaches its stop boundary. it only takes effect when the current expectant stage is Running, so the
+// stage updated by the user concurrently is not overwritten. it returns whether the stage is updated.
+func FinishSubTaskStage(cli *clientv3.Client, source, task string) (bool, int64, error) {
+	running, err := NewSubTaskStage(pb.Stage_Running, source, task).toJSON()
+	if err != nil {
+		return false, 0, err
+	}
+	finished, err := NewSubTaskStage(pb.Stage_Finished, source, task).toJSON()
+	if err != nil {
+		return false, 0, err
+	}
+	key := common.StageSubTaskKeyAdapter.Encode(source, task)
+	cmp := clientv3.Compare(clientv3.Value(key), "=", running)
+	resp, rev, err := etcdutil.DoTxnWithRepeatable(cli, etcdutil.FullOpFunc([]clientv3.Cmp{cmp}, []clientv3.Op{clientv3.OpPut(key, finished)}, nil))
+	if err != nil {
+		return false, 0, err
+	}
+	return resp.Succeeded, rev, nil
+}
+
 // GetRelayStage gets the relay stage for the specified upstream source.
 // if the stage for the source not exist, return with `err == nil` and `revision=0`.
 func GetRelayStage(cli *clientv3.Client, source string) (Stage, int64, error) {
@@ -213,6 +213,38 @@ func (t *testForEtcd) TestSubTaskStageEtcd(c *check.C) {
 	c.Assert(stm, check.HasLen, 0)
 }
 
+func (t *testForEtcd) TestFinishSubTaskStageEtcd(c *check.C) {
+	defer clearTestInfoOperation(c)
+
+	var (
+		source = "mysql-replica-1"
+		task   = "task-1"
+	)
+
+	// no stage exists.
+	finished, _, err := FinishSubTaskStage(etcdTestCli, source, task)
+	c.Assert(err, check.IsNil)
+	c.Assert(finished, check.IsFalse)
+
+	// the paused subtask is not finished.
+	_, err = PutSubTaskStage(etcdTestCli, NewSubTaskStage(pb.Stage_Paused, source, task))
+	c.Assert(err, check.IsNil)
+	finished, _, err = FinishSubTaskStage(etcdTestCli, source, task)
+	c.Assert(err, check.IsNil)
+	c.Assert(finished, check.IsFalse)
+
+	_, err = PutSubTaskStage(etcdTestCli, NewSubTaskStage(pb.Stage_Running, source, task))
+	c.Assert(err, check.IsNil)
+	finished, rev, err := FinishSubTaskStage(etcdTestCli, source, task)
+	c.Assert(err, check.IsNil)
+	c.Assert(finished, check.IsTrue)
+	stm, _, err := GetSubTaskStage(etcdTestCli, source, task)
+	c.Assert(err, check.IsNil)
+	expected := NewSubTaskStage(pb.Stage_Finished, source, task)
+	expected.Revision = rev
+	c.Assert(stm[task], check.DeepEquals, expected)
+}
+
 func (t *testForEtcd) TestGetSubTaskStageConfigEtcd(c *check.C) {
 	defer clearTestInfoOperation(c)
 
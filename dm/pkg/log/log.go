@@ -149,6 +149,25 @@ func InitLogger(cfg *Config) error {
 	return nil
 }
 
+// NewFileLogger creates a standalone logger which is not the global logger, it's used to
+// write logs that should be separated from the main log such as the audit log.
+func NewFileLogger(cfg *Config) (Logger, error) {
+	logger, _, err := pclog.InitLogger(&pclog.Config{
+		Level:  cfg.Level,
+		Format: cfg.Format,
+		File: pclog.FileLogConfig{
+			Filename:   cfg.File,
+			MaxSize:    cfg.FileMaxSize,
+			MaxDays:    cfg.FileMaxDays,
+			MaxBackups: cfg.FileMaxBackups,
+		},
+	})
+	if err != nil {
+		return Logger{}, terror.ErrInitLoggerFail.Delegate(err)
+	}
+	return Logger{logger.WithOptions(zap.AddStacktrace(zap.DPanicLevel))}, nil
+}
+
 // With creates a child logger from the global logger and adds structured
 // context to it.
 func With(fields ...zap.Field) Logger {
@@ -72,6 +72,7 @@ var (
 		int32(terror.ErrSyncerCancelledDDL.Code()):          {},
 		int32(terror.ErrLoadLightningHasDup.Code()):         {},
 		int32(terror.ErrLoadLightningChecksum.Code()):       {},
+		int32(terror.ErrSyncerDDLNeedApproval.Code()):       {},
 	}
 
 	// UnresumableRelayErrCodes is a set of unresumeable relay unit err codes.
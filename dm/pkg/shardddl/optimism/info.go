@@ -61,6 +61,9 @@ type Info struct {
 
 	// use to resolve conflict
 	IgnoreConflict bool `json:"ignore-conflict"`
+
+	// whether the DDLs need approval in DM-master before being executed.
+	NeedApproval bool `json:"need-approval,omitempty"`
 }
 
 // LogInfo replace TableInfo with schema.Table.String() for log.
@@ -78,6 +81,7 @@ type LogInfo struct {
 	Version        int64    `json:"version"`
 	Revision       int64    `json:"revision"`
 	IgnoreConflict bool     `json:"ignore-conflict"`
+	NeedApproval   bool     `json:"need-approval,omitempty"`
 }
 
 // NewInfo creates a new Info instance.
@@ -117,6 +121,7 @@ func (i *Info) ShortString() string {
 		Version:        i.Version,
 		Revision:       i.Revision,
 		IgnoreConflict: i.IgnoreConflict,
+		NeedApproval:   i.NeedApproval,
 	}
 	if i.TableInfoBefore != nil {
 		logInfo.TableBefore = schemacmp.Encode(i.TableInfoBefore).String()
@@ -402,6 +402,32 @@ func (l *Lock) UpdateTableAfterUnlock(info Info) {
 	l.tables[info.Source][info.UpSchema][info.UpTable] = schemacmp.Encode(info.TableInfosAfter[len(info.TableInfosAfter)-1])
 }
 
+// RevertTable reverts the table info of a table to the one before the DDLs in the info,
+// it's used when the DDLs are skipped, so that later DDLs are computed against the
+// table info which is the same as the downstream.
+func (l *Lock) RevertTable(info Info) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.tables[info.Source][info.UpSchema][info.UpTable]; !ok || info.TableInfoBefore == nil {
+		return
+	}
+	ti := schemacmp.Encode(info.TableInfoBefore)
+	l.tables[info.Source][info.UpSchema][info.UpTable] = ti
+	l.finalTables[info.Source][info.UpSchema][info.UpTable] = ti
+	l.removeConflictTable(info.Source, info.UpSchema, info.UpTable)
+
+	oldSynced := l.synced
+	_, remain := l.syncStatus()
+	l.synced = remain == 0
+	if oldSynced != l.synced {
+		if oldSynced {
+			metrics.ReportDDLPending(l.Task, metrics.DDLPendingSynced, metrics.DDLPendingUnSynced)
+		} else {
+			metrics.ReportDDLPending(l.Task, metrics.DDLPendingUnSynced, metrics.DDLPendingSynced)
+		}
+	}
+}
+
 // IsSynced returns whether the lock has synced.
 // In the optimistic mode, we call it `synced` if table info of all tables are the same,
 // and we define `remain` as the table count which have different table info with the joined one,
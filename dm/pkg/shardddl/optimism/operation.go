@@ -69,6 +69,9 @@ type Operation struct {
 	ConflictMsg   string        `json:"conflict-message"` // current conflict message
 	Done          bool          `json:"done"`             // whether the operation has done
 	Cols          []string      `json:"cols"`             // drop columns' name
+	// Skipped means the DDLs are skipped by the operator rather than applied to the downstream,
+	// the table info of the upstream table should be reverted to the one before the DDLs.
+	Skipped bool `json:"skipped,omitempty"`
 
 	// only set it when get from etcd
 	// use for sort infos in recovering locks
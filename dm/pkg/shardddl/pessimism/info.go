@@ -34,6 +34,9 @@ type Info struct {
 	Schema string   `json:"schema"` // schema name of the DDL
 	Table  string   `json:"table"`  // table name of the DDL
 	DDLs   []string `json:"ddls"`   // DDL statements
+
+	// whether the DDLs need approval in DM-master before being executed.
+	NeedApproval bool `json:"need-approval,omitempty"`
 }
 
 // NewInfo creates a new Info instance.
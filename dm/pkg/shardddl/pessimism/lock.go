@@ -39,6 +39,12 @@ type Lock struct {
 	// whether the operations have done (exec/skip the shard DDL).
 	// if all of them have done, then we call the lock `resolved`.
 	done map[string]bool
+
+	// whether the DDLs need approval before putting the operation for the owner,
+	// and whether they have been approved (or skipped) in DM-master.
+	needApproval bool
+	approved     bool
+	skipped      bool
 }
 
 // NewLock creates a new Lock instance.
@@ -124,6 +130,36 @@ func (l *Lock) RevertSynced(sources []string) {
 	}
 }
 
+// MarkNeedApproval marks the DDLs of the lock need approval.
+func (l *Lock) MarkNeedApproval() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.needApproval = true
+}
+
+// Approve marks the DDLs of the lock as approved, or skipped if `skip` is true.
+func (l *Lock) Approve(skip bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.approved = true
+	l.skipped = skip
+}
+
+// WaitingApproval returns whether the DDLs of the lock are still waiting for approval.
+func (l *Lock) WaitingApproval() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.needApproval && !l.approved
+}
+
+// IsSkipped returns whether the DDLs of the lock need approval and have been skipped,
+// then the owner should skip the DDLs too.
+func (l *Lock) IsSkipped() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.needApproval && l.skipped
+}
+
 // IsSynced returns whether the lock has synced.
 func (l *Lock) IsSynced() (bool, int) {
 	l.mu.RLock()
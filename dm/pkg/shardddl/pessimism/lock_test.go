@@ -138,3 +138,21 @@ func (t *testLock) TestLock(c *check.C) {
 	c.Assert(ready[source1], check.IsTrue)
 	c.Assert(ready[source2], check.IsFalse)
 }
+
+func (t *testLock) TestLockApproval(c *check.C) {
+	l := NewLock("test-`foo`.`bar`", "test", "mysql-replica-1", []string{"DROP TABLE bar"}, []string{"mysql-replica-1"})
+	c.Assert(l.WaitingApproval(), check.IsFalse)
+	c.Assert(l.IsSkipped(), check.IsFalse)
+
+	l.MarkNeedApproval()
+	c.Assert(l.WaitingApproval(), check.IsTrue)
+	c.Assert(l.IsSkipped(), check.IsFalse)
+
+	l.Approve(true)
+	c.Assert(l.WaitingApproval(), check.IsFalse)
+	c.Assert(l.IsSkipped(), check.IsTrue)
+
+	l.Approve(false)
+	c.Assert(l.WaitingApproval(), check.IsFalse)
+	c.Assert(l.IsSkipped(), check.IsFalse)
+}
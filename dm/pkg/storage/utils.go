@@ -166,6 +166,25 @@ func CollectDirFiles(ctx context.Context, dir string, storage storeapi.Storage)
 	return files, err
 }
 
+// CollectDirFileSizes walks and collects the sizes of files in dir, keyed by file name.
+func CollectDirFileSizes(ctx context.Context, dir string, storage storeapi.Storage) (map[string]int64, error) {
+	var err error
+	if storage == nil {
+		storage, err = CreateStorage(ctx, dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sizes := make(map[string]int64)
+
+	err = storage.WalkDir(ctx, &storeapi.WalkOption{}, func(filePath string, size int64) error {
+		sizes[path.Base(filePath)] = size
+		return nil
+	})
+
+	return sizes, err
+}
+
 // RemoveAll remove files in dir.
 func RemoveAll(ctx context.Context, dir string, storage storeapi.Storage) error {
 	var err error
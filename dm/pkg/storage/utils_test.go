@@ -274,6 +274,9 @@ func TestCollectDirFilesAndRemove(t *testing.T) {
 		_, ok := localRes[fileName]
 		require.True(t, ok)
 	}
+	localSizes, err := CollectDirFileSizes(ctx, localDir, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"schema.sql": 0, "table.sql": 0}, localSizes)
 
 	// current dir
 	pwd, err := os.Getwd()
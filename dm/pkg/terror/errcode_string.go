@@ -215,6 +215,11 @@ func _() {
 	_ = x[codeConfigImportIntoShardingNotSupport-20068]
 	_ = x[codeConfigImportIntoRequiresSharedStorage-20069]
 	_ = x[codeConfigUnsupportedForeignKeyChecksOption-20070]
+	_ = x[codeConfigTargetSinkNotSupport-20071]
+	_ = x[codeConfigOfflineBinlogNotSupport-20072]
+	_ = x[codeConfigInvalidApplyDelay-20073]
+	_ = x[codeConfigInvalidDDLApproval-20074]
+	_ = x[codeConfigInvalidStopBoundary-20075]
 	_ = x[codeBinlogExtractPosition-22001]
 	_ = x[codeBinlogInvalidFilename-22002]
 	_ = x[codeBinlogParsePosFromStr-22003]
@@ -280,6 +285,9 @@ func _() {
 	_ = x[codeRelayPurgeArgsNotValid-30042]
 	_ = x[codePreviousGTIDsNotValid-30043]
 	_ = x[codeRotateEventWithDifferentServerID-30044]
+	_ = x[codeRelayImportOfflineBinlog-30045]
+	_ = x[codeRelayArchiveRelayLog-30046]
+	_ = x[codeRelayRestoreRelayLog-30047]
 	_ = x[codeDumpUnitRuntime-32001]
 	_ = x[codeDumpUnitGenTableRouter-32002]
 	_ = x[codeDumpUnitGenBAList-32003]
@@ -376,6 +384,8 @@ func _() {
 	_ = x[codeSyncerGetEvent-36069]
 	_ = x[codeSyncerDownstreamTableNotFound-36070]
 	_ = x[codeSyncerReprocessWithSafeModeFail-36071]
+	_ = x[codeSyncerWriteSink-36072]
+	_ = x[codeSyncerDDLNeedApproval-36073]
 	_ = x[codeMasterSQLOpNilRequest-38001]
 	_ = x[codeMasterSQLOpNotSupport-38002]
 	_ = x[codeMasterSQLOpWithoutSharding-38003]
@@ -434,6 +444,13 @@ func _() {
 	_ = x[codeMasterOptimisticDownstreamMetaNotFound-38056]
 	_ = x[codeMasterInvalidClusterID-38057]
 	_ = x[codeMasterStartTask-38058]
+	_ = x[codeMasterAuthUnauthenticated-38059]
+	_ = x[codeMasterAuthPermissionDenied-38060]
+	_ = x[codeMasterAuthInvalidRole-38061]
+	_ = x[codeMasterAuthUserNotExist-38062]
+	_ = x[codeMasterAuthTokenExist-38063]
+	_ = x[codeMasterAuthTokenNotExist-38064]
+	_ = x[codeMasterLockNotWaitApproval-38065]
 	_ = x[codeWorkerParseFlagSet-40001]
 	_ = x[codeWorkerInvalidFlag-40002]
 	_ = x[codeWorkerDecodeConfigFromFile-40003]
@@ -540,6 +557,8 @@ func _() {
 	_ = x[codeValidatorNotFound-43006]
 	_ = x[codeValidatorPanic-43007]
 	_ = x[codeValidatorTooMuchPending-43008]
+	_ = x[codeValidatorRepairError-43009]
+	_ = x[codeValidatorRepairNotFinished-43010]
 	_ = x[codeSchemaTrackerInvalidJSON-44001]
 	_ = x[codeSchemaTrackerCannotCreateSchema-44002]
 	_ = x[codeSchemaTrackerCannotCreateTable-44003]
@@ -603,7 +622,7 @@ func _() {
 	_ = x[codeNotSet-50000]
 }
 
-const _ErrCode_name = "DBDriverErrorDBBadConnDBInvalidConnDBUnExpectDBQueryFailedDBExecuteFailedDBExecuteFailedBeginParseMydumperMetaGetFileSizeDropMultipleTablesRenameMultipleTablesAlterMultipleTablesParseSQLUnknownTypeDDLRestoreASTNodeParseGTIDNotSupportedFlavorNotMySQLGTIDNotMariaDBGTIDNotUUIDStringMariaDBDomainIDInvalidServerIDGetSQLModeFromStrVerifySQLOperateArgsStatFileSizeReaderAlreadyRunningReaderAlreadyStartedReaderStateCannotCloseReaderShouldStartSyncEmptyRelayDirReadDirBaseFileNotFoundBinFileCmpCondNotSupportBinlogFileNotValidBinlogFilesNotFoundGetRelayLogStatAddWatchForRelayLogDirWatcherStartWatcherChanClosedWatcherChanRecvErrorRelayLogFileSizeSmallerBinlogFileNotSpecifiedNoRelayLogMatchPosFirstRelayLogNotMatchPosParserParseRelayLogNoSubdirToSwitchNeedSyncAgainSyncClosedSchemaTableNameNotValidGenTableRouterEncryptSecretKeyNotValidEncryptGenCipherEncryptGenIVCiphertextLenNotValidCiphertextContextNotValidInvalidBinlogPosStrEncCipherTextBase64DecodeBinlogWriteBinaryDataBinlogWriteDataToBufferBinlogHeaderLengthNotValidBinlogEventDecodeBinlogEmptyNextBinNameBinlogParseSIDBinlogEmptyGTIDBinlogGTIDSetNotValidBinlogGTIDMySQLNotValidBinlogGTIDMariaDBNotValidBinlogMariaDBServerIDMismatchBinlogOnlyOneGTIDSupportBinlogOnlyOneIntervalInUUIDBinlogIntervalValueNotValidBinlogEmptyQueryBinlogTableMapEvNotValidBinlogExpectFormatDescEvBinlogExpectTableMapEvBinlogExpectRowsEvBinlogUnexpectedEvBinlogParseSingleEvBinlogEventTypeNotValidBinlogEventNoRowsBinlogEventNoColumnsBinlogEventRowLengthNotEqBinlogColumnTypeNotSupportBinlogGoMySQLTypeNotSupportBinlogColumnTypeMisMatchBinlogDummyEvSizeTooSmallBinlogFlavorNotSupportBinlogDMLEmptyDataBinlogLatestGTIDNotInPrevBinlogReadFileByGTIDBinlogWriterNotStateNewBinlogWriterStateCannotCloseBinlogWriterNeedStartBinlogWriterOpenFileBinlogWriterGetFileStatBinlogWriterWriteDataLenBinlogWriterFileNotOpenedBinlogWriterFileSyncBinlogPrevGTIDEvNotValidBinlogDecodeMySQLGTIDSetBinlogNeedMariaDBGTIDSetBinlogParseMariaDBGTIDSetBinlogMariaDBAddGTIDSetTracingEventDataNotValidTracingUploadDataTracingEventTypeNotValidTracingGetTraceCodeTracingDataChecksumTracingGetTSOBackoffArgsNotValidInitLoggerFailGTIDTruncateInvalidRelayLogGivenPosTooBigElectionCampaignFailElectionGetLeaderIDFailBinlogInvalidFilenameWithUUIDSuffixDecodeEtcdKeyFailShardDDLOptimismTrySyncFailConnInvalidTLSConfigConnRegistryTLSConfigUpgradeVersionEtcdFailInvalidV1WorkerMetaPathFailUpdateV1DBSchemaBinlogStatusVarsParseVerifyHandleErrorArgsRewriteSQLNoUUIDDirMatchGTIDNoRelayPosMatchGTIDReaderReachEndOfFileMetadataNoBinlogLocPreviousGTIDNotExistNoMasterStatusBinlogNotLogColumnShardDDLOptimismNeedSkipAndRedirectShardDDLOptimismAddNotFullyDroppedColumnSyncerCancelledDDLIncorrectReturnColumnsNumConfigCheckItemNotSupportConfigTomlTransformConfigYamlTransformConfigTaskNameEmptyConfigEmptySourceIDConfigTooLongSourceIDConfigOnlineSchemeNotSupportConfigInvalidTimezoneConfigParseFlagSetConfigDecryptDBPasswordConfigMetaInvalidConfigMySQLInstNotFoundConfigMySQLInstsAtLeastOneConfigMySQLInstSameSourceIDConfigMydumperCfgConflictConfigLoaderCfgConflictConfigSyncerCfgConflictConfigReadCfgFromFileConfigNeedUniqueTaskNameConfigInvalidTaskModeConfigNeedTargetDBConfigMetadataNotSetConfigRouteRuleNotFoundConfigFilterRuleNotFoundConfigColumnMappingNotFoundConfigBAListNotFoundConfigMydumperCfgNotFoundConfigMydumperPathNotValidConfigLoaderCfgNotFoundConfigSyncerCfgNotFoundConfigSourceIDNotFoundConfigDuplicateCfgItemConfigShardModeNotSupportConfigMoreThanOneConfigEtcdParseConfigMissingForBoundConfigBinlogEventFilterConfigGlobalConfigsUnusedConfigExprFilterManyExprConfigExprFilterNotFoundConfigExprFilterWrongGrammarConfigExprFilterEmptyNameConfigCheckerMaxTooSmallConfigGenBAListConfigGenTableRouterConfigGenColumnMappingConfigInvalidChunkFileSizeConfigOnlineDDLInvalidRegexConfigOnlineDDLMistakeRegexConfigOpenAPITaskConfigExistConfigOpenAPITaskConfigNotExistCollationCompatibleNotSupportConfigInvalidLoadModeConfigInvalidLoadDuplicateResolutionConfigValidationModeContinuousValidatorCfgNotFoundConfigStartTimeTooLateConfigLoaderDirInvalidConfigLoaderS3NotSupportConfigInvalidSafeModeDurationConfigConfictSafeModeDurationAndSafeModeConfigInvalidLoadPhysicalDuplicateResolutionConfigInvalidLoadPhysicalChecksumConfigColumnMappingDeprecatedConfigInvalidLoadAnalyzeConfigStrictOptimisticShardModeConfigSecretKeyPathConfigImportIntoShardingNotSupportConfigImportIntoRequiresSharedStorageConfigUnsupportedForeignKeyChecksOptionBinlogExtractPositionBinlogInvalidFilenameBinlogParsePosFromStrCheckpointInvalidTaskModeCheckpointSaveInvalidPosCheckpointInvalidTableFileCheckpointDBNotExistInFileCheckpointTableNotExistInFileCheckpointRestoreCountGreaterTaskCheckSameTableNameTaskCheckFailedOpenDBTaskCheckGenTableRouterTaskCheckGenColumnMappingTaskCheckSyncConfigErrorTaskCheckGenBAListSourceCheckGTIDRelayParseUUIDIndexRelayParseUUIDSuffixRelayUUIDWithSuffixNotFoundRelayGenFakeRotateEventRelayNoValidRelaySubDirRelayUUIDSuffixNotValidRelayUUIDSuffixLessThanPrevRelayLoadMetaDataRelayBinlogNameNotValidRelayNoCurrentUUIDRelayFlushLocalMetaRelayUpdateIndexFileRelayLogDirpathEmptyRelayReaderNotStateNewRelayReaderStateCannotCloseRelayReaderNeedStartRelayTCPReaderStartSyncRelayTCPReaderNilGTIDRelayTCPReaderStartSyncGTIDRelayTCPReaderGetEventRelayWriterNotStateNewRelayWriterStateCannotCloseRelayWriterNeedStartRelayWriterNotOpenedRelayWriterExpectRotateEvRelayWriterRotateEvWithNoWriterRelayWriterStatusNotValidRelayWriterGetFileStatRelayWriterLatestPosGTFileSizeRelayWriterFileOperateRelayCheckBinlogFileHeaderExistRelayCheckFormatDescEventExistRelayCheckFormatDescEventParseEvRelayCheckIsDuplicateEventRelayUpdateGTIDRelayNeedPrevGTIDEvBeforeGTIDEvRelayNeedMaGTIDListEvBeforeGTIDEvRelayMkdirRelaySwitchMasterNeedGTIDRelayThisStrategyIsPurgingRelayOtherStrategyIsPurgingRelayPurgeIsForbiddenRelayNoActiveRelayLogRelayPurgeRequestNotValidRelayTrimUUIDNotFoundRelayRemoveFileFailRelayPurgeArgsNotValidPreviousGTIDsNotValidRotateEventWithDifferentServerIDDumpUnitRuntimeDumpUnitGenTableRouterDumpUnitGenBAListDumpUnitGlobalLockLoadUnitCreateSchemaFileLoadUnitInvalidFileEndingLoadUnitParseQuoteValuesLoadUnitDoColumnMappingLoadUnitReadSchemaFileLoadUnitParseStatementLoadUnitNotCreateTableLoadUnitDispatchSQLFromFileLoadUnitInvalidInsertSQLLoadUnitGenTableRouterLoadUnitGenColumnMappingLoadUnitNoDBFileLoadUnitNoTableFileLoadUnitDumpDirNotFoundLoadUnitDuplicateTableFileLoadUnitGenBAListLoadTaskWorkerNotMatchLoadCheckPointNotMatchLoadLightningRuntimeLoadLightningHasDupLoadLightningChecksumSyncerUnitPanicSyncUnitInvalidTableNameSyncUnitTableNameQuerySyncUnitNotSupportedDMLSyncUnitAddTableInShardingSyncUnitDropSchemaTableInShardingSyncUnitInvalidShardMetaSyncUnitDDLWrongSequenceSyncUnitDDLActiveIndexLargerSyncUnitDupTableGroupSyncUnitShardingGroupNotFoundSyncUnitSafeModeSetCountSyncUnitCausalityConflictSyncUnitDMLStatementFoundSyncerUnitBinlogEventFilterSyncerUnitInvalidReplicaEventSyncerUnitParseStmtSyncerUnitUUIDNotLatestSyncerUnitDDLExecChanCloseOrBusySyncerUnitDDLChanDoneSyncerUnitDDLChanCanceledSyncerUnitDDLOnMultipleTableSyncerUnitInjectDDLOnlySyncerUnitInjectDDLWithoutSchemaSyncerUnitNotSupportedOperateSyncerUnitNilOperatorReqSyncerUnitDMLColumnNotMatchSyncerUnitDMLOldNewValueMismatchSyncerUnitDMLPruneColumnMismatchSyncerUnitGenBinlogEventFilterSyncerUnitGenTableRouterSyncerUnitGenColumnMappingSyncerUnitDoColumnMappingSyncerUnitCacheKeyNotFoundSyncerUnitHeartbeatCheckConfigSyncerUnitHeartbeatRecordExistsSyncerUnitHeartbeatRecordNotFoundSyncerUnitHeartbeatRecordNotValidSyncerUnitOnlineDDLInvalidMetaSyncerUnitOnlineDDLSchemeNotSupportSyncerUnitOnlineDDLOnMultipleTableSyncerUnitGhostApplyEmptyTableSyncerUnitGhostRenameTableNotValidSyncerUnitGhostRenameToGhostTableSyncerUnitGhostRenameGhostTblToOtherSyncerUnitGhostOnlineDDLOnGhostTblSyncerUnitPTApplyEmptyTableSyncerUnitPTRenameTableNotValidSyncerUnitPTRenameToPTTableSyncerUnitPTRenamePTTblToOtherSyncerUnitPTOnlineDDLOnPTTblSyncerUnitRemoteSteamerWithGTIDSyncerUnitRemoteSteamerStartSyncSyncerUnitGetTableFromDBSyncerUnitFirstEndPosNotFoundSyncerUnitResolveCasualityFailSyncerUnitReopenStreamNotSupportSyncerUnitUpdateConfigInShardingSyncerUnitExecWithNoBlockingDDLSyncerUnitGenBAListSyncerUnitHandleDDLFailedSyncerShardDDLConflictSyncerFailpointSyncerEventSyncerOperatorNotExistSyncerEventNotExistSyncerParseDDLSyncerUnsupportedStmtSyncerGetEventSyncerDownstreamTableNotFoundSyncerReprocessWithSafeModeFailMasterSQLOpNilRequestMasterSQLOpNotSupportMasterSQLOpWithoutShardingMasterGRPCCreateConnMasterGRPCSendOnCloseConnMasterGRPCClientCloseMasterGRPCInvalidReqTypeMasterGRPCRequestErrorMasterDeployMapperVerifyMasterConfigParseFlagSetMasterConfigUnknownItemMasterConfigInvalidFlagMasterConfigTomlTransformMasterConfigTimeoutParseMasterConfigUpdateCfgFileMasterShardingDDLDiffMasterStartServiceMasterNoEmitTokenMasterLockNotFoundMasterLockIsResolvingMasterWorkerCliNotFoundMasterWorkerNotWaitLockMasterHandleSQLReqFailMasterOwnerExecDDLMasterPartWorkerExecDDLFailMasterWorkerExistDDLLockMasterGetWorkerCfgExtractorMasterTaskConfigExtractorMasterWorkerArgsExtractorMasterQueryWorkerConfigMasterOperNotFoundMasterOperRespNotSuccessMasterOperRequestTimeoutMasterHandleHTTPApisMasterHostPortNotValidMasterGetHostnameFailMasterGenEmbedEtcdConfigFailMasterStartEmbedEtcdFailMasterParseURLFailMasterJoinEmbedEtcdFailMasterInvalidOperateOpMasterAdvertiseAddrNotValidMasterRequestIsNotForwardToLeaderMasterIsNotAsyncRequestMasterFailToGetExpectResultMasterPessimistNotStartedMasterOptimistNotStartedMasterMasterNameNotExistMasterInvalidOfflineTypeMasterAdvertisePeerURLsNotValidMasterTLSConfigNotValidMasterBoundChangingMasterFailToImportFromV10xMasterInconsistentOptimistDDLsAndInfoMasterOptimisticTableInfobeforeNotExistMasterOptimisticDownstreamMetaNotFoundMasterInvalidClusterIDMasterStartTaskWorkerParseFlagSetWorkerInvalidFlagWorkerDecodeConfigFromFileWorkerUndecodedItemFromFileWorkerNeedSourceIDWorkerTooLongSourceIDWorkerRelayBinlogNameWorkerWriteConfigFileWorkerLogInvalidHandlerWorkerLogPointerInvalidWorkerLogFetchPointerWorkerLogUnmarshalPointerWorkerLogClearPointerWorkerLogTaskKeyNotValidWorkerLogUnmarshalTaskKeyWorkerLogFetchLogIterWorkerLogGetTaskLogWorkerLogUnmarshalBinaryWorkerLogForwardPointerWorkerLogMarshalTaskWorkerLogSaveTaskWorkerLogDeleteKVWorkerLogDeleteKVIterWorkerLogUnmarshalTaskMetaWorkerLogFetchTaskFromMetaWorkerLogVerifyTaskMetaWorkerLogSaveTaskMetaWorkerLogGetTaskMetaWorkerLogDeleteTaskMetaWorkerMetaTomlTransformWorkerMetaOldFileStatWorkerMetaOldReadFileWorkerMetaEncodeTaskWorkerMetaRemoveOldDirWorkerMetaTaskLogNotFoundWorkerMetaHandleTaskOrderWorkerMetaOpenTxnWorkerMetaCommitTxnWorkerRelayStageNotValidWorkerRelayOperNotSupportWorkerOpenKVDBFileWorkerUpgradeCheckKVDirWorkerMarshalVerBinaryWorkerUnmarshalVerBinaryWorkerGetVersionFromKVWorkerSaveVersionToKVWorkerVerAutoDowngradeWorkerStartServiceWorkerAlreadyClosedWorkerNotRunningStageWorkerNotPausedStageWorkerUpdateTaskStageWorkerMigrateStopRelayWorkerSubTaskNotFoundWorkerSubTaskExistsWorkerOperSyncUnitOnlyWorkerRelayUnitStageWorkerNoSyncerRunningWorkerCannotUpdateSourceIDWorkerNoAvailUnitsWorkerDDLLockInfoNotFoundWorkerDDLLockInfoExistsWorkerCacheDDLInfoExistsWorkerExecSkipDDLConflictWorkerExecDDLSyncerOnlyWorkerExecDDLTimeoutWorkerWaitRelayCatchupTimeoutWorkerRelayIsPurgingWorkerHostPortNotValidWorkerNoStartWorkerAlreadyStartedWorkerSourceNotMatchWorkerFailToGetSubtaskConfigFromEtcdWorkerFailToGetSourceConfigFromEtcdWorkerDDLLockOpNotFoundWorkerTLSConfigNotValidWorkerFailConnectMasterWorkerWaitRelayCatchupGTIDWorkerRelayConfigChangingWorkerRouteTableDupMatchWorkerUpdateSubTaskConfigWorkerValidatorNotPausedWorkerServerClosedTracerParseFlagSetTracerConfigTomlTransformTracerConfigInvalidFlagTracerTraceEventNotFoundTracerTraceIDNotProvidedTracerParamNotValidTracerPostMethodOnlyTracerEventAssertionFailTracerEventTypeNotValidTracerStartServiceHAFailTxnOperationHAInvalidItemHAFailWatchEtcdHAFailLeaseOperationHAFailKeepaliveValidatorLoadPersistedDataValidatorPersistDataValidatorGetEventValidatorProcessRowEventValidatorValidateChangeValidatorNotFoundValidatorPanicValidatorTooMuchPendingSchemaTrackerInvalidJSONSchemaTrackerCannotCreateSchemaSchemaTrackerCannotCreateTableSchemaTrackerCannotSerializeSchemaTrackerCannotGetTableSchemaTrackerCannotExecDDLSchemaTrackerCannotFetchDownstreamTableSchemaTrackerCannotParseDownstreamTableSchemaTrackerInvalidCreateTableStmtSchemaTrackerRestoreStmtFailSchemaTrackerCannotDropTableSchemaTrackerInitSchemaTrackerMarshalJSONSchemaTrackerUnMarshalJSONSchemaTrackerUnSchemaNotExistSchemaTrackerCannotSetDownstreamSQLModeSchemaTrackerCannotInitDownstreamParserSchemaTrackerCannotMockDownstreamTableSchemaTrackerCannotFetchDownstreamCreateTableStmtSchemaTrackerIsClosedSchedulerNotStartedSchedulerStartedSchedulerWorkerExistSchedulerWorkerNotExistSchedulerWorkerOnlineSchedulerWorkerInvalidTransSchedulerSourceCfgExistSchedulerSourceCfgNotExistSchedulerSourcesUnboundSchedulerSourceOpTaskExistSchedulerRelayStageInvalidUpdateSchedulerRelayStageSourceNotExistSchedulerMultiTaskSchedulerSubTaskExistSchedulerSubTaskStageInvalidUpdateSchedulerSubTaskOpTaskNotExistSchedulerSubTaskOpSourceNotExistSchedulerTaskNotExistSchedulerRequireRunningTaskInSyncUnitSchedulerRelayWorkersBusySchedulerRelayWorkersBoundSchedulerRelayWorkersWrongRelaySchedulerSourceOpRelayExistSchedulerLatchInUseSchedulerSourceCfgUpdateSchedulerWrongWorkerInputSchedulerCantTransferToRelayWorkerSchedulerStartRelayOnSpecifiedSchedulerStopRelayOnSpecifiedSchedulerStartRelayOnBoundSchedulerStopRelayOnBoundSchedulerPauseTaskForTransferSourceSchedulerWorkerNotFreeSchedulerSubTaskNotExistSchedulerSubTaskCfgUpdateCtlGRPCCreateConnCtlInvalidTLSCfgCtlLoadTLSCfgOpenAPICommonOpenAPITaskSourceNotFoundNotSet"
+const _ErrCode_name = "DBDriverErrorDBBadConnDBInvalidConnDBUnExpectDBQueryFailedDBExecuteFailedDBExecuteFailedBeginParseMydumperMetaGetFileSizeDropMultipleTablesRenameMultipleTablesAlterMultipleTablesParseSQLUnknownTypeDDLRestoreASTNodeParseGTIDNotSupportedFlavorNotMySQLGTIDNotMariaDBGTIDNotUUIDStringMariaDBDomainIDInvalidServerIDGetSQLModeFromStrVerifySQLOperateArgsStatFileSizeReaderAlreadyRunningReaderAlreadyStartedReaderStateCannotCloseReaderShouldStartSyncEmptyRelayDirReadDirBaseFileNotFoundBinFileCmpCondNotSupportBinlogFileNotValidBinlogFilesNotFoundGetRelayLogStatAddWatchForRelayLogDirWatcherStartWatcherChanClosedWatcherChanRecvErrorRelayLogFileSizeSmallerBinlogFileNotSpecifiedNoRelayLogMatchPosFirstRelayLogNotMatchPosParserParseRelayLogNoSubdirToSwitchNeedSyncAgainSyncClosedSchemaTableNameNotValidGenTableRouterEncryptSecretKeyNotValidEncryptGenCipherEncryptGenIVCiphertextLenNotValidCiphertextContextNotValidInvalidBinlogPosStrEncCipherTextBase64DecodeBinlogWriteBinaryDataBinlogWriteDataToBufferBinlogHeaderLengthNotValidBinlogEventDecodeBinlogEmptyNextBinNameBinlogParseSIDBinlogEmptyGTIDBinlogGTIDSetNotValidBinlogGTIDMySQLNotValidBinlogGTIDMariaDBNotValidBinlogMariaDBServerIDMismatchBinlogOnlyOneGTIDSupportBinlogOnlyOneIntervalInUUIDBinlogIntervalValueNotValidBinlogEmptyQueryBinlogTableMapEvNotValidBinlogExpectFormatDescEvBinlogExpectTableMapEvBinlogExpectRowsEvBinlogUnexpectedEvBinlogParseSingleEvBinlogEventTypeNotValidBinlogEventNoRowsBinlogEventNoColumnsBinlogEventRowLengthNotEqBinlogColumnTypeNotSupportBinlogGoMySQLTypeNotSupportBinlogColumnTypeMisMatchBinlogDummyEvSizeTooSmallBinlogFlavorNotSupportBinlogDMLEmptyDataBinlogLatestGTIDNotInPrevBinlogReadFileByGTIDBinlogWriterNotStateNewBinlogWriterStateCannotCloseBinlogWriterNeedStartBinlogWriterOpenFileBinlogWriterGetFileStatBinlogWriterWriteDataLenBinlogWriterFileNotOpenedBinlogWriterFileSyncBinlogPrevGTIDEvNotValidBinlogDecodeMySQLGTIDSetBinlogNeedMariaDBGTIDSetBinlogParseMariaDBGTIDSetBinlogMariaDBAddGTIDSetTracingEventDataNotValidTracingUploadDataTracingEventTypeNotValidTracingGetTraceCodeTracingDataChecksumTracingGetTSOBackoffArgsNotValidInitLoggerFailGTIDTruncateInvalidRelayLogGivenPosTooBigElectionCampaignFailElectionGetLeaderIDFailBinlogInvalidFilenameWithUUIDSuffixDecodeEtcdKeyFailShardDDLOptimismTrySyncFailConnInvalidTLSConfigConnRegistryTLSConfigUpgradeVersionEtcdFailInvalidV1WorkerMetaPathFailUpdateV1DBSchemaBinlogStatusVarsParseVerifyHandleErrorArgsRewriteSQLNoUUIDDirMatchGTIDNoRelayPosMatchGTIDReaderReachEndOfFileMetadataNoBinlogLocPreviousGTIDNotExistNoMasterStatusBinlogNotLogColumnShardDDLOptimismNeedSkipAndRedirectShardDDLOptimismAddNotFullyDroppedColumnSyncerCancelledDDLIncorrectReturnColumnsNumConfigCheckItemNotSupportConfigTomlTransformConfigYamlTransformConfigTaskNameEmptyConfigEmptySourceIDConfigTooLongSourceIDConfigOnlineSchemeNotSupportConfigInvalidTimezoneConfigParseFlagSetConfigDecryptDBPasswordConfigMetaInvalidConfigMySQLInstNotFoundConfigMySQLInstsAtLeastOneConfigMySQLInstSameSourceIDConfigMydumperCfgConflictConfigLoaderCfgConflictConfigSyncerCfgConflictConfigReadCfgFromFileConfigNeedUniqueTaskNameConfigInvalidTaskModeConfigNeedTargetDBConfigMetadataNotSetConfigRouteRuleNotFoundConfigFilterRuleNotFoundConfigColumnMappingNotFoundConfigBAListNotFoundConfigMydumperCfgNotFoundConfigMydumperPathNotValidConfigLoaderCfgNotFoundConfigSyncerCfgNotFoundConfigSourceIDNotFoundConfigDuplicateCfgItemConfigShardModeNotSupportConfigMoreThanOneConfigEtcdParseConfigMissingForBoundConfigBinlogEventFilterConfigGlobalConfigsUnusedConfigExprFilterManyExprConfigExprFilterNotFoundConfigExprFilterWrongGrammarConfigExprFilterEmptyNameConfigCheckerMaxTooSmallConfigGenBAListConfigGenTableRouterConfigGenColumnMappingConfigInvalidChunkFileSizeConfigOnlineDDLInvalidRegexConfigOnlineDDLMistakeRegexConfigOpenAPITaskConfigExistConfigOpenAPITaskConfigNotExistCollationCompatibleNotSupportConfigInvalidLoadModeConfigInvalidLoadDuplicateResolutionConfigValidationModeContinuousValidatorCfgNotFoundConfigStartTimeTooLateConfigLoaderDirInvalidConfigLoaderS3NotSupportConfigInvalidSafeModeDurationConfigConfictSafeModeDurationAndSafeModeConfigInvalidLoadPhysicalDuplicateResolutionConfigInvalidLoadPhysicalChecksumConfigColumnMappingDeprecatedConfigInvalidLoadAnalyzeConfigStrictOptimisticShardModeConfigSecretKeyPathConfigImportIntoShardingNotSupportConfigImportIntoRequiresSharedStorageConfigUnsupportedForeignKeyChecksOptionConfigTargetSinkNotSupportConfigOfflineBinlogNotSupportConfigInvalidApplyDelayConfigInvalidDDLApprovalConfigInvalidStopBoundaryBinlogExtractPositionBinlogInvalidFilenameBinlogParsePosFromStrCheckpointInvalidTaskModeCheckpointSaveInvalidPosCheckpointInvalidTableFileCheckpointDBNotExistInFileCheckpointTableNotExistInFileCheckpointRestoreCountGreaterTaskCheckSameTableNameTaskCheckFailedOpenDBTaskCheckGenTableRouterTaskCheckGenColumnMappingTaskCheckSyncConfigErrorTaskCheckGenBAListSourceCheckGTIDRelayParseUUIDIndexRelayParseUUIDSuffixRelayUUIDWithSuffixNotFoundRelayGenFakeRotateEventRelayNoValidRelaySubDirRelayUUIDSuffixNotValidRelayUUIDSuffixLessThanPrevRelayLoadMetaDataRelayBinlogNameNotValidRelayNoCurrentUUIDRelayFlushLocalMetaRelayUpdateIndexFileRelayLogDirpathEmptyRelayReaderNotStateNewRelayReaderStateCannotCloseRelayReaderNeedStartRelayTCPReaderStartSyncRelayTCPReaderNilGTIDRelayTCPReaderStartSyncGTIDRelayTCPReaderGetEventRelayWriterNotStateNewRelayWriterStateCannotCloseRelayWriterNeedStartRelayWriterNotOpenedRelayWriterExpectRotateEvRelayWriterRotateEvWithNoWriterRelayWriterStatusNotValidRelayWriterGetFileStatRelayWriterLatestPosGTFileSizeRelayWriterFileOperateRelayCheckBinlogFileHeaderExistRelayCheckFormatDescEventExistRelayCheckFormatDescEventParseEvRelayCheckIsDuplicateEventRelayUpdateGTIDRelayNeedPrevGTIDEvBeforeGTIDEvRelayNeedMaGTIDListEvBeforeGTIDEvRelayMkdirRelaySwitchMasterNeedGTIDRelayThisStrategyIsPurgingRelayOtherStrategyIsPurgingRelayPurgeIsForbiddenRelayNoActiveRelayLogRelayPurgeRequestNotValidRelayTrimUUIDNotFoundRelayRemoveFileFailRelayPurgeArgsNotValidPreviousGTIDsNotValidRotateEventWithDifferentServerIDRelayImportOfflineBinlogRelayArchiveRelayLogRelayRestoreRelayLogDumpUnitRuntimeDumpUnitGenTableRouterDumpUnitGenBAListDumpUnitGlobalLockLoadUnitCreateSchemaFileLoadUnitInvalidFileEndingLoadUnitParseQuoteValuesLoadUnitDoColumnMappingLoadUnitReadSchemaFileLoadUnitParseStatementLoadUnitNotCreateTableLoadUnitDispatchSQLFromFileLoadUnitInvalidInsertSQLLoadUnitGenTableRouterLoadUnitGenColumnMappingLoadUnitNoDBFileLoadUnitNoTableFileLoadUnitDumpDirNotFoundLoadUnitDuplicateTableFileLoadUnitGenBAListLoadTaskWorkerNotMatchLoadCheckPointNotMatchLoadLightningRuntimeLoadLightningHasDupLoadLightningChecksumSyncerUnitPanicSyncUnitInvalidTableNameSyncUnitTableNameQuerySyncUnitNotSupportedDMLSyncUnitAddTableInShardingSyncUnitDropSchemaTableInShardingSyncUnitInvalidShardMetaSyncUnitDDLWrongSequenceSyncUnitDDLActiveIndexLargerSyncUnitDupTableGroupSyncUnitShardingGroupNotFoundSyncUnitSafeModeSetCountSyncUnitCausalityConflictSyncUnitDMLStatementFoundSyncerUnitBinlogEventFilterSyncerUnitInvalidReplicaEventSyncerUnitParseStmtSyncerUnitUUIDNotLatestSyncerUnitDDLExecChanCloseOrBusySyncerUnitDDLChanDoneSyncerUnitDDLChanCanceledSyncerUnitDDLOnMultipleTableSyncerUnitInjectDDLOnlySyncerUnitInjectDDLWithoutSchemaSyncerUnitNotSupportedOperateSyncerUnitNilOperatorReqSyncerUnitDMLColumnNotMatchSyncerUnitDMLOldNewValueMismatchSyncerUnitDMLPruneColumnMismatchSyncerUnitGenBinlogEventFilterSyncerUnitGenTableRouterSyncerUnitGenColumnMappingSyncerUnitDoColumnMappingSyncerUnitCacheKeyNotFoundSyncerUnitHeartbeatCheckConfigSyncerUnitHeartbeatRecordExistsSyncerUnitHeartbeatRecordNotFoundSyncerUnitHeartbeatRecordNotValidSyncerUnitOnlineDDLInvalidMetaSyncerUnitOnlineDDLSchemeNotSupportSyncerUnitOnlineDDLOnMultipleTableSyncerUnitGhostApplyEmptyTableSyncerUnitGhostRenameTableNotValidSyncerUnitGhostRenameToGhostTableSyncerUnitGhostRenameGhostTblToOtherSyncerUnitGhostOnlineDDLOnGhostTblSyncerUnitPTApplyEmptyTableSyncerUnitPTRenameTableNotValidSyncerUnitPTRenameToPTTableSyncerUnitPTRenamePTTblToOtherSyncerUnitPTOnlineDDLOnPTTblSyncerUnitRemoteSteamerWithGTIDSyncerUnitRemoteSteamerStartSyncSyncerUnitGetTableFromDBSyncerUnitFirstEndPosNotFoundSyncerUnitResolveCasualityFailSyncerUnitReopenStreamNotSupportSyncerUnitUpdateConfigInShardingSyncerUnitExecWithNoBlockingDDLSyncerUnitGenBAListSyncerUnitHandleDDLFailedSyncerShardDDLConflictSyncerFailpointSyncerEventSyncerOperatorNotExistSyncerEventNotExistSyncerParseDDLSyncerUnsupportedStmtSyncerGetEventSyncerDownstreamTableNotFoundSyncerReprocessWithSafeModeFailSyncerWriteSinkSyncerDDLNeedApprovalMasterSQLOpNilRequestMasterSQLOpNotSupportMasterSQLOpWithoutShardingMasterGRPCCreateConnMasterGRPCSendOnCloseConnMasterGRPCClientCloseMasterGRPCInvalidReqTypeMasterGRPCRequestErrorMasterDeployMapperVerifyMasterConfigParseFlagSetMasterConfigUnknownItemMasterConfigInvalidFlagMasterConfigTomlTransformMasterConfigTimeoutParseMasterConfigUpdateCfgFileMasterShardingDDLDiffMasterStartServiceMasterNoEmitTokenMasterLockNotFoundMasterLockIsResolvingMasterWorkerCliNotFoundMasterWorkerNotWaitLockMasterHandleSQLReqFailMasterOwnerExecDDLMasterPartWorkerExecDDLFailMasterWorkerExistDDLLockMasterGetWorkerCfgExtractorMasterTaskConfigExtractorMasterWorkerArgsExtractorMasterQueryWorkerConfigMasterOperNotFoundMasterOperRespNotSuccessMasterOperRequestTimeoutMasterHandleHTTPApisMasterHostPortNotValidMasterGetHostnameFailMasterGenEmbedEtcdConfigFailMasterStartEmbedEtcdFailMasterParseURLFailMasterJoinEmbedEtcdFailMasterInvalidOperateOpMasterAdvertiseAddrNotValidMasterRequestIsNotForwardToLeaderMasterIsNotAsyncRequestMasterFailToGetExpectResultMasterPessimistNotStartedMasterOptimistNotStartedMasterMasterNameNotExistMasterInvalidOfflineTypeMasterAdvertisePeerURLsNotValidMasterTLSConfigNotValidMasterBoundChangingMasterFailToImportFromV10xMasterInconsistentOptimistDDLsAndInfoMasterOptimisticTableInfobeforeNotExistMasterOptimisticDownstreamMetaNotFoundMasterInvalidClusterIDMasterStartTaskMasterAuthUnauthenticatedMasterAuthPermissionDeniedMasterAuthInvalidRoleMasterAuthUserNotExistMasterAuthTokenExistMasterAuthTokenNotExistMasterLockNotWaitApprovalWorkerParseFlagSetWorkerInvalidFlagWorkerDecodeConfigFromFileWorkerUndecodedItemFromFileWorkerNeedSourceIDWorkerTooLongSourceIDWorkerRelayBinlogNameWorkerWriteConfigFileWorkerLogInvalidHandlerWorkerLogPointerInvalidWorkerLogFetchPointerWorkerLogUnmarshalPointerWorkerLogClearPointerWorkerLogTaskKeyNotValidWorkerLogUnmarshalTaskKeyWorkerLogFetchLogIterWorkerLogGetTaskLogWorkerLogUnmarshalBinaryWorkerLogForwardPointerWorkerLogMarshalTaskWorkerLogSaveTaskWorkerLogDeleteKVWorkerLogDeleteKVIterWorkerLogUnmarshalTaskMetaWorkerLogFetchTaskFromMetaWorkerLogVerifyTaskMetaWorkerLogSaveTaskMetaWorkerLogGetTaskMetaWorkerLogDeleteTaskMetaWorkerMetaTomlTransformWorkerMetaOldFileStatWorkerMetaOldReadFileWorkerMetaEncodeTaskWorkerMetaRemoveOldDirWorkerMetaTaskLogNotFoundWorkerMetaHandleTaskOrderWorkerMetaOpenTxnWorkerMetaCommitTxnWorkerRelayStageNotValidWorkerRelayOperNotSupportWorkerOpenKVDBFileWorkerUpgradeCheckKVDirWorkerMarshalVerBinaryWorkerUnmarshalVerBinaryWorkerGetVersionFromKVWorkerSaveVersionToKVWorkerVerAutoDowngradeWorkerStartServiceWorkerAlreadyClosedWorkerNotRunningStageWorkerNotPausedStageWorkerUpdateTaskStageWorkerMigrateStopRelayWorkerSubTaskNotFoundWorkerSubTaskExistsWorkerOperSyncUnitOnlyWorkerRelayUnitStageWorkerNoSyncerRunningWorkerCannotUpdateSourceIDWorkerNoAvailUnitsWorkerDDLLockInfoNotFoundWorkerDDLLockInfoExistsWorkerCacheDDLInfoExistsWorkerExecSkipDDLConflictWorkerExecDDLSyncerOnlyWorkerExecDDLTimeoutWorkerWaitRelayCatchupTimeoutWorkerRelayIsPurgingWorkerHostPortNotValidWorkerNoStartWorkerAlreadyStartedWorkerSourceNotMatchWorkerFailToGetSubtaskConfigFromEtcdWorkerFailToGetSourceConfigFromEtcdWorkerDDLLockOpNotFoundWorkerTLSConfigNotValidWorkerFailConnectMasterWorkerWaitRelayCatchupGTIDWorkerRelayConfigChangingWorkerRouteTableDupMatchWorkerUpdateSubTaskConfigWorkerValidatorNotPausedWorkerServerClosedTracerParseFlagSetTracerConfigTomlTransformTracerConfigInvalidFlagTracerTraceEventNotFoundTracerTraceIDNotProvidedTracerParamNotValidTracerPostMethodOnlyTracerEventAssertionFailTracerEventTypeNotValidTracerStartServiceHAFailTxnOperationHAInvalidItemHAFailWatchEtcdHAFailLeaseOperationHAFailKeepaliveValidatorLoadPersistedDataValidatorPersistDataValidatorGetEventValidatorProcessRowEventValidatorValidateChangeValidatorNotFoundValidatorPanicValidatorTooMuchPendingValidatorRepairErrorValidatorRepairNotFinishedSchemaTrackerInvalidJSONSchemaTrackerCannotCreateSchemaSchemaTrackerCannotCreateTableSchemaTrackerCannotSerializeSchemaTrackerCannotGetTableSchemaTrackerCannotExecDDLSchemaTrackerCannotFetchDownstreamTableSchemaTrackerCannotParseDownstreamTableSchemaTrackerInvalidCreateTableStmtSchemaTrackerRestoreStmtFailSchemaTrackerCannotDropTableSchemaTrackerInitSchemaTrackerMarshalJSONSchemaTrackerUnMarshalJSONSchemaTrackerUnSchemaNotExistSchemaTrackerCannotSetDownstreamSQLModeSchemaTrackerCannotInitDownstreamParserSchemaTrackerCannotMockDownstreamTableSchemaTrackerCannotFetchDownstreamCreateTableStmtSchemaTrackerIsClosedSchedulerNotStartedSchedulerStartedSchedulerWorkerExistSchedulerWorkerNotExistSchedulerWorkerOnlineSchedulerWorkerInvalidTransSchedulerSourceCfgExistSchedulerSourceCfgNotExistSchedulerSourcesUnboundSchedulerSourceOpTaskExistSchedulerRelayStageInvalidUpdateSchedulerRelayStageSourceNotExistSchedulerMultiTaskSchedulerSubTaskExistSchedulerSubTaskStageInvalidUpdateSchedulerSubTaskOpTaskNotExistSchedulerSubTaskOpSourceNotExistSchedulerTaskNotExistSchedulerRequireRunningTaskInSyncUnitSchedulerRelayWorkersBusySchedulerRelayWorkersBoundSchedulerRelayWorkersWrongRelaySchedulerSourceOpRelayExistSchedulerLatchInUseSchedulerSourceCfgUpdateSchedulerWrongWorkerInputSchedulerCantTransferToRelayWorkerSchedulerStartRelayOnSpecifiedSchedulerStopRelayOnSpecifiedSchedulerStartRelayOnBoundSchedulerStopRelayOnBoundSchedulerPauseTaskForTransferSourceSchedulerWorkerNotFreeSchedulerSubTaskNotExistSchedulerSubTaskCfgUpdateCtlGRPCCreateConnCtlInvalidTLSCfgCtlLoadTLSCfgOpenAPICommonOpenAPITaskSourceNotFoundNotSet"
 
 var _ErrCode_map = map[ErrCode]string{
 	10001: _ErrCode_name[0:13],
@@ -813,392 +832,411 @@ var _ErrCode_map = map[ErrCode]string{
 	20068: _ErrCode_name[4311:4345],
 	20069: _ErrCode_name[4345:4382],
 	20070: _ErrCode_name[4382:4421],
-	22001: _ErrCode_name[4421:4442],
-	22002: _ErrCode_name[4442:4463],
-	22003: _ErrCode_name[4463:4484],
-	24001: _ErrCode_name[4484:4509],
-	24002: _ErrCode_name[4509:4533],
-	24003: _ErrCode_name[4533:4559],
-	24004: _ErrCode_name[4559:4585],
-	24005: _ErrCode_name[4585:4614],
-	24006: _ErrCode_name[4614:4643],
-	26001: _ErrCode_name[4643:4665],
-	26002: _ErrCode_name[4665:4686],
-	26003: _ErrCode_name[4686:4709],
-	26004: _ErrCode_name[4709:4734],
-	26005: _ErrCode_name[4734:4758],
-	26006: _ErrCode_name[4758:4776],
-	26007: _ErrCode_name[4776:4791],
-	28001: _ErrCode_name[4791:4810],
-	28002: _ErrCode_name[4810:4830],
-	28003: _ErrCode_name[4830:4857],
-	28004: _ErrCode_name[4857:4880],
-	28005: _ErrCode_name[4880:4903],
-	30001: _ErrCode_name[4903:4926],
-	30002: _ErrCode_name[4926:4953],
-	30003: _ErrCode_name[4953:4970],
-	30004: _ErrCode_name[4970:4993],
-	30005: _ErrCode_name[4993:5011],
-	30006: _ErrCode_name[5011:5030],
-	30007: _ErrCode_name[5030:5050],
-	30008: _ErrCode_name[5050:5070],
-	30009: _ErrCode_name[5070:5092],
-	30010: _ErrCode_name[5092:5119],
-	30011: _ErrCode_name[5119:5139],
-	30012: _ErrCode_name[5139:5162],
-	30013: _ErrCode_name[5162:5183],
-	30014: _ErrCode_name[5183:5210],
-	30015: _ErrCode_name[5210:5232],
-	30016: _ErrCode_name[5232:5254],
-	30017: _ErrCode_name[5254:5281],
-	30018: _ErrCode_name[5281:5301],
-	30019: _ErrCode_name[5301:5321],
-	30020: _ErrCode_name[5321:5346],
-	30021: _ErrCode_name[5346:5377],
-	30022: _ErrCode_name[5377:5402],
-	30023: _ErrCode_name[5402:5424],
-	30024: _ErrCode_name[5424:5454],
-	30025: _ErrCode_name[5454:5476],
-	30026: _ErrCode_name[5476:5507],
-	30027: _ErrCode_name[5507:5537],
-	30028: _ErrCode_name[5537:5569],
-	30029: _ErrCode_name[5569:5595],
-	30030: _ErrCode_name[5595:5610],
-	30031: _ErrCode_name[5610:5641],
-	30032: _ErrCode_name[5641:5674],
-	30033: _ErrCode_name[5674:5684],
-	30034: _ErrCode_name[5684:5709],
-	30035: _ErrCode_name[5709:5735],
-	30036: _ErrCode_name[5735:5762],
-	30037: _ErrCode_name[5762:5783],
-	30038: _ErrCode_name[5783:5804],
-	30039: _ErrCode_name[5804:5829],
-	30040: _ErrCode_name[5829:5850],
-	30041: _ErrCode_name[5850:5869],
-	30042: _ErrCode_name[5869:5891],
-	30043: _ErrCode_name[5891:5912],
-	30044: _ErrCode_name[5912:5944],
-	32001: _ErrCode_name[5944:5959],
-	32002: _ErrCode_name[5959:5981],
-	32003: _ErrCode_name[5981:5998],
-	32004: _ErrCode_name[5998:6016],
-	34001: _ErrCode_name[6016:6040],
-	34002: _ErrCode_name[6040:6065],
-	34003: _ErrCode_name[6065:6089],
-	34004: _ErrCode_name[6089:6112],
-	34005: _ErrCode_name[6112:6134],
-	34006: _ErrCode_name[6134:6156],
-	34007: _ErrCode_name[6156:6178],
-	34008: _ErrCode_name[6178:6205],
-	34009: _ErrCode_name[6205:6229],
-	34010: _ErrCode_name[6229:6251],
-	34011: _ErrCode_name[6251:6275],
-	34012: _ErrCode_name[6275:6291],
-	34013: _ErrCode_name[6291:6310],
-	34014: _ErrCode_name[6310:6333],
-	34015: _ErrCode_name[6333:6359],
-	34016: _ErrCode_name[6359:6376],
-	34017: _ErrCode_name[6376:6398],
-	34018: _ErrCode_name[6398:6420],
-	34019: _ErrCode_name[6420:6440],
-	34020: _ErrCode_name[6440:6459],
-	34021: _ErrCode_name[6459:6480],
-	36001: _ErrCode_name[6480:6495],
-	36002: _ErrCode_name[6495:6519],
-	36003: _ErrCode_name[6519:6541],
-	36004: _ErrCode_name[6541:6564],
-	36005: _ErrCode_name[6564:6590],
-	36006: _ErrCode_name[6590:6623],
-	36007: _ErrCode_name[6623:6647],
-	36008: _ErrCode_name[6647:6671],
-	36009: _ErrCode_name[6671:6699],
-	36010: _ErrCode_name[6699:6720],
-	36011: _ErrCode_name[6720:6749],
-	36012: _ErrCode_name[6749:6773],
-	36013: _ErrCode_name[6773:6798],
-	36014: _ErrCode_name[6798:6823],
-	36015: _ErrCode_name[6823:6850],
-	36016: _ErrCode_name[6850:6879],
-	36017: _ErrCode_name[6879:6898],
-	36018: _ErrCode_name[6898:6921],
-	36019: _ErrCode_name[6921:6953],
-	36020: _ErrCode_name[6953:6974],
-	36021: _ErrCode_name[6974:6999],
-	36022: _ErrCode_name[6999:7027],
-	36023: _ErrCode_name[7027:7050],
-	36024: _ErrCode_name[7050:7082],
-	36025: _ErrCode_name[7082:7111],
-	36026: _ErrCode_name[7111:7135],
-	36027: _ErrCode_name[7135:7162],
-	36028: _ErrCode_name[7162:7194],
-	36029: _ErrCode_name[7194:7226],
-	36030: _ErrCode_name[7226:7256],
-	36031: _ErrCode_name[7256:7280],
-	36032: _ErrCode_name[7280:7306],
-	36033: _ErrCode_name[7306:7331],
-	36034: _ErrCode_name[7331:7357],
-	36035: _ErrCode_name[7357:7387],
-	36036: _ErrCode_name[7387:7418],
-	36037: _ErrCode_name[7418:7451],
-	36038: _ErrCode_name[7451:7484],
-	36039: _ErrCode_name[7484:7514],
-	36040: _ErrCode_name[7514:7549],
-	36041: _ErrCode_name[7549:7583],
-	36042: _ErrCode_name[7583:7613],
-	36043: _ErrCode_name[7613:7647],
-	36044: _ErrCode_name[7647:7680],
-	36045: _ErrCode_name[7680:7716],
-	36046: _ErrCode_name[7716:7750],
-	36047: _ErrCode_name[7750:7777],
-	36048: _ErrCode_name[7777:7808],
-	36049: _ErrCode_name[7808:7835],
-	36050: _ErrCode_name[7835:7865],
-	36051: _ErrCode_name[7865:7893],
-	36052: _ErrCode_name[7893:7924],
-	36053: _ErrCode_name[7924:7956],
-	36054: _ErrCode_name[7956:7980],
-	36055: _ErrCode_name[7980:8009],
-	36056: _ErrCode_name[8009:8039],
-	36057: _ErrCode_name[8039:8071],
-	36058: _ErrCode_name[8071:8103],
-	36059: _ErrCode_name[8103:8134],
-	36060: _ErrCode_name[8134:8153],
-	36061: _ErrCode_name[8153:8178],
-	36062: _ErrCode_name[8178:8200],
-	36063: _ErrCode_name[8200:8215],
-	36064: _ErrCode_name[8215:8226],
-	36065: _ErrCode_name[8226:8248],
-	36066: _ErrCode_name[8248:8267],
-	36067: _ErrCode_name[8267:8281],
-	36068: _ErrCode_name[8281:8302],
-	36069: _ErrCode_name[8302:8316],
-	36070: _ErrCode_name[8316:8345],
-	36071: _ErrCode_name[8345:8376],
-	38001: _ErrCode_name[8376:8397],
-	38002: _ErrCode_name[8397:8418],
-	38003: _ErrCode_name[8418:8444],
-	38004: _ErrCode_name[8444:8464],
-	38005: _ErrCode_name[8464:8489],
-	38006: _ErrCode_name[8489:8510],
-	38007: _ErrCode_name[8510:8534],
-	38008: _ErrCode_name[8534:8556],
-	38009: _ErrCode_name[8556:8580],
-	38010: _ErrCode_name[8580:8604],
-	38011: _ErrCode_name[8604:8627],
-	38012: _ErrCode_name[8627:8650],
-	38013: _ErrCode_name[8650:8675],
-	38014: _ErrCode_name[8675:8699],
-	38015: _ErrCode_name[8699:8724],
-	38016: _ErrCode_name[8724:8745],
-	38017: _ErrCode_name[8745:8763],
-	38018: _ErrCode_name[8763:8780],
-	38019: _ErrCode_name[8780:8798],
-	38020: _ErrCode_name[8798:8819],
-	38021: _ErrCode_name[8819:8842],
-	38022: _ErrCode_name[8842:8865],
-	38023: _ErrCode_name[8865:8887],
-	38024: _ErrCode_name[8887:8905],
-	38025: _ErrCode_name[8905:8932],
-	38026: _ErrCode_name[8932:8956],
-	38027: _ErrCode_name[8956:8983],
-	38028: _ErrCode_name[8983:9008],
-	38029: _ErrCode_name[9008:9033],
-	38030: _ErrCode_name[9033:9056],
-	38031: _ErrCode_name[9056:9074],
-	38032: _ErrCode_name[9074:9098],
-	38033: _ErrCode_name[9098:9122],
-	38034: _ErrCode_name[9122:9142],
-	38035: _ErrCode_name[9142:9164],
-	38036: _ErrCode_name[9164:9185],
-	38037: _ErrCode_name[9185:9213],
-	38038: _ErrCode_name[9213:9237],
-	38039: _ErrCode_name[9237:9255],
-	38040: _ErrCode_name[9255:9278],
-	38041: _ErrCode_name[9278:9300],
-	38042: _ErrCode_name[9300:9327],
-	38043: _ErrCode_name[9327:9360],
-	38044: _ErrCode_name[9360:9383],
-	38045: _ErrCode_name[9383:9410],
-	38046: _ErrCode_name[9410:9435],
-	38047: _ErrCode_name[9435:9459],
-	38048: _ErrCode_name[9459:9483],
-	38049: _ErrCode_name[9483:9507],
-	38050: _ErrCode_name[9507:9538],
-	38051: _ErrCode_name[9538:9561],
-	38052: _ErrCode_name[9561:9580],
-	38053: _ErrCode_name[9580:9606],
-	38054: _ErrCode_name[9606:9643],
-	38055: _ErrCode_name[9643:9682],
-	38056: _ErrCode_name[9682:9720],
-	38057: _ErrCode_name[9720:9742],
-	38058: _ErrCode_name[9742:9757],
-	40001: _ErrCode_name[9757:9775],
-	40002: _ErrCode_name[9775:9792],
-	40003: _ErrCode_name[9792:9818],
-	40004: _ErrCode_name[9818:9845],
-	40005: _ErrCode_name[9845:9863],
-	40006: _ErrCode_name[9863:9884],
-	40007: _ErrCode_name[9884:9905],
-	40008: _ErrCode_name[9905:9926],
-	40009: _ErrCode_name[9926:9949],
-	40010: _ErrCode_name[9949:9972],
-	40011: _ErrCode_name[9972:9993],
-	40012: _ErrCode_name[9993:10018],
-	40013: _ErrCode_name[10018:10039],
-	40014: _ErrCode_name[10039:10063],
-	40015: _ErrCode_name[10063:10088],
-	40016: _ErrCode_name[10088:10109],
-	40017: _ErrCode_name[10109:10128],
-	40018: _ErrCode_name[10128:10152],
-	40019: _ErrCode_name[10152:10175],
-	40020: _ErrCode_name[10175:10195],
-	40021: _ErrCode_name[10195:10212],
-	40022: _ErrCode_name[10212:10229],
-	40023: _ErrCode_name[10229:10250],
-	40024: _ErrCode_name[10250:10276],
-	40025: _ErrCode_name[10276:10302],
-	40026: _ErrCode_name[10302:10325],
-	40027: _ErrCode_name[10325:10346],
-	40028: _ErrCode_name[10346:10366],
-	40029: _ErrCode_name[10366:10389],
-	40030: _ErrCode_name[10389:10412],
-	40031: _ErrCode_name[10412:10433],
-	40032: _ErrCode_name[10433:10454],
-	40033: _ErrCode_name[10454:10474],
-	40034: _ErrCode_name[10474:10496],
-	40035: _ErrCode_name[10496:10521],
-	40036: _ErrCode_name[10521:10546],
-	40037: _ErrCode_name[10546:10563],
-	40038: _ErrCode_name[10563:10582],
-	40039: _ErrCode_name[10582:10606],
-	40040: _ErrCode_name[10606:10631],
-	40041: _ErrCode_name[10631:10649],
-	40042: _ErrCode_name[10649:10672],
-	40043: _ErrCode_name[10672:10694],
-	40044: _ErrCode_name[10694:10718],
-	40045: _ErrCode_name[10718:10740],
-	40046: _ErrCode_name[10740:10761],
-	40047: _ErrCode_name[10761:10783],
-	40048: _ErrCode_name[10783:10801],
-	40049: _ErrCode_name[10801:10820],
-	40050: _ErrCode_name[10820:10841],
-	40051: _ErrCode_name[10841:10861],
-	40052: _ErrCode_name[10861:10882],
-	40053: _ErrCode_name[10882:10904],
-	40054: _ErrCode_name[10904:10925],
-	40055: _ErrCode_name[10925:10944],
-	40056: _ErrCode_name[10944:10966],
-	40057: _ErrCode_name[10966:10986],
-	40058: _ErrCode_name[10986:11007],
-	40059: _ErrCode_name[11007:11033],
-	40060: _ErrCode_name[11033:11051],
-	40061: _ErrCode_name[11051:11076],
-	40062: _ErrCode_name[11076:11099],
-	40063: _ErrCode_name[11099:11123],
-	40064: _ErrCode_name[11123:11148],
-	40065: _ErrCode_name[11148:11171],
-	40066: _ErrCode_name[11171:11191],
-	40067: _ErrCode_name[11191:11220],
-	40068: _ErrCode_name[11220:11240],
-	40069: _ErrCode_name[11240:11262],
-	40070: _ErrCode_name[11262:11275],
-	40071: _ErrCode_name[11275:11295],
-	40072: _ErrCode_name[11295:11315],
-	40073: _ErrCode_name[11315:11351],
-	40074: _ErrCode_name[11351:11386],
-	40075: _ErrCode_name[11386:11409],
-	40076: _ErrCode_name[11409:11432],
-	40077: _ErrCode_name[11432:11455],
-	40078: _ErrCode_name[11455:11481],
-	40079: _ErrCode_name[11481:11506],
-	40080: _ErrCode_name[11506:11530],
-	40081: _ErrCode_name[11530:11555],
-	40082: _ErrCode_name[11555:11579],
-	40083: _ErrCode_name[11579:11597],
-	42001: _ErrCode_name[11597:11615],
-	42002: _ErrCode_name[11615:11640],
-	42003: _ErrCode_name[11640:11663],
-	42004: _ErrCode_name[11663:11687],
-	42005: _ErrCode_name[11687:11711],
-	42006: _ErrCode_name[11711:11730],
-	42007: _ErrCode_name[11730:11750],
-	42008: _ErrCode_name[11750:11774],
-	42009: _ErrCode_name[11774:11797],
-	42010: _ErrCode_name[11797:11815],
-	42501: _ErrCode_name[11815:11833],
-	42502: _ErrCode_name[11833:11846],
-	42503: _ErrCode_name[11846:11861],
-	42504: _ErrCode_name[11861:11881],
-	42505: _ErrCode_name[11881:11896],
-	43001: _ErrCode_name[11896:11922],
-	43002: _ErrCode_name[11922:11942],
-	43003: _ErrCode_name[11942:11959],
-	43004: _ErrCode_name[11959:11983],
-	43005: _ErrCode_name[11983:12006],
-	43006: _ErrCode_name[12006:12023],
-	43007: _ErrCode_name[12023:12037],
-	43008: _ErrCode_name[12037:12060],
-	44001: _ErrCode_name[12060:12084],
-	44002: _ErrCode_name[12084:12115],
-	44003: _ErrCode_name[12115:12145],
-	44004: _ErrCode_name[12145:12173],
-	44005: _ErrCode_name[12173:12200],
-	44006: _ErrCode_name[12200:12226],
-	44007: _ErrCode_name[12226:12265],
-	44008: _ErrCode_name[12265:12304],
-	44009: _ErrCode_name[12304:12339],
-	44010: _ErrCode_name[12339:12367],
-	44011: _ErrCode_name[12367:12395],
-	44012: _ErrCode_name[12395:12412],
-	44013: _ErrCode_name[12412:12436],
-	44014: _ErrCode_name[12436:12462],
-	44015: _ErrCode_name[12462:12491],
-	44016: _ErrCode_name[12491:12530],
-	44017: _ErrCode_name[12530:12569],
-	44018: _ErrCode_name[12569:12607],
-	44019: _ErrCode_name[12607:12656],
-	44020: _ErrCode_name[12656:12677],
-	46001: _ErrCode_name[12677:12696],
-	46002: _ErrCode_name[12696:12712],
-	46003: _ErrCode_name[12712:12732],
-	46004: _ErrCode_name[12732:12755],
-	46005: _ErrCode_name[12755:12776],
-	46006: _ErrCode_name[12776:12803],
-	46007: _ErrCode_name[12803:12826],
-	46008: _ErrCode_name[12826:12852],
-	46009: _ErrCode_name[12852:12875],
-	46010: _ErrCode_name[12875:12901],
-	46011: _ErrCode_name[12901:12933],
-	46012: _ErrCode_name[12933:12966],
-	46013: _ErrCode_name[12966:12984],
-	46014: _ErrCode_name[12984:13005],
-	46015: _ErrCode_name[13005:13039],
-	46016: _ErrCode_name[13039:13069],
-	46017: _ErrCode_name[13069:13101],
-	46018: _ErrCode_name[13101:13122],
-	46019: _ErrCode_name[13122:13159],
-	46020: _ErrCode_name[13159:13184],
-	46021: _ErrCode_name[13184:13210],
-	46022: _ErrCode_name[13210:13241],
-	46023: _ErrCode_name[13241:13268],
-	46024: _ErrCode_name[13268:13287],
-	46025: _ErrCode_name[13287:13311],
-	46026: _ErrCode_name[13311:13336],
-	46027: _ErrCode_name[13336:13370],
-	46028: _ErrCode_name[13370:13400],
-	46029: _ErrCode_name[13400:13429],
-	46030: _ErrCode_name[13429:13455],
-	46031: _ErrCode_name[13455:13480],
-	46032: _ErrCode_name[13480:13515],
-	46033: _ErrCode_name[13515:13537],
-	46034: _ErrCode_name[13537:13561],
-	46035: _ErrCode_name[13561:13586],
-	48001: _ErrCode_name[13586:13603],
-	48002: _ErrCode_name[13603:13619],
-	48003: _ErrCode_name[13619:13632],
-	49001: _ErrCode_name[13632:13645],
-	49002: _ErrCode_name[13645:13670],
-	50000: _ErrCode_name[13670:13676],
+	20071: _ErrCode_name[4421:4447],
+	20072: _ErrCode_name[4447:4476],
+	20073: _ErrCode_name[4476:4499],
+	20074: _ErrCode_name[4499:4523],
+	20075: _ErrCode_name[4523:4548],
+	22001: _ErrCode_name[4548:4569],
+	22002: _ErrCode_name[4569:4590],
+	22003: _ErrCode_name[4590:4611],
+	24001: _ErrCode_name[4611:4636],
+	24002: _ErrCode_name[4636:4660],
+	24003: _ErrCode_name[4660:4686],
+	24004: _ErrCode_name[4686:4712],
+	24005: _ErrCode_name[4712:4741],
+	24006: _ErrCode_name[4741:4770],
+	26001: _ErrCode_name[4770:4792],
+	26002: _ErrCode_name[4792:4813],
+	26003: _ErrCode_name[4813:4836],
+	26004: _ErrCode_name[4836:4861],
+	26005: _ErrCode_name[4861:4885],
+	26006: _ErrCode_name[4885:4903],
+	26007: _ErrCode_name[4903:4918],
+	28001: _ErrCode_name[4918:4937],
+	28002: _ErrCode_name[4937:4957],
+	28003: _ErrCode_name[4957:4984],
+	28004: _ErrCode_name[4984:5007],
+	28005: _ErrCode_name[5007:5030],
+	30001: _ErrCode_name[5030:5053],
+	30002: _ErrCode_name[5053:5080],
+	30003: _ErrCode_name[5080:5097],
+	30004: _ErrCode_name[5097:5120],
+	30005: _ErrCode_name[5120:5138],
+	30006: _ErrCode_name[5138:5157],
+	30007: _ErrCode_name[5157:5177],
+	30008: _ErrCode_name[5177:5197],
+	30009: _ErrCode_name[5197:5219],
+	30010: _ErrCode_name[5219:5246],
+	30011: _ErrCode_name[5246:5266],
+	30012: _ErrCode_name[5266:5289],
+	30013: _ErrCode_name[5289:5310],
+	30014: _ErrCode_name[5310:5337],
+	30015: _ErrCode_name[5337:5359],
+	30016: _ErrCode_name[5359:5381],
+	30017: _ErrCode_name[5381:5408],
+	30018: _ErrCode_name[5408:5428],
+	30019: _ErrCode_name[5428:5448],
+	30020: _ErrCode_name[5448:5473],
+	30021: _ErrCode_name[5473:5504],
+	30022: _ErrCode_name[5504:5529],
+	30023: _ErrCode_name[5529:5551],
+	30024: _ErrCode_name[5551:5581],
+	30025: _ErrCode_name[5581:5603],
+	30026: _ErrCode_name[5603:5634],
+	30027: _ErrCode_name[5634:5664],
+	30028: _ErrCode_name[5664:5696],
+	30029: _ErrCode_name[5696:5722],
+	30030: _ErrCode_name[5722:5737],
+	30031: _ErrCode_name[5737:5768],
+	30032: _ErrCode_name[5768:5801],
+	30033: _ErrCode_name[5801:5811],
+	30034: _ErrCode_name[5811:5836],
+	30035: _ErrCode_name[5836:5862],
+	30036: _ErrCode_name[5862:5889],
+	30037: _ErrCode_name[5889:5910],
+	30038: _ErrCode_name[5910:5931],
+	30039: _ErrCode_name[5931:5956],
+	30040: _ErrCode_name[5956:5977],
+	30041: _ErrCode_name[5977:5996],
+	30042: _ErrCode_name[5996:6018],
+	30043: _ErrCode_name[6018:6039],
+	30044: _ErrCode_name[6039:6071],
+	30045: _ErrCode_name[6071:6095],
+	30046: _ErrCode_name[6095:6115],
+	30047: _ErrCode_name[6115:6135],
+	32001: _ErrCode_name[6135:6150],
+	32002: _ErrCode_name[6150:6172],
+	32003: _ErrCode_name[6172:6189],
+	32004: _ErrCode_name[6189:6207],
+	34001: _ErrCode_name[6207:6231],
+	34002: _ErrCode_name[6231:6256],
+	34003: _ErrCode_name[6256:6280],
+	34004: _ErrCode_name[6280:6303],
+	34005: _ErrCode_name[6303:6325],
+	34006: _ErrCode_name[6325:6347],
+	34007: _ErrCode_name[6347:6369],
+	34008: _ErrCode_name[6369:6396],
+	34009: _ErrCode_name[6396:6420],
+	34010: _ErrCode_name[6420:6442],
+	34011: _ErrCode_name[6442:6466],
+	34012: _ErrCode_name[6466:6482],
+	34013: _ErrCode_name[6482:6501],
+	34014: _ErrCode_name[6501:6524],
+	34015: _ErrCode_name[6524:6550],
+	34016: _ErrCode_name[6550:6567],
+	34017: _ErrCode_name[6567:6589],
+	34018: _ErrCode_name[6589:6611],
+	34019: _ErrCode_name[6611:6631],
+	34020: _ErrCode_name[6631:6650],
+	34021: _ErrCode_name[6650:6671],
+	36001: _ErrCode_name[6671:6686],
+	36002: _ErrCode_name[6686:6710],
+	36003: _ErrCode_name[6710:6732],
+	36004: _ErrCode_name[6732:6755],
+	36005: _ErrCode_name[6755:6781],
+	36006: _ErrCode_name[6781:6814],
+	36007: _ErrCode_name[6814:6838],
+	36008: _ErrCode_name[6838:6862],
+	36009: _ErrCode_name[6862:6890],
+	36010: _ErrCode_name[6890:6911],
+	36011: _ErrCode_name[6911:6940],
+	36012: _ErrCode_name[6940:6964],
+	36013: _ErrCode_name[6964:6989],
+	36014: _ErrCode_name[6989:7014],
+	36015: _ErrCode_name[7014:7041],
+	36016: _ErrCode_name[7041:7070],
+	36017: _ErrCode_name[7070:7089],
+	36018: _ErrCode_name[7089:7112],
+	36019: _ErrCode_name[7112:7144],
+	36020: _ErrCode_name[7144:7165],
+	36021: _ErrCode_name[7165:7190],
+	36022: _ErrCode_name[7190:7218],
+	36023: _ErrCode_name[7218:7241],
+	36024: _ErrCode_name[7241:7273],
+	36025: _ErrCode_name[7273:7302],
+	36026: _ErrCode_name[7302:7326],
+	36027: _ErrCode_name[7326:7353],
+	36028: _ErrCode_name[7353:7385],
+	36029: _ErrCode_name[7385:7417],
+	36030: _ErrCode_name[7417:7447],
+	36031: _ErrCode_name[7447:7471],
+	36032: _ErrCode_name[7471:7497],
+	36033: _ErrCode_name[7497:7522],
+	36034: _ErrCode_name[7522:7548],
+	36035: _ErrCode_name[7548:7578],
+	36036: _ErrCode_name[7578:7609],
+	36037: _ErrCode_name[7609:7642],
+	36038: _ErrCode_name[7642:7675],
+	36039: _ErrCode_name[7675:7705],
+	36040: _ErrCode_name[7705:7740],
+	36041: _ErrCode_name[7740:7774],
+	36042: _ErrCode_name[7774:7804],
+	36043: _ErrCode_name[7804:7838],
+	36044: _ErrCode_name[7838:7871],
+	36045: _ErrCode_name[7871:7907],
+	36046: _ErrCode_name[7907:7941],
+	36047: _ErrCode_name[7941:7968],
+	36048: _ErrCode_name[7968:7999],
+	36049: _ErrCode_name[7999:8026],
+	36050: _ErrCode_name[8026:8056],
+	36051: _ErrCode_name[8056:8084],
+	36052: _ErrCode_name[8084:8115],
+	36053: _ErrCode_name[8115:8147],
+	36054: _ErrCode_name[8147:8171],
+	36055: _ErrCode_name[8171:8200],
+	36056: _ErrCode_name[8200:8230],
+	36057: _ErrCode_name[8230:8262],
+	36058: _ErrCode_name[8262:8294],
+	36059: _ErrCode_name[8294:8325],
+	36060: _ErrCode_name[8325:8344],
+	36061: _ErrCode_name[8344:8369],
+	36062: _ErrCode_name[8369:8391],
+	36063: _ErrCode_name[8391:8406],
+	36064: _ErrCode_name[8406:8417],
+	36065: _ErrCode_name[8417:8439],
+	36066: _ErrCode_name[8439:8458],
+	36067: _ErrCode_name[8458:8472],
+	36068: _ErrCode_name[8472:8493],
+	36069: _ErrCode_name[8493:8507],
+	36070: _ErrCode_name[8507:8536],
+	36071: _ErrCode_name[8536:8567],
+	36072: _ErrCode_name[8567:8582],
+	36073: _ErrCode_name[8582:8603],
+	38001: _ErrCode_name[8603:8624],
+	38002: _ErrCode_name[8624:8645],
+	38003: _ErrCode_name[8645:8671],
+	38004: _ErrCode_name[8671:8691],
+	38005: _ErrCode_name[8691:8716],
+	38006: _ErrCode_name[8716:8737],
+	38007: _ErrCode_name[8737:8761],
+	38008: _ErrCode_name[8761:8783],
+	38009: _ErrCode_name[8783:8807],
+	38010: _ErrCode_name[8807:8831],
+	38011: _ErrCode_name[8831:8854],
+	38012: _ErrCode_name[8854:8877],
+	38013: _ErrCode_name[8877:8902],
+	38014: _ErrCode_name[8902:8926],
+	38015: _ErrCode_name[8926:8951],
+	38016: _ErrCode_name[8951:8972],
+	38017: _ErrCode_name[8972:8990],
+	38018: _ErrCode_name[8990:9007],
+	38019: _ErrCode_name[9007:9025],
+	38020: _ErrCode_name[9025:9046],
+	38021: _ErrCode_name[9046:9069],
+	38022: _ErrCode_name[9069:9092],
+	38023: _ErrCode_name[9092:9114],
+	38024: _ErrCode_name[9114:9132],
+	38025: _ErrCode_name[9132:9159],
+	38026: _ErrCode_name[9159:9183],
+	38027: _ErrCode_name[9183:9210],
+	38028: _ErrCode_name[9210:9235],
+	38029: _ErrCode_name[9235:9260],
+	38030: _ErrCode_name[9260:9283],
+	38031: _ErrCode_name[9283:9301],
+	38032: _ErrCode_name[9301:9325],
+	38033: _ErrCode_name[9325:9349],
+	38034: _ErrCode_name[9349:9369],
+	38035: _ErrCode_name[9369:9391],
+	38036: _ErrCode_name[9391:9412],
+	38037: _ErrCode_name[9412:9440],
+	38038: _ErrCode_name[9440:9464],
+	38039: _ErrCode_name[9464:9482],
+	38040: _ErrCode_name[9482:9505],
+	38041: _ErrCode_name[9505:9527],
+	38042: _ErrCode_name[9527:9554],
+	38043: _ErrCode_name[9554:9587],
+	38044: _ErrCode_name[9587:9610],
+	38045: _ErrCode_name[9610:9637],
+	38046: _ErrCode_name[9637:9662],
+	38047: _ErrCode_name[9662:9686],
+	38048: _ErrCode_name[9686:9710],
+	38049: _ErrCode_name[9710:9734],
+	38050: _ErrCode_name[9734:9765],
+	38051: _ErrCode_name[9765:9788],
+	38052: _ErrCode_name[9788:9807],
+	38053: _ErrCode_name[9807:9833],
+	38054: _ErrCode_name[9833:9870],
+	38055: _ErrCode_name[9870:9909],
+	38056: _ErrCode_name[9909:9947],
+	38057: _ErrCode_name[9947:9969],
+	38058: _ErrCode_name[9969:9984],
+	38059: _ErrCode_name[9984:10009],
+	38060: _ErrCode_name[10009:10035],
+	38061: _ErrCode_name[10035:10056],
+	38062: _ErrCode_name[10056:10078],
+	38063: _ErrCode_name[10078:10098],
+	38064: _ErrCode_name[10098:10121],
+	38065: _ErrCode_name[10121:10146],
+	40001: _ErrCode_name[10146:10164],
+	40002: _ErrCode_name[10164:10181],
+	40003: _ErrCode_name[10181:10207],
+	40004: _ErrCode_name[10207:10234],
+	40005: _ErrCode_name[10234:10252],
+	40006: _ErrCode_name[10252:10273],
+	40007: _ErrCode_name[10273:10294],
+	40008: _ErrCode_name[10294:10315],
+	40009: _ErrCode_name[10315:10338],
+	40010: _ErrCode_name[10338:10361],
+	40011: _ErrCode_name[10361:10382],
+	40012: _ErrCode_name[10382:10407],
+	40013: _ErrCode_name[10407:10428],
+	40014: _ErrCode_name[10428:10452],
+	40015: _ErrCode_name[10452:10477],
+	40016: _ErrCode_name[10477:10498],
+	40017: _ErrCode_name[10498:10517],
+	40018: _ErrCode_name[10517:10541],
+	40019: _ErrCode_name[10541:10564],
+	40020: _ErrCode_name[10564:10584],
+	40021: _ErrCode_name[10584:10601],
+	40022: _ErrCode_name[10601:10618],
+	40023: _ErrCode_name[10618:10639],
+	40024: _ErrCode_name[10639:10665],
+	40025: _ErrCode_name[10665:10691],
+	40026: _ErrCode_name[10691:10714],
+	40027: _ErrCode_name[10714:10735],
+	40028: _ErrCode_name[10735:10755],
+	40029: _ErrCode_name[10755:10778],
+	40030: _ErrCode_name[10778:10801],
+	40031: _ErrCode_name[10801:10822],
+	40032: _ErrCode_name[10822:10843],
+	40033: _ErrCode_name[10843:10863],
+	40034: _ErrCode_name[10863:10885],
+	40035: _ErrCode_name[10885:10910],
+	40036: _ErrCode_name[10910:10935],
+	40037: _ErrCode_name[10935:10952],
+	40038: _ErrCode_name[10952:10971],
+	40039: _ErrCode_name[10971:10995],
+	40040: _ErrCode_name[10995:11020],
+	40041: _ErrCode_name[11020:11038],
+	40042: _ErrCode_name[11038:11061],
+	40043: _ErrCode_name[11061:11083],
+	40044: _ErrCode_name[11083:11107],
+	40045: _ErrCode_name[11107:11129],
+	40046: _ErrCode_name[11129:11150],
+	40047: _ErrCode_name[11150:11172],
+	40048: _ErrCode_name[11172:11190],
+	40049: _ErrCode_name[11190:11209],
+	40050: _ErrCode_name[11209:11230],
+	40051: _ErrCode_name[11230:11250],
+	40052: _ErrCode_name[11250:11271],
+	40053: _ErrCode_name[11271:11293],
+	40054: _ErrCode_name[11293:11314],
+	40055: _ErrCode_name[11314:11333],
+	40056: _ErrCode_name[11333:11355],
+	40057: _ErrCode_name[11355:11375],
+	40058: _ErrCode_name[11375:11396],
+	40059: _ErrCode_name[11396:11422],
+	40060: _ErrCode_name[11422:11440],
+	40061: _ErrCode_name[11440:11465],
+	40062: _ErrCode_name[11465:11488],
+	40063: _ErrCode_name[11488:11512],
+	40064: _ErrCode_name[11512:11537],
+	40065: _ErrCode_name[11537:11560],
+	40066: _ErrCode_name[11560:11580],
+	40067: _ErrCode_name[11580:11609],
+	40068: _ErrCode_name[11609:11629],
+	40069: _ErrCode_name[11629:11651],
+	40070: _ErrCode_name[11651:11664],
+	40071: _ErrCode_name[11664:11684],
+	40072: _ErrCode_name[11684:11704],
+	40073: _ErrCode_name[11704:11740],
+	40074: _ErrCode_name[11740:11775],
+	40075: _ErrCode_name[11775:11798],
+	40076: _ErrCode_name[11798:11821],
+	40077: _ErrCode_name[11821:11844],
+	40078: _ErrCode_name[11844:11870],
+	40079: _ErrCode_name[11870:11895],
+	40080: _ErrCode_name[11895:11919],
+	40081: _ErrCode_name[11919:11944],
+	40082: _ErrCode_name[11944:11968],
+	40083: _ErrCode_name[11968:11986],
+	42001: _ErrCode_name[11986:12004],
+	42002: _ErrCode_name[12004:12029],
+	42003: _ErrCode_name[12029:12052],
+	42004: _ErrCode_name[12052:12076],
+	42005: _ErrCode_name[12076:12100],
+	42006: _ErrCode_name[12100:12119],
+	42007: _ErrCode_name[12119:12139],
+	42008: _ErrCode_name[12139:12163],
+	42009: _ErrCode_name[12163:12186],
+	42010: _ErrCode_name[12186:12204],
+	42501: _ErrCode_name[12204:12222],
+	42502: _ErrCode_name[12222:12235],
+	42503: _ErrCode_name[12235:12250],
+	42504: _ErrCode_name[12250:12270],
+	42505: _ErrCode_name[12270:12285],
+	43001: _ErrCode_name[12285:12311],
+	43002: _ErrCode_name[12311:12331],
+	43003: _ErrCode_name[12331:12348],
+	43004: _ErrCode_name[12348:12372],
+	43005: _ErrCode_name[12372:12395],
+	43006: _ErrCode_name[12395:12412],
+	43007: _ErrCode_name[12412:12426],
+	43008: _ErrCode_name[12426:12449],
+	43009: _ErrCode_name[12449:12469],
+	43010: _ErrCode_name[12469:12495],
+	44001: _ErrCode_name[12495:12519],
+	44002: _ErrCode_name[12519:12550],
+	44003: _ErrCode_name[12550:12580],
+	44004: _ErrCode_name[12580:12608],
+	44005: _ErrCode_name[12608:12635],
+	44006: _ErrCode_name[12635:12661],
+	44007: _ErrCode_name[12661:12700],
+	44008: _ErrCode_name[12700:12739],
+	44009: _ErrCode_name[12739:12774],
+	44010: _ErrCode_name[12774:12802],
+	44011: _ErrCode_name[12802:12830],
+	44012: _ErrCode_name[12830:12847],
+	44013: _ErrCode_name[12847:12871],
+	44014: _ErrCode_name[12871:12897],
+	44015: _ErrCode_name[12897:12926],
+	44016: _ErrCode_name[12926:12965],
+	44017: _ErrCode_name[12965:13004],
+	44018: _ErrCode_name[13004:13042],
+	44019: _ErrCode_name[13042:13091],
+	44020: _ErrCode_name[13091:13112],
+	46001: _ErrCode_name[13112:13131],
+	46002: _ErrCode_name[13131:13147],
+	46003: _ErrCode_name[13147:13167],
+	46004: _ErrCode_name[13167:13190],
+	46005: _ErrCode_name[13190:13211],
+	46006: _ErrCode_name[13211:13238],
+	46007: _ErrCode_name[13238:13261],
+	46008: _ErrCode_name[13261:13287],
+	46009: _ErrCode_name[13287:13310],
+	46010: _ErrCode_name[13310:13336],
+	46011: _ErrCode_name[13336:13368],
+	46012: _ErrCode_name[13368:13401],
+	46013: _ErrCode_name[13401:13419],
+	46014: _ErrCode_name[13419:13440],
+	46015: _ErrCode_name[13440:13474],
+	46016: _ErrCode_name[13474:13504],
+	46017: _ErrCode_name[13504:13536],
+	46018: _ErrCode_name[13536:13557],
+	46019: _ErrCode_name[13557:13594],
+	46020: _ErrCode_name[13594:13619],
+	46021: _ErrCode_name[13619:13645],
+	46022: _ErrCode_name[13645:13676],
+	46023: _ErrCode_name[13676:13703],
+	46024: _ErrCode_name[13703:13722],
+	46025: _ErrCode_name[13722:13746],
+	46026: _ErrCode_name[13746:13771],
+	46027: _ErrCode_name[13771:13805],
+	46028: _ErrCode_name[13805:13835],
+	46029: _ErrCode_name[13835:13864],
+	46030: _ErrCode_name[13864:13890],
+	46031: _ErrCode_name[13890:13915],
+	46032: _ErrCode_name[13915:13950],
+	46033: _ErrCode_name[13950:13972],
+	46034: _ErrCode_name[13972:13996],
+	46035: _ErrCode_name[13996:14021],
+	48001: _ErrCode_name[14021:14038],
+	48002: _ErrCode_name[14038:14054],
+	48003: _ErrCode_name[14054:14067],
+	49001: _ErrCode_name[14067:14080],
+	49002: _ErrCode_name[14080:14105],
+	50000: _ErrCode_name[14105:14111],
 }
 
 func (i ErrCode) String() string {
@@ -276,6 +276,11 @@ const (
 	codeConfigImportIntoShardingNotSupport
 	codeConfigImportIntoRequiresSharedStorage
 	codeConfigUnsupportedForeignKeyChecksOption
+	codeConfigTargetSinkNotSupport
+	codeConfigOfflineBinlogNotSupport
+	codeConfigInvalidApplyDelay
+	codeConfigInvalidDDLApproval
+	codeConfigInvalidStopBoundary
 )
 
 // Binlog operation error code list.
@@ -361,6 +366,9 @@ const (
 	codeRelayPurgeArgsNotValid
 	codePreviousGTIDsNotValid
 	codeRotateEventWithDifferentServerID
+	codeRelayImportOfflineBinlog
+	codeRelayArchiveRelayLog
+	codeRelayRestoreRelayLog
 )
 
 // Dump unit error code.
@@ -469,6 +477,8 @@ const (
 	codeSyncerGetEvent
 	codeSyncerDownstreamTableNotFound
 	codeSyncerReprocessWithSafeModeFail
+	codeSyncerWriteSink
+	codeSyncerDDLNeedApproval
 )
 
 // DM-master error code.
@@ -531,6 +541,13 @@ const (
 	codeMasterOptimisticDownstreamMetaNotFound
 	codeMasterInvalidClusterID
 	codeMasterStartTask
+	codeMasterAuthUnauthenticated
+	codeMasterAuthPermissionDenied
+	codeMasterAuthInvalidRole
+	codeMasterAuthUserNotExist
+	codeMasterAuthTokenExist
+	codeMasterAuthTokenNotExist
+	codeMasterLockNotWaitApproval
 )
 
 // DM-worker error code.
@@ -653,6 +670,8 @@ const (
 	codeValidatorNotFound
 	codeValidatorPanic
 	codeValidatorTooMuchPending
+	codeValidatorRepairError
+	codeValidatorRepairNotFinished
 )
 
 // Schema-tracker error code.
@@ -993,6 +1012,11 @@ var (
 	ErrConfigImportIntoShardingNotSupport       = New(codeConfigImportIntoShardingNotSupport, ClassConfig, ScopeInternal, LevelHigh, "import-into mode does not support sharding (multi-source) scenario", "Please use 'physical' or 'logical' mode for sharding scenarios, or disable sharding mode to use 'import-into'.")
 	ErrConfigImportIntoRequiresSharedStorage    = New(codeConfigImportIntoRequiresSharedStorage, ClassConfig, ScopeInternal, LevelHigh, "import-into mode requires shared storage (s3, gcs, azure, etc.) for loader's dir, but got local path '%s'", "Please use a shared storage URI like s3://bucket/path")
 	ErrConfigUnsupportedForeignKeyChecksOption  = New(codeConfigUnsupportedForeignKeyChecksOption, ClassConfig, ScopeInternal, LevelMedium, "`%s` is not supported when foreign_key_checks=1", "Please disable `foreign_key_checks`, or disable this syncer option in task configuration file.")
+	ErrConfigTargetSinkNotSupport               = New(codeConfigTargetSinkNotSupport, ClassConfig, ScopeInternal, LevelMedium, "`target-sink` is not supported %s", "Please remove `target-sink`, or adjust the task configuration file according to the message.")
+	ErrConfigOfflineBinlogNotSupport            = New(codeConfigOfflineBinlogNotSupport, ClassConfig, ScopeInternal, LevelMedium, "`offline-binlog` is not supported %s", "Please remove `offline-binlog`, or adjust the source configuration file according to the message.")
+	ErrConfigInvalidApplyDelay                  = New(codeConfigInvalidApplyDelay, ClassConfig, ScopeInternal, LevelMedium, "apply-delay '%s' is invalid: %v", "Please check the `apply-delay` is a non-negative duration like '30m' or '1h'.")
+	ErrConfigInvalidDDLApproval                 = New(codeConfigInvalidDDLApproval, ClassConfig, ScopeInternal, LevelMedium, "invalid `ddl-approval`: %s", "Please check the `sql-patterns` of `ddl-approval` are valid regular expressions.")
+	ErrConfigInvalidStopBoundary                = New(codeConfigInvalidStopBoundary, ClassConfig, ScopeInternal, LevelMedium, "invalid stop boundary of the task: %s", "Please specify at most one of `stop-time`, `stop-binlog-pos` and `stop-gtid`, and check its format.")
 
 	// Binlog operation error.
 	ErrBinlogExtractPosition = New(codeBinlogExtractPosition, ClassBinlogOp, ScopeInternal, LevelHigh, "", "")
@@ -1068,6 +1092,9 @@ var (
 	ErrRelayPurgeArgsNotValid            = New(codeRelayPurgeArgsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "args (%T) %+v not valid", "")
 	ErrPreviousGTIDsNotValid             = New(codePreviousGTIDsNotValid, ClassRelayUnit, ScopeInternal, LevelHigh, "previousGTIDs %s not valid", "")
 	ErrRotateEventWithDifferentServerID  = New(codeRotateEventWithDifferentServerID, ClassRelayUnit, ScopeInternal, LevelHigh, "receive fake rotate event with different server_id", "Please use `resume-relay` command if upstream database has changed")
+	ErrRelayImportOfflineBinlog          = New(codeRelayImportOfflineBinlog, ClassRelayUnit, ScopeUpstream, LevelHigh, "fail to import offline binlog file %s from %s", "Please check whether the binlog files in `offline-binlog` of source configuration file are accessible.")
+	ErrRelayArchiveRelayLog              = New(codeRelayArchiveRelayLog, ClassRelayUnit, ScopeInternal, LevelHigh, "fail to archive relay log file %s to %s", "Please check whether the `archive-uri` in `purge` of source configuration file is accessible.")
+	ErrRelayRestoreRelayLog              = New(codeRelayRestoreRelayLog, ClassRelayUnit, ScopeInternal, LevelHigh, "fail to restore archived relay log file %s from %s", "Please check whether the `archive-uri` in `purge` of source configuration file is accessible.")
 
 	// Dump unit error.
 	ErrDumpUnitRuntime        = New(codeDumpUnitRuntime, ClassDumpUnit, ScopeInternal, LevelHigh, "mydumper/dumpling runs with error, with output (may empty): %s", "")
@@ -1172,6 +1199,8 @@ var (
 	ErrSyncerDownstreamTableNotFound        = New(codeSyncerDownstreamTableNotFound, ClassSyncUnit, ScopeInternal, LevelHigh, "downstream table %s not found", "")
 	ErrSyncerCancelledDDL                   = New(codeSyncerCancelledDDL, ClassSyncUnit, ScopeInternal, LevelHigh, "DDL %s executed in background and met error", "Please manually check the error from TiDB and handle it.")
 	ErrSyncerReprocessWithSafeModeFail      = New(codeSyncerReprocessWithSafeModeFail, ClassSyncUnit, ScopeInternal, LevelMedium, "your `safe-mode-duration` in task.yaml is set to 0s, the task can't be re-processed without safe mode currently", "Please stop and re-start this task. If you want to start task successfully, you need set `safe-mode-duration` greater than `0s`.")
+	ErrSyncerWriteSink                      = New(codeSyncerWriteSink, ClassSyncUnit, ScopeDownstream, LevelHigh, "failed to write binlog events to sink %s", "Please check whether the sink is available, and check the `sink-uri` of `target-sink` in task configuration file.")
+	ErrSyncerDDLNeedApproval                = New(codeSyncerDDLNeedApproval, ClassSyncUnit, ScopeInternal, LevelMedium, "DDLs %v need approval before being executed", "Please use `binlog approve`, `binlog skip` or `binlog replace` to handle the DDLs, then the task will be resumed.")
 
 	// DM-master error.
 	ErrMasterSQLOpNilRequest        = New(codeMasterSQLOpNilRequest, ClassDMMaster, ScopeInternal, LevelMedium, "nil request not valid", "")
@@ -1237,6 +1266,13 @@ var (
 	ErrMasterOptimisticDownstreamMetaNotFound  = New(codeMasterOptimisticDownstreamMetaNotFound, ClassDMMaster, ScopeInternal, LevelHigh, "downstream database config and meta for task %s not found", "")
 	ErrMasterInvalidClusterID                  = New(codeMasterInvalidClusterID, ClassDMMaster, ScopeInternal, LevelHigh, "invalid cluster id: %v", "")
 	ErrMasterStartTask                         = New(codeMasterStartTask, ClassDMMaster, ScopeInternal, LevelHigh, "can not start task: %s reason: %s", "")
+	ErrMasterAuthUnauthenticated               = New(codeMasterAuthUnauthenticated, ClassDMMaster, ScopeInternal, LevelMedium, "authentication failed: %s", "Please provide a valid user and password or API token, e.g. `dmctl --user` or `dmctl --token`.")
+	ErrMasterAuthPermissionDenied              = New(codeMasterAuthPermissionDenied, ClassDMMaster, ScopeInternal, LevelMedium, "permission denied, %s with role %s is not allowed to %s", "Please use a user or API token with a higher role.")
+	ErrMasterAuthInvalidRole                   = New(codeMasterAuthInvalidRole, ClassDMMaster, ScopeInternal, LevelMedium, "invalid role %s, should be one of viewer, operator and admin", "")
+	ErrMasterAuthUserNotExist                  = New(codeMasterAuthUserNotExist, ClassDMMaster, ScopeInternal, LevelMedium, "user %s does not exist", "")
+	ErrMasterAuthTokenExist                    = New(codeMasterAuthTokenExist, ClassDMMaster, ScopeInternal, LevelMedium, "api token %s already exists", "Please delete it first or use another name.")
+	ErrMasterAuthTokenNotExist                 = New(codeMasterAuthTokenNotExist, ClassDMMaster, ScopeInternal, LevelMedium, "api token %s does not exist", "")
+	ErrMasterLockNotWaitApproval               = New(codeMasterLockNotWaitApproval, ClassDMMaster, ScopeInternal, LevelMedium, "lock %s is not waiting for DDL approval", "Please use show-ddl-locks command to check the lock has synced, and query-status command to see the DDLs waiting for approval.")
 
 	// DM-worker error.
 	ErrWorkerParseFlagSet            = New(codeWorkerParseFlagSet, ClassDMWorker, ScopeInternal, LevelMedium, "parse dm-worker config flag set", "")
@@ -1352,6 +1388,8 @@ var (
 	ErrValidatorNotFound          = New(codeValidatorNotFound, ClassValidator, ScopeNotSet, LevelMedium, "validator not found for task %s with source %s", "")
 	ErrValidatorPanic             = New(codeValidatorPanic, ClassValidator, ScopeInternal, LevelHigh, "panic error: %v", "")
 	ErrValidatorTooMuchPending    = New(codeValidatorTooMuchPending, ClassValidator, ScopeInternal, LevelMedium, "too much pending data, stop validator. row size(curr/max): %d/%d, row count(curr/max): %d/%d", "")
+	ErrValidatorRepairError       = New(codeValidatorRepairError, ClassValidator, ScopeInternal, LevelHigh, "failed to repair validation error row %d", "")
+	ErrValidatorRepairNotFinished = New(codeValidatorRepairNotFinished, ClassValidator, ScopeInternal, LevelMedium, "only %d of %d validation error rows are repaired in time", "Please repair the remaining validation error rows again.")
 
 	// Schema-tracker error.
 	ErrSchemaTrackerInvalidJSON        = New(codeSchemaTrackerInvalidJSON, ClassSchemaTracker, ScopeDownstream, LevelHigh, "saved schema of `%s`.`%s` is not proper JSON", "")
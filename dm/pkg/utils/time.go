@@ -15,6 +15,7 @@ package utils
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
 	"time"
@@ -94,3 +95,15 @@ func ParseStartTimeInLoc(timeStr string, loc *time.Location) (time.Time, error)
 
 	return t.GoTime(loc)
 }
+
+// EstimateRemainingSeconds estimates the seconds needed to finish the rest of
+// the work at the given speed. It returns -1 when the estimation is unknown.
+func EstimateRemainingSeconds(finished, total, speed float64) int64 {
+	if total <= 0 || speed <= 0 {
+		return -1
+	}
+	if finished >= total {
+		return 0
+	}
+	return int64(math.Ceil((total - finished) / speed))
+}
@@ -65,3 +65,11 @@ func TestParseStartTimeInLoc(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, time.Date(2026, 4, 17, 0, 0, 0, 0, time.UTC).Unix(), absoluteWithoutColon.Unix())
 }
+
+func TestEstimateRemainingSeconds(t *testing.T) {
+	require.Equal(t, int64(-1), EstimateRemainingSeconds(10, 0, 10))
+	require.Equal(t, int64(-1), EstimateRemainingSeconds(10, 100, 0))
+	require.Equal(t, int64(0), EstimateRemainingSeconds(120, 100, 10))
+	require.Equal(t, int64(9), EstimateRemainingSeconds(10, 100, 10))
+	require.Equal(t, int64(4), EstimateRemainingSeconds(0, 100, 30))
+}
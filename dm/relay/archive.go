@@ -0,0 +1,269 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tidb/pkg/objstore/storeapi"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// archiveBufferSize is the size of the buffer used when uploading a relay log file.
+const archiveBufferSize = 4 * 1024 * 1024
+
+// relayArchiver archives the closed relay log files to a local directory or an external
+// storage, so they are still available after being purged from the local disk.
+//
+// the relay log files are archived as <uri>/<sub dir>/<filename>, which is the same
+// layout as the relay directory, so the archive can also be copied back manually.
+type relayArchiver struct {
+	mu sync.Mutex // archiving in the background and purging manually may happen concurrently
+
+	uri          string
+	baseRelayDir string
+
+	// archived records the sizes of the archived relay log files, keyed by <sub dir>/<filename>.
+	// it's loaded from the archive lazily.
+	archived map[string]int64
+
+	logger log.Logger
+}
+
+func newRelayArchiver(uri, baseRelayDir string) *relayArchiver {
+	return &relayArchiver{
+		uri:          uri,
+		baseRelayDir: baseRelayDir,
+		logger:       log.With(zap.String("component", "relay archiver")),
+	}
+}
+
+// archive uploads the closed relay log files in subDirs which are not archived yet.
+// the newest relay log file in the last sub directory may be still being written, so it's skipped.
+// a relay log file is archived again if its size changed since the last archiving.
+func (a *relayArchiver) archive(ctx context.Context, subDirs []string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	store, err := storage.CreateStorage(ctx, a.uri)
+	if err != nil {
+		return terror.ErrRelayArchiveRelayLog.Delegate(err, "", a.uri)
+	}
+	defer store.Close()
+
+	if a.archived == nil {
+		archived, err2 := collectArchivedRelayFiles(ctx, store)
+		if err2 != nil {
+			return terror.ErrRelayArchiveRelayLog.Delegate(err2, "", a.uri)
+		}
+		a.archived = archived
+	}
+
+	for i, subDir := range subDirs {
+		dir := filepath.Join(a.baseRelayDir, subDir)
+		if !utils.IsDirExists(dir) {
+			continue // all relay log files in it have been purged
+		}
+		files, err2 := CollectAllBinlogFiles(dir)
+		if err2 != nil {
+			return terror.Annotatef(err2, "dir %s", dir)
+		}
+		if i == len(subDirs)-1 && len(files) > 0 {
+			files = files[:len(files)-1]
+		}
+
+		for _, f := range files {
+			fullPath := filepath.Join(dir, f)
+			fs, err2 := os.Stat(fullPath)
+			if err2 != nil {
+				return terror.ErrGetRelayLogStat.Delegate(err2, fullPath)
+			}
+			name := path.Join(subDir, f)
+			if size, ok := a.archived[name]; ok && size == fs.Size() {
+				continue
+			}
+			if err2 = uploadFile(ctx, store, fullPath, name); err2 != nil {
+				return terror.ErrRelayArchiveRelayLog.Delegate(err2, fullPath, a.uri)
+			}
+			a.archived[name] = fs.Size()
+			a.logger.Info("archived relay log file", zap.String("file", fullPath), zap.Int64("size", fs.Size()))
+		}
+	}
+	return nil
+}
+
+// restoreArchivedRelayFiles downloads the archived relay log files in subDirs which don't
+// exist in baseRelayDir. the files older than fromFile in fromSubDir and the files in the
+// sub directories older than fromSubDir are not restored, an empty fromSubDir means
+// restoring all the archived relay log files in subDirs.
+// it returns the number of restored relay log files.
+func restoreArchivedRelayFiles(
+	ctx context.Context,
+	logger log.Logger,
+	uri, baseRelayDir string,
+	subDirs []string,
+	fromSubDir, fromFile string,
+) (int, error) {
+	startIdx := 0
+	if len(fromSubDir) > 0 {
+		startIdx = -1
+		for i, subDir := range subDirs {
+			if subDir == fromSubDir {
+				startIdx = i
+				break
+			}
+		}
+		if startIdx < 0 {
+			return 0, nil
+		}
+	}
+
+	store, err := storage.CreateStorage(ctx, uri)
+	if err != nil {
+		return 0, terror.ErrRelayRestoreRelayLog.Delegate(err, "", uri)
+	}
+	defer store.Close()
+
+	archived, err := collectArchivedRelayFiles(ctx, store)
+	if err != nil {
+		return 0, terror.ErrRelayRestoreRelayLog.Delegate(err, "", uri)
+	}
+
+	restored := 0
+	for _, subDir := range subDirs[startIdx:] {
+		files := make([]string, 0)
+		for name := range archived {
+			dir, f := path.Split(name)
+			if strings.TrimSuffix(dir, "/") != subDir {
+				continue
+			}
+			if subDir == fromSubDir && mysql.CompareBinlogFileName(f, fromFile) < 0 {
+				continue
+			}
+			files = append(files, f)
+		}
+		if len(files) == 0 {
+			continue
+		}
+		sort.Slice(files, func(i, j int) bool {
+			return mysql.CompareBinlogFileName(files[i], files[j]) < 0
+		})
+
+		dir := filepath.Join(baseRelayDir, subDir)
+		if err = os.MkdirAll(dir, 0o700); err != nil {
+			return restored, terror.ErrRelayMkdir.Delegate(err)
+		}
+		for _, f := range files {
+			fullPath := filepath.Join(dir, f)
+			if utils.IsFileExists(fullPath) {
+				continue
+			}
+			name := path.Join(subDir, f)
+			if err = downloadFile(ctx, store, name, fullPath); err != nil {
+				return restored, terror.ErrRelayRestoreRelayLog.Delegate(err, name, uri)
+			}
+			restored++
+			logger.Info("restored archived relay log file", zap.String("file", fullPath))
+		}
+	}
+	return restored, nil
+}
+
+// collectArchivedRelayFiles collects the archived relay log files and their sizes,
+// keyed by <sub dir>/<filename>.
+func collectArchivedRelayFiles(ctx context.Context, store storeapi.Storage) (map[string]int64, error) {
+	archived := make(map[string]int64)
+	err := store.WalkDir(ctx, &storeapi.WalkOption{}, func(filePath string, size int64) error {
+		dir, f := path.Split(filePath)
+		if len(dir) == 0 || strings.Contains(strings.TrimSuffix(dir, "/"), "/") || !utils.VerifyFilename(f) {
+			return nil
+		}
+		archived[filePath] = size
+		return nil
+	})
+	return archived, err
+}
+
+// uploadFile uploads the local file fullPath to the storage as name.
+func uploadFile(ctx context.Context, store storeapi.Storage, fullPath, name string) error {
+	f, err := os.Open(fullPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	writer, err := store.Create(ctx, name, nil)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, archiveBufferSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			if _, err2 := writer.Write(ctx, buf[:n]); err2 != nil {
+				_ = writer.Close(ctx)
+				return err2
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = writer.Close(ctx)
+			return err
+		}
+	}
+	// the file is uploaded completely only after the writer is closed.
+	return writer.Close(ctx)
+}
+
+// downloadFile downloads name in the storage to the local file fullPath.
+// it writes to a temporary file which is not a valid relay log filename first, so the
+// binlog readers never read a partially downloaded file.
+func downloadFile(ctx context.Context, store storeapi.Storage, name, fullPath string) error {
+	reader, err := store.Open(ctx, name, nil)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	tmpPath := fullPath + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, reader)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, fullPath)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+	}
+	return err
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pingcap/check"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+)
+
+var _ = check.Suite(&testArchiveSuite{})
+
+type testArchiveSuite struct{}
+
+func (t *testArchiveSuite) TestArchiveAndRestore(c *check.C) {
+	ctx := context.Background()
+	relayDir := c.MkDir()
+	archiveDir := c.MkDir()
+	subDirs := []string{
+		"85ab69d1-b21f-11e6-9c5e-64006a8978d2.000001",
+		"85ab69d1-b21f-11e6-9c5e-64006a8978d2.000002",
+	}
+	writeFile := func(subDir, name, content string) {
+		dir := filepath.Join(relayDir, subDir)
+		c.Assert(os.MkdirAll(dir, 0o700), check.IsNil)
+		c.Assert(os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600), check.IsNil)
+	}
+	writeFile(subDirs[0], "mysql-bin.000001", "binlog-1")
+	writeFile(subDirs[0], "mysql-bin.000002", "binlog-2")
+	writeFile(subDirs[1], "mysql-bin.000001", "binlog-3")
+	writeFile(subDirs[1], "mysql-bin.000002", "binlog-4")
+	writeFile(subDirs[1], "relay.meta", "meta")
+
+	// the newest relay log file is not archived.
+	archiver := newRelayArchiver(archiveDir, relayDir)
+	c.Assert(archiver.archive(ctx, subDirs), check.IsNil)
+	c.Assert(archiver.archived, check.DeepEquals, map[string]int64{
+		subDirs[0] + "/mysql-bin.000001": 8,
+		subDirs[0] + "/mysql-bin.000002": 8,
+		subDirs[1] + "/mysql-bin.000001": 8,
+	})
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, subDirs[1], "mysql-bin.000002")), check.IsFalse)
+	c.Assert(utils.IsFileExists(filepath.Join(archiveDir, subDirs[1], "relay.meta")), check.IsFalse)
+
+	// a new relay log file is written, the archived files are loaded from the archive.
+	writeFile(subDirs[1], "mysql-bin.000003", "binlog-5")
+	archiver = newRelayArchiver(archiveDir, relayDir)
+	c.Assert(archiver.archive(ctx, subDirs), check.IsNil)
+	c.Assert(archiver.archived, check.HasLen, 4)
+	content, err := os.ReadFile(filepath.Join(archiveDir, subDirs[1], "mysql-bin.000002"))
+	c.Assert(err, check.IsNil)
+	c.Assert(string(content), check.Equals, "binlog-4")
+
+	// purge the relay log files.
+	c.Assert(os.RemoveAll(filepath.Join(relayDir, subDirs[0])), check.IsNil)
+	c.Assert(os.Remove(filepath.Join(relayDir, subDirs[1], "mysql-bin.000001")), check.IsNil)
+
+	// only the files not older than the given file are restored.
+	restored, err := restoreArchivedRelayFiles(ctx, log.L(), archiveDir, relayDir, subDirs, subDirs[0], "mysql-bin.000002")
+	c.Assert(err, check.IsNil)
+	c.Assert(restored, check.Equals, 2)
+	c.Assert(utils.IsFileExists(filepath.Join(relayDir, subDirs[0], "mysql-bin.000001")), check.IsFalse)
+	content, err = os.ReadFile(filepath.Join(relayDir, subDirs[0], "mysql-bin.000002"))
+	c.Assert(err, check.IsNil)
+	c.Assert(string(content), check.Equals, "binlog-2")
+	content, err = os.ReadFile(filepath.Join(relayDir, subDirs[1], "mysql-bin.000001"))
+	c.Assert(err, check.IsNil)
+	c.Assert(string(content), check.Equals, "binlog-3")
+
+	// restore all archived files.
+	restored, err = restoreArchivedRelayFiles(ctx, log.L(), archiveDir, relayDir, subDirs, "", "")
+	c.Assert(err, check.IsNil)
+	c.Assert(restored, check.Equals, 1)
+	c.Assert(utils.IsFileExists(filepath.Join(relayDir, subDirs[0], "mysql-bin.000001")), check.IsTrue)
+
+	// unknown sub directory.
+	restored, err = restoreArchivedRelayFiles(ctx, log.L(), archiveDir, relayDir, subDirs, "unknown.000001", "mysql-bin.000001")
+	c.Assert(err, check.IsNil)
+	c.Assert(restored, check.Equals, 0)
+}
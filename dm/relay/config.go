@@ -38,6 +38,13 @@ type Config struct {
 	BinlogGTID string `toml:"binlog-gtid" json:"binlog-gtid"`
 	UUIDSuffix int    `toml:"-" json:"-"`
 
+	// OfflineBinlog is set when the binlog files are imported instead of pulled from `From`.
+	OfflineBinlog *config.OfflineBinlogConfig `toml:"offline-binlog" json:"offline-binlog"`
+
+	// ArchiveURI is where the purged relay log files are archived, binlog readers restore
+	// the relay log files from it if they are purged from the local disk.
+	ArchiveURI string `toml:"archive-uri" json:"archive-uri"`
+
 	// for binlog reader retry
 	ReaderRetry ReaderRetryConfig `toml:"reader-retry" json:"reader-retry"`
 }
@@ -54,15 +61,17 @@ func (c *Config) String() string {
 func FromSourceCfg(sourceCfg *config.SourceConfig) *Config {
 	clone := sourceCfg.GetDecryptedClone()
 	cfg := &Config{
-		EnableGTID: clone.EnableGTID,
-		Flavor:     clone.Flavor,
-		RelayDir:   clone.RelayDir,
-		ServerID:   clone.ServerID,
-		Charset:    clone.Charset,
-		From:       clone.From,
-		BinLogName: clone.RelayBinLogName,
-		BinlogGTID: clone.RelayBinlogGTID,
-		UUIDSuffix: clone.UUIDSuffix,
+		EnableGTID:    clone.EnableGTID,
+		Flavor:        clone.Flavor,
+		RelayDir:      clone.RelayDir,
+		ServerID:      clone.ServerID,
+		Charset:       clone.Charset,
+		From:          clone.From,
+		BinLogName:    clone.RelayBinLogName,
+		BinlogGTID:    clone.RelayBinlogGTID,
+		UUIDSuffix:    clone.UUIDSuffix,
+		OfflineBinlog: clone.OfflineBinlog,
+		ArchiveURI:    clone.Purge.ArchiveURI,
 		ReaderRetry: ReaderRetryConfig{ // we use config from TaskChecker now
 			BackoffRollback: clone.Checker.BackoffRollback.Duration,
 			BackoffMax:      clone.Checker.BackoffMax.Duration,
@@ -47,6 +47,8 @@ type BinlogReaderConfig struct {
 	Timezone            *time.Location
 	Flavor              string
 	RowsEventDecodeFunc func(*replication.RowsEvent, []byte) error
+	// ArchiveURI is where the purged relay log files are archived, set by the relay unit.
+	ArchiveURI string
 }
 
 // BinlogReader is a binlog reader.
@@ -123,6 +125,28 @@ func (r *BinlogReader) checkRelayPos(pos mysql.Position) error {
 	return nil
 }
 
+// restoreArchivedRelayLogs restores the relay log files needed to read from pos from the
+// archive, if they have been purged from the local disk.
+func (r *BinlogReader) restoreArchivedRelayLogs(pos mysql.Position) error {
+	if len(r.cfg.ArchiveURI) == 0 {
+		return nil
+	}
+	subDir, _, realPos, err := binlog.ExtractPos(pos, r.subDirs)
+	if err != nil {
+		return terror.Annotatef(err, "parse relay dir with pos %s", pos)
+	}
+	if utils.IsFileExists(path.Join(r.cfg.RelayDir, subDir, realPos.Name)) {
+		// relay log files are purged from the oldest one, so the later ones exist too.
+		return nil
+	}
+	restored, err := restoreArchivedRelayFiles(r.tctx.Ctx, r.tctx.L(), r.cfg.ArchiveURI, r.cfg.RelayDir, r.subDirs, subDir, realPos.Name)
+	if err != nil {
+		return err
+	}
+	r.tctx.L().Info("restored archived relay log files", zap.Stringer("position", pos), zap.Int("count", restored))
+	return nil
+}
+
 // IsGTIDCoverPreviousFiles check whether gset contains file's previous_gset.
 func (r *BinlogReader) IsGTIDCoverPreviousFiles(ctx context.Context, filePath string, gset mysql.GTIDSet) (bool, error) {
 	fileReader := reader.NewFileReader(&reader.FileReaderConfig{Timezone: r.cfg.Timezone})
@@ -228,6 +252,10 @@ func (r *BinlogReader) StartSyncByPos(pos mysql.Position) (reader.Streamer, erro
 	if err != nil {
 		return nil, err
 	}
+	err = r.restoreArchivedRelayLogs(pos)
+	if err != nil {
+		return nil, err
+	}
 	err = r.checkRelayPos(pos)
 	if err != nil {
 		return nil, err
@@ -267,6 +295,16 @@ func (r *BinlogReader) StartSyncByGTID(gset mysql.GTIDSet) (reader.Streamer, err
 	}
 
 	pos, err := r.getPosByGTID(gset)
+	if err != nil && len(r.cfg.ArchiveURI) > 0 {
+		// the relay log files containing gset may have been purged, restore them and try again.
+		restored, err2 := restoreArchivedRelayFiles(r.tctx.Ctx, r.tctx.L(), r.cfg.ArchiveURI, r.cfg.RelayDir, r.subDirs, "", "")
+		if err2 != nil {
+			return nil, err2
+		}
+		if restored > 0 {
+			pos, err = r.getPosByGTID(gset)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
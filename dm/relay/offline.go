@@ -0,0 +1,223 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/objstore/storeapi"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/unit"
+	"go.uber.org/zap"
+)
+
+// offlineBinlogCheckInterval is the interval to check new binlog files of an offline source.
+var offlineBinlogCheckInterval = 10 * time.Second
+
+// OfflineRelay is the relay log unit of an offline source. Instead of pulling binlog
+// events from the upstream server, it imports the binlog files in a directory or an
+// external storage as relay log files, so they can be read by BinlogReader and the
+// binlog position finder in the same way as relay logs.
+//
+// Binlog files newly added to the directory are imported periodically, the binlog
+// file imported last time is imported again if its size grows.
+type OfflineRelay struct {
+	*Relay
+}
+
+var _ Process = &OfflineRelay{}
+
+// NewOfflineRelay creates an instance of OfflineRelay.
+func NewOfflineRelay(cfg *Config) Process {
+	return &OfflineRelay{Relay: NewRealRelay(cfg).(*Relay)}
+}
+
+// Process implements the dm.Unit interface.
+func (r *OfflineRelay) Process(ctx context.Context) pb.ProcessResult {
+	relayExitWithErrorCounter.WithLabelValues("true").Add(0)
+	relayExitWithErrorCounter.WithLabelValues("false").Add(0)
+	err := r.process(ctx)
+	if err != nil {
+		r.logger.Error("process exit", zap.Error(err))
+		processError := unit.NewProcessError(err)
+		resumable := fmt.Sprintf("%t", unit.IsResumableRelayError(processError))
+		relayExitWithErrorCounter.WithLabelValues(resumable).Inc()
+		return pb.ProcessResult{Errors: []*pb.ProcessError{processError}}
+	}
+	return pb.ProcessResult{IsCanceled: true}
+}
+
+func (r *OfflineRelay) process(ctx context.Context) error {
+	if err := os.MkdirAll(r.cfg.RelayDir, 0o700); err != nil {
+		return terror.ErrRelayMkdir.Delegate(err)
+	}
+	if err := r.setUpMeta(); err != nil {
+		return err
+	}
+
+	store, err := storage.CreateStorage(ctx, r.cfg.OfflineBinlog.Dir)
+	if err != nil {
+		return terror.ErrRelayImportOfflineBinlog.Delegate(err, "", r.cfg.OfflineBinlog.Dir)
+	}
+	defer store.Close()
+
+	ticker := time.NewTicker(offlineBinlogCheckInterval)
+	defer ticker.Stop()
+	for {
+		if err = r.importBinlogFiles(ctx, store); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// setUpMeta makes sure the relay log subdirectory of the offline source exists.
+func (r *OfflineRelay) setUpMeta() error {
+	if err := r.meta.Load(); err != nil {
+		return err
+	}
+	if r.cfg.UUIDSuffix > 0 {
+		// bound to a new source, clear all relay logs and meta.
+		if err := r.PurgeRelayDir(); err != nil {
+			return err
+		}
+		r.ResetMeta()
+	} else if len(r.meta.SubDir()) > 0 {
+		return nil
+	}
+
+	err := r.meta.AddDir(r.cfg.OfflineBinlog.ServerUUID, nil, nil, r.cfg.UUIDSuffix)
+	if err != nil {
+		return err
+	}
+	r.cfg.UUIDSuffix = 0
+	r.updateMetricsRelaySubDirIndex()
+	return r.meta.Load()
+}
+
+// importBinlogFiles imports the binlog files which are newer than the relay meta,
+// and updates the relay meta to the end of the last imported file.
+func (r *OfflineRelay) importBinlogFiles(ctx context.Context, store storeapi.Storage) error {
+	sizes := make(map[string]int64)
+	err := store.WalkDir(ctx, &storeapi.WalkOption{}, func(filePath string, size int64) error {
+		// only the binlog files in the top level directory are imported.
+		if !strings.Contains(filePath, "/") && utils.VerifyFilename(filePath) {
+			sizes[filePath] = size
+		}
+		return nil
+	})
+	if err != nil {
+		return terror.ErrRelayImportOfflineBinlog.Delegate(err, "", r.cfg.OfflineBinlog.Dir)
+	}
+	names := make([]string, 0, len(sizes))
+	for name := range sizes {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return mysql.CompareBinlogFileName(names[i], names[j]) < 0
+	})
+
+	_, lastPos := r.meta.Pos()
+	_, lastGTID := r.meta.GTID()
+	imported := false
+	for _, name := range names {
+		if len(lastPos.Name) > 0 {
+			cmp := mysql.CompareBinlogFileName(name, lastPos.Name)
+			if cmp < 0 || (cmp == 0 && sizes[name] <= int64(lastPos.Pos)) {
+				continue
+			}
+		}
+		if err = r.importBinlogFile(ctx, store, name); err != nil {
+			return err
+		}
+		r.logger.Info("imported offline binlog file", zap.String("file", name), zap.Int64("size", sizes[name]))
+		lastPos = mysql.Position{Name: name, Pos: uint32(sizes[name])}
+		imported = true
+	}
+	if !imported {
+		return nil
+	}
+
+	if r.cfg.EnableGTID {
+		if lastGTID, err = r.importedGTIDSet(ctx, lastPos.Name); err != nil {
+			return err
+		}
+	}
+	if err = r.saveAndFlushMeta(lastPos, lastGTID); err != nil {
+		return err
+	}
+	r.setActiveRelayLog(lastPos.Name)
+	// wake up the binlog readers which are waiting for new relay logs.
+	r.notify(nil)
+	return nil
+}
+
+// importedGTIDSet returns the GTID set of all the imported binlog files, i.e.
+// the previous GTIDs of the last imported file together with the GTIDs of the
+// transactions completed in it.
+func (r *OfflineRelay) importedGTIDSet(ctx context.Context, name string) (mysql.GTIDSet, error) {
+	_, gset, err := getTxnPosGTIDs(ctx, filepath.Join(r.meta.Dir(), name), parser.New())
+	if err != nil {
+		return nil, terror.Annotatef(err, "get GTID set from imported binlog file %s", name)
+	}
+	if gset == nil {
+		return nil, terror.ErrRelayImportOfflineBinlog.Delegate(
+			errors.New("no GTID set in the binlog file while `enable-gtid` is true"), name, r.cfg.OfflineBinlog.Dir)
+	}
+	return gset, nil
+}
+
+func (r *OfflineRelay) importBinlogFile(ctx context.Context, store storeapi.Storage, name string) error {
+	err := downloadFile(ctx, store, name, filepath.Join(r.meta.Dir(), name))
+	if err != nil {
+		return terror.ErrRelayImportOfflineBinlog.Delegate(err, name, r.cfg.OfflineBinlog.Dir)
+	}
+	return nil
+}
+
+// Location returns the end location of the imported binlog files, which is taken as
+// the latest location of the offline source.
+func (r *OfflineRelay) Location() binlog.Location {
+	r.RLock()
+	defer r.RUnlock()
+	_, pos := r.meta.Pos()
+	_, gset := r.meta.GTID()
+	return binlog.NewLocation(pos, gset)
+}
+
+// Reload implements Process.Reload. There is no upstream database to reconnect for
+// an offline source.
+func (r *OfflineRelay) Reload(newCfg *Config) error {
+	r.Lock()
+	defer r.Unlock()
+	r.cfg.OfflineBinlog = newCfg.OfflineBinlog
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	gmysql "github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/check"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/storage"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+)
+
+var _ = check.Suite(&testOfflineRelaySuite{})
+
+type testOfflineRelaySuite struct{}
+
+func (t *testOfflineRelaySuite) TestImportBinlogFiles(c *check.C) {
+	ctx := context.Background()
+	binlogDir := c.MkDir()
+	writeFile := func(name, content string) {
+		c.Assert(os.WriteFile(filepath.Join(binlogDir, name), []byte(content), 0o600), check.IsNil)
+	}
+	writeFile("mysql-bin.000001", "binlog-1")
+	writeFile("mysql-bin.000002", "binlog-2")
+	// not binlog files
+	writeFile("mysql-bin.index", "index")
+	writeFile("README", "readme")
+
+	cfg := &Config{
+		Flavor:   gmysql.MySQLFlavor,
+		RelayDir: c.MkDir(),
+		OfflineBinlog: &config.OfflineBinlogConfig{
+			Dir:        binlogDir,
+			ServerUUID: "85ab69d1-b21f-11e6-9c5e-64006a8978d2",
+		},
+	}
+	r := NewOfflineRelay(cfg).(*OfflineRelay)
+	c.Assert(r.setUpMeta(), check.IsNil)
+	c.Assert(r.meta.SubDir(), check.Equals, "85ab69d1-b21f-11e6-9c5e-64006a8978d2.000001")
+
+	store, err := storage.CreateStorage(ctx, binlogDir)
+	c.Assert(err, check.IsNil)
+	defer store.Close()
+
+	c.Assert(r.importBinlogFiles(ctx, store), check.IsNil)
+	relaySubDir := r.meta.Dir()
+	files, err := os.ReadDir(relaySubDir)
+	c.Assert(err, check.IsNil)
+	names := make([]string, 0, len(files))
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	c.Assert(names, check.DeepEquals, []string{"mysql-bin.000001", "mysql-bin.000002", "relay.meta"})
+	c.Assert(r.Location().Position, check.DeepEquals, gmysql.Position{Name: "mysql-bin.000002", Pos: 8})
+
+	// the last binlog file grows and a new binlog file is added.
+	writeFile("mysql-bin.000002", "binlog-2-grows")
+	writeFile("mysql-bin.000003", "binlog-3")
+	c.Assert(r.importBinlogFiles(ctx, store), check.IsNil)
+	content, err := os.ReadFile(filepath.Join(relaySubDir, "mysql-bin.000002"))
+	c.Assert(err, check.IsNil)
+	c.Assert(string(content), check.Equals, "binlog-2-grows")
+	c.Assert(r.Location().Position, check.DeepEquals, gmysql.Position{Name: "mysql-bin.000003", Pos: 8})
+
+	// the relay logs are kept after restarted.
+	r = NewOfflineRelay(cfg).(*OfflineRelay)
+	c.Assert(r.setUpMeta(), check.IsNil)
+	c.Assert(r.meta.SubDir(), check.Equals, "85ab69d1-b21f-11e6-9c5e-64006a8978d2.000001")
+	c.Assert(r.Location().Position, check.DeepEquals, gmysql.Position{Name: "mysql-bin.000003", Pos: 8})
+}
+
+func (t *testOfflineRelaySuite) TestImportBinlogFilesWithGTID(c *check.C) {
+	ctx := context.Background()
+	binlogDir := c.MkDir()
+	flavor := gmysql.MySQLFlavor
+	previousGTIDSet, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14,53bfca22-690d-11e7-8a62-18ded7a37b78:1-495")
+	c.Assert(err, check.IsNil)
+	latestGTID1, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:14")
+	c.Assert(err, check.IsNil)
+	latestGTID2, err := gtid.ParserGTID(flavor, "53bfca22-690d-11e7-8a62-18ded7a37b78:495")
+	c.Assert(err, check.IsNil)
+	_, _, data := genBinlogEventsWithGTIDs(c, flavor, previousGTIDSet, latestGTID1, latestGTID2)
+	c.Assert(os.WriteFile(filepath.Join(binlogDir, "mysql-bin.000001"), data, 0o600), check.IsNil)
+
+	cfg := &Config{
+		Flavor:     flavor,
+		EnableGTID: true,
+		RelayDir:   c.MkDir(),
+		OfflineBinlog: &config.OfflineBinlogConfig{
+			Dir:        binlogDir,
+			ServerUUID: "85ab69d1-b21f-11e6-9c5e-64006a8978d2",
+		},
+	}
+	r := NewOfflineRelay(cfg).(*OfflineRelay)
+	c.Assert(r.setUpMeta(), check.IsNil)
+	store, err := storage.CreateStorage(ctx, binlogDir)
+	c.Assert(err, check.IsNil)
+	defer store.Close()
+
+	// the GTID set in the relay meta advances with the imported transactions, 3 DDL + 10 DML.
+	c.Assert(r.importBinlogFiles(ctx, store), check.IsNil)
+	expected, err := gtid.ParserGTID(flavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-18,53bfca22-690d-11e7-8a62-18ded7a37b78:1-505")
+	c.Assert(err, check.IsNil)
+	location := r.Location()
+	c.Assert(location.Position, check.DeepEquals, gmysql.Position{Name: "mysql-bin.000001", Pos: uint32(len(data))})
+	c.Assert(location.GetGTID().Equal(expected), check.IsTrue)
+
+	// a binlog file without GTIDs can't be imported if GTID is enabled.
+	c.Assert(os.WriteFile(filepath.Join(binlogDir, "mysql-bin.000002"), []byte("binlog-2"), 0o600), check.IsNil)
+	err = r.importBinlogFiles(ctx, store)
+	c.Assert(terror.ErrRelayImportOfflineBinlog.Equal(err), check.IsTrue)
+}
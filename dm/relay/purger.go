@@ -76,6 +76,7 @@ type relayPurger struct {
 	operators    []Operator
 	interceptors []PurgeInterceptor
 	strategies   map[strategyType]PurgeStrategy
+	archiver     *relayArchiver // nil if archiving is not enabled
 
 	logger log.Logger
 }
@@ -98,6 +99,10 @@ func NewRelayPurger(cfg config.PurgeConfig, baseRelayDir string, operators []Ope
 	p.strategies[strategyTime] = newTimeStrategy()
 	p.strategies[strategySpace] = newSpaceStrategy()
 
+	if len(cfg.ArchiveURI) > 0 {
+		p.archiver = newRelayArchiver(cfg.ArchiveURI, baseRelayDir)
+	}
+
 	return p
 }
 
@@ -107,8 +112,8 @@ func (p *relayPurger) Start() {
 		return
 	}
 
-	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0) {
-		return // no need do purge in the background
+	if p.cfg.Interval <= 0 || (p.cfg.Expires <= 0 && p.cfg.RemainSpace <= 0 && p.archiver == nil) {
+		return // no need do purge or archive in the background
 	}
 
 	p.logger.Info("starting relay log purger", zap.Reflect("config", p.cfg))
@@ -136,7 +141,7 @@ func (p *relayPurger) run() {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			p.tryPurge()
+			p.tryPurge(ctx)
 		}
 	}
 }
@@ -176,7 +181,7 @@ func (p *relayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			relayBaseDir: p.baseRelayDir,
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return p.doPurge(ctx, ps, args)
 	case req.Time > 0:
 		ps := p.strategies[strategyTime]
 		args := &timeArgs{
@@ -184,7 +189,7 @@ func (p *relayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			safeTime:     time.Unix(req.Time, 0),
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return p.doPurge(ctx, ps, args)
 	case len(req.Filename) > 0:
 		ps := p.strategies[strategyFilename]
 		args := &filenameArgs{
@@ -193,30 +198,36 @@ func (p *relayPurger) Do(ctx context.Context, req *pb.PurgeRelayRequest) error {
 			subDir:       req.SubDir,
 			uuids:        uuids,
 		}
-		return p.doPurge(ps, args)
+		return p.doPurge(ctx, ps, args)
 	default:
 		return terror.ErrRelayPurgeRequestNotValid.Generate(req)
 	}
 }
 
 // tryPurge tries to do purge by check condition first.
-func (p *relayPurger) tryPurge() {
+// if archiving is enabled, the closed relay log files are archived even if no need to purge.
+func (p *relayPurger) tryPurge(ctx context.Context) {
 	strategy, args, err := p.check()
 	if err != nil {
 		p.logger.Error("check whether need to purge relay log files in background", zap.Error(err))
 		return
 	}
 	if strategy == nil {
+		if p.archiver != nil {
+			if err = p.archive(ctx); err != nil {
+				p.logger.Error("archive relay log files", zap.Error(err))
+			}
+		}
 		return
 	}
-	err = p.doPurge(strategy, args)
+	err = p.doPurge(ctx, strategy, args)
 	if err != nil {
 		p.logger.Error("do purge", zap.Stringer("strategy", strategy.Type()), zap.Error(err))
 	}
 }
 
 // doPurge does the purging operation.
-func (p *relayPurger) doPurge(ps PurgeStrategy, args StrategyArgs) error {
+func (p *relayPurger) doPurge(ctx context.Context, ps PurgeStrategy, args StrategyArgs) error {
 	if !p.purgingStrategy.CAS(uint32(strategyNone), uint32(ps.Type())) {
 		return terror.ErrRelayOtherStrategyIsPurging.Generate(ps.Type())
 	}
@@ -236,6 +247,14 @@ func (p *relayPurger) doPurge(ps PurgeStrategy, args StrategyArgs) error {
 	}
 	args.SetActiveRelayLog(earliest)
 
+	// archive after getting the earliest active relay log, so all relay log files going to
+	// be purged are closed and archived.
+	if p.archiver != nil {
+		if err := p.archive(ctx); err != nil {
+			return err
+		}
+	}
+
 	p.logger.Info("start purging relay log files", zap.Stringer("type", ps.Type()), zap.Any("args", args))
 	return ps.Do(args)
 }
@@ -290,6 +309,15 @@ func (p *relayPurger) check() (PurgeStrategy, StrategyArgs, error) {
 	return nil, nil, nil
 }
 
+// archive archives the closed relay log files.
+func (p *relayPurger) archive(ctx context.Context) error {
+	uuids, err := utils.ParseUUIDIndex(p.indexPath)
+	if err != nil {
+		return terror.Annotatef(err, "parse UUID index file %s", p.indexPath)
+	}
+	return p.archiver.archive(ctx, uuids)
+}
+
 // earliestActiveRelayLog returns the current earliest active relay log info.
 func (p *relayPurger) earliestActiveRelayLog() *streamer.RelayLogInfo {
 	var earliest *streamer.RelayLogInfo
@@ -1217,6 +1217,9 @@ func (r *Relay) notify(e *replication.BinlogEvent) {
 }
 
 func (r *Relay) NewReader(logger log.Logger, cfg *BinlogReaderConfig) *BinlogReader {
+	if len(cfg.ArchiveURI) == 0 {
+		cfg.ArchiveURI = r.cfg.ArchiveURI
+	}
 	return newBinlogReader(logger, cfg, r)
 }
 
@@ -85,15 +85,15 @@ func newStreamModifier(logger log.Logger) *streamModifier {
 	}
 }
 
-// Set handles HandleWorkerErrorRequest with ErrorOp_Skip, ErrorOp_Replace, ErrorOp_Inject.
-// - ErrorOp_Skip: events will be ignored.
+// Set handles HandleWorkerErrorRequest with ErrorOp_Skip, ErrorOp_Approve, ErrorOp_Replace, ErrorOp_Inject.
+// - ErrorOp_Skip, ErrorOp_Approve: events will be ignored.
 // - ErrorOp_Replace, ErrorOp_Inject: events should be query events generated by caller.
 func (m *streamModifier) Set(req *pb.HandleWorkerErrorRequest, events []*replication.BinlogEvent) error {
 	// precheck
 	switch req.Op {
-	case pb.ErrorOp_Skip:
+	case pb.ErrorOp_Skip, pb.ErrorOp_Approve:
 		if len(events) != 0 {
-			m.logger.Warn("skip op should not have events", zap.Int("eventLen", len(events)))
+			m.logger.Warn("op should not have events", zap.Stringer("op", req.Op), zap.Int("eventLen", len(events)))
 		}
 	case pb.ErrorOp_Replace, pb.ErrorOp_Inject:
 		if len(events) == 0 {
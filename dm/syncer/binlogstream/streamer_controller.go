@@ -333,6 +333,10 @@ func (c *StreamerController) resetReplicationSyncer(tctx *tcontext.Context, loca
 //   - Skip
 //     the skipped event will still be sent to caller, with op = pb.ErrorOp_Skip,
 //     to let caller track schema and save checkpoints.
+//
+//   - Approve
+//     the approved event is sent to caller with op = pb.ErrorOp_Approve, to let
+//     caller execute the DDL waiting for approval.
 func (c *StreamerController) GetEvent(tctx *tcontext.Context) (*replication.BinlogEvent, pb.ErrorOp, error) {
 	event, suffix, op, err := c.getEvent(tctx)
 	// if is local binlog but switch to remote on error, need to add uuid information in binlog's filename
@@ -450,6 +454,12 @@ LOOP:
 				c.streamModifier.next()
 				op = pb.ErrorOp_Skip
 				break LOOP
+			case pb.ErrorOp_Approve:
+				event = c.lastEventFromUpstream
+				c.lastEventFromUpstream = nil
+				c.streamModifier.next()
+				op = pb.ErrorOp_Approve
+				break LOOP
 			case pb.ErrorOp_Replace:
 				event, status = c.streamModifier.getEventFromFrontOp()
 				// this op has been totally consumed, move to next op and also delete
@@ -590,6 +600,10 @@ func (c *StreamerController) checkUUIDSameWithUpstream(ctx context.Context, pos
 		return true, nil
 	}
 	uuid := utils.GetUUIDBySuffix(uuids, uuidSuffix)
+	if c.fromDB == nil {
+		// there is no upstream server to switch to for an offline source.
+		return false, nil
+	}
 
 	upstreamUUID, err := conn.GetServerUUID(tcontext.NewContext(ctx, log.L()), c.fromDB.BaseDB, c.syncCfg.Flavor)
 	if err != nil {
@@ -615,6 +629,11 @@ func (c *StreamerController) CanRetry(err error) bool {
 }
 
 func (c *StreamerController) updateServerID(tctx *tcontext.Context) error {
+	if c.fromDB == nil {
+		// the server id is only used to pull binlog from the upstream server.
+		c.serverIDUpdated = true
+		return nil
+	}
 	randomServerID, err := conn.GetRandomServerID(tctx, c.fromDB.BaseDB)
 	if err != nil {
 		// should never happened unless the master has too many slave
@@ -110,6 +110,15 @@ func (c *causality) run() {
 		c.metricProxies.Metrics.ConflictDetectDurationHistogram.Observe(time.Since(startTime).Seconds())
 
 		c.outCh <- j
+
+		// some unique key values of the partial row image are unknown, so the
+		// following jobs may conflict with it without a common causality key.
+		// flush all sqls to make sure it's executed before them.
+		if j.tp == dml && j.dml.HasTableCausalityKey() {
+			c.logger.Debug("meet table-level causality key, will generate a conflict job to flush all sqls", zap.Stringer("job", j))
+			c.outCh <- newConflictJob(c.workerCount)
+			c.relation.clear()
+		}
 	}
 }
 
@@ -127,6 +127,52 @@ func TestCausality(t *testing.T) {
 	}
 }
 
+func TestCausalityPartialRowImage(t *testing.T) {
+	t.Parallel()
+
+	ti := mockTableInfo(t, "create table tb(a int primary key, b int unique);")
+
+	jobCh := make(chan *job, 10)
+	syncer := &Syncer{
+		cfg: &config.SubTaskConfig{
+			SyncerConfig: config.SyncerConfig{
+				QueueSize: 1024,
+			},
+			Name:     "task",
+			SourceID: "source",
+		},
+		tctx:    tcontext.Background().WithLogger(log.L()),
+		sessCtx: utils.NewSessionCtx(map[string]string{"time_zone": "UTC"}),
+	}
+	syncer.metricsProxies = metrics.DefaultMetricsProxies.CacheForOneTask("task", "worker", "source")
+	causalityCh := causalityWrap(jobCh, syncer)
+
+	table := &cdcmodel.TableName{Schema: "test", Table: "t1"}
+	location := binlog.MustZeroLocation(mysql.MySQLFlavor)
+	ec := &eventContext{startLocation: location, endLocation: location, lastLocation: location}
+
+	// binlog_row_image=MINIMAL only logs PK in the before image of DELETE,
+	// the unique key value is unknown.
+	change := sqlmodel.NewRowChange(table, nil, []interface{}{1, nil}, nil, ti, nil, nil)
+	change.SetPartialImage([]int{1}, nil)
+	require.True(t, change.HasTableCausalityKey())
+	jobCh <- newDMLJob(change, ec)
+	// the following INSERT may use the same unique key value.
+	change = sqlmodel.NewRowChange(table, nil, nil, []interface{}{2, 2}, ti, nil, nil)
+	require.False(t, change.HasTableCausalityKey())
+	jobCh <- newDMLJob(change, ec)
+
+	results := []opType{dml, conflict, dml}
+	require.Eventually(t, func() bool {
+		return len(causalityCh) == len(results)
+	}, 3*time.Second, 100*time.Millisecond)
+
+	for _, op := range results {
+		job := <-causalityCh
+		require.Equal(t, op, job.tp)
+	}
+}
+
 func (s *testSyncerSuite) TestCasualityRelation(c *check.C) {
 	rm := newCausalityRelation()
 	c.Assert(rm.len(), check.Equals, 0)
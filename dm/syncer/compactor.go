@@ -97,6 +97,15 @@ func (c *compactor) run() {
 				c.buffer = append(c.buffer, j)
 				continue
 			}
+			// partial row images can't be merged with other jobs, and the jobs
+			// before and after them should not be merged either, so flush the
+			// buffer first.
+			if j.dml.IsPartialImage() {
+				c.flushBuffer()
+				c.safeMode = j.safeMode
+				c.buffer = append(c.buffer, j)
+				continue
+			}
 
 			// if update job update its identify keys, turn it into delete + insert
 			if j.dml.IsIdentityUpdated() {
@@ -48,6 +48,7 @@ import (
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -222,6 +223,9 @@ type DataValidator struct {
 	location             *binlog.Location
 	loadedPendingChanges map[string]*tableChangeJob
 
+	repairMu      sync.Mutex
+	repairLimiter *rate.Limiter
+
 	vmetric *metrics.ValidatorMetrics
 }
 
@@ -241,6 +245,11 @@ func NewContinuousDataValidator(cfg *config.SubTaskConfig, syncerObj *Syncer, st
 	v.checkInterval = cfg.ValidatorCfg.CheckInterval.Duration
 	v.persistHelper = newValidatorCheckpointHelper(v)
 	v.pendingRowCounts = make([]atomic.Int64, rowChangeTypeCount)
+	repairRateLimit := cfg.ValidatorCfg.RepairRateLimit
+	if repairRateLimit <= 0 {
+		repairRateLimit = config.DefaultValidatorRepairRateLimit
+	}
+	v.repairLimiter = rate.NewLimiter(rate.Limit(repairRateLimit), 1)
 
 	return v
 }
@@ -374,6 +383,11 @@ func (v *DataValidator) Start(expect pb.Stage) {
 	v.wg.Add(1)
 	go utils.GoLogWrapper(v.L, v.markErrorStartedRoutine)
 
+	if v.cfg.ValidatorCfg.AutoRepair {
+		v.wg.Add(1)
+		go utils.GoLogWrapper(v.L, v.autoRepairRoutine)
+	}
+
 	// routineWrapper relies on errorProcessRoutine to handle panic errors,
 	// so just wrap it using a common wrapper.
 	v.errProcessWg.Add(1)
@@ -864,9 +878,14 @@ func (v *DataValidator) processRowsEvent(header *replication.EventHeader, ev *re
 
 	failpoint.Inject("ValidatorPanic", func() {})
 
+	// the validator compares full row images with downstream rows, so partial
+	// row images are not supported.
 	if err := checkLogColumns(ev.SkippedColumns); err != nil {
 		return terror.Annotate(err, sourceTable.String())
 	}
+	if header.EventType == replication.PARTIAL_UPDATE_ROWS_EVENT {
+		return terror.Annotate(terror.ErrBinlogNotLogColumn.Generate(), sourceTable.String())
+	}
 
 	needSkip, err := v.syncer.skipRowsEvent(sourceTable, header.EventType)
 	if err != nil {
@@ -1309,6 +1328,9 @@ func (v *DataValidator) GetValidatorError(errState pb.ValidateErrorState) ([]*pb
 }
 
 func (v *DataValidator) OperateValidatorError(validateOp pb.ValidationErrOp, errID uint64, isAll bool) error {
+	if validateOp == pb.ValidationErrOp_RepairErrOp {
+		return v.repairErrorRowsByOp(errID, isAll)
+	}
 	var (
 		toDB  *conn.BaseDB
 		err   error
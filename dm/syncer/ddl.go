@@ -81,6 +81,7 @@ type DDLWorker struct {
 	idAndCollationMap          map[int]string
 	baList                     *filter.Filter
 	foreignKeyChecksEnabled    bool
+	approver                   *ddlApprover
 
 	getTableInfo            func(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) (*model.TableInfo, error)
 	getDBInfoFromDownstream func(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) (*model.DBInfo, error)
@@ -111,6 +112,7 @@ func NewDDLWorker(pLogger *log.Logger, syncer *Syncer) *DDLWorker {
 		idAndCollationMap:          syncer.idAndCollationMap,
 		baList:                     syncer.baList,
 		foreignKeyChecksEnabled:    config.IsForeignKeyChecksEnabled(syncer.cfg.To.Session),
+		approver:                   syncer.ddlApprover,
 		recordSkipSQLsLocation:     syncer.recordSkipSQLsLocation,
 		trackDDL:                   syncer.trackDDL,
 		saveTablePoint:             syncer.saveTablePoint,
@@ -136,6 +138,8 @@ type Normal struct {
 	onlineDDL     onlineddl.OnlinePlugin
 	handleJobFunc func(*job) (bool, error)
 	execError     *atomic.Error
+
+	pendingApproval *pendingApproval
 }
 
 func NewNormalDDL(pLogger *log.Logger, syncer *Syncer) *Normal {
@@ -145,6 +149,8 @@ func NewNormalDDL(pLogger *log.Logger, syncer *Syncer) *Normal {
 		onlineDDL:     syncer.onlineDDL,
 		handleJobFunc: syncer.handleJobFunc,
 		execError:     &syncer.execError,
+
+		pendingApproval: &syncer.pendingApproval,
 	}
 }
 
@@ -164,6 +170,7 @@ type Pessimist struct {
 	flushCheckPoints func() error
 	saveTablePoint   func(table *filter.Table, location binlog.Location)
 	pessimist        *shardddl.Pessimist // shard DDL pessimist
+	pendingApproval  *pendingApproval
 }
 
 func NewPessimistDDL(pLogger *log.Logger, syncer *Syncer) *Pessimist {
@@ -182,6 +189,7 @@ func NewPessimistDDL(pLogger *log.Logger, syncer *Syncer) *Pessimist {
 		flushCheckPoints: syncer.flushCheckPoints,
 		saveTablePoint:   syncer.saveTablePoint,
 		pessimist:        syncer.pessimist,
+		pendingApproval:  &syncer.pendingApproval,
 	}
 }
 
@@ -200,6 +208,8 @@ type Optimist struct {
 	execError     *atomic.Error
 	optimist      *shardddl.Optimist // shard DDL optimist
 	strict        bool
+
+	pendingApproval *pendingApproval
 }
 
 func NewOptimistDDL(pLogger *log.Logger, syncer *Syncer) *Optimist {
@@ -217,6 +227,8 @@ func NewOptimistDDL(pLogger *log.Logger, syncer *Syncer) *Optimist {
 		execError:     &syncer.execError,
 		optimist:      syncer.optimist,
 		strict:        syncer.cfg.StrictOptimisticShardMode,
+
+		pendingApproval: &syncer.pendingApproval,
 	}
 }
 
@@ -409,6 +421,16 @@ func (ddl *DDLWorker) HandleQueryEvent(ev *replication.QueryEvent, ec eventConte
 		return err
 	}
 
+	// find the DDLs which need approval, the previous DMLs have been flushed,
+	// so the task can be resumed from this DDL if it's paused for approval.
+	if !qec.approved {
+		originDDLs := make([]string, 0, len(qec.trackInfos))
+		for _, trackInfo := range qec.trackInfos {
+			originDDLs = append(originDDLs, trackInfo.originDDL)
+		}
+		qec.needApprovalDDLs = ddl.approver.match(originDDLs)
+	}
+
 	return ddl.strategy.handleDDL(qec)
 }
 
@@ -417,6 +439,10 @@ func (ddl *Normal) preFilter(*ddlInfo, *queryEventContext, *filter.Table, *filte
 }
 
 func (ddl *Normal) handleDDL(qec *queryEventContext) error {
+	if len(qec.needApprovalDDLs) > 0 {
+		return pauseForApproval(ddl.logger, ddl.pendingApproval, qec)
+	}
+
 	ddl.logger.Info("start to handle ddls in normal mode", zap.String("event", "query"), zap.Stringer("queryEventContext", qec))
 
 	// interrupted after flush old checkpoint and before track DDL.
@@ -565,6 +591,11 @@ func (ddl *Pessimist) handleDDL(qec *queryEventContext) error {
 		zap.Bool("is-synced", synced),
 		zap.Int("unsynced", remain))
 
+	// the DDLs not in a shard group are not coordinated by DM-master, pause for approval here.
+	if len(qec.needApprovalDDLs) > 0 && !needShardingHandle {
+		return pauseForApproval(ddl.logger, ddl.pendingApproval, qec)
+	}
+
 	// interrupted after flush old checkpoint and before track DDL.
 	failpoint.Inject("FlushCheckpointStage", func(val failpoint.Value) {
 		err = handleFlushCheckpointStage(1, val.(int), "before track DDL")
@@ -640,7 +671,9 @@ func (ddl *Pessimist) handleDDL(qec *queryEventContext) error {
 		// we should add another config item to differ, and do not save DDLInfo, and not wait for ddlExecInfo
 
 		// construct & send shard DDL info into etcd, DM-master will handle it.
+		// DM-master holds the lock until the DDLs which need approval are approved or skipped.
 		shardInfo := ddl.pessimist.ConstructInfo(ddlInfo.targetTables[0].Schema, ddlInfo.targetTables[0].Name, needHandleDDLs)
+		shardInfo.NeedApproval = len(qec.needApprovalDDLs) > 0
 		rev, err2 := ddl.pessimist.PutInfo(qec.tctx.Ctx, shardInfo)
 		if err2 != nil {
 			return err2
@@ -648,7 +681,9 @@ func (ddl *Pessimist) handleDDL(qec *queryEventContext) error {
 		ddl.metricsProxies.Metrics.ShardLockResolving.Set(1) // block and wait DDL lock to be synced
 		ddl.logger.Info("putted shard DDL info", zap.Stringer("info", shardInfo), zap.Int64("revision", rev))
 
+		ddl.pendingApproval.set(qec.needApprovalDDLs, qec.startLocation)
 		shardOp, err2 := ddl.pessimist.GetOperation(qec.tctx.Ctx, shardInfo, rev+1)
+		ddl.pendingApproval.set(nil, binlog.Location{})
 		ddl.metricsProxies.Metrics.ShardLockResolving.Set(0)
 		if err2 != nil {
 			return err2
@@ -798,6 +833,14 @@ func (ddl *Optimist) handleDDL(qec *queryEventContext) error {
 		}
 	}
 
+	if len(qec.needApprovalDDLs) > 0 {
+		// these DDLs are not coordinated by DM-master, see the `skipOp` below, pause for approval here.
+		switch trackInfos[0].stmtCache.(type) {
+		case *ast.CreateDatabaseStmt, *ast.AlterDatabaseStmt, *ast.DropDatabaseStmt, *ast.CreateTableStmt, *ast.DropTableStmt:
+			return pauseForApproval(ddl.logger, ddl.pendingApproval, qec)
+		}
+	}
+
 	for _, trackInfo := range trackInfos {
 		if err = ddl.trackDDL(qec.ddlSchema, trackInfo, qec.eventContext); err != nil {
 			return err
@@ -815,6 +858,8 @@ func (ddl *Optimist) handleDDL(qec *queryEventContext) error {
 	// because it has no `UnresolvedTables` to prevent the flush of this checkpoint.
 
 	info := ddl.optimist.ConstructInfo(upTable.Schema, upTable.Name, downTable.Schema, downTable.Name, qec.needHandleDDLs, tiBefore, tisAfter)
+	// DM-master holds the operation until the DDLs which need approval are approved.
+	info.NeedApproval = len(qec.needApprovalDDLs) > 0
 
 	var (
 		rev    int64
@@ -854,6 +899,8 @@ func (ddl *Optimist) handleDDL(qec *queryEventContext) error {
 
 	ddl.logger.Info("putted a shard DDL info into etcd", zap.Stringer("info", info))
 	if !skipOp {
+		ddl.pendingApproval.set(qec.needApprovalDDLs, qec.startLocation)
+		defer ddl.pendingApproval.set(nil, binlog.Location{})
 		for {
 			op, err = ddl.optimist.GetOperation(qec.tctx.Ctx, info, rev+1)
 			if err != nil {
@@ -901,6 +948,17 @@ func (ddl *Optimist) handleDDL(qec *queryEventContext) error {
 		return err
 	}
 
+	// the DDLs are skipped by the operator and not executed in downstream, so we need to revert tableInfo in schemaTracker to `tiBefore`.
+	if op.Skipped && tiBefore != nil {
+		if err = ddl.schemaTracker.DropTable(upTable); err != nil {
+			return err
+		}
+		if err = ddl.schemaTracker.CreateTableIfNotExists(upTable, tiBefore); err != nil {
+			return err
+		}
+		ddl.logger.Info("revert table in schema tracker for skipped ddls", zap.Stringer("table", upTable), zap.Strings("ddls", qec.needHandleDDLs))
+	}
+
 	// updated needHandleDDLs to DDLs received from DM-master.
 	qec.needHandleDDLs = op.DDLs
 
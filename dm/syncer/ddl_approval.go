@@ -0,0 +1,98 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"go.uber.org/zap"
+)
+
+// ddlApprover finds the DDLs which need approval before being executed, see config.DDLApprovalConfig.
+// in non-shard mode, the task is paused and the DDLs are approved or skipped by `binlog approve/skip`.
+// in shard mode, the DDLs coordinated by DM-master are held in the shard DDL lock until they are
+// approved or skipped in DM-master, other DDLs pause the task just like in non-shard mode.
+type ddlApprover struct {
+	patterns []*regexp.Regexp
+}
+
+// newDDLApprover returns nil if DDL approval is not enabled.
+func newDDLApprover(cfg *config.DDLApprovalConfig) (*ddlApprover, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	patterns := make([]*regexp.Regexp, 0, len(cfg.SQLPatterns))
+	for _, pattern := range cfg.SQLPatterns {
+		reg, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidDDLApproval.Generate(fmt.Sprintf("pattern %s is invalid: %v", pattern, err))
+		}
+		patterns = append(patterns, reg)
+	}
+	return &ddlApprover{patterns: patterns}, nil
+}
+
+// match returns the DDLs matching any of the patterns.
+func (a *ddlApprover) match(ddls []string) []string {
+	if a == nil {
+		return nil
+	}
+	var matched []string
+	for _, ddl := range ddls {
+		for _, pattern := range a.patterns {
+			if pattern.MatchString(ddl) {
+				matched = append(matched, ddl)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// pendingApproval records the DDLs waiting for approval, which are shown in the status.
+type pendingApproval struct {
+	sync.RWMutex
+	ddls []string
+	// the binlog position of the DDLs, in the format of `--binlog-pos` of `binlog` commands.
+	position string
+}
+
+func (p *pendingApproval) set(ddls []string, location binlog.Location) {
+	p.Lock()
+	defer p.Unlock()
+	p.ddls = ddls
+	p.position = ""
+	if len(ddls) > 0 {
+		p.position = fmt.Sprintf("%s:%d", location.Position.Name, location.Position.Pos)
+	}
+}
+
+func (p *pendingApproval) get() ([]string, string) {
+	p.RLock()
+	defer p.RUnlock()
+	return p.ddls, p.position
+}
+
+// pauseForApproval returns the error to pause the task before executing the DDLs which need approval.
+func pauseForApproval(logger log.Logger, p *pendingApproval, qec *queryEventContext) error {
+	p.set(qec.needApprovalDDLs, qec.startLocation)
+	logger.Warn("DDLs need approval", zap.String("event", "query"), zap.Strings("ddls", qec.needApprovalDDLs), zap.Stringer("queryEventContext", qec))
+	return terror.ErrSyncerDDLNeedApproval.Generate(qec.needApprovalDDLs)
+}
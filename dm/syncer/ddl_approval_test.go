@@ -0,0 +1,87 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLApprover(t *testing.T) {
+	t.Parallel()
+
+	// approval is not enabled
+	approver, err := newDDLApprover(nil)
+	require.NoError(t, err)
+	require.Nil(t, approver)
+	require.Nil(t, approver.match([]string{"DROP TABLE `db`.`tbl`"}))
+
+	_, err = newDDLApprover(&config.DDLApprovalConfig{SQLPatterns: []string{"("}})
+	require.True(t, terror.ErrConfigInvalidDDLApproval.Equal(err))
+
+	approver, err = newDDLApprover(&config.DDLApprovalConfig{
+		SQLPatterns: []string{"^DROP TABLE", "^ALTER TABLE .* DROP COLUMN"},
+	})
+	require.NoError(t, err)
+	ddls := []string{
+		"drop table `db`.`tbl1`",
+		"ALTER TABLE `db`.`tbl2` ADD COLUMN `c1` INT",
+		"ALTER TABLE `db`.`tbl2` DROP COLUMN `c2`",
+		"CREATE TABLE `db`.`tbl3` (`id` INT)",
+	}
+	require.Equal(t, []string{ddls[0], ddls[2]}, approver.match(ddls))
+	require.Nil(t, approver.match(ddls[3:]))
+}
+
+func TestPendingApproval(t *testing.T) {
+	t.Parallel()
+
+	p := &pendingApproval{}
+	ddls, pos := p.get()
+	require.Nil(t, ddls)
+	require.Equal(t, "", pos)
+
+	location := binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil)
+	p.set([]string{"DROP TABLE `db`.`tbl`"}, location)
+	ddls, pos = p.get()
+	require.Equal(t, []string{"DROP TABLE `db`.`tbl`"}, ddls)
+	require.Equal(t, "mysql-bin.000001:1234", pos)
+
+	p.set(nil, binlog.Location{})
+	ddls, pos = p.get()
+	require.Nil(t, ddls)
+	require.Equal(t, "", pos)
+}
+
+func TestPauseForApproval(t *testing.T) {
+	t.Parallel()
+
+	p := &pendingApproval{}
+	location := binlog.NewLocation(mysql.Position{Name: "mysql-bin.000001", Pos: 1234}, nil)
+	qec := &queryEventContext{
+		eventContext:     &eventContext{startLocation: location},
+		needApprovalDDLs: []string{"DROP TABLE `db`.`tbl`"},
+	}
+	err := pauseForApproval(log.L(), p, qec)
+	require.True(t, terror.ErrSyncerDDLNeedApproval.Equal(err))
+	ddls, pos := p.get()
+	require.Equal(t, qec.needApprovalDDLs, ddls)
+	require.Equal(t, "mysql-bin.000001:1234", pos)
+}
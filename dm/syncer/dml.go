@@ -17,6 +17,7 @@ import (
 	"encoding/binary"
 	"strings"
 
+	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/charset"
@@ -41,6 +42,60 @@ type genDMLParam struct {
 	originalData    [][]interface{}  // all data
 	sourceTableInfo *model.TableInfo // all table info
 	extendData      [][]interface{}  // all data include extend data
+	skippedColumns  [][]int          // offsets of columns not logged in each row image of originalData
+}
+
+// skippedColumnsOf returns the offsets of columns not logged in the i-th row image.
+func (p *genDMLParam) skippedColumnsOf(i int) []int {
+	if i < len(p.skippedColumns) {
+		return p.skippedColumns[i]
+	}
+	return nil
+}
+
+// setPartialImage marks the row change built from a partial row image or
+// containing JSON diffs, and checks the row can be identified by the logged columns.
+// A partial row image is always replicated as UPDATE in safe mode, which is
+// reentrant unless it changes the primary or unique key, because the new key
+// may be occupied by another row when the binlog is replicated again and the
+// skipped columns can't be recovered by REPLACE. Inserting an array element by
+// a JSON diff is not reentrant either.
+func (p *genDMLParam) setPartialImage(rowChange *sqlmodel.RowChange, preSkipped, postSkipped []int) error {
+	hasJSONDiff, hasArrayInsert := inspectJSONDiffs(rowChange.GetPostValues())
+	if len(preSkipped) == 0 && len(postSkipped) == 0 && !hasJSONDiff {
+		return nil
+	}
+	rowChange.SetPartialImage(preSkipped, postSkipped)
+	if !rowChange.IsIdentityLogged() {
+		return terror.ErrBinlogNotLogColumn.Generate()
+	}
+	if p.safeMode && rowChange.IsPrimaryOrUniqueKeyUpdated() {
+		return terror.Annotate(terror.ErrBinlogNotLogColumn.Generate(),
+			"can't update primary key or unique key of a partial row image in safe mode, please set binlog_row_image to FULL, or set safe-mode-duration to 0s and disable safe mode")
+	}
+	if p.safeMode && hasArrayInsert {
+		return terror.Annotate(terror.ErrBinlogNotLogColumn.Generate(),
+			"can't insert JSON array elements of a partial JSON update in safe mode, please set binlog_row_value_options to '', or set safe-mode-duration to 0s and disable safe mode")
+	}
+	return nil
+}
+
+// inspectJSONDiffs returns whether the values contain JSON diffs of partial JSON
+// updates, and whether any of the diffs inserts an array element.
+func inspectJSONDiffs(values []interface{}) (hasJSONDiff, hasArrayInsert bool) {
+	for _, v := range values {
+		diffs, ok := v.([]sqlmodel.JSONDiff)
+		if !ok {
+			continue
+		}
+		hasJSONDiff = true
+		for _, diff := range diffs {
+			if diff.IsArrayInsert() {
+				hasArrayInsert = true
+			}
+		}
+	}
+	return hasJSONDiff, hasArrayInsert
 }
 
 // latin1Decider is not usually ISO8859_1 in MySQL.
@@ -88,6 +143,23 @@ func adjustValueFromBinlogData(
 			d = uint64(v)
 		case decimal.Decimal:
 			d = v.String()
+		case []*replication.JsonDiff:
+			// upstream binlog_row_value_options is PARTIAL_JSON, the JSON diffs
+			// are decoded by binlog.DecodeJSONDiffs.
+			diffs := make([]sqlmodel.JSONDiff, 0, len(v))
+			for _, diff := range v {
+				diffs = append(diffs, sqlmodel.JSONDiff{
+					Op:    sqlmodel.JSONDiffOperation(diff.Op),
+					Path:  diff.Path,
+					Value: diff.Value,
+				})
+			}
+			d = diffs
+		case *replication.JsonDiff:
+			// go-mysql only keeps the first one of the JSON diffs, so the new
+			// value can't be recovered without binlog.DecodeJSONDiffs.
+			return nil, terror.Annotate(terror.ErrBinlogNotLogColumn.Generate(),
+				"JSON diffs of partial JSON update are not fully decoded")
 		case []byte:
 			if isLatin1 {
 				d, err = latin1Decoder.Bytes(v)
@@ -149,7 +221,7 @@ func (s *Syncer) genAndFilterInsertDMLs(tctx *tcontext.Context, param *genDMLPar
 
 	causalityKeySourceTable := s.causalityKeySourceTableNameForRowChange(param.sourceTable)
 RowLoop:
-	for _, data := range originalDataSeq {
+	for i, data := range originalDataSeq {
 		originalValue, err := adjustValueFromBinlogData(data, ti)
 		if err != nil {
 			return nil, err
@@ -178,6 +250,9 @@ RowLoop:
 		rowChange.SetWhereHandle(downstreamTableInfo.WhereHandle)
 		rowChange.SetCausalityKeySourceTable(causalityKeySourceTable)
 		rowChange.SetForeignKeyRelations(downstreamTableInfo.ForeignKeyRelations)
+		if err = param.setPartialImage(rowChange, nil, param.skippedColumnsOf(i)); err != nil {
+			return nil, err
+		}
 		dmls = append(dmls, rowChange)
 	}
 
@@ -257,6 +332,9 @@ RowLoop:
 		rowChange.SetWhereHandle(downstreamTableInfo.WhereHandle)
 		rowChange.SetCausalityKeySourceTable(causalityKeySourceTable)
 		rowChange.SetForeignKeyRelations(downstreamTableInfo.ForeignKeyRelations)
+		if err = param.setPartialImage(rowChange, param.skippedColumnsOf(i), param.skippedColumnsOf(i+1)); err != nil {
+			return nil, err
+		}
 		dmls = append(dmls, rowChange)
 	}
 
@@ -284,7 +362,7 @@ func (s *Syncer) genAndFilterDeleteDMLs(tctx *tcontext.Context, param *genDMLPar
 
 	causalityKeySourceTable := s.causalityKeySourceTableNameForRowChange(param.sourceTable)
 RowLoop:
-	for _, data := range dataSeq {
+	for i, data := range dataSeq {
 		value, err := adjustValueFromBinlogData(data, ti)
 		if err != nil {
 			return nil, err
@@ -313,6 +391,9 @@ RowLoop:
 		rowChange.SetWhereHandle(downstreamTableInfo.WhereHandle)
 		rowChange.SetCausalityKeySourceTable(causalityKeySourceTable)
 		rowChange.SetForeignKeyRelations(downstreamTableInfo.ForeignKeyRelations)
+		if err = param.setPartialImage(rowChange, param.skippedColumnsOf(i), nil); err != nil {
+			return nil, err
+		}
 		dmls = append(dmls, rowChange)
 	}
 
@@ -406,7 +487,7 @@ func genDMLsWithSameCols(op sqlmodel.DMLType, dmls []*sqlmodel.RowChange) ([]str
 		if i == 0 {
 			lastDML = dml
 		}
-		if !sqlmodel.SameTypeTargetAndColumns(lastDML, dml) {
+		if len(groupDMLs) > 0 && !sqlmodel.SameTypeTargetAndColumns(lastDML, dml) {
 			query, arg = genSQLMultipleRows(op, groupDMLs)
 			queries = append(queries, query)
 			args = append(args, arg)
@@ -434,7 +515,9 @@ func genDMLsWithSameTable(op sqlmodel.DMLType, jobs []*job) ([]string, [][]inter
 
 	if op == sqlmodel.DMLUpdate {
 		for i, j := range jobs {
-			if j.safeMode {
+			// partial row images can't be replicated as REPLACE, which needs all
+			// columns. UPDATE is reentrant for them, see setPartialImage.
+			if j.safeMode && !j.dml.IsPartialImage() {
 				if j.dml.IsPrimaryOrUniqueKeyUpdated() {
 					query, arg := j.dml.GenSQL(sqlmodel.DMLDelete)
 					queries = append(queries, query)
@@ -503,6 +586,12 @@ func genDMLsWithSameOp(jobs []*job) ([]string, [][]interface{}) {
 		var curOp sqlmodel.DMLType
 		switch j.dml.Type() {
 		case sqlmodel.RowChangeUpdate:
+			// partial row images can only be replicated as UPDATE, which is
+			// reentrant in safe mode, see setPartialImage.
+			if j.dml.IsPartialImage() {
+				curOp = sqlmodel.DMLUpdate
+				break
+			}
 			// if update statement didn't update identify values and not in safemode, regard it as insert on duplicate.
 			if !j.dml.IsIdentityUpdated() && !j.safeMode {
 				curOp = sqlmodel.DMLInsertOnDuplicateUpdate
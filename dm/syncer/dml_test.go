@@ -17,6 +17,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/go-mysql-org/go-mysql/replication"
 	tiddl "github.com/pingcap/tidb/pkg/ddl"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser"
@@ -27,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/mock"
 	cdcmodel "github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
 	"github.com/pingcap/tiflow/pkg/sqlmodel"
 	"github.com/stretchr/testify/require"
 )
@@ -342,3 +344,84 @@ func TestGBKExtractValueFromData(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expect, got)
 }
+
+func TestPartialRowImageDMLs(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb"}
+	ti := mockTableInfo(t, "create table db.tb(id int primary key, c1 int, j json)")
+
+	// JSON diff is rejected if only the first diff is decoded
+	row := []interface{}{int32(1), nil, &replication.JsonDiff{Op: replication.JsonDiffOperationReplace, Path: "$.a", Value: "1"}}
+	_, err := adjustValueFromBinlogData(row, ti)
+	require.True(t, terror.ErrBinlogNotLogColumn.Equal(err))
+
+	// all the JSON diffs of a column are converted to sqlmodel.JSONDiff
+	row = []interface{}{int32(1), int32(2), []*replication.JsonDiff{
+		{Op: replication.JsonDiffOperationReplace, Path: "$.a", Value: "1"},
+		{Op: replication.JsonDiffOperationInsert, Path: "$.b", Value: `"x"`},
+		{Op: replication.JsonDiffOperationRemove, Path: "$.c"},
+	}}
+	values, err := adjustValueFromBinlogData(row, ti)
+	require.NoError(t, err)
+	diffs := []sqlmodel.JSONDiff{
+		{Op: sqlmodel.JSONDiffReplace, Path: "$.a", Value: "1"},
+		{Op: sqlmodel.JSONDiffInsert, Path: "$.b", Value: `"x"`},
+		{Op: sqlmodel.JSONDiffRemove, Path: "$.c"},
+	}
+	require.Equal(t, []interface{}{int64(1), int64(2), diffs}, values)
+
+	// a row with JSON diffs is a partial row image even if all the columns are logged
+	param := &genDMLParam{safeMode: true}
+	change := sqlmodel.NewRowChange(source, nil, []interface{}{int64(1), int64(2), `{"a": 0, "c": 0}`}, values, ti, nil, nil)
+	require.NoError(t, param.setPartialImage(change, nil, nil))
+	require.True(t, change.IsPartialImage())
+	jobs := []*job{newDMLJob(change, ecWithSafeMode)}
+	queries, args := genDMLsWithSameOp(jobs)
+	require.Equal(t, []string{"UPDATE `db`.`tb` SET `id` = ?, `c1` = ?, `j` = JSON_REMOVE(JSON_SET(JSON_REPLACE(`j`, ?, CAST(? AS JSON)), ?, CAST(? AS JSON)), ?) WHERE `id` = ? LIMIT 1"}, queries)
+	require.Equal(t, [][]interface{}{{int64(1), int64(2), "$.a", "1", "$.b", `"x"`, "$.c", int64(1)}}, args)
+
+	// inserting a JSON array element is not reentrant in safe mode
+	values[2] = append(diffs, sqlmodel.JSONDiff{Op: sqlmodel.JSONDiffInsert, Path: "$.d[0]", Value: "1"})
+	change = sqlmodel.NewRowChange(source, nil, []interface{}{int64(1), int64(2), `{"a": 0, "c": 0, "d": []}`}, values, ti, nil, nil)
+	err = param.setPartialImage(change, nil, nil)
+	require.True(t, terror.ErrBinlogNotLogColumn.Equal(err))
+	param.safeMode = false
+	change = sqlmodel.NewRowChange(source, nil, []interface{}{int64(1), int64(2), `{"a": 0, "c": 0, "d": []}`}, values, ti, nil, nil)
+	require.NoError(t, param.setPartialImage(change, nil, nil))
+
+	param = &genDMLParam{
+		skippedColumns: [][]int{{1, 2}, {0, 2}},
+	}
+	change := sqlmodel.NewRowChange(source, nil, []interface{}{int64(1), nil, nil}, []interface{}{nil, int64(2), nil}, ti, nil, nil)
+	require.NoError(t, param.setPartialImage(change, param.skippedColumnsOf(0), param.skippedColumnsOf(1)))
+	require.True(t, change.IsPartialImage())
+
+	// partial row images are replicated as UPDATE even in safe mode
+	jobs = []*job{newDMLJob(change, ecWithSafeMode), newDMLJob(change, ec)}
+	queries, args = genDMLsWithSameOp(jobs)
+	expectedQuery := "UPDATE `db`.`tb` SET `c1` = ? WHERE `id` = ? LIMIT 1"
+	require.Equal(t, []string{expectedQuery, expectedQuery}, queries)
+	require.Equal(t, [][]interface{}{{int64(2), int64(1)}, {int64(2), int64(1)}}, args)
+
+	// updating PK of a partial row image is not reentrant in safe mode
+	param.safeMode = true
+	change = sqlmodel.NewRowChange(source, nil, []interface{}{int64(1), nil, nil}, []interface{}{int64(3), nil, nil}, ti, nil, nil)
+	err = param.setPartialImage(change, []int{1, 2}, []int{1, 2})
+	require.True(t, terror.ErrBinlogNotLogColumn.Equal(err))
+	param.safeMode = false
+	change = sqlmodel.NewRowChange(source, nil, []interface{}{int64(1), nil, nil}, []interface{}{int64(3), nil, nil}, ti, nil, nil)
+	require.NoError(t, param.setPartialImage(change, []int{1, 2}, []int{1, 2}))
+
+	// full row images are not changed
+	param = &genDMLParam{}
+	change = sqlmodel.NewRowChange(source, nil, []interface{}{int64(1), int64(2), nil}, nil, ti, nil, nil)
+	require.NoError(t, param.setPartialImage(change, param.skippedColumnsOf(0), nil))
+	require.False(t, change.IsPartialImage())
+
+	// PK is not logged
+	param = &genDMLParam{skippedColumns: [][]int{{0}}}
+	change = sqlmodel.NewRowChange(source, nil, []interface{}{nil, int64(2), nil}, nil, ti, nil, nil)
+	err = param.setPartialImage(change, param.skippedColumnsOf(0), nil)
+	require.True(t, terror.ErrBinlogNotLogColumn.Equal(err))
+}
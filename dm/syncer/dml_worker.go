@@ -38,6 +38,7 @@ type DMLWorker struct {
 	chanSize      int
 	multipleRows  bool
 	toDBConns     []*dbconn.DBConn
+	sinkWriter    *sinkWriter
 	syncCtx       *tcontext.Context
 	logger        log.Logger
 	metricProxies *metrics.Proxies
@@ -86,7 +87,8 @@ func (w *DMLWorker) validateSafeModeForeignKeyUpdate(jobs []*job) error {
 		if j == nil || j.tp != dml || !j.safeMode || j.dml == nil {
 			continue
 		}
-		if j.dml.Type() != sqlmodel.RowChangeUpdate {
+		// partial row images are always replicated as UPDATE
+		if j.dml.Type() != sqlmodel.RowChangeUpdate || j.dml.IsPartialImage() {
 			continue
 		}
 		if j.dml.IsPrimaryOrUniqueKeyUpdated() {
@@ -122,6 +124,7 @@ func dmlWorkerWrap(inCh chan *job, syncer *Syncer) chan *job {
 		syncCtx:                 syncer.syncCtx, // this ctx can be used to cancel all the workers
 		metricProxies:           syncer.metricsProxies,
 		toDBConns:               syncer.toDBConns,
+		sinkWriter:              syncer.sinkWriter,
 		foreignKeyChecksEnabled: config.IsForeignKeyChecksEnabled(syncer.cfg.To.Session),
 		inCh:                    inCh,
 		flushCh:                 make(chan *job),
@@ -295,6 +298,13 @@ func (w *DMLWorker) executeBatchJobs(queueID int, jobs []*job, disableForeignKey
 		}
 	})
 
+	if w.sinkWriter != nil {
+		ctx, cancel := w.syncCtx.WithTimeout(maxDMLConnectionDuration)
+		defer cancel()
+		err = w.sinkWriter.writeDMLs(ctx.Ctx, jobs)
+		return
+	}
+
 	queries, args = w.genSQLs(jobs)
 	failpoint.Inject("BlockExecuteSQLs", func(v failpoint.Value) {
 		t := v.(int) // sleep time
@@ -399,7 +409,9 @@ func (w *DMLWorker) genSQLs(jobs []*job) ([]string, [][]interface{}) {
 			}
 
 		case sqlmodel.RowChangeUpdate:
-			if j.safeMode {
+			// partial row images can't be replicated as REPLACE, which needs all
+			// columns. UPDATE is reentrant for them, see setPartialImage.
+			if j.safeMode && !j.dml.IsPartialImage() {
 				if j.dml.IsPrimaryOrUniqueKeyUpdated() {
 					query, arg = j.dml.GenSQL(sqlmodel.DMLDelete)
 					appendQueryAndArg()
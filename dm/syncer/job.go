@@ -85,7 +85,8 @@ type job struct {
 	startLocation   binlog.Location // start location of the sql in binlog, for handle_error
 	currentLocation binlog.Location // end location of the sql in binlog, for user to skip sql manually by changing checkpoint
 	ddls            []string
-	originSQL       string // show origin sql when error, only DDL now
+	ddlInfos        []*ddlInfo // the DDLs in ddls, used to publish them to the sink
+	originSQL       string     // show origin sql when error, only DDL now
 
 	eventHeader *replication.EventHeader
 	jobAddTime  time.Time       // job commit time
@@ -142,6 +143,7 @@ func newDDLJob(qec *queryEventContext) *job {
 		tp:          ddl,
 		targetTable: &filter.Table{},
 		ddls:        qec.needHandleDDLs,
+		ddlInfos:    qec.trackInfos,
 		originSQL:   qec.originSQL,
 
 		location:        qec.lastLocation,
@@ -60,6 +60,11 @@ func (s *Syncer) setInitActiveRelayLog(ctx context.Context) error {
 			Name: s.cfg.Meta.BinLogName,
 			Pos:  s.cfg.Meta.BinLogPos,
 		}
+	case s.fromDB == nil:
+		// an offline source has no master to query, the position must come
+		// from the checkpoint or the task meta.
+		return terror.ErrConfigOfflineBinlogNotSupport.Generate(
+			"without a checkpoint or the binlog position in the task meta to start from")
 	default:
 		// start from dumper or loader, get current pos from master
 		pos, _, err = s.fromDB.GetMasterStatus(s.tctx.WithContext(ctx), s.cfg.Flavor)
@@ -73,6 +78,9 @@ func (s *Syncer) setInitActiveRelayLog(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+	} else if s.fromDB == nil {
+		// the relay logs of an offline source are always in the latest subdirectory.
+		activeSubDir = subDirs[len(subDirs)-1]
 	} else {
 		var uuid string
 		latestSubDir := subDirs[len(subDirs)-1]
@@ -0,0 +1,51 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tiflow/dm/config"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/dm/syncer/binlogstream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetInitActiveRelayLogOfflineSource(t *testing.T) {
+	cfg := &config.SubTaskConfig{
+		Name:       "test",
+		Flavor:     mysql.MySQLFlavor,
+		Mode:       config.ModeAll,
+		MetaSchema: "dm_meta",
+		RelayDir:   t.TempDir(),
+	}
+	indexPath := filepath.Join(cfg.RelayDir, utils.UUIDIndexFilename)
+	require.NoError(t, os.WriteFile(indexPath, []byte("c6ae5afe-c7a3-11e8-a19d-0242ac130006.000001\n"), 0o644))
+
+	// an offline source has no upstream database to query the start position.
+	s := &Syncer{
+		cfg:        cfg,
+		tctx:       tcontext.Background(),
+		binlogType: binlogstream.LocalBinlog,
+	}
+	s.checkpoint = NewRemoteCheckPoint(s.tctx, cfg, nil, "1")
+	err := s.setInitActiveRelayLog(context.Background())
+	require.True(t, terror.ErrConfigOfflineBinlogNotSupport.Equal(err))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/pkg/meta/autoid"
 	"github.com/pingcap/tidb/pkg/meta/metabuild"
 	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/util/filter"
@@ -91,6 +92,9 @@ func (s *Syncer) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 	case pb.SchemaOp_SetSchema:
 		// from source or target need get schema
 		if req.FromSource {
+			if s.fromConn == nil {
+				return "", terror.ErrConfigOfflineBinlogNotSupport.Generate("to get the table structure from source")
+			}
 			schema, err := dbconn.GetTableCreateSQL(s.tctx.WithContext(ctx), s.fromConn, sourceTable.String())
 			if err != nil {
 				return "", err
@@ -109,9 +113,13 @@ func (s *Syncer) OperateSchema(ctx context.Context, req *pb.OperateWorkerSchemaR
 
 		// for set schema, we must ensure it's a valid `CREATE TABLE` statement.
 		// if want to update the one in checkpoint, it should wait for the flush of checkpoint.
-		parser2, err := s.fromDB.GetParser(ctx)
-		if err != nil {
-			return "", err
+		parser2 := parser.New()
+		if s.fromDB != nil {
+			var err error
+			parser2, err = s.fromDB.GetParser(ctx)
+			if err != nil {
+				return "", err
+			}
 		}
 		node, err := parser2.ParseOneStmt(req.Schema, "", "")
 		if err != nil {
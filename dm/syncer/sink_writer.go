@@ -0,0 +1,407 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/parser/types"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/cdc/sink/ddlsink"
+	ddlfactory "github.com/pingcap/tiflow/cdc/sink/ddlsink/factory"
+	dmlfactory "github.com/pingcap/tiflow/cdc/sink/dmlsink/factory"
+	"github.com/pingcap/tiflow/cdc/sink/tablesink"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	cdcconfig "github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/pdutil"
+	"github.com/pingcap/tiflow/pkg/spanz"
+	"github.com/pingcap/tiflow/pkg/util"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+const sinkFlushCheckInterval = 10 * time.Millisecond
+
+// sinkWriter publishes row changes and DDLs to a TiCDC sink, such as Kafka,
+// Pulsar or cloud storage, instead of executing them in the downstream database.
+// The binlog position of each event is attached to it, so that the consumers
+// can locate the event in the upstream binlog.
+//
+// The sink requires a commit ts for every event, so a monotonic commit ts is
+// generated from the timestamp in the binlog event header. A batch of row
+// changes shares the same commit ts, just like it's executed in one transaction
+// in the downstream database.
+type sinkWriter struct {
+	sync.Mutex
+
+	changefeedID cdcmodel.ChangeFeedID
+	sinkURI      string
+	enableGTID   bool
+	logger       log.Logger
+
+	cancel     context.CancelFunc
+	errCh      chan error
+	dmlFactory *dmlfactory.SinkFactory
+	ddlSink    ddlsink.Sink
+
+	// tables are the sinks of target tables, indexed by the quoted target table name.
+	tables       map[string]*sinkTable
+	nextTableID  int64
+	lastCommitTs uint64
+}
+
+type sinkTable struct {
+	tableSink tablesink.TableSink
+	// sourceTableInfo is the table info that tableInfo is built from, tableInfo
+	// is rebuilt when the source table info is changed by DDL.
+	sourceTableInfo *model.TableInfo
+	tableInfo       *cdcmodel.TableInfo
+}
+
+func newSinkWriter(cfg *config.SubTaskConfig, logger log.Logger) (*sinkWriter, error) {
+	sinkURI, err := url.Parse(cfg.TargetSink.SinkURI)
+	if err != nil {
+		return nil, terror.ErrConfigTargetSinkNotSupport.Generate(fmt.Sprintf("with an invalid `sink-uri`: %v", err))
+	}
+	replicaConfig := cdcconfig.GetDefaultReplicaConfig()
+	if err = replicaConfig.ValidateAndAdjust(sinkURI); err != nil {
+		return nil, terror.ErrConfigTargetSinkNotSupport.Generate(fmt.Sprintf("with `sink-uri` %s: %v",
+			util.MaskSensitiveDataInURI(cfg.TargetSink.SinkURI), err))
+	}
+
+	w := &sinkWriter{
+		changefeedID: cdcmodel.DefaultChangeFeedID(cfg.Name),
+		sinkURI:      util.MaskSensitiveDataInURI(cfg.TargetSink.SinkURI),
+		enableGTID:   cfg.EnableGTID,
+		logger:       logger.WithFields(zap.String("component", "sink writer")),
+		errCh:        make(chan error, 1),
+		tables:       make(map[string]*sinkTable),
+	}
+	// the sinks run in background until the writer is closed, so they don't
+	// share the lifetime of the context of any caller.
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(context.Background())
+	w.dmlFactory, err = dmlfactory.New(ctx, w.changefeedID, cfg.TargetSink.SinkURI,
+		replicaConfig, w.errCh, pdutil.NewMonotonicClock(clock.New()))
+	if err != nil {
+		w.cancel()
+		return nil, terror.ErrSyncerWriteSink.Delegate(err, w.sinkURI)
+	}
+	w.ddlSink, err = ddlfactory.New(ctx, w.changefeedID, cfg.TargetSink.SinkURI, replicaConfig)
+	if err != nil {
+		w.dmlFactory.Close()
+		w.cancel()
+		return nil, terror.ErrSyncerWriteSink.Delegate(err, w.sinkURI)
+	}
+	w.logger.Info("sink writer created", zap.String("sink-uri", w.sinkURI))
+	return w, nil
+}
+
+// allocCommitTs allocates a commit ts which is greater than all allocated ones.
+func (w *sinkWriter) allocCommitTs(header *replication.EventHeader) uint64 {
+	physical := time.Now()
+	if header != nil && header.Timestamp != 0 {
+		physical = time.Unix(int64(header.Timestamp), 0)
+	}
+	commitTs := oracle.GoTimeToTS(physical)
+	if commitTs <= w.lastCommitTs {
+		commitTs = w.lastCommitTs + 1
+	}
+	w.lastCommitTs = commitTs
+	return commitTs
+}
+
+func (w *sinkWriter) binlogPosition(location binlog.Location) *cdcmodel.BinlogPosition {
+	pos := &cdcmodel.BinlogPosition{
+		File: location.Position.Name,
+		Pos:  location.Position.Pos,
+	}
+	if w.enableGTID {
+		pos.GTID = location.GTIDSetStr()
+	}
+	return pos
+}
+
+// getTable returns the sink of the target table, and makes sure its table info
+// is built from sourceTableInfo.
+func (w *sinkWriter) getTable(
+	targetTable *filter.Table, sourceTableInfo *model.TableInfo, commitTs uint64,
+) *sinkTable {
+	tableID := utils.GenTableID(targetTable)
+	table, ok := w.tables[tableID]
+	if !ok {
+		w.nextTableID++
+		table = &sinkTable{
+			tableSink: w.dmlFactory.CreateTableSinkForConsumer(
+				w.changefeedID, spanz.TableIDToComparableSpan(w.nextTableID), commitTs-1),
+		}
+		w.tables[tableID] = table
+	}
+	if table.sourceTableInfo != sourceTableInfo {
+		table.sourceTableInfo = sourceTableInfo
+		table.tableInfo = wrapTargetTableInfo(targetTable, sourceTableInfo, commitTs)
+	}
+	return table
+}
+
+// writeDMLs publishes the row changes of jobs to the sink, and waits until
+// they are flushed.
+func (w *sinkWriter) writeDMLs(ctx context.Context, jobs []*job) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+	// the sink needs full row images
+	for _, j := range jobs {
+		if j.dml.IsPartialImage() {
+			return terror.Annotate(terror.ErrBinlogNotLogColumn.Generate(), j.targetTable.String())
+		}
+	}
+	w.Lock()
+	defer w.Unlock()
+
+	commitTs := w.allocCommitTs(jobs[len(jobs)-1].eventHeader)
+	tables := make(map[*sinkTable]struct{})
+	for _, j := range jobs {
+		table := w.getTable(j.targetTable, j.dml.SourceTableInfo(), commitTs)
+		row := &cdcmodel.RowChangedEvent{
+			StartTs:        commitTs,
+			CommitTs:       commitTs,
+			TableInfo:      table.tableInfo,
+			Columns:        rowChangeColumns(j.dml.SourceTableInfo(), j.dml.GetPostValues()),
+			PreColumns:     rowChangeColumns(j.dml.SourceTableInfo(), j.dml.GetPreValues()),
+			BinlogPosition: w.binlogPosition(j.startLocation),
+		}
+		table.tableSink.AppendRowChangedEvents(row)
+		tables[table] = struct{}{}
+	}
+
+	resolvedTs := cdcmodel.NewResolvedTs(commitTs)
+	for {
+		flushed := true
+		for table := range tables {
+			if err := table.tableSink.UpdateResolvedTs(resolvedTs); err != nil {
+				return terror.ErrSyncerWriteSink.Delegate(err, w.sinkURI)
+			}
+			if table.tableSink.GetCheckpointTs().Less(resolvedTs) {
+				flushed = false
+			}
+		}
+		if flushed {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-w.errCh:
+			return terror.ErrSyncerWriteSink.Delegate(err, w.sinkURI)
+		case <-time.After(sinkFlushCheckInterval):
+		}
+	}
+}
+
+// writeDDL publishes the DDLs of the job to the sink. getTableInfo returns the
+// table info of the source table after the DDL is tracked, it's used to build
+// the table info of the target table.
+func (w *sinkWriter) writeDDL(
+	ctx context.Context, j *job, getTableInfo func(*filter.Table) (*model.TableInfo, error),
+) error {
+	w.Lock()
+	defer w.Unlock()
+
+	for _, info := range j.ddlInfos {
+		commitTs := w.allocCommitTs(j.eventHeader)
+		targetTable := info.targetTables[0]
+		// the table info can't be found for DDLs like DROP TABLE, only the
+		// table name is published in this case.
+		tableInfo := &cdcmodel.TableInfo{
+			TableName: cdcmodel.TableName{Schema: targetTable.Schema, Table: targetTable.Name},
+			Version:   commitTs,
+		}
+		if targetTable.Name != "" {
+			if ti, err := getTableInfo(info.sourceTables[0]); err == nil {
+				tableInfo = wrapTargetTableInfo(targetTable, ti, commitTs)
+			}
+		}
+		ddl := &cdcmodel.DDLEvent{
+			StartTs:        commitTs,
+			CommitTs:       commitTs,
+			Query:          info.routedDDL,
+			TableInfo:      tableInfo,
+			Type:           ddlActionType(info.originStmt),
+			BinlogPosition: w.binlogPosition(j.startLocation),
+		}
+		if err := w.ddlSink.WriteDDLEvent(ctx, ddl); err != nil {
+			return terror.ErrSyncerWriteSink.Delegate(err, w.sinkURI)
+		}
+	}
+	return nil
+}
+
+func (w *sinkWriter) close() {
+	w.Lock()
+	defer w.Unlock()
+
+	for _, table := range w.tables {
+		table.tableSink.Close()
+	}
+	w.tables = make(map[string]*sinkTable)
+	w.ddlSink.Close()
+	w.dmlFactory.Close()
+	w.cancel()
+}
+
+func wrapTargetTableInfo(targetTable *filter.Table, sourceTableInfo *model.TableInfo, version uint64) *cdcmodel.TableInfo {
+	ti := sourceTableInfo.Clone()
+	ti.Name = ast.NewCIStr(targetTable.Name)
+	return cdcmodel.WrapTableInfo(0, targetTable.Schema, version, ti)
+}
+
+// rowChangeColumns converts the values of a row change to the columns of
+// a RowChangedEvent. The values only contain the non-hidden columns.
+func rowChangeColumns(ti *model.TableInfo, values []interface{}) []*cdcmodel.ColumnData {
+	if values == nil {
+		return nil
+	}
+	columns := make([]*cdcmodel.ColumnData, 0, len(values))
+	i := 0
+	for _, col := range ti.Columns {
+		if col.Hidden {
+			continue
+		}
+		columns = append(columns, &cdcmodel.ColumnData{
+			ColumnID: col.ID,
+			Value:    sinkColumnValue(values[i], &col.FieldType),
+		})
+		i++
+	}
+	return columns
+}
+
+// sinkColumnValue converts the value decoded from binlog to the type that
+// TiCDC codecs expect, which is the same as the type of the value read from
+// TiKV, such as []byte for strings and uint64 for enums.
+func sinkColumnValue(value interface{}, ft *types.FieldType) interface{} {
+	if value == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	switch ft.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeYear:
+		switch {
+		case rv.CanInt() && mysql.HasUnsignedFlag(ft.GetFlag()):
+			return uint64(rv.Int())
+		case rv.CanInt():
+			return rv.Int()
+		case rv.CanUint() && mysql.HasUnsignedFlag(ft.GetFlag()):
+			return rv.Uint()
+		case rv.CanUint():
+			return int64(rv.Uint())
+		}
+	case mysql.TypeEnum, mysql.TypeSet, mysql.TypeBit:
+		switch {
+		case rv.CanInt():
+			return uint64(rv.Int())
+		case rv.CanUint():
+			return rv.Uint()
+		}
+	case mysql.TypeFloat:
+		if rv.CanFloat() {
+			return float32(rv.Float())
+		}
+	case mysql.TypeDouble:
+		if rv.CanFloat() {
+			return rv.Float()
+		}
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		switch v := value.(type) {
+		case string:
+			return []byte(v)
+		case []byte:
+			return v
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// ddlActionType returns the action type of the DDL for the codecs which
+// output the type of DDLs, such as canal-json.
+func ddlActionType(stmt ast.StmtNode) model.ActionType {
+	switch v := stmt.(type) {
+	case *ast.CreateDatabaseStmt:
+		return model.ActionCreateSchema
+	case *ast.DropDatabaseStmt:
+		return model.ActionDropSchema
+	case *ast.AlterDatabaseStmt:
+		return model.ActionModifySchemaCharsetAndCollate
+	case *ast.CreateTableStmt:
+		return model.ActionCreateTable
+	case *ast.DropTableStmt:
+		return model.ActionDropTable
+	case *ast.TruncateTableStmt:
+		return model.ActionTruncateTable
+	case *ast.RenameTableStmt:
+		return model.ActionRenameTable
+	case *ast.CreateIndexStmt:
+		return model.ActionAddIndex
+	case *ast.DropIndexStmt:
+		return model.ActionDropIndex
+	case *ast.AlterTableStmt:
+		// the DDL is split to have only one spec.
+		if len(v.Specs) == 0 {
+			return model.ActionNone
+		}
+		switch v.Specs[0].Tp {
+		case ast.AlterTableAddColumns:
+			return model.ActionAddColumn
+		case ast.AlterTableDropColumn:
+			return model.ActionDropColumn
+		case ast.AlterTableModifyColumn, ast.AlterTableChangeColumn:
+			return model.ActionModifyColumn
+		case ast.AlterTableRenameColumn:
+			return model.ActionRenameColumn
+		case ast.AlterTableRenameTable:
+			return model.ActionRenameTable
+		case ast.AlterTableAddConstraint:
+			return model.ActionAddIndex
+		case ast.AlterTableDropIndex:
+			return model.ActionDropIndex
+		case ast.AlterTableDropPrimaryKey:
+			return model.ActionDropPrimaryKey
+		}
+	}
+	return model.ActionNone
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/parser/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestSinkWriterAllocCommitTs(t *testing.T) {
+	w := &sinkWriter{}
+	header := &replication.EventHeader{Timestamp: 1700000000}
+	ts1 := w.allocCommitTs(header)
+	require.Equal(t, oracle.GoTimeToTS(time.Unix(1700000000, 0)), ts1)
+
+	// the commit ts is monotonic even if the timestamp in header goes back.
+	ts2 := w.allocCommitTs(header)
+	require.Equal(t, ts1+1, ts2)
+	ts3 := w.allocCommitTs(&replication.EventHeader{Timestamp: 1600000000})
+	require.Equal(t, ts2+1, ts3)
+	ts4 := w.allocCommitTs(&replication.EventHeader{Timestamp: 1800000000})
+	require.Equal(t, oracle.GoTimeToTS(time.Unix(1800000000, 0)), ts4)
+}
+
+func TestSinkColumnValue(t *testing.T) {
+	newFT := func(tp byte, flag uint) *types.FieldType {
+		ft := types.NewFieldType(tp)
+		ft.AddFlag(flag)
+		return ft
+	}
+	cases := []struct {
+		value    interface{}
+		ft       *types.FieldType
+		expected interface{}
+	}{
+		{nil, newFT(mysql.TypeLong, 0), nil},
+		{int32(-1), newFT(mysql.TypeLong, 0), int64(-1)},
+		{int8(1), newFT(mysql.TypeTiny, mysql.UnsignedFlag), uint64(1)},
+		{uint64(1 << 63), newFT(mysql.TypeLonglong, mysql.UnsignedFlag), uint64(1 << 63)},
+		{int64(2), newFT(mysql.TypeEnum, 0), uint64(2)},
+		{float32(1.5), newFT(mysql.TypeFloat, 0), float32(1.5)},
+		{float64(1.5), newFT(mysql.TypeDouble, 0), float64(1.5)},
+		{"abc", newFT(mysql.TypeVarchar, 0), []byte("abc")},
+		{[]byte("abc"), newFT(mysql.TypeBlob, 0), []byte("abc")},
+		{"1.10", newFT(mysql.TypeNewDecimal, 0), "1.10"},
+		{"2024-01-01 00:00:00", newFT(mysql.TypeDatetime, 0), "2024-01-01 00:00:00"},
+		{[]byte(`{"a":1}`), newFT(mysql.TypeJSON, 0), `{"a":1}`},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.expected, sinkColumnValue(c.value, c.ft), "value: %v", c.value)
+	}
+}
+
+func TestDDLActionType(t *testing.T) {
+	cases := []struct {
+		sql      string
+		expected model.ActionType
+	}{
+		{"CREATE DATABASE db", model.ActionCreateSchema},
+		{"DROP DATABASE db", model.ActionDropSchema},
+		{"CREATE TABLE db.tb (id INT PRIMARY KEY)", model.ActionCreateTable},
+		{"DROP TABLE db.tb", model.ActionDropTable},
+		{"TRUNCATE TABLE db.tb", model.ActionTruncateTable},
+		{"RENAME TABLE db.tb TO db.tb2", model.ActionRenameTable},
+		{"ALTER TABLE db.tb ADD COLUMN c INT", model.ActionAddColumn},
+		{"ALTER TABLE db.tb DROP COLUMN c", model.ActionDropColumn},
+		{"ALTER TABLE db.tb MODIFY COLUMN c BIGINT", model.ActionModifyColumn},
+		{"ALTER TABLE db.tb ADD INDEX idx(c)", model.ActionAddIndex},
+		{"ALTER TABLE db.tb DROP PRIMARY KEY", model.ActionDropPrimaryKey},
+		{"ALTER TABLE db.tb COMMENT 'x'", model.ActionNone},
+	}
+	p := parser.New()
+	for _, c := range cases {
+		stmt, err := p.ParseOneStmt(c.sql, "", "")
+		require.NoError(t, err)
+		require.Equal(t, c.expected, ddlActionType(stmt), c.sql)
+	}
+}
@@ -14,6 +14,7 @@
 package syncer
 
 import (
+	"math"
 	"time"
 
 	"github.com/pingcap/failpoint"
@@ -46,6 +47,18 @@ func (s *Syncer) Status(sourceStatus *binlog.SourceStatus) interface{} {
 		st.DumpIOTotalBytes = s.cfg.DumpIOTotalBytes.Load()
 	}
 
+	if applyDelay := s.applyDelay.Load(); applyDelay > 0 {
+		st.ApplyDelay = int64(applyDelay.Seconds())
+		if until := s.delayUntil.Load(); !until.IsZero() {
+			st.DelayRemaining = int64(math.Ceil(time.Until(until).Seconds()))
+			if st.DelayRemaining < 0 {
+				st.DelayRemaining = 0
+			}
+		}
+	}
+
+	st.PendingApprovalDDLs, st.PendingApprovalPosition = s.pendingApproval.get()
+
 	if syncerLocation.GetGTID() != nil {
 		st.SyncerBinlogGtid = syncerLocation.GetGTID().String()
 	}
@@ -0,0 +1,137 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/ha"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/dm/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// stopBoundary is where a bounded task stops its binlog replication automatically, only one of the
+// stop time, binlog position and GTID set is specified.
+type stopBoundary struct {
+	stopTime time.Time
+	pos      *mysql.Position
+	gSet     mysql.GTIDSet
+}
+
+// newStopBoundary creates a stopBoundary from the task command line arguments, nil is returned if the
+// task is not bounded. the stop-time without timezone is interpreted in the upstream timezone like start-time.
+func newStopBoundary(cliArgs *config.TaskCliArgs, flavor string, loc *time.Location) (*stopBoundary, error) {
+	if cliArgs == nil || !cliArgs.HasStopBoundary() {
+		return nil, nil
+	}
+	if err := cliArgs.Verify(); err != nil {
+		return nil, err
+	}
+
+	b := &stopBoundary{}
+	switch {
+	case cliArgs.StopTime != "":
+		t, err := utils.ParseStartTimeInLoc(cliArgs.StopTime, loc)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidStopBoundary.Generate(err.Error())
+		}
+		b.stopTime = t
+	case cliArgs.StopBinlogPos != "":
+		pos, err := binlog.PositionFromStr(cliArgs.StopBinlogPos)
+		if err != nil {
+			return nil, err
+		}
+		b.pos = &pos
+	default:
+		gSet, err := gtid.ParserGTID(flavor, cliArgs.StopGTID)
+		if err != nil {
+			return nil, terror.ErrConfigInvalidStopBoundary.Generate(err.Error())
+		}
+		b.gSet = gSet
+	}
+	return b, nil
+}
+
+// String implements Stringer.String.
+func (b *stopBoundary) String() string {
+	switch {
+	case b.pos != nil:
+		return fmt.Sprintf("stop-binlog-pos %s", b.pos)
+	case b.gSet != nil:
+		return fmt.Sprintf("stop-gtid %s", b.gSet)
+	default:
+		return fmt.Sprintf("stop-time %s", b.stopTime)
+	}
+}
+
+// reachedAt returns whether all transactions before the stop binlog position or in the stop GTID set have been
+// replicated when the binlog replication is at the end of a transaction.
+func (b *stopBoundary) reachedAt(txnEndLocation binlog.Location) bool {
+	switch {
+	case b.pos != nil:
+		return binlog.ComparePosition(txnEndLocation.Position, *b.pos) >= 0
+	case b.gSet != nil:
+		gSet := txnEndLocation.GetGTID()
+		return gSet != nil && gSet.Contain(b.gSet)
+	default:
+		return false
+	}
+}
+
+// reachedBy returns whether the binlog event which starts a new transaction is after the stop time. the events
+// without timestamp are ignored, except the heartbeat event which means there is no more event in the upstream
+// now, so the current time of the upstream is compared.
+func (b *stopBoundary) reachedBy(e *replication.BinlogEvent, upstreamNow time.Time) bool {
+	if b.stopTime.IsZero() {
+		return false
+	}
+	if e.Header.EventType == replication.HEARTBEAT_EVENT {
+		return upstreamNow.After(b.stopTime)
+	}
+	return e.Header.Timestamp != 0 && int64(e.Header.Timestamp) > b.stopTime.Unix()
+}
+
+// finishAtStopBoundary flushes all jobs and the checkpoint after the stop boundary is reached, and marks the
+// subtask as Finished in etcd so it will not be resumed by the DM-worker.
+func (s *Syncer) finishAtStopBoundary(lastTxnEndLocation binlog.Location) error {
+	s.tctx.L().Info("binlog replication reaches the stop boundary, will finish",
+		zap.Stringer("boundary", s.stopBoundary),
+		zap.Stringer("last location", lastTxnEndLocation))
+	if err := s.flushJobs(); err != nil {
+		return err
+	}
+	if s.execError.Load() != nil {
+		// the error is reported by runFatalChan, the subtask will be paused rather than finished.
+		return nil
+	}
+	if s.cli == nil {
+		// for dummy syncer in ut
+		return nil
+	}
+	finished, _, err := ha.FinishSubTaskStage(s.cli, s.cfg.SourceID, s.cfg.Name)
+	if err != nil {
+		return err
+	}
+	if !finished {
+		s.tctx.L().Warn("expectant stage of subtask is not Running, don't mark it as Finished")
+	}
+	return nil
+}
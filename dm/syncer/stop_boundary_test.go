@@ -0,0 +1,88 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
+	"github.com/pingcap/tiflow/dm/pkg/gtid"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopBoundary(t *testing.T) {
+	t.Parallel()
+
+	// not bounded
+	b, err := newStopBoundary(nil, mysql.MySQLFlavor, time.UTC)
+	require.NoError(t, err)
+	require.Nil(t, b)
+	b, err = newStopBoundary(&config.TaskCliArgs{StartTime: "2022-01-01 00:00:00"}, mysql.MySQLFlavor, time.UTC)
+	require.NoError(t, err)
+	require.Nil(t, b)
+
+	_, err = newStopBoundary(&config.TaskCliArgs{
+		StopTime:      "2022-01-01 00:00:00",
+		StopBinlogPos: "mysql-bin.000002:4",
+	}, mysql.MySQLFlavor, time.UTC)
+	require.True(t, terror.ErrConfigInvalidStopBoundary.Equal(err))
+
+	// stop-binlog-pos
+	b, err = newStopBoundary(&config.TaskCliArgs{StopBinlogPos: "mysql-bin.000002:1000"}, mysql.MySQLFlavor, time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, "stop-binlog-pos (mysql-bin.000002, 1000)", b.String())
+	loc := binlog.NewLocation(mysql.Position{Name: "mysql-bin.000002", Pos: 500}, nil)
+	require.False(t, b.reachedAt(loc))
+	loc.Position.Pos = 1000
+	require.True(t, b.reachedAt(loc))
+	loc.Position = mysql.Position{Name: "mysql-bin.000003", Pos: 4}
+	require.True(t, b.reachedAt(loc))
+	require.False(t, b.reachedBy(&replication.BinlogEvent{Header: &replication.EventHeader{Timestamp: 1}}, time.Now()))
+
+	// stop-gtid
+	b, err = newStopBoundary(&config.TaskCliArgs{StopGTID: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14"}, mysql.MySQLFlavor, time.UTC)
+	require.NoError(t, err)
+	gSet, err := gtid.ParserGTID(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-10")
+	require.NoError(t, err)
+	loc = binlog.NewLocation(mysql.Position{Name: "mysql-bin.000002", Pos: 500}, gSet)
+	require.False(t, b.reachedAt(loc))
+	gSet, err = gtid.ParserGTID(mysql.MySQLFlavor, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-20,53bfca22-690d-11e7-8a62-18ded7a37b78:1-5")
+	require.NoError(t, err)
+	loc = binlog.NewLocation(mysql.Position{Name: "mysql-bin.000002", Pos: 500}, gSet)
+	require.True(t, b.reachedAt(loc))
+
+	// stop-time
+	b, err = newStopBoundary(&config.TaskCliArgs{StopTime: "2022-01-01 00:00:00"}, mysql.MySQLFlavor, time.UTC)
+	require.NoError(t, err)
+	stopTS := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.True(t, b.stopTime.Equal(stopTS))
+	require.False(t, b.reachedAt(loc))
+	require.False(t, b.reachedBy(&replication.BinlogEvent{Header: &replication.EventHeader{Timestamp: uint32(stopTS.Unix())}}, stopTS))
+	require.True(t, b.reachedBy(&replication.BinlogEvent{Header: &replication.EventHeader{Timestamp: uint32(stopTS.Unix() + 1)}}, stopTS))
+	// events without timestamp are ignored
+	require.False(t, b.reachedBy(&replication.BinlogEvent{Header: &replication.EventHeader{}}, stopTS.Add(time.Hour)))
+	heartbeat := &replication.BinlogEvent{Header: &replication.EventHeader{EventType: replication.HEARTBEAT_EVENT}}
+	require.False(t, b.reachedBy(heartbeat, stopTS.Add(-time.Second)))
+	require.True(t, b.reachedBy(heartbeat, stopTS.Add(time.Second)))
+
+	// stop-time with timezone
+	b, err = newStopBoundary(&config.TaskCliArgs{StopTime: "2022-01-01T08:00:00+08:00"}, mysql.MySQLFlavor, time.UTC)
+	require.NoError(t, err)
+	require.True(t, b.stopTime.Equal(stopTS))
+}
@@ -132,6 +132,7 @@ type Syncer struct {
 	cfg            *config.SubTaskConfig
 	syncCfg        replication.BinlogSyncerConfig
 	cliArgs        *config.TaskCliArgs
+	stopBoundary   *stopBoundary
 	metricsProxies *metrics.Proxies
 
 	sgk  *ShardingGroupKeeper    // keeper to keep all sharding (sub) group in this syncer
@@ -156,6 +157,9 @@ type Syncer struct {
 	ddlDB               *conn.BaseDB
 	ddlDBConn           *dbconn.DBConn
 	downstreamTrackConn *dbconn.DBConn
+	// sinkWriter publishes binlog events to a TiCDC sink instead of the downstream
+	// database, it's nil if `target-sink` is not set.
+	sinkWriter *sinkWriter
 
 	dmlJobCh            chan *job
 	ddlJobCh            chan *job
@@ -230,6 +234,9 @@ type Syncer struct {
 
 	cutOverLocation atomic.Pointer[binlog.Location]
 
+	ddlApprover     *ddlApprover
+	pendingApproval pendingApproval
+
 	handleJobFunc func(*job) (bool, error)
 	flushSeq      int64
 
@@ -244,6 +251,11 @@ type Syncer struct {
 	// the minimal timestamp of currently processing binlog events.
 	// this lag will consider time difference between upstream and DM nodes
 	secondsBehindMaster atomic.Int64
+	// binlog events are applied no earlier than applyDelay after they are executed in the upstream,
+	// it's loaded from `apply-delay` when initializing and updating the config.
+	applyDelay atomic.Duration
+	// the time until which the current binlog event is held for applyDelay, zero if no event is held.
+	delayUntil atomic.Time
 	// stores the last job TS(binlog event timestamp) of each worker,
 	// if there's no active job, the corresponding worker's TS is reset to 0.
 	// since DML worker runs jobs in batch, the TS is the TS of the first job in the batch.
@@ -397,6 +409,7 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	if err := config.CheckForeignKeyChecksSyncerOptions(s.cfg.To.Session, s.cfg.SyncerConfig); err != nil {
 		return err
 	}
+	s.applyDelay.Store(s.cfg.ApplyDelayDuration())
 	s.upstreamTZ, s.upstreamTZStr, err = str2TimezoneOrFromDB(tctx, "", conn.UpstreamDBConfig(&s.cfg.From))
 	if err != nil {
 		return err
@@ -422,6 +435,14 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	rollbackHolder.Add(fr.FuncRollback{Name: "close-DBs", Fn: s.closeDBs})
 
+	if s.cfg.TargetSink != nil {
+		s.sinkWriter, err = newSinkWriter(s.cfg, s.tctx.L())
+		if err != nil {
+			return err
+		}
+		rollbackHolder.Add(fr.FuncRollback{Name: "close-sink-writer", Fn: s.closeSinkWriter})
+	}
+
 	if s.cfg.CollationCompatible == config.StrictCollationCompatible {
 		s.charsetAndDefaultCollation, s.idAndCollationMap, err = dbconn.GetCharsetAndCollationInfo(tctx, s.fromConn)
 		if err != nil {
@@ -467,7 +488,7 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 
 	var schemaMap map[string]string
 	var tableMap map[string]map[string]string
-	if s.SourceTableNamesFlavor == conn.LCTableNamesSensitive {
+	if s.SourceTableNamesFlavor == conn.LCTableNamesSensitive && s.fromDB != nil {
 		// TODO: we should avoid call this function multi times
 		allTables, err1 := conn.FetchAllDoTables(ctx, s.fromDB.BaseDB, s.baList)
 		if err1 != nil {
@@ -533,6 +554,10 @@ func (s *Syncer) Init(ctx context.Context) (err error) {
 	}
 	s.metricsProxies = metricProxies.CacheForOneTask(s.cfg.Name, s.cfg.WorkerName, s.cfg.SourceID)
 
+	s.ddlApprover, err = newDDLApprover(s.cfg.DDLApproval)
+	if err != nil {
+		return err
+	}
 	s.ddlWorker = NewDDLWorker(&s.tctx.Logger, s)
 	return nil
 }
@@ -667,6 +692,7 @@ func (s *Syncer) reset() {
 
 	s.execError.Store(nil)
 	s.setErrLocation(nil, nil, false)
+	s.pendingApproval.set(nil, binlog.Location{})
 	s.waitXIDJob.Store(int64(noWait))
 	s.isTransactionEnd = true
 	s.flushSeq = 0
@@ -845,16 +871,27 @@ func (s *Syncer) getTableInfo(tctx *tcontext.Context, sourceTable, targetTable *
 	return ti, nil
 }
 
+// schemaSourceConn returns the connection and the table to fetch the table
+// structure from. There is no table in the downstream database when binlog
+// events are published to a sink, so the upstream table is used instead.
+func (s *Syncer) schemaSourceConn(sourceTable, targetTable *filter.Table) (*dbconn.DBConn, *filter.Table) {
+	if s.sinkWriter != nil {
+		return s.fromConn, sourceTable
+	}
+	return s.ddlDBConn, targetTable
+}
+
 // getDBInfoFromDownstream tries to track the db info from the downstream. It will not overwrite existing table.
 func (s *Syncer) getDBInfoFromDownstream(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) (*model.DBInfo, error) {
 	// TODO: Switch to use the HTTP interface to retrieve the TableInfo directly if HTTP port is available
 	// use parser for downstream.
-	parser2, err := dbconn.GetParserForConn(tctx, s.ddlDBConn)
+	downstreamConn, downstreamTable := s.schemaSourceConn(sourceTable, targetTable)
+	parser2, err := dbconn.GetParserForConn(tctx, downstreamConn)
 	if err != nil {
 		return nil, terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
 
-	createSQL, err := dbconn.GetSchemaCreateSQL(tctx, s.ddlDBConn, targetTable.Schema)
+	createSQL, err := dbconn.GetSchemaCreateSQL(tctx, downstreamConn, downstreamTable.Schema)
 	if err != nil {
 		return nil, terror.ErrSchemaTrackerCannotFetchDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
@@ -888,12 +925,13 @@ func (s *Syncer) getDBInfoFromDownstream(tctx *tcontext.Context, sourceTable, ta
 func (s *Syncer) trackTableInfoFromDownstream(tctx *tcontext.Context, sourceTable, targetTable *filter.Table) error {
 	// TODO: Switch to use the HTTP interface to retrieve the TableInfo directly if HTTP port is available
 	// use parser for downstream.
-	parser2, err := dbconn.GetParserForConn(tctx, s.ddlDBConn)
+	downstreamConn, downstreamTable := s.schemaSourceConn(sourceTable, targetTable)
+	parser2, err := dbconn.GetParserForConn(tctx, downstreamConn)
 	if err != nil {
 		return terror.ErrSchemaTrackerCannotParseDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
 
-	createSQL, err := dbconn.GetTableCreateSQL(tctx, s.ddlDBConn, targetTable.String())
+	createSQL, err := dbconn.GetTableCreateSQL(tctx, downstreamConn, downstreamTable.String())
 	if err != nil {
 		return terror.ErrSchemaTrackerCannotFetchDownstreamTable.Delegate(err, targetTable, sourceTable)
 	}
@@ -969,6 +1007,32 @@ func (s *Syncer) calcReplicationLag(headerTS int64) int64 {
 	return time.Now().Unix() - s.tsOffset.Load() - headerTS
 }
 
+// waitApplyDelay holds the binlog event until applyDelay after it's executed in the upstream.
+// the events without timestamp, such as fake rotate events and heartbeat events, are not held.
+func (s *Syncer) waitApplyDelay(ctx context.Context, e *replication.BinlogEvent) error {
+	delay := s.applyDelay.Load()
+	if delay <= 0 || e.Header.Timestamp == 0 {
+		return nil
+	}
+	// convert to the time of DM nodes to tolerate the clock difference, see tsOffset.
+	until := time.Unix(int64(e.Header.Timestamp)+s.tsOffset.Load(), 0).Add(delay)
+	wait := time.Until(until)
+	if wait <= 0 {
+		return nil
+	}
+
+	s.delayUntil.Store(until)
+	defer s.delayUntil.Store(time.Time{})
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // updateReplicationJobTS store job TS, it is called after every batch dml job / one skip job / one ddl job is added and committed.
 func (s *Syncer) updateReplicationJobTS(job *job, jobIdx int) {
 	// when job is nil mean no job in this bucket, need to reset this bucket job ts to 0
@@ -1484,7 +1548,9 @@ func (s *Syncer) syncDDL(queueBucket string, db *dbconn.DBConn, ddlJobChan chan
 			failpoint.Goto("bypass")
 		})
 
-		if !ignore {
+		if !ignore && s.sinkWriter != nil {
+			err = s.sinkWriter.writeDDL(s.syncCtx.Ctx, ddlJob, s.schemaTracker.GetTableInfo)
+		} else if !ignore {
 			failpoint.Inject("SkipSaveGlobalPoint", func() {
 				s.tctx.L().Info("skip save global point", zap.String("failpoint", "SkipSaveGlobalPoint"))
 				panic("SkipSaveGlobalPoint")
@@ -1753,6 +1819,10 @@ func (s *Syncer) updateLagCronJob(ctx context.Context) {
 }
 
 func (s *Syncer) updateTSOffset(ctx context.Context) error {
+	if s.fromDB == nil {
+		// the replication lag of an offline source is calculated by the local clock.
+		return nil
+	}
 	t1 := time.Now()
 	ts, tsErr := s.fromDB.GetServerUnixTS(ctx)
 	rtt := time.Since(t1).Seconds()
@@ -1804,6 +1874,10 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		}
 		skipLoadMeta = err == nil
 	}
+	s.stopBoundary, err = newStopBoundary(s.cliArgs, s.cfg.Flavor, s.upstreamTZ)
+	if err != nil {
+		return err
+	}
 
 	// some initialization that can't be put in Syncer.Init
 	if fresh && !skipLoadMeta {
@@ -2082,6 +2156,12 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		if s.execError.Load() != nil {
 			return nil
 		}
+		// endLocation equals to lastTxnEndLocation when not in a transaction
+		if s.stopBoundary != nil && shardingReSync == nil &&
+			binlog.ComparePosition(endLocation.Position, lastTxnEndLocation.Position) == 0 &&
+			s.stopBoundary.reachedAt(lastTxnEndLocation) {
+			return s.finishAtStopBoundary(lastTxnEndLocation)
+		}
 		s.currentLocationMu.Lock()
 		s.currentLocationMu.currentLocation = endLocation
 		s.currentLocationMu.Unlock()
@@ -2270,6 +2350,18 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 		s.binlogSizeCount.Add(int64(e.Header.EventSize))
 		s.metricsProxies.Metrics.BinlogEventSizeHistogram.Observe(float64(e.Header.EventSize))
 
+		// the event which starts a new transaction after the stop time is not replicated
+		if s.stopBoundary != nil && shardingReSync == nil &&
+			binlog.ComparePosition(s.streamerController.GetCurStartLocation().Position, s.streamerController.GetTxnEndLocation().Position) == 0 &&
+			s.stopBoundary.reachedBy(e, time.Unix(time.Now().Unix()-s.tsOffset.Load(), 0)) {
+			return s.finishAtStopBoundary(s.streamerController.GetTxnEndLocation())
+		}
+
+		if err = s.waitApplyDelay(s.runCtx, e); err != nil {
+			s.tctx.L().Info("binlog replication main routine quit(context canceled) when delaying binlog event", zap.Stringer("last location", lastTxnEndLocation))
+			return nil
+		}
+
 		failpoint.Inject("ProcessBinlogSlowDown", nil)
 
 		s.tctx.L().Debug("receive binlog event", zap.Reflect("header", e.Header))
@@ -2365,6 +2457,7 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			safeMode:            s.safeMode.Enable(),
 			startTime:           startTime,
 			shardingReSyncCh:    &shardingReSyncCh,
+			approved:            op != pb.ErrorOp_InvalidErrorOp,
 		}
 
 		var originSQL string // show origin sql when error, only ddl now
@@ -2542,6 +2635,9 @@ type eventContext struct {
 	safeMode         bool
 	startTime        time.Time
 	shardingReSyncCh *chan *ShardingReSync
+	// approved is true when the event is approved by `binlog approve` or generated by `binlog replace`
+	// and `binlog inject`, so it doesn't need DDL approval.
+	approved bool
 }
 
 // TODO: Further split into smaller functions and group common arguments into a context struct.
@@ -2667,10 +2763,6 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		return nil, terror.WithScope(err, terror.ScopeDownstream)
 	}
 	originRows := ev.Rows
-	if err2 := checkLogColumns(ev.SkippedColumns); err2 != nil {
-		return nil, err2
-	}
-
 	extRows := generateExtendColumn(originRows, s.tableRouter, sourceTable, s.cfg.SourceID)
 
 	var dmls []*sqlmodel.RowChange
@@ -2681,6 +2773,9 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		sourceTableInfo: tableInfo,
 		sourceTable:     sourceTable,
 		extendData:      extRows,
+		// when upstream binlog_row_image is not FULL, the row images are
+		// partial, and only the logged columns are replicated.
+		skippedColumns: ev.SkippedColumns,
 	}
 
 	switch ec.header.EventType {
@@ -2697,7 +2792,8 @@ func (s *Syncer) handleRowsEvent(ev *replication.RowsEvent, ec eventContext) (*f
 		}
 		s.metricsProxies.BinlogEventCost.WithLabelValues(metrics.BinlogEventCostStageGenWriteRows, s.cfg.Name, s.cfg.WorkerName, s.cfg.SourceID).Observe(time.Since(ec.startTime).Seconds())
 
-	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2:
+	case replication.UPDATE_ROWS_EVENTv0, replication.UPDATE_ROWS_EVENTv1, replication.UPDATE_ROWS_EVENTv2,
+		replication.PARTIAL_UPDATE_ROWS_EVENT:
 		oldExprFilter, newExprFilter, err2 := s.exprFilterGroup.GetUpdateExprs(sourceTable, tableInfo)
 		if err2 != nil {
 			return nil, err2
@@ -2775,6 +2871,8 @@ type queryEventContext struct {
 	splitDDLs      []string // after split before online ddl
 	appliedDDLs    []string // after onlineDDL apply if onlineDDL != nil
 	needHandleDDLs []string // after route
+	// the original DDLs which need approval before being executed, see `ddlApprover`.
+	needApprovalDDLs []string
 
 	shardingDDLInfo *ddlInfo
 	trackInfos      []*ddlInfo
@@ -3156,23 +3254,13 @@ func (s *Syncer) loadTableStructureFromDump(ctx context.Context) error {
 
 func (s *Syncer) createDBs(ctx context.Context) error {
 	var err error
-	dbCfg := s.cfg.From
-	dbCfg.RawDBCfg = dbconfig.DefaultRawDBConfig().SetReadTimeout(maxDMLConnectionTimeout)
-	fromDB, fromConns, err := dbconn.CreateConns(s.tctx, s.cfg, conn.UpstreamDBConfig(&dbCfg), 1, s.cfg.DumpIOTotalBytes, s.cfg.DumpUUID)
-	if err != nil {
-		return err
-	}
-	s.fromDB = &dbconn.UpStreamConn{BaseDB: fromDB}
-	s.fromConn = fromConns[0]
-	baseConn, err := s.fromDB.BaseDB.GetBaseConn(ctx)
-	if err != nil {
-		return err
-	}
-	lcFlavor, err := conn.FetchLowerCaseTableNamesSetting(ctx, baseConn)
-	if err != nil {
-		return err
+	// there is no upstream database for an offline source, the default settings of
+	// lower_case_table_names and sql_mode are used.
+	if !s.cfg.OfflineBinlog {
+		if err = s.createUpstreamDB(ctx); err != nil {
+			return err
+		}
 	}
-	s.SourceTableNamesFlavor = lcFlavor
 
 	hasSQLMode := false
 	// get sql_mode from upstream db
@@ -3187,9 +3275,13 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 		}
 	}
 	if !hasSQLMode {
-		sqlMode, err2 := conn.GetGlobalVariable(tcontext.NewContext(ctx, log.L()), s.fromDB.BaseDB, "sql_mode")
-		if err2 != nil {
-			s.tctx.L().Warn("cannot get sql_mode from upstream database, the sql_mode will be assigned \"IGNORE_SPACE, NO_AUTO_VALUE_ON_ZERO, ALLOW_INVALID_DATES\"", log.ShortError(err2))
+		var sqlMode string
+		if s.fromDB != nil {
+			var err2 error
+			sqlMode, err2 = conn.GetGlobalVariable(tcontext.NewContext(ctx, log.L()), s.fromDB.BaseDB, "sql_mode")
+			if err2 != nil {
+				s.tctx.L().Warn("cannot get sql_mode from upstream database, the sql_mode will be assigned \"IGNORE_SPACE, NO_AUTO_VALUE_ON_ZERO, ALLOW_INVALID_DATES\"", log.ShortError(err2))
+			}
 		}
 		sqlModes, err3 := conn.AdjustSQLModeCompatible(sqlMode)
 		if err3 != nil {
@@ -3198,7 +3290,7 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 		s.cfg.To.Session["sql_mode"] = sqlModes
 	}
 
-	dbCfg = s.cfg.To
+	dbCfg := s.cfg.To
 	dbCfg.RawDBCfg = dbconfig.DefaultRawDBConfig().
 		SetReadTimeout(maxDMLConnectionTimeout).
 		SetMaxIdleConns(s.cfg.WorkerCount)
@@ -3221,12 +3313,35 @@ func (s *Syncer) createDBs(ctx context.Context) error {
 	}
 	s.ddlDBConn = ddlDBConns[0]
 	s.downstreamTrackConn = ddlDBConns[1]
-	printServerVersion(s.tctx, s.fromDB.BaseDB, "upstream")
+	if s.fromDB != nil {
+		printServerVersion(s.tctx, s.fromDB.BaseDB, "upstream")
+	}
 	printServerVersion(s.tctx, s.toDB, "downstream")
 
 	return nil
 }
 
+func (s *Syncer) createUpstreamDB(ctx context.Context) error {
+	dbCfg := s.cfg.From
+	dbCfg.RawDBCfg = dbconfig.DefaultRawDBConfig().SetReadTimeout(maxDMLConnectionTimeout)
+	fromDB, fromConns, err := dbconn.CreateConns(s.tctx, s.cfg, conn.UpstreamDBConfig(&dbCfg), 1, s.cfg.DumpIOTotalBytes, s.cfg.DumpUUID)
+	if err != nil {
+		return err
+	}
+	s.fromDB = &dbconn.UpStreamConn{BaseDB: fromDB}
+	s.fromConn = fromConns[0]
+	baseConn, err := s.fromDB.BaseDB.GetBaseConn(ctx)
+	if err != nil {
+		return err
+	}
+	lcFlavor, err := conn.FetchLowerCaseTableNamesSetting(ctx, baseConn)
+	if err != nil {
+		return err
+	}
+	s.SourceTableNamesFlavor = lcFlavor
+	return nil
+}
+
 // closeBaseDB closes all opened DBs, rollback for createConns.
 func (s *Syncer) closeDBs() {
 	dbconn.CloseUpstreamConn(s.tctx, s.fromDB)
@@ -3296,6 +3411,7 @@ func (s *Syncer) Close() {
 		s.sgk.Close()
 	}
 	s.closeOnlineDDL()
+	s.closeSinkWriter()
 	// when closing syncer by `stop-task`, remove active relay log from hub
 	s.removeActiveRelayLog()
 	s.metricsProxies.RemoveLabelValuesWithTaskInMetrics(s.cfg.Name)
@@ -3337,6 +3453,13 @@ func (s *Syncer) closeOnlineDDL() {
 	}
 }
 
+func (s *Syncer) closeSinkWriter() {
+	if s.sinkWriter != nil {
+		s.sinkWriter.close()
+		s.sinkWriter = nil
+	}
+}
+
 // Pause implements Unit.Pause.
 func (s *Syncer) Pause() {
 	if s.isClosed() {
@@ -3526,6 +3649,7 @@ func (s *Syncer) Update(ctx context.Context, cfg *config.SubTaskConfig) (err err
 	}
 	// update syncer config
 	s.cfg.SyncerConfig = cfg.SyncerConfig
+	s.applyDelay.Store(s.cfg.ApplyDelayDuration())
 
 	// updated fileds that changed in func `copyConfigFromSource`
 	s.cfg.From = cfg.From
@@ -3626,15 +3750,18 @@ func (s *Syncer) adjustGlobalPointGTID(tctx *tcontext.Context) (bool, error) {
 		s.tctx.L().Warn("fail to get gtids for global location", zap.Stringer("pos", location), zap.Error(err))
 		return false, err
 	}
-	dbConn, err := s.fromDB.BaseDB.GetBaseConn(tctx.Context())
-	if err != nil {
-		s.tctx.L().Warn("fail to build connection", zap.Stringer("pos", location), zap.Error(err))
-		return false, err
-	}
-	gs, err = conn.AddGSetWithPurged(tctx.Context(), gs, dbConn)
-	if err != nil {
-		s.tctx.L().Warn("fail to merge purged gtidSet", zap.Stringer("pos", location), zap.Error(err))
-		return false, err
+	// the purged GTID sets of an offline source are unknown.
+	if s.fromDB != nil {
+		dbConn, err2 := s.fromDB.BaseDB.GetBaseConn(tctx.Context())
+		if err2 != nil {
+			s.tctx.L().Warn("fail to build connection", zap.Stringer("pos", location), zap.Error(err2))
+			return false, err2
+		}
+		gs, err = conn.AddGSetWithPurged(tctx.Context(), gs, dbConn)
+		if err != nil {
+			s.tctx.L().Warn("fail to merge purged gtidSet", zap.Stringer("pos", location), zap.Error(err))
+			return false, err
+		}
 	}
 	err = location.SetGTID(gs)
 	if err != nil {
@@ -3906,6 +4033,13 @@ func (s *Syncer) prepareDownStreamTableInfo(
 	targetTable *filter.Table,
 	originTI *model.TableInfo,
 ) (*schema.DownstreamTableInfo, error) {
+	if s.sinkWriter != nil {
+		// the target table is published to the sink as the same as the source table.
+		return &schema.DownstreamTableInfo{
+			TableInfo:   originTI,
+			WhereHandle: sqlmodel.GetWhereHandle(originTI, originTI),
+		}, nil
+	}
 	tableID := utils.GenTableID(targetTable)
 	dti, err := s.schemaTracker.GetDownStreamTableInfoWithoutForeignKey(tctx, tableID, originTI)
 	if err != nil {
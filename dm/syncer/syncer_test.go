@@ -2626,3 +2626,34 @@ func TestUpdateFKHotUpdateGuardAllows(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, skipped)
 }
+
+func TestWaitApplyDelay(t *testing.T) {
+	t.Parallel()
+
+	s := &Syncer{}
+	now := time.Now().Unix()
+	newEvent := func(ts int64) *replication.BinlogEvent {
+		return &replication.BinlogEvent{Header: &replication.EventHeader{Timestamp: uint32(ts)}}
+	}
+
+	// no delay
+	require.NoError(t, s.waitApplyDelay(context.Background(), newEvent(now)))
+
+	// events older than the delay and events without timestamp are not held
+	s.applyDelay.Store(time.Hour)
+	require.NoError(t, s.waitApplyDelay(context.Background(), newEvent(now-7200)))
+	require.NoError(t, s.waitApplyDelay(context.Background(), newEvent(0)))
+
+	// the event is held until the context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.waitApplyDelay(ctx, newEvent(now)), context.DeadlineExceeded)
+	require.True(t, s.delayUntil.Load().IsZero())
+
+	// the event is held until the delay passed
+	s.applyDelay.Store(time.Second)
+	start := time.Now()
+	require.NoError(t, s.waitApplyDelay(context.Background(), newEvent(time.Now().Unix())))
+	require.Less(t, time.Since(start), 2*time.Second)
+	require.True(t, s.delayUntil.Load().IsZero())
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/filter"
 	"github.com/pingcap/tiflow/dm/config"
+	"github.com/pingcap/tiflow/dm/pkg/binlog"
 	"github.com/pingcap/tiflow/dm/pkg/binlog/common"
 	"github.com/pingcap/tiflow/dm/pkg/conn"
 	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
@@ -157,7 +158,21 @@ func subtaskCfg2BinlogSyncerCfg(cfg *config.SubTaskConfig, timezone *time.Locati
 		}
 	}
 
-	var rowsEventDecodeFunc func(*replication.RowsEvent, []byte) error
+	// go-mysql only decodes the first JSON diff of a partial JSON column, so
+	// the JSON diffs are decoded again after the rows are decoded.
+	decodeData := func(re *replication.RowsEvent, pos int, data []byte) error {
+		if err := re.DecodeData(pos, data); err != nil {
+			return err
+		}
+		return binlog.DecodeJSONDiffs(re, pos, data)
+	}
+	rowsEventDecodeFunc := func(re *replication.RowsEvent, data []byte) error {
+		pos, err := re.DecodeHeader(data)
+		if err != nil {
+			return err
+		}
+		return decodeData(re, pos, data)
+	}
 	if baList != nil {
 		// we don't track delete table events, so simply reset the cache if it's full
 		// TODO: use LRU or CLOCK cache if needed.
@@ -178,7 +193,7 @@ func subtaskCfg2BinlogSyncerCfg(cfg *config.SubTaskConfig, timezone *time.Locati
 			if _, ok := blockListCache[tb]; ok {
 				return nil
 			} else if _, ok := allowListCache[tb]; ok {
-				return re.DecodeData(pos, data)
+				return decodeData(re, pos, data)
 			}
 
 			if skipByTable(baList, &tb) {
@@ -193,7 +208,7 @@ func subtaskCfg2BinlogSyncerCfg(cfg *config.SubTaskConfig, timezone *time.Locati
 				allowListCache = make(map[filter.Table]struct{}, maxCapacity)
 			}
 			allowListCache[tb] = struct{}{}
-			return re.DecodeData(pos, data)
+			return decodeData(re, pos, data)
 		}
 	}
 
@@ -63,6 +63,7 @@ type validatorPersistHelper struct {
 	pendingChangeTableName string
 	errorChangeTableName   string
 	tableStatusTableName   string
+	repairActionTableName  string
 
 	db                *conn.BaseDB
 	schemaInitialized atomic.Bool
@@ -90,6 +91,7 @@ func newValidatorCheckpointHelper(validator *DataValidator) *validatorPersistHel
 		pendingChangeTableName: dbutil.TableName(cfg.MetaSchema, cputil.ValidatorPendingChange(cfg.Name)),
 		errorChangeTableName:   dbutil.TableName(cfg.MetaSchema, cputil.ValidatorErrorChange(cfg.Name)),
 		tableStatusTableName:   dbutil.TableName(cfg.MetaSchema, cputil.ValidatorTableStatus(cfg.Name)),
+		repairActionTableName:  dbutil.TableName(cfg.MetaSchema, cputil.ValidatorRepairAction(cfg.Name)),
 	}
 
 	return c
@@ -187,6 +189,21 @@ func (c *validatorPersistHelper) createTable(tctx *tcontext.Context) error {
 			UNIQUE KEY uk_source_schema_table_key(source, src_schema_name, src_table_name),
 			INDEX idx_stage(stage)
 		)`,
+		`CREATE TABLE IF NOT EXISTS ` + c.repairActionTableName + ` (
+			id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			source VARCHAR(32) NOT NULL,
+			error_id BIGINT NOT NULL,
+			src_schema_name VARCHAR(128) NOT NULL,
+			src_table_name VARCHAR(128) NOT NULL,
+			row_pk VARCHAR(` + maxRowKeyLengthStr + `) NOT NULL,
+			dst_schema_name VARCHAR(128) NOT NULL,
+			dst_table_name VARCHAR(128) NOT NULL,
+			action VARCHAR(16) NOT NULL,
+			repair_sql TEXT NOT NULL,
+			repair_args JSON NOT NULL,
+			create_time timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			INDEX idx_source_error(source, error_id)
+		)`,
 	}
 	tctx.L().Info("create checkpoint and data table", zap.Strings("statements", sqls))
 	for _, q := range sqls {
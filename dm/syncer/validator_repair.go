@@ -0,0 +1,284 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/terror"
+	"github.com/pingcap/tiflow/pkg/sqlmodel"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+const (
+	repairActionReplace = "replace"
+	repairActionDelete  = "delete"
+)
+
+// validatorRepairOpTimeout is the timeout of repairing error rows by dmctl, it should be
+// less than the RPC timeout between dm-master and dm-worker.
+var validatorRepairOpTimeout = 20 * time.Second
+
+// errorRowForRepair is an error row loaded from the error change table.
+type errorRowForRepair struct {
+	id          uint64
+	sourceTable filter.Table
+	targetTable filter.Table
+	key         string
+	data        []interface{}
+}
+
+// repairAction is the statement generated to repair an error row.
+type repairAction struct {
+	action string
+	query  string
+	args   []interface{}
+}
+
+// loadErrorRowsForRepair loads the error rows to repair. if isAll is false, only the error
+// row of errID is loaded, which can be either a new or an ignored error, otherwise at most
+// limit new error rows are loaded, limit <= 0 means no limit.
+func (c *validatorPersistHelper) loadErrorRowsForRepair(
+	tctx *tcontext.Context, db *conn.BaseDB, errID uint64, isAll bool, limit int,
+) ([]*errorRowForRepair, error) {
+	query := "SELECT id, src_schema_name, src_table_name, dst_schema_name, dst_table_name, row_pk, data FROM " +
+		c.errorChangeTableName + " WHERE source = ?"
+	args := []interface{}{c.cfg.SourceID}
+	if isAll {
+		query += " AND status = ? ORDER BY id"
+		args = append(args, int(pb.ValidateErrorState_NewErr))
+		if limit > 0 {
+			query += fmt.Sprintf(" LIMIT %d", limit)
+		}
+	} else {
+		query += " AND id = ? AND status IN (?, ?)"
+		args = append(args, errID, int(pb.ValidateErrorState_NewErr), int(pb.ValidateErrorState_IgnoredErr))
+	}
+	rows, err := db.QueryContext(tctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make([]*errorRowForRepair, 0)
+	for rows.Next() {
+		var (
+			row  errorRowForRepair
+			data []byte
+		)
+		err = rows.Scan(&row.id, &row.sourceTable.Schema, &row.sourceTable.Name,
+			&row.targetTable.Schema, &row.targetTable.Name, &row.key, &data)
+		if err != nil {
+			return nil, err
+		}
+		// use json.Number to keep the precision of big integers
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err = decoder.Decode(&row.data); err != nil {
+			return nil, err
+		}
+		res = append(res, &row)
+	}
+	return res, rows.Err()
+}
+
+// applyRepairAction executes the repair statement in the downstream, marks the error row as
+// resolved and records the repair action in one transaction.
+func (c *validatorPersistHelper) applyRepairAction(
+	tctx *tcontext.Context, db *conn.BaseDB, row *errorRowForRepair, action *repairAction,
+) error {
+	argsBytes, err := json.Marshal(action.args)
+	if err != nil {
+		return err
+	}
+	queries := []string{
+		action.query,
+		"UPDATE " + c.errorChangeTableName + " SET status=? WHERE source=? AND id=?",
+		`INSERT INTO ` + c.repairActionTableName + `
+			(source, error_id, src_schema_name, src_table_name, row_pk, dst_schema_name, dst_table_name, action, repair_sql, repair_args)
+			VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+	}
+	args := [][]interface{}{
+		action.args,
+		{int(pb.ValidateErrorState_ResolvedErr), c.cfg.SourceID, row.id},
+		{
+			c.cfg.SourceID, row.id, row.sourceTable.Schema, row.sourceTable.Name, row.key,
+			row.targetTable.Schema, row.targetTable.Name, action.action, action.query, string(argsBytes),
+		},
+	}
+	return db.DoTxWithRetry(tctx, queries, args, c.retryer)
+}
+
+// genRepairAction re-reads the current upstream row of the error row by primary key, and
+// generates a REPLACE statement if the row exists, or a DELETE statement if not.
+func (v *DataValidator) genRepairAction(tctx *tcontext.Context, row *errorRowForRepair) (*repairAction, error) {
+	validateTbl, err := v.genValidateTableInfo(&row.sourceTable, len(row.data))
+	if err != nil {
+		return nil, err
+	}
+	if validateTbl.message != "" {
+		return nil, errors.New(validateTbl.message)
+	}
+	sourceTable := &cdcmodel.TableName{Schema: row.sourceTable.Schema, Table: row.sourceTable.Name}
+	targetTable := &cdcmodel.TableName{Schema: validateTbl.targetTable.Schema, Table: validateTbl.targetTable.Name}
+	srcTableInfo := validateTbl.srcTableInfo
+	dstTableInfo := validateTbl.downstreamTableInfo.TableInfo
+
+	// the persisted data is used as the before image, to locate the row by primary key.
+	errRow := sqlmodel.NewRowChange(sourceTable, targetTable, row.data, nil, srcTableInfo, dstTableInfo, nil)
+	cond := &Cond{
+		TargetTbl: dbutil.TableName(row.sourceTable.Schema, row.sourceTable.Name),
+		Columns:   srcTableInfo.Columns,
+		PK:        validateTbl.downstreamTableInfo.WhereHandle.UniqueNotNullIdx,
+		PkValues:  [][]string{errRow.RowStrIdentity()},
+	}
+	columnNames := make([]string, 0, len(cond.Columns))
+	for _, col := range cond.Columns {
+		columnNames = append(columnNames, dbutil.ColumnName(col.Name.O))
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s", strings.Join(columnNames, ", "), cond.TargetTbl, cond.GetWhere())
+	rows, err := v.fromDB.QueryContext(tctx, query, cond.GetArgs()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return nil, err
+		}
+		sql, args := errRow.GenSQL(sqlmodel.DMLDelete)
+		return &repairAction{action: repairActionDelete, query: sql, args: args}, nil
+	}
+	rowData, err := scanRow(rows)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(rowData))
+	for i, d := range rowData {
+		if d.Valid {
+			values[i] = d.String
+		}
+	}
+	upstreamRow := sqlmodel.NewRowChange(sourceTable, targetTable, nil, values, srcTableInfo, dstTableInfo, nil)
+	sql, args := upstreamRow.GenSQL(sqlmodel.DMLReplace)
+	return &repairAction{action: repairActionReplace, query: sql, args: args}, nil
+}
+
+// repairErrorRows repairs the error rows under the rate limit, see loadErrorRowsForRepair
+// for the meaning of errID, isAll and limit. the repair of a row may fail, e.g. the table is
+// dropped, then the remaining rows are still repaired, and the first error is returned.
+func (v *DataValidator) repairErrorRows(tctx *tcontext.Context, errID uint64, isAll bool, limit int) (int, int, error) {
+	v.repairMu.Lock()
+	defer v.repairMu.Unlock()
+
+	rows, err := v.persistHelper.loadErrorRowsForRepair(tctx, v.toDB, errID, isAll, limit)
+	if err != nil {
+		return 0, 0, err
+	}
+	var (
+		repaired int
+		firstErr error
+	)
+	for _, row := range rows {
+		if err = v.repairLimiter.Wait(tctx.Ctx); err != nil {
+			return repaired, len(rows), err
+		}
+		action, err2 := v.genRepairAction(tctx, row)
+		if err2 == nil {
+			err2 = v.persistHelper.applyRepairAction(tctx, v.toDB, row, action)
+		}
+		if err2 != nil {
+			if tctx.Ctx.Err() != nil {
+				return repaired, len(rows), tctx.Ctx.Err()
+			}
+			v.L.Warn("failed to repair error row", zap.Uint64("id", row.id),
+				zap.Stringer("table", &row.sourceTable), zap.Error(err2))
+			if firstErr == nil {
+				firstErr = terror.ErrValidatorRepairError.Delegate(err2, row.id)
+			}
+			continue
+		}
+		repaired++
+		v.L.Info("repaired error row", zap.Uint64("id", row.id),
+			zap.Stringer("table", &row.sourceTable), zap.String("action", action.action))
+	}
+	return repaired, len(rows), firstErr
+}
+
+// repairErrorRowsByOp repairs the error rows on the request of dmctl.
+func (v *DataValidator) repairErrorRowsByOp(errID uint64, isAll bool) error {
+	v.RLock()
+	if v.Stage() != pb.Stage_Running {
+		v.RUnlock()
+		// repairing needs the upstream connection and the table structures of the running validator.
+		return errors.New("validator is not running")
+	}
+	// the lock is not held during repairing, otherwise stopping the validator is blocked
+	// until the repair finishes. instead, stopping cancels v.ctx and waits for the repair by wg.
+	ctx, cancel := context.WithTimeout(v.ctx, validatorRepairOpTimeout)
+	v.wg.Add(1)
+	v.RUnlock()
+	defer func() {
+		cancel()
+		v.wg.Done()
+	}()
+
+	tctx := tcontext.NewContext(ctx, v.L)
+	repaired, total, err := v.repairErrorRows(tctx, errID, isAll, 0)
+	if errors.Cause(err) == context.DeadlineExceeded {
+		return terror.ErrValidatorRepairNotFinished.Generate(repaired, total)
+	}
+	return err
+}
+
+// repairBatchSize returns the number of rows can be repaired in one interval under
+// the rate limit, at least one row is repaired in an interval.
+func repairBatchSize(interval time.Duration, limit rate.Limit) int {
+	return max(int(interval.Seconds()*float64(limit)), 1)
+}
+
+// autoRepairRoutine repairs the new error rows periodically if auto-repair is enabled.
+func (v *DataValidator) autoRepairRoutine() {
+	defer v.wg.Done()
+
+	batch := repairBatchSize(v.validateInterval, v.repairLimiter.Limit())
+	for {
+		select {
+		case <-v.ctx.Done():
+			return
+		case <-time.After(v.validateInterval):
+		}
+		repaired, total, err := v.repairErrorRows(v.tctx, 0, true, batch)
+		if err != nil && v.ctx.Err() == nil {
+			v.L.Warn("failed to repair error rows automatically", zap.Error(err))
+		}
+		if total > 0 {
+			v.L.Info("repaired error rows automatically", zap.Int("repaired", repaired), zap.Int("total", total))
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/pkg/util/filter"
+	regexprrouter "github.com/pingcap/tidb/pkg/util/regexpr-router"
+	router "github.com/pingcap/tidb/pkg/util/table-router"
+	"github.com/pingcap/tiflow/dm/pb"
+	"github.com/pingcap/tiflow/dm/pkg/conn"
+	tcontext "github.com/pingcap/tiflow/dm/pkg/context"
+	"github.com/pingcap/tiflow/dm/pkg/log"
+	"github.com/pingcap/tiflow/dm/pkg/retry"
+	"github.com/pingcap/tiflow/dm/pkg/schema"
+	"github.com/pingcap/tiflow/dm/syncer/dbconn"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestValidatorLoadAndApplyRepair(t *testing.T) {
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+	cfg := genSubtaskConfig(t)
+	syncerObj := NewSyncer(cfg, nil, nil)
+	validator := NewContinuousDataValidator(cfg, syncerObj, false)
+	helper := validator.persistHelper
+	baseDB := conn.NewBaseDBForTest(db, func() {})
+	tctx := tcontext.Background()
+	sourceID := cfg.SourceID
+
+	// load all new error rows with limit
+	dbMock.ExpectQuery("SELECT id, src_schema_name, src_table_name, dst_schema_name, dst_table_name, row_pk, data FROM "+
+		helper.errorChangeTableName+" WHERE source = \\? AND status = \\? ORDER BY id LIMIT 10").
+		WithArgs(sourceID, int(pb.ValidateErrorState_NewErr)).
+		WillReturnRows(sqlmock.NewRows([]string{"", "", "", "", "", "", ""}).
+			AddRow(1, "test", "tbl", "test", "tbl", "12345678901234567890", `[12345678901234567890, "a", null]`))
+	rows, err := helper.loadErrorRowsForRepair(tctx, baseDB, 0, true, 10)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, uint64(1), rows[0].id)
+	require.Equal(t, filter.Table{Schema: "test", Name: "tbl"}, rows[0].sourceTable)
+	require.Equal(t, "12345678901234567890", rows[0].key)
+	// big integer is not truncated
+	require.Equal(t, []interface{}{json.Number("12345678901234567890"), "a", nil}, rows[0].data)
+
+	// load error row of errID
+	dbMock.ExpectQuery("SELECT .* FROM "+helper.errorChangeTableName+" WHERE source = \\? AND id = \\? AND status IN \\(\\?, \\?\\)").
+		WithArgs(sourceID, 2, int(pb.ValidateErrorState_NewErr), int(pb.ValidateErrorState_IgnoredErr)).
+		WillReturnRows(sqlmock.NewRows([]string{"", "", "", "", "", "", ""}))
+	rows, err = helper.loadErrorRowsForRepair(tctx, baseDB, 2, false, 0)
+	require.NoError(t, err)
+	require.Len(t, rows, 0)
+
+	// apply the repair action in a transaction
+	row := &errorRowForRepair{
+		id:          1,
+		sourceTable: filter.Table{Schema: "test", Name: "tbl"},
+		targetTable: filter.Table{Schema: "test", Name: "tbl"},
+		key:         "1",
+	}
+	action := &repairAction{
+		action: repairActionDelete,
+		query:  "DELETE FROM `test`.`tbl` WHERE `id` = ? LIMIT 1",
+		args:   []interface{}{"1"},
+	}
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("DELETE FROM `test`.`tbl` WHERE `id` = \\? LIMIT 1").WithArgs("1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("UPDATE "+helper.errorChangeTableName+" SET status=\\? WHERE source=\\? AND id=\\?").
+		WithArgs(int(pb.ValidateErrorState_ResolvedErr), sourceID, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("INSERT INTO "+helper.repairActionTableName+".*").
+		WithArgs(sourceID, 1, "test", "tbl", "1", "test", "tbl", repairActionDelete, action.query, `["1"]`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	dbMock.ExpectCommit()
+	require.NoError(t, helper.applyRepairAction(tctx, baseDB, row, action))
+	require.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestValidatorRepairNotRunning(t *testing.T) {
+	cfg := genSubtaskConfig(t)
+	syncerObj := NewSyncer(cfg, nil, nil)
+	validator := NewContinuousDataValidator(cfg, syncerObj, false)
+	validator.ctx, validator.cancel = context.WithCancel(context.Background())
+	defer validator.cancel()
+	err := validator.OperateValidatorError(pb.ValidationErrOp_RepairErrOp, 0, true)
+	require.ErrorContains(t, err, "validator is not running")
+}
+
+func TestValidatorGenRepairAction(t *testing.T) {
+	var (
+		schemaName = "test"
+		tableName  = "tbl"
+		createSQL  = "CREATE TABLE `tbl`(id int primary key, v varchar(100))"
+		table      = filter.Table{Schema: schemaName, Name: tableName}
+		selectSQL  = "SELECT `id`, `v` FROM `test`.`tbl` WHERE id in \\(\\?\\)"
+	)
+	createAST, err := parseSQL(createSQL)
+	require.NoError(t, err)
+
+	cfg := genSubtaskConfig(t)
+	syncerObj := NewSyncer(cfg, nil, nil)
+	syncerObj.tableRouter, err = regexprrouter.NewRegExprRouter(cfg.CaseSensitive, []*router.TableRule{})
+	require.NoError(t, err)
+
+	downDB, downMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer downDB.Close()
+	downMock.MatchExpectationsInOrder(false)
+	downMock.ExpectBegin()
+	downMock.ExpectExec("SET SESSION SQL_MODE.*").WillReturnResult(sqlmock.NewResult(1, 1))
+	downMock.ExpectCommit()
+	downMock.ExpectQuery("SHOW CREATE TABLE " + table.String() + ".*").WillReturnRows(
+		downMock.NewRows([]string{"Table", "Create Table"}).AddRow(tableName, createSQL),
+	)
+	dbConn, err := downDB.Conn(context.Background())
+	require.NoError(t, err)
+	syncerObj.downstreamTrackConn = dbconn.NewDBConn(cfg, conn.NewBaseConnForTest(dbConn, &retry.FiniteRetryStrategy{}))
+	syncerObj.schemaTracker, err = schema.NewTestTracker(context.Background(), cfg.Name, syncerObj.downstreamTrackConn, log.L())
+	require.NoError(t, err)
+	defer syncerObj.schemaTracker.Close()
+	require.NoError(t, syncerObj.schemaTracker.CreateSchemaIfNotExists(schemaName))
+	require.NoError(t, syncerObj.schemaTracker.Exec(context.Background(), schemaName, createAST))
+
+	upDB, upMock, err := sqlmock.New()
+	require.NoError(t, err)
+	validator := NewContinuousDataValidator(cfg, syncerObj, false)
+	validator.ctx, validator.cancel = context.WithCancel(context.Background())
+	defer validator.cancel()
+	validator.tctx = tcontext.NewContext(validator.ctx, validator.L)
+	validator.fromDB = conn.NewBaseDBForTest(upDB, func() {})
+
+	// the persisted data is decoded with json.Number, see loadErrorRowsForRepair.
+	row := &errorRowForRepair{
+		id:          1,
+		sourceTable: table,
+		targetTable: table,
+		key:         "1",
+		data:        []interface{}{json.Number("1"), "a"},
+	}
+
+	// the upstream row is deleted
+	upMock.ExpectQuery(selectSQL).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}))
+	action, err := validator.genRepairAction(validator.tctx, row)
+	require.NoError(t, err)
+	require.Equal(t, repairActionDelete, action.action)
+	require.Equal(t, "DELETE FROM `test`.`tbl` WHERE `id` = ? LIMIT 1", action.query)
+	require.Equal(t, []interface{}{json.Number("1")}, action.args)
+
+	// the upstream row is changed after the error row is recorded
+	upMock.ExpectQuery(selectSQL).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow("1", "b"))
+	action, err = validator.genRepairAction(validator.tctx, row)
+	require.NoError(t, err)
+	require.Equal(t, repairActionReplace, action.action)
+	require.Equal(t, "REPLACE INTO `test`.`tbl` (`id`,`v`) VALUES (?,?)", action.query)
+	require.Equal(t, []interface{}{"1", "b"}, action.args)
+
+	// the upstream row is the same as the error row, the downstream is overwritten by it
+	upMock.ExpectQuery(selectSQL).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow("1", "a"))
+	action, err = validator.genRepairAction(validator.tctx, row)
+	require.NoError(t, err)
+	require.Equal(t, repairActionReplace, action.action)
+	require.Equal(t, "REPLACE INTO `test`.`tbl` (`id`,`v`) VALUES (?,?)", action.query)
+	require.Equal(t, []interface{}{"1", "a"}, action.args)
+
+	// a NULL upstream value is kept
+	upMock.ExpectQuery(selectSQL).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow("1", nil))
+	action, err = validator.genRepairAction(validator.tctx, row)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"1", nil}, action.args)
+
+	require.NoError(t, upMock.ExpectationsWereMet())
+	require.NoError(t, downMock.ExpectationsWereMet())
+}
+
+func TestRepairBatchSize(t *testing.T) {
+	require.Equal(t, 100, repairBatchSize(10*time.Second, rate.Limit(10)))
+	require.Equal(t, 5, repairBatchSize(500*time.Millisecond, rate.Limit(10)))
+	// at least one row is repaired in an interval
+	require.Equal(t, 1, repairBatchSize(50*time.Millisecond, rate.Limit(10)))
+	require.Equal(t, 1, repairBatchSize(100*time.Millisecond, rate.Limit(1)))
+}
@@ -78,11 +78,15 @@ type realRelayHolder struct {
 // NewRealRelayHolder creates a new RelayHolder.
 func NewRealRelayHolder(sourceCfg *config.SourceConfig) RelayHolder {
 	cfg := relay.FromSourceCfg(sourceCfg)
+	newRelay := relay.NewRelay
+	if cfg.OfflineBinlog != nil {
+		newRelay = relay.NewOfflineRelay
+	}
 
 	h := &realRelayHolder{
 		cfg:   sourceCfg,
 		stage: pb.Stage_New,
-		relay: relay.NewRelay(cfg),
+		relay: newRelay(cfg),
 		l:     log.With(zap.String("component", "relay holder")),
 	}
 	h.closed.Store(true)
@@ -158,10 +158,13 @@ func (w *SourceWorker) Start() {
 		w.taskStatusChecker.Start()
 	}
 
-	var err error
-	w.sourceDB, err = conn.GetUpstreamDB(&w.cfg.GetDecryptedClone().From)
-	if err != nil {
-		w.l.Error("can't connected to upstream", zap.Error(err))
+	// there is no upstream database for an offline source.
+	if w.cfg.OfflineBinlog == nil {
+		var err error
+		w.sourceDB, err = conn.GetUpstreamDB(&w.cfg.GetDecryptedClone().From)
+		if err != nil {
+			w.l.Error("can't connected to upstream", zap.Error(err))
+		}
 	}
 
 	w.wg.Add(1)
@@ -316,6 +319,9 @@ func (w *SourceWorker) updateSourceStatus(ctx context.Context, needLock bool, al
 	} else {
 		cfg = w.cfg
 	}
+	if cfg.OfflineBinlog != nil {
+		return w.updateOfflineSourceStatus(needLock)
+	}
 	// Ensure sourceDB exists (optionally reconnect), but never hold sourceDBMu while dialing upstream.
 	w.sourceDBMu.Lock()
 	sourceDB := w.sourceDB
@@ -368,6 +374,28 @@ func (w *SourceWorker) updateSourceStatus(ctx context.Context, needLock bool, al
 	return nil
 }
 
+// updateOfflineSourceStatus updates w.sourceStatus for an offline source, whose latest
+// location is the end location of the imported binlog files.
+func (w *SourceWorker) updateOfflineSourceStatus(needLock bool) error {
+	if needLock {
+		w.RLock()
+		defer w.RUnlock()
+	}
+	if w.relayHolder == nil {
+		return nil
+	}
+	offlineRelay, ok := w.relayHolder.Relay().(*relay.OfflineRelay)
+	if !ok {
+		return nil
+	}
+	w.sourceStatus.Store(&binlog.SourceStatus{
+		Location:   offlineRelay.Location(),
+		UpdateTime: time.Now(),
+	})
+	w.clearSourceStatusErr()
+	return nil
+}
+
 // EnableRelay enables the functionality of start/watch/handle relay.
 // According to relay schedule of DM-master, a source worker will enable relay in two scenarios: its bound source has
 // `enable-relay: true` in config, or it has a UpstreamRelayWorkerKeyAdapter etcd KV.
@@ -902,7 +930,7 @@ func (w *SourceWorker) operateSubTaskStage(stage ha.Stage, subTaskCfg config.Sub
 	// for new added subtask
 	if st := w.subTaskHolder.findSubTask(stage.Task); st == nil {
 		switch stage.Expect {
-		case pb.Stage_Running, pb.Stage_Paused, pb.Stage_Stopped:
+		case pb.Stage_Running, pb.Stage_Paused, pb.Stage_Stopped, pb.Stage_Finished:
 			// todo refactor here deciding if the expected stage is valid should be put inside StartSubTask and OperateSubTask
 			log.L().Info("start to create subtask in operateSubTaskStage", zap.String("sourceID", subTaskCfg.SourceID), zap.String("task", subTaskCfg.Name))
 			expectValidatorStage, err := getExpectValidatorStage(subTaskCfg.ValidatorCfg, w.etcdClient, stage.Source, stage.Task, stage.Revision)
@@ -924,6 +952,9 @@ func (w *SourceWorker) operateSubTaskStage(stage ha.Stage, subTaskCfg config.Sub
 	}
 	if stage.IsDeleted {
 		op = pb.TaskOp_Delete
+	} else if stage.Expect == pb.Stage_Finished {
+		// the subtask marks itself as Finished after reaching the stop boundary, nothing to do.
+		return op.String(), nil
 	}
 	return op.String(), w.OperateSubTask(stage.Task, op)
 }
@@ -931,7 +962,7 @@ func (w *SourceWorker) operateSubTaskStage(stage ha.Stage, subTaskCfg config.Sub
 // operateSubTaskStageWithoutConfig returns TaskOp additionally to record metrics.
 func (w *SourceWorker) operateSubTaskStageWithoutConfig(stage ha.Stage) (string, error) {
 	var subTaskCfg config.SubTaskConfig
-	if stage.Expect == pb.Stage_Running || stage.Expect == pb.Stage_Stopped {
+	if stage.Expect == pb.Stage_Running || stage.Expect == pb.Stage_Stopped || stage.Expect == pb.Stage_Finished {
 		if st := w.subTaskHolder.findSubTask(stage.Task); st == nil {
 			tsm, _, err := ha.GetSubTaskCfg(w.etcdClient, stage.Source, stage.Task, stage.Revision)
 			if err != nil {
@@ -1149,6 +1180,12 @@ func copyConfigFromSource(cfg *config.SubTaskConfig, sourceCfg *config.SourceCon
 	cfg.RelayDir = sourceCfg.RelayDir
 	cfg.EnableGTID = sourceCfg.EnableGTID
 	cfg.UseRelay = enableRelay
+	cfg.OfflineBinlog = sourceCfg.OfflineBinlog != nil
+	if cfg.OfflineBinlog {
+		if err := cfg.CheckOfflineBinlog(); err != nil {
+			return err
+		}
+	}
 
 	if cfg.CaseSensitive != sourceCfg.CaseSensitive {
 		log.L().Warn("different case-sensitive config between task config and source config, use `true` for it.")
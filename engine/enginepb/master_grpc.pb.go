@@ -461,6 +461,9 @@ type JobManagerClient interface {
 	// refer to: https://cloud.google.com/apis/design/custom_methods
 	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*Job, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListJobEvents lists the events of a job and its workers in the order
+	// they happened. The events of a deleted job are kept until they expire.
+	ListJobEvents(ctx context.Context, in *ListJobEventsRequest, opts ...grpc.CallOption) (*ListJobEventsResponse, error)
 }
 
 type jobManagerClient struct {
@@ -516,6 +519,15 @@ func (c *jobManagerClient) DeleteJob(ctx context.Context, in *DeleteJobRequest,
 	return out, nil
 }
 
+func (c *jobManagerClient) ListJobEvents(ctx context.Context, in *ListJobEventsRequest, opts ...grpc.CallOption) (*ListJobEventsResponse, error) {
+	out := new(ListJobEventsResponse)
+	err := c.cc.Invoke(ctx, "/enginepb.JobManager/ListJobEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JobManagerServer is the server API for JobManager service.
 // All implementations should embed UnimplementedJobManagerServer
 // for forward compatibility
@@ -528,6 +540,9 @@ type JobManagerServer interface {
 	// refer to: https://cloud.google.com/apis/design/custom_methods
 	CancelJob(context.Context, *CancelJobRequest) (*Job, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*emptypb.Empty, error)
+	// ListJobEvents lists the events of a job and its workers in the order
+	// they happened. The events of a deleted job are kept until they expire.
+	ListJobEvents(context.Context, *ListJobEventsRequest) (*ListJobEventsResponse, error)
 }
 
 // UnimplementedJobManagerServer should be embedded to have forward compatible implementations.
@@ -549,6 +564,9 @@ func (UnimplementedJobManagerServer) CancelJob(context.Context, *CancelJobReques
 func (UnimplementedJobManagerServer) DeleteJob(context.Context, *DeleteJobRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
 }
+func (UnimplementedJobManagerServer) ListJobEvents(context.Context, *ListJobEventsRequest) (*ListJobEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobEvents not implemented")
+}
 
 // UnsafeJobManagerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to JobManagerServer will
@@ -651,6 +669,24 @@ func _JobManager_DeleteJob_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JobManager_ListJobEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobManagerServer).ListJobEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobManager/ListJobEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobManagerServer).ListJobEvents(ctx, req.(*ListJobEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JobManager_ServiceDesc is the grpc.ServiceDesc for JobManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -678,6 +714,10 @@ var JobManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJob",
 			Handler:    _JobManager_DeleteJob_Handler,
 		},
+		{
+			MethodName: "ListJobEvents",
+			Handler:    _JobManager_ListJobEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "engine/proto/master.proto",
@@ -0,0 +1,1169 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.1
+// source: engine/proto/plugin.proto
+
+package enginepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PluginCallRequest_Callback int32
+
+const (
+	PluginCallRequest_CallbackUnknown     PluginCallRequest_Callback = 0
+	PluginCallRequest_Init                PluginCallRequest_Callback = 1
+	PluginCallRequest_Recover             PluginCallRequest_Callback = 2
+	PluginCallRequest_Tick                PluginCallRequest_Callback = 3
+	PluginCallRequest_WorkerDispatched    PluginCallRequest_Callback = 4
+	PluginCallRequest_WorkerOnline        PluginCallRequest_Callback = 5
+	PluginCallRequest_WorkerOffline       PluginCallRequest_Callback = 6
+	PluginCallRequest_WorkerStatusUpdated PluginCallRequest_Callback = 7
+	PluginCallRequest_MasterMessage       PluginCallRequest_Callback = 8
+	PluginCallRequest_Cancel              PluginCallRequest_Callback = 9
+	PluginCallRequest_Pause               PluginCallRequest_Callback = 10
+	PluginCallRequest_Close               PluginCallRequest_Callback = 11
+)
+
+// Enum value maps for PluginCallRequest_Callback.
+var (
+	PluginCallRequest_Callback_name = map[int32]string{
+		0:  "CallbackUnknown",
+		1:  "Init",
+		2:  "Recover",
+		3:  "Tick",
+		4:  "WorkerDispatched",
+		5:  "WorkerOnline",
+		6:  "WorkerOffline",
+		7:  "WorkerStatusUpdated",
+		8:  "MasterMessage",
+		9:  "Cancel",
+		10: "Pause",
+		11: "Close",
+	}
+	PluginCallRequest_Callback_value = map[string]int32{
+		"CallbackUnknown":     0,
+		"Init":                1,
+		"Recover":             2,
+		"Tick":                3,
+		"WorkerDispatched":    4,
+		"WorkerOnline":        5,
+		"WorkerOffline":       6,
+		"WorkerStatusUpdated": 7,
+		"MasterMessage":       8,
+		"Cancel":              9,
+		"Pause":               10,
+		"Close":               11,
+	}
+)
+
+func (x PluginCallRequest_Callback) Enum() *PluginCallRequest_Callback {
+	p := new(PluginCallRequest_Callback)
+	*p = x
+	return p
+}
+
+func (x PluginCallRequest_Callback) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PluginCallRequest_Callback) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_proto_plugin_proto_enumTypes[0].Descriptor()
+}
+
+func (PluginCallRequest_Callback) Type() protoreflect.EnumType {
+	return &file_engine_proto_plugin_proto_enumTypes[0]
+}
+
+func (x PluginCallRequest_Callback) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PluginCallRequest_Callback.Descriptor instead.
+func (PluginCallRequest_Callback) EnumDescriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type PluginWorkerType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type int64  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *PluginWorkerType) Reset() {
+	*x = PluginWorkerType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginWorkerType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginWorkerType) ProtoMessage() {}
+
+func (x *PluginWorkerType) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginWorkerType.ProtoReflect.Descriptor instead.
+func (*PluginWorkerType) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PluginWorkerType) GetType() int64 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *PluginWorkerType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RegisterJobTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobType int64  `protobuf:"varint,1,opt,name=job_type,json=jobType,proto3" json:"job_type,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// worker_types are the types of the workers created by the job master.
+	WorkerTypes []*PluginWorkerType `protobuf:"bytes,3,rep,name=worker_types,json=workerTypes,proto3" json:"worker_types,omitempty"`
+	// plugin_addr is the address of the JobTypePlugin service of the plugin.
+	PluginAddr string `protobuf:"bytes,4,opt,name=plugin_addr,json=pluginAddr,proto3" json:"plugin_addr,omitempty"`
+}
+
+func (x *RegisterJobTypeRequest) Reset() {
+	*x = RegisterJobTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterJobTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterJobTypeRequest) ProtoMessage() {}
+
+func (x *RegisterJobTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterJobTypeRequest.ProtoReflect.Descriptor instead.
+func (*RegisterJobTypeRequest) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterJobTypeRequest) GetJobType() int64 {
+	if x != nil {
+		return x.JobType
+	}
+	return 0
+}
+
+func (x *RegisterJobTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterJobTypeRequest) GetWorkerTypes() []*PluginWorkerType {
+	if x != nil {
+		return x.WorkerTypes
+	}
+	return nil
+}
+
+func (x *RegisterJobTypeRequest) GetPluginAddr() string {
+	if x != nil {
+		return x.PluginAddr
+	}
+	return ""
+}
+
+type RegisterJobTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterJobTypeResponse) Reset() {
+	*x = RegisterJobTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterJobTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterJobTypeResponse) ProtoMessage() {}
+
+func (x *RegisterJobTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterJobTypeResponse.ProtoReflect.Descriptor instead.
+func (*RegisterJobTypeResponse) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{2}
+}
+
+type PluginWorkerStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State        int32  `protobuf:"varint,1,opt,name=state,proto3" json:"state,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ExtBytes     []byte `protobuf:"bytes,3,opt,name=ext_bytes,json=extBytes,proto3" json:"ext_bytes,omitempty"`
+}
+
+func (x *PluginWorkerStatus) Reset() {
+	*x = PluginWorkerStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginWorkerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginWorkerStatus) ProtoMessage() {}
+
+func (x *PluginWorkerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginWorkerStatus.ProtoReflect.Descriptor instead.
+func (*PluginWorkerStatus) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PluginWorkerStatus) GetState() int32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *PluginWorkerStatus) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *PluginWorkerStatus) GetExtBytes() []byte {
+	if x != nil {
+		return x.ExtBytes
+	}
+	return nil
+}
+
+type PluginCreateWorkerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// master_id is the ID of the job master which creates the worker.
+	MasterId   string `protobuf:"bytes,1,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	WorkerType int64  `protobuf:"varint,2,opt,name=worker_type,json=workerType,proto3" json:"worker_type,omitempty"`
+	Config     []byte `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// resources are the IDs of the external resources the worker relies on.
+	Resources []string `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *PluginCreateWorkerRequest) Reset() {
+	*x = PluginCreateWorkerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginCreateWorkerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginCreateWorkerRequest) ProtoMessage() {}
+
+func (x *PluginCreateWorkerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginCreateWorkerRequest.ProtoReflect.Descriptor instead.
+func (*PluginCreateWorkerRequest) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PluginCreateWorkerRequest) GetMasterId() string {
+	if x != nil {
+		return x.MasterId
+	}
+	return ""
+}
+
+func (x *PluginCreateWorkerRequest) GetWorkerType() int64 {
+	if x != nil {
+		return x.WorkerType
+	}
+	return 0
+}
+
+func (x *PluginCreateWorkerRequest) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PluginCreateWorkerRequest) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type PluginCreateWorkerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+}
+
+func (x *PluginCreateWorkerResponse) Reset() {
+	*x = PluginCreateWorkerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginCreateWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginCreateWorkerResponse) ProtoMessage() {}
+
+func (x *PluginCreateWorkerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginCreateWorkerResponse.ProtoReflect.Descriptor instead.
+func (*PluginCreateWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PluginCreateWorkerResponse) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+type PluginUpdateStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId string              `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Status   *PluginWorkerStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *PluginUpdateStatusRequest) Reset() {
+	*x = PluginUpdateStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginUpdateStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginUpdateStatusRequest) ProtoMessage() {}
+
+func (x *PluginUpdateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginUpdateStatusRequest.ProtoReflect.Descriptor instead.
+func (*PluginUpdateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PluginUpdateStatusRequest) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *PluginUpdateStatusRequest) GetStatus() *PluginWorkerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PluginUpdateStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PluginUpdateStatusResponse) Reset() {
+	*x = PluginUpdateStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginUpdateStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginUpdateStatusResponse) ProtoMessage() {}
+
+func (x *PluginUpdateStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginUpdateStatusResponse.ProtoReflect.Descriptor instead.
+func (*PluginUpdateStatusResponse) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{7}
+}
+
+type PluginExitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId     string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	Reason       int32  `protobuf:"varint,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Detail       []byte `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *PluginExitRequest) Reset() {
+	*x = PluginExitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginExitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginExitRequest) ProtoMessage() {}
+
+func (x *PluginExitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginExitRequest.ProtoReflect.Descriptor instead.
+func (*PluginExitRequest) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PluginExitRequest) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *PluginExitRequest) GetReason() int32 {
+	if x != nil {
+		return x.Reason
+	}
+	return 0
+}
+
+func (x *PluginExitRequest) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *PluginExitRequest) GetDetail() []byte {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+type PluginExitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PluginExitResponse) Reset() {
+	*x = PluginExitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginExitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginExitResponse) ProtoMessage() {}
+
+func (x *PluginExitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginExitResponse.ProtoReflect.Descriptor instead.
+func (*PluginExitResponse) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{9}
+}
+
+type PluginCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Callback PluginCallRequest_Callback `protobuf:"varint,1,opt,name=callback,proto3,enum=enginepb.PluginCallRequest_Callback" json:"callback,omitempty"`
+	// worker_id is the ID of the job master or the worker called back, the ID
+	// of a job master is the job ID.
+	WorkerId   string `protobuf:"bytes,2,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	MasterId   string `protobuf:"bytes,3,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	WorkerType int64  `protobuf:"varint,4,opt,name=worker_type,json=workerType,proto3" json:"worker_type,omitempty"`
+	// config is only set for Init and Recover.
+	Config []byte `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// event_worker_id is the worker which a callback of a job master is about.
+	EventWorkerId     string              `protobuf:"bytes,6,opt,name=event_worker_id,json=eventWorkerId,proto3" json:"event_worker_id,omitempty"`
+	EventWorkerStatus *PluginWorkerStatus `protobuf:"bytes,7,opt,name=event_worker_status,json=eventWorkerStatus,proto3" json:"event_worker_status,omitempty"`
+	// error_message is the error of WorkerDispatched or the reason of
+	// WorkerOffline.
+	ErrorMessage string `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// topic and message are set for MasterMessage, the message is encoded
+	// in JSON.
+	Topic   string `protobuf:"bytes,9,opt,name=topic,proto3" json:"topic,omitempty"`
+	Message []byte `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PluginCallRequest) Reset() {
+	*x = PluginCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginCallRequest) ProtoMessage() {}
+
+func (x *PluginCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginCallRequest.ProtoReflect.Descriptor instead.
+func (*PluginCallRequest) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PluginCallRequest) GetCallback() PluginCallRequest_Callback {
+	if x != nil {
+		return x.Callback
+	}
+	return PluginCallRequest_CallbackUnknown
+}
+
+func (x *PluginCallRequest) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+func (x *PluginCallRequest) GetMasterId() string {
+	if x != nil {
+		return x.MasterId
+	}
+	return ""
+}
+
+func (x *PluginCallRequest) GetWorkerType() int64 {
+	if x != nil {
+		return x.WorkerType
+	}
+	return 0
+}
+
+func (x *PluginCallRequest) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PluginCallRequest) GetEventWorkerId() string {
+	if x != nil {
+		return x.EventWorkerId
+	}
+	return ""
+}
+
+func (x *PluginCallRequest) GetEventWorkerStatus() *PluginWorkerStatus {
+	if x != nil {
+		return x.EventWorkerStatus
+	}
+	return nil
+}
+
+func (x *PluginCallRequest) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *PluginCallRequest) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *PluginCallRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type PluginCallResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PluginCallResponse) Reset() {
+	*x = PluginCallResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_proto_plugin_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginCallResponse) ProtoMessage() {}
+
+func (x *PluginCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_proto_plugin_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginCallResponse.ProtoReflect.Descriptor instead.
+func (*PluginCallResponse) Descriptor() ([]byte, []int) {
+	return file_engine_proto_plugin_proto_rawDescGZIP(), []int{11}
+}
+
+var File_engine_proto_plugin_proto protoreflect.FileDescriptor
+
+var file_engine_proto_plugin_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x70, 0x62, 0x22, 0x3a, 0x0a, 0x10, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0xa7, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6a, 0x6f, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x12, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x78, 0x74, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x19, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x1a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x6e, 0x0a, 0x19, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x85, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x14, 0x0a, 0x12, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdf, 0x04,
+	0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x63, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x4c, 0x0a, 0x13, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x08, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x13, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x54, 0x69, 0x63, 0x6b, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x10, 0x05, 0x12, 0x11,
+	0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x10,
+	0x06, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x10, 0x08, 0x12, 0x0a, 0x0a,
+	0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x09, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x10, 0x0a, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x10, 0x0b, 0x22,
+	0x14, 0x0a, 0x12, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe6, 0x02, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4a, 0x6f, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
+	0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x04, 0x45, 0x78, 0x69,
+	0x74, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x54,
+	0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12,
+	0x43, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x6e, 0x67, 0x63, 0x61, 0x70, 0x2f, 0x74, 0x69, 0x66, 0x6c, 0x6f,
+	0x77, 0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_engine_proto_plugin_proto_rawDescOnce sync.Once
+	file_engine_proto_plugin_proto_rawDescData = file_engine_proto_plugin_proto_rawDesc
+)
+
+func file_engine_proto_plugin_proto_rawDescGZIP() []byte {
+	file_engine_proto_plugin_proto_rawDescOnce.Do(func() {
+		file_engine_proto_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(file_engine_proto_plugin_proto_rawDescData)
+	})
+	return file_engine_proto_plugin_proto_rawDescData
+}
+
+var file_engine_proto_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_engine_proto_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_engine_proto_plugin_proto_goTypes = []any{
+	(PluginCallRequest_Callback)(0),    // 0: enginepb.PluginCallRequest.Callback
+	(*PluginWorkerType)(nil),           // 1: enginepb.PluginWorkerType
+	(*RegisterJobTypeRequest)(nil),     // 2: enginepb.RegisterJobTypeRequest
+	(*RegisterJobTypeResponse)(nil),    // 3: enginepb.RegisterJobTypeResponse
+	(*PluginWorkerStatus)(nil),         // 4: enginepb.PluginWorkerStatus
+	(*PluginCreateWorkerRequest)(nil),  // 5: enginepb.PluginCreateWorkerRequest
+	(*PluginCreateWorkerResponse)(nil), // 6: enginepb.PluginCreateWorkerResponse
+	(*PluginUpdateStatusRequest)(nil),  // 7: enginepb.PluginUpdateStatusRequest
+	(*PluginUpdateStatusResponse)(nil), // 8: enginepb.PluginUpdateStatusResponse
+	(*PluginExitRequest)(nil),          // 9: enginepb.PluginExitRequest
+	(*PluginExitResponse)(nil),         // 10: enginepb.PluginExitResponse
+	(*PluginCallRequest)(nil),          // 11: enginepb.PluginCallRequest
+	(*PluginCallResponse)(nil),         // 12: enginepb.PluginCallResponse
+}
+var file_engine_proto_plugin_proto_depIdxs = []int32{
+	1,  // 0: enginepb.RegisterJobTypeRequest.worker_types:type_name -> enginepb.PluginWorkerType
+	4,  // 1: enginepb.PluginUpdateStatusRequest.status:type_name -> enginepb.PluginWorkerStatus
+	0,  // 2: enginepb.PluginCallRequest.callback:type_name -> enginepb.PluginCallRequest.Callback
+	4,  // 3: enginepb.PluginCallRequest.event_worker_status:type_name -> enginepb.PluginWorkerStatus
+	2,  // 4: enginepb.JobTypeHost.RegisterJobType:input_type -> enginepb.RegisterJobTypeRequest
+	5,  // 5: enginepb.JobTypeHost.CreateWorker:input_type -> enginepb.PluginCreateWorkerRequest
+	7,  // 6: enginepb.JobTypeHost.UpdateStatus:input_type -> enginepb.PluginUpdateStatusRequest
+	9,  // 7: enginepb.JobTypeHost.Exit:input_type -> enginepb.PluginExitRequest
+	11, // 8: enginepb.JobTypePlugin.Call:input_type -> enginepb.PluginCallRequest
+	3,  // 9: enginepb.JobTypeHost.RegisterJobType:output_type -> enginepb.RegisterJobTypeResponse
+	6,  // 10: enginepb.JobTypeHost.CreateWorker:output_type -> enginepb.PluginCreateWorkerResponse
+	8,  // 11: enginepb.JobTypeHost.UpdateStatus:output_type -> enginepb.PluginUpdateStatusResponse
+	10, // 12: enginepb.JobTypeHost.Exit:output_type -> enginepb.PluginExitResponse
+	12, // 13: enginepb.JobTypePlugin.Call:output_type -> enginepb.PluginCallResponse
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_engine_proto_plugin_proto_init() }
+func file_engine_proto_plugin_proto_init() {
+	if File_engine_proto_plugin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_engine_proto_plugin_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginWorkerType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterJobTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterJobTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginWorkerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginCreateWorkerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginCreateWorkerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginUpdateStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginUpdateStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginExitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginExitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginCallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_proto_plugin_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*PluginCallResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_engine_proto_plugin_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_engine_proto_plugin_proto_goTypes,
+		DependencyIndexes: file_engine_proto_plugin_proto_depIdxs,
+		EnumInfos:         file_engine_proto_plugin_proto_enumTypes,
+		MessageInfos:      file_engine_proto_plugin_proto_msgTypes,
+	}.Build()
+	File_engine_proto_plugin_proto = out.File
+	file_engine_proto_plugin_proto_rawDesc = nil
+	file_engine_proto_plugin_proto_goTypes = nil
+	file_engine_proto_plugin_proto_depIdxs = nil
+}
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package enginepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// JobTypeHostClient is the client API for JobTypeHost service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobTypeHostClient interface {
+	// RegisterJobType registers a job type served by a plugin. Registering a
+	// registered job type again updates the address of the plugin, so that a
+	// plugin can register its job types periodically to survive the restarts.
+	RegisterJobType(ctx context.Context, in *RegisterJobTypeRequest, opts ...grpc.CallOption) (*RegisterJobTypeResponse, error)
+	// CreateWorker creates a worker by a job master of a plugin.
+	CreateWorker(ctx context.Context, in *PluginCreateWorkerRequest, opts ...grpc.CallOption) (*PluginCreateWorkerResponse, error)
+	// UpdateStatus updates the status of a job master or a worker of a plugin.
+	UpdateStatus(ctx context.Context, in *PluginUpdateStatusRequest, opts ...grpc.CallOption) (*PluginUpdateStatusResponse, error)
+	// Exit exits a job master or a worker of a plugin.
+	Exit(ctx context.Context, in *PluginExitRequest, opts ...grpc.CallOption) (*PluginExitResponse, error)
+}
+
+type jobTypeHostClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobTypeHostClient(cc grpc.ClientConnInterface) JobTypeHostClient {
+	return &jobTypeHostClient{cc}
+}
+
+func (c *jobTypeHostClient) RegisterJobType(ctx context.Context, in *RegisterJobTypeRequest, opts ...grpc.CallOption) (*RegisterJobTypeResponse, error) {
+	out := new(RegisterJobTypeResponse)
+	err := c.cc.Invoke(ctx, "/enginepb.JobTypeHost/RegisterJobType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobTypeHostClient) CreateWorker(ctx context.Context, in *PluginCreateWorkerRequest, opts ...grpc.CallOption) (*PluginCreateWorkerResponse, error) {
+	out := new(PluginCreateWorkerResponse)
+	err := c.cc.Invoke(ctx, "/enginepb.JobTypeHost/CreateWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobTypeHostClient) UpdateStatus(ctx context.Context, in *PluginUpdateStatusRequest, opts ...grpc.CallOption) (*PluginUpdateStatusResponse, error) {
+	out := new(PluginUpdateStatusResponse)
+	err := c.cc.Invoke(ctx, "/enginepb.JobTypeHost/UpdateStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobTypeHostClient) Exit(ctx context.Context, in *PluginExitRequest, opts ...grpc.CallOption) (*PluginExitResponse, error) {
+	out := new(PluginExitResponse)
+	err := c.cc.Invoke(ctx, "/enginepb.JobTypeHost/Exit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobTypeHostServer is the server API for JobTypeHost service.
+// All implementations should embed UnimplementedJobTypeHostServer
+// for forward compatibility
+type JobTypeHostServer interface {
+	// RegisterJobType registers a job type served by a plugin. Registering a
+	// registered job type again updates the address of the plugin, so that a
+	// plugin can register its job types periodically to survive the restarts.
+	RegisterJobType(context.Context, *RegisterJobTypeRequest) (*RegisterJobTypeResponse, error)
+	// CreateWorker creates a worker by a job master of a plugin.
+	CreateWorker(context.Context, *PluginCreateWorkerRequest) (*PluginCreateWorkerResponse, error)
+	// UpdateStatus updates the status of a job master or a worker of a plugin.
+	UpdateStatus(context.Context, *PluginUpdateStatusRequest) (*PluginUpdateStatusResponse, error)
+	// Exit exits a job master or a worker of a plugin.
+	Exit(context.Context, *PluginExitRequest) (*PluginExitResponse, error)
+}
+
+// UnimplementedJobTypeHostServer should be embedded to have forward compatible implementations.
+type UnimplementedJobTypeHostServer struct {
+}
+
+func (UnimplementedJobTypeHostServer) RegisterJobType(context.Context, *RegisterJobTypeRequest) (*RegisterJobTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterJobType not implemented")
+}
+func (UnimplementedJobTypeHostServer) CreateWorker(context.Context, *PluginCreateWorkerRequest) (*PluginCreateWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorker not implemented")
+}
+func (UnimplementedJobTypeHostServer) UpdateStatus(context.Context, *PluginUpdateStatusRequest) (*PluginUpdateStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
+}
+func (UnimplementedJobTypeHostServer) Exit(context.Context, *PluginExitRequest) (*PluginExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exit not implemented")
+}
+
+// UnsafeJobTypeHostServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobTypeHostServer will
+// result in compilation errors.
+type UnsafeJobTypeHostServer interface {
+	mustEmbedUnimplementedJobTypeHostServer()
+}
+
+func RegisterJobTypeHostServer(s grpc.ServiceRegistrar, srv JobTypeHostServer) {
+	s.RegisterService(&JobTypeHost_ServiceDesc, srv)
+}
+
+func _JobTypeHost_RegisterJobType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterJobTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobTypeHostServer).RegisterJobType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobTypeHost/RegisterJobType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobTypeHostServer).RegisterJobType(ctx, req.(*RegisterJobTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobTypeHost_CreateWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginCreateWorkerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobTypeHostServer).CreateWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobTypeHost/CreateWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobTypeHostServer).CreateWorker(ctx, req.(*PluginCreateWorkerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobTypeHost_UpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginUpdateStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobTypeHostServer).UpdateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobTypeHost/UpdateStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobTypeHostServer).UpdateStatus(ctx, req.(*PluginUpdateStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobTypeHost_Exit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobTypeHostServer).Exit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobTypeHost/Exit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobTypeHostServer).Exit(ctx, req.(*PluginExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobTypeHost_ServiceDesc is the grpc.ServiceDesc for JobTypeHost service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobTypeHost_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "enginepb.JobTypeHost",
+	HandlerType: (*JobTypeHostServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterJobType",
+			Handler:    _JobTypeHost_RegisterJobType_Handler,
+		},
+		{
+			MethodName: "CreateWorker",
+			Handler:    _JobTypeHost_CreateWorker_Handler,
+		},
+		{
+			MethodName: "UpdateStatus",
+			Handler:    _JobTypeHost_UpdateStatus_Handler,
+		},
+		{
+			MethodName: "Exit",
+			Handler:    _JobTypeHost_Exit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "engine/proto/plugin.proto",
+}
+
+// JobTypePluginClient is the client API for JobTypePlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobTypePluginClient interface {
+	Call(ctx context.Context, in *PluginCallRequest, opts ...grpc.CallOption) (*PluginCallResponse, error)
+}
+
+type jobTypePluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobTypePluginClient(cc grpc.ClientConnInterface) JobTypePluginClient {
+	return &jobTypePluginClient{cc}
+}
+
+func (c *jobTypePluginClient) Call(ctx context.Context, in *PluginCallRequest, opts ...grpc.CallOption) (*PluginCallResponse, error) {
+	out := new(PluginCallResponse)
+	err := c.cc.Invoke(ctx, "/enginepb.JobTypePlugin/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JobTypePluginServer is the server API for JobTypePlugin service.
+// All implementations should embed UnimplementedJobTypePluginServer
+// for forward compatibility
+type JobTypePluginServer interface {
+	Call(context.Context, *PluginCallRequest) (*PluginCallResponse, error)
+}
+
+// UnimplementedJobTypePluginServer should be embedded to have forward compatible implementations.
+type UnimplementedJobTypePluginServer struct {
+}
+
+func (UnimplementedJobTypePluginServer) Call(context.Context, *PluginCallRequest) (*PluginCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+
+// UnsafeJobTypePluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobTypePluginServer will
+// result in compilation errors.
+type UnsafeJobTypePluginServer interface {
+	mustEmbedUnimplementedJobTypePluginServer()
+}
+
+func RegisterJobTypePluginServer(s grpc.ServiceRegistrar, srv JobTypePluginServer) {
+	s.RegisterService(&JobTypePlugin_ServiceDesc, srv)
+}
+
+func _JobTypePlugin_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluginCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobTypePluginServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enginepb.JobTypePlugin/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobTypePluginServer).Call(ctx, req.(*PluginCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// JobTypePlugin_ServiceDesc is the grpc.ServiceDesc for JobTypePlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobTypePlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "enginepb.JobTypePlugin",
+	HandlerType: (*JobTypePluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Call",
+			Handler:    _JobTypePlugin_Call_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "engine/proto/plugin.proto",
+}
@@ -0,0 +1,330 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/pingcap/log"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/framework/registry"
+	engineModel "github.com/pingcap/tiflow/engine/model"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// Host serves the JobTypeHost service in an executor. The job types registered
+// by the plugins are run by the proxies created by Host, which forward the
+// callbacks of the framework to the plugins and serve the calls of the plugins
+// to the framework.
+type Host struct {
+	mu      sync.RWMutex
+	plugins map[engineModel.JobType]*pluginInfo
+	proxies map[frameModel.WorkerID]proxy
+
+	onRegistered func(tp engineModel.JobType)
+}
+
+var _ pb.JobTypeHostServer = (*Host)(nil)
+
+type pluginInfo struct {
+	name        string
+	workerTypes map[frameModel.WorkerType]string
+	addr        string
+	conn        *grpc.ClientConn
+	client      pb.JobTypePluginClient
+}
+
+// NewHost creates a Host. onRegistered is called when a job type is registered
+// for the first time, after which the executor can run the jobs of the type.
+func NewHost(onRegistered func(tp engineModel.JobType)) *Host {
+	return &Host{
+		plugins:      make(map[engineModel.JobType]*pluginInfo),
+		proxies:      make(map[frameModel.WorkerID]proxy),
+		onRegistered: onRegistered,
+	}
+}
+
+// RegisterJobType implements pb.JobTypeHostServer.RegisterJobType
+func (h *Host) RegisterJobType(
+	_ context.Context, req *pb.RegisterJobTypeRequest,
+) (*pb.RegisterJobTypeResponse, error) {
+	jobType := engineModel.JobType(req.GetJobType())
+	workerTypes, err := validateRegisterJobTypeRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	h.mu.Lock()
+	if info, ok := h.plugins[jobType]; ok {
+		defer h.mu.Unlock()
+		if info.name != req.GetName() || !equalWorkerTypes(info.workerTypes, workerTypes) {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"job type %d is registered with different worker types", jobType)
+		}
+		if info.addr == req.GetPluginAddr() {
+			return &pb.RegisterJobTypeResponse{}, nil
+		}
+		conn, err := dialPlugin(req.GetPluginAddr())
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		if err := info.conn.Close(); err != nil {
+			log.Warn("failed to close the connection to plugin",
+				zap.String("addr", info.addr), zap.Error(err))
+		}
+		log.Info("address of plugin is updated",
+			zap.Stringer("job-type", jobType),
+			zap.String("old-addr", info.addr),
+			zap.String("new-addr", req.GetPluginAddr()))
+		info.addr, info.conn, info.client = req.GetPluginAddr(), conn, pb.NewJobTypePluginClient(conn)
+		return &pb.RegisterJobTypeResponse{}, nil
+	}
+
+	conn, err := dialPlugin(req.GetPluginAddr())
+	if err != nil {
+		h.mu.Unlock()
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if err := h.registerWorkerTypes(jobType, req.GetName(), workerTypes); err != nil {
+		h.mu.Unlock()
+		_ = conn.Close()
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	h.plugins[jobType] = &pluginInfo{
+		name:        req.GetName(),
+		workerTypes: workerTypes,
+		addr:        req.GetPluginAddr(),
+		conn:        conn,
+		client:      pb.NewJobTypePluginClient(conn),
+	}
+	h.mu.Unlock()
+
+	log.Info("job type of plugin is registered",
+		zap.Stringer("job-type", jobType),
+		zap.String("addr", req.GetPluginAddr()))
+	if h.onRegistered != nil {
+		h.onRegistered(jobType)
+	}
+	return &pb.RegisterJobTypeResponse{}, nil
+}
+
+// registerWorkerTypes registers the job type and its worker types in the
+// framework. The registries can't be rolled back, so the job type can't be
+// registered again if it fails halfway, which only happens when the types
+// conflict with the job types linked into the executor.
+func (h *Host) registerWorkerTypes(
+	jobType engineModel.JobType, name string, workerTypes map[frameModel.WorkerType]string,
+) error {
+	if err := engineModel.RegisterCustomJobType(jobType, name); err != nil {
+		return err
+	}
+	if err := h.registerWorkerType(frameModel.WorkerType(jobType), name+"JobMaster", jobType); err != nil {
+		return err
+	}
+	for tp, workerName := range workerTypes {
+		if err := h.registerWorkerType(tp, workerName, jobType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Host) registerWorkerType(tp frameModel.WorkerType, name string, jobType engineModel.JobType) error {
+	if err := framework.RegisterCustomWorkerType(tp, name, jobType); err != nil {
+		return err
+	}
+	if ok := registry.GlobalWorkerRegistry().RegisterWorkerType(tp, &proxyFactory{host: h, jobType: jobType, workerType: tp}); !ok {
+		return errors.ErrInvalidArgument.GenWithStackByArgs("duplicate worker type " + name)
+	}
+	return nil
+}
+
+// CreateWorker implements pb.JobTypeHostServer.CreateWorker
+func (h *Host) CreateWorker(
+	_ context.Context, req *pb.PluginCreateWorkerRequest,
+) (*pb.PluginCreateWorkerResponse, error) {
+	p, err := h.getProxy(req.GetMasterId())
+	if err != nil {
+		return nil, err
+	}
+	master, ok := p.(*jobMasterProxy)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is not a job master", req.GetMasterId())
+	}
+
+	resources := make([]resModel.ResourceID, 0, len(req.GetResources()))
+	for _, res := range req.GetResources() {
+		resources = append(resources, resModel.ResourceID(res))
+	}
+	// The config is marshaled by the framework, RawMessage keeps it as is.
+	workerID, err := master.CreateWorker(
+		frameModel.WorkerType(req.GetWorkerType()),
+		json.RawMessage(req.GetConfig()),
+		framework.CreateWorkerWithResourceRequirements(resources...))
+	if err != nil {
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pb.PluginCreateWorkerResponse{WorkerId: workerID}, nil
+}
+
+// UpdateStatus implements pb.JobTypeHostServer.UpdateStatus
+func (h *Host) UpdateStatus(
+	ctx context.Context, req *pb.PluginUpdateStatusRequest,
+) (*pb.PluginUpdateStatusResponse, error) {
+	p, err := h.getProxy(req.GetWorkerId())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.updateStatus(ctx, fromPBWorkerStatus(req.GetStatus())); err != nil {
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pb.PluginUpdateStatusResponse{}, nil
+}
+
+// Exit implements pb.JobTypeHostServer.Exit
+func (h *Host) Exit(ctx context.Context, req *pb.PluginExitRequest) (*pb.PluginExitResponse, error) {
+	p, err := h.getProxy(req.GetWorkerId())
+	if err != nil {
+		return nil, err
+	}
+	var exitErr error
+	if req.GetErrorMessage() != "" {
+		exitErr = errors.New(req.GetErrorMessage())
+	}
+	if err := p.exit(ctx, framework.ExitReason(req.GetReason()), exitErr, req.GetDetail()); err != nil {
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pb.PluginExitResponse{}, nil
+}
+
+// Close closes the connections to the plugins.
+func (h *Host) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, info := range h.plugins {
+		_ = info.conn.Close()
+	}
+}
+
+func (h *Host) getProxy(id frameModel.WorkerID) (proxy, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	p, ok := h.proxies[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s is not running in the executor", id)
+	}
+	return p, nil
+}
+
+func (h *Host) addProxy(id frameModel.WorkerID, p proxy) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.proxies[id] = p
+}
+
+func (h *Host) removeProxy(id frameModel.WorkerID, p proxy) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// A worker may be recreated in the executor with the same ID.
+	if h.proxies[id] == p {
+		delete(h.proxies, id)
+	}
+}
+
+func (h *Host) getClient(jobType engineModel.JobType) (pb.JobTypePluginClient, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	info, ok := h.plugins[jobType]
+	if !ok {
+		return nil, errors.ErrWorkerTypeNotFound.GenWithStackByArgs(jobType)
+	}
+	return info.client, nil
+}
+
+func validateRegisterJobTypeRequest(req *pb.RegisterJobTypeRequest) (map[frameModel.WorkerType]string, error) {
+	jobType := engineModel.JobType(req.GetJobType())
+	if int64(jobType) != req.GetJobType() || !jobType.IsCustom() {
+		return nil, fmt.Errorf("job type %d must be in [%d, %d]",
+			req.GetJobType(), engineModel.JobTypeCustomBase, engineModel.JobTypeCustomMax)
+	}
+	if req.GetName() == "" {
+		return nil, fmt.Errorf("name of job type %d must not be empty", jobType)
+	}
+	if req.GetPluginAddr() == "" {
+		return nil, fmt.Errorf("plugin address of job type %d must not be empty", jobType)
+	}
+
+	workerTypes := make(map[frameModel.WorkerType]string, len(req.GetWorkerTypes()))
+	for _, w := range req.GetWorkerTypes() {
+		tp := frameModel.WorkerType(w.GetType())
+		if int64(tp) != w.GetType() || !tp.IsCustom() {
+			return nil, fmt.Errorf("worker type %d must be in [%d, %d]",
+				w.GetType(), frameModel.CustomWorkerTypeBase, frameModel.CustomWorkerTypeMax)
+		}
+		if _, ok := workerTypes[tp]; ok || tp == frameModel.WorkerType(jobType) {
+			return nil, fmt.Errorf("duplicate worker type %d", tp)
+		}
+		if w.GetName() == "" {
+			return nil, fmt.Errorf("name of worker type %d must not be empty", tp)
+		}
+		workerTypes[tp] = w.GetName()
+	}
+	return workerTypes, nil
+}
+
+func equalWorkerTypes(a, b map[frameModel.WorkerType]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for tp, name := range a {
+		if bName, ok := b[tp]; !ok || bName != name {
+			return false
+		}
+	}
+	return true
+}
+
+func dialPlugin(addr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+func toPBWorkerStatus(s *frameModel.WorkerStatus) *pb.PluginWorkerStatus {
+	if s == nil {
+		return nil
+	}
+	return &pb.PluginWorkerStatus{
+		State:        int32(s.State),
+		ErrorMessage: s.ErrorMsg,
+		ExtBytes:     s.ExtBytes,
+	}
+}
+
+func fromPBWorkerStatus(s *pb.PluginWorkerStatus) frameModel.WorkerStatus {
+	return frameModel.WorkerStatus{
+		State:    frameModel.WorkerState(s.GetState()),
+		ErrorMsg: s.GetErrorMessage(),
+		ExtBytes: s.GetExtBytes(),
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	engineModel "github.com/pingcap/tiflow/engine/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockPlugin struct {
+	mu    sync.Mutex
+	calls []*pb.PluginCallRequest
+}
+
+func (p *mockPlugin) Call(_ context.Context, req *pb.PluginCallRequest) (*pb.PluginCallResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, req)
+	return &pb.PluginCallResponse{}, nil
+}
+
+func (p *mockPlugin) getCalls() []*pb.PluginCallRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]*pb.PluginCallRequest(nil), p.calls...)
+}
+
+func startMockPlugin(t *testing.T) (*mockPlugin, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	plugin := &mockPlugin{}
+	srv := grpc.NewServer()
+	pb.RegisterJobTypePluginServer(srv, plugin)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+	return plugin, lis.Addr().String()
+}
+
+func TestRegisterJobType(t *testing.T) {
+	t.Parallel()
+
+	var registered []engineModel.JobType
+	h := NewHost(func(tp engineModel.JobType) {
+		registered = append(registered, tp)
+	})
+	defer h.Close()
+
+	jobType := engineModel.JobTypeCustomBase + 200
+	workerType := frameModel.CustomWorkerTypeBase + 1200
+	for _, req := range []*pb.RegisterJobTypeRequest{
+		{JobType: int64(engineModel.JobTypeDM), Name: "Plugin", PluginAddr: "127.0.0.1:1"},
+		{JobType: int64(jobType), PluginAddr: "127.0.0.1:1"},
+		{JobType: int64(jobType), Name: "Plugin"},
+		{
+			JobType: int64(jobType), Name: "Plugin", PluginAddr: "127.0.0.1:1",
+			WorkerTypes: []*pb.PluginWorkerType{{Type: int64(jobType), Name: "PluginTask"}},
+		},
+		{
+			JobType: int64(jobType), Name: "Plugin", PluginAddr: "127.0.0.1:1",
+			WorkerTypes: []*pb.PluginWorkerType{{Type: int64(workerType)}},
+		},
+	} {
+		_, err := h.RegisterJobType(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	require.Empty(t, registered)
+
+	req := &pb.RegisterJobTypeRequest{
+		JobType: int64(jobType), Name: "Plugin", PluginAddr: "127.0.0.1:1",
+		WorkerTypes: []*pb.PluginWorkerType{{Type: int64(workerType), Name: "PluginTask"}},
+	}
+	_, err := h.RegisterJobType(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, []engineModel.JobType{jobType}, registered)
+	require.Equal(t, "Plugin", jobType.String())
+	require.Equal(t, "PluginJobMaster", frameModel.WorkerType(jobType).String())
+	require.Equal(t, "PluginTask", workerType.String())
+	require.Equal(t, jobType, framework.MustConvertWorkerType2JobType(workerType))
+
+	// registering again updates the address of the plugin
+	req.PluginAddr = "127.0.0.1:2"
+	_, err = h.RegisterJobType(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, registered, 1)
+	require.Equal(t, "127.0.0.1:2", h.plugins[jobType].addr)
+
+	req.WorkerTypes[0].Name = "PluginTask2"
+	_, err = h.RegisterJobType(context.Background(), req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = h.UpdateStatus(context.Background(), &pb.PluginUpdateStatusRequest{WorkerId: "worker-1"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = h.Exit(context.Background(), &pb.PluginExitRequest{WorkerId: "worker-1"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = h.CreateWorker(context.Background(), &pb.PluginCreateWorkerRequest{MasterId: "job-1"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWorkerProxy(t *testing.T) {
+	t.Parallel()
+
+	plugin, addr := startMockPlugin(t)
+	h := NewHost(nil)
+	defer h.Close()
+
+	jobType := engineModel.JobTypeCustomBase + 201
+	workerType := frameModel.CustomWorkerTypeBase + 1201
+	_, err := h.RegisterJobType(context.Background(), &pb.RegisterJobTypeRequest{
+		JobType: int64(jobType), Name: "ProxyTest", PluginAddr: addr,
+		WorkerTypes: []*pb.PluginWorkerType{{Type: int64(workerType), Name: "ProxyTestTask"}},
+	})
+	require.NoError(t, err)
+
+	factory := &proxyFactory{host: h, jobType: jobType, workerType: workerType}
+	config, err := factory.DeserializeConfig([]byte(`{"a":1}`))
+	require.NoError(t, err)
+	impl, err := factory.NewWorkerImpl(nil, "worker-1", "job-1", config)
+	require.NoError(t, err)
+	worker, ok := impl.(*workerProxy)
+	require.True(t, ok)
+
+	ctx := context.Background()
+	require.NoError(t, worker.InitImpl(ctx))
+	_, err = h.getProxy("worker-1")
+	require.NoError(t, err)
+	require.NoError(t, worker.Tick(ctx))
+	require.NoError(t, worker.OnMasterMessage(ctx, "topic", map[string]int{"b": 2}))
+	worker.CloseImpl(ctx)
+	_, err = h.getProxy("worker-1")
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	calls := plugin.getCalls()
+	require.Len(t, calls, 4)
+	for i, callback := range []pb.PluginCallRequest_Callback{
+		pb.PluginCallRequest_Init,
+		pb.PluginCallRequest_Tick,
+		pb.PluginCallRequest_MasterMessage,
+		pb.PluginCallRequest_Close,
+	} {
+		require.Equal(t, callback, calls[i].Callback)
+		require.Equal(t, "worker-1", calls[i].WorkerId)
+		require.Equal(t, "job-1", calls[i].MasterId)
+		require.Equal(t, int64(workerType), calls[i].WorkerType)
+	}
+	require.Equal(t, []byte(`{"a":1}`), calls[0].Config)
+	require.Equal(t, "topic", calls[2].Topic)
+	require.JSONEq(t, `{"b":2}`, string(calls[2].Message))
+}
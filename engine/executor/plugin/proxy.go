@@ -0,0 +1,276 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	engineModel "github.com/pingcap/tiflow/engine/model"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// pluginCallTimeout is the timeout of the callbacks forwarded to a plugin
+// which are not given a context by the framework.
+const pluginCallTimeout = 10 * time.Second
+
+// proxy is a job master or a worker run by Host for a plugin.
+type proxy interface {
+	updateStatus(ctx context.Context, status frameModel.WorkerStatus) error
+	exit(ctx context.Context, reason framework.ExitReason, err error, detail []byte) error
+}
+
+// proxyFactory implements registry.WorkerFactory for a worker type registered
+// by a plugin.
+type proxyFactory struct {
+	host       *Host
+	jobType    engineModel.JobType
+	workerType frameModel.WorkerType
+}
+
+// NewWorkerImpl implements registry.WorkerFactory.NewWorkerImpl
+func (f *proxyFactory) NewWorkerImpl(
+	_ *dcontext.Context,
+	workerID frameModel.WorkerID,
+	masterID frameModel.MasterID,
+	config framework.WorkerConfig,
+) (framework.WorkerImpl, error) {
+	base := proxyBase{
+		host:       f.host,
+		jobType:    f.jobType,
+		workerType: f.workerType,
+		id:         workerID,
+		masterID:   masterID,
+		config:     config.([]byte),
+	}
+	if f.workerType == frameModel.WorkerType(f.jobType) {
+		return &jobMasterProxy{proxyBase: base}, nil
+	}
+	return &workerProxy{proxyBase: base}, nil
+}
+
+// DeserializeConfig implements registry.WorkerFactory.DeserializeConfig, the
+// config is decoded by the plugin.
+func (f *proxyFactory) DeserializeConfig(configBytes []byte) (framework.WorkerConfig, error) {
+	return configBytes, nil
+}
+
+// IsRetryableError implements registry.WorkerFactory.IsRetryableError
+func (f *proxyFactory) IsRetryableError(_ error) bool {
+	return true
+}
+
+type proxyBase struct {
+	host       *Host
+	jobType    engineModel.JobType
+	workerType frameModel.WorkerType
+	id         frameModel.WorkerID
+	masterID   frameModel.MasterID
+	config     []byte
+}
+
+func (p *proxyBase) call(
+	ctx context.Context, callback pb.PluginCallRequest_Callback, req *pb.PluginCallRequest,
+) error {
+	client, err := p.host.getClient(p.jobType)
+	if err != nil {
+		return err
+	}
+	if req == nil {
+		req = &pb.PluginCallRequest{}
+	}
+	req.Callback = callback
+	req.WorkerId = p.id
+	req.MasterId = p.masterID
+	req.WorkerType = int64(p.workerType)
+	_, err = client.Call(ctx, req)
+	return errors.Trace(err)
+}
+
+func (p *proxyBase) callWithTimeout(callback pb.PluginCallRequest_Callback, req *pb.PluginCallRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	return p.call(ctx, callback, req)
+}
+
+// jobMasterProxy implements framework.JobMasterImpl for a plugin.
+type jobMasterProxy struct {
+	framework.BaseJobMaster
+	proxyBase
+}
+
+var _ framework.JobMasterImpl = (*jobMasterProxy)(nil)
+
+// InitImpl implements JobMasterImpl.InitImpl
+func (m *jobMasterProxy) InitImpl(ctx context.Context) error {
+	m.host.addProxy(m.id, m)
+	return m.call(ctx, pb.PluginCallRequest_Init, &pb.PluginCallRequest{Config: m.config})
+}
+
+// OnMasterRecovered implements JobMasterImpl.OnMasterRecovered
+func (m *jobMasterProxy) OnMasterRecovered(ctx context.Context) error {
+	m.host.addProxy(m.id, m)
+	return m.call(ctx, pb.PluginCallRequest_Recover, &pb.PluginCallRequest{Config: m.config})
+}
+
+// Tick implements JobMasterImpl.Tick
+func (m *jobMasterProxy) Tick(ctx context.Context) error {
+	return m.call(ctx, pb.PluginCallRequest_Tick, nil)
+}
+
+// OnWorkerDispatched implements JobMasterImpl.OnWorkerDispatched
+func (m *jobMasterProxy) OnWorkerDispatched(worker framework.WorkerHandle, result error) error {
+	req := &pb.PluginCallRequest{EventWorkerId: worker.ID()}
+	if result != nil {
+		req.ErrorMessage = result.Error()
+	}
+	return m.callWithTimeout(pb.PluginCallRequest_WorkerDispatched, req)
+}
+
+// OnWorkerOnline implements JobMasterImpl.OnWorkerOnline
+func (m *jobMasterProxy) OnWorkerOnline(worker framework.WorkerHandle) error {
+	return m.callWithTimeout(pb.PluginCallRequest_WorkerOnline, &pb.PluginCallRequest{
+		EventWorkerId:     worker.ID(),
+		EventWorkerStatus: toPBWorkerStatus(worker.Status()),
+	})
+}
+
+// OnWorkerOffline implements JobMasterImpl.OnWorkerOffline
+func (m *jobMasterProxy) OnWorkerOffline(worker framework.WorkerHandle, reason error) error {
+	req := &pb.PluginCallRequest{
+		EventWorkerId:     worker.ID(),
+		EventWorkerStatus: toPBWorkerStatus(worker.Status()),
+	}
+	if reason != nil {
+		req.ErrorMessage = reason.Error()
+	}
+	return m.callWithTimeout(pb.PluginCallRequest_WorkerOffline, req)
+}
+
+// OnWorkerMessage implements JobMasterImpl.OnWorkerMessage
+func (m *jobMasterProxy) OnWorkerMessage(_ framework.WorkerHandle, _ p2p.Topic, _ interface{}) error {
+	return nil
+}
+
+// OnWorkerStatusUpdated implements JobMasterImpl.OnWorkerStatusUpdated
+func (m *jobMasterProxy) OnWorkerStatusUpdated(worker framework.WorkerHandle, newStatus *frameModel.WorkerStatus) error {
+	return m.callWithTimeout(pb.PluginCallRequest_WorkerStatusUpdated, &pb.PluginCallRequest{
+		EventWorkerId:     worker.ID(),
+		EventWorkerStatus: toPBWorkerStatus(newStatus),
+	})
+}
+
+// OnMasterMessage implements JobMasterImpl.OnMasterMessage
+func (m *jobMasterProxy) OnMasterMessage(_ context.Context, _ p2p.Topic, _ p2p.MessageValue) error {
+	return nil
+}
+
+// CloseImpl implements JobMasterImpl.CloseImpl
+func (m *jobMasterProxy) CloseImpl(ctx context.Context) {
+	m.close(ctx)
+}
+
+// StopImpl implements JobMasterImpl.StopImpl
+func (m *jobMasterProxy) StopImpl(ctx context.Context) {
+	m.close(ctx)
+}
+
+func (m *jobMasterProxy) close(ctx context.Context) {
+	defer m.host.removeProxy(m.id, m)
+	if err := m.call(ctx, pb.PluginCallRequest_Close, nil); err != nil {
+		m.Logger().Warn("failed to close job master of plugin", zap.Error(err))
+	}
+}
+
+// OnCancel implements JobMasterImpl.OnCancel
+func (m *jobMasterProxy) OnCancel(ctx context.Context) error {
+	return m.call(ctx, pb.PluginCallRequest_Cancel, nil)
+}
+
+// OnPause implements JobMasterImpl.OnPause
+func (m *jobMasterProxy) OnPause(ctx context.Context) error {
+	return m.call(ctx, pb.PluginCallRequest_Pause, nil)
+}
+
+// OnOpenAPIInitialized implements JobMasterImpl.OnOpenAPIInitialized, the
+// OpenAPI of a job of a plugin isn't supported.
+func (m *jobMasterProxy) OnOpenAPIInitialized(_ *gin.RouterGroup) {}
+
+// IsJobMasterImpl implements JobMasterImpl.IsJobMasterImpl
+func (m *jobMasterProxy) IsJobMasterImpl() {
+	panic("unreachable")
+}
+
+func (m *jobMasterProxy) updateStatus(ctx context.Context, status frameModel.WorkerStatus) error {
+	return m.UpdateJobStatus(ctx, status)
+}
+
+func (m *jobMasterProxy) exit(ctx context.Context, reason framework.ExitReason, err error, detail []byte) error {
+	return m.Exit(ctx, reason, err, detail)
+}
+
+// workerProxy implements framework.WorkerImpl for a plugin.
+type workerProxy struct {
+	framework.BaseWorker
+	proxyBase
+}
+
+var _ framework.WorkerImpl = (*workerProxy)(nil)
+
+// InitImpl implements WorkerImpl.InitImpl
+func (w *workerProxy) InitImpl(ctx context.Context) error {
+	w.host.addProxy(w.id, w)
+	return w.call(ctx, pb.PluginCallRequest_Init, &pb.PluginCallRequest{Config: w.config})
+}
+
+// Tick implements WorkerImpl.Tick
+func (w *workerProxy) Tick(ctx context.Context) error {
+	return w.call(ctx, pb.PluginCallRequest_Tick, nil)
+}
+
+// OnMasterMessage implements WorkerImpl.OnMasterMessage
+func (w *workerProxy) OnMasterMessage(ctx context.Context, topic p2p.Topic, message p2p.MessageValue) error {
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return w.call(ctx, pb.PluginCallRequest_MasterMessage, &pb.PluginCallRequest{
+		Topic:   topic,
+		Message: msg,
+	})
+}
+
+// CloseImpl implements WorkerImpl.CloseImpl
+func (w *workerProxy) CloseImpl(ctx context.Context) {
+	defer w.host.removeProxy(w.id, w)
+	if err := w.call(ctx, pb.PluginCallRequest_Close, nil); err != nil {
+		w.Logger().Warn("failed to close worker of plugin", zap.Error(err))
+	}
+}
+
+func (w *workerProxy) updateStatus(ctx context.Context, status frameModel.WorkerStatus) error {
+	return w.UpdateStatus(ctx, status)
+}
+
+func (w *workerProxy) exit(ctx context.Context, reason framework.ExitReason, err error, detail []byte) error {
+	return w.Exit(ctx, reason, err, detail)
+}
@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"strings"
+	"sync"
 	"time"
 
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -26,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tiflow/dm/common"
 	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/executor/plugin"
 	"github.com/pingcap/tiflow/engine/executor/server"
 	"github.com/pingcap/tiflow/engine/executor/worker"
 	"github.com/pingcap/tiflow/engine/framework"
@@ -94,6 +96,12 @@ type Server struct {
 	p2pMsgRouter   p2pImpl.MessageRouter
 	resourceBroker broker.Broker
 	jobAPISrv      *jobAPIServer
+	pluginHost     *plugin.Host
+
+	// labels are the labels of the executor, the labels of the job types
+	// registered by the plugins are added at runtime.
+	labelsMu sync.Mutex
+	labels   map[string]string
 }
 
 // NewServer creates a new executor server instance
@@ -105,10 +113,33 @@ func NewServer(cfg *Config) *Server {
 		cfg:        cfg,
 		jobAPISrv:  newJobAPIServer(),
 		metastores: server.NewMetastoreManager(),
+		labels:     make(map[string]string, len(cfg.Labels)),
+	}
+	for k, v := range cfg.Labels {
+		s.labels[k] = v
 	}
+	s.pluginHost = plugin.NewHost(s.addJobTypeLabel)
 	return &s
 }
 
+// addJobTypeLabel adds the label of a job type registered by a plugin, it's
+// sent to the server master by the next heartbeat.
+func (s *Server) addJobTypeLabel(tp model.JobType) {
+	s.labelsMu.Lock()
+	defer s.labelsMu.Unlock()
+	s.labels[model.CustomJobTypeLabelKey(tp)] = model.CustomJobTypeLabelValue
+}
+
+func (s *Server) getLabels() map[string]string {
+	s.labelsMu.Lock()
+	defer s.labelsMu.Unlock()
+	labels := make(map[string]string, len(s.labels))
+	for k, v := range s.labels {
+		labels[k] = v
+	}
+	return labels
+}
+
 func (s *Server) buildDeps() (*deps.Deps, error) {
 	deps := deps.NewDeps()
 	err := deps.Provide(func() p2p.MessageHandlerManager {
@@ -357,6 +388,10 @@ func (s *Server) Stop() {
 		s.mockSrv.Stop()
 	}
 
+	if s.pluginHost != nil {
+		s.pluginHost.Close()
+	}
+
 	// TODO: unregister self from master.
 }
 
@@ -557,6 +592,7 @@ func (s *Server) startTCPService(ctx context.Context, wg *errgroup.Group) error
 	s.tcpServer = tcpServer
 	pb.RegisterExecutorServiceServer(s.grpcSrv, s)
 	pb.RegisterBrokerServiceServer(s.grpcSrv, s.resourceBroker)
+	pb.RegisterJobTypeHostServer(s.grpcSrv, s.pluginHost)
 	log.Info("listen address", zap.String("addr", s.cfg.Addr))
 
 	wg.Go(func() error {
@@ -614,7 +650,7 @@ func (s *Server) selfRegister(ctx context.Context) error {
 		Executor: &pb.Executor{
 			Name:    s.cfg.Name,
 			Address: s.cfg.AdvertiseAddr,
-			Labels:  s.cfg.Labels,
+			Labels:  s.getLabels(),
 		},
 	}
 	executorID, err := s.masterClient.RegisterExecutor(ctx, registerReq)
@@ -646,7 +682,8 @@ func (s *Server) keepHeartbeat(ctx context.Context) error {
 				Timestamp:  uint64(t.Unix()),
 				// We set longer ttl for master, which is "ttl + rpc timeout", to avoid that
 				// executor actually wait for a timeout when ttl is nearly up.
-				Ttl: uint64(s.cfg.KeepAliveTTL.Milliseconds() + s.cfg.RPCTimeout.Milliseconds()),
+				Ttl:    uint64(s.cfg.KeepAliveTTL.Milliseconds() + s.cfg.RPCTimeout.Milliseconds()),
+				Labels: s.getLabels(),
 			}
 			_, err := s.masterClient.Heartbeat(ctx, req)
 			if err != nil {
@@ -73,7 +73,7 @@ type BaseJobMaster interface {
 	SendMessage(ctx context.Context, topic p2p.Topic, message interface{}, nonblocking bool) error
 
 	// Exit should be called when jobmaster (in user logic) wants to exit.
-	// exitReason: ExitReasonFinished/ExitReasonCanceled/ExitReasonFailed
+	// exitReason: ExitReasonFinished/ExitReasonCanceled/ExitReasonFailed/ExitReasonPaused
 	Exit(ctx context.Context, exitReason ExitReason, err error, detail []byte) error
 
 	// IsMasterReady returns whether the master has received heartbeats for all
@@ -126,6 +126,12 @@ type JobMasterImpl interface {
 	// triggered multiple times.
 	// TODO: when it returns error, framework should close this jobmaster.
 	OnCancel(ctx context.Context) error
+	// OnPause is triggered when a pause message is received, e.g. the job is
+	// preempted by a job with a higher priority. Unlike OnCancel, the job
+	// master must keep its metadata and checkpoints and exit by calling Exit
+	// with ExitReasonPaused, it's recovered from them when it's resumed.
+	// It can be triggered multiple times.
+	OnPause(ctx context.Context) error
 	// OnOpenAPIInitialized is called as the first callback function of the JobMasterImpl
 	// instance, the business logic should only register the OpenAPI handler in it.
 	// The implementation must not retain the apiGroup.
@@ -381,6 +387,7 @@ func (d *DefaultBaseJobMaster) IsBaseJobMasterExt() {}
 type jobMasterImplAsWorkerImpl struct {
 	inner          JobMasterImpl
 	onCancelCalled bool
+	onPauseCalled  bool
 }
 
 func (j *jobMasterImplAsWorkerImpl) InitImpl(ctx context.Context) error {
@@ -400,6 +407,13 @@ func (j *jobMasterImplAsWorkerImpl) OnMasterMessage(
 	case *frameModel.StatusChangeRequest:
 		switch msg.ExpectState {
 		case frameModel.WorkerStateStopped:
+			if msg.Pause {
+				if !j.onPauseCalled && !j.onCancelCalled {
+					j.onPauseCalled = true
+					return j.inner.OnPause(ctx)
+				}
+				return nil
+			}
 			if !j.onCancelCalled {
 				j.onCancelCalled = true
 				return j.inner.OnCancel(ctx)
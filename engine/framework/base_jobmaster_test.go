@@ -156,6 +156,14 @@ func (m *testJobMasterImpl) OnCancel(ctx context.Context) error {
 	return args.Error(0)
 }
 
+func (m *testJobMasterImpl) OnPause(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 // simulate the job manager to insert a job record first since job master will only update the job
 func prepareInsertJob(ctx context.Context, cli pkgOrm.Client, jobID string) error {
 	return cli.UpsertJob(ctx, &frameModel.MasterMeta{
@@ -343,6 +351,15 @@ func TestJobMasterExit(t *testing.T) {
 			expectedErrorMsg: "test failed with error",
 			expectedDetail:   "test failed",
 		},
+		{
+			// the paused job master is recovered when it's resumed.
+			exitReason:       ExitReasonPaused,
+			err:              nil,
+			detail:           "test paused",
+			expectedState:    frameModel.MasterStateInit,
+			expectedErrorMsg: "",
+			expectedDetail:   "test paused",
+		},
 	}
 
 	for _, cs := range cases {
@@ -407,6 +424,29 @@ func TestJobMasterExit(t *testing.T) {
 	}
 }
 
+func TestJobMasterPauseAndCancelMessage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	jobMaster := &testJobMasterImpl{}
+	jobMaster.On("OnPause", mock.Anything).Return(nil)
+	jobMaster.On("OnCancel", mock.Anything).Return(nil)
+	impl := &jobMasterImplAsWorkerImpl{inner: jobMaster}
+
+	pause := &frameModel.StatusChangeRequest{ExpectState: frameModel.WorkerStateStopped, Pause: true}
+	require.NoError(t, impl.OnMasterMessage(ctx, "", pause))
+	require.NoError(t, impl.OnMasterMessage(ctx, "", pause))
+	jobMaster.AssertNumberOfCalls(t, "OnPause", 1)
+	jobMaster.AssertNumberOfCalls(t, "OnCancel", 0)
+
+	// the paused job master can still be canceled.
+	cancel := &frameModel.StatusChangeRequest{ExpectState: frameModel.WorkerStateStopped}
+	require.NoError(t, impl.OnMasterMessage(ctx, "", cancel))
+	require.NoError(t, impl.OnMasterMessage(ctx, "", pause))
+	jobMaster.AssertNumberOfCalls(t, "OnPause", 1)
+	jobMaster.AssertNumberOfCalls(t, "OnCancel", 1)
+}
+
 func TestJobMasterInitReturnError(t *testing.T) {
 	t.Parallel()
 
@@ -14,10 +14,13 @@
 package framework
 
 import (
+	"sync"
+
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/engine/framework/internal/master"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
 	engineModel "github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/pkg/label"
 	"go.uber.org/zap"
 )
 
@@ -67,11 +70,43 @@ func MustConvertWorkerType2JobType(tp WorkerType) engineModel.JobType {
 	case frameModel.CdcJobMaster, frameModel.CdcTask:
 		return engineModel.JobTypeCDC
 	}
+	if tp.IsCustom() {
+		if jobType, ok := customWorkerJobTypes.Load(tp); ok {
+			return jobType.(engineModel.JobType)
+		}
+		// The worker types of a user-defined job are only registered on the
+		// executors which can run the job, and the worker type of the job
+		// master is the same number as the job type.
+		return engineModel.JobType(tp)
+	}
 
 	log.Panic("unexpected fail when convert worker type to job type", zap.Stringer("worker_type", tp))
 	return engineModel.JobTypeInvalid
 }
 
+// customWorkerJobTypes maps the registered worker types of the user-defined
+// jobs to their job types.
+var customWorkerJobTypes sync.Map
+
+// RegisterCustomWorkerType registers a worker type of a user-defined job type.
+func RegisterCustomWorkerType(tp WorkerType, name string, jobType engineModel.JobType) error {
+	if err := frameModel.RegisterCustomWorkerType(tp, name); err != nil {
+		return err
+	}
+	customWorkerJobTypes.Store(tp, jobType)
+	return nil
+}
+
+// CustomJobTypeSelector returns the selector of the executors which registered
+// the user-defined job type, the workers of the job type can only run on them.
+func CustomJobTypeSelector(jobType engineModel.JobType) *label.Selector {
+	return &label.Selector{
+		Key:    label.Key(engineModel.CustomJobTypeLabelKey(jobType)),
+		Target: engineModel.CustomJobTypeLabelValue,
+		Op:     label.OpEq,
+	}
+}
+
 // ExitReason is the type for exit reason
 type ExitReason int
 
@@ -81,6 +116,10 @@ const (
 	ExitReasonFinished
 	ExitReasonCanceled
 	ExitReasonFailed
+	// ExitReasonPaused means the job master exits temporarily, e.g. it's
+	// preempted by a job with a higher priority. It keeps its metadata and
+	// checkpoints, and it's recovered from them when the job is resumed.
+	ExitReasonPaused
 )
 
 // WorkerStateToExitReason translates WorkerState to ExitReason
@@ -0,0 +1,42 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	engineModel "github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/pkg/label"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomWorkerType(t *testing.T) {
+	t.Parallel()
+
+	jobType := engineModel.JobTypeCustomBase + 10
+	// the job master of a user-defined job has the same number as the job type.
+	require.Equal(t, jobType, MustConvertWorkerType2JobType(frameModel.WorkerType(jobType)))
+
+	workerType := frameModel.CustomWorkerTypeBase + 11
+	require.Equal(t, engineModel.JobType(workerType), MustConvertWorkerType2JobType(workerType))
+	require.NoError(t, RegisterCustomWorkerType(workerType, "CustomCommonTestTask", jobType))
+	require.Equal(t, jobType, MustConvertWorkerType2JobType(workerType))
+	require.Error(t, RegisterCustomWorkerType(workerType, "CustomCommonTestTask2", jobType))
+
+	selector := CustomJobTypeSelector(jobType)
+	require.NoError(t, selector.Validate())
+	require.True(t, selector.Matches(label.Set{"job-type-1010": "enabled"}))
+	require.False(t, selector.Matches(label.Set{"job-type-1011": "enabled"}))
+}
@@ -96,7 +96,7 @@ func (c *WorkerCreator) CreateWorker(
 		op(options)
 	}
 
-	req, err := c.buildScheduleTaskRequest(c.masterID, workerID, options)
+	req, err := c.buildScheduleTaskRequest(c.masterID, workerID, projectInfo, options)
 	if err != nil {
 		return err
 	}
@@ -147,6 +147,7 @@ func (c *WorkerCreator) CreateWorker(
 func (c *WorkerCreator) buildScheduleTaskRequest(
 	masterID frameModel.MasterID,
 	workerID frameModel.WorkerID,
+	projectInfo tenant.ProjectInfo,
 	opts *createWorkerOpts,
 ) (*pb.ScheduleTaskRequest, error) {
 	finalSelectors := make([]*label.Selector, 0, len(c.inheritedSelectors)+len(opts.Selectors))
@@ -162,6 +163,8 @@ func (c *WorkerCreator) buildScheduleTaskRequest(
 		TaskId:    workerID,
 		Resources: resModel.ToResourceRequirement(masterID, opts.Resources...),
 		Selectors: selectors,
+		TenantId:  projectInfo.TenantID(),
+		JobId:     masterID,
 	}, nil
 }
 
@@ -144,6 +144,8 @@ func TestCreateWorkerNormal(t *testing.T) {
 			Resources: resModel.ToResourceRequirement(
 				"job-1", "/local/resource-1", "/local/resource-2"),
 			Selectors: expectedPBSelectors,
+			TenantId:  "tenant-1",
+			JobId:     "job-1",
 		}).Return(
 		&pb.ScheduleTaskResponse{
 			ExecutorId:   "executor-1",
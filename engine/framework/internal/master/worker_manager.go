@@ -515,6 +515,8 @@ func (m *WorkerManager) checkWorkerEntriesOnce() error {
 				offlineError = errors.ErrWorkerCancel.FastGenByArgs()
 			case frameModel.WorkerStateError:
 				offlineError = errors.ErrWorkerFailed.FastGenByArgs()
+			case frameModel.WorkerStatePaused:
+				offlineError = errors.ErrWorkerPaused.FastGenByArgs()
 			default:
 				offlineError = errors.ErrWorkerOffline.FastGenByArgs(workerID)
 			}
@@ -37,6 +37,7 @@ import (
 	"github.com/pingcap/tiflow/engine/pkg/meta"
 	metaModel "github.com/pingcap/tiflow/engine/pkg/meta/model"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
 	"github.com/pingcap/tiflow/engine/pkg/p2p"
 	"github.com/pingcap/tiflow/engine/pkg/promutil"
 	"github.com/pingcap/tiflow/engine/pkg/quota"
@@ -158,6 +159,7 @@ type MasterImpl interface {
 const (
 	createWorkerWaitQuotaTimeout = 5 * time.Second
 	createWorkerTimeout          = 10 * time.Second
+	recordWorkerEventTimeout     = 5 * time.Second
 	maxCreateWorkerConcurrency   = 100
 )
 
@@ -421,13 +423,24 @@ func (m *DefaultBaseMaster) doInit(ctx context.Context) (isFirstStartUp bool, er
 		func(_ context.Context, handle master.WorkerHandle) error {
 			return m.Impl.OnWorkerOnline(handle)
 		},
-		func(_ context.Context, handle master.WorkerHandle, err error) error {
+		func(ctx context.Context, handle master.WorkerHandle, err error) error {
+			tp := ormModel.JobEventFailover
+			// the finished and canceled workers won't be created again.
+			if errors.Is(err, errors.ErrWorkerFinish) || errors.Is(err, errors.ErrWorkerCancel) {
+				tp = ormModel.JobEventOffline
+			}
+			m.recordWorkerEvent(ctx, handle.ID(), tp, err)
 			return m.Impl.OnWorkerOffline(handle, err)
 		},
 		func(_ context.Context, handle master.WorkerHandle) error {
 			return m.Impl.OnWorkerStatusUpdated(handle, handle.Status())
 		},
-		func(_ context.Context, handle master.WorkerHandle, err error) error {
+		func(ctx context.Context, handle master.WorkerHandle, err error) error {
+			tp := ormModel.JobEventDispatched
+			if err != nil {
+				tp = ormModel.JobEventDispatchFailed
+			}
+			m.recordWorkerEvent(ctx, handle.ID(), tp, err)
 			return m.Impl.OnWorkerDispatched(handle, err)
 		}, isInit, m.timeoutConfig, m.clock).
 		WithLogger(m.logger)
@@ -648,6 +661,30 @@ func (m *DefaultBaseMaster) persistMetaError() {
 	}
 }
 
+// recordWorkerEvent records an event of a worker in the events of the job,
+// the events of the job masters are recorded by the job manager itself.
+func (m *DefaultBaseMaster) recordWorkerEvent(
+	ctx context.Context, workerID frameModel.WorkerID, tp ormModel.JobEventType, err error,
+) {
+	if m.id == metadata.JobManagerUUID {
+		return
+	}
+	event := &ormModel.JobEvent{
+		JobID:     m.id,
+		WorkerID:  workerID,
+		EventType: tp,
+		CreatedAt: m.clock.Now(),
+	}
+	if err != nil {
+		event.Message = err.Error()
+	}
+	ctx, cancel := context.WithTimeout(ctx, recordWorkerEventTimeout)
+	defer cancel()
+	if err := m.frameMetaClient.CreateJobEvent(ctx, event); err != nil {
+		m.Logger().Warn("failed to record worker event", zap.Any("event", event), zap.Error(err))
+	}
+}
+
 // PrepareWorkerConfig extracts information from WorkerConfig into detail fields.
 //   - If workerType is master type, the config is a `*MasterMeta` struct and
 //     contains pre allocated maseter ID, and json marshalled config.
@@ -674,6 +711,13 @@ func (m *DefaultBaseMaster) PrepareWorkerConfig(
 		rawConfig = b.Bytes()
 		workerID = m.uuidGen.NewString()
 	default:
+		// The job masters of the user-defined job types are created by the
+		// job manager with their master meta like the built-in ones.
+		if masterMeta, ok := config.(*frameModel.MasterMeta); ok && workerType.IsCustom() {
+			rawConfig = masterMeta.Config
+			workerID = masterMeta.ID
+			return
+		}
 		rawConfig, err = json.Marshal(config)
 		if err != nil {
 			return
@@ -698,6 +742,10 @@ func (m *DefaultBaseMaster) CreateWorker(
 	if err != nil {
 		return "", err
 	}
+	if workerType.IsCustom() {
+		opts = append(opts, CreateWorkerWithSelectors(
+			CustomJobTypeSelector(MustConvertWorkerType2JobType(workerType))))
+	}
 
 	errCtx, cancel := m.errCenter.WithCancelOnFirstError(context.Background())
 	defer cancel()
@@ -758,6 +806,9 @@ func (m *DefaultBaseMaster) exitWithoutSetErrCenter(ctx context.Context, exitRea
 		m.masterMeta.State = frameModel.MasterStateStopped
 	case ExitReasonFailed:
 		m.masterMeta.State = frameModel.MasterStateFailed
+	case ExitReasonPaused:
+		// the paused master is recovered when it's dispatched again.
+		m.masterMeta.State = frameModel.MasterStateInit
 	default:
 		m.masterMeta.State = frameModel.MasterStateFailed
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tiflow/engine/framework/statusutil"
 	"github.com/pingcap/tiflow/engine/pkg/externalresource/broker"
 	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/model"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/uuid"
 	"github.com/stretchr/testify/mock"
@@ -279,6 +280,13 @@ func TestMasterCreateWorkerMetError(t *testing.T) {
 		require.NoError(t, master.Poll(ctx))
 		select {
 		case <-done:
+			// the failed dispatch is recorded in the events of the job.
+			events, err := master.GetFrameMetaClient().QueryJobEvents(ctx, masterName, 0)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			require.Equal(t, ormModel.JobEventDispatchFailed, events[0].EventType)
+			require.Equal(t, workerID1, events[0].WorkerID)
+			require.Contains(t, events[0].Message, "ErrClusterResourceNotEnough")
 			return
 		default:
 		}
@@ -315,6 +323,10 @@ func TestPrepareWorkerConfig(t *testing.T) {
 			frameModel.FakeTask, fakeWorkerCfg,
 			fakeCfgBytes, fakeWorkerID,
 		},
+		{
+			frameModel.CustomWorkerTypeBase, &frameModel.MasterMeta{ID: "custom-master-1", Config: fakeCfgBytes},
+			fakeCfgBytes, "custom-master-1",
+		},
 	}
 	for _, tc := range testCases {
 		rawConfig, workerID, err := master.PrepareWorkerConfig(tc.workerType, tc.config)
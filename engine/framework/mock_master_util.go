@@ -111,6 +111,7 @@ func MockBaseMasterCreateWorker(
 	expectedSchedulerReq := &pb.ScheduleTaskRequest{
 		TaskId:    workerID,
 		Resources: resModel.ToResourceRequirement(masterID, resources...),
+		JobId:     masterID,
 	}
 	master.serverMasterClient.(*client.MockServerMasterClient).EXPECT().
 		ScheduleTask(gomock.Any(), gomock.Eq(expectedSchedulerReq)).
@@ -156,6 +157,7 @@ func MockBaseMasterCreateWorkerMetScheduleTaskError(
 	master.uuidGen = uuid.NewMock()
 	expectedSchedulerReq := &pb.ScheduleTaskRequest{
 		TaskId: workerID,
+		JobId:  masterID,
 	}
 	master.serverMasterClient.(*client.MockServerMasterClient).EXPECT().
 		ScheduleTask(gomock.Any(), gomock.Eq(expectedSchedulerReq)).
@@ -53,10 +53,50 @@ func (code MasterState) IsTerminatedState() bool {
 	}
 }
 
+// PriorityClass is the priority of a job. When the cluster capacity is short,
+// the jobs with a higher priority are scheduled first, and they may preempt
+// the running jobs with a lower priority.
+type PriorityClass string
+
+// Priority classes of jobs, an empty class is treated as normal.
+const (
+	PriorityClassLow    = PriorityClass("low")
+	PriorityClassNormal = PriorityClass("normal")
+	PriorityClassHigh   = PriorityClass("high")
+)
+
+// Level returns the comparable level of the priority class,
+// a larger level means a higher priority.
+func (p PriorityClass) Level() int {
+	switch p {
+	case PriorityClassLow:
+		return 0
+	case PriorityClassHigh:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// Validate checks whether the priority class is valid.
+func (p PriorityClass) Validate() error {
+	switch p {
+	case "", PriorityClassLow, PriorityClassNormal, PriorityClassHigh:
+		return nil
+	default:
+		return errors.Errorf("unknown priority class %q", string(p))
+	}
+}
+
 // MasterMetaExt stores some attributes of job masters that do not need
 // to be indexed.
 type MasterMetaExt struct {
 	Selectors []*label.Selector `json:"selectors"`
+	// TenantID is the tenant which the job belongs to, it's used to check
+	// the tenant quota.
+	TenantID string `json:"tenant-id,omitempty"`
+	// Priority is the priority class of the job.
+	Priority PriorityClass `json:"priority,omitempty"`
 }
 
 // Value implements driver.Valuer.
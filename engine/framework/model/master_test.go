@@ -143,6 +143,19 @@ func TestMasterStateIsTerminated(t *testing.T) {
 	}
 }
 
+func TestPriorityClass(t *testing.T) {
+	t.Parallel()
+
+	require.Greater(t, PriorityClassHigh.Level(), PriorityClassNormal.Level())
+	require.Greater(t, PriorityClassNormal.Level(), PriorityClassLow.Level())
+	// an empty class is treated as normal.
+	require.Equal(t, PriorityClassNormal.Level(), PriorityClass("").Level())
+
+	require.NoError(t, PriorityClass("").Validate())
+	require.NoError(t, PriorityClassHigh.Validate())
+	require.Error(t, PriorityClass("urgent").Validate())
+}
+
 func TestOrmKeyValues(t *testing.T) {
 	t.Parallel()
 	meta := &MasterMeta{
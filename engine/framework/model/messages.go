@@ -61,4 +61,7 @@ type StatusChangeRequest struct {
 	FromMasterID MasterID            `json:"from-master-id"`
 	Epoch        Epoch               `json:"epoch"`
 	ExpectState  WorkerState         `json:"expect-state"`
+	// Pause means the worker should exit temporarily rather than being
+	// canceled, it's only meaningful when ExpectState is WorkerStateStopped.
+	Pause bool `json:"pause,omitempty"`
 }
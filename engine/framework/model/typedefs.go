@@ -16,6 +16,10 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/pingcap/tiflow/pkg/errors"
 )
@@ -58,6 +62,14 @@ const (
 	// extend the worker type here
 )
 
+// Define the range of the worker types of the user-defined jobs, see the
+// engine/sdk package. The worker type of the job master of a user-defined
+// job is the same number as the job type.
+const (
+	CustomWorkerTypeBase = WorkerType(1000)
+	CustomWorkerTypeMax  = WorkerType(math.MaxInt16)
+)
+
 var typesStringify = [...]string{
 	0:             "",
 	JobManager:    "JobManager",
@@ -83,14 +95,70 @@ func init() {
 	}
 }
 
+// customTypes maintains the names of the registered worker types of
+// the user-defined jobs.
+var customTypes = struct {
+	sync.RWMutex
+	toName       map[WorkerType]string
+	toWorkerType map[string]WorkerType
+}{
+	toName:       make(map[WorkerType]string),
+	toWorkerType: make(map[string]WorkerType),
+}
+
+// IsCustom returns whether the worker type belongs to a user-defined job.
+func (wt WorkerType) IsCustom() bool {
+	return wt >= CustomWorkerTypeBase && wt <= CustomWorkerTypeMax
+}
+
+// RegisterCustomWorkerType registers the name of a worker type of a
+// user-defined job.
+func RegisterCustomWorkerType(wt WorkerType, name string) error {
+	if !wt.IsCustom() {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(fmt.Sprintf(
+			"worker type %d is out of the range of user-defined worker types [%d, %d]",
+			wt, CustomWorkerTypeBase, CustomWorkerTypeMax))
+	}
+	if _, ok := toWorkerType[name]; ok || name == "" {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(
+			fmt.Sprintf("invalid name %q of worker type %d", name, wt))
+	}
+
+	customTypes.Lock()
+	defer customTypes.Unlock()
+	if _, ok := customTypes.toWorkerType[name]; ok {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(
+			fmt.Sprintf("worker type name %s is already registered", name))
+	}
+	if _, ok := customTypes.toName[wt]; ok {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(
+			fmt.Sprintf("worker type %d is already registered", wt))
+	}
+	customTypes.toName[wt] = name
+	customTypes.toWorkerType[name] = wt
+	return nil
+}
+
 // String implements fmt.Stringer interface
 func (wt WorkerType) String() string {
+	if wt.IsCustom() {
+		customTypes.RLock()
+		defer customTypes.RUnlock()
+		if name, ok := customTypes.toName[wt]; ok {
+			return name
+		}
+		// The worker types of the user-defined jobs are only registered
+		// on the executors which can run them.
+		return fmt.Sprintf("%s%d", customTypePrefix, wt)
+	}
 	if int(wt) >= len(typesStringify) || wt < 0 {
 		return fmt.Sprintf("Unknown WorkerType %d", wt)
 	}
 	return typesStringify[wt]
 }
 
+const customTypePrefix = "Custom"
+
 // MarshalJSON marshals the enum as a quoted json string
 func (wt WorkerType) MarshalJSON() ([]byte, error) {
 	return json.Marshal(wt.String())
@@ -106,8 +174,22 @@ func (wt *WorkerType) UnmarshalJSON(b []byte) error {
 		return err
 	}
 	*wt, ok = toWorkerType[j]
-	if !ok {
-		return errors.Errorf("Unknown WorkerType %s", j)
+	if ok {
+		return nil
 	}
-	return nil
+
+	customTypes.RLock()
+	*wt, ok = customTypes.toWorkerType[j]
+	customTypes.RUnlock()
+	if ok {
+		return nil
+	}
+	if s, found := strings.CutPrefix(j, customTypePrefix); found {
+		n, err := strconv.ParseInt(s, 10, 16)
+		if err == nil && WorkerType(n).IsCustom() {
+			*wt = WorkerType(n)
+			return nil
+		}
+	}
+	return errors.Errorf("Unknown WorkerType %s", j)
 }
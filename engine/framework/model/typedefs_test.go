@@ -35,11 +35,37 @@ func TestWorkerType(t *testing.T) {
 
 	wt := WorkerType(-1)
 	require.Equal(t, "Unknown WorkerType -1", wt.String())
-	wt = WorkerType(1000)
-	require.Equal(t, "Unknown WorkerType 1000", wt.String())
+	wt = WorkerType(100)
+	require.Equal(t, "Unknown WorkerType 100", wt.String())
 	bs, err := json.Marshal(wt)
 	require.NoError(t, err)
 	var wt2 WorkerType
-	require.EqualError(t, json.Unmarshal(bs, &wt2), "Unknown WorkerType Unknown WorkerType 1000")
+	require.EqualError(t, json.Unmarshal(bs, &wt2), "Unknown WorkerType Unknown WorkerType 100")
 	require.Equal(t, WorkerType(0), wt2)
 }
+
+func TestCustomWorkerType(t *testing.T) {
+	t.Parallel()
+
+	// an unregistered custom worker type can be marshaled and unmarshaled.
+	wt := CustomWorkerTypeBase
+	require.True(t, wt.IsCustom())
+	require.Equal(t, "Custom1000", wt.String())
+	bs, err := json.Marshal(wt)
+	require.NoError(t, err)
+	var wt2 WorkerType
+	require.NoError(t, json.Unmarshal(bs, &wt2))
+	require.Equal(t, wt, wt2)
+
+	wt = CustomWorkerTypeBase + 1
+	require.Error(t, RegisterCustomWorkerType(DMJobMaster, "MyJobMaster"))
+	require.Error(t, RegisterCustomWorkerType(wt, "DMJobMaster"))
+	require.NoError(t, RegisterCustomWorkerType(wt, "WordCountJobMaster"))
+	require.Error(t, RegisterCustomWorkerType(wt, "WordCountJobMaster2"))
+	require.Error(t, RegisterCustomWorkerType(wt+1, "WordCountJobMaster"))
+	require.Equal(t, "WordCountJobMaster", wt.String())
+	bs, err = json.Marshal(wt)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bs, &wt2))
+	require.Equal(t, wt, wt2)
+}
@@ -38,6 +38,9 @@ const (
 	WorkerStateError    = WorkerState(4)
 	WorkerStateFinished = WorkerState(5)
 	WorkerStateStopped  = WorkerState(6)
+	// WorkerStatePaused means the worker exits temporarily and it can be
+	// recovered from its metadata and checkpoints later.
+	WorkerStatePaused = WorkerState(7)
 	// extend the status code here
 )
 
@@ -65,7 +68,7 @@ type WorkerStatus struct {
 	JobID     MasterID         `json:"job-id" gorm:"column:job_id;type:varchar(128) not null;uniqueIndex:uidx_wid,priority:1;index:idx_wst,priority:1"`
 	ID        WorkerID         `json:"id" gorm:"column:id;type:varchar(128) not null;uniqueIndex:uidx_wid,priority:2"`
 	Type      WorkerType       `json:"type" gorm:"column:type;type:smallint not null;comment:JobManager(1),CvsJobMaster(2),FakeJobMaster(3),DMJobMaster(4),CDCJobMaster(5),CvsTask(6),FakeTask(7),DMTask(8),CDCTask(9),WorkerDMDump(10),WorkerDMLoad(11),WorkerDMSync(12)"`
-	State     WorkerState      `json:"state" gorm:"column:state;type:tinyint not null;index:idx_wst,priority:2;comment:Normal(1),Created(2),Init(3),Error(4),Finished(5),Stopped(6)"`
+	State     WorkerState      `json:"state" gorm:"column:state;type:tinyint not null;index:idx_wst,priority:2;comment:Normal(1),Created(2),Init(3),Error(4),Finished(5),Stopped(6),Paused(7)"`
 	Epoch     Epoch            `json:"epoch" gorm:"column:epoch;type:bigint not null"`
 	ErrorMsg  string           `json:"error-message" gorm:"column:error_message;type:text"`
 
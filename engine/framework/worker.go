@@ -522,6 +522,11 @@ func (w *DefaultBaseWorker) Exit(ctx context.Context, exitReason ExitReason, err
 		// keep the original error or ErrWorkerFinish in error center
 		if err == nil {
 			err = errors.ErrWorkerFinish.FastGenByArgs()
+			if exitReason == ExitReasonPaused {
+				// the paused worker is closed rather than stopped, so that
+				// it can be recovered later.
+				err = errors.ErrWorkerPaused.FastGenByArgs()
+			}
 		}
 		w.onError(err)
 	}()
@@ -535,6 +540,8 @@ func (w *DefaultBaseWorker) Exit(ctx context.Context, exitReason ExitReason, err
 	case ExitReasonFailed:
 		// TODO: replace error with failed
 		w.workerStatus.State = frameModel.WorkerStateError
+	case ExitReasonPaused:
+		w.workerStatus.State = frameModel.WorkerStatePaused
 	default:
 		w.workerStatus.State = frameModel.WorkerStateError
 	}
@@ -356,6 +356,21 @@ func (jm *JobMaster) OnCancel(ctx context.Context) error {
 	return jm.cancelWorkers()
 }
 
+// OnPause implements JobMasterImpl.OnPause
+func (jm *JobMaster) OnPause(ctx context.Context) error {
+	log.Info("cvs jobmaster: OnPause")
+	statsBytes, err := json.Marshal(jm.jobStatus)
+	if err != nil {
+		return err
+	}
+	// the workers are recovered from the job status when the job is resumed.
+	if _, err := jm.MetaKVClient().Put(ctx, jm.workerID, string(statsBytes)); err != nil {
+		return err
+	}
+	status := jm.Status()
+	return jm.BaseJobMaster.Exit(ctx, framework.ExitReasonPaused, nil, status.ExtBytes)
+}
+
 func (jm *JobMaster) cancelWorkers() error {
 	jm.setState(frameModel.WorkerStateStopped)
 	for _, worker := range jm.syncFilesInfo {
@@ -331,6 +331,21 @@ func (jm *JobMaster) OnCancel(ctx context.Context) error {
 	return jm.cancel(ctx, frameModel.WorkerStateStopped)
 }
 
+// OnPause implements JobMasterImpl.OnPause
+// Unlike OnCancel, the tasks and the checkpoints are kept, and the job master
+// is recovered from them when the job is resumed, like a failover.
+func (jm *JobMaster) OnPause(ctx context.Context) error {
+	jm.Logger().Info("on pause job master")
+	var detail []byte
+	status, err := jm.status(ctx, frameModel.WorkerStateStopped)
+	if err != nil {
+		jm.Logger().Error("failed to get status", zap.Error(err))
+	} else {
+		detail = status.ExtBytes
+	}
+	return jm.Exit(ctx, framework.ExitReasonPaused, nil, detail)
+}
+
 // StopImpl implements JobMasterImpl.StopImpl
 // checkpoint is removed when job is stopped, this is different with OP DM where
 // `--remove-meta` is specified at start-task.
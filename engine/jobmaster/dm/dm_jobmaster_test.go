@@ -377,6 +377,14 @@ func (t *testDMJobmasterSuite) TestDMJobmaster() {
 	// Close
 	jm.CloseImpl(context.Background())
 
+	// OnPause keeps the job, it's recovered when the job is resumed.
+	mockMessageAgent.On("SendRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&dmpkg.QueryStatusResponse{Unit: frameModel.WorkerDMSync, Stage: metadata.StageRunning, Status: bytes1}, nil).Twice()
+	mockBaseJobmaster.On("Exit").Return(nil).Once()
+	require.NoError(t.T(), jm.OnPause(context.Background()))
+	state, err := jm.metadata.JobStore().Get(context.Background())
+	require.NoError(t.T(), err)
+	require.False(t.T(), state.(*metadata.Job).Deleting)
+
 	// OnCancel
 	mockMessageAgent.On("SendRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&dmpkg.QueryStatusResponse{Unit: frameModel.WorkerDMSync, Stage: metadata.StageRunning, Status: bytes1}, nil).Twice()
 	mockMessageAgent.On("SendMessage").Return(nil).Twice()
@@ -389,7 +397,7 @@ func (t *testDMJobmasterSuite) TestDMJobmaster() {
 	}()
 	require.Eventually(t.T(), func() bool {
 		mockMessageAgent.Lock()
-		if len(mockMessageAgent.Calls) == 4 {
+		if len(mockMessageAgent.Calls) == 6 {
 			mockMessageAgent.Unlock()
 			return true
 		}
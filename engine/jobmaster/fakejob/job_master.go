@@ -128,6 +128,16 @@ func (m *Master) OnCancel(ctx context.Context) error {
 	return nil
 }
 
+// OnPause implements JobMasterImpl.OnPause
+func (m *Master) OnPause(ctx context.Context) error {
+	log.Info("FakeMaster: OnPause")
+	// the workers are recovered from the checkpoint when the job is resumed.
+	if _, err := m.MetaKVClient().Put(ctx, CheckpointKey(m.workerID), m.genCheckpoint()); err != nil {
+		return err
+	}
+	return m.Exit(ctx, framework.ExitReasonPaused, nil, []byte("fake master is paused"))
+}
+
 func (m *Master) cancelWorkers(ctx context.Context) error {
 	m.workerListMu.Lock()
 	defer m.workerListMu.Unlock()
@@ -13,6 +13,14 @@
 
 package model
 
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
 type (
 	// JobID is the unique identifier of a job
 	JobID = string
@@ -31,6 +39,14 @@ const (
 	JobTypeFakeJob
 )
 
+// Define the range of the user-defined job types. A user-defined job type
+// is identified by its number, which is persisted in the metastore, so it
+// must not be changed once there are jobs of the type.
+const (
+	JobTypeCustomBase = JobType(1000)
+	JobTypeCustomMax  = JobType(math.MaxInt16)
+)
+
 // Define job type name
 const (
 	JobTypeNameInvalid    = "Invalid"
@@ -49,16 +65,75 @@ var jobTypeNameToType = map[string]JobType{
 	JobTypeNameFakeJob: JobTypeFakeJob,
 }
 
+// customJobTypes maintains the names of the registered user-defined job types.
+var customJobTypes = struct {
+	sync.RWMutex
+	nameToType map[string]JobType
+	typeToName map[JobType]string
+}{
+	nameToType: make(map[string]JobType),
+	typeToName: make(map[JobType]string),
+}
+
+// RegisterCustomJobType registers the name of a user-defined job type.
+func RegisterCustomJobType(tp JobType, name string) error {
+	if !tp.IsCustom() {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(fmt.Sprintf(
+			"job type %d is out of the range of user-defined job types [%d, %d]",
+			tp, JobTypeCustomBase, JobTypeCustomMax))
+	}
+	if name == "" {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(fmt.Sprintf("name of job type %d is empty", tp))
+	}
+	if _, exists := jobTypeNameToType[name]; exists {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(fmt.Sprintf("job type name %s is reserved", name))
+	}
+
+	customJobTypes.Lock()
+	defer customJobTypes.Unlock()
+	if _, exists := customJobTypes.nameToType[name]; exists {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(
+			fmt.Sprintf("job type name %s is already registered", name))
+	}
+	if _, exists := customJobTypes.typeToName[tp]; exists {
+		return errors.ErrInvalidArgument.GenWithStackByArgs(fmt.Sprintf("job type %d is already registered", tp))
+	}
+	customJobTypes.nameToType[name] = tp
+	customJobTypes.typeToName[tp] = name
+	return nil
+}
+
 // GetJobTypeByName get JobType by readable job name
 func GetJobTypeByName(name string) (JobType, bool) {
 	tp, exists := jobTypeNameToType[name]
-	if !exists {
-		return JobTypeInvalid, false
+	if exists {
+		return tp, true
+	}
+
+	customJobTypes.RLock()
+	defer customJobTypes.RUnlock()
+	if tp, exists := customJobTypes.nameToType[name]; exists {
+		return tp, true
 	}
+	return JobTypeInvalid, false
+}
+
+// IsCustom returns whether the job type is a user-defined job type.
+func (j JobType) IsCustom() bool {
+	return j >= JobTypeCustomBase && j <= JobTypeCustomMax
+}
 
-	return tp, true
+// CustomJobTypeLabelKey returns the key of the executor label, which
+// indicates that the executor can run the jobs of a user-defined job type.
+// The value of the label is CustomJobTypeLabelValue.
+func CustomJobTypeLabelKey(tp JobType) string {
+	return fmt.Sprintf("job-type-%d", tp)
 }
 
+// CustomJobTypeLabelValue is the value of the executor label whose key is
+// returned by CustomJobTypeLabelKey.
+const CustomJobTypeLabelValue = "enabled"
+
 func (j JobType) String() string {
 	switch j {
 	case JobTypeCVSDemo:
@@ -73,5 +148,15 @@ func (j JobType) String() string {
 		return JobTypeNameJobManager
 	}
 
+	if j.IsCustom() {
+		customJobTypes.RLock()
+		defer customJobTypes.RUnlock()
+		if name, exists := customJobTypes.typeToName[j]; exists {
+			return name
+		}
+		// The user-defined job types are not registered on the server master.
+		return fmt.Sprintf("Custom%d", int32(j))
+	}
+
 	return JobTypeNameInvalid
 }
@@ -39,3 +39,25 @@ func TestJobTypeStringer(t *testing.T) {
 	tp = JobType(100)
 	require.Equal(t, JobTypeNameInvalid, tp.String())
 }
+
+func TestCustomJobType(t *testing.T) {
+	t.Parallel()
+
+	tp := JobTypeCustomBase + 1
+	require.True(t, tp.IsCustom())
+	require.False(t, JobTypeDM.IsCustom())
+	require.Equal(t, "Custom1001", tp.String())
+	require.Equal(t, "job-type-1001", CustomJobTypeLabelKey(tp))
+
+	require.Error(t, RegisterCustomJobType(JobTypeDM, "MyDM"))
+	require.Error(t, RegisterCustomJobType(tp, ""))
+	require.Error(t, RegisterCustomJobType(tp, JobTypeNameDM))
+	require.NoError(t, RegisterCustomJobType(tp, "WordCount"))
+	require.Error(t, RegisterCustomJobType(tp, "WordCount2"))
+	require.Error(t, RegisterCustomJobType(tp+1, "WordCount"))
+
+	require.Equal(t, "WordCount", tp.String())
+	got, ok := GetJobTypeByName("WordCount")
+	require.True(t, ok)
+	require.Equal(t, tp, got)
+}
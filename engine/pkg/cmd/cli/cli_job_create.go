@@ -18,9 +18,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/tiflow/engine/enginepb"
+	engineModel "github.com/pingcap/tiflow/engine/model"
 	cmdcontext "github.com/pingcap/tiflow/pkg/cmd/context"
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/spf13/cobra"
@@ -50,7 +52,7 @@ func (o *jobCreateOptions) addFlags(cmd *cobra.Command) {
 		return
 	}
 
-	cmd.Flags().Var(newJobTypeValue(enginepb.Job_TypeUnknown, &o.jobType), "job-type", "job type, one of [FakeJob, CVSDemo, DM]")
+	cmd.Flags().Var(newJobTypeValue(enginepb.Job_TypeUnknown, &o.jobType), "job-type", "job type, one of [FakeJob, CVSDemo, DM] or the number of a user-defined job type")
 	cmd.Flags().StringVar(&o.jobConfigStr, "job-config", "", "path of config file for the job")
 	cmd.Flags().StringVar(&o.jobID, "job-id", "", "job id")
 
@@ -80,7 +82,13 @@ func (v *jobTypeValue) Set(val string) error {
 	case "DM":
 		*v = jobTypeValue(enginepb.Job_DM)
 	default:
-		return fmt.Errorf("job type must be one of [FakeJob, CVSDemo, DM]")
+		// A user-defined job type is specified by its number.
+		n, err := strconv.ParseInt(val, 10, 32)
+		if err != nil || !engineModel.JobType(n).IsCustom() {
+			return fmt.Errorf("job type must be one of [FakeJob, CVSDemo, DM] "+
+				"or a number in [%d, %d]", engineModel.JobTypeCustomBase, engineModel.JobTypeCustomMax)
+		}
+		*v = jobTypeValue(n)
 	}
 	return nil
 }
@@ -240,6 +240,30 @@ func (m *FileManager) RemoveResource(
 	return nil
 }
 
+// ResourceSizes returns the total size of the files of each resource
+// in the scope, the scope is walked only once. It can be called on any node.
+func (m *FileManager) ResourceSizes(
+	ctx context.Context, scope internal.ResourceScope,
+) (map[resModel.ResourceName]int64, error) {
+	storage, err := m.storageCreator.newBucketForScope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make(map[resModel.ResourceName]int64)
+	err = storage.WalkDir(ctx, &storeapi.WalkOption{}, func(path string, fileSize int64) error {
+		resName, _, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+		if ok {
+			sizes[resName] += fileSize
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.ErrExternalStorageAPI.Wrap(err).GenWithStackByArgs("ResourceSizes")
+	}
+	return sizes, nil
+}
+
 // SetPersisted marks a resource as persisted. It can only be called
 // on the creator of the resource.
 func (m *FileManager) SetPersisted(
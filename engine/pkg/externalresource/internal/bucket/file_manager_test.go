@@ -57,6 +57,46 @@ func TestFileManagerCreateAndRemoveResource(t *testing.T) {
 		UtBucketName, MockExecutorID, "worker-1", "resource-1", "file-1"))
 }
 
+func TestFileManagerResourceSizes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fm, _ := NewFileManagerForUT(t.TempDir(), MockExecutorID)
+
+	newIdent := func(name string) internal.ResourceIdent {
+		return internal.ResourceIdent{
+			ResourceScope: internal.ResourceScope{
+				Executor: MockExecutorID,
+				WorkerID: "worker-1",
+			},
+			Name: name,
+		}
+	}
+	for name, content := range map[string]string{
+		"resource-1":  "dummydummy",
+		"resource-10": "dummy",
+	} {
+		desc, err := fm.CreateResource(ctx, newIdent(name))
+		require.NoError(t, err)
+		storage, err := desc.ExternalStorage(ctx)
+		require.NoError(t, err)
+		require.NoError(t, storage.WriteFile(ctx, "file-1", []byte(content)))
+	}
+
+	sizes, err := fm.ResourceSizes(ctx, newIdent("resource-1").Scope())
+	require.NoError(t, err)
+	require.Equal(t, int64(10), sizes["resource-1"])
+	require.Equal(t, int64(5), sizes["resource-10"])
+	require.NotContains(t, sizes, "resource-2")
+
+	sizes, err = fm.ResourceSizes(ctx, internal.ResourceScope{
+		Executor: MockExecutorID,
+		WorkerID: "worker-2",
+	})
+	require.NoError(t, err)
+	require.Empty(t, sizes)
+}
+
 func TestFileManagerCreateDuplicate(t *testing.T) {
 	t.Parallel()
 
@@ -26,7 +26,10 @@ import (
 
 const defaultControllerID = "leader-controller"
 
-var _ internal.ResourceController = &resourceController{}
+var (
+	_ internal.ResourceController = &resourceController{}
+	_ internal.ResourceInspector  = &resourceController{}
+)
 
 // resourceController defines operations specific to the s3 file type.
 type resourceController struct {
@@ -43,21 +46,36 @@ func NewResourceController(config *resModel.Config) *resourceController {
 
 // GCSingleResource returns a closure to the invoker to perform GC.
 func (r *resourceController) GCSingleResource(ctx context.Context, res *resModel.ResourceMeta) error {
-	tp, resName, err := resModel.ParseResourceID(res.ID)
+	ident, err := resourceIdent(res)
 	if err != nil {
 		return err
 	}
+	return r.fm.RemoveResource(ctx, ident)
+}
+
+// ResourceSizes returns the total size of the files of each resource in the scope.
+func (r *resourceController) ResourceSizes(
+	ctx context.Context, scope internal.ResourceScope,
+) (map[resModel.ResourceName]int64, error) {
+	return r.fm.ResourceSizes(ctx, scope)
+}
+
+func resourceIdent(res *resModel.ResourceMeta) (internal.ResourceIdent, error) {
+	tp, resName, err := resModel.ParseResourceID(res.ID)
+	if err != nil {
+		return internal.ResourceIdent{}, err
+	}
 	if tp != resModel.ResourceTypeS3 && tp != resModel.ResourceTypeGCS {
 		log.Panic("unexpected resource type", zap.Any("resource", res))
 	}
 
-	return r.fm.RemoveResource(ctx, internal.ResourceIdent{
+	return internal.ResourceIdent{
 		Name: resName,
 		ResourceScope: internal.ResourceScope{
 			Executor: res.Executor,
 			WorkerID: res.Worker,
 		},
-	})
+	}, nil
 }
 
 // GCExecutor cleanups all temporary resources created by the executor.
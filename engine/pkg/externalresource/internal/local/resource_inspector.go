@@ -0,0 +1,103 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+var _ internal.ResourceInspector = &resourceInspector{}
+
+// resourceInspector inspects the local file resources through a file
+// system shared with the executors, e.g. a network file system mounted
+// at the same base directory on all nodes.
+type resourceInspector struct {
+	baseDir string
+}
+
+// NewFileResourceInspector creates a ResourceInspector for local file
+// resources, baseDir is the base directory configured for the executors,
+// without the executor ID.
+func NewFileResourceInspector(baseDir string) *resourceInspector {
+	return &resourceInspector{baseDir: baseDir}
+}
+
+// ResourceSizes returns the total size of the files of each local file
+// resource created by a worker, the directory of the worker is walked only
+// once. An empty result is returned if the directory is not visible on the
+// local file system.
+func (r *resourceInspector) ResourceSizes(
+	_ context.Context, scope internal.ResourceScope,
+) (map[resModel.ResourceName]int64, error) {
+	config := resModel.LocalFileConfig{BaseDir: r.baseDir}
+	config.Adjust(scope.Executor)
+	workerDir := filepath.Join(config.BaseDir, scope.WorkerID)
+
+	sizes := make(map[resModel.ResourceName]int64)
+	if _, err := os.Stat(workerDir); err != nil {
+		if os.IsNotExist(err) {
+			return sizes, nil
+		}
+		return nil, errors.ErrReadLocalFileDirectoryFailed.Wrap(err)
+	}
+	err := filepath.WalkDir(workerDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(workerDir, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+		dirName, _, nested := strings.Cut(filepath.ToSlash(relPath), "/")
+		resName, err := filePathNameToResourceName(dirName)
+		if err != nil {
+			// Not a resource directory.
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !nested {
+			// The resource exists even if it has no file.
+			if d.IsDir() {
+				sizes[resName] = 0
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		sizes[resName] += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.ErrReadLocalFileDirectoryFailed.Wrap(err)
+	}
+	return sizes, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceInspectorSizes(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	config := resModel.LocalFileConfig{BaseDir: dir}
+	config.Adjust("executor-1")
+	fm := NewLocalFileManager("executor-1", config)
+
+	ctx := context.Background()
+	resID := "/local/resource-1"
+	_, resName, err := resModel.ParseResourceID(resID)
+	require.NoError(t, err)
+	desc, err := fm.CreateResource(ctx, newResourceIdentForTesting("executor-1", "worker-1", resName))
+	require.NoError(t, err)
+	storage, err := desc.ExternalStorage(ctx)
+	require.NoError(t, err)
+	require.NoError(t, storage.WriteFile(ctx, "1.txt", []byte("hello")))
+	require.NoError(t, storage.WriteFile(ctx, "dir/2.txt", []byte("world!")))
+
+	_, err = fm.CreateResource(ctx, newResourceIdentForTesting("executor-1", "worker-1", "resource-2"))
+	require.NoError(t, err)
+
+	inspector := NewFileResourceInspector(dir)
+	sizes, err := inspector.ResourceSizes(ctx, internal.ResourceScope{
+		Executor: model.ExecutorID("executor-1"),
+		WorkerID: "worker-1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[resModel.ResourceName]int64{
+		resName:      11,
+		"resource-2": 0,
+	}, sizes)
+
+	sizes, err = inspector.ResourceSizes(ctx, internal.ResourceScope{
+		Executor: model.ExecutorID("executor-1"),
+		WorkerID: "worker-2",
+	})
+	require.NoError(t, err)
+	require.Empty(t, sizes)
+}
@@ -28,3 +28,12 @@ type ResourceController interface {
 	// GCExecutor removes all temporary resources created by the offlined executors.
 	GCExecutor(context.Context, []*resModel.ResourceMeta, model.ExecutorID) error
 }
+
+// ResourceInspector is an interface to inspect the files of persisted
+// resources of one resource type.
+type ResourceInspector interface {
+	// ResourceSizes lists the files of a scope once and returns the total
+	// size in bytes of the files of each resource in it, keyed by the
+	// resource name. The resources without any file are absent.
+	ResourceSizes(context.Context, ResourceScope) (map[resModel.ResourceName]int64, error)
+}
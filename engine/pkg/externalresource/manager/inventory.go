@@ -0,0 +1,276 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal/bucket"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal/local"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/model"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const inspectResourceTimeout = 10 * time.Second
+
+// ResourceInfo describes a persisted resource in the inventory.
+type ResourceInfo struct {
+	ID        resModel.ResourceID `json:"id"`
+	Job       resModel.JobID      `json:"job"`
+	Worker    resModel.WorkerID   `json:"worker"`
+	Executor  resModel.ExecutorID `json:"executor"`
+	GCPending bool                `json:"gc-pending"`
+	// Size is the total size in bytes of the files of the resource,
+	// it's -1 if the size is unknown.
+	Size int64 `json:"size"`
+	// AgeSeconds is the time since the resource was created.
+	AgeSeconds int64 `json:"age-seconds"`
+	// Orphaned means the job which owns the resource no longer exists.
+	Orphaned bool `json:"orphaned"`
+}
+
+// ResourceFilter filters the resources in the inventory, an empty
+// field matches all resources.
+type ResourceFilter struct {
+	JobID        resModel.JobID
+	WorkerID     resModel.WorkerID
+	OrphanedOnly bool
+}
+
+// Inventory lists the persisted resources together with their sizes
+// and finds the orphaned resources, i.e. the resources whose job no
+// longer exists.
+//
+// The sizes of the local file resources are only known if the base
+// directory of the local file storage is shared between the server
+// masters and the executors.
+type Inventory struct {
+	metaClient pkgOrm.Client
+	inspectors map[resModel.ResourceType]internal.ResourceInspector
+	clock      clock.Clock
+}
+
+// NewInventory creates a new Inventory.
+func NewInventory(metaClient pkgOrm.Client, config *resModel.Config) *Inventory {
+	inv := &Inventory{
+		metaClient: metaClient,
+		inspectors: map[resModel.ResourceType]internal.ResourceInspector{},
+		clock:      clock.New(),
+	}
+	if config == nil {
+		return inv
+	}
+	if config.LocalEnabled() {
+		inv.inspectors[resModel.ResourceTypeLocalFile] = local.NewFileResourceInspector(config.Local.BaseDir)
+	}
+	if config.S3Enabled() {
+		inv.inspectors[resModel.ResourceTypeS3] = bucket.NewResourceController(config)
+	}
+	if config.GCSEnabled() {
+		inv.inspectors[resModel.ResourceTypeGCS] = bucket.NewResourceController(config)
+	}
+	return inv
+}
+
+// ListResources lists the resources matching the filter, ordered by
+// job, worker and resource ID.
+func (inv *Inventory) ListResources(
+	ctx context.Context, filter ResourceFilter,
+) ([]*ResourceInfo, error) {
+	var (
+		resources []*resModel.ResourceMeta
+		err       error
+	)
+	if filter.JobID != "" {
+		resources, err = inv.metaClient.QueryResourcesByJobID(ctx, filter.JobID)
+	} else {
+		resources, err = inv.metaClient.QueryResources(ctx)
+	}
+	if err != nil {
+		return nil, errors.ErrResourceMetastoreError.Wrap(err).GenWithStackByArgs()
+	}
+
+	jobs, err := inv.metaClient.QueryJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobSet := make(map[resModel.JobID]struct{}, len(jobs))
+	for _, job := range jobs {
+		jobSet[job.ID] = struct{}{}
+	}
+
+	now := inv.clock.Now()
+	sizes := make(scopeSizes)
+	infos := make([]*ResourceInfo, 0, len(resources))
+	for _, res := range resources {
+		if filter.WorkerID != "" && res.Worker != filter.WorkerID {
+			continue
+		}
+		tp, resName, err := resModel.ParseResourceID(res.ID)
+		if err != nil {
+			log.Warn("invalid resource id in metastore", zap.Any("resource", res), zap.Error(err))
+			continue
+		}
+		// The dummy bucket resources are owned by the executors rather than jobs.
+		if isDummyBucketResource(tp, resName) {
+			continue
+		}
+		_, jobExists := jobSet[res.Job]
+		if filter.OrphanedOnly && jobExists {
+			continue
+		}
+
+		infos = append(infos, &ResourceInfo{
+			ID:         res.ID,
+			Job:        res.Job,
+			Worker:     res.Worker,
+			Executor:   res.Executor,
+			GCPending:  res.GCPending,
+			Size:       inv.resourceSize(ctx, sizes, tp, resName, res),
+			AgeSeconds: int64(now.Sub(res.CreatedAt) / time.Second),
+			Orphaned:   !jobExists,
+		})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Job != infos[j].Job {
+			return infos[i].Job < infos[j].Job
+		}
+		if infos[i].Worker != infos[j].Worker {
+			return infos[i].Worker < infos[j].Worker
+		}
+		return infos[i].ID < infos[j].ID
+	})
+	return infos, nil
+}
+
+// TenantStorageUsage returns the total size in bytes of the resources of
+// each tenant. The resources whose sizes are unknown are not counted.
+func (inv *Inventory) TenantStorageUsage(ctx context.Context) (map[string]int64, error) {
+	jobs, err := inv.metaClient.QueryJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenants := make(map[resModel.JobID]string, len(jobs))
+	for _, job := range jobs {
+		if job.Ext.TenantID != "" {
+			tenants[job.ID] = job.Ext.TenantID
+		}
+	}
+	if len(tenants) == 0 {
+		return map[string]int64{}, nil
+	}
+
+	resources, err := inv.ListResources(ctx, ResourceFilter{})
+	if err != nil {
+		return nil, err
+	}
+	usage := make(map[string]int64)
+	for _, res := range resources {
+		tenantID, ok := tenants[res.Job]
+		if !ok || res.Size < 0 {
+			continue
+		}
+		usage[tenantID] += res.Size
+	}
+	return usage, nil
+}
+
+// scopeKey identifies the files of a resource type in a resource scope.
+type scopeKey struct {
+	tp    resModel.ResourceType
+	scope internal.ResourceScope
+}
+
+// scopeSizes caches the resource sizes of the listed scopes, a nil
+// entry means the scope failed to be listed.
+type scopeSizes map[scopeKey]map[resModel.ResourceName]int64
+
+// resourceSize returns the size of a resource, the scope of the resource
+// is listed at most once per call of ListResources.
+func (inv *Inventory) resourceSize(
+	ctx context.Context, sizes scopeSizes,
+	tp resModel.ResourceType, resName resModel.ResourceName, res *resModel.ResourceMeta,
+) int64 {
+	inspector, ok := inv.inspectors[tp]
+	if !ok {
+		return -1
+	}
+
+	key := scopeKey{
+		tp:    tp,
+		scope: internal.ResourceScope{Executor: res.Executor, WorkerID: res.Worker},
+	}
+	resSizes, listed := sizes[key]
+	if !listed {
+		var err error
+		resSizes, err = inv.listScope(ctx, inspector, key.scope)
+		if err != nil {
+			log.Warn("failed to get the sizes of resources",
+				zap.Any("scope", key.scope), zap.String("job-id", res.Job), zap.Error(err))
+		}
+		sizes[key] = resSizes
+	}
+	size, ok := resSizes[resName]
+	if !ok {
+		return -1
+	}
+	return size
+}
+
+func (inv *Inventory) listScope(
+	ctx context.Context, inspector internal.ResourceInspector, scope internal.ResourceScope,
+) (map[resModel.ResourceName]int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, inspectResourceTimeout)
+	defer cancel()
+	return inspector.ResourceSizes(ctx, scope)
+}
+
+// PurgeOrphanedResources marks the orphaned resources as gc_pending,
+// so that they are removed by the GC runner of the leader. Only the
+// orphaned resources of jobID are purged if it's not empty.
+// The purged resources are returned.
+func (inv *Inventory) PurgeOrphanedResources(
+	ctx context.Context, jobID resModel.JobID,
+) ([]*ResourceInfo, error) {
+	orphans, err := inv.ListResources(ctx, ResourceFilter{JobID: jobID, OrphanedOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(orphans) == 0 {
+		return orphans, nil
+	}
+
+	jobSet := make(map[resModel.JobID]struct{})
+	jobIDs := make([]resModel.JobID, 0)
+	for _, res := range orphans {
+		if _, ok := jobSet[res.Job]; !ok {
+			jobSet[res.Job] = struct{}{}
+			jobIDs = append(jobIDs, res.Job)
+		}
+		res.GCPending = true
+	}
+	if err := inv.metaClient.SetGCPendingByJobs(ctx, jobIDs...); err != nil {
+		return nil, errors.ErrResourceMetastoreError.Wrap(err).GenWithStackByArgs()
+	}
+	log.Info("orphaned resources are purged", zap.Strings("job-ids", jobIDs), zap.Int("count", len(orphans)))
+	return orphans, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal"
+	"github.com/pingcap/tiflow/engine/pkg/externalresource/internal/local"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/model"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventory(t *testing.T) {
+	t.Parallel()
+
+	meta, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer meta.Close()
+
+	baseDir := t.TempDir()
+	inv := NewInventory(meta, &resModel.Config{Local: resModel.LocalFileConfig{BaseDir: baseDir}})
+	clk := clock.NewMock()
+	clk.Set(time.Now().Add(time.Hour))
+	inv.clock = clk
+
+	ctx := context.Background()
+	require.NoError(t, meta.UpsertJob(ctx, &frameModel.MasterMeta{
+		ID: "job-1", Ext: frameModel.MasterMetaExt{TenantID: "tenant-1"},
+	}))
+	for _, res := range []*resModel.ResourceMeta{
+		{ID: "/local/resource-1", Job: "job-1", Worker: "worker-1", Executor: "executor-1"},
+		{ID: "/local/resource-2", Job: "job-1", Worker: "worker-2", Executor: "executor-1"},
+		{ID: "/local/resource-3", Job: "job-2", Worker: "worker-3", Executor: "executor-2"},
+		{ID: "/s3/dummy", Job: "dummy-job-executor-1", Worker: "dummy-worker", Executor: "executor-1"},
+	} {
+		require.NoError(t, meta.CreateResource(ctx, res))
+	}
+
+	// Only the files of resource-1 and resource-3 are visible.
+	writeResourceFile := func(executor, worker, resID string, content string) {
+		_, resName, err := resModel.ParseResourceID(resID)
+		require.NoError(t, err)
+		dir := filepath.Join(baseDir, executor, worker, local.ResourceNameToFilePathName(resName))
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "1.txt"), []byte(content), 0o644))
+	}
+	writeResourceFile("executor-1", "worker-1", "/local/resource-1", "hello")
+	writeResourceFile("executor-2", "worker-3", "/local/resource-3", "world!")
+
+	infos, err := inv.ListResources(ctx, ResourceFilter{})
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+	require.Equal(t, "/local/resource-1", infos[0].ID)
+	require.Equal(t, int64(5), infos[0].Size)
+	require.False(t, infos[0].Orphaned)
+	require.InDelta(t, int64(3600), infos[0].AgeSeconds, 60)
+	require.Equal(t, "/local/resource-2", infos[1].ID)
+	require.Equal(t, int64(-1), infos[1].Size)
+	require.Equal(t, "/local/resource-3", infos[2].ID)
+	require.Equal(t, int64(6), infos[2].Size)
+	require.True(t, infos[2].Orphaned)
+
+	infos, err = inv.ListResources(ctx, ResourceFilter{JobID: "job-1", WorkerID: "worker-2"})
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "/local/resource-2", infos[0].ID)
+
+	infos, err = inv.ListResources(ctx, ResourceFilter{OrphanedOnly: true})
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "/local/resource-3", infos[0].ID)
+
+	// The orphaned resources and the unknown sizes are not counted.
+	usage, err := inv.TenantStorageUsage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{"tenant-1": 5}, usage)
+
+	// Purging the resources of an existing job is a no-op.
+	purged, err := inv.PurgeOrphanedResources(ctx, "job-1")
+	require.NoError(t, err)
+	require.Empty(t, purged)
+
+	purged, err = inv.PurgeOrphanedResources(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+	require.Equal(t, "/local/resource-3", purged[0].ID)
+	require.True(t, purged[0].GCPending)
+
+	res, err := meta.GetOneResourceForGC(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "/local/resource-3", res.ID)
+	require.Equal(t, "job-2", res.Job)
+}
+
+type countingInspector struct {
+	sizes map[resModel.WorkerID]map[resModel.ResourceName]int64
+	calls int
+}
+
+func (c *countingInspector) ResourceSizes(
+	_ context.Context, scope internal.ResourceScope,
+) (map[resModel.ResourceName]int64, error) {
+	c.calls++
+	return c.sizes[scope.WorkerID], nil
+}
+
+func TestInventoryListsScopeOnce(t *testing.T) {
+	t.Parallel()
+
+	meta, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer meta.Close()
+
+	inspector := &countingInspector{
+		sizes: map[resModel.WorkerID]map[resModel.ResourceName]int64{
+			"worker-1": {"resource-1": 1, "resource-2": 2},
+			"worker-2": {"resource-3": 3},
+		},
+	}
+	inv := NewInventory(meta, nil)
+	inv.inspectors[resModel.ResourceTypeS3] = inspector
+
+	ctx := context.Background()
+	for _, res := range []*resModel.ResourceMeta{
+		{ID: "/s3/resource-1", Job: "job-1", Worker: "worker-1", Executor: "executor-1"},
+		{ID: "/s3/resource-2", Job: "job-1", Worker: "worker-1", Executor: "executor-1"},
+		{ID: "/s3/resource-3", Job: "job-1", Worker: "worker-2", Executor: "executor-1"},
+		{ID: "/s3/resource-4", Job: "job-1", Worker: "worker-2", Executor: "executor-1"},
+	} {
+		require.NoError(t, meta.CreateResource(ctx, res))
+	}
+
+	infos, err := inv.ListResources(ctx, ResourceFilter{})
+	require.NoError(t, err)
+	require.Len(t, infos, 4)
+	for i, size := range []int64{1, 2, 3, -1} {
+		require.Equal(t, size, infos[i].Size)
+	}
+	require.Equal(t, 2, inspector.calls)
+}
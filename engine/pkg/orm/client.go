@@ -37,6 +37,8 @@ var globalModels = []interface{}{
 	&model.LogicEpoch{},
 	&model.JobOp{},
 	&model.Executor{},
+	&model.TenantQuota{},
+	&model.JobEvent{},
 }
 
 // TODO: retry and idempotent??
@@ -74,6 +76,10 @@ type Client interface {
 	JobOpClient
 	// ExecutorClient is the client to operate executor info.
 	ExecutorClient
+	// TenantQuotaClient is the client to operate tenant quota.
+	TenantQuotaClient
+	// JobEventClient is the client to operate job event.
+	JobEventClient
 }
 
 // ProjectClient defines interface that manages project in metastore
@@ -114,6 +120,7 @@ type WorkerClient interface {
 	GetWorkerByID(ctx context.Context, masterID string, workerID string) (*frameModel.WorkerStatus, error)
 	QueryWorkersByMasterID(ctx context.Context, masterID string) ([]*frameModel.WorkerStatus, error)
 	QueryWorkersByState(ctx context.Context, masterID string, state int) ([]*frameModel.WorkerStatus, error)
+	CountWorkersByJob(ctx context.Context, states ...frameModel.WorkerState) (map[frameModel.MasterID]int, error)
 }
 
 // ResourceClient defines interface that manages resource in metastore
@@ -153,6 +160,21 @@ type ExecutorClient interface {
 	QueryExecutors(ctx context.Context) ([]*model.Executor, error)
 }
 
+// TenantQuotaClient defines interface that manages tenant quota in metastore.
+type TenantQuotaClient interface {
+	UpsertTenantQuota(ctx context.Context, quota *model.TenantQuota) error
+	DeleteTenantQuota(ctx context.Context, tenantID string) (Result, error)
+	GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, error)
+	QueryTenantQuotas(ctx context.Context) ([]*model.TenantQuota, error)
+}
+
+// JobEventClient defines interface that manages job event in metastore.
+type JobEventClient interface {
+	CreateJobEvent(ctx context.Context, event *model.JobEvent) error
+	QueryJobEvents(ctx context.Context, jobID string, limit int) ([]*model.JobEvent, error)
+	DeleteJobEventsBefore(ctx context.Context, t time.Time) (Result, error)
+}
+
 // NewClient return the client to operate framework metastore
 func NewClient(cc metaModel.ClientConn) (Client, error) {
 	if cc == nil {
@@ -494,6 +516,30 @@ func (c *metaOpsClient) QueryWorkersByState(ctx context.Context, masterID string
 	return workers, nil
 }
 
+// CountWorkersByJob counts the workers in the specified states of each job
+func (c *metaOpsClient) CountWorkersByJob(
+	ctx context.Context, states ...frameModel.WorkerState,
+) (map[frameModel.MasterID]int, error) {
+	var rows []struct {
+		JobID frameModel.MasterID `gorm:"column:job_id"`
+		Count int                 `gorm:"column:cnt"`
+	}
+	if err := c.db.WithContext(ctx).
+		Model(&frameModel.WorkerStatus{}).
+		Select("job_id, COUNT(*) AS cnt").
+		Where("state IN ?", states).
+		Group("job_id").
+		Scan(&rows).Error; err != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	counts := make(map[frameModel.MasterID]int, len(rows))
+	for _, row := range rows {
+		counts[row.JobID] = row.Count
+	}
+	return counts, nil
+}
+
 // ///////////////////////////// Resource Operation
 // UpsertResource upsert the ResourceMeta
 func (c *metaOpsClient) UpsertResource(ctx context.Context, resource *resModel.ResourceMeta) error {
@@ -819,6 +865,106 @@ func (c *metaOpsClient) QueryExecutors(ctx context.Context) ([]*model.Executor,
 	return executors, nil
 }
 
+// UpsertTenantQuota creates or updates the quota of a tenant.
+func (c *metaOpsClient) UpsertTenantQuota(ctx context.Context, quota *model.TenantQuota) error {
+	if quota == nil {
+		return errors.ErrMetaParamsInvalid.GenWithStackByArgs("input tenant quota is nil")
+	}
+
+	if err := c.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "tenant_id"}},
+			DoUpdates: clause.AssignmentColumns(model.TenantQuotaUpdateColumns),
+		}).Create(quota).Error; err != nil {
+		return errors.ErrMetaOpFail.Wrap(err)
+	}
+	return nil
+}
+
+// DeleteTenantQuota deletes the quota of a tenant.
+func (c *metaOpsClient) DeleteTenantQuota(ctx context.Context, tenantID string) (Result, error) {
+	result := c.db.WithContext(ctx).
+		Where("tenant_id = ?", tenantID).
+		Delete(&model.TenantQuota{})
+	if result.Error != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(result.Error)
+	}
+
+	return &ormResult{rowsAffected: result.RowsAffected}, nil
+}
+
+// GetTenantQuota queries the quota of a tenant.
+func (c *metaOpsClient) GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, error) {
+	var quota model.TenantQuota
+	if err := c.db.WithContext(ctx).
+		Where("tenant_id = ?", tenantID).
+		First(&quota).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrMetaEntryNotFound.Wrap(err)
+		}
+
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+
+	return &quota, nil
+}
+
+// QueryTenantQuotas queries the quotas of all tenants.
+func (c *metaOpsClient) QueryTenantQuotas(ctx context.Context) ([]*model.TenantQuota, error) {
+	var quotas []*model.TenantQuota
+	if err := c.db.WithContext(ctx).
+		Find(&quotas).Error; err != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+	return quotas, nil
+}
+
+// CreateJobEvent appends an event of a job.
+func (c *metaOpsClient) CreateJobEvent(ctx context.Context, event *model.JobEvent) error {
+	if event == nil {
+		return errors.ErrMetaParamsInvalid.GenWithStackByArgs("input job event is nil")
+	}
+
+	if err := c.db.WithContext(ctx).
+		Create(event).Error; err != nil {
+		return errors.ErrMetaOpFail.Wrap(err)
+	}
+	return nil
+}
+
+// QueryJobEvents queries the latest `limit` events of a job in the order
+// they happened, zero limit means no limit.
+func (c *metaOpsClient) QueryJobEvents(
+	ctx context.Context, jobID string, limit int,
+) ([]*model.JobEvent, error) {
+	var events []*model.JobEvent
+	db := c.db.WithContext(ctx).
+		Where("job_id = ?", jobID).
+		Order("seq_id desc")
+	if limit > 0 {
+		db = db.Limit(limit)
+	}
+	if err := db.Find(&events).Error; err != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(err)
+	}
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events, nil
+}
+
+// DeleteJobEventsBefore deletes the events which happened before the given time.
+func (c *metaOpsClient) DeleteJobEventsBefore(ctx context.Context, t time.Time) (Result, error) {
+	result := c.db.WithContext(ctx).
+		Where("created_at < ?", t).
+		Delete(&model.JobEvent{})
+	if result.Error != nil {
+		return nil, errors.ErrMetaOpFail.Wrap(result.Error)
+	}
+
+	return &ormResult{rowsAffected: result.RowsAffected}, nil
+}
+
 // Result defines a query result interface
 type Result interface {
 	RowsAffected() int64
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/pingcap/tiflow/engine/framework/model"
@@ -53,6 +54,26 @@ func (mr *MockClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockClient)(nil).Close))
 }
 
+// CountWorkersByJob mocks base method.
+func (m *MockClient) CountWorkersByJob(arg0 context.Context, arg1 ...model.WorkerState) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountWorkersByJob", varargs...)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkersByJob indicates an expected call of CountWorkersByJob.
+func (mr *MockClientMockRecorder) CountWorkersByJob(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkersByJob", reflect.TypeOf((*MockClient)(nil).CountWorkersByJob), varargs...)
+}
+
 // CreateExecutor mocks base method.
 func (m *MockClient) CreateExecutor(arg0 context.Context, arg1 *model2.Executor) error {
 	m.ctrl.T.Helper()
@@ -67,6 +88,20 @@ func (mr *MockClientMockRecorder) CreateExecutor(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExecutor", reflect.TypeOf((*MockClient)(nil).CreateExecutor), arg0, arg1)
 }
 
+// CreateJobEvent mocks base method.
+func (m *MockClient) CreateJobEvent(arg0 context.Context, arg1 *model2.JobEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJobEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateJobEvent indicates an expected call of CreateJobEvent.
+func (mr *MockClientMockRecorder) CreateJobEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJobEvent", reflect.TypeOf((*MockClient)(nil).CreateJobEvent), arg0, arg1)
+}
+
 // CreateProject mocks base method.
 func (m *MockClient) CreateProject(arg0 context.Context, arg1 *model2.ProjectInfo) error {
 	m.ctrl.T.Helper()
@@ -138,6 +173,21 @@ func (mr *MockClientMockRecorder) DeleteJob(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*MockClient)(nil).DeleteJob), arg0, arg1)
 }
 
+// DeleteJobEventsBefore mocks base method.
+func (m *MockClient) DeleteJobEventsBefore(arg0 context.Context, arg1 time.Time) (orm.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJobEventsBefore", arg0, arg1)
+	ret0, _ := ret[0].(orm.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteJobEventsBefore indicates an expected call of DeleteJobEventsBefore.
+func (mr *MockClientMockRecorder) DeleteJobEventsBefore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobEventsBefore", reflect.TypeOf((*MockClient)(nil).DeleteJobEventsBefore), arg0, arg1)
+}
+
 // DeleteProject mocks base method.
 func (m *MockClient) DeleteProject(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -187,6 +237,21 @@ func (mr *MockClientMockRecorder) DeleteResourcesByTypeAndExecutorIDs(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourcesByTypeAndExecutorIDs", reflect.TypeOf((*MockClient)(nil).DeleteResourcesByTypeAndExecutorIDs), varargs...)
 }
 
+// DeleteTenantQuota mocks base method.
+func (m *MockClient) DeleteTenantQuota(arg0 context.Context, arg1 string) (orm.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTenantQuota", arg0, arg1)
+	ret0, _ := ret[0].(orm.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTenantQuota indicates an expected call of DeleteTenantQuota.
+func (mr *MockClientMockRecorder) DeleteTenantQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTenantQuota", reflect.TypeOf((*MockClient)(nil).DeleteTenantQuota), arg0, arg1)
+}
+
 // DeleteWorker mocks base method.
 func (m *MockClient) DeleteWorker(arg0 context.Context, arg1, arg2 string) (orm.Result, error) {
 	m.ctrl.T.Helper()
@@ -277,6 +342,21 @@ func (mr *MockClientMockRecorder) GetResourceByID(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceByID", reflect.TypeOf((*MockClient)(nil).GetResourceByID), arg0, arg1)
 }
 
+// GetTenantQuota mocks base method.
+func (m *MockClient) GetTenantQuota(arg0 context.Context, arg1 string) (*model2.TenantQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTenantQuota", arg0, arg1)
+	ret0, _ := ret[0].(*model2.TenantQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTenantQuota indicates an expected call of GetTenantQuota.
+func (mr *MockClientMockRecorder) GetTenantQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTenantQuota", reflect.TypeOf((*MockClient)(nil).GetTenantQuota), arg0, arg1)
+}
+
 // GetWorkerByID mocks base method.
 func (m *MockClient) GetWorkerByID(arg0 context.Context, arg1, arg2 string) (*model.WorkerStatus, error) {
 	m.ctrl.T.Helper()
@@ -321,6 +401,21 @@ func (mr *MockClientMockRecorder) QueryExecutors(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryExecutors", reflect.TypeOf((*MockClient)(nil).QueryExecutors), arg0)
 }
 
+// QueryJobEvents mocks base method.
+func (m *MockClient) QueryJobEvents(arg0 context.Context, arg1 string, arg2 int) ([]*model2.JobEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryJobEvents", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model2.JobEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryJobEvents indicates an expected call of QueryJobEvents.
+func (mr *MockClientMockRecorder) QueryJobEvents(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryJobEvents", reflect.TypeOf((*MockClient)(nil).QueryJobEvents), arg0, arg1, arg2)
+}
+
 // QueryJobOp mocks base method.
 func (m *MockClient) QueryJobOp(arg0 context.Context, arg1 string) (*model2.JobOp, error) {
 	m.ctrl.T.Helper()
@@ -491,6 +586,21 @@ func (mr *MockClientMockRecorder) QueryResourcesByJobID(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryResourcesByJobID", reflect.TypeOf((*MockClient)(nil).QueryResourcesByJobID), arg0, arg1)
 }
 
+// QueryTenantQuotas mocks base method.
+func (m *MockClient) QueryTenantQuotas(arg0 context.Context) ([]*model2.TenantQuota, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryTenantQuotas", arg0)
+	ret0, _ := ret[0].([]*model2.TenantQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryTenantQuotas indicates an expected call of QueryTenantQuotas.
+func (mr *MockClientMockRecorder) QueryTenantQuotas(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryTenantQuotas", reflect.TypeOf((*MockClient)(nil).QueryTenantQuotas), arg0)
+}
+
 // QueryWorkersByMasterID mocks base method.
 func (m *MockClient) QueryWorkersByMasterID(arg0 context.Context, arg1 string) ([]*model.WorkerStatus, error) {
 	m.ctrl.T.Helper()
@@ -669,6 +779,20 @@ func (mr *MockClientMockRecorder) UpsertResource(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertResource", reflect.TypeOf((*MockClient)(nil).UpsertResource), arg0, arg1)
 }
 
+// UpsertTenantQuota mocks base method.
+func (m *MockClient) UpsertTenantQuota(arg0 context.Context, arg1 *model2.TenantQuota) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTenantQuota", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertTenantQuota indicates an expected call of UpsertTenantQuota.
+func (mr *MockClientMockRecorder) UpsertTenantQuota(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTenantQuota", reflect.TypeOf((*MockClient)(nil).UpsertTenantQuota), arg0, arg1)
+}
+
 // UpsertWorker mocks base method.
 func (m *MockClient) UpsertWorker(arg0 context.Context, arg1 *model.WorkerStatus) error {
 	m.ctrl.T.Helper()
@@ -742,6 +742,113 @@ func TestResourceMock(t *testing.T) {
 	}
 }
 
+func TestTenantQuotaMock(t *testing.T) {
+	cli, err := NewMockClient()
+	require.Nil(t, err)
+	require.NotNil(t, cli)
+	defer cli.Close()
+
+	ctx := context.Background()
+	_, err = cli.GetTenantQuota(ctx, "t1")
+	require.True(t, IsNotFoundError(err))
+
+	require.NoError(t, cli.UpsertTenantQuota(ctx, &model.TenantQuota{TenantID: "t1", MaxJobs: 1}))
+	require.NoError(t, cli.UpsertTenantQuota(ctx, &model.TenantQuota{TenantID: "t2", MaxExecutorSlots: 2}))
+	// update the quota of an existing tenant.
+	require.NoError(t, cli.UpsertTenantQuota(ctx, &model.TenantQuota{TenantID: "t1", MaxJobs: 3}))
+
+	quota, err := cli.GetTenantQuota(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, 3, quota.MaxJobs)
+	require.Equal(t, 0, quota.MaxExecutorSlots)
+
+	quotas, err := cli.QueryTenantQuotas(ctx)
+	require.NoError(t, err)
+	require.Len(t, quotas, 2)
+
+	res, err := cli.DeleteTenantQuota(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.RowsAffected())
+	res, err = cli.DeleteTenantQuota(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.RowsAffected())
+	_, err = cli.GetTenantQuota(ctx, "t1")
+	require.True(t, IsNotFoundError(err))
+}
+
+func TestJobEventMock(t *testing.T) {
+	cli, err := NewMockClient()
+	require.Nil(t, err)
+	require.NotNil(t, cli)
+	defer cli.Close()
+
+	ctx := context.Background()
+	events, err := cli.QueryJobEvents(ctx, "j1", 0)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	now := time.Now()
+	for i, tp := range []model.JobEventType{
+		model.JobEventCreated, model.JobEventDispatched, model.JobEventOnline,
+	} {
+		require.NoError(t, cli.CreateJobEvent(ctx, &model.JobEvent{
+			JobID:     "j1",
+			EventType: tp,
+			CreatedAt: now.Add(time.Duration(i) * time.Hour),
+		}))
+	}
+	require.NoError(t, cli.CreateJobEvent(ctx, &model.JobEvent{
+		JobID:     "j2",
+		EventType: model.JobEventCreated,
+		CreatedAt: now.Add(2 * time.Hour),
+	}))
+
+	events, err = cli.QueryJobEvents(ctx, "j1", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, model.JobEventCreated, events[0].EventType)
+	require.Equal(t, model.JobEventOnline, events[2].EventType)
+	// only the latest events are returned with a limit.
+	events, err = cli.QueryJobEvents(ctx, "j1", 2)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, model.JobEventDispatched, events[0].EventType)
+	require.Equal(t, model.JobEventOnline, events[1].EventType)
+
+	res, err := cli.DeleteJobEventsBefore(ctx, now.Add(90*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.RowsAffected())
+	events, err = cli.QueryJobEvents(ctx, "j1", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, model.JobEventOnline, events[0].EventType)
+	events, err = cli.QueryJobEvents(ctx, "j2", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+}
+
+func TestCountWorkersByJobMock(t *testing.T) {
+	cli, err := NewMockClient()
+	require.Nil(t, err)
+	require.NotNil(t, cli)
+	defer cli.Close()
+
+	ctx := context.Background()
+	for _, w := range []*frameModel.WorkerStatus{
+		{JobID: "j1", ID: "w1", State: frameModel.WorkerStateNormal},
+		{JobID: "j1", ID: "w2", State: frameModel.WorkerStateInit},
+		{JobID: "j1", ID: "w3", State: frameModel.WorkerStateFinished},
+		{JobID: "j2", ID: "w4", State: frameModel.WorkerStateNormal},
+		{JobID: "j3", ID: "w5", State: frameModel.WorkerStateStopped},
+	} {
+		require.NoError(t, cli.UpsertWorker(ctx, w))
+	}
+
+	counts, err := cli.CountWorkersByJob(ctx, frameModel.WorkerStateNormal, frameModel.WorkerStateInit)
+	require.NoError(t, err)
+	require.Equal(t, map[frameModel.MasterID]int{"j1": 2, "j2": 1}, counts)
+}
+
 func testInnerMock(t *testing.T, cli Client, c mCase) {
 	var args []reflect.Value
 	args = append(args, reflect.ValueOf(context.Background()))
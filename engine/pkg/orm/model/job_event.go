@@ -0,0 +1,70 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// JobEventType is the type of a job event.
+type JobEventType string
+
+// Defines all JobEventType
+const (
+	// JobEventCreated means the job is created by the user.
+	JobEventCreated = JobEventType("created")
+	// JobEventQueued means the job is waiting for enough capacity to be dispatched.
+	JobEventQueued = JobEventType("queued")
+	// JobEventDispatched means the job master or worker is dispatched to an executor.
+	JobEventDispatched = JobEventType("dispatched")
+	// JobEventDispatchFailed means the job master or worker fails to be dispatched.
+	JobEventDispatchFailed = JobEventType("dispatch-failed")
+	// JobEventOnline means the first heartbeat of the job master is received.
+	JobEventOnline = JobEventType("online")
+	// JobEventFailover means the job master or worker is offline and it will
+	// be dispatched again.
+	JobEventFailover = JobEventType("failover")
+	// JobEventOffline means the job master or worker is offline and it won't
+	// be dispatched again.
+	JobEventOffline = JobEventType("offline")
+	// JobEventPreempted means the job master is stopped by a job with a
+	// higher priority.
+	JobEventPreempted = JobEventType("preempted")
+	// JobEventCanceling means the job is being canceled by the user.
+	JobEventCanceling = JobEventType("canceling")
+	// JobEventTerminated means the job is terminated with a final state.
+	JobEventTerminated = JobEventType("terminated")
+	// JobEventDeleted means the job is deleted by the user.
+	JobEventDeleted = JobEventType("deleted")
+)
+
+// JobEvent records an event in the lifetime of a job. The events are
+// append-only and they are kept after the job is deleted, until they
+// are expired.
+type JobEvent struct {
+	SeqID uint   `json:"seq-id" gorm:"primaryKey;auto_increment"`
+	JobID string `json:"job-id" gorm:"column:job_id;type:varchar(128) not null;index:idx_job_event_job_id"`
+	// WorkerID is set if it's an event of a worker of the job, the events of
+	// the workers are recorded by the job master.
+	WorkerID  string       `json:"worker-id,omitempty" gorm:"column:worker_id;type:varchar(128)"`
+	EventType JobEventType `json:"event-type" gorm:"column:event_type;type:varchar(32) not null"`
+	// FromState and ToState are the states of the job in the job manager
+	// before and after the event, an empty state means the job is not
+	// managed by the job manager. ToState of a terminated event is the
+	// final state of the job.
+	FromState string `json:"from-state,omitempty" gorm:"column:from_state;type:varchar(32)"`
+	ToState   string `json:"to-state,omitempty" gorm:"column:to_state;type:varchar(32)"`
+	// ExecutorID is the executor which the job master or worker runs on, if known.
+	ExecutorID string    `json:"executor-id,omitempty" gorm:"column:executor_id;type:varchar(256)"`
+	Message    string    `json:"message,omitempty" gorm:"column:message;type:text"`
+	CreatedAt  time.Time `json:"created-at" gorm:"autoCreateTime;index:idx_job_event_created_at"`
+}
@@ -0,0 +1,40 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// TenantQuotaUpdateColumns is used in gorm update.
+var TenantQuotaUpdateColumns = []string{
+	"updated_at",
+	"max_jobs",
+	"max_executor_slots",
+	"max_storage_bytes",
+	"queue_jobs",
+}
+
+// TenantQuota records the resource limits of a tenant, zero means unlimited.
+type TenantQuota struct {
+	Model
+	TenantID string `json:"tenant-id" gorm:"column:tenant_id;type:varchar(128) not null;uniqueIndex:uidx_tenant_id"`
+	// MaxJobs is the max number of jobs of the tenant which are not terminated.
+	MaxJobs int `json:"max-jobs" gorm:"column:max_jobs;type:int not null"`
+	// MaxExecutorSlots is the max number of tasks of the tenant running in the cluster,
+	// both job masters and workers are counted.
+	MaxExecutorSlots int `json:"max-executor-slots" gorm:"column:max_executor_slots;type:int not null"`
+	// MaxStorageBytes is the max total size of the external resources of the tenant.
+	MaxStorageBytes int64 `json:"max-storage-bytes" gorm:"column:max_storage_bytes;type:bigint not null"`
+	// QueueJobs queues the jobs exceeding the max-jobs or max-storage-bytes quota
+	// instead of rejecting them. The queued jobs are dispatched once the tenant has
+	// fewer than max-jobs dispatched jobs and its storage is below max-storage-bytes.
+	QueueJobs bool `json:"queue-jobs" gorm:"column:queue_jobs;type:BOOLEAN"`
+}
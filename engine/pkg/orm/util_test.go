@@ -87,7 +87,7 @@ func TestInitialize(t *testing.T) {
 			"CDCJobMaster(5),CvsTask(6),FakeTask(7),DMTask(8),CDCTask(9),"+
 			"WorkerDMDump(10),WorkerDMLoad(11),WorkerDMSync(12)',"+
 			"`state` tinyint not null COMMENT "+
-			"'Normal(1),Created(2),Init(3),Error(4),Finished(5),Stopped(6)',"+
+			"'Normal(1),Created(2),Init(3),Error(4),Finished(5),Stopped(6),Paused(7)',"+
 			"`epoch` bigint not null,`error_message` text,`extend_bytes` blob,"+
 			"PRIMARY KEY (`seq_id`)") +
 		".*", // sequence of indexes are nondeterministic
@@ -123,6 +123,23 @@ func TestInitialize(t *testing.T) {
 			"UNIQUE INDEX `uni_id` (`id`))"),
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
+	mock.ExpectExec(regexp.QuoteMeta(
+		"CREATE TABLE `tenant_quota` (`seq_id` bigint unsigned AUTO_INCREMENT," +
+			"`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL," +
+			"`tenant_id` varchar(128) not null,`max_jobs` int not null," +
+			"`max_executor_slots` int not null,`max_storage_bytes` bigint not null," +
+			"`queue_jobs` BOOLEAN,PRIMARY KEY (`seq_id`)," +
+			"UNIQUE INDEX `uidx_tenant_id` (`tenant_id`))"),
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectExec(regexp.QuoteMeta(
+		"CREATE TABLE `job_events` (`seq_id` bigint unsigned AUTO_INCREMENT,"+
+			"`job_id` varchar(128) not null,`event_type` varchar(32) not null,"+
+			"`from_state` varchar(32),`to_state` varchar(32),`executor_id` varchar(256),"+
+			"`message` text,`created_at` datetime(3) NULL,PRIMARY KEY (`seq_id`),") +
+		".*", // sequence of indexes are nondeterministic
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+
 	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `logic_epoches` (`seq_id` bigint unsigned AUTO_INCREMENT," +
 		"`created_at` datetime(3) NULL,`updated_at` datetime(3) NULL,`job_id` varchar(128) not null,`epoch` bigint not null default 1," +
 		"PRIMARY KEY (`seq_id`),UNIQUE INDEX `uidx_jk` (`job_id`))")).
@@ -0,0 +1,215 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/engine/framework/metadata"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+const (
+	// slotsRefreshInterval is the interval to reload the running tasks of
+	// the tenants from the metastore.
+	slotsRefreshInterval = 10 * time.Second
+	// reservedSlotTTL is how long the slot of a scheduled task is reserved,
+	// the task should have persisted its status in the metastore by then.
+	reservedSlotTTL = 30 * time.Second
+)
+
+var runningWorkerStates = []frameModel.WorkerState{
+	frameModel.WorkerStateNormal,
+	frameModel.WorkerStateCreated,
+	frameModel.WorkerStateInit,
+}
+
+// ClusterSlots counts the slots used by the running job masters and workers in
+// the whole cluster, it checks the max-executor-slots quota of the tenants and
+// the slots of the executors. The running tasks are reloaded from the metastore
+// periodically, and the slots of the tasks scheduled since then are reserved in
+// memory, so a check doesn't query the metastore.
+// Note that the check is best effort, a task scheduled recently may be counted
+// twice until its reservation expires.
+type ClusterSlots struct {
+	cli    pkgOrm.Client
+	quotas *Cache
+	// capacity returns the number of slots of all executors, zero means unlimited.
+	capacity func() int
+	clock    clock.Clock
+
+	mu sync.Mutex
+	// used is the number of running tasks of each tenant in the metastore,
+	// the tasks without a tenant are counted by the empty tenant.
+	used     map[string]int
+	loadedAt time.Time
+	// reserved records the time the tasks of each tenant are scheduled.
+	reserved map[string]map[string]time.Time
+}
+
+// NewClusterSlots creates a new ClusterSlots instance, capacity can be nil if
+// the slots of the executors are unlimited.
+func NewClusterSlots(cli pkgOrm.Client, capacity func() int) *ClusterSlots {
+	return &ClusterSlots{
+		cli:      cli,
+		quotas:   NewCache(cli, slotsRefreshInterval),
+		capacity: capacity,
+		clock:    clock.New(),
+		reserved: make(map[string]map[string]time.Time),
+	}
+}
+
+// Acquire reserves a slot for the task of the tenant. An error is returned if
+// the tenant has run out of its slots, or the executors have no free slots.
+func (s *ClusterSlots) Acquire(ctx context.Context, tenantID, taskID string) error {
+	maxSlots := 0
+	if tenantID != "" {
+		quota, err := s.quotas.Get(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		maxSlots = quota.MaxExecutorSlots
+	}
+	capacity := s.getCapacity()
+	if maxSlots == 0 && capacity == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.refreshLocked(ctx); err != nil {
+		return err
+	}
+	tasks := s.reserved[tenantID]
+	// the task is rescheduled, e.g. the dispatch failed.
+	if _, ok := tasks[taskID]; !ok {
+		if capacity > 0 && s.totalLocked() >= capacity {
+			return errors.ErrClusterResourceNotEnough.GenWithStackByArgs()
+		}
+		if maxSlots > 0 && s.used[tenantID]+len(tasks) >= maxSlots {
+			return errors.ErrTenantQuotaExceeded.GenWithStackByArgs(
+				tenantID, TenantQuotaMaxExecutorSlots, maxSlots)
+		}
+	}
+	if tasks == nil {
+		tasks = make(map[string]time.Time)
+		s.reserved[tenantID] = tasks
+	}
+	tasks[taskID] = s.clock.Now()
+	return nil
+}
+
+// HasFreeSlots returns whether the executors have a free slot for one more task
+// besides the `tasks` tasks which are being scheduled.
+func (s *ClusterSlots) HasFreeSlots(ctx context.Context, tasks int) (bool, error) {
+	capacity := s.getCapacity()
+	if capacity == 0 {
+		return true, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.refreshLocked(ctx); err != nil {
+		return false, err
+	}
+	return s.totalLocked()+tasks < capacity, nil
+}
+
+// Used returns the number of slots used by the tenant, including the reserved ones.
+func (s *ClusterSlots) Used(ctx context.Context, tenantID string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.refreshLocked(ctx); err != nil {
+		return 0, err
+	}
+	return s.used[tenantID] + len(s.reserved[tenantID]), nil
+}
+
+func (s *ClusterSlots) getCapacity() int {
+	if s.capacity == nil {
+		return 0
+	}
+	return s.capacity()
+}
+
+func (s *ClusterSlots) totalLocked() int {
+	total := 0
+	for _, used := range s.used {
+		total += used
+	}
+	for _, tasks := range s.reserved {
+		total += len(tasks)
+	}
+	return total
+}
+
+func (s *ClusterSlots) refreshLocked(ctx context.Context) error {
+	if s.used != nil && s.clock.Since(s.loadedAt) < slotsRefreshInterval {
+		return nil
+	}
+
+	jobs, err := s.cli.QueryJobs(ctx)
+	if err != nil {
+		return err
+	}
+	// The job masters are the workers of the job manager, the workers of a
+	// job are not counted if its job master isn't running, e.g. it's paused.
+	masters, err := s.cli.QueryWorkersByMasterID(ctx, metadata.JobManagerUUID)
+	if err != nil {
+		return err
+	}
+	workers, err := s.cli.CountWorkersByJob(ctx, runningWorkerStates...)
+	if err != nil {
+		return err
+	}
+	tenants := make(map[frameModel.MasterID]string, len(jobs))
+	for _, job := range jobs {
+		tenants[job.ID] = job.Ext.TenantID
+	}
+	used := make(map[string]int)
+	for _, master := range masters {
+		if !isRunningWorker(master.State) {
+			continue
+		}
+		used[tenants[master.ID]] += 1 + workers[master.ID]
+	}
+	s.used = used
+	s.loadedAt = s.clock.Now()
+
+	for tenantID, tasks := range s.reserved {
+		for taskID, reservedAt := range tasks {
+			if s.clock.Since(reservedAt) >= reservedSlotTTL {
+				delete(tasks, taskID)
+			}
+		}
+		if len(tasks) == 0 {
+			delete(s.reserved, tenantID)
+		}
+	}
+	return nil
+}
+
+func isRunningWorker(state frameModel.WorkerState) bool {
+	for _, s := range runningWorkerStates {
+		if state == s {
+			return true
+		}
+	}
+	return false
+}
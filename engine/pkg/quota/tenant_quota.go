@@ -0,0 +1,123 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// Names of the tenant quotas, they are used in the error message.
+const (
+	TenantQuotaMaxJobs          = "max-jobs"
+	TenantQuotaMaxExecutorSlots = "max-executor-slots"
+	TenantQuotaMaxStorageBytes  = "max-storage-bytes"
+)
+
+// GetTenantQuota returns the quota of the tenant, a zero quota which means
+// unlimited is returned if the quota of the tenant is not set.
+func GetTenantQuota(
+	ctx context.Context, cli pkgOrm.TenantQuotaClient, tenantID string,
+) (*ormModel.TenantQuota, error) {
+	quota, err := cli.GetTenantQuota(ctx, tenantID)
+	if err != nil {
+		if pkgOrm.IsNotFoundError(err) {
+			return &ormModel.TenantQuota{TenantID: tenantID}, nil
+		}
+		return nil, err
+	}
+	return quota, nil
+}
+
+// CountTenantJobs returns the number of jobs of the tenant which are not terminated.
+func CountTenantJobs(jobs []*frameModel.MasterMeta, tenantID string) int {
+	count := 0
+	for _, job := range jobs {
+		if job.Ext.TenantID == tenantID && !job.State.IsTerminatedState() {
+			count++
+		}
+	}
+	return count
+}
+
+// CheckJobQuota checks whether the tenant can create one more job.
+func CheckJobQuota(quota *ormModel.TenantQuota, jobs int) error {
+	if quota.MaxJobs > 0 && jobs >= quota.MaxJobs {
+		return errors.ErrTenantQuotaExceeded.GenWithStackByArgs(
+			quota.TenantID, TenantQuotaMaxJobs, quota.MaxJobs)
+	}
+	return nil
+}
+
+// CheckStorageQuota checks whether the tenant can use more storage when
+// its external resources have used `used` bytes.
+func CheckStorageQuota(quota *ormModel.TenantQuota, used int64) error {
+	if quota.MaxStorageBytes > 0 && used >= quota.MaxStorageBytes {
+		return errors.ErrTenantQuotaExceeded.GenWithStackByArgs(
+			quota.TenantID, TenantQuotaMaxStorageBytes, quota.MaxStorageBytes)
+	}
+	return nil
+}
+
+// Cache caches the quotas of all tenants, so that the quotas can be checked
+// frequently without querying the metastore each time. The quotas are reloaded
+// after they expire, so an update takes effect within the ttl.
+type Cache struct {
+	cli   pkgOrm.TenantQuotaClient
+	ttl   time.Duration
+	clock clock.Clock
+
+	mu       sync.Mutex
+	quotas   map[string]*ormModel.TenantQuota
+	loadedAt time.Time
+}
+
+// NewCache creates a new Cache instance.
+func NewCache(cli pkgOrm.TenantQuotaClient, ttl time.Duration) *Cache {
+	return &Cache{
+		cli:   cli,
+		ttl:   ttl,
+		clock: clock.New(),
+	}
+}
+
+// Get returns the quota of the tenant, a zero quota which means unlimited
+// is returned if the quota of the tenant is not set.
+func (c *Cache) Get(ctx context.Context, tenantID string) (*ormModel.TenantQuota, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.quotas == nil || c.clock.Since(c.loadedAt) >= c.ttl {
+		quotas, err := c.cli.QueryTenantQuotas(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.quotas = make(map[string]*ormModel.TenantQuota, len(quotas))
+		for _, q := range quotas {
+			c.quotas[q.TenantID] = q
+		}
+		c.loadedAt = c.clock.Now()
+	}
+	if q, ok := c.quotas[tenantID]; ok {
+		return q, nil
+	}
+	return &ormModel.TenantQuota{TenantID: tenantID}, nil
+}
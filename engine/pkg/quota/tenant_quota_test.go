@@ -0,0 +1,177 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/engine/framework/metadata"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTenantQuota(t *testing.T) {
+	t.Parallel()
+
+	cli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer cli.Close()
+
+	ctx := context.Background()
+	quota, err := GetTenantQuota(ctx, cli, "t1")
+	require.NoError(t, err)
+	require.Equal(t, "t1", quota.TenantID)
+	require.Equal(t, 0, quota.MaxJobs)
+
+	require.NoError(t, cli.UpsertTenantQuota(ctx, &ormModel.TenantQuota{TenantID: "t1", MaxJobs: 2}))
+	quota, err = GetTenantQuota(ctx, cli, "t1")
+	require.NoError(t, err)
+	require.Equal(t, 2, quota.MaxJobs)
+}
+
+func TestCheckJobQuota(t *testing.T) {
+	t.Parallel()
+
+	jobs := []*frameModel.MasterMeta{
+		{ID: "j1", State: frameModel.MasterStateInit, Ext: frameModel.MasterMetaExt{TenantID: "t1"}},
+		{ID: "j2", State: frameModel.MasterStateUninit, Ext: frameModel.MasterMetaExt{TenantID: "t1"}},
+		{ID: "j3", State: frameModel.MasterStateFailed, Ext: frameModel.MasterMetaExt{TenantID: "t1"}},
+		{ID: "j4", State: frameModel.MasterStateInit, Ext: frameModel.MasterMetaExt{TenantID: "t2"}},
+	}
+	require.Equal(t, 2, CountTenantJobs(jobs, "t1"))
+	require.Equal(t, 1, CountTenantJobs(jobs, "t2"))
+	require.Equal(t, 0, CountTenantJobs(jobs, "t3"))
+
+	// zero means unlimited.
+	require.NoError(t, CheckJobQuota(&ormModel.TenantQuota{TenantID: "t1"}, 2))
+	require.NoError(t, CheckJobQuota(&ormModel.TenantQuota{TenantID: "t1", MaxJobs: 3}, 2))
+	err := CheckJobQuota(&ormModel.TenantQuota{TenantID: "t1", MaxJobs: 2}, 2)
+	require.True(t, errors.Is(err, errors.ErrTenantQuotaExceeded))
+	require.ErrorContains(t, err, TenantQuotaMaxJobs)
+}
+
+func TestCheckStorageQuota(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, CheckStorageQuota(&ormModel.TenantQuota{TenantID: "t1"}, 100))
+	require.NoError(t, CheckStorageQuota(&ormModel.TenantQuota{TenantID: "t1", MaxStorageBytes: 101}, 100))
+	err := CheckStorageQuota(&ormModel.TenantQuota{TenantID: "t1", MaxStorageBytes: 100}, 100)
+	require.True(t, errors.Is(err, errors.ErrTenantQuotaExceeded))
+	require.ErrorContains(t, err, TenantQuotaMaxStorageBytes)
+}
+
+func TestQuotaCache(t *testing.T) {
+	t.Parallel()
+
+	cli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer cli.Close()
+
+	ctx := context.Background()
+	cache := NewCache(cli, time.Minute)
+	mockClock := clock.NewMock()
+	cache.clock = mockClock
+
+	quota, err := cache.Get(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, "t1", quota.TenantID)
+	require.Equal(t, 0, quota.MaxJobs)
+
+	// the update takes effect after the cache expires.
+	require.NoError(t, cli.UpsertTenantQuota(ctx, &ormModel.TenantQuota{TenantID: "t1", MaxJobs: 2}))
+	quota, err = cache.Get(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, 0, quota.MaxJobs)
+	mockClock.Add(time.Minute)
+	quota, err = cache.Get(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, 2, quota.MaxJobs)
+}
+
+func TestClusterSlots(t *testing.T) {
+	t.Parallel()
+
+	cli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer cli.Close()
+
+	ctx := context.Background()
+	mockClock := clock.NewMock()
+	capacity := 0
+	slots := NewClusterSlots(cli, func() int { return capacity })
+	slots.clock = mockClock
+	slots.quotas.clock = mockClock
+
+	require.NoError(t, cli.UpsertTenantQuota(ctx, &ormModel.TenantQuota{TenantID: "t1", MaxExecutorSlots: 3}))
+	for _, job := range []*frameModel.MasterMeta{
+		{ID: "j1", State: frameModel.MasterStateInit, Ext: frameModel.MasterMetaExt{TenantID: "t1"}},
+		{ID: "j2", State: frameModel.MasterStateInit, Ext: frameModel.MasterMetaExt{TenantID: "t1"}},
+	} {
+		require.NoError(t, cli.InsertJob(ctx, job))
+	}
+	for _, worker := range []*frameModel.WorkerStatus{
+		// job master j1 is running and j2 is paused.
+		{JobID: metadata.JobManagerUUID, ID: "j1", State: frameModel.WorkerStateNormal},
+		{JobID: metadata.JobManagerUUID, ID: "j2", State: frameModel.WorkerStatePaused},
+		{JobID: "j1", ID: "w1", State: frameModel.WorkerStateNormal},
+		{JobID: "j1", ID: "w2", State: frameModel.WorkerStateFinished},
+		{JobID: "j2", ID: "w3", State: frameModel.WorkerStateNormal},
+	} {
+		require.NoError(t, cli.UpsertWorker(ctx, worker))
+	}
+
+	// the tasks without a tenant or quota are not limited.
+	require.NoError(t, slots.Acquire(ctx, "", "task0"))
+	require.NoError(t, slots.Acquire(ctx, "t2", "task0"))
+
+	// job master j1 and worker w1 are running.
+	used, err := slots.Used(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, 2, used)
+	require.NoError(t, slots.Acquire(ctx, "t1", "task1"))
+	// a rescheduled task doesn't take another slot.
+	require.NoError(t, slots.Acquire(ctx, "t1", "task1"))
+	err = slots.Acquire(ctx, "t1", "task2")
+	require.True(t, errors.Is(err, errors.ErrTenantQuotaExceeded))
+	require.ErrorContains(t, err, TenantQuotaMaxExecutorSlots)
+
+	// the executors run out of slots.
+	capacity = 4
+	ok, err := slots.HasFreeSlots(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = slots.HasFreeSlots(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, slots.Acquire(ctx, "t2", "task3"))
+	err = slots.Acquire(ctx, "", "task4")
+	require.True(t, errors.Is(err, errors.ErrClusterResourceNotEnough))
+
+	// the slots are released once the tasks stop.
+	require.NoError(t, cli.UpsertWorker(ctx, &frameModel.WorkerStatus{
+		JobID: metadata.JobManagerUUID, ID: "j1", State: frameModel.WorkerStateFinished,
+	}))
+	mockClock.Add(reservedSlotTTL)
+	used, err = slots.Used(ctx, "t1")
+	require.NoError(t, err)
+	require.Equal(t, 0, used)
+	require.NoError(t, slots.Acquire(ctx, "t1", "task2"))
+	require.NoError(t, slots.Acquire(ctx, "", "task4"))
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sdk is the public SDK to implement user-defined job types for the
+// dataflow engine, so that a job type can be built and deployed without
+// changing the engine.
+//
+// A job type consists of a job master and the workers created by the job
+// master. The job master implements JobMaster and embeds BaseJobMaster, and
+// a worker implements Worker and embeds BaseWorker. The embedded fields are
+// filled in by the framework, and they provide the utilities to create
+// workers, exchange messages, update the status and exit.
+//
+// A job type is identified by a number in [JobTypeBase, JobTypeMax], and a
+// worker type is identified by a number in [WorkerTypeBase, WorkerTypeMax].
+// The numbers are persisted in the metastore, so they must not be changed
+// once there are jobs of the type. The worker type of the job master is the
+// same number as the job type, so the worker types of the workers must be
+// different from all the job types.
+//
+// The job types are registered by RegisterJobType, usually in an init
+// function, and then RunExecutor runs an executor in the binary. The executor
+// joins the cluster like a built-in executor, and the registered job types are
+// sent to the server master as the labels of the executor when it registers
+// itself. The job masters and workers of a user-defined job type are only
+// dispatched to the executors which registered the job type.
+//
+// A job type can also be served by a plugin, which is a separate binary from
+// the executor. ServePlugin registers the job types of RemoteJobTypeSpec in
+// an executor at runtime through the JobTypeHost gRPC service of the executor,
+// and the executor sends the new labels to the server master by the next
+// heartbeat. The executor runs a proxy for each job master and worker of the
+// job types, which forwards the callbacks of the framework to RemoteJobMaster
+// and RemoteWorker in the plugin by the JobTypePlugin gRPC service, and they
+// call the framework by RemoteHandle. A plugin registers its job types in one
+// executor, so it usually runs beside the executor, and it registers them
+// periodically to survive the restarts of both sides.
+//
+// A job of a user-defined job type is created by the CreateJob API with the
+// number of the job type, e.g. `{"type": 1001, "config": "..."}` through the
+// OpenAPI, and the config is passed to the job master as is.
+//
+// The aliases in this package are the stable interfaces of the SDK, the
+// packages they refer to may be changed between releases.
+package sdk
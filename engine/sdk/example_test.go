@@ -0,0 +1,210 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/pingcap/tiflow/engine/sdk"
+)
+
+// The job types and worker types are persisted in the metastore, so they
+// are constants. The worker types must be different from all the job types.
+const (
+	sumJobType  = sdk.JobTypeBase + 1
+	sumTaskType = sdk.WorkerTypeBase + 1001
+)
+
+// sumConfig is the config of the sum job, which sums the integers in [0, N)
+// by splitting them into Parts workers.
+type sumConfig struct {
+	N     int `json:"n"`
+	Parts int `json:"parts"`
+}
+
+type sumTaskConfig struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+}
+
+// sumJobMaster is the job master of the sum job.
+type sumJobMaster struct {
+	sdk.BaseJobMaster
+
+	tasks []*sumTaskConfig
+
+	mu       sync.Mutex
+	running  map[sdk.WorkerID]*sumTaskConfig
+	finished int
+	canceled bool
+}
+
+var _ sdk.JobMaster = (*sumJobMaster)(nil)
+
+func newSumJobMaster(_ *sdk.Context, _ sdk.WorkerID, _ sdk.MasterID, config *sumConfig) *sumJobMaster {
+	m := &sumJobMaster{running: make(map[sdk.WorkerID]*sumTaskConfig)}
+	step := (config.N + config.Parts - 1) / config.Parts
+	for from := 0; from < config.N; from += step {
+		m.tasks = append(m.tasks, &sumTaskConfig{From: from, To: min(from+step, config.N)})
+	}
+	return m
+}
+
+func (m *sumJobMaster) createTask(task *sumTaskConfig) error {
+	workerID, err := m.CreateWorker(sumTaskType, task)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.running[workerID] = task
+	return nil
+}
+
+func (m *sumJobMaster) InitImpl(_ context.Context) error {
+	for _, task := range m.tasks {
+		if err := m.createTask(task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnMasterRecovered is called instead of InitImpl after the job master fails
+// over, a real job should persist its progress by MetaKVClient and load it here.
+func (m *sumJobMaster) OnMasterRecovered(_ context.Context) error {
+	return nil
+}
+
+func (m *sumJobMaster) Tick(ctx context.Context) error {
+	m.mu.Lock()
+	finished, canceled := m.finished, m.canceled
+	m.mu.Unlock()
+
+	if canceled {
+		return m.Exit(ctx, sdk.ExitReasonCanceled, nil, []byte("sum job is canceled"))
+	}
+	if finished == len(m.tasks) {
+		return m.Exit(ctx, sdk.ExitReasonFinished, nil, []byte("all parts are summed"))
+	}
+	return nil
+}
+
+func (m *sumJobMaster) OnWorkerDispatched(_ sdk.WorkerHandle, result error) error {
+	return result
+}
+
+func (m *sumJobMaster) OnWorkerOnline(_ sdk.WorkerHandle) error {
+	return nil
+}
+
+// OnWorkerOffline recreates the worker unless it's finished.
+func (m *sumJobMaster) OnWorkerOffline(worker sdk.WorkerHandle, reason error) error {
+	m.mu.Lock()
+	task := m.running[worker.ID()]
+	delete(m.running, worker.ID())
+	if sdk.IsWorkerFinished(reason) {
+		m.finished++
+		log.Printf("worker %s is finished, sum: %s", worker.ID(), worker.Status().ExtBytes)
+	}
+	m.mu.Unlock()
+
+	if sdk.IsWorkerFinished(reason) || task == nil {
+		return nil
+	}
+	return m.createTask(task)
+}
+
+func (m *sumJobMaster) OnWorkerMessage(_ sdk.WorkerHandle, _ sdk.Topic, _ interface{}) error {
+	return nil
+}
+
+func (m *sumJobMaster) OnWorkerStatusUpdated(_ sdk.WorkerHandle, _ *sdk.WorkerStatus) error {
+	return nil
+}
+
+func (m *sumJobMaster) CloseImpl(_ context.Context) {}
+
+func (m *sumJobMaster) StopImpl(_ context.Context) {}
+
+func (m *sumJobMaster) OnMasterMessage(_ context.Context, _ sdk.Topic, _ sdk.MessageValue) error {
+	return nil
+}
+
+func (m *sumJobMaster) OnCancel(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.canceled = true
+	return nil
+}
+
+func (m *sumJobMaster) OnOpenAPIInitialized(_ *sdk.RouterGroup) {}
+
+func (m *sumJobMaster) IsJobMasterImpl() {}
+
+// sumTask is the worker of the sum job, which sums a part of the integers.
+type sumTask struct {
+	sdk.BaseWorker
+
+	config *sumTaskConfig
+}
+
+var _ sdk.Worker = (*sumTask)(nil)
+
+func newSumTask(_ *sdk.Context, _ sdk.WorkerID, _ sdk.MasterID, config *sumTaskConfig) *sumTask {
+	return &sumTask{config: config}
+}
+
+func (t *sumTask) InitImpl(_ context.Context) error {
+	return nil
+}
+
+func (t *sumTask) Tick(ctx context.Context) error {
+	sum := 0
+	for i := t.config.From; i < t.config.To; i++ {
+		sum += i
+	}
+	return t.Exit(ctx, sdk.ExitReasonFinished, nil, []byte(fmt.Sprint(sum)))
+}
+
+func (t *sumTask) OnMasterMessage(_ context.Context, _ sdk.Topic, _ sdk.MessageValue) error {
+	return nil
+}
+
+func (t *sumTask) CloseImpl(_ context.Context) {}
+
+// This example registers a job type in a separate binary and runs an
+// executor for it. A job of the type can be created with
+// `{"type": 1001, "config": "<base64 of {"n": 100, "parts": 4}>"}`
+// by the OpenAPI of the server master.
+func Example() {
+	sdk.MustRegisterJobType(sdk.JobTypeSpec{
+		Type:      sumJobType,
+		Name:      "Sum",
+		JobMaster: sdk.NewWorkerFactory(newSumJobMaster),
+		Workers: []sdk.WorkerTypeSpec{
+			{Type: sumTaskType, Name: "SumTask", Factory: sdk.NewWorkerFactory(newSumTask)},
+		},
+	})
+
+	cfg := sdk.NewExecutorConfig()
+	cfg.Join = "127.0.0.1:10240"
+	cfg.Addr = "0.0.0.0:10241"
+	if err := sdk.RunExecutor(context.Background(), cfg); err != nil {
+		log.Fatal(err)
+	}
+}
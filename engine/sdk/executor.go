@@ -0,0 +1,55 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/pingcap/tiflow/engine/executor"
+	engineModel "github.com/pingcap/tiflow/engine/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// ExecutorConfig is the config of an executor.
+type ExecutorConfig = executor.Config
+
+// NewExecutorConfig returns the default config of an executor, the fields
+// can be changed before calling RunExecutor.
+func NewExecutorConfig() *ExecutorConfig {
+	return executor.GetDefaultExecutorConfig()
+}
+
+// RunExecutor runs an executor which can run the registered user-defined job
+// types besides the built-in ones, it blocks until ctx is canceled or the
+// executor exits with an error.
+//
+// The executor joins the cluster of the server masters in cfg.Join. The
+// registered job types are added to the labels of the executor, which are
+// sent to the server master when the executor registers itself, so that the
+// jobs of the types are only dispatched to the executors which can run them.
+// More job types can be registered at runtime by the plugins served by
+// ServePlugin, their labels are sent to the server master by the heartbeats.
+func RunExecutor(ctx context.Context, cfg *ExecutorConfig) error {
+	if cfg.Labels == nil {
+		cfg.Labels = make(map[string]string)
+	}
+	for _, tp := range RegisteredJobTypes() {
+		cfg.Labels[engineModel.CustomJobTypeLabelKey(tp)] = engineModel.CustomJobTypeLabelValue
+	}
+	if err := cfg.Adjust(); err != nil {
+		return errors.Trace(err)
+	}
+
+	return executor.NewServer(cfg).Run(ctx)
+}
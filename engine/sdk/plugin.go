@@ -0,0 +1,440 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const defaultPluginRegisterInterval = 10 * time.Second
+
+// RemoteJobMaster is the job master of a job type served by a plugin. Its
+// callbacks are forwarded from the executor, which runs a proxy of the job
+// master, they're the same as the callbacks of JobMaster.
+type RemoteJobMaster interface {
+	// Init is called when the job is created.
+	Init(ctx context.Context) error
+	// Recover is called instead of Init when the job master fails over.
+	Recover(ctx context.Context) error
+	Tick(ctx context.Context) error
+	OnWorkerDispatched(ctx context.Context, workerID WorkerID, result error) error
+	OnWorkerOnline(ctx context.Context, workerID WorkerID, status *WorkerStatus) error
+	// OnWorkerOffline is called when a worker is offline, reason is the error
+	// message of the worker.
+	OnWorkerOffline(ctx context.Context, workerID WorkerID, status *WorkerStatus, reason error) error
+	OnWorkerStatusUpdated(ctx context.Context, workerID WorkerID, status *WorkerStatus) error
+	OnCancel(ctx context.Context) error
+	OnPause(ctx context.Context) error
+	// Close is called when the job master exits or fails, no other callbacks
+	// are called after it.
+	Close(ctx context.Context)
+}
+
+// RemoteWorker is a worker of a job type served by a plugin. Its callbacks are
+// forwarded from the executor, which runs a proxy of the worker, they're the
+// same as the callbacks of Worker.
+type RemoteWorker interface {
+	Init(ctx context.Context) error
+	Tick(ctx context.Context) error
+	// OnMasterMessage is called when the worker receives a message from its
+	// master, the message is encoded in JSON.
+	OnMasterMessage(ctx context.Context, topic Topic, message []byte) error
+	// Close is called when the worker exits or fails, no other callbacks are
+	// called after it.
+	Close(ctx context.Context)
+}
+
+// RemoteHandle is used by a RemoteJobMaster or a RemoteWorker to call the
+// framework through the executor which runs its proxy, like BaseJobMaster and
+// BaseWorker.
+type RemoteHandle struct {
+	id       WorkerID
+	masterID MasterID
+	client   pb.JobTypeHostClient
+}
+
+// ID returns the ID of the job master or the worker, the ID of a job master
+// is the job ID.
+func (h *RemoteHandle) ID() WorkerID {
+	return h.id
+}
+
+// MasterID returns the ID of the master.
+func (h *RemoteHandle) MasterID() MasterID {
+	return h.masterID
+}
+
+// CreateWorker creates a worker of the job master, the config must be encoded
+// in JSON. It can only be called by a RemoteJobMaster.
+func (h *RemoteHandle) CreateWorker(
+	ctx context.Context, tp WorkerType, config []byte, resources ...string,
+) (WorkerID, error) {
+	resp, err := h.client.CreateWorker(ctx, &pb.PluginCreateWorkerRequest{
+		MasterId:   h.id,
+		WorkerType: int64(tp),
+		Config:     config,
+		Resources:  resources,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetWorkerId(), nil
+}
+
+// UpdateStatus updates the status of the job master or the worker.
+func (h *RemoteHandle) UpdateStatus(ctx context.Context, workerStatus WorkerStatus) error {
+	_, err := h.client.UpdateStatus(ctx, &pb.PluginUpdateStatusRequest{
+		WorkerId: h.id,
+		Status:   toPBWorkerStatus(&workerStatus),
+	})
+	return err
+}
+
+// Exit exits the job master or the worker.
+func (h *RemoteHandle) Exit(ctx context.Context, reason ExitReason, err error, detail []byte) error {
+	req := &pb.PluginExitRequest{
+		WorkerId: h.id,
+		Reason:   int32(reason),
+		Detail:   detail,
+	}
+	if err != nil {
+		req.ErrorMessage = err.Error()
+	}
+	_, err = h.client.Exit(ctx, req)
+	return err
+}
+
+// RemoteJobTypeSpec describes a job type served by a plugin.
+type RemoteJobTypeSpec struct {
+	// Type is the number of the job type in [JobTypeBase, JobTypeMax].
+	Type JobType
+	// Name is the name of the job type, it's used in logs and metrics.
+	Name string
+	// JobMaster creates the job master of a job, config is the config of
+	// the job passed to the CreateJob API.
+	JobMaster func(handle *RemoteHandle, config []byte) (RemoteJobMaster, error)
+	// Workers are the types of the workers created by the job master.
+	Workers []RemoteWorkerTypeSpec
+}
+
+// RemoteWorkerTypeSpec describes a worker type of a job type served by a plugin.
+type RemoteWorkerTypeSpec struct {
+	// Type is the number of the worker type in [WorkerTypeBase, WorkerTypeMax],
+	// it must be different from all the job types.
+	Type WorkerType
+	// Name is the name of the worker type, it's used in logs.
+	Name string
+	// Factory creates a worker, config is passed to RemoteHandle.CreateWorker
+	// by the job master.
+	Factory func(handle *RemoteHandle, config []byte) (RemoteWorker, error)
+}
+
+// PluginConfig is the config of a plugin.
+type PluginConfig struct {
+	// ExecutorAddr is the address of the executor which runs the job types of
+	// the plugin, a plugin usually runs beside its executor.
+	ExecutorAddr string
+	// ListenAddr is the address the plugin listens on.
+	ListenAddr string
+	// AdvertiseAddr is the address the executor connects to, it's ListenAddr
+	// by default.
+	AdvertiseAddr string
+	// RegisterInterval is the interval to register the job types, so that they
+	// are registered again after the executor restarts.
+	RegisterInterval time.Duration
+}
+
+// ServePlugin serves the job types in a plugin, which is a separate binary
+// from the executor. It registers the job types in the executor at runtime,
+// and blocks until ctx is canceled or the job types can't be registered.
+func ServePlugin(ctx context.Context, cfg *PluginConfig, specs ...RemoteJobTypeSpec) error {
+	for i := range specs {
+		if err := specs[i].validate(); err != nil {
+			return err
+		}
+	}
+
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	conn, err := grpc.Dial(cfg.ExecutorAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		_ = lis.Close()
+		return errors.Trace(err)
+	}
+	defer conn.Close()
+
+	advertiseAddr := cfg.AdvertiseAddr
+	if advertiseAddr == "" {
+		advertiseAddr = lis.Addr().String()
+	}
+	interval := cfg.RegisterInterval
+	if interval == 0 {
+		interval = defaultPluginRegisterInterval
+	}
+
+	srv := newPluginServer(pb.NewJobTypeHostClient(conn), specs)
+	grpcSrv := grpc.NewServer()
+	pb.RegisterJobTypePluginServer(grpcSrv, srv)
+
+	wg, ctx := errgroup.WithContext(ctx)
+	wg.Go(func() error {
+		return grpcSrv.Serve(lis)
+	})
+	wg.Go(func() error {
+		defer grpcSrv.Stop()
+		return srv.keepRegistering(ctx, advertiseAddr, interval)
+	})
+	return wg.Wait()
+}
+
+type pluginServer struct {
+	client  pb.JobTypeHostClient
+	specs   []RemoteJobTypeSpec
+	masters map[WorkerType]*RemoteJobTypeSpec
+	workers map[WorkerType]*RemoteWorkerTypeSpec
+
+	mu         sync.Mutex
+	jobMasters map[WorkerID]RemoteJobMaster
+	instances  map[WorkerID]RemoteWorker
+}
+
+func newPluginServer(client pb.JobTypeHostClient, specs []RemoteJobTypeSpec) *pluginServer {
+	s := &pluginServer{
+		client:     client,
+		specs:      specs,
+		masters:    make(map[WorkerType]*RemoteJobTypeSpec),
+		workers:    make(map[WorkerType]*RemoteWorkerTypeSpec),
+		jobMasters: make(map[WorkerID]RemoteJobMaster),
+		instances:  make(map[WorkerID]RemoteWorker),
+	}
+	for i := range specs {
+		s.masters[WorkerType(specs[i].Type)] = &specs[i]
+		for j := range specs[i].Workers {
+			s.workers[specs[i].Workers[j].Type] = &specs[i].Workers[j]
+		}
+	}
+	return s
+}
+
+// keepRegistering registers the job types periodically until ctx is canceled.
+// The executor returns an error which isn't retryable if the job types conflict
+// with the registered ones.
+func (s *pluginServer) keepRegistering(ctx context.Context, addr string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for i := range s.specs {
+			err := s.register(ctx, &s.specs[i], addr)
+			switch status.Code(err) {
+			case codes.OK:
+			case codes.InvalidArgument, codes.FailedPrecondition, codes.AlreadyExists:
+				return errors.Trace(err)
+			default:
+				log.Warn("failed to register job type",
+					zap.Stringer("job-type", s.specs[i].Type), zap.Error(err))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *pluginServer) register(ctx context.Context, spec *RemoteJobTypeSpec, addr string) error {
+	req := &pb.RegisterJobTypeRequest{
+		JobType:    int64(spec.Type),
+		Name:       spec.Name,
+		PluginAddr: addr,
+	}
+	for _, w := range spec.Workers {
+		req.WorkerTypes = append(req.WorkerTypes, &pb.PluginWorkerType{Type: int64(w.Type), Name: w.Name})
+	}
+	_, err := s.client.RegisterJobType(ctx, req)
+	return err
+}
+
+// Call implements pb.JobTypePluginServer.Call
+func (s *pluginServer) Call(ctx context.Context, req *pb.PluginCallRequest) (*pb.PluginCallResponse, error) {
+	tp := WorkerType(req.GetWorkerType())
+	var err error
+	if _, ok := s.masters[tp]; ok {
+		err = s.callJobMaster(ctx, req)
+	} else if _, ok := s.workers[tp]; ok {
+		err = s.callWorker(ctx, req)
+	} else {
+		return nil, status.Errorf(codes.NotFound, "worker type %d is not served by the plugin", tp)
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+	return &pb.PluginCallResponse{}, nil
+}
+
+func (s *pluginServer) callJobMaster(ctx context.Context, req *pb.PluginCallRequest) error {
+	id := req.GetWorkerId()
+	if req.GetCallback() == pb.PluginCallRequest_Init || req.GetCallback() == pb.PluginCallRequest_Recover {
+		spec := s.masters[WorkerType(req.GetWorkerType())]
+		m, err := spec.JobMaster(s.newHandle(req), req.GetConfig())
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		s.jobMasters[id] = m
+		s.mu.Unlock()
+		if req.GetCallback() == pb.PluginCallRequest_Init {
+			return m.Init(ctx)
+		}
+		return m.Recover(ctx)
+	}
+
+	s.mu.Lock()
+	m, ok := s.jobMasters[id]
+	if req.GetCallback() == pb.PluginCallRequest_Close {
+		delete(s.jobMasters, id)
+	}
+	s.mu.Unlock()
+	if !ok {
+		// The plugin may be restarted, the proxy of the job master is closed by
+		// the error and then recovered.
+		if req.GetCallback() == pb.PluginCallRequest_Close {
+			return nil
+		}
+		return status.Errorf(codes.NotFound, "job master %s is not found", id)
+	}
+
+	workerStatus := fromPBWorkerStatus(req.GetEventWorkerStatus())
+	switch req.GetCallback() {
+	case pb.PluginCallRequest_Tick:
+		return m.Tick(ctx)
+	case pb.PluginCallRequest_WorkerDispatched:
+		return m.OnWorkerDispatched(ctx, req.GetEventWorkerId(), toError(req.GetErrorMessage()))
+	case pb.PluginCallRequest_WorkerOnline:
+		return m.OnWorkerOnline(ctx, req.GetEventWorkerId(), workerStatus)
+	case pb.PluginCallRequest_WorkerOffline:
+		return m.OnWorkerOffline(ctx, req.GetEventWorkerId(), workerStatus, toError(req.GetErrorMessage()))
+	case pb.PluginCallRequest_WorkerStatusUpdated:
+		return m.OnWorkerStatusUpdated(ctx, req.GetEventWorkerId(), workerStatus)
+	case pb.PluginCallRequest_Cancel:
+		return m.OnCancel(ctx)
+	case pb.PluginCallRequest_Pause:
+		return m.OnPause(ctx)
+	case pb.PluginCallRequest_Close:
+		m.Close(ctx)
+		return nil
+	default:
+		return errors.ErrInvalidArgument.GenWithStackByArgs("unexpected callback " + req.GetCallback().String())
+	}
+}
+
+func (s *pluginServer) callWorker(ctx context.Context, req *pb.PluginCallRequest) error {
+	id := req.GetWorkerId()
+	if req.GetCallback() == pb.PluginCallRequest_Init {
+		spec := s.workers[WorkerType(req.GetWorkerType())]
+		w, err := spec.Factory(s.newHandle(req), req.GetConfig())
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		s.instances[id] = w
+		s.mu.Unlock()
+		return w.Init(ctx)
+	}
+
+	s.mu.Lock()
+	w, ok := s.instances[id]
+	if req.GetCallback() == pb.PluginCallRequest_Close {
+		delete(s.instances, id)
+	}
+	s.mu.Unlock()
+	if !ok {
+		if req.GetCallback() == pb.PluginCallRequest_Close {
+			return nil
+		}
+		return status.Errorf(codes.NotFound, "worker %s is not found", id)
+	}
+
+	switch req.GetCallback() {
+	case pb.PluginCallRequest_Tick:
+		return w.Tick(ctx)
+	case pb.PluginCallRequest_MasterMessage:
+		return w.OnMasterMessage(ctx, req.GetTopic(), req.GetMessage())
+	case pb.PluginCallRequest_Close:
+		w.Close(ctx)
+		return nil
+	default:
+		return errors.ErrInvalidArgument.GenWithStackByArgs("unexpected callback " + req.GetCallback().String())
+	}
+}
+
+func (s *pluginServer) newHandle(req *pb.PluginCallRequest) *RemoteHandle {
+	return &RemoteHandle{
+		id:       req.GetWorkerId(),
+		masterID: req.GetMasterId(),
+		client:   s.client,
+	}
+}
+
+func (s *RemoteJobTypeSpec) validate() error {
+	workers := make([]workerTypeInfo, 0, len(s.Workers))
+	for _, w := range s.Workers {
+		workers = append(workers, workerTypeInfo{tp: w.Type, name: w.Name, hasFactory: w.Factory != nil})
+	}
+	return validateJobType(s.Type, s.Name, s.JobMaster != nil, workers)
+}
+
+func toError(msg string) error {
+	if msg == "" {
+		return nil
+	}
+	return errors.New(msg)
+}
+
+func toPBWorkerStatus(s *WorkerStatus) *pb.PluginWorkerStatus {
+	return &pb.PluginWorkerStatus{
+		State:        int32(s.State),
+		ErrorMessage: s.ErrorMsg,
+		ExtBytes:     s.ExtBytes,
+	}
+}
+
+func fromPBWorkerStatus(s *pb.PluginWorkerStatus) *WorkerStatus {
+	if s == nil {
+		return nil
+	}
+	return &WorkerStatus{
+		State:    WorkerState(s.GetState()),
+		ErrorMsg: s.GetErrorMessage(),
+		ExtBytes: s.GetExtBytes(),
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type mockJobTypeHost struct {
+	mu            sync.Mutex
+	registered    []*pb.RegisterJobTypeRequest
+	createWorkers []*pb.PluginCreateWorkerRequest
+}
+
+func (h *mockJobTypeHost) RegisterJobType(
+	_ context.Context, req *pb.RegisterJobTypeRequest,
+) (*pb.RegisterJobTypeResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.registered = append(h.registered, req)
+	return &pb.RegisterJobTypeResponse{}, nil
+}
+
+func (h *mockJobTypeHost) CreateWorker(
+	_ context.Context, req *pb.PluginCreateWorkerRequest,
+) (*pb.PluginCreateWorkerResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.createWorkers = append(h.createWorkers, req)
+	return &pb.PluginCreateWorkerResponse{WorkerId: "worker-1"}, nil
+}
+
+func (h *mockJobTypeHost) UpdateStatus(
+	_ context.Context, _ *pb.PluginUpdateStatusRequest,
+) (*pb.PluginUpdateStatusResponse, error) {
+	return &pb.PluginUpdateStatusResponse{}, nil
+}
+
+func (h *mockJobTypeHost) Exit(_ context.Context, _ *pb.PluginExitRequest) (*pb.PluginExitResponse, error) {
+	return &pb.PluginExitResponse{}, nil
+}
+
+func (h *mockJobTypeHost) getRegistered() []*pb.RegisterJobTypeRequest {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*pb.RegisterJobTypeRequest(nil), h.registered...)
+}
+
+func (h *mockJobTypeHost) getCreateWorkers() []*pb.PluginCreateWorkerRequest {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*pb.PluginCreateWorkerRequest(nil), h.createWorkers...)
+}
+
+type testRemoteJobMaster struct {
+	handle *RemoteHandle
+	config []byte
+
+	mu     sync.Mutex
+	ticks  int
+	closed bool
+}
+
+func (m *testRemoteJobMaster) Init(ctx context.Context) error {
+	_, err := m.handle.CreateWorker(ctx, WorkerTypeBase+1300, m.config)
+	return err
+}
+
+func (m *testRemoteJobMaster) Recover(_ context.Context) error { return nil }
+
+func (m *testRemoteJobMaster) Tick(_ context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ticks++
+	return nil
+}
+
+func (m *testRemoteJobMaster) OnWorkerDispatched(_ context.Context, _ WorkerID, result error) error {
+	return result
+}
+
+func (m *testRemoteJobMaster) OnWorkerOnline(_ context.Context, _ WorkerID, _ *WorkerStatus) error {
+	return nil
+}
+
+func (m *testRemoteJobMaster) OnWorkerOffline(_ context.Context, _ WorkerID, _ *WorkerStatus, _ error) error {
+	return nil
+}
+
+func (m *testRemoteJobMaster) OnWorkerStatusUpdated(_ context.Context, _ WorkerID, _ *WorkerStatus) error {
+	return nil
+}
+
+func (m *testRemoteJobMaster) OnCancel(_ context.Context) error { return nil }
+
+func (m *testRemoteJobMaster) OnPause(_ context.Context) error { return nil }
+
+func (m *testRemoteJobMaster) Close(_ context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+}
+
+func TestServePlugin(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host := &mockJobTypeHost{}
+	hostSrv := grpc.NewServer()
+	pb.RegisterJobTypeHostServer(hostSrv, host)
+	go func() {
+		_ = hostSrv.Serve(lis)
+	}()
+	defer hostSrv.Stop()
+
+	masterCh := make(chan *testRemoteJobMaster, 1)
+	spec := RemoteJobTypeSpec{
+		Type: JobTypeBase + 300,
+		Name: "RemoteTest",
+		JobMaster: func(handle *RemoteHandle, config []byte) (RemoteJobMaster, error) {
+			master := &testRemoteJobMaster{handle: handle, config: config}
+			masterCh <- master
+			return master, nil
+		},
+		Workers: []RemoteWorkerTypeSpec{{
+			Type: WorkerTypeBase + 1300,
+			Name: "RemoteTestTask",
+			Factory: func(_ *RemoteHandle, _ []byte) (RemoteWorker, error) {
+				return nil, nil
+			},
+		}},
+	}
+	cfg := &PluginConfig{
+		ExecutorAddr:     lis.Addr().String(),
+		ListenAddr:       "127.0.0.1:0",
+		RegisterInterval: 10 * time.Millisecond,
+	}
+	require.Error(t, ServePlugin(context.Background(), cfg, RemoteJobTypeSpec{Type: JobTypeBase + 300}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ServePlugin(ctx, cfg, spec)
+	}()
+
+	// the job types are registered periodically
+	require.Eventually(t, func() bool {
+		return len(host.getRegistered()) >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	req := host.getRegistered()[0]
+	require.Equal(t, int64(spec.Type), req.JobType)
+	require.Equal(t, "RemoteTest", req.Name)
+	require.Len(t, req.WorkerTypes, 1)
+	require.Equal(t, int64(WorkerTypeBase+1300), req.WorkerTypes[0].Type)
+	require.Equal(t, "RemoteTestTask", req.WorkerTypes[0].Name)
+
+	conn, err := grpc.Dial(req.PluginAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewJobTypePluginClient(conn)
+	call := func(callback pb.PluginCallRequest_Callback, config []byte) error {
+		_, err := client.Call(context.Background(), &pb.PluginCallRequest{
+			Callback:   callback,
+			WorkerId:   "job-1",
+			MasterId:   "jobmanager",
+			WorkerType: int64(spec.Type),
+			Config:     config,
+		})
+		return err
+	}
+
+	err = call(pb.PluginCallRequest_Tick, nil)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.NoError(t, call(pb.PluginCallRequest_Init, []byte(`{"a":1}`)))
+	master := <-masterCh
+	require.Equal(t, "job-1", master.handle.ID())
+	require.Equal(t, "jobmanager", master.handle.MasterID())
+	createWorkers := host.getCreateWorkers()
+	require.Len(t, createWorkers, 1)
+	require.Equal(t, "job-1", createWorkers[0].MasterId)
+	require.Equal(t, int64(WorkerTypeBase+1300), createWorkers[0].WorkerType)
+	require.Equal(t, []byte(`{"a":1}`), createWorkers[0].Config)
+
+	require.NoError(t, call(pb.PluginCallRequest_Tick, nil))
+	require.NoError(t, call(pb.PluginCallRequest_Close, nil))
+	master.mu.Lock()
+	require.Equal(t, 1, master.ticks)
+	require.True(t, master.closed)
+	master.mu.Unlock()
+	err = call(pb.PluginCallRequest_Tick, nil)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	// closing a job master which isn't found is a no-op
+	require.NoError(t, call(pb.PluginCallRequest_Close, nil))
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
@@ -0,0 +1,248 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/framework/registry"
+	engineModel "github.com/pingcap/tiflow/engine/model"
+	dcontext "github.com/pingcap/tiflow/engine/pkg/context"
+	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+type (
+	// JobType is the type of a job.
+	JobType = engineModel.JobType
+	// WorkerType is the type of a worker or a job master.
+	WorkerType = frameModel.WorkerType
+	// JobID is the ID of a job, it's also the ID of the job master.
+	JobID = engineModel.JobID
+	// WorkerID is the ID of a worker.
+	WorkerID = frameModel.WorkerID
+	// MasterID is the ID of the master of a worker.
+	MasterID = frameModel.MasterID
+
+	// JobMaster is the interface to be implemented by the job master of a
+	// user-defined job type.
+	JobMaster = framework.JobMasterImpl
+	// BaseJobMaster is embedded in the job master, it's filled in by the
+	// framework.
+	BaseJobMaster = framework.BaseJobMaster
+	// Worker is the interface to be implemented by the workers of a
+	// user-defined job type.
+	Worker = framework.WorkerImpl
+	// BaseWorker is embedded in the worker, it's filled in by the framework.
+	BaseWorker = framework.BaseWorker
+	// WorkerHandle is the handle of a worker in the job master.
+	WorkerHandle = framework.WorkerHandle
+	// WorkerStatus is the status of a worker reported to its master.
+	WorkerStatus = frameModel.WorkerStatus
+	// WorkerState is the state in WorkerStatus.
+	WorkerState = frameModel.WorkerState
+	// CreateWorkerOpt specifies an option for creating a worker.
+	CreateWorkerOpt = framework.CreateWorkerOpt
+	// ExitReason is the reason why a job master or a worker exits.
+	ExitReason = framework.ExitReason
+
+	// RouterGroup is used by JobMaster.OnOpenAPIInitialized to register the
+	// OpenAPI handlers of a job, which are served under /api/v1/jobs/{job_id}/.
+	RouterGroup = gin.RouterGroup
+
+	// Topic is the topic of a message between a master and its workers.
+	Topic = p2p.Topic
+	// MessageValue is the value of a message between a master and its workers.
+	MessageValue = p2p.MessageValue
+
+	// Context provides the dependencies to the job masters and workers when
+	// they are created.
+	Context = dcontext.Context
+	// WorkerFactory creates the job masters or workers of a type.
+	WorkerFactory = registry.WorkerFactory
+)
+
+// Defines the ranges of the user-defined job types and worker types.
+const (
+	JobTypeBase    = engineModel.JobTypeCustomBase
+	JobTypeMax     = engineModel.JobTypeCustomMax
+	WorkerTypeBase = frameModel.CustomWorkerTypeBase
+	WorkerTypeMax  = frameModel.CustomWorkerTypeMax
+)
+
+// Defines all ExitReason
+const (
+	ExitReasonFinished = framework.ExitReasonFinished
+	ExitReasonCanceled = framework.ExitReasonCanceled
+	ExitReasonFailed   = framework.ExitReasonFailed
+	ExitReasonPaused   = framework.ExitReasonPaused
+)
+
+// Defines all WorkerState
+const (
+	WorkerStateNormal   = frameModel.WorkerStateNormal
+	WorkerStateInit     = frameModel.WorkerStateInit
+	WorkerStateFinished = frameModel.WorkerStateFinished
+	WorkerStateStopped  = frameModel.WorkerStateStopped
+	WorkerStateError    = frameModel.WorkerStateError
+)
+
+// CreateWorkerWithResourceRequirements specifies the external resources a
+// worker relies on, it's used by BaseJobMaster.CreateWorker.
+var CreateWorkerWithResourceRequirements = framework.CreateWorkerWithResourceRequirements
+
+// NewWorkerFactory creates a WorkerFactory from a constructor of the job
+// master or worker. The config is decoded from JSON, so C must be a pointer
+// to a struct.
+func NewWorkerFactory[T Worker, C any](
+	constructor func(ctx *Context, id WorkerID, masterID MasterID, config C) T,
+) WorkerFactory {
+	return registry.NewSimpleWorkerFactory[T, C](constructor)
+}
+
+// IsWorkerFinished returns whether a worker is offline because it exits
+// with ExitReasonFinished, reason is passed by JobMaster.OnWorkerOffline.
+func IsWorkerFinished(reason error) bool {
+	return errors.Is(reason, errors.ErrWorkerFinish)
+}
+
+// IsWorkerCanceled returns whether a worker is offline because it exits
+// with ExitReasonCanceled, reason is passed by JobMaster.OnWorkerOffline.
+func IsWorkerCanceled(reason error) bool {
+	return errors.Is(reason, errors.ErrWorkerCancel)
+}
+
+// JobTypeSpec describes a user-defined job type.
+type JobTypeSpec struct {
+	// Type is the number of the job type in [JobTypeBase, JobTypeMax].
+	Type JobType
+	// Name is the name of the job type, it's used in logs and metrics.
+	Name string
+	// JobMaster creates the job master of the job type.
+	JobMaster WorkerFactory
+	// Workers are the types of the workers created by the job master.
+	Workers []WorkerTypeSpec
+}
+
+// WorkerTypeSpec describes a worker type of a user-defined job type.
+type WorkerTypeSpec struct {
+	// Type is the number of the worker type in [WorkerTypeBase, WorkerTypeMax],
+	// it must be different from all the job types.
+	Type WorkerType
+	// Name is the name of the worker type, it's used in logs.
+	Name string
+	// Factory creates the workers of the worker type.
+	Factory WorkerFactory
+}
+
+var registeredJobTypes struct {
+	sync.Mutex
+	types []JobType
+}
+
+// RegisterJobType registers a user-defined job type, so that the executor
+// run by RunExecutor can run the jobs of the type. It should be called
+// before RunExecutor, usually in an init function.
+func RegisterJobType(spec JobTypeSpec) error {
+	if err := spec.validate(); err != nil {
+		return err
+	}
+
+	if err := engineModel.RegisterCustomJobType(spec.Type, spec.Name); err != nil {
+		return err
+	}
+	if err := registerWorkerType(WorkerType(spec.Type), spec.Name+"JobMaster", spec.Type, spec.JobMaster); err != nil {
+		return err
+	}
+	for _, w := range spec.Workers {
+		if err := registerWorkerType(w.Type, w.Name, spec.Type, w.Factory); err != nil {
+			return err
+		}
+	}
+
+	registeredJobTypes.Lock()
+	defer registeredJobTypes.Unlock()
+	registeredJobTypes.types = append(registeredJobTypes.types, spec.Type)
+	return nil
+}
+
+// MustRegisterJobType is like RegisterJobType but panics on error.
+func MustRegisterJobType(spec JobTypeSpec) {
+	if err := RegisterJobType(spec); err != nil {
+		panic(err)
+	}
+}
+
+// RegisteredJobTypes returns the registered user-defined job types.
+func RegisteredJobTypes() []JobType {
+	registeredJobTypes.Lock()
+	defer registeredJobTypes.Unlock()
+	return append([]JobType(nil), registeredJobTypes.types...)
+}
+
+func (s *JobTypeSpec) validate() error {
+	workers := make([]workerTypeInfo, 0, len(s.Workers))
+	for _, w := range s.Workers {
+		workers = append(workers, workerTypeInfo{tp: w.Type, name: w.Name, hasFactory: w.Factory != nil})
+	}
+	return validateJobType(s.Type, s.Name, s.JobMaster != nil, workers)
+}
+
+type workerTypeInfo struct {
+	tp         WorkerType
+	name       string
+	hasFactory bool
+}
+
+// validateJobType validates the spec of a job type, it's shared by the job
+// types linked into the executor and the ones served by the plugins.
+func validateJobType(tp JobType, name string, hasJobMaster bool, workers []workerTypeInfo) error {
+	if !tp.IsCustom() {
+		return errors.ErrInvalidArgument.GenWithStackByArgs("job type must be in [JobTypeBase, JobTypeMax]")
+	}
+	if name == "" {
+		return errors.ErrInvalidArgument.GenWithStackByArgs("job type name must not be empty")
+	}
+	if !hasJobMaster {
+		return errors.ErrInvalidArgument.GenWithStackByArgs("job master factory must not be nil")
+	}
+
+	workerTypes := map[WorkerType]struct{}{WorkerType(tp): {}}
+	for _, w := range workers {
+		if !w.tp.IsCustom() {
+			return errors.ErrInvalidArgument.GenWithStackByArgs("worker type must be in [WorkerTypeBase, WorkerTypeMax]")
+		}
+		if _, ok := workerTypes[w.tp]; ok {
+			return errors.ErrInvalidArgument.GenWithStackByArgs("duplicate worker type " + w.tp.String())
+		}
+		workerTypes[w.tp] = struct{}{}
+		if w.name == "" || !w.hasFactory {
+			return errors.ErrInvalidArgument.GenWithStackByArgs("worker type name and factory must be specified")
+		}
+	}
+	return nil
+}
+
+func registerWorkerType(tp WorkerType, name string, jobType JobType, factory WorkerFactory) error {
+	if err := framework.RegisterCustomWorkerType(tp, name, jobType); err != nil {
+		return err
+	}
+	if ok := registry.GlobalWorkerRegistry().RegisterWorkerType(tp, factory); !ok {
+		return errors.ErrInvalidArgument.GenWithStackByArgs("duplicate worker type " + name)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/engine/framework"
+	"github.com/pingcap/tiflow/engine/framework/registry"
+	engineModel "github.com/pingcap/tiflow/engine/model"
+	"github.com/stretchr/testify/require"
+)
+
+type testConfig struct{}
+
+type testWorker struct {
+	BaseWorker
+}
+
+func newTestWorker(_ *Context, _ WorkerID, _ MasterID, _ *testConfig) *testWorker {
+	return &testWorker{}
+}
+
+func (w *testWorker) InitImpl(_ context.Context) error { return nil }
+
+func (w *testWorker) Tick(_ context.Context) error { return nil }
+
+func (w *testWorker) OnMasterMessage(_ context.Context, _ Topic, _ MessageValue) error {
+	return nil
+}
+
+func (w *testWorker) CloseImpl(_ context.Context) {}
+
+func TestRegisterJobType(t *testing.T) {
+	t.Parallel()
+
+	factory := NewWorkerFactory(newTestWorker)
+	for _, spec := range []JobTypeSpec{
+		{Type: engineModel.JobTypeDM, Name: "Test", JobMaster: factory},
+		{Type: JobTypeBase + 100, JobMaster: factory},
+		{Type: JobTypeBase + 100, Name: "Test"},
+		{
+			Type: JobTypeBase + 100, Name: "Test", JobMaster: factory,
+			Workers: []WorkerTypeSpec{{Type: WorkerTypeBase + 100, Name: "TestTask", Factory: factory}},
+		},
+		{
+			Type: JobTypeBase + 100, Name: "Test", JobMaster: factory,
+			Workers: []WorkerTypeSpec{{Type: WorkerTypeBase + 1100, Factory: factory}},
+		},
+	} {
+		require.Error(t, RegisterJobType(spec))
+	}
+
+	spec := JobTypeSpec{
+		Type: JobTypeBase + 100, Name: "Test", JobMaster: factory,
+		Workers: []WorkerTypeSpec{{Type: WorkerTypeBase + 1100, Name: "TestTask", Factory: factory}},
+	}
+	require.NoError(t, RegisterJobType(spec))
+	require.Error(t, RegisterJobType(spec))
+	require.Contains(t, RegisteredJobTypes(), JobTypeBase+100)
+
+	require.Equal(t, "Test", spec.Type.String())
+	require.Equal(t, "TestJobMaster", WorkerType(spec.Type).String())
+	require.Equal(t, "TestTask", spec.Workers[0].Type.String())
+	require.Equal(t, spec.Type, framework.MustConvertWorkerType2JobType(spec.Workers[0].Type))
+	retryable, err := registry.GlobalWorkerRegistry().IsRetryableError(context.Canceled, spec.Workers[0].Type)
+	require.NoError(t, err)
+	require.True(t, retryable)
+}
+
+func TestRunExecutorInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewExecutorConfig()
+	cfg.KeepAliveIntervalStr = "invalid"
+	require.Error(t, RunExecutor(context.Background(), cfg))
+}
@@ -26,6 +26,7 @@ import (
 	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/model"
 	metaModel "github.com/pingcap/tiflow/engine/pkg/meta/model"
 	"github.com/pingcap/tiflow/engine/servermaster/jobop"
+	"github.com/pingcap/tiflow/engine/servermaster/scheduler"
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/logutil"
 	"github.com/pingcap/tiflow/pkg/security"
@@ -79,6 +80,10 @@ type Config struct {
 	Security *security.Credential `toml:"security" json:"security"`
 
 	JobBackoff *jobop.BackoffConfig `toml:"job-backoff" json:"job-backoff"`
+
+	JobPriority *scheduler.PriorityConfig `toml:"job-priority" json:"job-priority"`
+
+	JobEvent *JobEventConfig `toml:"job-event" json:"job-event"`
 }
 
 func (c *Config) String() string {
@@ -140,6 +145,14 @@ func (c *Config) AdjustAndValidate() (err error) {
 		return err
 	}
 
+	if err := c.JobPriority.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.JobEvent.Validate(); err != nil {
+		return err
+	}
+
 	return validation.ValidateStruct(c,
 		validation.Field(&c.FrameworkMeta),
 		validation.Field(&c.BusinessMeta),
@@ -169,6 +182,8 @@ func GetDefaultMasterConfig() *Config {
 		KeepAliveTTLStr:      defaultKeepAliveTTL,
 		KeepAliveIntervalStr: defaultKeepAliveInterval,
 		JobBackoff:           jobop.NewDefaultBackoffConfig(),
+		JobPriority:          scheduler.NewDefaultPriorityConfig(),
+		JobEvent:             NewDefaultJobEventConfig(),
 		Storage:              resModel.DefaultConfig,
 	}
 }
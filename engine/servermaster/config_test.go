@@ -18,6 +18,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
 	"github.com/pingcap/tiflow/pkg/cmd/util"
 	"github.com/pingcap/tiflow/pkg/security"
 	"github.com/stretchr/testify/require"
@@ -129,3 +131,31 @@ func TestExternalStorageConfig(t *testing.T) {
 	err = cfg.AdjustAndValidate()
 	require.NoError(t, err)
 }
+
+func TestJobPriorityConfig(t *testing.T) {
+	t.Parallel()
+	data := `
+[job-priority]
+max-running-jobs = 10
+enable-preemption = true
+[job-priority.job-types]
+DM = "high"
+CVSDemo = "low"
+`
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "master-priority.toml")
+	err := os.WriteFile(filename, []byte(data), 0o600)
+	require.NoError(t, err)
+
+	cfg := GetDefaultMasterConfig()
+	err = util.StrictDecodeFile(filename, "tiflow master", cfg)
+	require.NoError(t, err)
+	require.NoError(t, cfg.AdjustAndValidate())
+	require.Equal(t, 10, cfg.JobPriority.MaxRunningJobs)
+	require.True(t, cfg.JobPriority.EnablePreemption)
+	require.Equal(t, frameModel.PriorityClassHigh, cfg.JobPriority.JobPriority(pb.Job_DM))
+	require.Equal(t, frameModel.PriorityClassNormal, cfg.JobPriority.JobPriority(pb.Job_FakeJob))
+
+	cfg.JobPriority.JobTypes["DM"] = "urgent"
+	require.Error(t, cfg.AdjustAndValidate())
+}
@@ -15,6 +15,7 @@ package servermaster
 
 import (
 	"context"
+	"maps"
 	"sync"
 	"time"
 
@@ -31,6 +32,8 @@ import (
 	"golang.org/x/time/rate"
 )
 
+const updateExecutorTimeout = 5 * time.Second
+
 // ExecutorManager defines an interface to manager all executors
 type ExecutorManager interface {
 	HandleHeartbeat(req *pb.HeartbeatRequest) (*pb.HeartbeatResponse, error)
@@ -140,10 +143,51 @@ func (e *ExecutorManagerImpl) HandleHeartbeat(req *pb.HeartbeatRequest) (*pb.Hea
 	if err := exec.heartbeat(req.Ttl); err != nil {
 		return nil, err
 	}
+	if len(req.Labels) > 0 {
+		if err := e.updateLabels(execID, req.Labels); err != nil {
+			return nil, err
+		}
+	}
 	resp := &pb.HeartbeatResponse{}
 	return resp, nil
 }
 
+// updateLabels updates the labels of an executor if they're changed, e.g.
+// a plugin registers its job types in the executor at runtime.
+func (e *ExecutorManagerImpl) updateLabels(execID model.ExecutorID, labels map[string]string) error {
+	labelSet, err := label.NewSetFromMap(labels)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	exec, ok := e.executors[execID]
+	if !ok || maps.Equal(exec.Labels.ToMap(), labels) {
+		e.mu.Unlock()
+		return nil
+	}
+	executorMeta := exec.Executor
+	e.mu.Unlock()
+
+	// The labels are persisted before they're used, so that they're kept when
+	// the server master fails over. If it fails, the next heartbeat retries.
+	executorMeta.Labels = ormModel.LabelSet(labelSet)
+	ctx, cancel := context.WithTimeout(context.Background(), updateExecutorTimeout)
+	defer cancel()
+	if err := e.metaClient.UpdateExecutor(ctx, &executorMeta); err != nil {
+		log.Warn("failed to update executor labels", zap.String("executor-id", string(execID)), zap.Error(err))
+		return nil
+	}
+
+	e.mu.Lock()
+	if exec, ok := e.executors[execID]; ok {
+		exec.Labels = ormModel.LabelSet(labelSet)
+	}
+	e.mu.Unlock()
+	log.Info("executor labels updated", zap.String("executor-id", string(execID)), zap.Any("labels", labels))
+	return nil
+}
+
 // registerExec registers executor to both executor manager and resource manager.
 // Note that this method must be called with the lock held.
 func (e *ExecutorManagerImpl) registerExecLocked(executorMeta *ormModel.Executor) {
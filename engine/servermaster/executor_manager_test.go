@@ -75,6 +75,27 @@ func TestExecutorManager(t *testing.T) {
 	_, err = mgr.HandleHeartbeat(newHeartbeatReq())
 	require.NoError(t, err)
 
+	// the labels of the executor are updated by the heartbeat.
+	labels := map[string]string{"job-type-1001": "enabled"}
+	metaClient.EXPECT().
+		UpdateExecutor(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, exec *ormModel.Executor) error {
+			require.Equal(t, executor.ID, exec.ID)
+			require.Equal(t, labels, exec.Labels.ToMap())
+			return nil
+		})
+	req := newHeartbeatReq()
+	req.Labels = labels
+	_, err = mgr.HandleHeartbeat(req)
+	require.NoError(t, err)
+	require.Equal(t, labels, mgr.GetExecutorInfos()[executor.ID].Labels.ToMap())
+	// the unchanged labels are not updated again.
+	_, err = mgr.HandleHeartbeat(req)
+	require.NoError(t, err)
+	req.Labels = map[string]string{"!invalid": "enabled"}
+	_, err = mgr.HandleHeartbeat(req)
+	require.Error(t, err)
+
 	metaClient.EXPECT().QueryExecutors(gomock.Any()).Times(1).Return([]*ormModel.Executor{}, nil)
 	metaClient.EXPECT().DeleteExecutor(gomock.Any(), executor.ID).Times(1).Return(nil)
 
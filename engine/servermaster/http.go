@@ -26,7 +26,10 @@ import (
 )
 
 // registerRoutes registers the routes for the HTTP server.
-func registerRoutes(router *http.ServeMux, grpcMux *runtime.ServeMux, forwardJobAPI http.HandlerFunc) {
+func registerRoutes(
+	router *http.ServeMux, grpcMux *runtime.ServeMux,
+	forwardJobAPI http.HandlerFunc, tenantAPI *tenantAPI, resourceAPI *resourceAPI,
+) {
 	// Swagger UI
 	router.HandleFunc("/swagger", openapi.SwaggerUI)
 	router.HandleFunc("/swagger/v1/openapiv2.json", openapi.SwaggerAPIv1)
@@ -37,7 +40,7 @@ func registerRoutes(router *http.ServeMux, grpcMux *runtime.ServeMux, forwardJob
 	// 2. The job API implemented by the job master.
 	// Both of them are registered in the same "/api/v1/jobs/" path.
 	// The job API implemented by the job master is registered in the "/api/v1/jobs/{job_id}/".
-	// But framework has a special APIs cancel will register in the "/api/v1/jobs/{job_id}/" path too.
+	// But framework has special APIs cancel and events which are registered in the "/api/v1/jobs/{job_id}/" path too.
 	// So we first check whether the request should be forwarded to the job master.
 	// If yes, forward the request to the job master. Otherwise, delegate the request to the framework.
 	router.HandleFunc("/api/v1/", func(w http.ResponseWriter, r *http.Request) {
@@ -48,6 +51,13 @@ func registerRoutes(router *http.ServeMux, grpcMux *runtime.ServeMux, forwardJob
 		}
 	})
 
+	// Tenant API
+	// The routes are more specific than "/api/v1/", so they take precedence.
+	tenantAPI.registerRoutes(router)
+
+	// Resource inventory API
+	resourceAPI.registerRoutes(router)
+
 	// pprof debug API
 	router.HandleFunc("/debug/pprof/", pprof.Index)
 	router.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
@@ -76,9 +86,9 @@ func shouldForwardJobAPI(r *http.Request) bool {
 	if len(fields) != 2 {
 		return false
 	}
-	// cancel is implemented by framework,
+	// cancel and events are implemented by framework,
 	// don't forward them to the job master.
-	if fields[1] == "cancel" {
+	if fields[1] == "cancel" || fields[1] == "events" {
 		return false
 	}
 	return true
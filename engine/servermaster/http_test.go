@@ -22,6 +22,8 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	pb "github.com/pingcap/tiflow/engine/enginepb"
+	externRescManager "github.com/pingcap/tiflow/engine/pkg/externalresource/manager"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -45,7 +47,13 @@ func TestRegisterRoutes(t *testing.T) {
 			http.NotFound(w, r)
 		}
 	})
-	registerRoutes(router, grpcMux, forwardJobAPI)
+	metaCli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = metaCli.Close()
+	})
+	registerRoutes(router, grpcMux, forwardJobAPI, newTenantAPI(metaCli),
+		newResourceAPI(externRescManager.NewInventory(metaCli, nil)))
 
 	testCases := []struct {
 		method       string
@@ -107,6 +115,46 @@ func TestRegisterRoutes(t *testing.T) {
 			path:         "/api/v1/jobs/job1/config",
 			expectedCode: http.StatusNotFound,
 		},
+		{
+			method:       http.MethodGet,
+			path:         "/api/v1/jobs/job1/events",
+			expectedCode: http.StatusNotImplemented,
+		},
+		{
+			method:       http.MethodPost,
+			path:         "/api/v1/jobs/job1/events",
+			expectedCode: http.StatusNotImplemented,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/api/v1/tenants",
+			expectedCode: http.StatusOK,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/api/v1/tenants/tenant1/quota",
+			expectedCode: http.StatusOK,
+		},
+		{
+			method:       http.MethodPost,
+			path:         "/api/v1/tenants/tenant1/quota",
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/api/v1/resources",
+			expectedCode: http.StatusOK,
+		},
+		{
+			method:       http.MethodPost,
+			path:         "/api/v1/resources/purge",
+			expectedCode: http.StatusOK,
+		},
+		{
+			method:       http.MethodGet,
+			path:         "/api/v1/resources/purge",
+			expectedCode: http.StatusNotFound,
+		},
 		{
 			method:       http.MethodGet,
 			path:         "/debug/pprof/",
@@ -169,6 +217,11 @@ func TestShouldForwardJobAPI(t *testing.T) {
 			path:          "/api/v1/jobs/job1/cancel",
 			shouldForward: false,
 		},
+		{
+			method:        http.MethodGet,
+			path:          "/api/v1/jobs/job1/events",
+			shouldForward: false,
+		},
 		{
 			method:        http.MethodGet,
 			path:          "/api/v1/jobs/job1/status",
@@ -0,0 +1,244 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/log"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultJobEventRetention = 7 * 24 * time.Hour
+	jobEventGCInterval       = 10 * time.Minute
+	jobEventBufferSize       = 1024
+	jobEventWriteTimeout     = 5 * time.Second
+	jobEventDrainTimeout     = 10 * time.Second
+)
+
+// JobEventConfig is the config of the job event log.
+type JobEventConfig struct {
+	// Retention is how long the events are kept, the events of a job are
+	// kept after the job is deleted until they are expired.
+	Retention time.Duration `toml:"retention" json:"retention"`
+}
+
+// NewDefaultJobEventConfig creates a default job event config.
+func NewDefaultJobEventConfig() *JobEventConfig {
+	return &JobEventConfig{
+		Retention: defaultJobEventRetention,
+	}
+}
+
+// Validate checks whether the config is valid.
+func (c *JobEventConfig) Validate() error {
+	if c.Retention <= 0 {
+		return errors.ErrInvalidArgument.GenWithStackByArgs("job event retention must be positive")
+	}
+	return nil
+}
+
+// jobEventRecorder appends the job events to the metastore asynchronously.
+// Recording an event blocks if the buffer is full, so that the job manager
+// is slowed down rather than losing events when the metastore can't keep up
+// with them. The buffered events are written before the recorder stops.
+type jobEventRecorder struct {
+	metaCli   pkgOrm.Client
+	clocker   clock.Clock
+	retention time.Duration
+	eventCh   chan *ormModel.JobEvent
+	// stopped is closed when the recorder stops, the events recorded after
+	// that are dropped.
+	stopped chan struct{}
+}
+
+func newJobEventRecorder(
+	metaCli pkgOrm.Client, clocker clock.Clock, cfg *JobEventConfig,
+) *jobEventRecorder {
+	return &jobEventRecorder{
+		metaCli:   metaCli,
+		clocker:   clocker,
+		retention: cfg.Retention,
+		eventCh:   make(chan *ormModel.JobEvent, jobEventBufferSize),
+		stopped:   make(chan struct{}),
+	}
+}
+
+// record records a job event, it's a no-op on a nil recorder. It blocks if
+// the buffer is full until there is room or the recorder stops.
+func (r *jobEventRecorder) record(event *ormModel.JobEvent) {
+	if r == nil {
+		return
+	}
+	event.CreatedAt = r.clocker.Now()
+	select {
+	case <-r.stopped:
+		log.Warn("job event recorder is stopped, drop the event",
+			zap.String("job-id", event.JobID), zap.String("event-type", string(event.EventType)))
+		return
+	default:
+	}
+	select {
+	case r.eventCh <- event:
+		return
+	default:
+	}
+
+	log.Warn("job event buffer is full, wait for the events to be written",
+		zap.String("job-id", event.JobID), zap.String("event-type", string(event.EventType)))
+	select {
+	case <-r.stopped:
+		log.Warn("job event recorder is stopped, drop the event",
+			zap.String("job-id", event.JobID), zap.String("event-type", string(event.EventType)))
+	case r.eventCh <- event:
+	}
+}
+
+// run writes the recorded events to the metastore in order and removes
+// the expired events periodically. The buffered events are written when
+// ctx is canceled before it returns.
+func (r *jobEventRecorder) run(ctx context.Context) error {
+	defer r.drain()
+
+	ticker := time.NewTicker(jobEventGCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case event := <-r.eventCh:
+			r.write(ctx, event)
+		case <-ticker.C:
+			r.gc(ctx)
+		}
+	}
+}
+
+// drain stops the recorder and writes the events left in the buffer.
+func (r *jobEventRecorder) drain() {
+	close(r.stopped)
+
+	// The context of run is canceled, use a new one to write the events.
+	ctx, cancel := context.WithTimeout(context.Background(), jobEventDrainTimeout)
+	defer cancel()
+	for {
+		select {
+		case event := <-r.eventCh:
+			r.write(ctx, event)
+		default:
+			return
+		}
+	}
+}
+
+func (r *jobEventRecorder) write(ctx context.Context, event *ormModel.JobEvent) {
+	ctx, cancel := context.WithTimeout(ctx, jobEventWriteTimeout)
+	defer cancel()
+
+	// The job master reports its executor in the master meta when it starts,
+	// which happens before it's online.
+	if event.EventType == ormModel.JobEventOnline && event.ExecutorID == "" {
+		if meta, err := r.metaCli.GetJobByID(ctx, event.JobID); err == nil {
+			event.ExecutorID = string(meta.NodeID)
+		}
+	}
+	if err := r.metaCli.CreateJobEvent(ctx, event); err != nil {
+		log.Warn("failed to record job event", zap.Any("event", event), zap.Error(err))
+	}
+}
+
+func (r *jobEventRecorder) gc(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, jobEventWriteTimeout)
+	defer cancel()
+
+	res, err := r.metaCli.DeleteJobEventsBefore(ctx, r.clocker.Now().Add(-r.retention))
+	if err != nil {
+		log.Warn("failed to remove expired job events", zap.Error(err))
+		return
+	}
+	if res.RowsAffected() > 0 {
+		log.Info("expired job events removed", zap.Int64("count", res.RowsAffected()))
+	}
+}
+
+// recordJobEvent records an event of a job which is not a state transition
+// in JobFsm, toState is the final state if the job is terminated.
+func (jm *JobManagerImpl) recordJobEvent(
+	jobID frameModel.MasterID, tp ormModel.JobEventType, toState string, message string,
+) {
+	jm.JobFsm.eventRecorder.record(&ormModel.JobEvent{
+		JobID:     jobID,
+		EventType: tp,
+		ToState:   toState,
+		Message:   message,
+	})
+}
+
+func terminatedStateName(state frameModel.MasterState) string {
+	switch state {
+	case frameModel.MasterStateFinished:
+		return "finished"
+	case frameModel.MasterStateStopped:
+		return "stopped"
+	case frameModel.MasterStateFailed:
+		return "failed"
+	default:
+		return ""
+	}
+}
+
+// listJobEvents lists the events of a job from the metastore in the order
+// they happened, the latest `limit` events are returned if limit is positive.
+func listJobEvents(
+	ctx context.Context, metaCli pkgOrm.Client, req *pb.ListJobEventsRequest,
+) (*pb.ListJobEventsResponse, error) {
+	limit := int(req.GetLimit())
+	if limit < 0 {
+		limit = 0
+	}
+	events, err := metaCli.QueryJobEvents(ctx, req.GetJobId(), limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListJobEventsResponse{Events: make([]*pb.JobEvent, 0, len(events))}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &pb.JobEvent{
+			SeqId:      uint64(event.SeqID),
+			JobId:      event.JobID,
+			WorkerId:   event.WorkerID,
+			EventType:  string(event.EventType),
+			FromState:  event.FromState,
+			ToState:    event.ToState,
+			ExecutorId: event.ExecutorID,
+			Message:    event.Message,
+			CreateTime: event.CreatedAt.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// ListJobEvents implements JobManagerServer.ListJobEvents.
+func (jm *JobManagerImpl) ListJobEvents(
+	ctx context.Context, req *pb.ListJobEventsRequest,
+) (*pb.ListJobEventsResponse, error) {
+	return listJobEvents(ctx, jm.frameMetaClient, req)
+}
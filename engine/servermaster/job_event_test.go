@@ -0,0 +1,174 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestJobEventRecorder(t *testing.T) {
+	t.Parallel()
+
+	metaCli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer metaCli.Close()
+
+	ctx := context.Background()
+	clk := clock.NewMock()
+	clk.Set(time.Now())
+	recorder := newJobEventRecorder(metaCli, clk, &JobEventConfig{Retention: time.Hour})
+	require.NoError(t, metaCli.UpsertJob(ctx, &frameModel.MasterMeta{ID: "job1", NodeID: "executor-1"}))
+
+	recorder.record(&ormModel.JobEvent{JobID: "job1", EventType: ormModel.JobEventCreated})
+	clk.Add(time.Hour)
+	recorder.record(&ormModel.JobEvent{JobID: "job1", EventType: ormModel.JobEventOnline})
+	for len(recorder.eventCh) > 0 {
+		recorder.write(ctx, <-recorder.eventCh)
+	}
+	events, err := metaCli.QueryJobEvents(ctx, "job1", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, ormModel.JobEventCreated, events[0].EventType)
+	require.Equal(t, ormModel.JobEventOnline, events[1].EventType)
+	// the executor of the job master is filled for the online event.
+	require.Equal(t, "executor-1", events[1].ExecutorID)
+
+	// the expired events are removed.
+	clk.Add(30 * time.Minute)
+	recorder.gc(ctx)
+	events, err = metaCli.QueryJobEvents(ctx, "job1", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, ormModel.JobEventOnline, events[0].EventType)
+
+	// recording on a nil recorder is a no-op.
+	var nilRecorder *jobEventRecorder
+	nilRecorder.record(&ormModel.JobEvent{JobID: "job1", EventType: ormModel.JobEventDeleted})
+}
+
+func TestJobEventRecorderBackpressure(t *testing.T) {
+	t.Parallel()
+
+	metaCli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer metaCli.Close()
+
+	ctx := context.Background()
+	recorder := newJobEventRecorder(metaCli, clock.New(), NewDefaultJobEventConfig())
+	recorder.eventCh = make(chan *ormModel.JobEvent, 1)
+
+	recorder.record(&ormModel.JobEvent{JobID: "job1", EventType: ormModel.JobEventCreated})
+	// the event is not dropped but blocked when the buffer is full.
+	recorded := make(chan struct{})
+	go func() {
+		defer close(recorded)
+		recorder.record(&ormModel.JobEvent{JobID: "job1", EventType: ormModel.JobEventDispatched})
+	}()
+	select {
+	case <-recorded:
+		require.FailNow(t, "the event should be blocked")
+	case <-time.After(100 * time.Millisecond):
+	}
+	recorder.write(ctx, <-recorder.eventCh)
+	<-recorded
+
+	// the buffered events are written when the recorder stops.
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, recorder.run(runCtx), context.Canceled)
+	events, err := metaCli.QueryJobEvents(ctx, "job1", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, ormModel.JobEventCreated, events[0].EventType)
+	require.Equal(t, ormModel.JobEventDispatched, events[1].EventType)
+
+	// the events recorded after the recorder stops are dropped.
+	recorder.record(&ormModel.JobEvent{JobID: "job1", EventType: ormModel.JobEventOnline})
+	recorder.record(&ormModel.JobEvent{JobID: "job1", EventType: ormModel.JobEventTerminated})
+	require.Len(t, recorder.eventCh, 0)
+}
+
+func TestListJobEvents(t *testing.T) {
+	t.Parallel()
+
+	metaCli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer metaCli.Close()
+
+	// the events are served through the grpc gateway.
+	grpcMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{},
+		}),
+	)
+	err = pb.RegisterJobManagerHandlerServer(context.Background(), grpcMux, &Server{frameMetaClient: metaCli})
+	require.NoError(t, err)
+	list := func(path string) (*pb.ListJobEventsResponse, int) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+		grpcMux.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			return nil, w.Code
+		}
+		resp := &pb.ListJobEventsResponse{}
+		require.NoError(t, protojson.Unmarshal(w.Body.Bytes(), resp))
+		return resp, w.Code
+	}
+
+	ctx := context.Background()
+	for _, tp := range []ormModel.JobEventType{
+		ormModel.JobEventCreated, ormModel.JobEventDispatched, ormModel.JobEventTerminated,
+	} {
+		require.NoError(t, metaCli.CreateJobEvent(ctx, &ormModel.JobEvent{JobID: "job1", EventType: tp}))
+	}
+	require.NoError(t, metaCli.CreateJobEvent(ctx, &ormModel.JobEvent{
+		JobID: "job1", WorkerID: "worker1", EventType: ormModel.JobEventFailover, Message: "heartbeat timeout",
+	}))
+
+	resp, code := list("/api/v1/jobs/job1/events")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, resp.Events, 4)
+	require.Equal(t, string(ormModel.JobEventCreated), resp.Events[0].EventType)
+	require.Equal(t, "job1", resp.Events[0].JobId)
+	require.NotZero(t, resp.Events[0].CreateTime)
+
+	resp, code = list("/api/v1/jobs/job1/events?limit=1")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, resp.Events, 1)
+	require.Equal(t, string(ormModel.JobEventFailover), resp.Events[0].EventType)
+	require.Equal(t, "worker1", resp.Events[0].WorkerId)
+	require.Equal(t, "heartbeat timeout", resp.Events[0].Message)
+
+	// the events of an unknown job are empty.
+	resp, code = list("/api/v1/jobs/job2/events")
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, resp.Events)
+
+	_, code = list("/api/v1/jobs/job1/events?limit=x")
+	require.Equal(t, http.StatusBadRequest, code)
+}
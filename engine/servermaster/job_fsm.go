@@ -20,10 +20,19 @@ import (
 	pb "github.com/pingcap/tiflow/engine/enginepb"
 	"github.com/pingcap/tiflow/engine/framework"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/engine/servermaster/scheduler"
 	"github.com/pingcap/tiflow/pkg/errors"
 	"go.uber.org/zap"
 )
 
+// The states of a job in JobFsm, which are recorded in the job events.
+const (
+	jobFsmStatePending = "pending"
+	jobFsmStateWaitAck = "wait-ack"
+	jobFsmStateOnline  = "online"
+)
+
 // JobHolder holds job meta and worker handle for a job.
 type JobHolder struct {
 	workerHandle framework.WorkerHandle
@@ -94,6 +103,9 @@ type JobFsm struct {
 	pendingJobs map[frameModel.MasterID]*frameModel.MasterMeta
 	waitAckJobs map[frameModel.MasterID]*JobHolder
 	onlineJobs  map[frameModel.MasterID]*JobHolder
+
+	// eventRecorder records the state transitions of jobs, it's optional.
+	eventRecorder *jobEventRecorder
 }
 
 // JobStats defines a statistics interface for JobFsm
@@ -147,14 +159,33 @@ func (fsm *JobFsm) JobDispatched(job *frameModel.MasterMeta, addFromFailover boo
 		masterMeta:      job,
 		addFromFailover: addFromFailover,
 	}
+	if !addFromFailover {
+		fsm.recordEvent(job.ID, ormModel.JobEventDispatched, "", jobFsmStateWaitAck, nil)
+	}
+}
+
+// JobQueued is called when a job is created but it can't be dispatched
+// until there is enough capacity.
+func (fsm *JobFsm) JobQueued(job *frameModel.MasterMeta) {
+	fsm.jobsMu.Lock()
+	defer fsm.jobsMu.Unlock()
+	fsm.pendingJobs[job.ID] = job
+	fsm.recordEvent(job.ID, ormModel.JobEventQueued, "", jobFsmStatePending, nil)
 }
 
 // IterPendingJobs iterates all pending jobs and dispatch(via create worker) them again.
+// The jobs with a higher priority are iterated first.
 func (fsm *JobFsm) IterPendingJobs(dispatchJobFn func(job *frameModel.MasterMeta) (string, error)) error {
 	fsm.jobsMu.Lock()
 	defer fsm.jobsMu.Unlock()
 
-	for oldJobID, job := range fsm.pendingJobs {
+	jobs := make([]*frameModel.MasterMeta, 0, len(fsm.pendingJobs))
+	for _, job := range fsm.pendingJobs {
+		jobs = append(jobs, job)
+	}
+	scheduler.SortJobsByPriority(jobs)
+	for _, job := range jobs {
+		oldJobID := job.ID
 		id, err := dispatchJobFn(job)
 		if err != nil {
 			// This job is being backoff, skip it and process other jobs.
@@ -163,6 +194,7 @@ func (fsm *JobFsm) IterPendingJobs(dispatchJobFn func(job *frameModel.MasterMeta
 			}
 			if errors.Is(err, errors.ErrMasterCreateWorkerTerminate) {
 				delete(fsm.pendingJobs, oldJobID)
+				fsm.recordEvent(oldJobID, ormModel.JobEventOffline, jobFsmStatePending, "", nil)
 				continue
 			}
 			return err
@@ -172,6 +204,7 @@ func (fsm *JobFsm) IterPendingJobs(dispatchJobFn func(job *frameModel.MasterMeta
 		fsm.waitAckJobs[id] = &JobHolder{
 			masterMeta: job,
 		}
+		fsm.recordEvent(id, ormModel.JobEventDispatched, jobFsmStatePending, jobFsmStateWaitAck, nil)
 		log.Info("job master recovered", zap.Any("job", job))
 	}
 
@@ -192,6 +225,7 @@ func (fsm *JobFsm) IterWaitAckJobs(dispatchJobFn func(job *frameModel.MasterMeta
 			return err
 		}
 		fsm.waitAckJobs[id].addFromFailover = false
+		fsm.recordEvent(id, ormModel.JobEventDispatched, jobFsmStateWaitAck, jobFsmStateWaitAck, nil)
 		log.Info("tombstone job master doesn't receive heartbeat in time, recreate it", zap.Any("job", job))
 	}
 
@@ -212,18 +246,21 @@ func (fsm *JobFsm) JobOnline(worker framework.WorkerHandle) error {
 		masterMeta:   job.masterMeta,
 	}
 	delete(fsm.waitAckJobs, worker.ID())
+	fsm.recordEvent(worker.ID(), ormModel.JobEventOnline, jobFsmStateWaitAck, jobFsmStateOnline, nil)
 	return nil
 }
 
 // JobOffline is called when a job meets error or finishes
-func (fsm *JobFsm) JobOffline(worker framework.WorkerHandle, needFailover bool) {
+func (fsm *JobFsm) JobOffline(worker framework.WorkerHandle, needFailover bool, reason error) {
 	fsm.jobsMu.Lock()
 	defer fsm.jobsMu.Unlock()
 
+	fromState := jobFsmStateOnline
 	job, ok := fsm.onlineJobs[worker.ID()]
 	if ok {
 		delete(fsm.onlineJobs, worker.ID())
 	} else {
+		fromState = jobFsmStateWaitAck
 		job, ok = fsm.waitAckJobs[worker.ID()]
 		if !ok {
 			log.Warn("unknown worker, ignore it", zap.String("id", worker.ID()))
@@ -233,11 +270,14 @@ func (fsm *JobFsm) JobOffline(worker framework.WorkerHandle, needFailover bool)
 	}
 	if needFailover {
 		fsm.pendingJobs[worker.ID()] = job.masterMeta
+		fsm.recordEvent(worker.ID(), ormModel.JobEventFailover, fromState, jobFsmStatePending, reason)
+	} else {
+		fsm.recordEvent(worker.ID(), ormModel.JobEventOffline, fromState, "", reason)
 	}
 }
 
 // JobDispatchFailed is called when a job dispatch fails
-func (fsm *JobFsm) JobDispatchFailed(worker framework.WorkerHandle) error {
+func (fsm *JobFsm) JobDispatchFailed(worker framework.WorkerHandle, reason error) error {
 	fsm.jobsMu.Lock()
 	defer fsm.jobsMu.Unlock()
 
@@ -247,9 +287,43 @@ func (fsm *JobFsm) JobDispatchFailed(worker framework.WorkerHandle) error {
 	}
 	fsm.pendingJobs[worker.ID()] = job.masterMeta
 	delete(fsm.waitAckJobs, worker.ID())
+	fsm.recordEvent(worker.ID(), ormModel.JobEventDispatchFailed, jobFsmStateWaitAck, jobFsmStatePending, reason)
 	return nil
 }
 
+// ScheduledJobCount returns the number of jobs which are dispatched to executors.
+func (fsm *JobFsm) ScheduledJobCount() int {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+	return len(fsm.waitAckJobs) + len(fsm.onlineJobs)
+}
+
+// ScheduledJobCountByTenant returns the number of dispatched jobs of each tenant.
+func (fsm *JobFsm) ScheduledJobCountByTenant() map[string]int {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+	counts := make(map[string]int)
+	for _, holders := range []map[frameModel.MasterID]*JobHolder{fsm.waitAckJobs, fsm.onlineJobs} {
+		for _, job := range holders {
+			if tenantID := job.masterMeta.Ext.TenantID; tenantID != "" {
+				counts[tenantID]++
+			}
+		}
+	}
+	return counts
+}
+
+// OnlineJobs returns all online jobs.
+func (fsm *JobFsm) OnlineJobs() []*JobHolder {
+	fsm.jobsMu.RLock()
+	defer fsm.jobsMu.RUnlock()
+	jobs := make([]*JobHolder, 0, len(fsm.onlineJobs))
+	for _, job := range fsm.onlineJobs {
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
 // JobCount queries job count based on job status
 func (fsm *JobFsm) JobCount(status pb.Job_State) int {
 	fsm.jobsMu.RLock()
@@ -264,3 +338,20 @@ func (fsm *JobFsm) JobCount(status pb.Job_State) int {
 		return 0
 	}
 }
+
+// recordEvent records a state transition of a job, an empty state means
+// the job is not managed by JobFsm.
+func (fsm *JobFsm) recordEvent(
+	jobID frameModel.MasterID, tp ormModel.JobEventType, from, to string, reason error,
+) {
+	event := &ormModel.JobEvent{
+		JobID:     jobID,
+		EventType: tp,
+		FromState: from,
+		ToState:   to,
+	}
+	if reason != nil {
+		event.Message = reason.Error()
+	}
+	fsm.eventRecorder.record(event)
+}
@@ -18,6 +18,9 @@ import (
 
 	"github.com/pingcap/tiflow/engine/framework"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -67,7 +70,7 @@ func TestJobFsmStateTrans(t *testing.T) {
 		WorkerID:     id,
 		WorkerStatus: &frameModel.WorkerStatus{State: frameModel.WorkerStateNormal},
 		IsTombstone:  true,
-	}, true /* needFailover */)
+	}, true /* needFailover */, nil)
 	require.Empty(t, fsm.onlineJobs)
 	require.Len(t, fsm.pendingJobs, 1)
 
@@ -86,7 +89,7 @@ func TestJobFsmStateTrans(t *testing.T) {
 		WorkerID:     id,
 		WorkerStatus: &frameModel.WorkerStatus{State: frameModel.WorkerStateNormal},
 		IsTombstone:  true,
-	})
+	}, errors.New("dispatch failed"))
 	require.NoError(t, err)
 	require.Len(t, fsm.pendingJobs, 1)
 	require.Empty(t, fsm.waitAckJobs)
@@ -102,7 +105,7 @@ func TestJobFsmStateTrans(t *testing.T) {
 		WorkerID:     id,
 		WorkerStatus: &frameModel.WorkerStatus{State: frameModel.WorkerStateNormal},
 		IsTombstone:  true,
-	}, false /*needFailover*/)
+	}, false /*needFailover*/, nil)
 	require.Empty(t, fsm.waitAckJobs)
 
 	// offline invalid job, will do nothing
@@ -112,5 +115,51 @@ func TestJobFsmStateTrans(t *testing.T) {
 		ExecutorID:   "executor-1",
 	}
 
-	fsm.JobOffline(invalidWorker, true)
+	fsm.JobOffline(invalidWorker, true, nil)
+}
+
+func TestJobFsmRecordEvents(t *testing.T) {
+	t.Parallel()
+
+	fsm := NewJobFsm()
+	recorder := newJobEventRecorder(nil, clock.New(), NewDefaultJobEventConfig())
+	fsm.eventRecorder = recorder
+
+	id := "fsm-test-job-master-events"
+	handle := &framework.MockHandle{WorkerID: id}
+	fsm.JobDispatched(&frameModel.MasterMeta{ID: id}, false)
+	require.NoError(t, fsm.JobOnline(handle))
+	fsm.JobOffline(handle, true /* needFailover */, errors.New("executor offline"))
+	require.NoError(t, fsm.IterPendingJobs(func(job *frameModel.MasterMeta) (string, error) {
+		return id, nil
+	}))
+	require.NoError(t, fsm.JobDispatchFailed(handle, errors.New("dispatch failed")))
+	require.NoError(t, fsm.IterPendingJobs(func(job *frameModel.MasterMeta) (string, error) {
+		return "", errors.ErrMasterCreateWorkerTerminate.FastGenByArgs()
+	}))
+
+	expected := []ormModel.JobEvent{
+		{EventType: ormModel.JobEventDispatched, ToState: jobFsmStateWaitAck},
+		{EventType: ormModel.JobEventOnline, FromState: jobFsmStateWaitAck, ToState: jobFsmStateOnline},
+		{
+			EventType: ormModel.JobEventFailover, FromState: jobFsmStateOnline,
+			ToState: jobFsmStatePending, Message: "executor offline",
+		},
+		{EventType: ormModel.JobEventDispatched, FromState: jobFsmStatePending, ToState: jobFsmStateWaitAck},
+		{
+			EventType: ormModel.JobEventDispatchFailed, FromState: jobFsmStateWaitAck,
+			ToState: jobFsmStatePending, Message: "dispatch failed",
+		},
+		{EventType: ormModel.JobEventOffline, FromState: jobFsmStatePending},
+	}
+	require.Len(t, recorder.eventCh, len(expected))
+	for _, exp := range expected {
+		event := <-recorder.eventCh
+		require.Equal(t, id, event.JobID)
+		require.Equal(t, exp.EventType, event.EventType)
+		require.Equal(t, exp.FromState, event.FromState)
+		require.Equal(t, exp.ToState, event.ToState)
+		require.Equal(t, exp.Message, event.Message)
+		require.False(t, event.CreatedAt.IsZero())
+	}
 }
@@ -0,0 +1,175 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/log"
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	"github.com/pingcap/tiflow/engine/framework"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/engine/servermaster/scheduler"
+	"go.uber.org/zap"
+)
+
+const (
+	// preemptRetryInterval is the interval to resend the stop message if the
+	// preempted job master has not exited.
+	preemptRetryInterval = 30 * time.Second
+	// blockedTaskTTL is how long a job whose task can't be scheduled is
+	// considered to preempt other jobs.
+	blockedTaskTTL = 30 * time.Second
+)
+
+// executorSlots checks the slots of the executors used by the running tasks.
+type executorSlots interface {
+	// HasFreeSlots returns whether the executors have a free slot for one
+	// more task besides the `tasks` tasks which are being scheduled.
+	HasFreeSlots(ctx context.Context, tasks int) (bool, error)
+}
+
+// hasCapacity returns whether one more job can be dispatched when there
+// are already `scheduled` jobs dispatched to the executors, and `dispatched`
+// of them are dispatched in the current Tick.
+func (jm *JobManagerImpl) hasCapacity(ctx context.Context, scheduled, dispatched int) bool {
+	if jm.priorityConfig != nil && jm.priorityConfig.MaxRunningJobs > 0 &&
+		scheduled >= jm.priorityConfig.MaxRunningJobs {
+		return false
+	}
+	if jm.executorSlots == nil {
+		return true
+	}
+	ok, err := jm.executorSlots.HasFreeSlots(ctx, dispatched)
+	if err != nil {
+		// the executors reject the job master if they are full.
+		log.Warn("failed to check the slots of executors", zap.Error(err))
+		return true
+	}
+	return ok
+}
+
+// OnTaskBlocked implements JobManager.OnTaskBlocked.
+func (jm *JobManagerImpl) OnTaskBlocked(jobID frameModel.MasterID) {
+	jm.blockedJobsMu.Lock()
+	defer jm.blockedJobsMu.Unlock()
+	if jm.blockedJobs == nil {
+		jm.blockedJobs = make(map[frameModel.MasterID]time.Time)
+	}
+	jm.blockedJobs[jobID] = jm.clocker.Now()
+}
+
+// blockedOnlineJob returns the online job with the highest priority whose
+// task is blocked recently, so it can preempt the jobs with lower priorities
+// for its workers. The expired records are removed.
+func (jm *JobManagerImpl) blockedOnlineJob() *frameModel.MasterMeta {
+	jm.blockedJobsMu.Lock()
+	defer jm.blockedJobsMu.Unlock()
+
+	var blocked *frameModel.MasterMeta
+	for jobID, blockTime := range jm.blockedJobs {
+		job := jm.JobFsm.QueryOnlineJob(jobID)
+		if job == nil || jm.clocker.Since(blockTime) >= blockedTaskTTL {
+			delete(jm.blockedJobs, jobID)
+			continue
+		}
+		meta := job.MasterMeta()
+		if blocked == nil || meta.Ext.Priority.Level() > blocked.Ext.Priority.Level() ||
+			(meta.Ext.Priority.Level() == blocked.Ext.Priority.Level() && meta.SeqID < blocked.SeqID) {
+			blocked = meta
+		}
+	}
+	return blocked
+}
+
+// jobPriority returns the priority class of a new job of the given type.
+func (jm *JobManagerImpl) jobPriority(tp pb.Job_Type) frameModel.PriorityClass {
+	if jm.priorityConfig == nil {
+		return frameModel.PriorityClassNormal
+	}
+	return jm.priorityConfig.JobPriority(tp)
+}
+
+// tryPreempt pauses a running job with a lower priority than the queued job,
+// the paused job is queued again and it will be resumed once there is
+// enough capacity. At most one job is preempted at the same time.
+func (jm *JobManagerImpl) tryPreempt(ctx context.Context, queued *frameModel.MasterMeta) {
+	if jm.priorityConfig == nil || !jm.priorityConfig.EnablePreemption {
+		return
+	}
+
+	var victim *JobHolder
+	for jobID, preemptTime := range jm.preemptingJobs {
+		if jm.clocker.Since(preemptTime) < preemptRetryInterval {
+			return
+		}
+		victim = jm.JobFsm.QueryOnlineJob(jobID)
+		if victim == nil {
+			delete(jm.preemptingJobs, jobID)
+			return
+		}
+		log.Warn("preempted job master has not exited, resend the pause message",
+			zap.String("job-id", jobID))
+	}
+
+	if victim == nil {
+		onlineJobs := jm.JobFsm.OnlineJobs()
+		running := make([]*frameModel.MasterMeta, 0, len(onlineJobs))
+		for _, job := range onlineJobs {
+			running = append(running, job.MasterMeta())
+		}
+		victimMeta := scheduler.PickPreemptionVictim(queued, running)
+		if victimMeta == nil {
+			return
+		}
+		for _, job := range onlineJobs {
+			if job.MasterMeta().ID == victimMeta.ID {
+				victim = job
+			}
+		}
+		log.Info("preempt job", zap.String("job-id", victimMeta.ID),
+			zap.String("priority", string(victimMeta.Ext.Priority)),
+			zap.String("queued-job-id", queued.ID),
+			zap.String("queued-priority", string(queued.Ext.Priority)))
+		jm.recordJobEvent(victimMeta.ID, ormModel.JobEventPreempted, "",
+			fmt.Sprintf("preempted by job %s with %s priority", queued.ID, queued.Ext.Priority))
+	}
+
+	if err := jm.sendStopJobMessage(ctx, victim, true /* pause */); err != nil {
+		log.Warn("failed to pause the preempted job", zap.String("job-id", victim.MasterMeta().ID),
+			zap.Error(err))
+		return
+	}
+	if jm.preemptingJobs == nil {
+		jm.preemptingJobs = make(map[frameModel.MasterID]time.Time)
+	}
+	jm.preemptingJobs[victim.MasterMeta().ID] = jm.clocker.Now()
+}
+
+// onJobPaused queues the paused job again, it's recovered from the metadata
+// and checkpoints kept by the job master when it's dispatched again. If the
+// job is canceled meanwhile, it's terminated when the pending jobs are iterated.
+func (jm *JobManagerImpl) onJobPaused(
+	ctx context.Context, worker framework.WorkerHandle, reason error,
+) error {
+	log.Info("job master paused", zap.String("id", worker.ID()))
+	if err := worker.GetTombstone().CleanTombstone(ctx); err != nil {
+		return err
+	}
+	jm.JobFsm.JobOffline(worker, true /* needFailover */, reason)
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"regexp"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/pingcap/log"
@@ -34,9 +35,12 @@ import (
 	engineHTTPUtil "github.com/pingcap/tiflow/engine/pkg/httputil"
 	"github.com/pingcap/tiflow/engine/pkg/notifier"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
 	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/engine/pkg/quota"
 	"github.com/pingcap/tiflow/engine/pkg/tenant"
 	"github.com/pingcap/tiflow/engine/servermaster/jobop"
+	"github.com/pingcap/tiflow/engine/servermaster/scheduler"
 	schedModel "github.com/pingcap/tiflow/engine/servermaster/scheduler/model"
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/httputil"
@@ -62,6 +66,9 @@ type JobManager interface {
 	WatchJobStatuses(
 		ctx context.Context,
 	) (resManager.JobStatusesSnapshot, *notifier.Receiver[resManager.JobStatusChangeEvent], error)
+	// OnTaskBlocked is called when a task of the job can't be scheduled
+	// because the slots of the executors are used up.
+	OnTaskBlocked(jobID frameModel.MasterID)
 }
 
 const (
@@ -104,6 +111,21 @@ type JobManagerImpl struct {
 
 	// http client for the job detail
 	jobHTTPClient engineHTTPUtil.JobHTTPClient
+
+	priorityConfig *scheduler.PriorityConfig
+	// preemptingJobs records the jobs being preempted and the time the stop
+	// messages are sent, it's only accessed in Tick and the worker callbacks.
+	preemptingJobs map[frameModel.MasterID]time.Time
+	// executorSlots counts the slots of the executors, nil if they are unlimited.
+	executorSlots executorSlots
+	// blockedJobs records the jobs whose tasks are blocked by the executor
+	// slots recently and the time they are blocked.
+	blockedJobsMu sync.Mutex
+	blockedJobs   map[frameModel.MasterID]time.Time
+
+	// tenantQuota checks the quotas of the tenants before their jobs are
+	// created or dispatched.
+	tenantQuota *tenantQuotaChecker
 }
 
 // CancelJob implements JobManagerServer.CancelJob.
@@ -127,6 +149,7 @@ func (jm *JobManagerImpl) CancelJob(ctx context.Context, req *pb.CancelJobReques
 	if err := jm.jobOperator.MarkJobCanceling(ctx, req.Id); err != nil {
 		return nil, err
 	}
+	jm.recordJobEvent(req.Id, ormModel.JobEventCanceling, "", "")
 	jm.jobOperatorNotifier.Notify()
 	pbJob.State = pb.Job_Canceling
 	return pbJob, nil
@@ -141,17 +164,23 @@ func (jm *JobManagerImpl) SendCancelJobMessage(ctx context.Context, jobID string
 		}
 		return errors.ErrJobNotRunning.GenWithStackByArgs(jobID)
 	}
+	return jm.sendStopJobMessage(ctx, job, false /* pause */)
+}
 
+// sendStopJobMessage asks the job master to stop gracefully. A paused job master
+// keeps its metadata and checkpoints, so that it can be resumed later.
+func (jm *JobManagerImpl) sendStopJobMessage(ctx context.Context, job *JobHolder, pause bool) error {
 	topic := frameModel.WorkerStatusChangeRequestTopic(jm.BaseMaster.MasterID(), job.WorkerHandle().ID())
 	msg := &frameModel.StatusChangeRequest{
 		SendTime:     jm.clocker.Mono(),
 		FromMasterID: jm.BaseMaster.MasterID(),
 		Epoch:        jm.BaseMaster.MasterMeta().Epoch,
 		ExpectState:  frameModel.WorkerStateStopped,
+		Pause:        pause,
 	}
 	handle := job.WorkerHandle().Unwrap()
 	if handle == nil {
-		return errors.ErrJobNotRunning.GenWithStackByArgs(jobID)
+		return errors.ErrJobNotRunning.GenWithStackByArgs(job.WorkerHandle().ID())
 	}
 	return handle.SendMessage(ctx, topic, msg, true /*nonblocking*/)
 }
@@ -186,6 +215,43 @@ func isJobTerminated(state frameModel.MasterState) bool {
 	}
 }
 
+// insertJobMeta inserts the job meta if the quotas of the tenant are not exceeded.
+// If the tenant queues its jobs, the job meta is always inserted and the job is
+// queued until it can be dispatched within the quotas, and queued is true.
+func (jm *JobManagerImpl) insertJobMeta(ctx context.Context, meta *frameModel.MasterMeta) (queued bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// The lock makes sure the concurrent creations of the same tenant
+	// can't exceed the quota together.
+	if ok := jm.jobStatusChangeMu.Lock(ctx); !ok {
+		return false, errors.Trace(ctx.Err())
+	}
+	defer jm.jobStatusChangeMu.Unlock()
+
+	tenantQuota, err := quota.GetTenantQuota(ctx, jm.frameMetaClient, meta.Ext.TenantID)
+	if err != nil {
+		return false, err
+	}
+	if tenantQuota.QueueJobs {
+		queued = tenantQuota.MaxJobs > 0 || tenantQuota.MaxStorageBytes > 0
+		return queued, jm.frameMetaClient.InsertJob(ctx, meta)
+	}
+	if tenantQuota.MaxJobs > 0 {
+		jobs, err := jm.frameMetaClient.QueryJobs(ctx)
+		if err != nil {
+			return false, err
+		}
+		if err := quota.CheckJobQuota(tenantQuota, quota.CountTenantJobs(jobs, meta.Ext.TenantID)); err != nil {
+			return false, err
+		}
+	}
+	if err := jm.tenantQuota.checkStorage(ctx, tenantQuota); err != nil {
+		return false, err
+	}
+	return false, jm.frameMetaClient.InsertJob(ctx, meta)
+}
+
 func (jm *JobManagerImpl) deleteJobMeta(ctx context.Context, jobID string) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -203,6 +269,8 @@ func (jm *JobManagerImpl) deleteJobMeta(ctx context.Context, jobID string) error
 	if res.RowsAffected() == 0 {
 		log.Warn("Job not found in meta (or already deleted)",
 			zap.Any("job-id", jobID))
+	} else {
+		jm.recordJobEvent(jobID, ormModel.JobEventDeleted, "", "")
 	}
 
 	jm.notifier.Notify(resManager.JobStatusChangeEvent{
@@ -250,6 +318,11 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 	if job.Id == "" {
 		job.Id = jm.uuidGen.NewString()
 	}
+	// The priority of the job type is used if the job doesn't specify one.
+	priority := frameModel.PriorityClass(job.Priority)
+	if priority == "" {
+		priority = jm.jobPriority(job.Type)
+	}
 
 	meta := &frameModel.MasterMeta{
 		ProjectID: tenant.NewProjectInfo(
@@ -261,6 +334,8 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 		State:  frameModel.MasterStateUninit,
 		Ext: frameModel.MasterMetaExt{
 			Selectors: selectors,
+			TenantID:  req.TenantId,
+			Priority:  priority,
 		},
 	}
 	switch job.Type {
@@ -272,19 +347,31 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 		meta.Type = frameModel.CvsJobMaster
 	case pb.Job_DM:
 		meta.Type = frameModel.DMJobMaster
+	case pb.Job_CDC:
+		// No job master or worker is registered for cdc jobs, changefeeds
+		// are still replicated by the TiCDC servers.
+		return nil, status.Errorf(codes.Unimplemented, "job type %v is not supported yet", job.Type)
 	case pb.Job_FakeJob:
 		meta.Type = frameModel.FakeJobMaster
 	default:
-		return nil, status.Errorf(codes.InvalidArgument, "job type %v is not supported", job.Type)
+		jobType := engineModel.JobType(job.Type)
+		if !jobType.IsCustom() {
+			return nil, status.Errorf(codes.InvalidArgument, "job type %v is not supported", job.Type)
+		}
+		// The config of a user-defined job is validated by its job master,
+		// which is dispatched to the executors registering the job type.
+		meta.Type = frameModel.WorkerType(jobType)
 	}
 
 	// create job master metadata before creating it.
-	if err := jm.frameMetaClient.InsertJob(ctx, meta); err != nil {
+	queued, err := jm.insertJobMeta(ctx, meta)
+	if err != nil {
 		if pkgOrm.IsDuplicateEntryError(err) {
 			return nil, errors.ErrJobAlreadyExists.GenWithStackByArgs(job.Id)
 		}
 		return nil, err
 	}
+	jm.recordJobEvent(meta.ID, ormModel.JobEventCreated, "", "")
 
 	// TODO: Refine me. split the BaseMaster
 	defaultMaster, ok := jm.BaseMaster.(interface {
@@ -298,6 +385,13 @@ func (jm *JobManagerImpl) CreateJob(ctx context.Context, req *pb.CreateJobReques
 			zap.Any("projectInfo", tenant.NewProjectInfo(req.TenantId, req.ProjectId)))
 	}
 
+	// The job is queued and dispatched by Tick in the order of priority
+	// if the number of running jobs is limited.
+	if queued || (jm.priorityConfig != nil && jm.priorityConfig.MaxRunningJobs > 0) {
+		jm.JobFsm.JobQueued(meta)
+		return buildPBJob(meta, false /* includeConfig */)
+	}
+
 	// CreateWorker here is to create job master actually
 	// TODO: use correct worker cost
 	workerID, err := jm.frameworkCreateWorker(meta)
@@ -330,6 +424,9 @@ func validateCreateJobRequest(req *pb.CreateJobRequest) error {
 	if req.Job.Type == pb.Job_TypeUnknown {
 		return status.Error(codes.InvalidArgument, "job type must be specified")
 	}
+	if err := frameModel.PriorityClass(req.Job.Priority).Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 	return nil
 }
 
@@ -434,7 +531,10 @@ func buildPBJob(masterMeta *frameModel.MasterMeta, includeConfig bool) (*pb.Job,
 	case engineModel.JobTypeFakeJob:
 		jobType = pb.Job_FakeJob
 	default:
-		return nil, errors.Errorf("job %s has unknown type %v", masterMeta.ID, masterMeta.Type)
+		if !tp.IsCustom() {
+			return nil, errors.Errorf("job %s has unknown type %v", masterMeta.ID, masterMeta.Type)
+		}
+		jobType = pb.Job_Type(tp)
 	}
 
 	var jobState pb.Job_State
@@ -470,6 +570,7 @@ func buildPBJob(masterMeta *frameModel.MasterMeta, includeConfig bool) (*pb.Job,
 			Message: masterMeta.ErrorMsg,
 		},
 		Selectors: selectors,
+		Priority:  string(masterMeta.Ext.Priority),
 	}
 	if includeConfig {
 		job.Config = masterMeta.Config
@@ -529,6 +630,10 @@ func NewJobManagerImpl(
 	dctx *dcontext.Context,
 	id frameModel.MasterID,
 	backoffConfig *jobop.BackoffConfig,
+	priorityConfig *scheduler.PriorityConfig,
+	eventConfig *JobEventConfig,
+	storage tenantStorageUsage,
+	slots executorSlots,
 ) (*JobManagerImpl, error) {
 	metaCli, err := dctx.Deps().Construct(func(cli pkgOrm.Client) (pkgOrm.Client, error) {
 		return cli, nil
@@ -558,7 +663,12 @@ func NewJobManagerImpl(
 		jobOperatorNotifier: new(notify.Notifier),
 		jobHTTPClient:       engineHTTPUtil.NewJobHTTPClient(httpCli),
 		JobBackoffMgr:       jobop.NewBackoffManagerImpl(clocker, backoffConfig),
+		priorityConfig:      priorityConfig,
+		preemptingJobs:      make(map[frameModel.MasterID]time.Time),
+		tenantQuota:         newTenantQuotaChecker(metaClient, storage),
+		executorSlots:       slots,
 	}
+	impl.JobFsm.eventRecorder = newJobEventRecorder(metaClient, clocker, eventConfig)
 	impl.BaseMaster = framework.NewBaseMaster(
 		dctx,
 		impl,
@@ -584,6 +694,9 @@ func NewJobManagerImpl(
 		return nil, err
 	}
 	impl.bgJobOperatorLoop(ctx)
+	impl.wg.Go(func() error {
+		return impl.JobFsm.eventRecorder.run(ctx)
+	})
 
 	return impl, err
 }
@@ -606,6 +719,12 @@ func (jm *JobManagerImpl) Tick(ctx context.Context) error {
 		return false, err
 	}
 
+	// blockedJob is the pending job with the highest priority which can't
+	// be dispatched because the capacity is short.
+	var blockedJob *frameModel.MasterMeta
+	scheduled := jm.JobFsm.ScheduledJobCount()
+	dispatched := 0
+	tenantScheduled := jm.JobFsm.ScheduledJobCountByTenant()
 	err := jm.JobFsm.IterPendingJobs(
 		func(job *frameModel.MasterMeta) (string, error) {
 			isJobCanceling := jm.jobOperator.IsJobCanceling(ctx, job.ID)
@@ -622,11 +741,39 @@ func (jm *JobManagerImpl) Tick(ctx context.Context) error {
 			if !jm.JobBackoffMgr.Allow(job.ID) {
 				return "", errors.ErrMasterCreateWorkerBackoff.FastGenByArgs()
 			}
-			return jm.frameworkCreateWorker(job)
+			tenantID := job.Ext.TenantID
+			if err := jm.tenantQuota.checkDispatch(ctx, tenantID, tenantScheduled[tenantID]); err != nil {
+				if !errors.Is(err, errors.ErrTenantQuotaExceeded) {
+					return "", err
+				}
+				// the queued job of the tenant waits until its other jobs
+				// finish, it doesn't preempt the jobs of other tenants.
+				return "", errors.ErrMasterCreateWorkerBackoff.FastGenByArgs()
+			}
+			if !jm.hasCapacity(ctx, scheduled, dispatched) {
+				if blockedJob == nil {
+					blockedJob = job
+				}
+				// keep the job pending like a backoff one.
+				return "", errors.ErrMasterCreateWorkerBackoff.FastGenByArgs()
+			}
+			id, err := jm.frameworkCreateWorker(job)
+			if err == nil {
+				scheduled++
+				dispatched++
+				tenantScheduled[tenantID]++
+			}
+			return id, err
 		})
 	if _, err = filterQuotaError(err); err != nil {
 		return err
 	}
+	if blockedJob == nil {
+		blockedJob = jm.blockedOnlineJob()
+	}
+	if blockedJob != nil {
+		jm.tryPreempt(ctx, blockedJob)
+	}
 
 	if !jm.tombstoneCleaned && jm.BaseMaster.IsMasterReady() {
 		for _, worker := range jm.BaseMaster.GetWorkers() {
@@ -709,12 +856,12 @@ func (jm *JobManagerImpl) OnWorkerDispatched(worker framework.WorkerHandle, resu
 			); err != nil {
 				return err
 			}
-			jm.JobFsm.JobOffline(worker, false /* needFailover */)
+			jm.JobFsm.JobOffline(worker, false /* needFailover */, result)
 			return nil
 		}
 		log.Warn("dispatch worker met error", zap.Error(result))
 		jm.JobBackoffMgr.JobFail(worker.ID())
-		return jm.JobFsm.JobDispatchFailed(worker)
+		return jm.JobFsm.JobDispatchFailed(worker, result)
 	}
 	return nil
 }
@@ -728,6 +875,13 @@ func (jm *JobManagerImpl) OnWorkerOnline(worker framework.WorkerHandle) error {
 
 // OnWorkerOffline implements frame.MasterImpl.OnWorkerOffline
 func (jm *JobManagerImpl) OnWorkerOffline(worker framework.WorkerHandle, reason error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	delete(jm.preemptingJobs, worker.ID())
+	if errors.Is(reason, errors.ErrWorkerPaused) {
+		return jm.onJobPaused(ctx, worker, reason)
+	}
+
 	needFailover := true
 	if errors.Is(reason, errors.ErrWorkerFinish) {
 		log.Info("job master finished", zap.String("id", worker.ID()))
@@ -742,8 +896,6 @@ func (jm *JobManagerImpl) OnWorkerOffline(worker framework.WorkerHandle, reason
 	} else {
 		log.Info("on worker offline", zap.Any("id", worker.ID()), zap.Any("reason", reason))
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
-	defer cancel()
 	if err := worker.GetTombstone().CleanTombstone(ctx); err != nil {
 		return err
 	}
@@ -752,7 +904,7 @@ func (jm *JobManagerImpl) OnWorkerOffline(worker framework.WorkerHandle, reason
 	} else {
 		jm.JobBackoffMgr.JobTerminate(worker.ID())
 	}
-	jm.JobFsm.JobOffline(worker, needFailover)
+	jm.JobFsm.JobOffline(worker, needFailover, reason)
 	return nil
 }
 
@@ -852,5 +1004,9 @@ func (jm *JobManagerImpl) terminateJob(
 		zap.String("error", errMsg), zap.Any("state", state))
 	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
-	return jm.UpdateJobStatus(ctx, jobID, errMsg, state)
+	if err := jm.UpdateJobStatus(ctx, jobID, errMsg, state); err != nil {
+		return err
+	}
+	jm.recordJobEvent(jobID, ormModel.JobEventTerminated, terminatedStateName(state), errMsg)
+	return nil
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tiflow/engine/framework"
 	"github.com/pingcap/tiflow/engine/framework/metadata"
 	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	engineModel "github.com/pingcap/tiflow/engine/model"
 	"github.com/pingcap/tiflow/engine/pkg/clock"
 	"github.com/pingcap/tiflow/engine/pkg/ctxmu"
 	resManager "github.com/pingcap/tiflow/engine/pkg/externalresource/manager"
@@ -32,8 +33,10 @@ import (
 	"github.com/pingcap/tiflow/engine/pkg/notifier"
 	"github.com/pingcap/tiflow/engine/pkg/openapi"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
 	"github.com/pingcap/tiflow/engine/servermaster/jobop"
 	jobopMock "github.com/pingcap/tiflow/engine/servermaster/jobop/mock"
+	"github.com/pingcap/tiflow/engine/servermaster/scheduler"
 	"github.com/pingcap/tiflow/pkg/errors"
 	"github.com/pingcap/tiflow/pkg/label"
 	"github.com/pingcap/tiflow/pkg/notify"
@@ -42,6 +45,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -56,6 +61,7 @@ func prepareMockJobManager(
 		clocker:             clock.New(),
 		uuidGen:             uuid.NewGenerator(),
 		frameMetaClient:     mockMaster.GetFrameMetaClient(),
+		tenantQuota:         newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		masterMetaClient:    metadata.NewMasterMetadataClient(metadata.JobManagerUUID, mockMaster.GetFrameMetaClient()),
 		jobStatusChangeMu:   ctxmu.New(),
 		notifier:            notifier.NewNotifier[resManager.JobStatusChangeEvent](),
@@ -118,6 +124,56 @@ func TestJobManagerCreateJob(t *testing.T) {
 	require.NoError(t, err)
 	_, err = mgr.CreateJob(ctx, req)
 	require.True(t, errors.Is(err, errors.ErrJobAlreadyExists))
+
+	// the cdc job is not supported by the engine yet.
+	_, err = mgr.CreateJob(ctx, &pb.CreateJobRequest{Job: &pb.Job{Type: pb.Job_CDC}})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestJobManagerCreateJobExceedTenantQuota(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, mgr := prepareMockJobManager(ctx, t, "create-job-exceed-tenant-quota-test")
+	err := mgr.frameMetaClient.UpsertTenantQuota(ctx, &ormModel.TenantQuota{
+		TenantID: "tenant",
+		MaxJobs:  1,
+	})
+	require.NoError(t, err)
+	err = mgr.frameMetaClient.UpsertJob(ctx, &frameModel.MasterMeta{
+		ID:    "job-of-tenant",
+		Type:  frameModel.FakeJobMaster,
+		State: frameModel.MasterStateInit,
+		Ext:   frameModel.MasterMetaExt{TenantID: "tenant"},
+	})
+	require.NoError(t, err)
+
+	req := &pb.CreateJobRequest{
+		Job: &pb.Job{
+			Type:   pb.Job_CVSDemo,
+			Config: []byte("{\"srcHost\":\"0.0.0.0:1234\", \"dstHost\":\"0.0.0.0:1234\", \"srcDir\":\"data\", \"dstDir\":\"data1\"}"),
+		},
+		TenantId: "tenant",
+	}
+	_, err = mgr.CreateJob(ctx, req)
+	require.True(t, errors.Is(err, errors.ErrTenantQuotaExceeded))
+
+	// terminated jobs are not counted.
+	err = mgr.frameMetaClient.UpdateJob(ctx, "job-of-tenant",
+		map[string]interface{}{
+			"state": frameModel.MasterStateFinished,
+		},
+	)
+	require.NoError(t, err)
+	_, err = mgr.insertJobMeta(ctx, &frameModel.MasterMeta{
+		ID:    "another-job-of-tenant",
+		Type:  frameModel.CvsJobMaster,
+		State: frameModel.MasterStateUninit,
+		Ext:   frameModel.MasterMetaExt{TenantID: "tenant"},
+	})
+	require.NoError(t, err)
 }
 
 type mockBaseMasterCreateWorkerFailed struct {
@@ -144,10 +200,12 @@ func TestCreateWorkerReturnError(t *testing.T) {
 		MockMasterImpl: masterImpl,
 	}
 	mgr := &JobManagerImpl{
-		BaseMaster:      mockMaster,
-		JobFsm:          NewJobFsm(),
-		uuidGen:         uuid.NewGenerator(),
-		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		BaseMaster:        mockMaster,
+		JobFsm:            NewJobFsm(),
+		uuidGen:           uuid.NewGenerator(),
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		tenantQuota:       newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
+		jobStatusChangeMu: ctxmu.New(),
 	}
 	mockMaster.Impl = mgr
 	err := mockMaster.Init(ctx)
@@ -293,6 +351,7 @@ func TestJobManagerGetJob(t *testing.T) {
 		uuidGen:          uuid.NewGenerator(),
 		masterMetaClient: metadata.NewMasterMetadataClient(metadata.JobManagerUUID, mockMaster.GetFrameMetaClient()),
 		frameMetaClient:  mockMaster.GetFrameMetaClient(),
+		tenantQuota:      newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		jobHTTPClient:    jobMock.NewMockNilReturnJobHTTPClient(),
 	}
 
@@ -329,6 +388,7 @@ func TestJobManagerOnlineJob(t *testing.T) {
 		JobFsm:            NewJobFsm(),
 		uuidGen:           uuid.NewGenerator(),
 		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		tenantQuota:       newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		jobStatusChangeMu: ctxmu.New(),
 	}
 	// set master impl to JobManagerImpl
@@ -384,6 +444,7 @@ func TestJobManagerRecover(t *testing.T) {
 		uuidGen:          uuid.NewGenerator(),
 		masterMetaClient: metadata.NewMasterMetadataClient(metadata.JobManagerUUID, mockMaster.GetFrameMetaClient()),
 		frameMetaClient:  mockMaster.GetFrameMetaClient(),
+		tenantQuota:      newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		jobHTTPClient:    jobMock.NewMockNilReturnJobHTTPClient(),
 	}
 	err := mgr.OnMasterRecovered(ctx)
@@ -407,6 +468,7 @@ func TestJobManagerTickExceedQuota(t *testing.T) {
 		JobFsm:          NewJobFsm(),
 		uuidGen:         uuid.NewGenerator(),
 		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		tenantQuota:     newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		jobHTTPClient:   jobMock.NewMockNilReturnJobHTTPClient(),
 	}
 	mockMaster.Impl = mgr
@@ -573,6 +635,7 @@ func TestListJobsPagination(t *testing.T) {
 		uuidGen:          uuid.NewGenerator(),
 		masterMetaClient: metadata.NewMasterMetadataClient(metadata.JobManagerUUID, mockMaster.GetFrameMetaClient()),
 		frameMetaClient:  mockMaster.GetFrameMetaClient(),
+		tenantQuota:      newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		jobHTTPClient:    jobMock.NewMockNilReturnJobHTTPClient(),
 	}
 
@@ -660,6 +723,7 @@ func TestListJobWithFilter(t *testing.T) {
 		uuidGen:          uuid.NewGenerator(),
 		masterMetaClient: metadata.NewMasterMetadataClient(metadata.JobManagerUUID, mockMaster.GetFrameMetaClient()),
 		frameMetaClient:  mockMaster.GetFrameMetaClient(),
+		tenantQuota:      newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		jobHTTPClient:    jobMock.NewMockNilReturnJobHTTPClient(),
 	}
 
@@ -757,7 +821,7 @@ func dispatchJobAndMeetError(
 	mgr.JobFsm.JobDispatched(&frameModel.MasterMeta{ID: meta.ID}, false)
 	require.NotNil(t, mgr.QueryJob(meta.ID))
 	mockHandle := &framework.MockHandle{WorkerID: meta.ID}
-	mgr.JobFsm.JobOffline(mockHandle, true /* needFailover */)
+	mgr.JobFsm.JobOffline(mockHandle, true /* needFailover */, nil)
 }
 
 func TestJobManagerIterPendingJobs(t *testing.T) {
@@ -779,6 +843,7 @@ func TestJobManagerIterPendingJobs(t *testing.T) {
 		JobFsm:          NewJobFsm(),
 		uuidGen:         uuid.NewGenerator(),
 		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		tenantQuota:     newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		jobHTTPClient:   jobMock.NewMockNilReturnJobHTTPClient(),
 		JobBackoffMgr:   mockBackoffMgr,
 		jobOperator:     mockJobOperator,
@@ -858,6 +923,7 @@ func TestFailoverWithCreateWorkerOpt(t *testing.T) {
 		JobFsm:          NewJobFsm(),
 		uuidGen:         uuid.NewGenerator(),
 		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		tenantQuota:     newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
 		jobHTTPClient:   jobMock.NewMockNilReturnJobHTTPClient(),
 		JobBackoffMgr:   mockBackoffMgr,
 		jobOperator:     mockJobOperator,
@@ -896,6 +962,278 @@ func (m *mockBaseMasterCheckCreateOpts) CreateWorker(
 	return uuid.NewGenerator().NewString(), nil
 }
 
+type mockBaseMasterRecordCreatedJobs struct {
+	*framework.MockMasterImpl
+	createdJobs []string
+}
+
+func (m *mockBaseMasterRecordCreatedJobs) CreateWorker(
+	workerType framework.WorkerType,
+	config framework.WorkerConfig,
+	opts ...framework.CreateWorkerOpt,
+) (frameModel.WorkerID, error) {
+	jobID := config.(*frameModel.MasterMeta).ID
+	m.createdJobs = append(m.createdJobs, jobID)
+	return jobID, nil
+}
+
+func TestJobManagerPriorityScheduling(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	masterImpl := framework.NewMockMasterImpl(t, "", "priority-scheduling-test")
+	framework.MockMasterPrepareMeta(ctx, t, masterImpl)
+	mockMaster := &mockBaseMasterRecordCreatedJobs{MockMasterImpl: masterImpl}
+	ctrl := gomock.NewController(t)
+	mockJobOperator := jobopMock.NewMockJobOperator(ctrl)
+	mockJobOperator.EXPECT().IsJobCanceling(gomock.Any(), gomock.Any()).AnyTimes().Return(false)
+	clocker := clock.New()
+	mgr := &JobManagerImpl{
+		BaseMaster:      mockMaster,
+		JobFsm:          NewJobFsm(),
+		uuidGen:         uuid.NewGenerator(),
+		clocker:         clocker,
+		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		tenantQuota:     newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
+		jobHTTPClient:   jobMock.NewMockNilReturnJobHTTPClient(),
+		JobBackoffMgr:   jobop.NewBackoffManagerImpl(clocker, jobop.NewDefaultBackoffConfig()),
+		jobOperator:     mockJobOperator,
+		priorityConfig: &scheduler.PriorityConfig{
+			MaxRunningJobs:   1,
+			EnablePreemption: true,
+		},
+	}
+	mockMaster.Impl = mgr
+	err := mockMaster.Init(ctx)
+	require.NoError(t, err)
+
+	newJob := func(id string, seqID uint, priority frameModel.PriorityClass) *frameModel.MasterMeta {
+		job := &frameModel.MasterMeta{
+			Model: ormModel.Model{SeqID: seqID},
+			ID:    id,
+			Type:  frameModel.FakeJobMaster,
+			State: frameModel.MasterStateInit,
+			Ext:   frameModel.MasterMetaExt{Priority: priority},
+		}
+		require.NoError(t, mgr.frameMetaClient.UpsertJob(ctx, job))
+		return job
+	}
+
+	// a low priority job is running.
+	lowJob := newJob("low-job", 1, frameModel.PriorityClassLow)
+	mgr.JobFsm.JobDispatched(lowJob, false /* addFromFailover */)
+	handle := &framework.MockWorkerHandler{WorkerID: lowJob.ID}
+	handle.On("IsTombStone").Return(false)
+	// the running job is paused rather than canceled.
+	handle.On("SendMessage", mock.Anything, mock.Anything, mock.MatchedBy(func(msg *frameModel.StatusChangeRequest) bool {
+		return msg.ExpectState == frameModel.WorkerStateStopped && msg.Pause
+	}), true).Return(nil)
+	require.NoError(t, mgr.JobFsm.JobOnline(handle))
+
+	// the queued jobs are not dispatched since the capacity is short, and the
+	// running job is preempted by the job with the highest priority.
+	mgr.JobFsm.JobQueued(newJob("normal-job", 2, frameModel.PriorityClassNormal))
+	mgr.JobFsm.JobQueued(newJob("high-job", 3, frameModel.PriorityClassHigh))
+	require.NoError(t, mgr.Tick(ctx))
+	require.Empty(t, mockMaster.createdJobs)
+	require.Contains(t, mgr.preemptingJobs, lowJob.ID)
+	// the stop message is not sent again before the preempted job exits.
+	require.NoError(t, mgr.Tick(ctx))
+	handle.AssertNumberOfCalls(t, "SendMessage", 1)
+
+	// the preempted job is paused and it's queued again.
+	tombstone := &framework.MockWorkerHandler{WorkerID: lowJob.ID}
+	tombstone.On("IsTombStone").Return(true)
+	tombstone.On("CleanTombstone").Return(nil).Once()
+	err = mgr.OnWorkerOffline(tombstone, errors.ErrWorkerPaused.FastGenByArgs())
+	require.NoError(t, err)
+	require.NotContains(t, mgr.preemptingJobs, lowJob.ID)
+	meta, err := mgr.frameMetaClient.GetJobByID(ctx, lowJob.ID)
+	require.NoError(t, err)
+	require.Equal(t, frameModel.MasterStateInit, meta.State)
+	require.NotNil(t, mgr.JobFsm.QueryJob(lowJob.ID))
+
+	// only the job with the highest priority is dispatched.
+	require.NoError(t, mgr.Tick(ctx))
+	require.Equal(t, []string{"high-job"}, mockMaster.createdJobs)
+	require.Equal(t, 1, mgr.JobFsm.ScheduledJobCount())
+
+	// the paused job is resumed after the jobs with higher priorities finish.
+	finishJob := func(jobID string) {
+		handle := &framework.MockWorkerHandler{WorkerID: jobID}
+		handle.On("IsTombStone").Return(false)
+		require.NoError(t, mgr.JobFsm.JobOnline(handle))
+		tombstone := &framework.MockWorkerHandler{WorkerID: jobID}
+		tombstone.On("IsTombStone").Return(true)
+		tombstone.On("CleanTombstone").Return(nil).Once()
+		require.NoError(t, mgr.OnWorkerOffline(tombstone, errors.ErrWorkerFinish.FastGenByArgs()))
+	}
+	finishJob("high-job")
+	require.NoError(t, mgr.Tick(ctx))
+	require.Equal(t, []string{"high-job", "normal-job"}, mockMaster.createdJobs)
+	finishJob("normal-job")
+	require.NoError(t, mgr.Tick(ctx))
+	require.Equal(t, []string{"high-job", "normal-job", "low-job"}, mockMaster.createdJobs)
+	require.Empty(t, mgr.preemptingJobs)
+}
+
+type mockExecutorSlots struct {
+	free bool
+}
+
+func (m *mockExecutorSlots) HasFreeSlots(ctx context.Context, tasks int) (bool, error) {
+	return m.free, nil
+}
+
+func TestJobManagerPreemptForExecutorSlots(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	masterImpl := framework.NewMockMasterImpl(t, "", "preempt-executor-slots-test")
+	framework.MockMasterPrepareMeta(ctx, t, masterImpl)
+	mockMaster := &mockBaseMasterRecordCreatedJobs{MockMasterImpl: masterImpl}
+	ctrl := gomock.NewController(t)
+	mockJobOperator := jobopMock.NewMockJobOperator(ctrl)
+	mockJobOperator.EXPECT().IsJobCanceling(gomock.Any(), gomock.Any()).AnyTimes().Return(false)
+	clocker := clock.NewMock()
+	slots := &mockExecutorSlots{}
+	mgr := &JobManagerImpl{
+		BaseMaster:      mockMaster,
+		JobFsm:          NewJobFsm(),
+		uuidGen:         uuid.NewGenerator(),
+		clocker:         clocker,
+		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		tenantQuota:     newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
+		jobHTTPClient:   jobMock.NewMockNilReturnJobHTTPClient(),
+		JobBackoffMgr:   jobop.NewBackoffManagerImpl(clocker, jobop.NewDefaultBackoffConfig()),
+		jobOperator:     mockJobOperator,
+		priorityConfig:  &scheduler.PriorityConfig{EnablePreemption: true},
+		executorSlots:   slots,
+	}
+	mockMaster.Impl = mgr
+	err := mockMaster.Init(ctx)
+	require.NoError(t, err)
+
+	runJob := func(id string, seqID uint, priority frameModel.PriorityClass) *framework.MockWorkerHandler {
+		job := &frameModel.MasterMeta{
+			Model: ormModel.Model{SeqID: seqID},
+			ID:    id,
+			Type:  frameModel.FakeJobMaster,
+			State: frameModel.MasterStateInit,
+			Ext:   frameModel.MasterMetaExt{Priority: priority},
+		}
+		require.NoError(t, mgr.frameMetaClient.UpsertJob(ctx, job))
+		mgr.JobFsm.JobDispatched(job, false /* addFromFailover */)
+		handle := &framework.MockWorkerHandler{WorkerID: id}
+		handle.On("IsTombStone").Return(false)
+		require.NoError(t, mgr.JobFsm.JobOnline(handle))
+		return handle
+	}
+	lowHandle := runJob("low-job", 1, frameModel.PriorityClassLow)
+	lowHandle.On("SendMessage", mock.Anything, mock.Anything, mock.MatchedBy(func(msg *frameModel.StatusChangeRequest) bool {
+		return msg.Pause
+	}), true).Return(nil)
+	runJob("high-job", 2, frameModel.PriorityClassHigh)
+
+	// the blocked tasks expire if the job doesn't preempt others in time.
+	mgr.OnTaskBlocked("high-job")
+	mgr.OnTaskBlocked("unknown-job")
+	clocker.Add(blockedTaskTTL)
+	require.NoError(t, mgr.Tick(ctx))
+	require.Empty(t, mgr.preemptingJobs)
+	require.Empty(t, mgr.blockedJobs)
+
+	// a worker of the high priority job is blocked by the executor slots,
+	// so the low priority job is paused.
+	mgr.OnTaskBlocked("high-job")
+	require.NoError(t, mgr.Tick(ctx))
+	require.Contains(t, mgr.preemptingJobs, "low-job")
+	lowHandle.AssertNumberOfCalls(t, "SendMessage", 1)
+
+	tombstone := &framework.MockWorkerHandler{WorkerID: "low-job"}
+	tombstone.On("IsTombStone").Return(true)
+	tombstone.On("CleanTombstone").Return(nil).Once()
+	require.NoError(t, mgr.OnWorkerOffline(tombstone, errors.ErrWorkerPaused.FastGenByArgs()))
+
+	// the paused job is not resumed until the executors have free slots.
+	require.NoError(t, mgr.Tick(ctx))
+	require.Empty(t, mockMaster.createdJobs)
+	slots.free = true
+	require.NoError(t, mgr.Tick(ctx))
+	require.Equal(t, []string{"low-job"}, mockMaster.createdJobs)
+}
+
+func TestJobManagerQueueTenantJobs(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	masterImpl := framework.NewMockMasterImpl(t, "", "queue-tenant-jobs-test")
+	framework.MockMasterPrepareMeta(ctx, t, masterImpl)
+	mockMaster := &mockBaseMasterRecordCreatedJobs{MockMasterImpl: masterImpl}
+	ctrl := gomock.NewController(t)
+	mockJobOperator := jobopMock.NewMockJobOperator(ctrl)
+	mockJobOperator.EXPECT().IsJobCanceling(gomock.Any(), gomock.Any()).AnyTimes().Return(false)
+	clocker := clock.New()
+	mgr := &JobManagerImpl{
+		BaseMaster:        mockMaster,
+		JobFsm:            NewJobFsm(),
+		uuidGen:           uuid.NewGenerator(),
+		clocker:           clocker,
+		frameMetaClient:   mockMaster.GetFrameMetaClient(),
+		tenantQuota:       newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
+		jobStatusChangeMu: ctxmu.New(),
+		jobHTTPClient:     jobMock.NewMockNilReturnJobHTTPClient(),
+		JobBackoffMgr:     jobop.NewBackoffManagerImpl(clocker, jobop.NewDefaultBackoffConfig()),
+		jobOperator:       mockJobOperator,
+	}
+	mockMaster.Impl = mgr
+	err := mockMaster.Init(ctx)
+	require.NoError(t, err)
+
+	err = mgr.frameMetaClient.UpsertTenantQuota(ctx, &ormModel.TenantQuota{
+		TenantID:  "tenant",
+		MaxJobs:   1,
+		QueueJobs: true,
+	})
+	require.NoError(t, err)
+
+	// a job of the tenant is running.
+	runningJob := &frameModel.MasterMeta{
+		ID:    "running-job",
+		Type:  frameModel.FakeJobMaster,
+		State: frameModel.MasterStateInit,
+		Ext:   frameModel.MasterMetaExt{TenantID: "tenant"},
+	}
+	require.NoError(t, mgr.frameMetaClient.UpsertJob(ctx, runningJob))
+	mgr.JobFsm.JobDispatched(runningJob, false /* addFromFailover */)
+	handle := &framework.MockWorkerHandler{WorkerID: runningJob.ID}
+	handle.On("IsTombStone").Return(false)
+	require.NoError(t, mgr.JobFsm.JobOnline(handle))
+
+	// the job exceeding the quota is queued instead of being rejected.
+	job, err := mgr.CreateJob(ctx, &pb.CreateJobRequest{
+		Job:      &pb.Job{Id: "queued-job", Type: pb.Job_FakeJob},
+		TenantId: "tenant",
+	})
+	require.NoError(t, err)
+	require.Equal(t, pb.Job_Created, job.State)
+	require.NoError(t, mgr.Tick(ctx))
+	require.Empty(t, mockMaster.createdJobs)
+	require.Equal(t, 1, mgr.JobFsm.JobCount(pb.Job_Created))
+
+	// the queued job is dispatched after the running job exits.
+	mgr.JobFsm.JobOffline(handle, false /* needFailover */, nil)
+	require.NoError(t, mgr.Tick(ctx))
+	require.Equal(t, []string{"queued-job"}, mockMaster.createdJobs)
+	require.Equal(t, 1, mgr.JobFsm.ScheduledJobCount())
+}
+
 func TestIsJobTerminated(t *testing.T) {
 	require.False(t, isJobTerminated(frameModel.MasterStateUninit))
 	require.False(t, isJobTerminated(frameModel.MasterStateInit))
@@ -1009,3 +1347,106 @@ func TestBuildPBJob(t *testing.T) {
 		require.True(t, proto.Equal(tc.job, job))
 	}
 }
+
+func TestJobManagerCreateCustomJob(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	masterImpl := framework.NewMockMasterImpl(t, "", "custom-job-test")
+	framework.MockMasterPrepareMeta(ctx, t, masterImpl)
+	mockMaster := &mockBaseMasterRecordCreatedJobs{MockMasterImpl: masterImpl}
+	mgr := &JobManagerImpl{
+		BaseMaster:      mockMaster,
+		JobFsm:          NewJobFsm(),
+		uuidGen:         uuid.NewGenerator(),
+		clocker:         clock.New(),
+		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		tenantQuota:     newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
+		jobHTTPClient:   jobMock.NewMockNilReturnJobHTTPClient(),
+	}
+
+	customType := pb.Job_Type(engineModel.JobTypeCustomBase + 1)
+	job, err := mgr.CreateJob(ctx, &pb.CreateJobRequest{
+		Job: &pb.Job{Id: "custom-job", Type: customType, Config: []byte("{}")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, customType, job.Type)
+	require.Equal(t, []string{"custom-job"}, mockMaster.createdJobs)
+
+	meta, err := mgr.frameMetaClient.GetJobByID(ctx, "custom-job")
+	require.NoError(t, err)
+	require.Equal(t, frameModel.WorkerType(customType), meta.Type)
+
+	jobs, err := mgr.ListJobs(ctx, &pb.ListJobsRequest{Type: customType})
+	require.NoError(t, err)
+	require.Len(t, jobs.Jobs, 1)
+	require.Equal(t, "custom-job", jobs.Jobs[0].Id)
+
+	_, err = mgr.CreateJob(ctx, &pb.CreateJobRequest{
+		Job: &pb.Job{Id: "unknown-job", Type: pb.Job_Type(100)},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestJobManagerCreateJobWithPriority(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	masterImpl := framework.NewMockMasterImpl(t, "", "priority-job-test")
+	framework.MockMasterPrepareMeta(ctx, t, masterImpl)
+	mockMaster := &mockBaseMasterRecordCreatedJobs{MockMasterImpl: masterImpl}
+	mgr := &JobManagerImpl{
+		BaseMaster:      mockMaster,
+		JobFsm:          NewJobFsm(),
+		uuidGen:         uuid.NewGenerator(),
+		clocker:         clock.New(),
+		frameMetaClient: mockMaster.GetFrameMetaClient(),
+		tenantQuota:     newTenantQuotaChecker(mockMaster.GetFrameMetaClient(), nil),
+		jobHTTPClient:   jobMock.NewMockNilReturnJobHTTPClient(),
+		priorityConfig: &scheduler.PriorityConfig{
+			JobTypes: map[string]frameModel.PriorityClass{
+				pb.Job_FakeJob.String(): frameModel.PriorityClassLow,
+			},
+		},
+	}
+
+	// the priority of the job type is used by default.
+	job, err := mgr.CreateJob(ctx, &pb.CreateJobRequest{
+		Job: &pb.Job{Id: "default-job", Type: pb.Job_FakeJob, Config: []byte("{}")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, string(frameModel.PriorityClassLow), job.Priority)
+
+	// the priority of the job overrides the priority of the job type.
+	job, err = mgr.CreateJob(ctx, &pb.CreateJobRequest{
+		Job: &pb.Job{
+			Id:       "high-job",
+			Type:     pb.Job_FakeJob,
+			Config:   []byte("{}"),
+			Priority: string(frameModel.PriorityClassHigh),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, string(frameModel.PriorityClassHigh), job.Priority)
+	require.Equal(t, []string{"default-job", "high-job"}, mockMaster.createdJobs)
+
+	meta, err := mgr.frameMetaClient.GetJobByID(ctx, "default-job")
+	require.NoError(t, err)
+	require.Equal(t, frameModel.PriorityClassLow, meta.Ext.Priority)
+	meta, err = mgr.frameMetaClient.GetJobByID(ctx, "high-job")
+	require.NoError(t, err)
+	require.Equal(t, frameModel.PriorityClassHigh, meta.Ext.Priority)
+
+	job, err = mgr.GetJob(ctx, &pb.GetJobRequest{Id: "high-job"})
+	require.NoError(t, err)
+	require.Equal(t, string(frameModel.PriorityClassHigh), job.Priority)
+
+	_, err = mgr.CreateJob(ctx, &pb.CreateJobRequest{
+		Job: &pb.Job{Id: "unknown-priority-job", Type: pb.Job_FakeJob, Priority: "urgent"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
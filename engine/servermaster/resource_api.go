@@ -0,0 +1,79 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"net/http"
+	"strconv"
+
+	externRescManager "github.com/pingcap/tiflow/engine/pkg/externalresource/manager"
+	"github.com/pingcap/tiflow/engine/pkg/openapi"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// resourceAPI serves the inventory of the external resources through the
+// OpenAPI. The resources are stored in the framework metastore and the
+// orphaned resources are removed by the GC runner of the leader, so the
+// API can be served by any server master without forwarding to the leader.
+type resourceAPI struct {
+	inventory *externRescManager.Inventory
+}
+
+func newResourceAPI(inventory *externRescManager.Inventory) *resourceAPI {
+	return &resourceAPI{inventory: inventory}
+}
+
+func (a *resourceAPI) registerRoutes(router *http.ServeMux) {
+	router.HandleFunc("GET /api/v1/resources", a.listResources)
+	router.HandleFunc("POST /api/v1/resources/purge", a.purgeResources)
+}
+
+// listResources lists the resources with their sizes and ages.
+// The resources can be filtered by the `job_id` and `worker_id` query
+// parameters, and only the orphaned resources are listed if `orphaned`
+// is true.
+func (a *resourceAPI) listResources(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := externRescManager.ResourceFilter{
+		JobID:    query.Get("job_id"),
+		WorkerID: query.Get("worker_id"),
+	}
+	if s := query.Get("orphaned"); s != "" {
+		orphaned, err := strconv.ParseBool(s)
+		if err != nil {
+			openapi.WriteHTTPError(w, errors.ErrInvalidArgument.GenWithStackByArgs("orphaned"))
+			return
+		}
+		filter.OrphanedOnly = orphaned
+	}
+
+	resources, err := a.inventory.ListResources(r.Context(), filter)
+	if err != nil {
+		openapi.WriteHTTPError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resources)
+}
+
+// purgeResources removes the orphaned resources, or only the orphaned
+// resources of a job if the `job_id` query parameter is set. The purged
+// resources are returned, and they are removed asynchronously.
+func (a *resourceAPI) purgeResources(w http.ResponseWriter, r *http.Request) {
+	resources, err := a.inventory.PurgeOrphanedResources(r.Context(), r.URL.Query().Get("job_id"))
+	if err != nil {
+		openapi.WriteHTTPError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resources)
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	externRescManager "github.com/pingcap/tiflow/engine/pkg/externalresource/manager"
+	resModel "github.com/pingcap/tiflow/engine/pkg/externalresource/model"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceAPI(t *testing.T) {
+	t.Parallel()
+
+	metaCli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer metaCli.Close()
+
+	router := http.NewServeMux()
+	newResourceAPI(externRescManager.NewInventory(metaCli, nil)).registerRoutes(router)
+	do := func(method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	decode := func(w *httptest.ResponseRecorder) []*externRescManager.ResourceInfo {
+		var resources []*externRescManager.ResourceInfo
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resources))
+		return resources
+	}
+
+	ctx := context.Background()
+	require.NoError(t, metaCli.UpsertJob(ctx, &frameModel.MasterMeta{ID: "job1"}))
+	for _, res := range []*resModel.ResourceMeta{
+		{ID: "/local/resource-1", Job: "job1", Worker: "worker1", Executor: "executor1"},
+		{ID: "/local/resource-2", Job: "job2", Worker: "worker2", Executor: "executor1"},
+	} {
+		require.NoError(t, metaCli.CreateResource(ctx, res))
+	}
+
+	w := do(http.MethodGet, "/api/v1/resources")
+	require.Equal(t, http.StatusOK, w.Code)
+	resources := decode(w)
+	require.Len(t, resources, 2)
+	// the size is unknown since the local file storage is not configured.
+	require.Equal(t, int64(-1), resources[0].Size)
+
+	w = do(http.MethodGet, "/api/v1/resources?worker_id=worker1")
+	require.Equal(t, http.StatusOK, w.Code)
+	resources = decode(w)
+	require.Len(t, resources, 1)
+	require.Equal(t, "/local/resource-1", resources[0].ID)
+
+	w = do(http.MethodGet, "/api/v1/resources?orphaned=true")
+	require.Equal(t, http.StatusOK, w.Code)
+	resources = decode(w)
+	require.Len(t, resources, 1)
+	require.Equal(t, "/local/resource-2", resources[0].ID)
+	require.True(t, resources[0].Orphaned)
+
+	w = do(http.MethodGet, "/api/v1/resources?orphaned=x")
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = do(http.MethodPost, "/api/v1/resources/purge?job_id=job1")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Empty(t, decode(w))
+
+	w = do(http.MethodPost, "/api/v1/resources/purge")
+	require.Equal(t, http.StatusOK, w.Code)
+	resources = decode(w)
+	require.Len(t, resources, 1)
+	require.Equal(t, "/local/resource-2", resources[0].ID)
+	require.True(t, resources[0].GCPending)
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"sort"
+
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	"github.com/pingcap/tiflow/pkg/errors"
+)
+
+// PriorityConfig is the config of the priority scheduling of jobs.
+type PriorityConfig struct {
+	// MaxRunningJobs is the max number of job masters which are dispatched
+	// to the executors, the other jobs are queued by their priorities.
+	// Zero means unlimited.
+	MaxRunningJobs int `toml:"max-running-jobs" json:"max-running-jobs"`
+	// ExecutorSlots is the max number of tasks, including both job masters
+	// and workers, which can run on an executor. A queued job can preempt
+	// a running job when the slots of all executors are used up.
+	// Zero means unlimited.
+	ExecutorSlots int `toml:"executor-slots" json:"executor-slots"`
+	// EnablePreemption indicates whether a queued job can preempt a running
+	// job with a lower priority.
+	EnablePreemption bool `toml:"enable-preemption" json:"enable-preemption"`
+	// JobTypes maps the job type, such as "DM", to its default priority class,
+	// which is used if a job doesn't specify its priority when it's created.
+	// The jobs of the types which are not listed are of the normal priority.
+	JobTypes map[string]frameModel.PriorityClass `toml:"job-types" json:"job-types"`
+}
+
+// NewDefaultPriorityConfig creates a default priority config.
+func NewDefaultPriorityConfig() *PriorityConfig {
+	return &PriorityConfig{}
+}
+
+// Validate checks whether the config is valid.
+func (c *PriorityConfig) Validate() error {
+	if c.MaxRunningJobs < 0 {
+		return errors.ErrInvalidArgument.GenWithStackByArgs("max-running-jobs")
+	}
+	if c.ExecutorSlots < 0 {
+		return errors.ErrInvalidArgument.GenWithStackByArgs("executor-slots")
+	}
+	for tp, priority := range c.JobTypes {
+		if _, ok := pb.Job_Type_value[tp]; !ok {
+			return errors.ErrInvalidArgument.GenWithStackByArgs("job type " + tp)
+		}
+		if err := priority.Validate(); err != nil {
+			return errors.ErrInvalidArgument.Wrap(err).GenWithStackByArgs("priority of job type " + tp)
+		}
+	}
+	return nil
+}
+
+// JobPriority returns the priority class of the given job type.
+func (c *PriorityConfig) JobPriority(tp pb.Job_Type) frameModel.PriorityClass {
+	if priority, ok := c.JobTypes[tp.String()]; ok && priority != "" {
+		return priority
+	}
+	return frameModel.PriorityClassNormal
+}
+
+// SortJobsByPriority sorts the jobs so that the jobs with a higher priority
+// come first, and the jobs with the same priority are in creation order.
+func SortJobsByPriority(jobs []*frameModel.MasterMeta) {
+	sort.SliceStable(jobs, func(i, j int) bool {
+		li, lj := jobs[i].Ext.Priority.Level(), jobs[j].Ext.Priority.Level()
+		if li != lj {
+			return li > lj
+		}
+		if jobs[i].SeqID != jobs[j].SeqID {
+			return jobs[i].SeqID < jobs[j].SeqID
+		}
+		return jobs[i].ID < jobs[j].ID
+	})
+}
+
+// PickPreemptionVictim picks a running job to be preempted by the queued job.
+// Only the jobs with a lower priority can be preempted, the one with the lowest
+// priority is picked, and the latest created job is preferred among the jobs
+// with the same priority, since it's likely to lose the least progress.
+// Nil is returned if no job can be preempted.
+func PickPreemptionVictim(
+	queued *frameModel.MasterMeta, running []*frameModel.MasterMeta,
+) *frameModel.MasterMeta {
+	var victim *frameModel.MasterMeta
+	for _, job := range running {
+		if job.Ext.Priority.Level() >= queued.Ext.Priority.Level() {
+			continue
+		}
+		if victim == nil {
+			victim = job
+			continue
+		}
+		lv, lj := victim.Ext.Priority.Level(), job.Ext.Priority.Level()
+		if lj < lv || (lj == lv && job.SeqID > victim.SeqID) {
+			victim = job
+		}
+	}
+	return victim
+}
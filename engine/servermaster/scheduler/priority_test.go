@@ -0,0 +1,97 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"testing"
+
+	pb "github.com/pingcap/tiflow/engine/enginepb"
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/stretchr/testify/require"
+)
+
+func newPriorityJob(id string, seqID uint, priority frameModel.PriorityClass) *frameModel.MasterMeta {
+	return &frameModel.MasterMeta{
+		Model: ormModel.Model{SeqID: seqID},
+		ID:    id,
+		Ext:   frameModel.MasterMetaExt{Priority: priority},
+	}
+}
+
+func TestPriorityConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewDefaultPriorityConfig()
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, frameModel.PriorityClassNormal, cfg.JobPriority(pb.Job_DM))
+
+	cfg.JobTypes = map[string]frameModel.PriorityClass{
+		"DM":      frameModel.PriorityClassHigh,
+		"CVSDemo": frameModel.PriorityClassLow,
+	}
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, frameModel.PriorityClassHigh, cfg.JobPriority(pb.Job_DM))
+	require.Equal(t, frameModel.PriorityClassLow, cfg.JobPriority(pb.Job_CVSDemo))
+	require.Equal(t, frameModel.PriorityClassNormal, cfg.JobPriority(pb.Job_FakeJob))
+
+	cfg.JobTypes["Unknown"] = frameModel.PriorityClassHigh
+	require.ErrorContains(t, cfg.Validate(), "Unknown")
+	delete(cfg.JobTypes, "Unknown")
+	cfg.JobTypes["CDC"] = "urgent"
+	require.ErrorContains(t, cfg.Validate(), "CDC")
+	delete(cfg.JobTypes, "CDC")
+	cfg.MaxRunningJobs = -1
+	require.ErrorContains(t, cfg.Validate(), "max-running-jobs")
+	cfg.MaxRunningJobs = 0
+	cfg.ExecutorSlots = -1
+	require.ErrorContains(t, cfg.Validate(), "executor-slots")
+}
+
+func TestSortJobsByPriority(t *testing.T) {
+	t.Parallel()
+
+	jobs := []*frameModel.MasterMeta{
+		newPriorityJob("j1", 1, frameModel.PriorityClassLow),
+		newPriorityJob("j2", 2, ""),
+		newPriorityJob("j3", 3, frameModel.PriorityClassHigh),
+		newPriorityJob("j4", 4, frameModel.PriorityClassNormal),
+		newPriorityJob("j0", 0, frameModel.PriorityClassHigh),
+	}
+	SortJobsByPriority(jobs)
+	ids := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+	require.Equal(t, []string{"j0", "j3", "j2", "j4", "j1"}, ids)
+}
+
+func TestPickPreemptionVictim(t *testing.T) {
+	t.Parallel()
+
+	running := []*frameModel.MasterMeta{
+		newPriorityJob("j1", 1, frameModel.PriorityClassNormal),
+		newPriorityJob("j2", 2, frameModel.PriorityClassLow),
+		newPriorityJob("j3", 3, frameModel.PriorityClassLow),
+		newPriorityJob("j4", 4, frameModel.PriorityClassHigh),
+	}
+	// the latest created job with the lowest priority is picked.
+	victim := PickPreemptionVictim(newPriorityJob("q", 5, frameModel.PriorityClassHigh), running)
+	require.Equal(t, "j3", victim.ID)
+	victim = PickPreemptionVictim(newPriorityJob("q", 5, frameModel.PriorityClassNormal), running)
+	require.Equal(t, "j3", victim.ID)
+	// jobs with the same or a higher priority can't be preempted.
+	require.Nil(t, PickPreemptionVictim(newPriorityJob("q", 5, frameModel.PriorityClassLow), running))
+	require.Nil(t, PickPreemptionVictim(newPriorityJob("q", 5, frameModel.PriorityClassHigh), running[3:]))
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/pingcap/tiflow/engine/pkg/openapi"
 	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
 	"github.com/pingcap/tiflow/engine/pkg/p2p"
+	"github.com/pingcap/tiflow/engine/pkg/quota"
 	"github.com/pingcap/tiflow/engine/pkg/rpcutil"
 	"github.com/pingcap/tiflow/engine/pkg/tenant"
 	"github.com/pingcap/tiflow/engine/servermaster/scheduler"
@@ -100,6 +101,7 @@ type Server struct {
 	jobManager             JobManager
 	resourceManagerService *externRescManager.Service
 	scheduler              *scheduler.Scheduler
+	tenantSlots            *quota.ClusterSlots
 
 	// file resource GC
 	gcRunner      externRescManager.GCRunner
@@ -197,6 +199,12 @@ func (s *Server) DeleteJob(ctx context.Context, req *pb.DeleteJobRequest) (*empt
 	return s.jobManager.DeleteJob(ctx, req)
 }
 
+// ListJobEvents implements pb interface. The events are stored in the framework
+// metastore, so they are served by any server master without the leader.
+func (s *Server) ListJobEvents(ctx context.Context, req *pb.ListJobEventsRequest) (*pb.ListJobEventsResponse, error) {
+	return listJobEvents(ctx, s.frameMetaClient, req)
+}
+
 // RegisterExecutor implements grpc interface, and passes request onto executor manager.
 func (s *Server) RegisterExecutor(ctx context.Context, req *pb.RegisterExecutorRequest) (*pb.Executor, error) {
 	executorMeta, err := s.executorManager.AllocateNewExec(ctx, req)
@@ -251,6 +259,7 @@ func (s *Server) ListMasters(ctx context.Context, req *pb.ListMastersRequest) (*
 
 // ScheduleTask implements grpc interface. It works as follows
 // - receives request from job master
+// - checks the executor slots quota of the tenant in the whole cluster
 // - queries resource manager to allocate resource and maps tasks to executors
 // - returns scheduler response to job master
 func (s *Server) ScheduleTask(ctx context.Context, req *pb.ScheduleTaskRequest) (*pb.ScheduleTaskResponse, error) {
@@ -259,6 +268,18 @@ func (s *Server) ScheduleTask(ctx context.Context, req *pb.ScheduleTaskRequest)
 		return nil, err
 	}
 
+	if err := s.tenantSlots.Acquire(ctx, req.GetTenantId(), req.GetTaskId()); err != nil {
+		if errors.Is(err, errors.ErrClusterResourceNotEnough) {
+			// the job masters are scheduled by the job manager.
+			jobID := req.GetJobId()
+			if jobID == "" || jobID == metadata.JobManagerUUID {
+				jobID = req.GetTaskId()
+			}
+			s.jobManager.OnTaskBlocked(jobID)
+		}
+		return nil, err
+	}
+
 	schedulerResp, err := s.scheduler.ScheduleTask(ctx, schedulerReq)
 	if err != nil {
 		return nil, err
@@ -615,7 +636,8 @@ func (s *Server) createHTTPServer(conn *grpc.ClientConn) (*http.Server, error) {
 	}
 
 	router := http.NewServeMux()
-	registerRoutes(router, grpcMux, s.forwardJobAPI)
+	registerRoutes(router, grpcMux, s.forwardJobAPI, newTenantAPI(s.frameMetaClient),
+		newResourceAPI(externRescManager.NewInventory(s.frameMetaClient, &s.cfg.Storage)))
 
 	return &http.Server{
 		Handler:           router,
@@ -775,6 +797,12 @@ func (s *Server) runLeaderService(ctx context.Context) (err error) {
 	s.scheduler = scheduler.NewScheduler(
 		s.executorManager,
 		s.resourceManagerService)
+	s.tenantSlots = quota.NewClusterSlots(s.frameMetaClient, func() int {
+		if s.cfg.JobPriority == nil || s.cfg.JobPriority.ExecutorSlots == 0 {
+			return 0
+		}
+		return len(s.executorManager.GetExecutorInfos()) * s.cfg.JobPriority.ExecutorSlots
+	})
 
 	// TODO refactor this method to make it more readable and maintainable.
 	errg, errgCtx := errgroup.WithContext(ctx)
@@ -794,7 +822,8 @@ func (s *Server) runLeaderService(ctx context.Context) (err error) {
 	s.leaderDegrader.updateExecutorManager(true)
 
 	dctx = dctx.WithDeps(dp)
-	s.jobManager, err = NewJobManagerImpl(dctx, metadata.JobManagerUUID, s.cfg.JobBackoff)
+	s.jobManager, err = NewJobManagerImpl(dctx, metadata.JobManagerUUID, s.cfg.JobBackoff, s.cfg.JobPriority, s.cfg.JobEvent,
+		externRescManager.NewInventory(s.frameMetaClient, &s.cfg.Storage), s.tenantSlots)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,159 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/pkg/openapi"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/engine/pkg/quota"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// TenantQuotaStatus is the quota and the usage of a tenant.
+type TenantQuotaStatus struct {
+	TenantID         string `json:"tenant-id"`
+	MaxJobs          int    `json:"max-jobs"`
+	MaxExecutorSlots int    `json:"max-executor-slots"`
+	MaxStorageBytes  int64  `json:"max-storage-bytes"`
+	QueueJobs        bool   `json:"queue-jobs"`
+	// Jobs is the number of jobs of the tenant which are not terminated.
+	Jobs int `json:"jobs"`
+}
+
+// tenantAPI manages the tenant quotas through the OpenAPI.
+// The quotas are stored in the framework metastore, so the API can
+// be served by any server master without forwarding to the leader.
+type tenantAPI struct {
+	metaCli pkgOrm.Client
+}
+
+func newTenantAPI(metaCli pkgOrm.Client) *tenantAPI {
+	return &tenantAPI{metaCli: metaCli}
+}
+
+func (a *tenantAPI) registerRoutes(router *http.ServeMux) {
+	router.HandleFunc("GET /api/v1/tenants", a.listTenants)
+	router.HandleFunc("GET /api/v1/tenants/{tenant_id}/quota", a.getTenantQuota)
+	router.HandleFunc("PUT /api/v1/tenants/{tenant_id}/quota", a.updateTenantQuota)
+	router.HandleFunc("DELETE /api/v1/tenants/{tenant_id}/quota", a.deleteTenantQuota)
+}
+
+// listTenants lists the quota and usage of the tenants which either have a
+// quota or own some jobs.
+func (a *tenantAPI) listTenants(w http.ResponseWriter, r *http.Request) {
+	quotas, err := a.metaCli.QueryTenantQuotas(r.Context())
+	if err != nil {
+		openapi.WriteHTTPError(w, err)
+		return
+	}
+	jobs, err := a.metaCli.QueryJobs(r.Context())
+	if err != nil {
+		openapi.WriteHTTPError(w, err)
+		return
+	}
+
+	tenants := make(map[string]*TenantQuotaStatus)
+	for _, q := range quotas {
+		tenants[q.TenantID] = newTenantQuotaStatus(q)
+	}
+	for _, job := range jobs {
+		tenantID := job.Ext.TenantID
+		if _, ok := tenants[tenantID]; !ok {
+			tenants[tenantID] = newTenantQuotaStatus(&ormModel.TenantQuota{TenantID: tenantID})
+		}
+	}
+	ret := make([]*TenantQuotaStatus, 0, len(tenants))
+	for tenantID, status := range tenants {
+		status.Jobs = quota.CountTenantJobs(jobs, tenantID)
+		ret = append(ret, status)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].TenantID < ret[j].TenantID
+	})
+	writeJSON(w, http.StatusOK, ret)
+}
+
+func (a *tenantAPI) getTenantQuota(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.PathValue("tenant_id")
+	q, err := quota.GetTenantQuota(r.Context(), a.metaCli, tenantID)
+	if err != nil {
+		openapi.WriteHTTPError(w, err)
+		return
+	}
+	jobs, err := a.metaCli.QueryJobs(r.Context())
+	if err != nil {
+		openapi.WriteHTTPError(w, err)
+		return
+	}
+	status := newTenantQuotaStatus(q)
+	status.Jobs = quota.CountTenantJobs(jobs, tenantID)
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (a *tenantAPI) updateTenantQuota(w http.ResponseWriter, r *http.Request) {
+	q := &ormModel.TenantQuota{}
+	if err := json.NewDecoder(r.Body).Decode(q); err != nil {
+		openapi.WriteHTTPError(w, errors.ErrInvalidArgument.GenWithStackByArgs(fmt.Sprintf("failed to decode quota: %v", err)))
+		return
+	}
+	if q.MaxJobs < 0 || q.MaxExecutorSlots < 0 || q.MaxStorageBytes < 0 {
+		openapi.WriteHTTPError(w, errors.ErrInvalidArgument.GenWithStackByArgs("quota must not be negative"))
+		return
+	}
+	q.TenantID = r.PathValue("tenant_id")
+	if err := a.metaCli.UpsertTenantQuota(r.Context(), q); err != nil {
+		openapi.WriteHTTPError(w, err)
+		return
+	}
+	log.Info("update tenant quota", zap.String("tenant-id", q.TenantID),
+		zap.Int("max-jobs", q.MaxJobs), zap.Int("max-executor-slots", q.MaxExecutorSlots),
+		zap.Int64("max-storage-bytes", q.MaxStorageBytes), zap.Bool("queue-jobs", q.QueueJobs))
+	writeJSON(w, http.StatusOK, newTenantQuotaStatus(q))
+}
+
+func (a *tenantAPI) deleteTenantQuota(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.PathValue("tenant_id")
+	if _, err := a.metaCli.DeleteTenantQuota(r.Context(), tenantID); err != nil {
+		openapi.WriteHTTPError(w, err)
+		return
+	}
+	log.Info("delete tenant quota", zap.String("tenant-id", tenantID))
+	w.WriteHeader(http.StatusOK)
+}
+
+func newTenantQuotaStatus(q *ormModel.TenantQuota) *TenantQuotaStatus {
+	return &TenantQuotaStatus{
+		TenantID:         q.TenantID,
+		MaxJobs:          q.MaxJobs,
+		MaxExecutorSlots: q.MaxExecutorSlots,
+		MaxStorageBytes:  q.MaxStorageBytes,
+		QueueJobs:        q.QueueJobs,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warn("Failed to write response", zap.Error(err))
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	frameModel "github.com/pingcap/tiflow/engine/framework/model"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantAPI(t *testing.T) {
+	t.Parallel()
+
+	metaCli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer metaCli.Close()
+
+	router := http.NewServeMux()
+	newTenantAPI(metaCli).registerRoutes(router)
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	ctx := context.Background()
+	for _, job := range []*frameModel.MasterMeta{
+		{ID: "job1", State: frameModel.MasterStateInit, Ext: frameModel.MasterMetaExt{TenantID: "t1"}},
+		{ID: "job2", State: frameModel.MasterStateFinished, Ext: frameModel.MasterMetaExt{TenantID: "t1"}},
+		{ID: "job3", State: frameModel.MasterStateInit, Ext: frameModel.MasterMetaExt{TenantID: "t2"}},
+	} {
+		require.NoError(t, metaCli.UpsertJob(ctx, job))
+	}
+
+	// the quota of a tenant is unlimited by default.
+	w := do(http.MethodGet, "/api/v1/tenants/t1/quota", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	status := &TenantQuotaStatus{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), status))
+	require.Equal(t, TenantQuotaStatus{TenantID: "t1", Jobs: 1}, *status)
+
+	w = do(http.MethodPut, "/api/v1/tenants/t1/quota", `{"max-jobs": 2, "max-executor-slots": 3, "max-storage-bytes": 1024, "queue-jobs": true}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	w = do(http.MethodPut, "/api/v1/tenants/t3/quota", `{"max-jobs": 1}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	w = do(http.MethodPut, "/api/v1/tenants/t3/quota", `{"max-jobs": -1}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	w = do(http.MethodPut, "/api/v1/tenants/t3/quota", `{"max-storage-bytes": -1}`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	w = do(http.MethodPut, "/api/v1/tenants/t3/quota", `{`)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = do(http.MethodGet, "/api/v1/tenants", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var statuses []TenantQuotaStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &statuses))
+	require.Equal(t, []TenantQuotaStatus{
+		{TenantID: "t1", MaxJobs: 2, MaxExecutorSlots: 3, MaxStorageBytes: 1024, QueueJobs: true, Jobs: 1},
+		{TenantID: "t2", Jobs: 1},
+		{TenantID: "t3", MaxJobs: 1},
+	}, statuses)
+
+	w = do(http.MethodDelete, "/api/v1/tenants/t1/quota", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	w = do(http.MethodGet, "/api/v1/tenants/t1/quota", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	status = &TenantQuotaStatus{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), status))
+	require.Equal(t, TenantQuotaStatus{TenantID: "t1", Jobs: 1}, *status)
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/engine/pkg/quota"
+)
+
+const (
+	// tenantQuotaCacheTTL is how long the quotas are cached when the
+	// pending jobs are dispatched.
+	tenantQuotaCacheTTL = 10 * time.Second
+	// tenantStorageUsageTTL is how long the storage usage is cached, it's
+	// longer since the sizes of all resources are inspected to get it.
+	tenantStorageUsageTTL = time.Minute
+)
+
+// tenantStorageUsage returns the size in bytes of the external resources of
+// each tenant, it's implemented by the resource inventory.
+type tenantStorageUsage interface {
+	TenantStorageUsage(ctx context.Context) (map[string]int64, error)
+}
+
+// tenantQuotaChecker checks the max-jobs and max-storage-bytes quotas of the
+// tenants when their jobs are created and dispatched.
+type tenantQuotaChecker struct {
+	quotas  *quota.Cache
+	storage tenantStorageUsage
+	clock   clock.Clock
+
+	mu            sync.Mutex
+	usage         map[string]int64
+	usageLoadedAt time.Time
+}
+
+func newTenantQuotaChecker(cli pkgOrm.TenantQuotaClient, storage tenantStorageUsage) *tenantQuotaChecker {
+	return &tenantQuotaChecker{
+		quotas:  quota.NewCache(cli, tenantQuotaCacheTTL),
+		storage: storage,
+		clock:   clock.New(),
+	}
+}
+
+// checkStorage checks whether the tenant has used up its storage quota.
+func (c *tenantQuotaChecker) checkStorage(ctx context.Context, q *ormModel.TenantQuota) error {
+	if q.MaxStorageBytes == 0 || c.storage == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.usage == nil || c.clock.Since(c.usageLoadedAt) >= tenantStorageUsageTTL {
+		usage, err := c.storage.TenantStorageUsage(ctx)
+		if err != nil {
+			return err
+		}
+		c.usage = usage
+		c.usageLoadedAt = c.clock.Now()
+	}
+	return quota.CheckStorageQuota(q, c.usage[q.TenantID])
+}
+
+// checkDispatch checks whether a pending job of the tenant can be dispatched
+// when `running` jobs of the tenant have been dispatched.
+func (c *tenantQuotaChecker) checkDispatch(ctx context.Context, tenantID string, running int) error {
+	if tenantID == "" {
+		return nil
+	}
+	q, err := c.quotas.Get(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if err := quota.CheckJobQuota(q, running); err != nil {
+		return err
+	}
+	return c.checkStorage(ctx, q)
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servermaster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/engine/pkg/clock"
+	pkgOrm "github.com/pingcap/tiflow/engine/pkg/orm"
+	ormModel "github.com/pingcap/tiflow/engine/pkg/orm/model"
+	"github.com/pingcap/tiflow/engine/pkg/quota"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTenantStorageUsage struct {
+	usage map[string]int64
+	calls int
+}
+
+func (m *mockTenantStorageUsage) TenantStorageUsage(ctx context.Context) (map[string]int64, error) {
+	m.calls++
+	return m.usage, nil
+}
+
+func TestTenantQuotaChecker(t *testing.T) {
+	t.Parallel()
+
+	cli, err := pkgOrm.NewMockClient()
+	require.NoError(t, err)
+	defer cli.Close()
+
+	ctx := context.Background()
+	storage := &mockTenantStorageUsage{usage: map[string]int64{"t1": 100}}
+	checker := newTenantQuotaChecker(cli, storage)
+	mockClock := clock.NewMock()
+	checker.clock = mockClock
+	require.NoError(t, cli.UpsertTenantQuota(ctx, &ormModel.TenantQuota{
+		TenantID: "t1", MaxJobs: 2, MaxStorageBytes: 100,
+	}))
+
+	// the jobs without a tenant or quota are not limited.
+	require.NoError(t, checker.checkDispatch(ctx, "", 10))
+	require.NoError(t, checker.checkDispatch(ctx, "t2", 10))
+	require.Equal(t, 0, storage.calls)
+
+	err = checker.checkDispatch(ctx, "t1", 2)
+	require.True(t, errors.Is(err, errors.ErrTenantQuotaExceeded))
+	require.ErrorContains(t, err, quota.TenantQuotaMaxJobs)
+	err = checker.checkDispatch(ctx, "t1", 1)
+	require.True(t, errors.Is(err, errors.ErrTenantQuotaExceeded))
+	require.ErrorContains(t, err, quota.TenantQuotaMaxStorageBytes)
+
+	// the storage usage is cached.
+	storage.usage = map[string]int64{"t1": 99}
+	require.Error(t, checker.checkDispatch(ctx, "t1", 1))
+	require.Equal(t, 1, storage.calls)
+	mockClock.Add(tenantStorageUsageTTL)
+	require.NoError(t, checker.checkDispatch(ctx, "t1", 1))
+	require.Equal(t, 2, storage.calls)
+}
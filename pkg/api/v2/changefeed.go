@@ -47,6 +47,11 @@ type ChangefeedInterface interface {
 	Get(ctx context.Context, namespace string, name string) (*v2.ChangeFeedInfo, error)
 	// List lists all changefeeds
 	List(ctx context.Context, namespace string, state string) ([]v2.ChangefeedCommonInfo, error)
+	// Export exports a stopped changefeed
+	Export(ctx context.Context, cfg *v2.ExportChangefeedConfig,
+		namespace string, name string) (*v2.ChangefeedExport, error)
+	// Import creates a changefeed from an export
+	Import(ctx context.Context, cfg *v2.ImportChangefeedConfig) (*v2.ChangeFeedInfo, error)
 }
 
 // changefeeds implements ChangefeedInterface
@@ -157,3 +162,30 @@ func (c *changefeeds) List(ctx context.Context,
 		Into(result)
 	return result.Items, err
 }
+
+// Export exports a stopped changefeed
+func (c *changefeeds) Export(ctx context.Context,
+	cfg *v2.ExportChangefeedConfig, namespace string, name string,
+) (*v2.ChangefeedExport, error) {
+	result := &v2.ChangefeedExport{}
+	u := fmt.Sprintf("changefeeds/%s/export?namespace=%s", name, namespace)
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Import creates a changefeed from an export
+func (c *changefeeds) Import(ctx context.Context,
+	cfg *v2.ImportChangefeedConfig,
+) (*v2.ChangeFeedInfo, error) {
+	result := &v2.ChangeFeedInfo{}
+	err := c.client.Post().
+		WithURI("changefeeds/import").
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
@@ -102,6 +102,21 @@ func (mr *MockChangefeedInterfaceMockRecorder) Delete(ctx, namespace, name inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChangefeedInterface)(nil).Delete), ctx, namespace, name)
 }
 
+// Export mocks base method.
+func (m *MockChangefeedInterface) Export(ctx context.Context, cfg *v2.ExportChangefeedConfig, namespace, name string) (*v2.ChangefeedExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, cfg, namespace, name)
+	ret0, _ := ret[0].(*v2.ChangefeedExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockChangefeedInterfaceMockRecorder) Export(ctx, cfg, namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockChangefeedInterface)(nil).Export), ctx, cfg, namespace, name)
+}
+
 // Get mocks base method.
 func (m *MockChangefeedInterface) Get(ctx context.Context, namespace, name string) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +132,21 @@ func (mr *MockChangefeedInterfaceMockRecorder) Get(ctx, namespace, name interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChangefeedInterface)(nil).Get), ctx, namespace, name)
 }
 
+// Import mocks base method.
+func (m *MockChangefeedInterface) Import(ctx context.Context, cfg *v2.ImportChangefeedConfig) (*v2.ChangeFeedInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, cfg)
+	ret0, _ := ret[0].(*v2.ChangeFeedInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockChangefeedInterfaceMockRecorder) Import(ctx, cfg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockChangefeedInterface)(nil).Import), ctx, cfg)
+}
+
 // List mocks base method.
 func (m *MockChangefeedInterface) List(ctx context.Context, namespace, state string) ([]v2.ChangefeedCommonInfo, error) {
 	m.ctrl.T.Helper()
@@ -34,6 +34,8 @@ func newCmdChangefeed(f factory.Factory) *cobra.Command {
 	cmds.AddCommand(newCmdQueryChangefeed(f))
 	cmds.AddCommand(newCmdRemoveChangefeed(f))
 	cmds.AddCommand(newCmdResumeChangefeed(f))
+	cmds.AddCommand(newCmdExportChangefeed(f))
+	cmds.AddCommand(newCmdImportChangefeed(f))
 
 	return cmds
 }
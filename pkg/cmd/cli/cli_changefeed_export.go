@@ -0,0 +1,106 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// exportChangefeedOptions defines flags for the `cli changefeed export` command.
+type exportChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	namespace    string
+	file         string
+	gcTTL        int64
+}
+
+// newExportChangefeedOptions creates new options for the `cli changefeed export` command.
+func newExportChangefeedOptions() *exportChangefeedOptions {
+	return &exportChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *exportChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.namespace, "namespace", "n", "default", "Replication task (changefeed) Namespace")
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().StringVarP(&o.file, "file", "f", "", "Path of the export file, print to stdout if it is empty")
+	cmd.PersistentFlags().Int64Var(&o.gcTTL, "gc-ttl", 24*60*60,
+		"TTL (in seconds) of the GC safepoint that keeps the checkpoint until the changefeed is imported")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *exportChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+
+	o.apiClient = apiClient
+	return nil
+}
+
+// run the `cli changefeed export` command.
+func (o *exportChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+	export, err := o.apiClient.Changefeeds().Export(ctx,
+		&v2.ExportChangefeedConfig{GCTTL: o.gcTTL}, o.namespace, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	if o.file == "" {
+		return util.JSONPrint(cmd, export)
+	}
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	// the export contains the sink URI, which may have credentials in it.
+	if err := os.WriteFile(o.file, data, 0o600); err != nil {
+		return err
+	}
+	cmd.Printf("Export changefeed successfully!\nID: %s\nCheckpointTs: %d\nFile: %s\n",
+		o.changefeedID, export.Status.CheckpointTs, o.file)
+	return nil
+}
+
+// newCmdExportChangefeed creates the `cli changefeed export` command.
+func newCmdExportChangefeed(f factory.Factory) *cobra.Command {
+	o := newExportChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Export a stopped replication task (changefeed) to a file",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	"github.com/pingcap/tiflow/cdc/model"
+	"github.com/pingcap/tiflow/pkg/api/v2/mock"
+	"github.com/pingcap/tiflow/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedExportAndImportCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+	file := filepath.Join(t.TempDir(), "abc.json")
+
+	export := &v2.ChangefeedExport{
+		Version: v2.ChangefeedExportVersion,
+		Info: &model.ChangeFeedInfo{
+			Namespace: "default",
+			ID:        "abc",
+			SinkURI:   "blackhole://",
+			Config:    config.GetDefaultReplicaConfig(),
+		},
+		Status:      &model.ChangeFeedStatusForAPI{CheckpointTs: 100},
+		GCServiceID: "ticdc-exporting-default_abc",
+	}
+	cf.EXPECT().Export(gomock.Any(), &v2.ExportChangefeedConfig{GCTTL: 3600},
+		"default", "abc").Return(export, nil)
+	cmd := newCmdExportChangefeed(f)
+	os.Args = []string{"export", "--changefeed-id=abc", "--gc-ttl=3600", "--file=" + file}
+	require.Nil(t, cmd.Execute())
+
+	cf.EXPECT().Import(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, cfg *v2.ImportChangefeedConfig) (*v2.ChangeFeedInfo, error) {
+			require.Equal(t, "def", cfg.ID)
+			require.Equal(t, []string{"http://127.0.0.1:2379"}, cfg.PDAddrs)
+			require.Equal(t, export.GCServiceID, cfg.Export.GCServiceID)
+			require.Equal(t, uint64(100), cfg.Export.Status.CheckpointTs)
+			return &v2.ChangeFeedInfo{ID: "def", CheckpointTs: 100}, nil
+		})
+	cmd = newCmdImportChangefeed(f)
+	os.Args = []string{
+		"import", "--file=" + file, "--changefeed-id=def",
+		"--upstream-pd=http://127.0.0.1:2379",
+	}
+	require.Nil(t, cmd.Execute())
+
+	// export failed
+	cf.EXPECT().Export(gomock.Any(), gomock.Any(), "default", "abc").
+		Return(nil, errors.New("test"))
+	o := newExportChangefeedOptions()
+	o.changefeedID = "abc"
+	o.namespace = "default"
+	require.Nil(t, o.complete(f))
+	require.NotNil(t, o.run(cmd))
+
+	// import from an invalid file
+	require.Nil(t, os.WriteFile(file, []byte("invalid"), 0o600))
+	importOpts := newImportChangefeedOptions()
+	importOpts.file = file
+	require.Nil(t, importOpts.complete(f))
+	require.NotNil(t, importOpts.run(cmd))
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/tiflow/cdc/api/v2"
+	apiv2client "github.com/pingcap/tiflow/pkg/api/v2"
+	"github.com/pingcap/tiflow/pkg/cmd/context"
+	"github.com/pingcap/tiflow/pkg/cmd/factory"
+	"github.com/pingcap/tiflow/pkg/cmd/util"
+	"github.com/spf13/cobra"
+)
+
+// importChangefeedOptions defines flags for the `cli changefeed import` command.
+type importChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	namespace    string
+	file         string
+
+	upstreamPDAddrs  string
+	upstreamCaPath   string
+	upstreamCertPath string
+	upstreamKeyPath  string
+}
+
+// newImportChangefeedOptions creates new options for the `cli changefeed import` command.
+func newImportChangefeedOptions() *importChangefeedOptions {
+	return &importChangefeedOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *importChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.namespace, "namespace", "n", "",
+		"Replication task (changefeed) Namespace, use the exported one if it is empty")
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "",
+		"Replication task (changefeed) ID, use the exported one if it is empty")
+	cmd.PersistentFlags().StringVarP(&o.file, "file", "f", "", "Path of the export file")
+	cmd.PersistentFlags().StringVar(&o.upstreamPDAddrs, "upstream-pd", "",
+		"upstream PD address, use ',' to separate multiple PDs")
+	cmd.PersistentFlags().StringVar(&o.upstreamCaPath, "upstream-ca", "",
+		"CA certificate path for TLS connection to upstream")
+	cmd.PersistentFlags().StringVar(&o.upstreamCertPath, "upstream-cert", "",
+		"Certificate path for TLS connection to upstream")
+	cmd.PersistentFlags().StringVar(&o.upstreamKeyPath, "upstream-key", "",
+		"Private key path for TLS connection to upstream")
+	_ = cmd.MarkPersistentFlagRequired("file")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *importChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+
+	o.apiClient = apiClient
+	return nil
+}
+
+// getImportChangefeedConfig reads the export file and returns the import config.
+func (o *importChangefeedOptions) getImportChangefeedConfig() (*v2.ImportChangefeedConfig, error) {
+	data, err := os.ReadFile(o.file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	export := &v2.ChangefeedExport{}
+	if err := json.Unmarshal(data, export); err != nil {
+		return nil, errors.Annotatef(err, "invalid changefeed export file %s", o.file)
+	}
+	cfg := &v2.ImportChangefeedConfig{
+		Namespace: o.namespace,
+		ID:        o.changefeedID,
+		Export:    export,
+	}
+	if o.upstreamPDAddrs != "" {
+		cfg.PDConfig = v2.PDConfig{
+			PDAddrs:  strings.Split(o.upstreamPDAddrs, ","),
+			CAPath:   o.upstreamCaPath,
+			CertPath: o.upstreamCertPath,
+			KeyPath:  o.upstreamKeyPath,
+		}
+	}
+	return cfg, nil
+}
+
+// run the `cli changefeed import` command.
+func (o *importChangefeedOptions) run(cmd *cobra.Command) error {
+	ctx := context.GetDefaultContext()
+	cfg, err := o.getImportChangefeedConfig()
+	if err != nil {
+		return err
+	}
+	info, err := o.apiClient.Changefeeds().Import(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	infoStr, err := info.Marshal()
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Import changefeed successfully!\nID: %s\nInfo: %s\n", info.ID, infoStr)
+	return nil
+}
+
+// newCmdImportChangefeed creates the `cli changefeed import` command.
+func newCmdImportChangefeed(f factory.Factory) *cobra.Command {
+	o := newImportChangefeedOptions()
+
+	command := &cobra.Command{
+		Use:   "import",
+		Short: "Create a replication task (changefeed) from an export file",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -207,6 +207,12 @@ func TestParseCfg(t *testing.T) {
 			Puller: &config.PullerConfig{
 				EnableResolvedTsStuckDetection: false,
 				ResolvedTsStuckInterval:        config.TomlDuration(5 * time.Minute),
+				RegionStallThreshold:           config.TomlDuration(3 * time.Minute),
+				RegionStallRemediationSteps: []string{
+					config.RegionStallStepResolveLock,
+					config.RegionStallStepResubscribe,
+					config.RegionStallStepTransferLeader,
+				},
 			},
 		},
 		ClusterID: "default",
@@ -354,6 +360,12 @@ check-balance-interval = "10s"
 			Puller: &config.PullerConfig{
 				EnableResolvedTsStuckDetection: false,
 				ResolvedTsStuckInterval:        config.TomlDuration(5 * time.Minute),
+				RegionStallThreshold:           config.TomlDuration(3 * time.Minute),
+				RegionStallRemediationSteps: []string{
+					config.RegionStallStepResolveLock,
+					config.RegionStallStepResubscribe,
+					config.RegionStallStepTransferLeader,
+				},
 			},
 		},
 		ClusterID: "default",
@@ -495,6 +507,12 @@ cert-allowed-cn = ["dd","ee"]
 			Puller: &config.PullerConfig{
 				EnableResolvedTsStuckDetection: false,
 				ResolvedTsStuckInterval:        config.TomlDuration(5 * time.Minute),
+				RegionStallThreshold:           config.TomlDuration(3 * time.Minute),
+				RegionStallRemediationSteps: []string{
+					config.RegionStallStepResolveLock,
+					config.RegionStallStepResubscribe,
+					config.RegionStallStepTransferLeader,
+				},
 			},
 		},
 		ClusterID: "default",
@@ -561,6 +579,12 @@ unknown3 = 3
 		Puller: &config.PullerConfig{
 			EnableResolvedTsStuckDetection: false,
 			ResolvedTsStuckInterval:        config.TomlDuration(5 * time.Minute),
+			RegionStallThreshold:           config.TomlDuration(3 * time.Minute),
+			RegionStallRemediationSteps: []string{
+				config.RegionStallStepResolveLock,
+				config.RegionStallStepResubscribe,
+				config.RegionStallStepTransferLeader,
+			},
 		},
 	}, o.ServerConfig.Debug)
 }
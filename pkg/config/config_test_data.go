@@ -196,7 +196,14 @@ const (
     "puller": {
       "enable-resolved-ts-stuck-detection": false,
       "resolved-ts-stuck-interval": 300000000000,
-      "log-region-details": false
+      "log-region-details": false,
+      "enable-region-stall-remediation": false,
+      "region-stall-threshold": 180000000000,
+      "region-stall-remediation-steps": [
+        "resolve-lock",
+        "resubscribe",
+        "transfer-leader"
+      ]
     }
   },
   "cluster-id": "default",
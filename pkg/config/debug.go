@@ -14,9 +14,11 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pingcap/errors"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
 )
 
 // DebugConfig represents config for ticdc unexposed feature configurations
@@ -49,6 +51,11 @@ func (c *DebugConfig) ValidateAndAdjust() error {
 	if err := c.CDCV2.ValidateAndAdjust(); err != nil {
 		return errors.Trace(err)
 	}
+	if c.Puller != nil {
+		if err := c.Puller.ValidateAndAdjust(); err != nil {
+			return errors.Trace(err)
+		}
+	}
 
 	return nil
 }
@@ -61,13 +68,62 @@ type PullerConfig struct {
 	ResolvedTsStuckInterval TomlDuration `toml:"resolved-ts-stuck-interval" json:"resolved-ts-stuck-interval"`
 	// LogRegionDetails determines whether logs Region details or not in puller and kv-client.
 	LogRegionDetails bool `toml:"log-region-details" json:"log-region-details"`
+
+	// EnableRegionStallRemediation is used to enable remediating regions whose
+	// resolved ts is stuck in kv-client.
+	EnableRegionStallRemediation bool `toml:"enable-region-stall-remediation" json:"enable-region-stall-remediation"`
+	// RegionStallThreshold is how long the resolved ts of a region can be stuck
+	// before it's remediated, it's also the interval between remediation steps.
+	RegionStallThreshold TomlDuration `toml:"region-stall-threshold" json:"region-stall-threshold"`
+	// RegionStallRemediationSteps are the steps applied to a stuck region in order.
+	RegionStallRemediationSteps []string `toml:"region-stall-remediation-steps" json:"region-stall-remediation-steps"`
 }
 
+const (
+	// RegionStallStepResolveLock resolves the locks which block the resolved ts of a region.
+	RegionStallStepResolveLock = "resolve-lock"
+	// RegionStallStepResubscribe deregisters a region from TiKV and subscribes it again.
+	RegionStallStepResubscribe = "resubscribe"
+	// RegionStallStepTransferLeader asks PD to transfer the leader of a region to a follower.
+	RegionStallStepTransferLeader = "transfer-leader"
+)
+
 // NewDefaultPullerConfig return the default puller configuration
 func NewDefaultPullerConfig() *PullerConfig {
 	return &PullerConfig{
 		EnableResolvedTsStuckDetection: false,
 		ResolvedTsStuckInterval:        TomlDuration(5 * time.Minute),
 		LogRegionDetails:               false,
+		EnableRegionStallRemediation:   false,
+		RegionStallThreshold:           TomlDuration(3 * time.Minute),
+		RegionStallRemediationSteps: []string{
+			RegionStallStepResolveLock,
+			RegionStallStepResubscribe,
+			RegionStallStepTransferLeader,
+		},
 	}
 }
+
+// ValidateAndAdjust validates and adjusts the puller configuration
+func (c *PullerConfig) ValidateAndAdjust() error {
+	if !c.EnableRegionStallRemediation {
+		return nil
+	}
+	if c.RegionStallThreshold < TomlDuration(time.Minute) {
+		return cerrors.ErrInvalidServerOption.GenWithStackByArgs(
+			"debug.puller.region-stall-threshold must be at least 1m")
+	}
+	if len(c.RegionStallRemediationSteps) == 0 {
+		return cerrors.ErrInvalidServerOption.GenWithStackByArgs(
+			"debug.puller.region-stall-remediation-steps must not be empty")
+	}
+	for _, step := range c.RegionStallRemediationSteps {
+		switch step {
+		case RegionStallStepResolveLock, RegionStallStepResubscribe, RegionStallStepTransferLeader:
+		default:
+			return cerrors.ErrInvalidServerOption.GenWithStackByArgs(
+				fmt.Sprintf("unknown region stall remediation step %s", step))
+		}
+	}
+	return nil
+}
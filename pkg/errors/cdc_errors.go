@@ -114,6 +114,10 @@ var (
 		"region worker exited",
 		errors.RFCCodeText("CDC:ErrRegionWorkerExit"),
 	)
+	ErrRegionResolvedTsStalled = errors.Normalize(
+		"resolved ts of region %d in table %d has been stuck for %s, remediate it by %s",
+		errors.RFCCodeText("CDC:ErrRegionResolvedTsStalled"),
+	)
 
 	// codec related errors
 	ErrEncodeFailed = errors.Normalize(
@@ -670,6 +674,10 @@ var (
 		"changefeed update error: %s",
 		errors.RFCCodeText("CDC:ErrChangefeedUpdateRefused"),
 	)
+	ErrChangefeedExportRefused = errors.Normalize(
+		"changefeed export error: %s",
+		errors.RFCCodeText("CDC:ErrChangefeedExportRefused"),
+	)
 	ErrChangefeedUpdateFailedTransaction = errors.Normalize(
 		"changefeed update failed due to unexpected etcd transaction failure: %s",
 		errors.RFCCodeText("CDC:ErrChangefeedUpdateFailed"),
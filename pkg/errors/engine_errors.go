@@ -134,6 +134,10 @@ var (
 		"worker is failed permanently",
 		errors.RFCCodeText("DFLOW:ErrWorkerFailed"),
 	)
+	ErrWorkerPaused = errors.Normalize(
+		"worker is paused and it will be resumed later",
+		errors.RFCCodeText("DFLOW:ErrWorkerPaused"),
+	)
 	ErrTooManyStatusUpdates = errors.Normalize(
 		"there are too many pending worker status updates: %d",
 		errors.RFCCodeText("DFLOW:ErrTooManyStatusUpdates"),
@@ -358,6 +362,12 @@ var (
 		errors.RFCCodeText("DFLOW:ErrJobNotRunning"),
 	)
 
+	// tenant related errors
+	ErrTenantQuotaExceeded = errors.Normalize(
+		"tenant %s has reached the %s quota %d",
+		errors.RFCCodeText("DFLOW:ErrTenantQuotaExceeded"),
+	)
+
 	// metastore related errors
 	ErrMetaStoreNotExists = errors.Normalize(
 		"metastore %s does not exist",
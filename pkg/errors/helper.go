@@ -196,6 +196,7 @@ var httpStatusCodeMapping = map[errors.RFCErrorCode]int{
 	ErrJobAlreadyCanceled.RFCCode():    http.StatusBadRequest,
 	ErrJobNotTerminated.RFCCode():      http.StatusBadRequest,
 	ErrJobNotRunning.RFCCode():         http.StatusBadRequest,
+	ErrTenantQuotaExceeded.RFCCode():   http.StatusTooManyRequests,
 	ErrMetaStoreNotExists.RFCCode():    http.StatusNotFound,
 	ErrResourceAlreadyExists.RFCCode(): http.StatusConflict,
 	ErrIllegalResourcePath.RFCCode():   http.StatusBadRequest,
@@ -238,6 +239,7 @@ var gRPCStatusCodeMapping = map[errors.RFCErrorCode]codes.Code{
 	ErrJobAlreadyCanceled.RFCCode():    codes.FailedPrecondition,
 	ErrJobNotTerminated.RFCCode():      codes.FailedPrecondition,
 	ErrJobNotRunning.RFCCode():         codes.FailedPrecondition,
+	ErrTenantQuotaExceeded.RFCCode():   codes.ResourceExhausted,
 	ErrMetaStoreNotExists.RFCCode():    codes.NotFound,
 	ErrResourceAlreadyExists.RFCCode(): codes.AlreadyExists,
 	ErrIllegalResourcePath.RFCCode():   codes.InvalidArgument,
@@ -59,6 +59,11 @@ func ChangefeedStatusKeyPrefix(clusterID, namespace string) string {
 	return NamespacedPrefix(clusterID, namespace) + ChangefeedStatusKey
 }
 
+// UpstreamInfoKeyPrefix is the prefix of upstream info keys
+func UpstreamInfoKeyPrefix(clusterID, namespace string) string {
+	return NamespacedPrefix(clusterID, namespace) + upstreamKey
+}
+
 // GetEtcdKeyChangeFeedList returns the prefix key of all changefeed config
 func GetEtcdKeyChangeFeedList(clusterID, namespace string) string {
 	return fmt.Sprintf("%s/changefeed/info", NamespacedPrefix(clusterID, namespace))
@@ -133,6 +138,20 @@ type CDCEtcdClient interface {
 		namespace string,
 	) (*model.UpstreamInfo, error)
 
+	GetUpstreamInfos(ctx context.Context,
+		namespace string,
+	) ([]*model.UpstreamInfo, error)
+
+	SaveUpstreamInfo(ctx context.Context,
+		upstreamInfo *model.UpstreamInfo,
+		namespace string,
+	) error
+
+	DeleteUpstreamInfo(ctx context.Context,
+		upstreamID model.UpstreamID,
+		namespace string,
+	) error
+
 	GetGCServiceID() string
 
 	GetEnsureGCServiceID(tag string) string
@@ -646,6 +665,61 @@ func (c *CDCEtcdClientImpl) GetUpstreamInfo(ctx context.Context,
 	return info, errors.Trace(err)
 }
 
+// GetUpstreamInfos returns all upstreamInfos of the given namespace from etcd server
+func (c *CDCEtcdClientImpl) GetUpstreamInfos(ctx context.Context,
+	namespace string,
+) ([]*model.UpstreamInfo, error) {
+	key := UpstreamInfoKeyPrefix(c.ClusterID, namespace)
+	resp, err := c.Client.Get(ctx, key, clientv3.WithPrefix())
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrPDEtcdAPIError, err)
+	}
+	infos := make([]*model.UpstreamInfo, 0, resp.Count)
+	for _, kv := range resp.Kvs {
+		info := &model.UpstreamInfo{}
+		if err := info.Unmarshal(kv.Value); err != nil {
+			return nil, errors.Trace(err)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// SaveUpstreamInfo stores an upstreamInfo into etcd server,
+// the existing upstreamInfo with the same ID is overwritten.
+func (c *CDCEtcdClientImpl) SaveUpstreamInfo(ctx context.Context,
+	upstreamInfo *model.UpstreamInfo,
+	namespace string,
+) error {
+	key := CDCKey{
+		Tp:         CDCKeyTypeUpStream,
+		ClusterID:  c.ClusterID,
+		UpstreamID: upstreamInfo.ID,
+		Namespace:  namespace,
+	}
+	value, err := upstreamInfo.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = c.Client.Put(ctx, key.String(), string(value))
+	return errors.WrapError(errors.ErrPDEtcdAPIError, err)
+}
+
+// DeleteUpstreamInfo deletes an upstreamInfo from etcd server
+func (c *CDCEtcdClientImpl) DeleteUpstreamInfo(ctx context.Context,
+	upstreamID model.UpstreamID,
+	namespace string,
+) error {
+	key := CDCKey{
+		Tp:         CDCKeyTypeUpStream,
+		ClusterID:  c.ClusterID,
+		UpstreamID: upstreamID,
+		Namespace:  namespace,
+	}
+	_, err := c.Client.Delete(ctx, key.String())
+	return errors.WrapError(errors.ErrPDEtcdAPIError, err)
+}
+
 // GcServiceIDForTest returns the gc service ID for tests
 func GcServiceIDForTest() string {
 	return fmt.Sprintf("ticdc-%s-%d", "default", 0)
@@ -276,6 +276,51 @@ func TestUpdateChangefeedAndUpstream(t *testing.T) {
 	require.Equal(t, changeFeedInfo.SinkURI, changefeedResult.SinkURI)
 }
 
+func TestSaveAndDeleteUpstreamInfo(t *testing.T) {
+	t.Parallel()
+
+	s := &Tester{}
+	s.SetUpTest(t)
+	defer s.TearDownTest(t)
+
+	ctx := context.Background()
+	namespace := model.DefaultNamespace
+	infos, err := s.client.GetUpstreamInfos(ctx, namespace)
+	require.NoError(t, err)
+	require.Len(t, infos, 0)
+
+	for i := 1; i <= 3; i++ {
+		err = s.client.SaveUpstreamInfo(ctx, &model.UpstreamInfo{
+			ID:          uint64(i),
+			PDEndpoints: fmt.Sprintf("http://127.0.0.%d:2379", i),
+		}, namespace)
+		require.NoError(t, err)
+	}
+	infos, err = s.client.GetUpstreamInfos(ctx, namespace)
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+
+	// overwrite an existing upstream
+	err = s.client.SaveUpstreamInfo(ctx, &model.UpstreamInfo{
+		ID:          2,
+		PDEndpoints: "http://127.0.0.20:2379",
+		CAPath:      "ca.pem",
+	}, namespace)
+	require.NoError(t, err)
+	info, err := s.client.GetUpstreamInfo(ctx, 2, namespace)
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.20:2379", info.PDEndpoints)
+	require.Equal(t, "ca.pem", info.CAPath)
+
+	err = s.client.DeleteUpstreamInfo(ctx, 2, namespace)
+	require.NoError(t, err)
+	_, err = s.client.GetUpstreamInfo(ctx, 2, namespace)
+	require.True(t, cerror.ErrUpstreamNotFound.Equal(err))
+	infos, err = s.client.GetUpstreamInfos(ctx, namespace)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+}
+
 func TestGetAllCaptureLeases(t *testing.T) {
 	t.Parallel()
 
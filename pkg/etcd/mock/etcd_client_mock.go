@@ -327,6 +327,49 @@ func (mr *MockCDCEtcdClientMockRecorder) GetUpstreamInfo(ctx, upstreamID, namesp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetUpstreamInfo), ctx, upstreamID, namespace)
 }
 
+// GetUpstreamInfos mocks base method.
+func (m *MockCDCEtcdClient) GetUpstreamInfos(ctx context.Context, namespace string) ([]*model.UpstreamInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpstreamInfos", ctx, namespace)
+	ret0, _ := ret[0].([]*model.UpstreamInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpstreamInfos indicates an expected call of GetUpstreamInfos.
+func (mr *MockCDCEtcdClientMockRecorder) GetUpstreamInfos(ctx, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamInfos", reflect.TypeOf((*MockCDCEtcdClient)(nil).GetUpstreamInfos), ctx, namespace)
+}
+
+// SaveUpstreamInfo mocks base method.
+func (m *MockCDCEtcdClient) SaveUpstreamInfo(ctx context.Context, upstreamInfo *model.UpstreamInfo, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveUpstreamInfo", ctx, upstreamInfo, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveUpstreamInfo indicates an expected call of SaveUpstreamInfo.
+func (mr *MockCDCEtcdClientMockRecorder) SaveUpstreamInfo(ctx, upstreamInfo, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUpstreamInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).SaveUpstreamInfo), ctx, upstreamInfo, namespace)
+}
+
+// DeleteUpstreamInfo mocks base method.
+func (m *MockCDCEtcdClient) DeleteUpstreamInfo(ctx context.Context, upstreamID model.UpstreamID, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpstreamInfo", ctx, upstreamID, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpstreamInfo indicates an expected call of DeleteUpstreamInfo.
+func (mr *MockCDCEtcdClientMockRecorder) DeleteUpstreamInfo(ctx, upstreamID, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpstreamInfo", reflect.TypeOf((*MockCDCEtcdClient)(nil).DeleteUpstreamInfo), ctx, upstreamID, namespace)
+}
+
 // PutCaptureInfo mocks base method.
 func (m *MockCDCEtcdClient) PutCaptureInfo(arg0 context.Context, arg1 *model.CaptureInfo, arg2 clientv3.LeaseID) error {
 	m.ctrl.T.Helper()
@@ -42,5 +42,9 @@ Here is a detailed description of how the ETCD worker works:
 The upper layer application which is a user of EtcdWorker only need to implement Reactor and ReactorState interface.
 The ReactorState is used to maintenance status of ETCD, and the Reactor can produce DataPatches differently according to the ReactorState.
 The EtcdWorker make sure any ReactorState which perceived by Reactor must be a consistent snapshot of ETCD servers.
+
+An EtcdWorker created by NewEtcdWorkerWithStorage works in the same way, but it polls snapshots from a Storage instead
+of watching ETCD, so that the metadata can be stored outside of ETCD. EtcdStorage and SQLStorage are the built-in
+implementations of Storage.
 */
 package orchestrator
@@ -77,6 +77,10 @@ type EtcdWorker struct {
 	migrator     migrate.Migrator
 	ownerMetaKey string
 	isOwner      bool
+
+	// storage is used instead of client to maintain the ReactorState
+	// if it's not nil, see NewEtcdWorkerWithStorage.
+	storage Storage
 }
 
 type etcdWorkerMetrics struct {
@@ -119,6 +123,28 @@ func NewEtcdWorker(
 	}, nil
 }
 
+// NewEtcdWorkerWithStorage returns a new EtcdWorker which maintains the
+// ReactorState on top of a Storage instead of an etcd cluster, e.g. a
+// SQLStorage to move the metadata traffic off PD. The storage has no watch
+// API, so the snapshots are polled from the storage on every timer tick.
+func NewEtcdWorkerWithStorage(
+	storage Storage,
+	prefix string,
+	reactor Reactor,
+	initState ReactorState,
+	migrator migrate.Migrator,
+) (*EtcdWorker, error) {
+	return &EtcdWorker{
+		storage:    storage,
+		reactor:    reactor,
+		state:      initState,
+		rawState:   make(map[util.EtcdKey]rawStateEntry),
+		prefix:     util.NormalizePrefix(prefix),
+		barrierRev: -1, // -1 indicates no barrier
+		migrator:   migrator,
+	}, nil
+}
+
 func (worker *EtcdWorker) initMetrics() {
 	metrics := &etcdWorkerMetrics{}
 	metrics.metricEtcdTxnSize = etcdTxnSize
@@ -144,6 +170,10 @@ func (worker *EtcdWorker) Run(ctx context.Context, session *concurrency.Session,
 
 	worker.initMetrics()
 
+	if worker.storage != nil {
+		return worker.runWithStorage(ctx, session, timerInterval, role)
+	}
+
 	err = worker.syncRawState(ctx)
 	if err != nil {
 		return errors.Trace(err)
@@ -430,6 +460,9 @@ func (worker *EtcdWorker) commitChangedState(ctx context.Context, changedState m
 	if len(changedState) == 0 {
 		return nil
 	}
+	if worker.storage != nil {
+		return worker.commitToStorage(ctx, changedState, size)
+	}
 
 	cmps := make([]clientv3.Cmp, 0, len(changedState))
 	opsThen := make([]clientv3.Op, 0, len(changedState))
@@ -0,0 +1,198 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/orchestrator/util"
+	"go.etcd.io/etcd/client/v3/concurrency"
+	"go.uber.org/zap"
+)
+
+// runWithStorage is the event loop of an EtcdWorker created by
+// NewEtcdWorkerWithStorage. A snapshot is polled from the storage on every
+// timer tick, and the differences from the previous snapshot are applied to
+// the ReactorState like the etcd events.
+func (worker *EtcdWorker) runWithStorage(
+	ctx context.Context, session *concurrency.Session, timerInterval time.Duration, role string,
+) error {
+	if err := worker.syncStorageSnapshot(ctx, true); err != nil {
+		return errors.Trace(err)
+	}
+
+	ticker := time.NewTicker(timerInterval)
+	defer ticker.Stop()
+
+	var (
+		pendingPatches [][]DataPatch
+		exiting        bool
+		sessionDone    <-chan struct{}
+		err            error
+	)
+	if session != nil {
+		sessionDone = session.Done()
+	} else {
+		// should never be closed
+		sessionDone = make(chan struct{})
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-sessionDone:
+			return cerrors.ErrEtcdSessionDone.GenWithStackByArgs()
+		case <-ticker.C:
+		}
+
+		if err := worker.syncStorageSnapshot(ctx, false); err != nil {
+			return errors.Trace(err)
+		}
+
+		if len(pendingPatches) > 0 {
+			pendingPatches, _, err = worker.applyPatchGroups(ctx, pendingPatches)
+			if isRetryableError(err) {
+				continue
+			}
+			if err != nil {
+				return errors.Trace(err)
+			}
+			// The committed changes are perceived by the next snapshot.
+			continue
+		}
+		if exiting {
+			// If exiting is true here, it means that the reactor returned `ErrReactorFinished` last tick,
+			// and all pending patches is applied.
+			return nil
+		}
+
+		// We are safe to update the ReactorState only if there is no pending patch.
+		if err := worker.applyUpdates(); err != nil {
+			return errors.Trace(err)
+		}
+
+		startTime := time.Now()
+		nextState, err := worker.reactor.Tick(ctx, worker.state)
+		costTime := time.Since(startTime)
+		if costTime > etcdWorkerLogsWarnDuration {
+			log.Warn("EtcdWorker reactor tick took too long",
+				zap.Duration("duration", costTime),
+				zap.String("role", role))
+		}
+		worker.metrics.metricEtcdWorkerTickDuration.Observe(costTime.Seconds())
+		if err != nil {
+			if !cerrors.ErrReactorFinished.Equal(errors.Cause(err)) {
+				return errors.Trace(err)
+			}
+			// normal exit
+			exiting = true
+		}
+		worker.state = nextState
+		pendingPatches = append(pendingPatches, nextState.GetPatches()...)
+		if len(pendingPatches) > 0 {
+			pendingPatches, _, err = worker.applyPatchGroups(ctx, pendingPatches)
+			if err != nil && !isRetryableError(err) {
+				return errors.Trace(err)
+			}
+		}
+		if exiting && len(pendingPatches) == 0 {
+			return nil
+		}
+	}
+}
+
+// syncStorageSnapshot replaces the rawState with a new snapshot of the
+// storage. The ReactorState is initialized with the snapshot if init is
+// true, otherwise the differences are queued in pendingUpdates.
+func (worker *EtcdWorker) syncStorageSnapshot(ctx context.Context, init bool) error {
+	snap, err := worker.storage.Snapshot(ctx, worker.prefix)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	rawState := make(map[util.EtcdKey]rawStateEntry, len(snap))
+	for key, entry := range snap {
+		// The delete counter is only maintained by the EtcdWorkers watching etcd.
+		if worker.isDeleteCounterKey(key.Bytes()) {
+			continue
+		}
+		value := entry.Value
+		if value == nil {
+			value = []byte{}
+		}
+		rawState[key] = rawStateEntry{value: value, modRevision: entry.Version}
+
+		if init {
+			if err := worker.state.Update(key, value, true); err != nil {
+				return errors.Trace(err)
+			}
+			continue
+		}
+		if old, ok := worker.rawState[key]; ok && old.modRevision == entry.Version {
+			continue
+		}
+		worker.pendingUpdates = append(worker.pendingUpdates, &etcdUpdate{
+			key:      key,
+			value:    value,
+			revision: entry.Version,
+		})
+	}
+	if !init {
+		for key := range worker.rawState {
+			if _, ok := rawState[key]; !ok {
+				worker.pendingUpdates = append(worker.pendingUpdates, &etcdUpdate{key: key})
+			}
+		}
+	}
+	worker.rawState = rawState
+	return nil
+}
+
+// commitToStorage commits the changed state to the storage, the version of
+// every changed key must not be changed since the last snapshot.
+func (worker *EtcdWorker) commitToStorage(
+	ctx context.Context, changedState map[util.EtcdKey][]byte, size int,
+) error {
+	changes := make([]StorageChange, 0, len(changedState))
+	for key, value := range changedState {
+		changes = append(changes, StorageChange{
+			Key:   key,
+			Value: value,
+			// The version is 0 if the key does not exist in the last snapshot.
+			ExpectedVersion: worker.rawState[key].modRevision,
+		})
+	}
+
+	worker.metrics.metricEtcdTxnSize.Observe(float64(size))
+	startTime := time.Now()
+	err := worker.storage.Commit(ctx, changes)
+	costTime := time.Since(startTime)
+	if costTime > etcdWorkerLogsWarnDuration {
+		log.Warn("Storage transaction took too long", zap.Duration("duration", costTime))
+	}
+	worker.metrics.metricEtcdTxnDuration.Observe(costTime.Seconds())
+	if err != nil {
+		if cerrors.ErrEtcdTryAgain.Equal(errors.Cause(err)) {
+			log.Info("[etcd worker] storage commit conflicts, retry on the next snapshot",
+				zap.Int("changes", len(changes)))
+		}
+		return errors.Trace(err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tiflow/pkg/migrate"
+	"github.com/pingcap/tiflow/pkg/orchestrator/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEtcdWorkerWithSQLStorageBank(t *testing.T) {
+	totalAccountNumber := 25
+	workerNumber := 10
+	var wg sync.WaitGroup
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	storage, err := NewInMemorySQLStorage(ctx, "TestEtcdWorkerWithSQLStorageBank", "metadata")
+	require.Nil(t, err)
+
+	changes := make([]StorageChange, 0, totalAccountNumber)
+	for i := 0; i < totalAccountNumber; i++ {
+		changes = append(changes, StorageChange{
+			Key:   util.NewEtcdKey(fmt.Sprintf("%s%d", bankTestPrefix, i)),
+			Value: []byte("0"),
+		})
+	}
+	require.Nil(t, storage.Commit(ctx, changes))
+
+	for i := 0; i < workerNumber; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				worker, err := NewEtcdWorkerWithStorage(storage, bankTestPrefix, &bankReactor{
+					accountNumber: totalAccountNumber,
+				}, &bankReactorState{t: t, index: i, account: make([]int, totalAccountNumber)},
+					&migrate.NoOpMigrator{})
+				require.Nil(t, err)
+				err = worker.Run(ctx, nil, 10*time.Millisecond, "owner")
+				if err == nil {
+					continue
+				}
+				require.Contains(t, err.Error(), "context deadline exceeded")
+				return
+			}
+		}()
+	}
+	wg.Wait()
+}
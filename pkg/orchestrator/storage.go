@@ -0,0 +1,51 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+
+	"github.com/pingcap/tiflow/pkg/orchestrator/util"
+)
+
+// Storage describes the requirements of a metadata storage that can be
+// used by an EtcdWorker to maintain a ReactorState outside of etcd,
+// see NewEtcdWorkerWithStorage.
+type Storage interface {
+	// Snapshot returns a consistent snapshot of all entries whose key has the given prefix.
+	Snapshot(ctx context.Context, prefix util.EtcdPrefix) (map[util.EtcdKey]StorageEntry, error)
+	// Commit atomically applies all the changes if the stored version of every changed key
+	// still matches StorageChange.ExpectedVersion. It returns ErrEtcdTryAgain if any version
+	// does not match, or any other error encountered.
+	Commit(ctx context.Context, changes []StorageChange) error
+}
+
+// StorageEntry is a value stored in a Storage together with its version.
+type StorageEntry struct {
+	Value []byte
+	// Version is the internal version of the entry. It is assigned by the storage
+	// on every write, and must never be reused by the same key even if the key is
+	// deleted and created again. The caller should treat this value as opaque.
+	Version int64
+}
+
+// StorageChange is a change to be committed to a Storage.
+type StorageChange struct {
+	Key util.EtcdKey
+	// Value is the new value of the key, nil means the key should be deleted.
+	Value []byte
+	// ExpectedVersion is the version of the key that the change is based on,
+	// 0 means the key should not exist.
+	ExpectedVersion int64
+}
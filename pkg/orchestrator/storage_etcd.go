@@ -0,0 +1,83 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	cerrors "github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/etcd"
+	"github.com/pingcap/tiflow/pkg/orchestrator/util"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+var _ Storage = &EtcdStorage{}
+
+// EtcdStorage is a Storage implementation based on etcd.
+// The mod revision of a key is used as its version, which is
+// never reused because etcd revisions are strictly increasing.
+type EtcdStorage struct {
+	client *etcd.Client
+}
+
+// NewEtcdStorage creates a new EtcdStorage.
+func NewEtcdStorage(client *etcd.Client) *EtcdStorage {
+	return &EtcdStorage{client: client}
+}
+
+// Snapshot implements Storage.Snapshot.
+func (s *EtcdStorage) Snapshot(
+	ctx context.Context, prefix util.EtcdPrefix,
+) (map[util.EtcdKey]StorageEntry, error) {
+	resp, err := s.client.Get(ctx, prefix.String(), clientv3.WithPrefix())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snap := make(map[util.EtcdKey]StorageEntry, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		snap[util.NewEtcdKeyFromBytes(kv.Key)] = StorageEntry{
+			Value:   kv.Value,
+			Version: kv.ModRevision,
+		}
+	}
+	return snap, nil
+}
+
+// Commit implements Storage.Commit.
+func (s *EtcdStorage) Commit(ctx context.Context, changes []StorageChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	cmps := make([]clientv3.Cmp, 0, len(changes))
+	opsThen := make([]clientv3.Op, 0, len(changes))
+	for _, change := range changes {
+		key := change.Key.String()
+		cmps = append(cmps,
+			clientv3.Compare(clientv3.ModRevision(key), "=", change.ExpectedVersion))
+		if change.Value != nil {
+			opsThen = append(opsThen, clientv3.OpPut(key, string(change.Value)))
+		} else {
+			opsThen = append(opsThen, clientv3.OpDelete(key))
+		}
+	}
+	resp, err := s.client.Txn(ctx, cmps, opsThen, etcd.TxnEmptyOpsElse)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !resp.Succeeded {
+		return cerrors.ErrEtcdTryAgain.GenWithStackByArgs()
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/tiflow/engine/pkg/meta/mock"
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/orchestrator/util"
+	"go.uber.org/zap"
+)
+
+var _ Storage = &SQLStorage{}
+
+const (
+	// sqlRevisionKey is the key of the row that holds the latest revision of the table.
+	// It never matches a prefix because all keys of a ReactorState start with "/".
+	sqlRevisionKey = "__revision__"
+
+	sqlCreateTable = "CREATE TABLE IF NOT EXISTS %s (meta_key varchar(512) NOT NULL, " +
+		"meta_value longblob NOT NULL, version bigint NOT NULL, PRIMARY KEY (meta_key))"
+	sqlQueryRevision  = "SELECT version FROM %s WHERE meta_key = ?"
+	sqlBumpRevision   = "UPDATE %s SET version = version + 1 WHERE meta_key = ?"
+	sqlQueryPrefix    = "SELECT meta_key, meta_value, version FROM %s WHERE meta_key LIKE ? ESCAPE '!'"
+	sqlInsertEntry    = "INSERT INTO %s (meta_key, meta_value, version) VALUES (?, ?, ?)"
+	sqlUpdateEntry    = "UPDATE %s SET meta_value = ?, version = ? WHERE meta_key = ? AND version = ?"
+	sqlDeleteEntry    = "DELETE FROM %s WHERE meta_key = ? AND version = ?"
+	sqlCountEntry     = "SELECT COUNT(*) FROM %s WHERE meta_key = ?"
+	sqlLikeEscapeChar = "!"
+)
+
+// SQLStorage is a Storage implementation based on SQL database.
+//
+// Every commit bumps a revision row in the same transaction and uses the
+// new revision as the version of all the changed keys, so versions are
+// never reused even if a key is deleted and created again.
+type SQLStorage struct {
+	db        *sql.DB
+	tableName string
+}
+
+// NewSQLStorage creates a new SQLStorage.
+func NewSQLStorage(ctx context.Context, db *sql.DB, tableName string) (*SQLStorage, error) {
+	if _, err := db.ExecContext(ctx, fmt.Sprintf(sqlCreateTable, tableName)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	s := &SQLStorage{
+		db:        db,
+		tableName: tableName,
+	}
+	if err := s.initRevision(ctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return s, nil
+}
+
+// NewInMemorySQLStorage creates a new SQLStorage in memory based on SQLite.
+func NewInMemorySQLStorage(ctx context.Context, dbName string, tableName string) (*SQLStorage, error) {
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", dbName)
+
+	db, err := sql.Open(mock.ThreadeSafeSqliteDriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	return NewSQLStorage(ctx, db, tableName)
+}
+
+func (s *SQLStorage) initRevision(ctx context.Context) error {
+	exists, err := s.exists(ctx, sqlRevisionKey)
+	if err != nil || exists {
+		return errors.Trace(err)
+	}
+	_, err = s.db.ExecContext(ctx,
+		fmt.Sprintf(sqlInsertEntry, s.tableName), sqlRevisionKey, []byte{}, 0)
+	if err != nil {
+		// the revision row may be created by another storage concurrently.
+		if exists, checkErr := s.exists(ctx, sqlRevisionKey); checkErr == nil && exists {
+			return nil
+		}
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+func (s *SQLStorage) exists(ctx context.Context, key string) (bool, error) {
+	var count int
+	err := s.db.QueryRowContext(ctx,
+		fmt.Sprintf(sqlCountEntry, s.tableName), key).Scan(&count)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return count > 0, nil
+}
+
+// Snapshot implements Storage.Snapshot.
+func (s *SQLStorage) Snapshot(
+	ctx context.Context, prefix util.EtcdPrefix,
+) (map[util.EtcdKey]StorageEntry, error) {
+	rows, err := s.db.QueryContext(ctx,
+		fmt.Sprintf(sqlQueryPrefix, s.tableName), escapeLikePattern(prefix.String())+"%")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Warn("close rows failed", zap.Error(err))
+		}
+	}()
+
+	snap := make(map[util.EtcdKey]StorageEntry)
+	for rows.Next() {
+		var (
+			key   string
+			entry StorageEntry
+		)
+		if err := rows.Scan(&key, &entry.Value, &entry.Version); err != nil {
+			return nil, errors.Trace(err)
+		}
+		// LIKE may be case-insensitive depending on the collation.
+		if !strings.HasPrefix(key, prefix.String()) {
+			continue
+		}
+		snap[util.NewEtcdKey(key)] = entry
+	}
+	return snap, errors.Trace(rows.Err())
+}
+
+// Commit implements Storage.Commit.
+func (s *SQLStorage) Commit(ctx context.Context, changes []StorageChange) (err error) {
+	if len(changes) == 0 {
+		return nil
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err == nil {
+			err = errors.Trace(tx.Commit())
+			return
+		}
+		if rbErr := tx.Rollback(); rbErr != nil {
+			log.Warn("rollback metadata transaction failed", zap.Error(rbErr))
+		}
+	}()
+
+	// Bumping the revision row serializes all commits on the table.
+	if _, err = tx.ExecContext(ctx,
+		fmt.Sprintf(sqlBumpRevision, s.tableName), sqlRevisionKey); err != nil {
+		return errors.Trace(err)
+	}
+	var revision int64
+	if err = tx.QueryRowContext(ctx,
+		fmt.Sprintf(sqlQueryRevision, s.tableName), sqlRevisionKey).Scan(&revision); err != nil {
+		return errors.Trace(err)
+	}
+
+	for _, change := range changes {
+		if err = s.applyChange(ctx, tx, change, revision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SQLStorage) applyChange(
+	ctx context.Context, tx *sql.Tx, change StorageChange, revision int64,
+) error {
+	key := change.Key.String()
+	if change.ExpectedVersion == 0 {
+		var count int
+		err := tx.QueryRowContext(ctx,
+			fmt.Sprintf(sqlCountEntry, s.tableName), key).Scan(&count)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if count > 0 {
+			return errors.ErrEtcdTryAgain.GenWithStackByArgs()
+		}
+		if change.Value == nil {
+			return nil
+		}
+		_, err = tx.ExecContext(ctx,
+			fmt.Sprintf(sqlInsertEntry, s.tableName), key, change.Value, revision)
+		return errors.Trace(err)
+	}
+
+	var (
+		result sql.Result
+		err    error
+	)
+	if change.Value == nil {
+		result, err = tx.ExecContext(ctx,
+			fmt.Sprintf(sqlDeleteEntry, s.tableName), key, change.ExpectedVersion)
+	} else {
+		result, err = tx.ExecContext(ctx,
+			fmt.Sprintf(sqlUpdateEntry, s.tableName),
+			change.Value, revision, key, change.ExpectedVersion)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if rowsAffected != 1 {
+		return errors.ErrEtcdTryAgain.GenWithStackByArgs()
+	}
+	return nil
+}
+
+// escapeLikePattern escapes the wildcard characters of a LIKE pattern.
+func escapeLikePattern(s string) string {
+	r := strings.NewReplacer(
+		sqlLikeEscapeChar, sqlLikeEscapeChar+sqlLikeEscapeChar,
+		"%", sqlLikeEscapeChar+"%",
+		"_", sqlLikeEscapeChar+"_",
+	)
+	return r.Replace(s)
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tiflow/pkg/errors"
+	"github.com/pingcap/tiflow/pkg/orchestrator/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLStorageCommitAndSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s, err := NewInMemorySQLStorage(ctx, "TestSQLStorageCommitAndSnapshot", "metadata")
+	require.NoError(t, err)
+
+	keyA := util.NewEtcdKey("/test/a")
+	keyB := util.NewEtcdKey("/test/b")
+	keyOther := util.NewEtcdKey("/other/a")
+	err = s.Commit(ctx, []StorageChange{
+		{Key: keyA, Value: []byte("a")},
+		{Key: keyB, Value: []byte("b")},
+		{Key: keyOther, Value: []byte("other")},
+	})
+	require.NoError(t, err)
+
+	snap, err := s.Snapshot(ctx, util.NewEtcdPrefix("/test"))
+	require.NoError(t, err)
+	require.Len(t, snap, 2)
+	require.Equal(t, []byte("a"), snap[keyA].Value)
+	require.Equal(t, []byte("b"), snap[keyB].Value)
+	require.Positive(t, snap[keyA].Version)
+
+	// insert an existing key
+	err = s.Commit(ctx, []StorageChange{{Key: keyA, Value: []byte("a2")}})
+	require.True(t, errors.ErrEtcdTryAgain.Equal(errors.Cause(err)))
+
+	// update with a stale version, the whole commit is rolled back
+	err = s.Commit(ctx, []StorageChange{
+		{Key: keyA, Value: []byte("a2"), ExpectedVersion: snap[keyA].Version},
+		{Key: keyB, Value: []byte("b2"), ExpectedVersion: snap[keyB].Version - 1},
+	})
+	require.True(t, errors.ErrEtcdTryAgain.Equal(errors.Cause(err)))
+	snap2, err := s.Snapshot(ctx, util.NewEtcdPrefix("/test"))
+	require.NoError(t, err)
+	require.Equal(t, snap, snap2)
+
+	// update and delete
+	err = s.Commit(ctx, []StorageChange{
+		{Key: keyA, Value: []byte("a2"), ExpectedVersion: snap[keyA].Version},
+		{Key: keyB, ExpectedVersion: snap[keyB].Version},
+	})
+	require.NoError(t, err)
+	snap2, err = s.Snapshot(ctx, util.NewEtcdPrefix("/test"))
+	require.NoError(t, err)
+	require.Len(t, snap2, 1)
+	require.Equal(t, []byte("a2"), snap2[keyA].Value)
+	require.Greater(t, snap2[keyA].Version, snap[keyA].Version)
+
+	// the version of a re-created key is never reused
+	err = s.Commit(ctx, []StorageChange{{Key: keyB, Value: []byte("b")}})
+	require.NoError(t, err)
+	snap3, err := s.Snapshot(ctx, util.NewEtcdPrefix("/test"))
+	require.NoError(t, err)
+	require.NotEqual(t, snap[keyB].Version, snap3[keyB].Version)
+	err = s.Commit(ctx, []StorageChange{
+		{Key: keyB, Value: []byte("b2"), ExpectedVersion: snap[keyB].Version},
+	})
+	require.True(t, errors.ErrEtcdTryAgain.Equal(errors.Cause(err)))
+}
+
+func TestSQLStorageSnapshotEscapePrefix(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s, err := NewInMemorySQLStorage(ctx, "TestSQLStorageSnapshotEscapePrefix", "metadata")
+	require.NoError(t, err)
+
+	err = s.Commit(ctx, []StorageChange{
+		{Key: util.NewEtcdKey("/test_1/a"), Value: []byte("a")},
+		{Key: util.NewEtcdKey("/testx1/a"), Value: []byte("b")},
+	})
+	require.NoError(t, err)
+	snap, err := s.Snapshot(ctx, util.NewEtcdPrefix("/test_1"))
+	require.NoError(t, err)
+	require.Len(t, snap, 1)
+	require.Contains(t, snap, util.NewEtcdKey("/test_1/a"))
+}
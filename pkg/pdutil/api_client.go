@@ -43,6 +43,7 @@ const (
 	gcServiceSafePointURL = "/pd/api/v1/gc/safepoint"
 	healthyAPI            = "/pd/api/v1/health"
 	scanRegionAPI         = "/pd/api/v1/regions/key"
+	operatorsAPI          = "/pd/api/v1/operators"
 
 	// Split the default rule by following keys to keep metadata region isolated
 	// from the normal data area.
@@ -101,6 +102,7 @@ type PDAPIClient interface {
 	CollectMemberEndpoints(ctx context.Context) ([]string, error)
 	Healthy(ctx context.Context, endpoint string) error
 	ScanRegions(ctx context.Context, span tablepb.Span) ([]RegionInfo, error)
+	TransferRegionLeader(ctx context.Context, regionID, toStoreID uint64) error
 	Close()
 }
 
@@ -341,6 +343,26 @@ func (pc *pdAPIClient) listGcServiceSafePoint(
 	return &resp, nil
 }
 
+// TransferRegionLeader asks PD to transfer the leader of the region to the given store.
+func (pc *pdAPIClient) TransferRegionLeader(ctx context.Context, regionID, toStoreID uint64) error {
+	url := pc.grpcClient.GetLeaderURL() + operatorsAPI
+	header := http.Header{"Content-Type": {"application/json"}}
+	content, err := json.Marshal(map[string]interface{}{
+		"name":        "transfer-leader",
+		"region_id":   regionID,
+		"to_store_id": toStoreID,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+	defer cancel()
+	_, err = pc.httpClient.DoRequest(ctx, url, http.MethodPost,
+		header, bytes.NewReader(content))
+	return errors.Trace(err)
+}
+
 // CollectMemberEndpoints return all members' endpoint
 func (pc *pdAPIClient) CollectMemberEndpoints(ctx context.Context) ([]string, error) {
 	resp, err := pc.grpcClient.GetAllMembers(ctx)
@@ -100,6 +100,31 @@ func TestMetaLabelFail(t *testing.T) {
 	mockClient.testServer.Close()
 }
 
+func TestTransferRegionLeader(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]interface{}
+	mockPDServer := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, operatorsAPI, r.URL.Path)
+			require.Equal(t, http.MethodPost, r.Method)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			_, _ = w.Write([]byte("\"The operator is created.\""))
+		},
+	))
+	defer mockPDServer.Close()
+
+	pc, err := NewPDAPIClient(&mockPDClient{url: mockPDServer.URL}, nil)
+	require.NoError(t, err)
+	defer pc.Close()
+
+	err = pc.TransferRegionLeader(context.Background(), 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, "transfer-leader", body["name"])
+	require.Equal(t, float64(1), body["region_id"])
+	require.Equal(t, float64(2), body["to_store_id"])
+}
+
 func TestListGcServiceSafePoint(t *testing.T) {
 	t.Parallel()
 
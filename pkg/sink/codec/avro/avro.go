@@ -276,6 +276,11 @@ type ddlEvent struct {
 	Schema   string             `json:"schema"`
 	Table    string             `json:"table"`
 	CommitTs uint64             `json:"commitTs"`
+	// BinlogFile, BinlogPos and GTID are the upstream binlog position of
+	// the DDL replicated from MySQL by DM.
+	BinlogFile string `json:"binlogFile,omitempty"`
+	BinlogPos  uint32 `json:"binlogPos,omitempty"`
+	GTID       string `json:"gtid,omitempty"`
 }
 
 // EncodeDDLEvent only encode DDL event if the watermark event is enabled
@@ -292,6 +297,11 @@ func (a *BatchEncoder) EncodeDDLEvent(e *model.DDLEvent) (*common.Message, error
 			Table:    e.TableInfo.TableName.Table,
 			CommitTs: e.CommitTs,
 		}
+		if pos := e.BinlogPosition; pos != nil {
+			event.BinlogFile = pos.File
+			event.BinlogPos = pos.Pos
+			event.GTID = pos.GTID
+		}
 		data, err := json.Marshal(event)
 		if err != nil {
 			return nil, cerror.WrapError(cerror.ErrAvroToEnvelopeError, err)
@@ -339,6 +349,17 @@ func (a *BatchEncoder) nativeValueWithExtension(
 		native[tidbCorrupted] = e.Checksum.Corrupted
 		native[tidbChecksumVersion] = e.Checksum.Version
 	}
+
+	if a.config.AvroEnableBinlogPosition {
+		native[tidbBinlogFile] = ""
+		native[tidbBinlogPos] = int64(0)
+		native[tidbBinlogGTID] = ""
+		if pos := e.BinlogPosition; pos != nil {
+			native[tidbBinlogFile] = pos.File
+			native[tidbBinlogPos] = int64(pos.Pos)
+			native[tidbBinlogGTID] = pos.GTID
+		}
+	}
 	return native
 }
 
@@ -359,6 +380,12 @@ const (
 	tidbRowLevelChecksum = "_tidb_row_level_checksum"
 	tidbChecksumVersion  = "_tidb_checksum_version"
 	tidbCorrupted        = "_tidb_corrupted"
+
+	// upstream binlog position related fields, only set for the events
+	// replicated from MySQL by DM.
+	tidbBinlogFile = "_tidb_binlog_file"
+	tidbBinlogPos  = "_tidb_binlog_pos"
+	tidbBinlogGTID = "_tidb_binlog_gtid"
 )
 
 var type2TiDBType = map[byte]string{
@@ -519,6 +546,25 @@ func (a *BatchEncoder) schemaWithExtension(
 			})
 	}
 
+	if a.config.AvroEnableBinlogPosition {
+		top.Fields = append(top.Fields,
+			map[string]interface{}{
+				"name":    tidbBinlogFile,
+				"type":    "string",
+				"default": "",
+			},
+			map[string]interface{}{
+				"name":    tidbBinlogPos,
+				"type":    "long",
+				"default": 0,
+			},
+			map[string]interface{}{
+				"name":    tidbBinlogGTID,
+				"type":    "string",
+				"default": "",
+			})
+	}
+
 	return top
 }
 
@@ -115,6 +115,76 @@ func TestDDLEventE2E(t *testing.T) {
 	require.NotEmpty(t, decodedEvent.TableInfo.TableName.Table)
 }
 
+func TestBinlogPositionE2E(t *testing.T) {
+	codecConfig := common.NewConfig(config.ProtocolAvro)
+	codecConfig.EnableTiDBExtension = true
+	codecConfig.AvroEnableWatermark = true
+	codecConfig.AvroEnableBinlogPosition = true
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ddl, event, _, _ := utils.NewLargeEvent4Test(t, config.GetDefaultReplicaConfig())
+	ddl.BinlogPosition = &model.BinlogPosition{
+		File: "mysql-bin.000001",
+		Pos:  4,
+	}
+	event.BinlogPosition = &model.BinlogPosition{
+		File: "mysql-bin.000001",
+		Pos:  1234,
+		GTID: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14",
+	}
+
+	encoder, err := SetupEncoderAndSchemaRegistry4Testing(ctx, codecConfig)
+	defer TeardownEncoderAndSchemaRegistry4Testing()
+	require.NoError(t, err)
+
+	topic := "avro-test-topic"
+	schemaM, err := NewConfluentSchemaManager(ctx, "http://127.0.0.1:8081", nil)
+	require.NoError(t, err)
+	decoder := NewDecoder(codecConfig, schemaM, topic, nil)
+
+	message, err := encoder.EncodeDDLEvent(ddl)
+	require.NoError(t, err)
+	err = decoder.AddKeyValue(message.Key, message.Value)
+	require.NoError(t, err)
+	messageType, exist, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, model.MessageTypeDDL, messageType)
+	decodedDDL, err := decoder.NextDDLEvent()
+	require.NoError(t, err)
+	require.Equal(t, ddl.BinlogPosition, decodedDDL.BinlogPosition)
+
+	err = encoder.AppendRowChangedEvent(ctx, topic, event, func() {})
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	messageType, exist, err = decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.Equal(t, model.MessageTypeRow, messageType)
+	decodedEvent, err := decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+	require.Equal(t, event.BinlogPosition, decodedEvent.BinlogPosition)
+
+	// the events replicated from TiDB have empty binlog position fields.
+	event.BinlogPosition = nil
+	err = encoder.AppendRowChangedEvent(ctx, topic, event, func() {})
+	require.NoError(t, err)
+	messages = encoder.Build()
+	require.Len(t, messages, 1)
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	_, exist, err = decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, exist)
+	decodedEvent, err = decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+	require.Nil(t, decodedEvent.BinlogPosition)
+}
+
 func TestResolvedE2E(t *testing.T) {
 	t.Parallel()
 
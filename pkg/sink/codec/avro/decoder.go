@@ -269,10 +269,27 @@ func assembleEvent(
 	} else {
 		event.Columns = model.Columns2ColumnDatas(columns, event.TableInfo)
 	}
+	event.BinlogPosition = extractBinlogPosition(valueMap)
 
 	return event, nil
 }
 
+// extractBinlogPosition returns the upstream binlog position in the received value map,
+// it returns nil if the binlog position is not encoded.
+func extractBinlogPosition(valueMap map[string]interface{}) *model.BinlogPosition {
+	file, ok := valueMap[tidbBinlogFile].(string)
+	if !ok || file == "" {
+		return nil
+	}
+	pos, _ := valueMap[tidbBinlogPos].(int64)
+	gtid, _ := valueMap[tidbBinlogGTID].(string)
+	return &model.BinlogPosition{
+		File: file,
+		Pos:  uint32(pos),
+		GTID: gtid,
+	}
+}
+
 func isCorrupted(valueMap map[string]interface{}) bool {
 	o, ok := valueMap[tidbCorrupted]
 	if !ok {
@@ -377,6 +394,13 @@ func (d *decoder) NextDDLEvent() (*model.DDLEvent, error) {
 	}
 	result.Type = baseDDLEvent.Type
 	result.Query = baseDDLEvent.Query
+	if baseDDLEvent.BinlogFile != "" {
+		result.BinlogPosition = &model.BinlogPosition{
+			File: baseDDLEvent.BinlogFile,
+			Pos:  baseDDLEvent.BinlogPos,
+			GTID: baseDDLEvent.GTID,
+		}
+	}
 
 	return result, nil
 }
@@ -255,7 +255,10 @@ func (b *batchDecoder) assembleHandleKeyOnlyRowChangedEvent(
 			EventType: eventType,
 		},
 		Extensions: &tidbExtension{
-			CommitTs: commitTs,
+			CommitTs:   commitTs,
+			BinlogFile: message.Extensions.BinlogFile,
+			BinlogPos:  message.Extensions.BinlogPos,
+			GTID:       message.Extensions.GTID,
 		},
 	}
 	switch eventType {
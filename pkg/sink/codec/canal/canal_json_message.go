@@ -38,6 +38,7 @@ type canalJSONMessageInterface interface {
 	getSchema() *string
 	getTable() *string
 	getCommitTs() uint64
+	getBinlogPosition() *model.BinlogPosition
 	getPhysicalTableID() int64
 	getTableID() int64
 	getQuery() string
@@ -87,6 +88,11 @@ func (c *JSONMessage) getCommitTs() uint64 {
 	return 0
 }
 
+// for JSONMessage, we lost the binlog position.
+func (c *JSONMessage) getBinlogPosition() *model.BinlogPosition {
+	return nil
+}
+
 func (c *JSONMessage) getTableID() int64 {
 	return 0
 }
@@ -150,6 +156,11 @@ type tidbExtension struct {
 	WatermarkTs        uint64 `json:"watermarkTs,omitempty"`
 	OnlyHandleKey      bool   `json:"onlyHandleKey,omitempty"`
 	ClaimCheckLocation string `json:"claimCheckLocation,omitempty"`
+	// BinlogFile, BinlogPos and GTID are the upstream binlog position of
+	// the events replicated from MySQL by DM.
+	BinlogFile string `json:"binlogFile,omitempty"`
+	BinlogPos  uint32 `json:"binlogPos,omitempty"`
+	GTID       string `json:"gtid,omitempty"`
 }
 
 type canalJSONMessageWithTiDBExtension struct {
@@ -165,6 +176,17 @@ func (c *canalJSONMessageWithTiDBExtension) getCommitTs() uint64 {
 	return c.Extensions.CommitTs
 }
 
+func (c *canalJSONMessageWithTiDBExtension) getBinlogPosition() *model.BinlogPosition {
+	if c.Extensions.BinlogFile == "" {
+		return nil
+	}
+	return &model.BinlogPosition{
+		File: c.Extensions.BinlogFile,
+		Pos:  c.Extensions.BinlogPos,
+		GTID: c.Extensions.GTID,
+	}
+}
+
 func (b *batchDecoder) queryTableInfo(msg canalJSONMessageInterface) *model.TableInfo {
 	schema := *msg.getSchema()
 	table := *msg.getTable()
@@ -273,6 +295,7 @@ func (b *batchDecoder) canalJSONMessage2RowChange() (*model.RowChangedEvent, err
 	result := new(model.RowChangedEvent)
 	result.TableInfo = b.queryTableInfo(msg)
 	result.CommitTs = msg.getCommitTs()
+	result.BinlogPosition = msg.getBinlogPosition()
 
 	mysqlType := msg.getMySQLType()
 	var err error
@@ -424,6 +447,7 @@ func canalJSONMessage2DDLEvent(msg canalJSONMessageInterface) *model.DDLEvent {
 	result := new(model.DDLEvent)
 	// we lost the startTs from kafka message
 	result.CommitTs = msg.getCommitTs()
+	result.BinlogPosition = msg.getBinlogPosition()
 
 	result.TableInfo = new(model.TableInfo)
 	result.TableInfo.TableName = model.TableName{
@@ -255,6 +255,16 @@ func newJSONMessageForDML(
 		out.RawByte('{')
 		out.RawString("\"commitTs\":")
 		out.Uint64(e.CommitTs)
+		if pos := e.BinlogPosition; pos != nil {
+			out.RawString(",\"binlogFile\":")
+			out.String(pos.File)
+			out.RawString(",\"binlogPos\":")
+			out.Uint32(pos.Pos)
+			if pos.GTID != "" {
+				out.RawString(",\"gtid\":")
+				out.String(pos.GTID)
+			}
+		}
 
 		// only send handle key may happen in 2 cases:
 		// 1. delete event, and set only handle key config. no need to encode `onlyHandleKey` field
@@ -331,8 +341,18 @@ func (c *JSONRowEventEncoder) newJSONMessageForDDL(e *model.DDLEvent) canalJSONM
 
 	return &canalJSONMessageWithTiDBExtension{
 		JSONMessage: msg,
-		Extensions:  &tidbExtension{CommitTs: e.CommitTs},
+		Extensions:  newTiDBExtensionForDDL(e),
+	}
+}
+
+func newTiDBExtensionForDDL(e *model.DDLEvent) *tidbExtension {
+	extension := &tidbExtension{CommitTs: e.CommitTs}
+	if pos := e.BinlogPosition; pos != nil {
+		extension.BinlogFile = pos.File
+		extension.BinlogPos = pos.Pos
+		extension.GTID = pos.GTID
 	}
+	return extension
 }
 
 func (c *JSONRowEventEncoder) newJSONMessage4CheckpointEvent(
@@ -536,6 +536,68 @@ func TestDDLEventWithExtension(t *testing.T) {
 	require.Equal(t, ddlEvent.TableInfo.TableName.Table, decodedDDL.TableInfo.TableName.Table)
 }
 
+func TestBinlogPositionWithExtension(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ctx := context.Background()
+	codecConfig := common.NewConfig(config.ProtocolCanalJSON)
+	codecConfig.EnableTiDBExtension = true
+	builder, err := NewJSONRowEventEncoderBuilder(ctx, codecConfig)
+	require.NoError(t, err)
+	encoder := builder.Build()
+
+	ddlEvent := helper.DDL2Event(`create table test.t(a int primary key, b varchar(10))`)
+	ddlEvent.BinlogPosition = &model.BinlogPosition{
+		File: "mysql-bin.000001",
+		Pos:  4,
+		GTID: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14",
+	}
+	row := helper.DML2Event(`insert into test.t values (1, 'a')`, "test", "t")
+	row.BinlogPosition = &model.BinlogPosition{
+		File: "mysql-bin.000001",
+		Pos:  1234,
+	}
+
+	decoder, err := NewBatchDecoder(ctx, codecConfig, nil)
+	require.NoError(t, err)
+
+	message, err := encoder.EncodeDDLEvent(ddlEvent)
+	require.NoError(t, err)
+	err = decoder.AddKeyValue(message.Key, message.Value)
+	require.NoError(t, err)
+	messageType, hasNext, err := decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeDDL, messageType)
+	decodedDDL, err := decoder.NextDDLEvent()
+	require.NoError(t, err)
+	require.Equal(t, ddlEvent.BinlogPosition, decodedDDL.BinlogPosition)
+
+	err = encoder.AppendRowChangedEvent(ctx, "", row, func() {})
+	require.NoError(t, err)
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+
+	var raw map[string]interface{}
+	err = json.Unmarshal(messages[0].Value, &raw)
+	require.NoError(t, err)
+	extension := raw["_tidb"].(map[string]interface{})
+	require.Equal(t, "mysql-bin.000001", extension["binlogFile"])
+	require.EqualValues(t, 1234, extension["binlogPos"])
+	require.NotContains(t, extension, "gtid")
+
+	err = decoder.AddKeyValue(messages[0].Key, messages[0].Value)
+	require.NoError(t, err)
+	messageType, hasNext, err = decoder.HasNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, model.MessageTypeRow, messageType)
+	decodedRow, err := decoder.NextRowChangedEvent()
+	require.NoError(t, err)
+	require.Equal(t, row.BinlogPosition, decodedRow.BinlogPosition)
+}
+
 func TestCanalJSONAppendRowChangedEventWithCallback(t *testing.T) {
 	helper := entry.NewSchemaTestHelper(t)
 	defer helper.Close()
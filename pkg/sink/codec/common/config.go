@@ -60,6 +60,9 @@ type Config struct {
 	// and would cause error, so this is only used for ticdc internal testing purpose, should not be
 	// exposed to the outside users.
 	AvroEnableWatermark bool
+	// AvroEnableBinlogPosition set to true, avro encode the upstream binlog position
+	// of the events replicated from MySQL by DM as extension fields.
+	AvroEnableBinlogPosition bool
 
 	// canal-json only
 	ContentCompatible bool
@@ -165,6 +168,9 @@ type urlConfig struct {
 	// only used for internal testing, do not set this in the production environment since the
 	// confluent official consumer cannot handle watermark.
 	AvroEnableWatermark *bool `form:"avro-enable-watermark"`
+	// AvroEnableBinlogPosition is the option for encoding the upstream binlog position
+	// in avro protocol, it only takes effect when the TiDB extension is enabled.
+	AvroEnableBinlogPosition *bool `form:"avro-enable-binlog-position"`
 
 	AvroSchemaRegistry       string `form:"schema-registry"`
 	OnlyOutputUpdatedColumns *bool  `form:"only-output-updated-columns"`
@@ -214,6 +220,11 @@ func (c *Config) Apply(sinkURI *url.URL, replicaConfig *config.ReplicaConfig) er
 			c.AvroEnableWatermark = *urlParameter.AvroEnableWatermark
 		}
 	}
+	if urlParameter.AvroEnableBinlogPosition != nil {
+		if c.EnableTiDBExtension && c.Protocol == config.ProtocolAvro {
+			c.AvroEnableBinlogPosition = *urlParameter.AvroEnableBinlogPosition
+		}
+	}
 	if urlParameter.AvroSchemaRegistry != "" {
 		c.AvroConfluentSchemaRegistry = urlParameter.AvroSchemaRegistry
 	}
@@ -968,6 +968,24 @@ func (c *dbzCodec) EncodeKey(
 	return err
 }
 
+// writeBinlogPosition writes the upstream binlog position of the events
+// replicated from MySQL by DM, they are empty for the events from TiDB.
+func writeBinlogPosition(writer *util.JSONWriter, pos *model.BinlogPosition) {
+	if pos == nil {
+		writer.WriteNullField("gtid")
+		writer.WriteStringField("file", "")
+		writer.WriteInt64Field("pos", 0)
+		return
+	}
+	if pos.GTID == "" {
+		writer.WriteNullField("gtid")
+	} else {
+		writer.WriteStringField("gtid", pos.GTID)
+	}
+	writer.WriteStringField("file", pos.File)
+	writer.WriteInt64Field("pos", int64(pos.Pos))
+}
+
 // EncodeValue encode RowChangedEvent into value message
 func (c *dbzCodec) EncodeValue(
 	e *model.RowChangedEvent,
@@ -994,9 +1012,7 @@ func (c *dbzCodec) EncodeValue(
 				jWriter.WriteStringField("db", e.TableInfo.GetSchemaName())
 				jWriter.WriteStringField("table", e.TableInfo.GetTableName())
 				jWriter.WriteInt64Field("server_id", 0)
-				jWriter.WriteNullField("gtid")
-				jWriter.WriteStringField("file", "")
-				jWriter.WriteInt64Field("pos", 0)
+				writeBinlogPosition(jWriter, e.BinlogPosition)
 				jWriter.WriteInt64Field("row", 0)
 				jWriter.WriteInt64Field("thread", 0)
 				jWriter.WriteNullField("query")
@@ -1250,9 +1266,7 @@ func (c *dbzCodec) EncodeDDLEvent(
 					jWriter.WriteStringField("table", tableName)
 				}
 				jWriter.WriteInt64Field("server_id", 0)
-				jWriter.WriteNullField("gtid")
-				jWriter.WriteStringField("file", "")
-				jWriter.WriteInt64Field("pos", 0)
+				writeBinlogPosition(jWriter, e.BinlogPosition)
 				jWriter.WriteInt64Field("row", 0)
 				jWriter.WriteInt64Field("thread", 0)
 				jWriter.WriteNullField("query")
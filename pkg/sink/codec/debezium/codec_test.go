@@ -15,6 +15,7 @@ package debezium
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -915,6 +916,78 @@ func TestEncodeInsert(t *testing.T) {
 	`, buf.String())
 }
 
+func TestEncodeBinlogPosition(t *testing.T) {
+	codec := &dbzCodec{
+		config:    common.NewConfig(config.ProtocolDebezium),
+		clusterID: "test_cluster",
+		nowFunc:   func() time.Time { return time.Unix(1701326309, 0) },
+	}
+	codec.config.DebeziumDisableSchema = true
+
+	tableInfo := model.BuildTableInfo("test", "table1", []*model.Column{{
+		Name: "tiny",
+		Type: mysql.TypeTiny,
+		Flag: model.NullableFlag | model.HandleKeyFlag | model.PrimaryKeyFlag,
+	}}, [][]int{{0}})
+	e := &model.RowChangedEvent{
+		CommitTs:  1,
+		TableInfo: tableInfo,
+		Columns: model.Columns2ColumnDatas([]*model.Column{{
+			Name:  "tiny",
+			Value: int64(1),
+		}}, tableInfo),
+		BinlogPosition: &model.BinlogPosition{
+			File: "mysql-bin.000001",
+			Pos:  1234,
+			GTID: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14",
+		},
+	}
+
+	getSource := func(data []byte) map[string]interface{} {
+		var value struct {
+			Payload struct {
+				Source map[string]interface{} `json:"source"`
+			} `json:"payload"`
+		}
+		require.NoError(t, json.Unmarshal(data, &value))
+		return value.Payload.Source
+	}
+
+	buf := bytes.NewBuffer(nil)
+	err := codec.EncodeValue(e, buf)
+	require.NoError(t, err)
+	source := getSource(buf.Bytes())
+	require.Equal(t, "mysql-bin.000001", source["file"])
+	require.EqualValues(t, 1234, source["pos"])
+	require.Equal(t, "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14", source["gtid"])
+
+	e.BinlogPosition.GTID = ""
+	buf.Reset()
+	err = codec.EncodeValue(e, buf)
+	require.NoError(t, err)
+	source = getSource(buf.Bytes())
+	require.Equal(t, "mysql-bin.000001", source["file"])
+	require.Nil(t, source["gtid"])
+
+	ddl := &model.DDLEvent{
+		CommitTs:  1,
+		Query:     "CREATE TABLE test.table1 (tiny TINYINT PRIMARY KEY)",
+		Type:      timodel.ActionCreateTable,
+		TableInfo: tableInfo,
+		BinlogPosition: &model.BinlogPosition{
+			File: "mysql-bin.000002",
+			Pos:  4,
+		},
+	}
+	keyBuf := bytes.NewBuffer(nil)
+	buf.Reset()
+	err = codec.EncodeDDLEvent(ddl, keyBuf, buf)
+	require.NoError(t, err)
+	source = getSource(buf.Bytes())
+	require.Equal(t, "mysql-bin.000002", source["file"])
+	require.EqualValues(t, 4, source["pos"])
+}
+
 func TestEncodeUpdate(t *testing.T) {
 	codec := &dbzCodec{
 		config:    common.NewConfig(config.ProtocolDebezium),
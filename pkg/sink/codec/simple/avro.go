@@ -197,6 +197,10 @@ func newDDLMessageMap(ddl *model.DDLEvent) map[string]interface{} {
 		}
 	}
 
+	if ddl.BinlogPosition != nil {
+		result["binlogPosition"] = newBinlogPositionMap(ddl.BinlogPosition)
+	}
+
 	result = map[string]interface{}{
 		"com.pingcap.simple.avro.DDL": result,
 	}
@@ -209,6 +213,21 @@ func newDDLMessageMap(ddl *model.DDLEvent) map[string]interface{} {
 	}
 }
 
+func newBinlogPositionMap(pos *model.BinlogPosition) map[string]interface{} {
+	position := map[string]interface{}{
+		"file": pos.File,
+		"pos":  int64(pos.Pos),
+	}
+	if pos.GTID != "" {
+		position["gtid"] = map[string]interface{}{
+			"string": pos.GTID,
+		}
+	}
+	return map[string]interface{}{
+		"com.pingcap.simple.avro.BinlogPosition": position,
+	}
+}
+
 var (
 	// genericMapPool return holder for each column and checksum
 	genericMapPool = sync.Pool{
@@ -282,6 +301,10 @@ func (a *avroMarshaller) newDMLMessageMap(
 		dmlMessagePayload["checksum"] = holder
 	}
 
+	if event.BinlogPosition != nil {
+		dmlMessagePayload["binlogPosition"] = newBinlogPositionMap(event.BinlogPosition)
+	}
+
 	if event.IsInsert() {
 		data := a.collectColumns(event.Columns, event.TableInfo, onlyHandleKey)
 		dmlMessagePayload["data"] = data
@@ -511,6 +534,7 @@ func newMessageFromAvroNative(native interface{}, m *message) {
 			rawPreTableSchema = rawPreTableSchema["com.pingcap.simple.avro.TableSchema"].(map[string]interface{})
 			m.PreTableSchema = newTableSchemaFromAvroNative(rawPreTableSchema)
 		}
+		m.BinlogPosition = newBinlogPositionFromAvroNative(rawValues)
 		return
 	}
 
@@ -532,6 +556,7 @@ func newMessageFromAvroNative(native interface{}, m *message) {
 	}
 
 	m.Checksum = newChecksum(rawValues)
+	m.BinlogPosition = newBinlogPositionFromAvroNative(rawValues)
 	m.Data = newDataMap(rawValues["data"])
 	m.Old = newDataMap(rawValues["old"])
 }
@@ -551,6 +576,23 @@ func newChecksum(raw map[string]interface{}) *checksum {
 	}
 }
 
+func newBinlogPositionFromAvroNative(raw map[string]interface{}) *binlogPosition {
+	rawValue := raw["binlogPosition"]
+	if rawValue == nil {
+		return nil
+	}
+	rawPosition := rawValue.(map[string]interface{})
+	rawPosition = rawPosition["com.pingcap.simple.avro.BinlogPosition"].(map[string]interface{})
+	result := &binlogPosition{
+		File: rawPosition["file"].(string),
+		Pos:  uint32(rawPosition["pos"].(int64)),
+	}
+	if rawGTID := rawPosition["gtid"]; rawGTID != nil {
+		result.GTID = rawGTID.(map[string]interface{})["string"].(string)
+	}
+	return result
+}
+
 func newDataMap(rawValues interface{}) map[string]interface{} {
 	if rawValues == nil {
 		return nil
@@ -1217,6 +1217,83 @@ func TestEncoderOtherTypes(t *testing.T) {
 	}
 }
 
+func TestEncodeBinlogPosition(t *testing.T) {
+	helper := entry.NewSchemaTestHelper(t)
+	defer helper.Close()
+
+	ddlEvent := helper.DDL2Event(`create table test.t(a int primary key, b varchar(10))`)
+	ddlEvent.BinlogPosition = &model.BinlogPosition{
+		File: "mysql-bin.000001",
+		Pos:  1234,
+		GTID: "3ccc475b-2343-11e7-be21-6c0b84d59f30:1-14",
+	}
+	row := helper.DML2Event(`insert into test.t values (1, 'a')`, "test", "t")
+	row.BinlogPosition = &model.BinlogPosition{
+		File: "mysql-bin.000001",
+		Pos:  1500,
+	}
+
+	ctx := context.Background()
+	codecConfig := common.NewConfig(config.ProtocolSimple)
+	for _, format := range []common.EncodingFormatType{
+		common.EncodingFormatAvro,
+		common.EncodingFormatJSON,
+	} {
+		codecConfig.EncodingFormat = format
+		b, err := NewBuilder(ctx, codecConfig)
+		require.NoError(t, err)
+		enc := b.Build()
+
+		dec, err := NewDecoder(ctx, codecConfig, nil)
+		require.NoError(t, err)
+
+		m, err := enc.EncodeDDLEvent(ddlEvent)
+		require.NoError(t, err)
+		err = dec.AddKeyValue(m.Key, m.Value)
+		require.NoError(t, err)
+		messageType, hasNext, err := dec.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeDDL, messageType)
+		decodedDDL, err := dec.NextDDLEvent()
+		require.NoError(t, err)
+		require.Equal(t, ddlEvent.BinlogPosition, decodedDDL.BinlogPosition)
+
+		err = enc.AppendRowChangedEvent(ctx, "", row, func() {})
+		require.NoError(t, err)
+		messages := enc.Build()
+		require.Len(t, messages, 1)
+		err = dec.AddKeyValue(messages[0].Key, messages[0].Value)
+		require.NoError(t, err)
+		messageType, hasNext, err = dec.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		require.Equal(t, model.MessageTypeRow, messageType)
+		decodedRow, err := dec.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.Equal(t, row.BinlogPosition, decodedRow.BinlogPosition)
+
+		// the events replicated from TiDB don't have the binlog position.
+		row.BinlogPosition = nil
+		err = enc.AppendRowChangedEvent(ctx, "", row, func() {})
+		require.NoError(t, err)
+		messages = enc.Build()
+		require.Len(t, messages, 1)
+		err = dec.AddKeyValue(messages[0].Key, messages[0].Value)
+		require.NoError(t, err)
+		_, hasNext, err = dec.HasNext()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+		decodedRow, err = dec.NextRowChangedEvent()
+		require.NoError(t, err)
+		require.Nil(t, decodedRow.BinlogPosition)
+		row.BinlogPosition = &model.BinlogPosition{
+			File: "mysql-bin.000001",
+			Pos:  1500,
+		}
+	}
+}
+
 func TestE2EPartitionTableDMLBeforeDDL(t *testing.T) {
 	helper := entry.NewSchemaTestHelper(t)
 	defer helper.Close()
@@ -372,11 +372,12 @@ func newDDLEvent(msg *message) *model.DDLEvent {
 		preTableInfo = newTableInfo(msg.PreTableSchema)
 	}
 	return &model.DDLEvent{
-		StartTs:      msg.CommitTs,
-		CommitTs:     msg.CommitTs,
-		TableInfo:    tableInfo,
-		PreTableInfo: preTableInfo,
-		Query:        msg.SQL,
+		StartTs:        msg.CommitTs,
+		CommitTs:       msg.CommitTs,
+		TableInfo:      tableInfo,
+		PreTableInfo:   preTableInfo,
+		Query:          msg.SQL,
+		BinlogPosition: msg.BinlogPosition.toModel(),
 	}
 }
 
@@ -390,6 +391,7 @@ func buildRowChangedEvent(
 		TableInfo:       tableInfo,
 		Columns:         decodeColumns(msg.Data, tableInfo),
 		PreColumns:      decodeColumns(msg.Old, tableInfo),
+		BinlogPosition:  msg.BinlogPosition.toModel(),
 	}
 
 	if enableRowChecksum && msg.Checksum != nil {
@@ -469,6 +471,36 @@ type checksum struct {
 	Previous  uint32 `json:"previous"`
 }
 
+// binlogPosition is the upstream binlog position of the event replicated
+// from MySQL by DM.
+type binlogPosition struct {
+	File string `json:"file"`
+	Pos  uint32 `json:"pos"`
+	GTID string `json:"gtid,omitempty"`
+}
+
+func newBinlogPosition(pos *model.BinlogPosition) *binlogPosition {
+	if pos == nil {
+		return nil
+	}
+	return &binlogPosition{
+		File: pos.File,
+		Pos:  pos.Pos,
+		GTID: pos.GTID,
+	}
+}
+
+func (p *binlogPosition) toModel() *model.BinlogPosition {
+	if p == nil {
+		return nil
+	}
+	return &model.BinlogPosition{
+		File: p.File,
+		Pos:  p.Pos,
+		GTID: p.GTID,
+	}
+}
+
 type message struct {
 	Version int `json:"version"`
 	// Schema and Table is empty for the resolved ts event.
@@ -490,6 +522,8 @@ type message struct {
 
 	// E2E checksum related fields, only set when enable checksum functionality.
 	Checksum *checksum `json:"checksum,omitempty"`
+	// BinlogPosition is for the DML and DDL event replicated from MySQL by DM.
+	BinlogPosition *binlogPosition `json:"binlogPosition,omitempty"`
 
 	// Data is available for the Insert and Update event.
 	Data map[string]interface{} `json:"data,omitempty"`
@@ -542,6 +576,7 @@ func newDDLMessage(ddl *model.DDLEvent) *message {
 		SQL:            ddl.Query,
 		TableSchema:    schema,
 		PreTableSchema: preSchema,
+		BinlogPosition: newBinlogPosition(ddl.BinlogPosition),
 	}
 	return msg
 }
@@ -560,6 +595,7 @@ func (a *jsonMarshaller) newDMLMessage(
 		SchemaVersion:      event.TableInfo.UpdateTS,
 		HandleKeyOnly:      onlyHandleKey,
 		ClaimCheckLocation: claimCheckFileName,
+		BinlogPosition:     newBinlogPosition(event.BinlogPosition),
 	}
 	if event.IsInsert() {
 		m.Type = DMLTypeInsert
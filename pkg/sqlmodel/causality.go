@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/tablecodec"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
 	"github.com/pingcap/tiflow/dm/pkg/log"
 	"github.com/pingcap/tiflow/dm/pkg/utils"
 	"go.uber.org/zap"
@@ -34,11 +35,11 @@ func (r *RowChange) CausalityKeys() []string {
 
 	ret := make([]string, 0, 1)
 	if r.preValues != nil {
-		ret = append(ret, r.getCausalityString(r.preValues)...)
+		ret = append(ret, r.getCausalityString(r.preValues, r.preSkipped)...)
 		ret = append(ret, r.getForeignKeyCausalityString(r.preValues)...)
 	}
 	if r.postValues != nil {
-		ret = append(ret, r.getCausalityString(r.postValues)...)
+		ret = append(ret, r.getCausalityString(r.postValues, r.postMissing)...)
 		ret = append(ret, r.getForeignKeyCausalityString(r.postValues)...)
 	}
 	return ret
@@ -200,7 +201,10 @@ func (r *RowChange) getForeignKeyCausalityString(values []interface{}) []string
 	return keys
 }
 
-func (r *RowChange) getCausalityString(values []interface{}) []string {
+// getCausalityString generates causality keys from values. missing marks the
+// columns whose values are unknown in a partial row image, it's nil for a full
+// row image.
+func (r *RowChange) getCausalityString(values []interface{}, missing []bool) []string {
 	sourceTable := r.sourceTable
 	if r.causalityKeySourceTable != nil {
 		// Only causality keys use this table name; r.sourceTable keeps the original source table.
@@ -213,6 +217,7 @@ func (r *RowChange) getCausalityString(values []interface{}) []string {
 	}
 
 	ret := make([]string, 0, len(pkAndUks))
+	tableKeyAdded := false
 
 	for _, indexCols := range pkAndUks {
 		// TODO: should not support multi value index and generate the value
@@ -220,6 +225,15 @@ func (r *RowChange) getCausalityString(values []interface{}) []string {
 		if indexCols.MVIndex {
 			continue
 		}
+		if hasMissingColumn(indexCols, missing) {
+			// the values of this index are unknown in the partial row image,
+			// any row change of the table may conflict with it.
+			if !tableKeyAdded {
+				ret = append(ret, TableCausalityKey(sourceTable))
+				tableKeyAdded = true
+			}
+			continue
+		}
 		cols, vals := getColsAndValuesOfIdx(r.sourceTableInfo.Columns, indexCols, values)
 		// handle prefix index
 		truncVals := truncateIndexValues(r.tiSessionCtx, r.sourceTableInfo, indexCols, cols, vals)
@@ -239,3 +253,42 @@ func (r *RowChange) getCausalityString(values []interface{}) []string {
 
 	return ret
 }
+
+// TableCausalityKey returns the table-level causality key of the table. It's
+// used when some unique key values of a row change are unknown, so the row
+// change should not be replicated concurrently with other row changes of the
+// table. It never equals to a causality key generated from the values.
+func TableCausalityKey(table *cdcmodel.TableName) string {
+	return table.String()
+}
+
+// HasTableCausalityKey returns true when CausalityKeys contains the table-level
+// causality key, see TableCausalityKey.
+func (r *RowChange) HasTableCausalityKey() bool {
+	if !r.partial {
+		return false
+	}
+	r.lazyInitWhereHandle()
+	for _, indexCols := range r.whereHandle.UniqueIdxs {
+		if indexCols.MVIndex {
+			continue
+		}
+		if (r.preValues != nil && hasMissingColumn(indexCols, r.preSkipped)) ||
+			(r.postValues != nil && hasMissingColumn(indexCols, r.postMissing)) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMissingColumn(index *timodel.IndexInfo, missing []bool) bool {
+	if missing == nil {
+		return false
+	}
+	for _, col := range index.Columns {
+		if missing[col.Offset] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"strings"
+
+	"github.com/pingcap/tiflow/pkg/quotes"
+)
+
+// JSONDiffOperation is the operation of a JSONDiff.
+type JSONDiffOperation int
+
+// these constants represent the operations of JSONDiff, they are the same as
+// MySQL's enum_json_diff_operation.
+const (
+	JSONDiffReplace JSONDiffOperation = iota
+	JSONDiffInsert
+	JSONDiffRemove
+)
+
+// JSONDiff is a partial update of a JSON column. When upstream
+// `binlog_row_value_options` is PARTIAL_JSON, MySQL logs the diffs instead of
+// the whole JSON document in the after image of UPDATE. The value of such
+// column in postValues should be []JSONDiff.
+type JSONDiff struct {
+	Op   JSONDiffOperation
+	Path string
+	// Value is the new value in JSON text, it's empty for JSONDiffRemove.
+	Value string
+}
+
+// IsArrayInsert returns true when the diff inserts an array element, which
+// shifts the following elements and is not idempotent.
+func (d JSONDiff) IsArrayInsert() bool {
+	return d.Op == JSONDiffInsert && strings.HasSuffix(d.Path, "]")
+}
+
+// genJSONDiffExpr generates the expression which applies the diffs on the
+// column in order, like JSON_REPLACE(JSON_SET(`c`, ?, CAST(? AS JSON)), ?, CAST(? AS JSON)).
+func genJSONDiffExpr(column string, diffs []JSONDiff) (string, []interface{}) {
+	expr := quotes.QuoteName(column)
+	args := make([]interface{}, 0, len(diffs)*2)
+	for _, diff := range diffs {
+		var buf strings.Builder
+		switch diff.Op {
+		case JSONDiffReplace:
+			buf.WriteString("JSON_REPLACE(")
+		case JSONDiffInsert:
+			// MySQL inserts an array element in the same way as JSON_ARRAY_INSERT,
+			// and an object member in the same way as JSON_INSERT. We use JSON_SET
+			// for the latter to keep it idempotent when the event is replicated again.
+			if diff.IsArrayInsert() {
+				buf.WriteString("JSON_ARRAY_INSERT(")
+			} else {
+				buf.WriteString("JSON_SET(")
+			}
+		case JSONDiffRemove:
+			buf.WriteString("JSON_REMOVE(")
+		}
+		buf.WriteString(expr)
+		buf.WriteString(", ?")
+		args = append(args, diff.Path)
+		if diff.Op != JSONDiffRemove {
+			buf.WriteString(", CAST(? AS JSON)")
+			args = append(args, diff.Value)
+		}
+		buf.WriteString(")")
+		expr = buf.String()
+	}
+	return expr, args
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlmodel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenJSONDiffExpr(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		diffs        []JSONDiff
+		expectedExpr string
+		expectedArgs []interface{}
+	}{
+		{
+			diffs:        []JSONDiff{{Op: JSONDiffReplace, Path: "$.a", Value: "1"}},
+			expectedExpr: "JSON_REPLACE(`j`, ?, CAST(? AS JSON))",
+			expectedArgs: []interface{}{"$.a", "1"},
+		},
+		{
+			diffs:        []JSONDiff{{Op: JSONDiffRemove, Path: "$.a"}},
+			expectedExpr: "JSON_REMOVE(`j`, ?)",
+			expectedArgs: []interface{}{"$.a"},
+		},
+		{
+			diffs: []JSONDiff{
+				{Op: JSONDiffReplace, Path: "$.a", Value: "1"},
+				{Op: JSONDiffInsert, Path: "$.b", Value: `"x"`},
+				{Op: JSONDiffInsert, Path: "$.c[0]", Value: "2"},
+				{Op: JSONDiffRemove, Path: "$.d"},
+			},
+			expectedExpr: "JSON_REMOVE(JSON_ARRAY_INSERT(JSON_SET(JSON_REPLACE(`j`, ?, CAST(? AS JSON)), ?, CAST(? AS JSON)), ?, CAST(? AS JSON)), ?)",
+			expectedArgs: []interface{}{"$.a", "1", "$.b", `"x"`, "$.c[0]", "2", "$.d"},
+		},
+		{
+			// the diffs on the same path are applied in order
+			diffs: []JSONDiff{
+				{Op: JSONDiffRemove, Path: "$.a"},
+				{Op: JSONDiffInsert, Path: "$.a", Value: `{"b": [1, 2]}`},
+				{Op: JSONDiffReplace, Path: "$.a.b[1]", Value: "3"},
+			},
+			expectedExpr: "JSON_REPLACE(JSON_SET(JSON_REMOVE(`j`, ?), ?, CAST(? AS JSON)), ?, CAST(? AS JSON))",
+			expectedArgs: []interface{}{"$.a", "$.a", `{"b": [1, 2]}`, "$.a.b[1]", "3"},
+		},
+	}
+
+	for _, c := range cases {
+		expr, args := genJSONDiffExpr("j", c.diffs)
+		require.Equal(t, c.expectedExpr, expr)
+		require.Equal(t, c.expectedArgs, args)
+	}
+}
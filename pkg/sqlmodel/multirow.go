@@ -34,6 +34,10 @@ func SameTypeTargetAndColumns(lhs *RowChange, rhs *RowChange) bool {
 	if lhs.tp != rhs.tp {
 		return false
 	}
+	// partial row images may have different logged columns
+	if lhs.partial || rhs.partial {
+		return false
+	}
 	if lhs.sourceTable.Schema == rhs.sourceTable.Schema &&
 		lhs.sourceTable.Table == rhs.sourceTable.Table {
 		return true
@@ -111,6 +115,11 @@ func GenUpdateSQL(changes ...*RowChange) (string, []any) {
 		log.L().DPanic("row changes is empty")
 		return "", nil
 	}
+	// partial row images are not merged with others, see SameTypeTargetAndColumns
+	if changes[0].partial {
+		return changes[0].genUpdateSQL()
+	}
+
 	var buf strings.Builder
 	buf.Grow(1024)
 
@@ -249,7 +258,7 @@ func GenInsertSQL(tp DMLType, changes ...*RowChange) (string, []interface{}) {
 	// build gegerated columns lower name set to accelerate the following check
 	generatedColumns := generatedColumnsNameSet(first.targetTableInfo.Columns)
 	for i, col := range first.sourceTableInfo.Columns {
-		if _, ok := generatedColumns[col.Name.L]; ok {
+		if _, ok := generatedColumns[col.Name.L]; ok || !first.postColumnLogged(i) {
 			skipColIdx = append(skipColIdx, i)
 			continue
 		}
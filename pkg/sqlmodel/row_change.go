@@ -75,6 +75,15 @@ type RowChange struct {
 	foreignKeyRelations []ForeignKeyCausalityRelation
 
 	approximateDataSize int64
+
+	// the fields below are only set when the row change is built from a
+	// partial row image, see SetPartialImage.
+	partial     bool
+	preSkipped  []bool
+	postSkipped []bool
+	// postMissing marks the columns whose values are unknown in postValues,
+	// the skipped columns which can be filled by preValues are not included.
+	postMissing []bool
 }
 
 // NewRowChange creates a new RowChange.
@@ -231,6 +240,81 @@ func (r *RowChange) SetApproximateDataSize(approximateDataSize int64) {
 	r.approximateDataSize = approximateDataSize
 }
 
+// SetPartialImage marks this row change is built from a partial row image,
+// that is, upstream `binlog_row_image` is MINIMAL or NOBLOB, or the row change
+// contains JSONDiff values. preSkipped and postSkipped are the offsets of the
+// columns not logged in preValues and postValues.
+// For UPDATE, the skipped columns of postValues are not changed, so they are
+// filled by preValues if possible. Only the logged columns are written by the
+// generated DML, and the row change will not be merged with others.
+func (r *RowChange) SetPartialImage(preSkipped, postSkipped []int) {
+	colCount := r.ColumnCount()
+	r.partial = true
+	r.preSkipped = make([]bool, colCount)
+	r.postSkipped = make([]bool, colCount)
+	r.postMissing = make([]bool, colCount)
+	for _, idx := range preSkipped {
+		if idx < colCount {
+			r.preSkipped[idx] = true
+		}
+	}
+	if r.postValues == nil {
+		return
+	}
+
+	postValues := make([]interface{}, len(r.postValues))
+	copy(postValues, r.postValues)
+	for _, idx := range postSkipped {
+		if idx >= colCount {
+			continue
+		}
+		r.postSkipped[idx] = true
+		if r.preValues != nil && !r.preSkipped[idx] {
+			postValues[idx] = r.preValues[idx]
+		} else {
+			r.postMissing[idx] = true
+		}
+	}
+	r.postValues = postValues
+}
+
+// IsPartialImage returns true when the row change is built from a partial row
+// image, see SetPartialImage.
+func (r *RowChange) IsPartialImage() bool {
+	return r.partial
+}
+
+// IsIdentityLogged returns false when some columns used to identify the row,
+// that is the columns in the WHERE clause of UPDATE and DELETE, are not logged
+// in the partial row image. It's always true for a full row image.
+func (r *RowChange) IsIdentityLogged() bool {
+	if !r.partial || r.preValues == nil {
+		return true
+	}
+
+	r.lazyInitWhereHandle()
+	if idx := r.whereHandle.UniqueNotNullIdx; idx != nil {
+		for _, col := range idx.Columns {
+			if r.preSkipped[col.Offset] {
+				return false
+			}
+		}
+		return true
+	}
+	// all columns are used to identify the row
+	for _, skipped := range r.preSkipped {
+		if skipped {
+			return false
+		}
+	}
+	return true
+}
+
+// postColumnLogged returns whether the i-th column is logged in postValues.
+func (r *RowChange) postColumnLogged(i int) bool {
+	return !r.partial || !r.postSkipped[i]
+}
+
 func (r *RowChange) lazyInitWhereHandle() {
 	if r.whereHandle != nil {
 		return
@@ -329,11 +413,20 @@ func (r *RowChange) genUpdateSQL() (string, []interface{}) {
 		if _, ok := generatedColumns[col.Name.L]; ok {
 			continue
 		}
+		if !r.postColumnLogged(i) {
+			continue
+		}
 
 		if writtenFirstCol {
 			buf.WriteString(", ")
 		}
 		writtenFirstCol = true
+		if diffs, ok := r.postValues[i].([]JSONDiff); ok {
+			expr, diffArgs := genJSONDiffExpr(col.Name.O, diffs)
+			fmt.Fprintf(&buf, "%s = %s", quotes.QuoteName(col.Name.O), expr)
+			args = append(args, diffArgs...)
+			continue
+		}
 		fmt.Fprintf(&buf, "%s = ?", quotes.QuoteName(col.Name.O))
 		args = append(args, r.postValues[i])
 	}
@@ -365,3 +365,67 @@ func TestGenInsert(t *testing.T) {
 		require.Equal(t, c.expectedArgs, args)
 	}
 }
+
+func TestPartialRowImage(t *testing.T) {
+	t.Parallel()
+
+	source := &cdcmodel.TableName{Schema: "db", Table: "tb1"}
+	ti := mockTableInfo(t, "CREATE TABLE tb1 (id INT PRIMARY KEY, c1 INT, c2 VARCHAR(10) UNIQUE, j JSON)")
+
+	// binlog_row_image=MINIMAL
+	change := NewRowChange(source, nil, []interface{}{1, nil, nil, nil}, []interface{}{nil, 5, nil, nil}, ti, nil, nil)
+	require.False(t, change.IsPartialImage())
+	require.False(t, change.HasTableCausalityKey())
+	change.SetPartialImage([]int{1, 2, 3}, []int{0, 2, 3})
+	require.True(t, change.IsPartialImage())
+	require.True(t, change.IsIdentityLogged())
+	// skipped post values are filled by pre values
+	require.Equal(t, []interface{}{1, 5, nil, nil}, change.GetPostValues())
+
+	expectedSQL := "UPDATE `db`.`tb1` SET `c1` = ? WHERE `id` = ? LIMIT 1"
+	expectedArgs := []interface{}{5, 1}
+	sql, args := change.GenSQL(DMLUpdate)
+	require.Equal(t, expectedSQL, sql)
+	require.Equal(t, expectedArgs, args)
+	sql, args = GenUpdateSQL(change)
+	require.Equal(t, expectedSQL, sql)
+	require.Equal(t, expectedArgs, args)
+
+	// the unique key is not logged, so a table-level causality key is used
+	require.True(t, change.HasTableCausalityKey())
+	require.Equal(t, []string{"1.id.db.tb1", "db.tb1", "1.id.db.tb1", "db.tb1"}, change.CausalityKeys())
+	require.False(t, SameTypeTargetAndColumns(change, change))
+
+	// binlog_row_value_options=PARTIAL_JSON, the diffs of a column are applied in order
+	diffs := []JSONDiff{
+		{Op: JSONDiffReplace, Path: "$.a", Value: "1"},
+		{Op: JSONDiffInsert, Path: "$.b", Value: `"x"`},
+		{Op: JSONDiffRemove, Path: "$.c"},
+	}
+	change = NewRowChange(source, nil, []interface{}{1, 2, "v", `{"a": 0, "c": 0}`}, []interface{}{1, 2, "v", diffs}, ti, nil, nil)
+	change.SetPartialImage(nil, nil)
+	require.True(t, change.IsPartialImage())
+	sql, args = change.GenSQL(DMLUpdate)
+	require.Equal(t, "UPDATE `db`.`tb1` SET `id` = ?, `c1` = ?, `c2` = ?, `j` = JSON_REMOVE(JSON_SET(JSON_REPLACE(`j`, ?, CAST(? AS JSON)), ?, CAST(? AS JSON)), ?) WHERE `id` = ? LIMIT 1", sql)
+	require.Equal(t, []interface{}{1, 2, "v", "$.a", "1", "$.b", `"x"`, "$.c", 1}, args)
+
+	// MINIMAL INSERT only logs the specified columns
+	change = NewRowChange(source, nil, nil, []interface{}{2, 3, nil, nil}, ti, nil, nil)
+	change.SetPartialImage(nil, []int{2, 3})
+	sql, args = change.GenSQL(DMLInsert)
+	require.Equal(t, "INSERT INTO `db`.`tb1` (`id`,`c1`) VALUES (?,?)", sql)
+	require.Equal(t, []interface{}{2, 3}, args)
+
+	// MINIMAL DELETE only logs PK
+	change = NewRowChange(source, nil, []interface{}{2, nil, nil, nil}, nil, ti, nil, nil)
+	change.SetPartialImage([]int{1, 2, 3}, nil)
+	require.True(t, change.IsIdentityLogged())
+	sql, args = change.GenSQL(DMLDelete)
+	require.Equal(t, "DELETE FROM `db`.`tb1` WHERE `id` = ? LIMIT 1", sql)
+	require.Equal(t, []interface{}{2}, args)
+
+	// PK is not logged
+	change = NewRowChange(source, nil, []interface{}{nil, 1, nil, nil}, nil, ti, nil, nil)
+	change.SetPartialImage([]int{0, 2, 3}, nil)
+	require.False(t, change.IsIdentityLogged())
+}
@@ -33,6 +33,9 @@ const (
 	EnsureGCServiceResuming = "-resuming-"
 	// EnsureGCServiceInitializing is a tag of GC service id for changefeed initialization
 	EnsureGCServiceInitializing = "-initializing-"
+	// EnsureGCServiceExporting is a tag of GC service id for changefeed export,
+	// it keeps the checkpoint of an exported changefeed until it is imported.
+	EnsureGCServiceExporting = "-exporting-"
 )
 
 // EnsureChangefeedStartTsSafety checks if the startTs less than the minimum of
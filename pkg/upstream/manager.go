@@ -94,6 +94,14 @@ func NewManager4Test(pdClient pd.Client) *Manager {
 	return res
 }
 
+// AddUpstream4Test adds an initialized upstream with the given ID for unit test.
+func (m *Manager) AddUpstream4Test(upstreamID uint64, pdClient pd.Client) *Upstream {
+	up := NewUpstream4Test(pdClient)
+	up.ID = upstreamID
+	m.ups.Store(upstreamID, up)
+	return up
+}
+
 // AddDefaultUpstream add the default upstream
 func (m *Manager) AddDefaultUpstream(
 	pdEndpoints []string,
@@ -183,6 +191,24 @@ func (m *Manager) Get(upstreamID uint64) (*Upstream, bool) {
 	return up, true
 }
 
+// Remove closes the upstream and removes it from the manager.
+// The default upstream will never be removed.
+func (m *Manager) Remove(upstreamID uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.ups.Load(upstreamID)
+	if !ok {
+		return
+	}
+	up := v.(*Upstream)
+	if up.isDefaultUpstream {
+		return
+	}
+	m.ups.Delete(upstreamID)
+	go up.Close()
+	log.Info("upstream is removed from manager", zap.Uint64("id", upstreamID))
+}
+
 // Close closes all upstreams.
 // Please make sure it will only be called once when capture exits.
 func (m *Manager) Close() {
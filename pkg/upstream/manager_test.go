@@ -182,3 +182,25 @@ func TestRemoveThenAddAgain(t *testing.T) {
 	_ = m.AddUpstream(&model.UpstreamInfo{ID: uint64(3)})
 	require.True(t, up.idleTime.IsZero())
 }
+
+func TestRemoveUpstream(t *testing.T) {
+	m := NewManager4Test(&gc.MockPDClient{})
+	initialized := make(chan struct{})
+	m.initUpstreamFunc = func(_ context.Context, up *Upstream, _ CaptureTopologyCfg) error {
+		up.cancel = func() {}
+		close(initialized)
+		return nil
+	}
+	up := m.AddUpstream(&model.UpstreamInfo{ID: uint64(3)})
+	require.NotNil(t, up)
+	<-initialized
+	m.Remove(uint64(3))
+	_, ok := m.Get(uint64(3))
+	require.False(t, ok)
+
+	// the default upstream can not be removed
+	m.Remove(testUpstreamID)
+	up, ok = m.Get(testUpstreamID)
+	require.True(t, ok)
+	require.NotNil(t, up)
+}
@@ -312,6 +312,23 @@ func (up *Upstream) IsClosed() bool {
 	return atomic.LoadInt32(&up.status) == closed
 }
 
+// CheckHealth returns nil if the upstream is initialized and its pd is reachable.
+func (up *Upstream) CheckHealth(ctx context.Context) error {
+	if err := up.Error(); err != nil {
+		return errors.Trace(err)
+	}
+	switch atomic.LoadInt32(&up.status) {
+	case uninit:
+		return errors.New("upstream is initializing")
+	case closing, closed:
+		return errors.ErrUpstreamClosed.GenWithStackByArgs()
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	_, _, err := up.PDClient.GetTS(ctx)
+	return errors.Trace(err)
+}
+
 // resetIdleTime set the upstream idle time to true
 func (up *Upstream) resetIdleTime() {
 	up.mu.Lock()
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/docker/go-units"
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"github.com/pingcap/errors"
@@ -50,6 +51,14 @@ const (
 	SplitterStrategyRandom = "random"
 )
 
+// Supported values for ReportFormat.
+const (
+	ReportFormatJSON  = "json"
+	ReportFormatJUnit = "junit"
+)
+
+const defaultApplyFixMaxRows = 1000
+
 const (
 	// LocalFilePerm is the permission for local files
 	LocalFilePerm os.FileMode = 0o644
@@ -91,6 +100,9 @@ type TableConfig struct {
 
 	// specify the chunksize for the table
 	ChunkSize int64 `toml:"chunk-size" json:"chunk-size"`
+
+	// rules to tolerate the differences caused by the type handling, such as float precision
+	CompareRules *utils.CompareRules `toml:"compare-rules" json:"compare-rules,omitempty"`
 }
 
 // Valid returns true if table's config is valide.
@@ -135,6 +147,50 @@ type DataSource struct {
 
 	Conn          *sql.DB
 	SessionConfig SessionConfig `toml:"session" json:"session"`
+
+	// TimeZone is the time zone offset of the DATETIME values in the data source,
+	// e.g. "+08:00". The `timestamp-to-utc` compare rule converts the DATETIME values
+	// from it to UTC. The default is "+00:00".
+	TimeZone string `toml:"time-zone" json:"time-zone,omitempty"`
+
+	// StorageURI is the sink-uri of a TiCDC changefeed with a storage sink.
+	// If it's set, the table data is read from the files of the storage sink
+	// instead of a database, and the snapshot is the commit-ts to read up to.
+	StorageURI string `toml:"storage-uri" json:"storage-uri,omitempty"`
+	// StorageConfig is the changefeed config file of the storage sink, it's
+	// used to decode the files, e.g. the csv delimiter.
+	StorageConfig string `toml:"storage-config" json:"storage-config,omitempty"`
+	// StorageTimeZone is the time zone of the TIMESTAMP values in the files,
+	// which is the time zone of the TiCDC server. The default is the local time zone.
+	StorageTimeZone string `toml:"storage-time-zone" json:"storage-time-zone,omitempty"`
+	// StorageMemoryLimit limits the memory of the rows materialized from the files,
+	// e.g. "8GiB". The default is DefaultStorageMemoryLimit.
+	StorageMemoryLimit string `toml:"storage-memory-limit" json:"storage-memory-limit,omitempty"`
+}
+
+// DefaultStorageMemoryLimit is the default memory limit of the storage data source.
+const DefaultStorageMemoryLimit = "4GiB"
+
+// GetStorageMemoryLimit returns the memory limit in bytes of the storage data source.
+func (d *DataSource) GetStorageMemoryLimit() (int64, error) {
+	limit := d.StorageMemoryLimit
+	if len(limit) == 0 {
+		limit = DefaultStorageMemoryLimit
+	}
+	bytes, err := units.RAMInBytes(limit)
+	if err != nil {
+		return 0, errors.Annotatef(err, "parse storage-memory-limit")
+	}
+	if bytes <= 0 {
+		return 0, errors.Errorf("storage-memory-limit should be greater than 0, but got %s", limit)
+	}
+	return bytes, nil
+}
+
+// IsStorage returns true if the data source reads the data from the files of
+// a TiCDC storage sink.
+func (d *DataSource) IsStorage() bool {
+	return len(d.StorageURI) > 0
 }
 
 // IsAutoSnapshot returns true if the tidb_snapshot is expected to automatically
@@ -246,6 +302,9 @@ type TaskConfig struct {
 	// SplitterStrategy mirrors top-level splitter-strategy so checkpoint hash
 	// can detect strategy changes consistently.
 	SplitterStrategy string `json:"-"`
+	// ReportFormat mirrors top-level report-format so the report can export
+	// the machine-readable report into the output dir.
+	ReportFormat string `json:"-"`
 
 	FixDir        string
 	CheckpointDir string
@@ -396,6 +455,44 @@ func (t *TaskConfig) ComputeConfigHash() (string, error) {
 // SessionConfig the the session level configuration for data source.
 type SessionConfig map[string]any
 
+const defaultContinuousCheckInterval = time.Minute
+
+// ContinuousConfig is the configuration to verify a TiCDC changefeed continuously,
+// the tables are checked at every syncpoint the changefeed writes to the downstream.
+type ContinuousConfig struct {
+	Enable bool `toml:"enable" json:"enable"`
+	// Changefeed is the ID of the changefeed to verify, it should be set if more than
+	// one changefeed writes syncpoints to the downstream.
+	Changefeed string `toml:"changefeed" json:"changefeed"`
+	// CheckInterval is the interval to poll the downstream for a new syncpoint.
+	CheckInterval string `toml:"check-interval" json:"check-interval"`
+	// MaxSyncpoints is the number of syncpoints to verify before exit, 0 means never exit.
+	MaxSyncpoints int `toml:"max-syncpoints" json:"max-syncpoints"`
+
+	checkInterval time.Duration
+}
+
+// GetCheckInterval returns the parsed check interval.
+func (c *ContinuousConfig) GetCheckInterval() time.Duration {
+	return c.checkInterval
+}
+
+func (c *ContinuousConfig) adjust() error {
+	if c.CheckInterval == "" {
+		c.checkInterval = defaultContinuousCheckInterval
+		return nil
+	}
+	interval, err := time.ParseDuration(c.CheckInterval)
+	if err != nil {
+		return errors.Annotatef(err, "invalid continuous check-interval %s", c.CheckInterval)
+	}
+	if interval <= 0 {
+		return errors.Errorf("continuous check-interval must be greater than 0")
+	}
+	c.checkInterval = interval
+	return nil
+}
+
 // Config is the configuration.
 type Config struct {
 	*flag.FlagSet `json:"-"`
@@ -420,6 +517,16 @@ type Config struct {
 	// on error; "random" and "limit" are enforced and skip the bucket iterator
 	// entirely.
 	SplitterStrategy string `toml:"splitter-strategy" json:"-"`
+	// ReportFormat is the format of the machine-readable report written besides
+	// the summary, it can be "json" or "junit". Empty means no such report.
+	ReportFormat string `toml:"report-format" json:"-"`
+	// ApplyFix executes the generated fix SQL on the target after the check.
+	ApplyFix bool `toml:"apply-fix" json:"-"`
+	// ApplyFixDryRun only reports the fix SQL that would be executed.
+	ApplyFixDryRun bool `toml:"apply-fix-dry-run" json:"-"`
+	// ApplyFixMaxRows is the max number of rows can be fixed, no fix SQL is
+	// executed if more rows are different.
+	ApplyFixMaxRows int `toml:"apply-fix-max-rows" json:"-"`
 	// DMAddr is dm-master's address, the format should like "http://127.0.0.1:8261"
 	DMAddr string `toml:"dm-addr" json:"dm-addr"`
 	// DMTask string `toml:"dm-task" json:"dm-task"`
@@ -432,6 +539,9 @@ type Config struct {
 	TableConfigs map[string]*TableConfig `toml:"table-configs" json:"table-configs"`
 
 	Task TaskConfig `toml:"task" json:"task"`
+
+	// Continuous is the config to verify a TiCDC changefeed at its syncpoints continuously.
+	Continuous ContinuousConfig `toml:"continuous" json:"-"`
 	// config file
 	ConfigFile string
 
@@ -459,6 +569,10 @@ func NewConfig() *Config {
 	fs.BoolVar(&cfg.CheckStructOnly, "check-struct-only", false, "ignore check table's data")
 	fs.BoolVar(&cfg.SkipNonExistingTable, "skip-non-existing-table", false, "skip validation for tables that don't exist upstream or downstream")
 	fs.BoolVar(&cfg.CheckDataOnly, "check-data-only", false, "ignore check table's struct")
+	fs.StringVar(&cfg.ReportFormat, "report-format", "", "<json|junit> also write a machine-readable report into the output dir")
+	fs.BoolVar(&cfg.ApplyFix, "apply-fix", false, "execute the fix SQL on the target instance after the check")
+	fs.BoolVar(&cfg.ApplyFixDryRun, "apply-fix-dry-run", false, "only report the fix SQL that would be executed by apply-fix")
+	fs.IntVar(&cfg.ApplyFixMaxRows, "apply-fix-max-rows", defaultApplyFixMaxRows, "the max number of rows can be fixed by apply-fix")
 
 	_ = fs.MarkHidden("check-data-only")
 
@@ -612,6 +726,11 @@ func (c *Config) Init() (err error) {
 	}
 	c.Task.ExportFixSQL = c.ExportFixSQL
 	c.Task.SplitterStrategy = c.SplitterStrategy
+	c.ReportFormat = strings.ToLower(strings.TrimSpace(c.ReportFormat))
+	c.Task.ReportFormat = c.ReportFormat
+	if err := c.Continuous.adjust(); err != nil {
+		return errors.Trace(err)
+	}
 
 	if len(c.DMAddr) > 0 {
 		err := c.adjustConfigByDMSubTasks()
@@ -667,6 +786,101 @@ func (c *Config) CheckConfig() bool {
 			return false
 		}
 	}
+	if c.Continuous.Enable {
+		if len(c.DMAddr) != 0 {
+			log.Error("continuous verification only supports the TiCDC changefeed, don't set `dm-addr`")
+			return false
+		}
+		if len(c.Task.SourceInstances) != 1 {
+			log.Error("continuous verification only supports one tidb source")
+			return false
+		}
+		for _, d := range append([]*DataSource{c.Task.TargetInstance}, c.Task.SourceInstances...) {
+			if d.Snapshot != "" && !d.IsAutoSnapshot() {
+				log.Error("the snapshot is set by the syncpoints in continuous verification, it should be empty or 'auto'")
+				return false
+			}
+		}
+		if c.CheckStructOnly {
+			log.Error("continuous verification checks the table data, don't set `check-struct-only`")
+			return false
+		}
+		if c.Continuous.MaxSyncpoints < 0 {
+			log.Error("continuous max-syncpoints must not be less than 0")
+			return false
+		}
+	}
+	if c.Task.TargetInstance != nil && c.Task.TargetInstance.IsStorage() {
+		log.Error("the storage data source can only be used as the source instance")
+		return false
+	}
+	for _, d := range append([]*DataSource{c.Task.TargetInstance}, c.Task.SourceInstances...) {
+		if d == nil {
+			continue
+		}
+		if _, err := utils.ParseTimeZoneOffset(d.TimeZone); err != nil {
+			log.Error("invalid time-zone", zap.Error(err))
+			return false
+		}
+	}
+	for _, d := range c.Task.SourceInstances {
+		if !d.IsStorage() {
+			continue
+		}
+		if len(c.Task.SourceInstances) != 1 {
+			log.Error("only support one source instance if the storage data source is used")
+			return false
+		}
+		if d.IsAutoSnapshot() {
+			log.Error("the snapshot of the storage data source should be a commit-ts, don't set it to 'auto'")
+			return false
+		}
+		if c.Continuous.Enable {
+			log.Error("continuous verification doesn't support the storage data source")
+			return false
+		}
+		if _, err := d.GetStorageMemoryLimit(); err != nil {
+			log.Error("invalid storage-memory-limit", zap.Error(err))
+			return false
+		}
+	}
+	for name, tableConfig := range c.TableConfigs {
+		if tableConfig.CompareRules == nil {
+			continue
+		}
+		if err := tableConfig.CompareRules.Validate(); err != nil {
+			log.Error("invalid compare-rules", zap.String("table-config", name), zap.Error(err))
+			return false
+		}
+	}
+	switch c.ReportFormat {
+	case "", ReportFormatJSON, ReportFormatJUnit:
+	default:
+		log.Error("report-format must be json or junit")
+		return false
+	}
+	if c.ApplyFix {
+		if !c.ExportFixSQL {
+			log.Error("apply-fix executes the fix SQL, `export-fix-sql` must be true")
+			return false
+		}
+		if c.CheckStructOnly {
+			log.Error("apply-fix fixes the table data, don't set `check-struct-only`")
+			return false
+		}
+		if c.Continuous.Enable {
+			log.Error("continuous verification doesn't support apply-fix")
+			return false
+		}
+		if c.ApplyFixMaxRows <= 0 {
+			log.Error("apply-fix-max-rows must greater than 0!")
+			return false
+		}
+		if c.Task.TargetInstance != nil && c.Task.TargetInstance.Snapshot != "" {
+			log.Error("apply-fix writes the target instance, don't set the snapshot of the target instance")
+			return false
+		}
+	}
 	return true
 }
 
@@ -19,7 +19,10 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/BurntSushi/toml"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -110,6 +113,146 @@ func TestInitNormalizesSplitterStrategyBeforeCheckpointHash(t *testing.T) {
 	require.Equal(t, SplitterStrategyRandom, cfg.Task.SplitterStrategy)
 }
 
+func TestContinuousConfig(t *testing.T) {
+	cfg := NewConfig()
+	require.NoError(t, cfg.Parse([]string{"--config", "config.toml"}))
+	cfg.Task.OutputDir = t.TempDir()
+	require.NoError(t, cfg.Init())
+	require.Equal(t, time.Minute, cfg.Continuous.GetCheckInterval())
+
+	cfg.Continuous.Enable = true
+	require.True(t, cfg.CheckConfig())
+	cfg.Continuous.CheckInterval = "10s"
+	require.NoError(t, cfg.Continuous.adjust())
+	require.Equal(t, 10*time.Second, cfg.Continuous.GetCheckInterval())
+	cfg.Continuous.CheckInterval = "10"
+	require.ErrorContains(t, cfg.Continuous.adjust(), "invalid continuous check-interval")
+	cfg.Continuous.CheckInterval = "-1s"
+	require.ErrorContains(t, cfg.Continuous.adjust(), "must be greater than 0")
+
+	// the snapshot is set by the syncpoints.
+	cfg.Task.TargetInstance.Snapshot = "auto"
+	cfg.Task.SourceInstances[0].Snapshot = "auto"
+	require.True(t, cfg.CheckConfig())
+	cfg.Task.TargetInstance.Snapshot = "386902609362944000"
+	require.False(t, cfg.CheckConfig())
+	cfg.Task.TargetInstance.Snapshot = ""
+	cfg.Task.SourceInstances[0].Snapshot = ""
+
+	cfg.CheckStructOnly = true
+	require.False(t, cfg.CheckConfig())
+	cfg.CheckStructOnly = false
+
+	cfg.Task.SourceInstances = append(cfg.Task.SourceInstances, cfg.Task.SourceInstances[0])
+	require.False(t, cfg.CheckConfig())
+}
+
+func TestStorageDataSource(t *testing.T) {
+	cfg := NewConfig()
+	require.NoError(t, cfg.Parse([]string{"--config", "config.toml"}))
+	cfg.Task.OutputDir = t.TempDir()
+	require.NoError(t, cfg.Init())
+
+	source := cfg.Task.SourceInstances[0]
+	require.False(t, source.IsStorage())
+	source.StorageURI = "file:///tmp/storage?protocol=csv"
+	require.True(t, source.IsStorage())
+	require.True(t, cfg.CheckConfig())
+
+	// the snapshot is the commit-ts.
+	source.Snapshot = "auto"
+	require.False(t, cfg.CheckConfig())
+	source.Snapshot = "386902609362944000"
+	require.True(t, cfg.CheckConfig())
+
+	cfg.Continuous.Enable = true
+	require.False(t, cfg.CheckConfig())
+	cfg.Continuous.Enable = false
+
+	limit, err := source.GetStorageMemoryLimit()
+	require.NoError(t, err)
+	require.Equal(t, int64(4<<30), limit)
+	source.StorageMemoryLimit = "512MiB"
+	limit, err = source.GetStorageMemoryLimit()
+	require.NoError(t, err)
+	require.Equal(t, int64(512<<20), limit)
+	source.StorageMemoryLimit = "abc"
+	require.False(t, cfg.CheckConfig())
+	source.StorageMemoryLimit = "0"
+	require.False(t, cfg.CheckConfig())
+	source.StorageMemoryLimit = ""
+
+	cfg.Task.SourceInstances = append(cfg.Task.SourceInstances, &DataSource{})
+	require.False(t, cfg.CheckConfig())
+	cfg.Task.SourceInstances = cfg.Task.SourceInstances[:1]
+
+	// the storage data source can't be the target.
+	cfg.Task.TargetInstance.StorageURI = "file:///tmp/storage?protocol=csv"
+	require.False(t, cfg.CheckConfig())
+}
+
+func TestReportFormatAndApplyFix(t *testing.T) {
+	cfg := NewConfig()
+	require.NoError(t, cfg.Parse([]string{"--config", "config.toml", "--report-format", "JSON"}))
+	cfg.Task.OutputDir = t.TempDir()
+	require.NoError(t, cfg.Init())
+	require.Equal(t, ReportFormatJSON, cfg.Task.ReportFormat)
+	require.Equal(t, defaultApplyFixMaxRows, cfg.ApplyFixMaxRows)
+	require.True(t, cfg.CheckConfig())
+
+	cfg.ReportFormat = "xml"
+	require.False(t, cfg.CheckConfig())
+	cfg.ReportFormat = ReportFormatJUnit
+	require.True(t, cfg.CheckConfig())
+
+	cfg.ApplyFix = true
+	cfg.Task.TargetInstance.Snapshot = ""
+	require.True(t, cfg.CheckConfig())
+	// the fix SQL must be exported.
+	cfg.ExportFixSQL = false
+	require.False(t, cfg.CheckConfig())
+	cfg.ExportFixSQL = true
+	cfg.ApplyFixMaxRows = 0
+	require.False(t, cfg.CheckConfig())
+	cfg.ApplyFixMaxRows = 10
+	// the target instance with snapshot can't be written.
+	cfg.Task.TargetInstance.Snapshot = "386902609362944000"
+	require.False(t, cfg.CheckConfig())
+}
+
+func TestCompareRules(t *testing.T) {
+	tableConfig := &TableConfig{}
+	_, err := toml.Decode(`
+target-tables = ["test.t"]
+[compare-rules]
+float-epsilon = 0.001
+timestamp-to-utc = true
+ignore-case = true
+`, tableConfig)
+	require.NoError(t, err)
+	require.Equal(t, &utils.CompareRules{FloatEpsilon: 0.001, TimestampToUTC: true, IgnoreCase: true}, tableConfig.CompareRules)
+
+	cfg := NewConfig()
+	require.NoError(t, cfg.Parse([]string{"--config", "config.toml"}))
+	cfg.Task.OutputDir = t.TempDir()
+	require.NoError(t, cfg.Init())
+	require.True(t, cfg.CheckConfig())
+	cfg.TableConfigs["config1"].CompareRules = tableConfig.CompareRules
+	require.True(t, cfg.CheckConfig())
+	tableConfig.CompareRules.FloatEpsilon = -1
+	require.False(t, cfg.CheckConfig())
+	tableConfig.CompareRules.FloatEpsilon = 0
+
+	// the time zone of the DATETIME values is an offset.
+	cfg.Task.SourceInstances[0].TimeZone = "+08:00"
+	cfg.Task.TargetInstance.TimeZone = "-05:30"
+	require.True(t, cfg.CheckConfig())
+	cfg.Task.SourceInstances[0].TimeZone = "Asia/Shanghai"
+	require.False(t, cfg.CheckConfig())
+	cfg.Task.SourceInstances[0].TimeZone = "+15:00"
+	require.False(t, cfg.CheckConfig())
+}
+
 func TestError(t *testing.T) {
 	tableConfig := &TableConfig{}
 	require.False(t, tableConfig.Valid())
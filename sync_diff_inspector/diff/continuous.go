@@ -0,0 +1,377 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tiflow/sync_diff_inspector/checkpoints"
+	"github.com/pingcap/tiflow/sync_diff_inspector/config"
+	"github.com/pingcap/tiflow/sync_diff_inspector/progress"
+	"github.com/pingcap/tiflow/sync_diff_inspector/report"
+	"github.com/pingcap/tiflow/sync_diff_inspector/source"
+	"github.com/pingcap/tiflow/sync_diff_inspector/source/common"
+	"github.com/pingcap/tiflow/sync_diff_inspector/splitter"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// getLatestSyncpointQuery gets the latest syncpoint, the verification starts from it because
+	// the snapshots of the older syncpoints may have been GC'd. primary_ts is stored as varchar,
+	// so it's casted to compare as a number.
+	getLatestSyncpointQuery = "SELECT primary_ts, secondary_ts FROM tidb_cdc.syncpoint_v1 " +
+		"WHERE (? = '' OR changefeed = ?) " +
+		"ORDER BY CAST(primary_ts AS UNSIGNED) DESC LIMIT 1"
+	// getNewSyncpointQuery gets the earliest syncpoint after the given primary ts, so no syncpoint
+	// is skipped even if the verification is slower than the syncpoint interval.
+	getNewSyncpointQuery = "SELECT primary_ts, secondary_ts FROM tidb_cdc.syncpoint_v1 " +
+		"WHERE (? = '' OR changefeed = ?) AND CAST(primary_ts AS UNSIGNED) > ? " +
+		"ORDER BY CAST(primary_ts AS UNSIGNED) ASC LIMIT 1"
+)
+
+// Syncpoint is a pair of TSOs written to the downstream by the TiCDC changefeed,
+// the upstream data at PrimaryTS should be equal to the downstream data at SecondaryTS.
+type Syncpoint struct {
+	PrimaryTS   string
+	SecondaryTS string
+}
+
+// getLatestSyncpoint returns the latest syncpoint, nil is returned if there is no syncpoint.
+func getLatestSyncpoint(ctx context.Context, db *sql.DB, changefeed string) (*Syncpoint, error) {
+	return querySyncpoint(ctx, db, getLatestSyncpointQuery, changefeed, changefeed)
+}
+
+// getNewSyncpoint returns the earliest syncpoint after the given primary ts,
+// nil is returned if there is no new syncpoint.
+func getNewSyncpoint(ctx context.Context, db *sql.DB, changefeed string, afterTS uint64) (*Syncpoint, error) {
+	return querySyncpoint(ctx, db, getNewSyncpointQuery, changefeed, changefeed, afterTS)
+}
+
+func querySyncpoint(ctx context.Context, db *sql.DB, query string, args ...interface{}) (*Syncpoint, error) {
+	sp := &Syncpoint{}
+	err := db.QueryRowContext(ctx, query, args...).Scan(&sp.PrimaryTS, &sp.SecondaryTS)
+	if errors.Cause(err) == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "sql: %s", query)
+	}
+	return sp, nil
+}
+
+// ContinuousDiff verifies the data replicated by a TiCDC changefeed at its syncpoints continuously.
+// It starts from the latest syncpoint, and then verifies every following syncpoint in order.
+// The chunks are split at the first syncpoint and reused at the following syncpoints, every
+// chunk is checked by checksum at each syncpoint, and its rows are compared only if the
+// checksums differ.
+type ContinuousDiff struct {
+	cfg *config.Config
+	df  *Diff
+
+	// syncpointDB connects to the downstream without the snapshot to read the syncpoints.
+	syncpointDB *sql.DB
+	// lastPrimaryTS is the primary ts of the last verified syncpoint, 0 means no syncpoint is verified.
+	lastPrimaryTS uint64
+
+	// chunks are split at the first syncpoint and reused at the following syncpoints.
+	chunks []*splitter.RangeInfo
+	// structResults are the table struct check results at the first syncpoint.
+	structResults []*report.TableResult
+
+	syncpoints *report.SyncpointsReport
+}
+
+// NewContinuousDiff returns a ContinuousDiff instance.
+func NewContinuousDiff(cfg *config.Config) (*ContinuousDiff, error) {
+	// the snapshots are set by the syncpoints.
+	cfg.Task.TargetInstance.SetSnapshot("")
+	cfg.Task.SourceInstances[0].SetSnapshot("")
+	db, err := common.ConnectMySQL(nil, cfg.Task.TargetInstance.ToDriverConfig(), 1)
+	if err != nil {
+		return nil, errors.Annotatef(err, "connect to the downstream to read syncpoints")
+	}
+	return &ContinuousDiff{
+		cfg:         cfg,
+		syncpointDB: db,
+		syncpoints:  report.NewSyncpointsReport(&cfg.Task),
+	}, nil
+}
+
+// Run verifies the syncpoints one by one until the context is done
+// or the number of verified syncpoints reaches max-syncpoints.
+func (cd *ContinuousDiff) Run(ctx context.Context) error {
+	maxSyncpoints := cd.cfg.Continuous.MaxSyncpoints
+	for verified := 0; maxSyncpoints == 0 || verified < maxSyncpoints; verified++ {
+		sp, err := cd.waitNewSyncpoint(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := cd.verify(ctx, sp); err != nil {
+			return errors.Annotatef(err, "verify syncpoint (primary-ts %s, secondary-ts %s)", sp.PrimaryTS, sp.SecondaryTS)
+		}
+	}
+	return nil
+}
+
+// Passed returns whether all the verified syncpoints are passed.
+func (cd *ContinuousDiff) Passed() bool {
+	return cd.syncpoints.Passed()
+}
+
+// Close closes the connections.
+func (cd *ContinuousDiff) Close() {
+	if cd.df != nil {
+		cd.df.Close()
+	}
+	cd.syncpointDB.Close()
+}
+
+func (cd *ContinuousDiff) waitNewSyncpoint(ctx context.Context) (*Syncpoint, error) {
+	for {
+		var (
+			sp  *Syncpoint
+			err error
+		)
+		if cd.lastPrimaryTS == 0 {
+			sp, err = getLatestSyncpoint(ctx, cd.syncpointDB, cd.cfg.Continuous.Changefeed)
+		} else {
+			sp, err = getNewSyncpoint(ctx, cd.syncpointDB, cd.cfg.Continuous.Changefeed, cd.lastPrimaryTS)
+		}
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if sp != nil {
+			return sp, nil
+		}
+		log.Info("wait for a new syncpoint",
+			zap.String("changefeed", cd.cfg.Continuous.Changefeed),
+			zap.Uint64("last primary-ts", cd.lastPrimaryTS))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(cd.cfg.Continuous.GetCheckInterval()):
+		}
+	}
+}
+
+func (cd *ContinuousDiff) verify(ctx context.Context, sp *Syncpoint) error {
+	primaryTS, err := strconv.ParseUint(sp.PrimaryTS, 10, 64)
+	if err != nil {
+		return errors.Annotatef(err, "invalid primary-ts")
+	}
+	log.Info("start to verify syncpoint", zap.String("primary-ts", sp.PrimaryTS), zap.String("secondary-ts", sp.SecondaryTS))
+	startTime := time.Now()
+
+	// the GC safepoint is kept only when the syncpoint is being verified.
+	roundCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if cd.df == nil {
+		err = cd.initDiff(roundCtx, sp)
+	} else {
+		err = cd.switchSnapshot(roundCtx, sp)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	df := cd.df
+	df.FixSQLDir = filepath.Join(cd.cfg.Task.FixDir, fmt.Sprintf("syncpoint-%s", sp.PrimaryTS))
+	if err := os.MkdirAll(df.FixSQLDir, os.ModePerm); err != nil {
+		return errors.Trace(err)
+	}
+	firstRound := cd.chunks == nil
+	checked, err := cd.equal(roundCtx)
+	if firstRound {
+		// the progress bar is only shown at the first syncpoint.
+		progress.Close()
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	df.report.CalculateTotalSize(roundCtx, df.downstream.GetDB())
+	if err := df.report.CommitSummary(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := cd.syncpoints.Add(&report.SyncpointResult{
+		PrimaryTS:     sp.PrimaryTS,
+		SecondaryTS:   sp.SecondaryTS,
+		Result:        df.report.Result,
+		CheckedChunks: checked,
+		FailedTables:  df.report.FailedTables(),
+		StartTime:     startTime,
+		Duration:      time.Since(startTime),
+	}); err != nil {
+		return errors.Trace(err)
+	}
+	cd.syncpoints.PrintLast(os.Stdout)
+	cd.lastPrimaryTS = primaryTS
+	log.Info("syncpoint verified",
+		zap.String("primary-ts", sp.PrimaryTS),
+		zap.String("secondary-ts", sp.SecondaryTS),
+		zap.String("result", df.report.Result),
+		zap.Int("checked chunks", checked),
+		zap.Duration("cost", time.Since(startTime)))
+	return nil
+}
+
+// initDiff initializes the diff at the first syncpoint and checks the table struct.
+func (cd *ContinuousDiff) initDiff(ctx context.Context, sp *Syncpoint) error {
+	cd.cfg.Task.SourceInstances[0].SetSnapshot(sp.PrimaryTS)
+	cd.cfg.Task.TargetInstance.SetSnapshot(sp.SecondaryTS)
+	df, err := NewDiff(ctx, cd.cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	cd.df = df
+	if _, ok := df.upstream.(source.SnapshotSwitchableSource); !ok {
+		return errors.New("continuous verification only supports the tidb upstream")
+	}
+	if _, ok := df.downstream.(source.SnapshotSwitchableSource); !ok {
+		return errors.New("continuous verification only supports the tidb downstream")
+	}
+	// continuous verification always starts from the beginning rather than the checkpoint.
+	df.startRange = nil
+	if err := cd.resetReport(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if !cd.cfg.CheckDataOnly {
+		if err := df.StructEqual(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for _, tableMap := range df.report.TableResults {
+		for _, result := range tableMap {
+			cd.structResults = append(cd.structResults, &report.TableResult{
+				Schema:      result.Schema,
+				Table:       result.Table,
+				StructEqual: result.StructEqual,
+				DataSkip:    result.DataSkip,
+				TableLack:   result.TableLack,
+			})
+		}
+	}
+	// Only enable new collation for data comparison.
+	collate.SetNewCollationEnabledForTest(true)
+	return nil
+}
+
+// switchSnapshot switches the sources to the snapshots of the syncpoint and resets the report.
+func (cd *ContinuousDiff) switchSnapshot(ctx context.Context, sp *Syncpoint) error {
+	df := cd.df
+	for _, s := range []struct {
+		source   source.Source
+		snapshot string
+	}{
+		{df.upstream, sp.PrimaryTS},
+		{df.downstream, sp.SecondaryTS},
+	} {
+		if err := s.source.(source.SnapshotSwitchableSource).SwitchSnapshot(s.snapshot); err != nil {
+			return errors.Trace(err)
+		}
+		df.startGCKeeperForTiDB(ctx, s.source.GetDB(), s.snapshot)
+	}
+
+	return cd.resetReport()
+}
+
+// resetReport creates a new report for the current syncpoint with the table struct check results.
+func (cd *ContinuousDiff) resetReport() error {
+	df := cd.df
+	sourceConfigs, targetConfig, err := getConfigsForReport(cd.cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	df.report = report.NewReport(&cd.cfg.Task)
+	df.report.Init(df.downstream.GetTables(), sourceConfigs, targetConfig)
+	for _, result := range cd.structResults {
+		df.report.SetTableStructCheckResult(result.Schema, result.Table, result.StructEqual, result.DataSkip, result.TableLack)
+	}
+	return nil
+}
+
+// equal checks the chunks at the current syncpoint, it returns the number of checked chunks.
+func (cd *ContinuousDiff) equal(ctx context.Context) (int, error) {
+	df := cd.df
+	df.sqlCh = make(chan *ChunkDML, splitter.DefaultChannelBuffer)
+	df.cp = new(checkpoints.Checkpoint)
+	df.cp.Init()
+	df.sqlWg.Add(1)
+	go df.writeSQLs(ctx)
+
+	pool := utils.NewWorkerPool(uint(df.checkThreadCount), "consumer")
+	firstRound := cd.chunks == nil
+	var checked atomic.Int64
+	apply := func(rangeInfo *splitter.RangeInfo) {
+		pool.Apply(func() {
+			df.consume(ctx, rangeInfo)
+			checked.Add(1)
+			if firstRound {
+				progress.Inc(rangeInfo.ProgressID)
+			}
+		})
+	}
+
+	var err error
+	if firstRound {
+		cd.chunks, err = cd.splitChunks(ctx, apply)
+	} else {
+		for _, rangeInfo := range cd.chunks {
+			apply(rangeInfo)
+		}
+	}
+	pool.WaitFinished()
+	close(df.sqlCh)
+	df.sqlWg.Wait()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return int(checked.Load()), nil
+}
+
+// splitChunks splits all the chunks at the first syncpoint, every chunk is applied once it's split.
+func (cd *ContinuousDiff) splitChunks(
+	ctx context.Context,
+	apply func(*splitter.RangeInfo),
+) ([]*splitter.RangeInfo, error) {
+	chunksIter, err := cd.df.generateChunksIterator(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer chunksIter.Close()
+	chunks := make([]*splitter.RangeInfo, 0)
+	for {
+		c, err := chunksIter.Next(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if c == nil {
+			return chunks, nil
+		}
+		chunks = append(chunks, c)
+		apply(c)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tiflow/sync_diff_inspector/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNewSyncpoint(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta(getNewSyncpointQuery)).
+		WithArgs("cf", "cf", uint64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}).AddRow("437000000000000001", "437000000000000002"))
+	sp, err := getNewSyncpoint(ctx, db, "cf", 0)
+	require.NoError(t, err)
+	require.Equal(t, &Syncpoint{PrimaryTS: "437000000000000001", SecondaryTS: "437000000000000002"}, sp)
+
+	// no new syncpoint
+	mock.ExpectQuery(regexp.QuoteMeta(getNewSyncpointQuery)).
+		WithArgs("", "", uint64(437000000000000001)).
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}))
+	sp, err = getNewSyncpoint(ctx, db, "", 437000000000000001)
+	require.NoError(t, err)
+	require.Nil(t, sp)
+
+	// no syncpoint
+	mock.ExpectQuery(regexp.QuoteMeta(getLatestSyncpointQuery)).
+		WithArgs("", "").
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}))
+	sp, err = getLatestSyncpoint(ctx, db, "")
+	require.NoError(t, err)
+	require.Nil(t, sp)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWaitNewSyncpoint(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	cfg := &config.Config{Continuous: config.ContinuousConfig{Changefeed: "cf"}}
+	cd := &ContinuousDiff{cfg: cfg, syncpointDB: db}
+
+	// the verification starts from the latest syncpoint.
+	mock.ExpectQuery(regexp.QuoteMeta(getLatestSyncpointQuery)).
+		WithArgs("cf", "cf").
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}).AddRow("437000000000000005", "437000000000000006"))
+	sp, err := cd.waitNewSyncpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Syncpoint{PrimaryTS: "437000000000000005", SecondaryTS: "437000000000000006"}, sp)
+
+	// the following syncpoints are verified in order.
+	cd.lastPrimaryTS = 437000000000000005
+	mock.ExpectQuery(regexp.QuoteMeta(getNewSyncpointQuery)).
+		WithArgs("cf", "cf", uint64(437000000000000005)).
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}).AddRow("437000000000000007", "437000000000000008"))
+	sp, err = cd.waitNewSyncpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Syncpoint{PrimaryTS: "437000000000000007", SecondaryTS: "437000000000000008"}, sp)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -84,6 +84,7 @@ type ChunkDML struct {
 	sqls      []string
 	rowAdd    int
 	rowDelete int
+	rowDiffs  []*report.RowDiff
 }
 
 // Diff contains two sql DB, used for comparing.
@@ -98,6 +99,9 @@ type Diff struct {
 	checkThreadCount int
 	splitThreadCount int
 	exportFixSQL     bool
+	applyFix         bool
+	applyFixDryRun   bool
+	applyFixMaxRows  int
 	sqlWg            sync.WaitGroup
 	checkpointWg     sync.WaitGroup
 
@@ -111,6 +115,12 @@ type Diff struct {
 
 	checksumCheckpoint   *checkpoints.ChecksumState
 	checksumCheckpointMu sync.Mutex
+
+	// fixChunks saves the fix SQL of the chunks to be applied by apply-fix,
+	// it is dropped once the number of rows exceeds applyFixMaxRows.
+	fixChunks       []*fixChunk
+	fixRows         int
+	fixRowsExceeded bool
 }
 
 // NewDiff returns a Diff instance.
@@ -119,6 +129,9 @@ func NewDiff(ctx context.Context, cfg *config.Config) (diff *Diff, err error) {
 		checkThreadCount: cfg.CheckThreadCount,
 		splitThreadCount: cfg.SplitThreadCount,
 		exportFixSQL:     cfg.ExportFixSQL,
+		applyFix:         cfg.ApplyFix,
+		applyFixDryRun:   cfg.ApplyFixDryRun,
+		applyFixMaxRows:  cfg.ApplyFixMaxRows,
 		sqlCh:            make(chan *ChunkDML, splitter.DefaultChannelBuffer),
 		cp:               new(checkpoints.Checkpoint),
 		report:           report.NewReport(&cfg.Task),
@@ -195,7 +208,8 @@ func (df *Diff) initCheckpoint() error {
 
 	finishTableNums := 0
 	path := filepath.Join(df.CheckpointDir, checkpointFile)
-	if fileExists(path) {
+	// apply-fix needs all the fix SQL, so the check always starts from beginning.
+	if fileExists(path) && !df.applyFix {
 		node, reportInfo, err := df.cp.LoadChunk(path)
 		if err != nil {
 			return errors.Annotate(err, "the checkpoint load process failed")
@@ -225,7 +239,11 @@ func (df *Diff) initCheckpoint() error {
 			}
 		}
 	} else {
-		log.Info("not found checkpoint file, start from beginning")
+		if df.applyFix {
+			log.Info("apply-fix is enabled, ignore the checkpoint file and start from beginning")
+		} else {
+			log.Info("not found checkpoint file, start from beginning")
+		}
 		id := &chunk.CID{TableIndex: -1, BucketIndexLeft: -1, BucketIndexRight: -1, ChunkIndex: -1, ChunkCnt: 0}
 		err := df.removeSQLFiles(id)
 		if err != nil {
@@ -391,7 +409,10 @@ func (df *Diff) startGCKeeperForTiDB(ctx context.Context, db *sql.DB, snap strin
 // pickSource pick one proper source to do some work. e.g. generate chunks
 func (df *Diff) pickSource(ctx context.Context) source.Source {
 	workSource := df.downstream
-	if ok, _ := dbutil.IsTiDB(ctx, df.upstream.GetDB()); ok {
+	// the storage source has no db connection.
+	if df.upstream.GetDB() == nil {
+		log.Info("The upstream has no db connection. pick the downstream as work source")
+	} else if ok, _ := dbutil.IsTiDB(ctx, df.upstream.GetDB()); ok {
 		log.Info("The upstream is TiDB. pick it as work source candidate")
 		df.startGCKeeperForTiDB(ctx, df.upstream.GetDB(), df.upstream.GetSnapshot())
 		workSource = df.upstream
@@ -498,6 +519,7 @@ func (df *Diff) consume(ctx context.Context, rangeInfo *splitter.RangeInfo) bool
 	}
 	dml.node.State = state
 	df.report.SetTableDataCheckResult(schema, table, isEqual, dml.rowAdd, dml.rowDelete, upCount, downCount, id)
+	df.report.AddChunkRowDiffs(schema, table, id, dml.rowDiffs)
 	return isEqual
 }
 
@@ -651,6 +673,7 @@ func (df *Diff) compareChecksumAndGetCount(ctx context.Context, tableRange *spli
 
 func (df *Diff) compareRows(ctx context.Context, rangeInfo *splitter.RangeInfo, dml *ChunkDML) (bool, error) {
 	collation := df.upstream.GetTables()[rangeInfo.GetTableIndex()].Collation
+	compareRules := df.upstream.GetTables()[rangeInfo.GetTableIndex()].CompareRules
 
 	rowsAdd, rowsDelete := 0, 0
 	upstreamRowsIterator, err := df.upstream.GetRowsIterator(ctx, rangeInfo)
@@ -688,6 +711,7 @@ func (df *Diff) compareRows(ctx context.Context, rangeInfo *splitter.RangeInfo,
 			// don't have source data, so all the targetRows's data is redundant, should be deleted
 			for lastDownstreamData != nil {
 				rowsDelete++
+				dml.addRowDiff(report.RowDiffDelete, lastDownstreamData, orderKeyCols)
 
 				if df.exportFixSQL {
 					sql := df.downstream.GenerateFixSQL(
@@ -711,6 +735,7 @@ func (df *Diff) compareRows(ctx context.Context, rangeInfo *splitter.RangeInfo,
 			// target lack some data, should insert the last source datas
 			for lastUpstreamData != nil {
 				rowsAdd++
+				dml.addRowDiff(report.RowDiffInsert, lastUpstreamData, orderKeyCols)
 				if df.exportFixSQL {
 					sql := df.downstream.GenerateFixSQL(source.Insert, lastUpstreamData, lastDownstreamData, rangeInfo.GetTableIndex())
 					log.Debug("[insert]", zap.String("sql", sql))
@@ -727,7 +752,7 @@ func (df *Diff) compareRows(ctx context.Context, rangeInfo *splitter.RangeInfo,
 			break
 		}
 
-		eq, cmp, err := utils.CompareData(lastUpstreamData, lastDownstreamData, orderKeyCols, tableInfo.Columns, collation)
+		eq, cmp, err := utils.CompareData(lastUpstreamData, lastDownstreamData, orderKeyCols, tableInfo.Columns, collation, compareRules)
 		if err != nil {
 			return false, errors.Trace(err)
 		}
@@ -744,6 +769,7 @@ func (df *Diff) compareRows(ctx context.Context, rangeInfo *splitter.RangeInfo,
 		case 1:
 			// delete
 			rowsDelete++
+			dml.addRowDiff(report.RowDiffDelete, lastDownstreamData, orderKeyCols)
 			if df.exportFixSQL {
 				sql = df.downstream.GenerateFixSQL(
 					source.Delete, lastUpstreamData, lastDownstreamData, rangeInfo.GetTableIndex(),
@@ -754,6 +780,7 @@ func (df *Diff) compareRows(ctx context.Context, rangeInfo *splitter.RangeInfo,
 		case -1:
 			// insert
 			rowsAdd++
+			dml.addRowDiff(report.RowDiffInsert, lastUpstreamData, orderKeyCols)
 			if df.exportFixSQL {
 				sql = df.downstream.GenerateFixSQL(
 					source.Insert, lastUpstreamData, lastDownstreamData, rangeInfo.GetTableIndex(),
@@ -765,6 +792,7 @@ func (df *Diff) compareRows(ctx context.Context, rangeInfo *splitter.RangeInfo,
 			// update
 			rowsAdd++
 			rowsDelete++
+			dml.addRowDiff(report.RowDiffReplace, lastUpstreamData, orderKeyCols)
 			if df.exportFixSQL {
 				sql = df.downstream.GenerateFixSQL(
 					source.Replace, lastUpstreamData, lastDownstreamData, rangeInfo.GetTableIndex(),
@@ -807,7 +835,7 @@ func (df *Diff) writeSQLs(ctx context.Context) {
 			}
 			if len(dml.sqls) > 0 {
 				tableDiff := df.downstream.GetTables()[dml.node.GetTableIndex()]
-				fileName := fmt.Sprintf("%s:%s:%s.sql", tableDiff.Schema, tableDiff.Table, utils.GetSQLFileName(dml.node.GetID()))
+				fileName := utils.GetFixSQLFileName(tableDiff.Schema, tableDiff.Table, dml.node.GetID())
 				fixSQLPath := filepath.Join(df.FixSQLDir, fileName)
 				if fileExists(fixSQLPath) {
 					// unreachable
@@ -830,6 +858,9 @@ func (df *Diff) writeSQLs(ctx context.Context) {
 					}
 				}
 				fixSQLFile.Close()
+				if df.applyFix {
+					df.addFixChunk(tableDiff, dml)
+				}
 			}
 			log.Debug("insert node", zap.Any("chunk index", dml.node.GetID()))
 			df.cp.Insert(dml.node)
@@ -0,0 +1,136 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tiflow/sync_diff_inspector/chunk"
+	"github.com/pingcap/tiflow/sync_diff_inspector/report"
+	"github.com/pingcap/tiflow/sync_diff_inspector/source/common"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
+	"go.uber.org/zap"
+)
+
+// fixChunk is the fix SQL of a chunk, it's executed in one transaction by apply-fix.
+type fixChunk struct {
+	schema string
+	table  string
+	id     *chunk.CID
+	sqls   []string
+}
+
+// addRowDiff records the order key values of the different row, at most
+// `report.MaxChunkRowDiffs` rows are recorded for a chunk.
+func (dml *ChunkDML) addRowDiff(tp string, data map[string]*dbutil.ColumnData, orderKeyCols []*model.ColumnInfo) {
+	if len(dml.rowDiffs) >= report.MaxChunkRowDiffs {
+		return
+	}
+	keys := make(map[string]any, len(orderKeyCols))
+	for _, col := range orderKeyCols {
+		var value any
+		if d, ok := data[col.Name.O]; ok && !d.IsNull {
+			value = string(d.Data)
+		}
+		keys[col.Name.O] = value
+	}
+	dml.rowDiffs = append(dml.rowDiffs, &report.RowDiff{Type: tp, Keys: keys})
+}
+
+// addFixChunk saves the fix SQL of the chunk for apply-fix. All the saved fix SQL is
+// dropped once the number of rows exceeds apply-fix-max-rows, because nothing will be applied.
+func (df *Diff) addFixChunk(tableDiff *common.TableDiff, dml *ChunkDML) {
+	if df.fixRowsExceeded {
+		return
+	}
+	df.fixRows += len(dml.sqls)
+	if df.fixRows > df.applyFixMaxRows {
+		log.Warn("the number of rows to fix exceeds apply-fix-max-rows, no fix SQL will be applied",
+			zap.Int("apply-fix-max-rows", df.applyFixMaxRows))
+		df.fixRowsExceeded = true
+		df.fixChunks = nil
+		return
+	}
+	df.fixChunks = append(df.fixChunks, &fixChunk{
+		schema: tableDiff.Schema,
+		table:  tableDiff.Table,
+		id:     dml.node.GetID(),
+		sqls:   dml.sqls,
+	})
+}
+
+// ApplyFix executes the fix SQL generated by the comparison on the target instance,
+// the fix SQL of each chunk is executed in one transaction. It should be called after Equal.
+func (df *Diff) ApplyFix(ctx context.Context) error {
+	res := &report.FixResult{DryRun: df.applyFixDryRun}
+	defer df.report.SetFixResult(res)
+
+	if df.report.Result == report.Error {
+		res.Message = "the comparison meets error, no fix SQL is applied"
+		return nil
+	}
+	if df.fixRowsExceeded {
+		res.Message = fmt.Sprintf("more than %d rows are different, no fix SQL is applied, please check the fix SQL and apply it manually", df.applyFixMaxRows)
+		return nil
+	}
+	if df.applyFixDryRun {
+		for _, c := range df.fixChunks {
+			log.Info("[dry-run] fix SQL would be applied",
+				zap.String("table", dbutil.TableName(c.schema, c.table)),
+				zap.String("fix sql file", utils.GetFixSQLFileName(c.schema, c.table, c.id)),
+				zap.Int("rows", len(c.sqls)))
+			res.Chunks++
+			res.Rows += len(c.sqls)
+		}
+		return nil
+	}
+
+	db := df.downstream.GetDB()
+	for _, c := range df.fixChunks {
+		if err := applyFixChunk(ctx, db, c); err != nil {
+			res.Message = fmt.Sprintf("failed to apply %s: %s", utils.GetFixSQLFileName(c.schema, c.table, c.id), err)
+			return errors.Annotatef(err, "failed to apply the fix SQL of table %s", dbutil.TableName(c.schema, c.table))
+		}
+		log.Info("fix SQL is applied",
+			zap.String("table", dbutil.TableName(c.schema, c.table)),
+			zap.String("fix sql file", utils.GetFixSQLFileName(c.schema, c.table, c.id)),
+			zap.Int("rows", len(c.sqls)))
+		res.Chunks++
+		res.Rows += len(c.sqls)
+	}
+	res.Applied = true
+	return nil
+}
+
+func applyFixChunk(ctx context.Context, db *sql.DB, c *fixChunk) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, query := range c.sqls {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Warn("failed to rollback the fix SQL", zap.Error(rbErr))
+			}
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(tx.Commit())
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tiflow/sync_diff_inspector/config"
+	"github.com/pingcap/tiflow/sync_diff_inspector/report"
+	"github.com/pingcap/tiflow/sync_diff_inspector/source/common"
+	"github.com/pingcap/tiflow/sync_diff_inspector/splitter"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type mockFixSource struct {
+	*mockChecksumSource
+	db *sql.DB
+}
+
+func (m *mockFixSource) GetDB() *sql.DB {
+	return m.db
+}
+
+func newFixChunkDML(idx int, sqls ...string) *ChunkDML {
+	rangeInfo := &splitter.RangeInfo{ChunkRange: newChecksumChunk(idx)}
+	return &ChunkDML{node: rangeInfo.ToNode(), sqls: sqls}
+}
+
+func TestAddRowDiff(t *testing.T) {
+	tableInfo, err := utils.GetTableInfoBySQL("create table t(a int, b varchar(10), c int, primary key(a, b))", parser.New())
+	require.NoError(t, err)
+	_, orderKeyCols := dbutil.SelectUniqueOrderKey(tableInfo)
+
+	dml := &ChunkDML{}
+	dml.addRowDiff(report.RowDiffInsert, map[string]*dbutil.ColumnData{
+		"a": {Data: []byte("1")},
+		"b": {IsNull: true},
+		"c": {Data: []byte("3")},
+	}, orderKeyCols)
+	require.Equal(t, []*report.RowDiff{{Type: report.RowDiffInsert, Keys: map[string]any{"a": "1", "b": nil}}}, dml.rowDiffs)
+
+	for i := 0; i < report.MaxChunkRowDiffs; i++ {
+		dml.addRowDiff(report.RowDiffDelete, nil, orderKeyCols)
+	}
+	require.Len(t, dml.rowDiffs, report.MaxChunkRowDiffs)
+}
+
+func TestApplyFix(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	tableDiff := &common.TableDiff{Schema: "test", Table: "t"}
+	newDiff := func(dryRun bool) *Diff {
+		df := &Diff{
+			downstream:      &mockFixSource{mockChecksumSource: &mockChecksumSource{}, db: db},
+			report:          report.NewReport(&config.TaskConfig{}),
+			applyFix:        true,
+			applyFixDryRun:  dryRun,
+			applyFixMaxRows: 3,
+		}
+		df.addFixChunk(tableDiff, newFixChunkDML(0, "DELETE 1", "REPLACE 2"))
+		df.addFixChunk(tableDiff, newFixChunkDML(1, "REPLACE 3"))
+		return df
+	}
+
+	// dry run doesn't execute the fix SQL.
+	df := newDiff(true)
+	require.NoError(t, df.ApplyFix(ctx))
+	require.Equal(t, &report.FixResult{DryRun: true, Chunks: 2, Rows: 3}, df.report.FixResult)
+
+	// every chunk is applied in a transaction, and the failed chunk is rolled back.
+	df = newDiff(false)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE 1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("REPLACE 2").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("REPLACE 3").WillReturnError(errors.New("mock error"))
+	mock.ExpectRollback()
+	require.ErrorContains(t, df.ApplyFix(ctx), "mock error")
+	require.NoError(t, mock.ExpectationsWereMet())
+	res := df.report.FixResult
+	require.False(t, res.Applied)
+	require.Equal(t, 1, res.Chunks)
+	require.Equal(t, 2, res.Rows)
+	require.Contains(t, res.Message, "test:t:0:0-0:1.sql")
+
+	df = newDiff(false)
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE 1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("REPLACE 2").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec("REPLACE 3").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, df.ApplyFix(ctx))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, &report.FixResult{Applied: true, Chunks: 2, Rows: 3}, df.report.FixResult)
+
+	// nothing is applied if too many rows are different.
+	df = newDiff(false)
+	df.addFixChunk(tableDiff, newFixChunkDML(2, "DELETE 4"))
+	require.True(t, df.fixRowsExceeded)
+	require.Empty(t, df.fixChunks)
+	require.NoError(t, df.ApplyFix(ctx))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.False(t, df.report.FixResult.Applied)
+	require.Contains(t, df.report.FixResult.Message, "more than 3 rows are different")
+}
@@ -128,7 +128,7 @@ func (m *mockChecksumSource) GetCountForLackTable(context.Context, *splitter.Ran
 }
 
 func (m *mockChecksumSource) GetRowsIterator(context.Context, *splitter.RangeInfo) (source.RowDataIterator, error) {
-	return nil, nil
+	return nil, fmt.Errorf("rows iterator is not supported")
 }
 
 func (m *mockChecksumSource) GenerateFixSQL(source.DMLType, map[string]*dbutil.ColumnData, map[string]*dbutil.ColumnData, int) string {
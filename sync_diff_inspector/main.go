@@ -17,7 +17,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -90,6 +92,14 @@ func main() {
 	log.Info("", zap.Stringer("config", cfg))
 
 	ctx := context.Background()
+	if cfg.Continuous.Enable {
+		if !checkContinuously(ctx, cfg) {
+			log.Warn("check failed!!!")
+			os.Exit(1)
+		}
+		log.Info("check pass!!!")
+		return
+	}
 	if !checkSyncState(ctx, cfg) {
 		log.Warn("check failed!!!")
 		os.Exit(1)
@@ -137,5 +147,39 @@ func checkSyncState(ctx context.Context, cfg *config.Config) bool {
 	} else {
 		log.Info("Check table struct only, skip data check")
 	}
-	return d.PrintSummary(ctx)
+	if cfg.ApplyFix {
+		err = d.ApplyFix(ctx)
+		if err != nil {
+			fmt.Printf("An error occurred while applying the fix SQL: %s, please check log info in %s for full details\n",
+				err, filepath.Join(cfg.Task.OutputDir, config.LogFileName))
+			log.Error("failed to apply the fix SQL", zap.Error(err))
+		}
+	}
+	return d.PrintSummary(ctx) && err == nil
+}
+
+// checkContinuously verifies the syncpoints of a TiCDC changefeed until it's interrupted
+// or max-syncpoints are verified, it returns true if all the verified syncpoints are passed.
+func checkContinuously(ctx context.Context, cfg *config.Config) bool {
+	ctx, cancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	d, err := diff.NewContinuousDiff(cfg)
+	if err != nil {
+		fmt.Printf("An error occurred while initializing continuous diff: %s, please check log info in %s for full details\n",
+			err, filepath.Join(cfg.Task.OutputDir, config.LogFileName))
+		log.Fatal("failed to initialize continuous diff process", zap.Error(err))
+		return false
+	}
+	defer d.Close()
+
+	// the verification is stopped by the signal if the context is done.
+	err = d.Run(ctx)
+	if err != nil && ctx.Err() == nil {
+		fmt.Printf("An error occurred while verifying syncpoints: %s, please check log info in %s for full details\n",
+			err, filepath.Join(cfg.Task.OutputDir, config.LogFileName))
+		log.Error("failed to verify syncpoints", zap.Error(err))
+		return false
+	}
+	return d.Passed()
 }
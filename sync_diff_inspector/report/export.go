@@ -0,0 +1,318 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/pingcap/tiflow/sync_diff_inspector/chunk"
+	"github.com/pingcap/tiflow/sync_diff_inspector/config"
+	"github.com/pingcap/tiflow/sync_diff_inspector/source/common"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
+)
+
+const (
+	// JSONReportFileName is the file name of the json report.
+	JSONReportFileName = "report.json"
+	// JUnitReportFileName is the file name of the junit report.
+	JUnitReportFileName = "report.xml"
+
+	// Skipped means the table does not exist in upstream or downstream
+	Skipped = "skipped"
+)
+
+// ExportReport is the machine-readable report of the comparison.
+type ExportReport struct {
+	Result    string               `json:"result"`
+	StartTime time.Time            `json:"start-time"`
+	Duration  string               `json:"duration"`
+	Passed    int                  `json:"passed"`
+	Failed    int                  `json:"failed"`
+	Skipped   int                  `json:"skipped"`
+	Errors    int                  `json:"errors"`
+	Tables    []*ExportTableResult `json:"tables"`
+	Fix       *ExportFixResult     `json:"fix,omitempty"`
+}
+
+// ExportTableResult is the result of a table in the machine-readable report.
+type ExportTableResult struct {
+	Schema      string               `json:"schema"`
+	Table       string               `json:"table"`
+	Result      string               `json:"result"`
+	StructEqual bool                 `json:"struct-equal"`
+	DataEqual   bool                 `json:"data-equal"`
+	DataSkip    bool                 `json:"data-skip"`
+	TableLack   string               `json:"table-lack,omitempty"`
+	Error       string               `json:"error,omitempty"`
+	UpCount     int64                `json:"up-count"`
+	DownCount   int64                `json:"down-count"`
+	RowsAdd     int                  `json:"rows-add"`
+	RowsDelete  int                  `json:"rows-delete"`
+	Chunks      []*ExportChunkResult `json:"chunks,omitempty"`
+}
+
+// ExportChunkResult is the result of a different chunk in the machine-readable report.
+type ExportChunkResult struct {
+	ID         string     `json:"id"`
+	RowsAdd    int        `json:"rows-add"`
+	RowsDelete int        `json:"rows-delete"`
+	FixSQLFile string     `json:"fix-sql-file,omitempty"`
+	Rows       []*RowDiff `json:"rows,omitempty"`
+}
+
+// ExportFixResult is the result of applying the fix SQL in the machine-readable report.
+type ExportFixResult struct {
+	DryRun  bool   `json:"dry-run"`
+	Applied bool   `json:"applied"`
+	Chunks  int    `json:"chunks"`
+	Rows    int    `json:"rows"`
+	Message string `json:"message,omitempty"`
+}
+
+func (r *Report) exportPath() string {
+	switch r.task.ReportFormat {
+	case config.ReportFormatJSON:
+		return filepath.Join(r.task.OutputDir, JSONReportFileName)
+	case config.ReportFormatJUnit:
+		return filepath.Join(r.task.OutputDir, JUnitReportFileName)
+	}
+	return ""
+}
+
+// export writes the machine-readable report into the output dir if the report-format is set.
+func (r *Report) export(duration time.Duration) error {
+	path := r.exportPath()
+	if path == "" {
+		return nil
+	}
+	exportReport := r.newExportReport(duration)
+	var (
+		data []byte
+		err  error
+	)
+	if r.task.ReportFormat == config.ReportFormatJSON {
+		data, err = json.MarshalIndent(exportReport, "", "  ")
+	} else {
+		data, err = exportReport.toJUnit(duration)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.WriteFile(path, data, config.LocalFilePerm))
+}
+
+func (r *Report) newExportReport(duration time.Duration) *ExportReport {
+	r.RLock()
+	defer r.RUnlock()
+	exportReport := &ExportReport{
+		Result:    r.Result,
+		StartTime: r.StartTime,
+		Duration:  duration.String(),
+		Tables:    make([]*ExportTableResult, 0),
+	}
+	for schema, tableMap := range r.TableResults {
+		for table, result := range tableMap {
+			tableResult := &ExportTableResult{
+				Schema:      schema,
+				Table:       table,
+				StructEqual: result.StructEqual,
+				DataEqual:   result.DataEqual,
+				DataSkip:    result.DataSkip,
+				UpCount:     result.UpCount,
+				DownCount:   result.DownCount,
+			}
+			switch result.TableLack {
+			case common.UpstreamTableLackFlag:
+				tableResult.TableLack = "upstream"
+			case common.DownstreamTableLackFlag:
+				tableResult.TableLack = "downstream"
+			}
+			switch {
+			case result.MeetError != nil:
+				tableResult.Result = Error
+				tableResult.Error = result.MeetError.Error()
+				exportReport.Errors++
+			case result.StructEqual && result.DataEqual:
+				tableResult.Result = Pass
+				exportReport.Passed++
+			case !common.AllTableExist(result.TableLack):
+				tableResult.Result = Skipped
+				exportReport.Skipped++
+			default:
+				tableResult.Result = Fail
+				exportReport.Failed++
+			}
+			tableResult.Chunks = r.newExportChunkResults(schema, table, result)
+			for _, chunkResult := range tableResult.Chunks {
+				tableResult.RowsAdd += chunkResult.RowsAdd
+				tableResult.RowsDelete += chunkResult.RowsDelete
+			}
+			exportReport.Tables = append(exportReport.Tables, tableResult)
+		}
+	}
+	sort.Slice(exportReport.Tables, func(i, j int) bool {
+		a, b := exportReport.Tables[i], exportReport.Tables[j]
+		return dbutil.TableName(a.Schema, a.Table) < dbutil.TableName(b.Schema, b.Table)
+	})
+	if r.FixResult != nil {
+		exportReport.Fix = &ExportFixResult{
+			DryRun:  r.FixResult.DryRun,
+			Applied: r.FixResult.Applied,
+			Chunks:  r.FixResult.Chunks,
+			Rows:    r.FixResult.Rows,
+			Message: r.FixResult.Message,
+		}
+	}
+	return exportReport
+}
+
+func (r *Report) newExportChunkResults(schema, table string, result *TableResult) []*ExportChunkResult {
+	chunkResults := make([]*ExportChunkResult, 0, len(result.ChunkMap))
+	ids := make(map[*ExportChunkResult]*chunk.CID, len(result.ChunkMap))
+	for id, res := range result.ChunkMap {
+		chunkResult := &ExportChunkResult{
+			ID:         id,
+			RowsAdd:    res.RowsAdd,
+			RowsDelete: res.RowsDelete,
+			Rows:       res.Rows,
+		}
+		cid := new(chunk.CID)
+		if err := cid.FromString(id); err == nil {
+			ids[chunkResult] = cid
+			fileName := utils.GetFixSQLFileName(schema, table, cid)
+			if _, err := os.Stat(filepath.Join(r.task.FixDir, fileName)); err == nil {
+				chunkResult.FixSQLFile = fileName
+			}
+		}
+		chunkResults = append(chunkResults, chunkResult)
+	}
+	sort.Slice(chunkResults, func(i, j int) bool {
+		a, b := ids[chunkResults[i]], ids[chunkResults[j]]
+		if a == nil || b == nil {
+			return chunkResults[i].ID < chunkResults[j].ID
+		}
+		return a.Compare(b) < 0
+	})
+	return chunkResults
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Name     string       `xml:"name,attr"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Errors   int          `xml:"errors,attr"`
+	Skipped  int          `xml:"skipped,attr"`
+	Time     string       `xml:"time,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// toJUnit converts the report to the JUnit XML format, every table is a test case.
+func (e *ExportReport) toJUnit(duration time.Duration) ([]byte, error) {
+	seconds := fmt.Sprintf("%.3f", duration.Seconds())
+	suite := junitSuite{
+		Name:      "sync_diff_inspector",
+		Tests:     len(e.Tables),
+		Failures:  e.Failed,
+		Errors:    e.Errors,
+		Skipped:   e.Skipped,
+		Time:      seconds,
+		Timestamp: e.StartTime.Format(time.RFC3339),
+		Cases:     make([]junitTestCase, 0, len(e.Tables)),
+	}
+	for _, t := range e.Tables {
+		testCase := junitTestCase{
+			ClassName: t.Schema,
+			Name:      dbutil.TableName(t.Schema, t.Table),
+		}
+		switch t.Result {
+		case Error:
+			testCase.Error = &junitMessage{Message: t.Error}
+		case Skipped:
+			testCase.Skipped = &junitMessage{Message: fmt.Sprintf("the table does not exist in %s", t.TableLack)}
+		case Fail:
+			testCase.Failure = &junitMessage{Message: t.failureMessage(), Text: t.failureDetail()}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	data, err := xml.MarshalIndent(&junitTestSuites{
+		Name:     "sync_diff_inspector",
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Errors:   suite.Errors,
+		Skipped:  suite.Skipped,
+		Time:     seconds,
+		Suites:   []junitSuite{suite},
+	}, "", "  ")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func (t *ExportTableResult) failureMessage() string {
+	if !t.StructEqual {
+		return "the table structure is not equal"
+	}
+	return fmt.Sprintf("the table data is not equal, +%d/-%d rows", t.RowsAdd, t.RowsDelete)
+}
+
+func (t *ExportTableResult) failureDetail() string {
+	var b strings.Builder
+	for _, c := range t.Chunks {
+		fmt.Fprintf(&b, "chunk %s: +%d/-%d rows", c.ID, c.RowsAdd, c.RowsDelete)
+		if c.FixSQLFile != "" {
+			fmt.Fprintf(&b, ", fix sql: %s", c.FixSQLFile)
+		}
+		b.WriteString("\n")
+		for _, row := range c.Rows {
+			keys, _ := json.Marshal(row.Keys)
+			fmt.Fprintf(&b, "  %s %s\n", row.Type, keys)
+		}
+	}
+	return b.String()
+}
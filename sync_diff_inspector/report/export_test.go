@@ -0,0 +1,155 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pingcap/tiflow/sync_diff_inspector/chunk"
+	"github.com/pingcap/tiflow/sync_diff_inspector/config"
+	"github.com/pingcap/tiflow/sync_diff_inspector/source/common"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func newExportTestReport(t *testing.T, format string) *Report {
+	outputDir := t.TempDir()
+	task := &config.TaskConfig{OutputDir: outputDir, FixDir: filepath.Join(outputDir, "fix"), ReportFormat: format}
+	require.NoError(t, os.MkdirAll(task.FixDir, 0o755))
+	report := NewReport(task)
+	report.Init([]*common.TableDiff{
+		{Schema: "test", Table: "equal"},
+		{Schema: "test", Table: "diff"},
+		{Schema: "test", Table: "lack"},
+		{Schema: "test", Table: "err"},
+	}, nil, nil)
+
+	report.SetTableDataCheckResult("test", "equal", true, 0, 0, 10, 10, &chunk.CID{TableIndex: 0, ChunkCnt: 1})
+
+	id1 := &chunk.CID{TableIndex: 1, BucketIndexLeft: 1, BucketIndexRight: 1, ChunkIndex: 0, ChunkCnt: 2}
+	id2 := &chunk.CID{TableIndex: 1, BucketIndexLeft: 0, BucketIndexRight: 0, ChunkIndex: 1, ChunkCnt: 2}
+	report.SetTableDataCheckResult("test", "diff", false, 2, 1, 5, 4, id1)
+	report.AddChunkRowDiffs("test", "diff", id1, []*RowDiff{
+		{Type: RowDiffInsert, Keys: map[string]any{"id": "1"}},
+		{Type: RowDiffReplace, Keys: map[string]any{"id": "2"}},
+	})
+	report.SetTableDataCheckResult("test", "diff", false, 0, 1, 0, 1, id2)
+	report.AddChunkRowDiffs("test", "diff", id2, []*RowDiff{{Type: RowDiffDelete, Keys: map[string]any{"id": nil}}})
+	require.NoError(t, os.WriteFile(filepath.Join(task.FixDir, utils.GetFixSQLFileName("test", "diff", id1)), nil, 0o644))
+
+	report.SetTableStructCheckResult("test", "lack", false, true, common.DownstreamTableLackFlag)
+	report.SetTableMeetError("test", "err", errors.New("mock error"))
+	report.SetFixResult(&FixResult{DryRun: true, Chunks: 2, Rows: 4})
+	return report
+}
+
+func TestAddChunkRowDiffs(t *testing.T) {
+	report := NewReport(&config.TaskConfig{})
+	report.Init([]*common.TableDiff{{Schema: "test", Table: "t"}}, nil, nil)
+	id := &chunk.CID{ChunkCnt: 1}
+	rows := make([]*RowDiff, 0, MaxChunkRowDiffs)
+	for i := 0; i < MaxChunkRowDiffs-1; i++ {
+		rows = append(rows, &RowDiff{Type: RowDiffInsert})
+	}
+
+	// the equal chunk has no row diffs.
+	report.AddChunkRowDiffs("test", "t", id, rows)
+	require.Empty(t, report.TableResults["test"]["t"].ChunkMap)
+
+	report.SetTableDataCheckResult("test", "t", false, 1, 0, 1, 0, id)
+	report.AddChunkRowDiffs("test", "t", id, rows)
+	report.AddChunkRowDiffs("test", "t", id, rows)
+	require.Len(t, report.TableResults["test"]["t"].ChunkMap[id.ToString()].Rows, MaxChunkRowDiffs)
+}
+
+func TestExportJSONReport(t *testing.T) {
+	report := newExportTestReport(t, config.ReportFormatJSON)
+	require.NoError(t, report.CommitSummary())
+
+	data, err := os.ReadFile(filepath.Join(report.task.OutputDir, JSONReportFileName))
+	require.NoError(t, err)
+	exportReport := &ExportReport{}
+	require.NoError(t, json.Unmarshal(data, exportReport))
+	require.Equal(t, Error, exportReport.Result)
+	require.Equal(t, 1, exportReport.Passed)
+	require.Equal(t, 1, exportReport.Failed)
+	require.Equal(t, 1, exportReport.Skipped)
+	require.Equal(t, 1, exportReport.Errors)
+	require.Equal(t, &ExportFixResult{DryRun: true, Chunks: 2, Rows: 4}, exportReport.Fix)
+
+	// the tables are sorted by name.
+	require.Len(t, exportReport.Tables, 4)
+	tables := make([]string, 0, len(exportReport.Tables))
+	for _, table := range exportReport.Tables {
+		tables = append(tables, table.Table)
+	}
+	require.Equal(t, []string{"diff", "equal", "err", "lack"}, tables)
+
+	diff := exportReport.Tables[0]
+	require.Equal(t, Fail, diff.Result)
+	require.Equal(t, 2, diff.RowsAdd)
+	require.Equal(t, 2, diff.RowsDelete)
+	// the chunks are sorted by chunk id.
+	require.Len(t, diff.Chunks, 2)
+	require.Equal(t, "1:0-0:1:2", diff.Chunks[0].ID)
+	require.Empty(t, diff.Chunks[0].FixSQLFile)
+	require.Equal(t, []*RowDiff{{Type: RowDiffDelete, Keys: map[string]any{"id": nil}}}, diff.Chunks[0].Rows)
+	require.Equal(t, "1:1-1:0:2", diff.Chunks[1].ID)
+	require.Equal(t, "test:diff:1:1-1:0.sql", diff.Chunks[1].FixSQLFile)
+	require.Len(t, diff.Chunks[1].Rows, 2)
+
+	require.Equal(t, Pass, exportReport.Tables[1].Result)
+	require.Equal(t, "mock error", exportReport.Tables[2].Error)
+	require.Equal(t, Skipped, exportReport.Tables[3].Result)
+	require.Equal(t, "downstream", exportReport.Tables[3].TableLack)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, report.Print(buf))
+	require.Contains(t, buf.String(), "Apply fix SQL: dry run, 4 rows in 2 chunks would be fixed\n")
+	require.Contains(t, buf.String(), JSONReportFileName)
+}
+
+func TestExportJUnitReport(t *testing.T) {
+	report := newExportTestReport(t, config.ReportFormatJUnit)
+	require.NoError(t, report.CommitSummary())
+
+	data, err := os.ReadFile(filepath.Join(report.task.OutputDir, JUnitReportFileName))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data, []byte(xml.Header)))
+	suites := &junitTestSuites{}
+	require.NoError(t, xml.Unmarshal(data, suites))
+	require.Equal(t, 4, suites.Tests)
+	require.Equal(t, 1, suites.Failures)
+	require.Equal(t, 1, suites.Errors)
+	require.Equal(t, 1, suites.Skipped)
+	require.Len(t, suites.Suites, 1)
+
+	cases := suites.Suites[0].Cases
+	require.Len(t, cases, 4)
+	require.Equal(t, "`test`.`diff`", cases[0].Name)
+	require.Equal(t, "the table data is not equal, +2/-2 rows", cases[0].Failure.Message)
+	require.Contains(t, cases[0].Failure.Text, "chunk 1:1-1:0:2: +2/-1 rows, fix sql: test:diff:1:1-1:0.sql\n")
+	require.Contains(t, cases[0].Failure.Text, `  replace {"id":"2"}`)
+	require.Nil(t, cases[1].Failure)
+	require.Nil(t, cases[1].Error)
+	require.Nil(t, cases[1].Skipped)
+	require.Equal(t, "mock error", cases[2].Error.Message)
+	require.Equal(t, "the table does not exist in downstream", cases[3].Skipped.Message)
+}
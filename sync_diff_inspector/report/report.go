@@ -46,6 +46,16 @@ const (
 	Error = "error"
 )
 
+// MaxChunkRowDiffs is the max number of different rows recorded for each chunk.
+const MaxChunkRowDiffs = 100
+
+// Types of RowDiff.
+const (
+	RowDiffInsert  = "insert"
+	RowDiffDelete  = "delete"
+	RowDiffReplace = "replace"
+)
+
 // Config stores the config information for the user
 type Config struct {
 	Host     string `toml:"host"`
@@ -71,8 +81,24 @@ type TableResult struct {
 
 // ChunkResult save the necessarily information to provide summary information
 type ChunkResult struct {
-	RowsAdd    int `json:"rows-add"`    // `RowsAdd` is the number of rows needed to add
-	RowsDelete int `json:"rows-delete"` // `RowsDelete` is the number of rows needed to delete
+	RowsAdd    int        `json:"rows-add"`       // `RowsAdd` is the number of rows needed to add
+	RowsDelete int        `json:"rows-delete"`    // `RowsDelete` is the number of rows needed to delete
+	Rows       []*RowDiff `json:"rows,omitempty"` // `Rows` is the first `MaxChunkRowDiffs` different rows of the chunk
+}
+
+// RowDiff identifies a different row by the values of its order key columns.
+type RowDiff struct {
+	Type string         `json:"type"` // `Type` is insert, delete or replace, the same as the fix SQL
+	Keys map[string]any `json:"keys"` // `Keys` is the map of column name => value, NULL is nil
+}
+
+// FixResult saves the result of applying the fix SQL to the target.
+type FixResult struct {
+	DryRun  bool
+	Applied bool
+	Chunks  int
+	Rows    int
+	Message string
 }
 
 // Report saves the check results.
@@ -88,6 +114,7 @@ type Report struct {
 	TotalSize    int64                              `json:"-"` // Total size of the checked tables
 	SourceConfig [][]byte                           `json:"-"`
 	TargetConfig []byte                             `json:"-"`
+	FixResult    *FixResult                         `json:"-"`
 
 	task *config.TaskConfig `json:"-"`
 }
@@ -156,6 +183,22 @@ func (r *Report) getDiffRows() [][]string {
 	return diffRows
 }
 
+// FailedTables returns the sorted tables which are not equal or meet error.
+func (r *Report) FailedTables() []string {
+	r.RLock()
+	defer r.RUnlock()
+	tables := make([]string, 0)
+	for schema, tableMap := range r.TableResults {
+		for table, result := range tableMap {
+			if result.MeetError != nil || !result.StructEqual || !result.DataEqual {
+				tables = append(tables, dbutil.TableName(schema, table))
+			}
+		}
+	}
+	sort.Strings(tables)
+	return tables
+}
+
 // CalculateTotalSize calculate the total size of all the checked tables
 // Notice, user should run the analyze table first, when some of tables' size are zero.
 func (r *Report) CalculateTotalSize(ctx context.Context, db *sql.DB) {
@@ -233,7 +276,10 @@ func (r *Report) CommitSummary() error {
 	duration := r.Duration + time.Since(r.StartTime)
 	summaryFile.WriteString(fmt.Sprintf("\nTime Cost: %s\n", duration))
 	summaryFile.WriteString(fmt.Sprintf("Average Speed: %fMB/s\n", float64(r.TotalSize)/(1024.0*1024.0*duration.Seconds())))
-	return nil
+	if r.FixResult != nil {
+		summaryFile.WriteString(fmt.Sprintf("Apply Fix: %s\n", r.FixResult.String()))
+	}
+	return errors.Trace(r.export(duration))
 }
 
 // Print print the current report
@@ -281,6 +327,12 @@ func (r *Report) Print(w io.Writer) error {
 		}
 		summary.WriteString(fmt.Sprintf("You can view the comparison details through '%s/%s'\n", r.task.OutputDir, config.LogFileName))
 	}
+	if r.FixResult != nil {
+		summary.WriteString(fmt.Sprintf("Apply fix SQL: %s\n", r.FixResult.String()))
+	}
+	if path := r.exportPath(); path != "" {
+		summary.WriteString(fmt.Sprintf("The %s report has been generated in '%s'\n", r.task.ReportFormat, path))
+	}
 	fmt.Fprint(w, summary.String())
 	return nil
 }
@@ -354,6 +406,47 @@ func (r *Report) SetTableDataCheckResult(schema, table string, equal bool, rowsA
 	}
 }
 
+// AddChunkRowDiffs records the different rows of the chunk, at most `MaxChunkRowDiffs` rows are kept.
+func (r *Report) AddChunkRowDiffs(schema, table string, id *chunk.CID, rows []*RowDiff) {
+	if len(rows) == 0 {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	chunkResult, ok := r.TableResults[schema][table].ChunkMap[id.ToString()]
+	if !ok {
+		return
+	}
+	if n := MaxChunkRowDiffs - len(chunkResult.Rows); n < len(rows) {
+		rows = rows[:max(n, 0)]
+	}
+	chunkResult.Rows = append(chunkResult.Rows, rows...)
+}
+
+// SetFixResult sets the result of applying the fix SQL.
+func (r *Report) SetFixResult(res *FixResult) {
+	r.Lock()
+	defer r.Unlock()
+	r.FixResult = res
+}
+
+// String implements fmt.Stringer.
+func (res *FixResult) String() string {
+	var b strings.Builder
+	switch {
+	case res.DryRun:
+		fmt.Fprintf(&b, "dry run, %d rows in %d chunks would be fixed", res.Rows, res.Chunks)
+	case res.Applied:
+		fmt.Fprintf(&b, "%d rows in %d chunks are fixed", res.Rows, res.Chunks)
+	default:
+		fmt.Fprintf(&b, "not completed, %d rows in %d chunks are fixed", res.Rows, res.Chunks)
+	}
+	if res.Message != "" {
+		fmt.Fprintf(&b, ", %s", res.Message)
+	}
+	return b.String()
+}
+
 // SetTableMeetError sets meet error when check the table.
 func (r *Report) SetTableMeetError(schema, table string, err error) {
 	r.Lock()
@@ -0,0 +1,107 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tiflow/sync_diff_inspector/config"
+)
+
+// SyncpointsFileName is the file name of the report of the continuous verification.
+const SyncpointsFileName = "syncpoints.txt"
+
+// SyncpointResult saves the check result of a syncpoint in the continuous verification.
+type SyncpointResult struct {
+	PrimaryTS     string
+	SecondaryTS   string
+	Result        string
+	CheckedChunks int
+	FailedTables  []string
+	StartTime     time.Time
+	Duration      time.Duration
+}
+
+// SyncpointsReport saves the results of all verified syncpoints.
+type SyncpointsReport struct {
+	Syncpoints []*SyncpointResult
+
+	task *config.TaskConfig
+}
+
+// NewSyncpointsReport returns a new SyncpointsReport.
+func NewSyncpointsReport(task *config.TaskConfig) *SyncpointsReport {
+	return &SyncpointsReport{task: task}
+}
+
+// Passed returns whether all the verified syncpoints are passed.
+func (r *SyncpointsReport) Passed() bool {
+	for _, res := range r.Syncpoints {
+		if res.Result != Pass {
+			return false
+		}
+	}
+	return true
+}
+
+// Add appends the result of a verified syncpoint and writes all results to the report file.
+func (r *SyncpointsReport) Add(res *SyncpointResult) error {
+	r.Syncpoints = append(r.Syncpoints, res)
+
+	reportFile, err := os.Create(filepath.Join(r.task.OutputDir, SyncpointsFileName))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer reportFile.Close()
+	reportFile.WriteString("Verified Syncpoints\n\n")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+	table.SetHeader([]string{"Primary TS", "Secondary TS", "Result", "Checked Chunks", "Failed Tables", "Start Time", "Time Cost"})
+	for _, s := range r.Syncpoints {
+		table.Append([]string{
+			s.PrimaryTS,
+			s.SecondaryTS,
+			s.Result,
+			strconv.Itoa(s.CheckedChunks),
+			strings.Join(s.FailedTables, ","),
+			s.StartTime.Format(time.RFC3339),
+			s.Duration.String(),
+		})
+	}
+	table.Render()
+	_, err = reportFile.WriteString(tableString.String())
+	return errors.Trace(err)
+}
+
+// PrintLast prints the result of the last verified syncpoint.
+func (r *SyncpointsReport) PrintLast(w io.Writer) {
+	if len(r.Syncpoints) == 0 {
+		return
+	}
+	s := r.Syncpoints[len(r.Syncpoints)-1]
+	fmt.Fprintf(w, "Syncpoint (primary-ts %s, secondary-ts %s) is verified: %s, %d chunks checked, cost %s\n",
+		s.PrimaryTS, s.SecondaryTS, s.Result, s.CheckedChunks, s.Duration)
+	if len(s.FailedTables) > 0 {
+		fmt.Fprintf(w, "The following tables are not equal: %s\n", strings.Join(s.FailedTables, ", "))
+	}
+	fmt.Fprintf(w, "You can view the verified syncpoints through '%s'\n", filepath.Join(r.task.OutputDir, SyncpointsFileName))
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
 )
 
 // TableShardSource represents the origin schema and table and DB connection before router.
@@ -27,6 +28,8 @@ type TableShardSource struct {
 	// DBConn represents the origin DB connection for this TableSource.
 	// This TableSource may exists in different MySQL shard.
 	DBConn *sql.DB
+	// TimeZone is the time zone offset of the DATETIME values in the MySQL shard.
+	TimeZone string
 }
 
 // TableSource represents the origin schema and table before router.
@@ -65,6 +68,9 @@ type TableDiff struct {
 
 	Collation string `json:"collation"`
 
+	// CompareRules tolerates the differences of the data caused by the type handling.
+	CompareRules *utils.CompareRules `json:"-"`
+
 	ChunkSize int64 `json:"chunk-size"`
 
 	// SplitterStrategy selects the chunk iterator. "auto" prefers bucket
@@ -114,7 +114,7 @@ func (s *MySQLSources) GetCountAndMD5(ctx context.Context, tableRange *splitter.
 
 	for _, ms := range matchSources {
 		go func(ms *common.TableShardSource) {
-			count, checksum, err := utils.GetCountAndMD5Checksum(ctx, ms.DBConn, ms.OriginSchema, ms.OriginTable, table.Info, chunk.Where, "", chunk.Args)
+			count, checksum, err := utils.GetCountAndMD5Checksum(ctx, ms.DBConn, ms.OriginSchema, ms.OriginTable, table.Info, table.CompareRules.WithSourceTimeZone(ms.TimeZone), chunk.Where, "", chunk.Args)
 			infoCh <- &ChecksumInfo{
 				Checksum: checksum,
 				Count:    count,
@@ -198,7 +198,7 @@ func (s *MySQLSources) GetRowsIterator(ctx context.Context, tableRange *splitter
 	var rowsQuery string
 	var orderKeyCols []*model.ColumnInfo
 	for i, ms := range matchSources {
-		rowsQuery, orderKeyCols = utils.GetTableRowsQueryFormat(ms.OriginSchema, ms.OriginTable, table.Info, table.Collation)
+		rowsQuery, orderKeyCols = utils.GetTableRowsQueryFormat(ms.OriginSchema, ms.OriginTable, table.Info, table.Collation, table.CompareRules.WithSourceTimeZone(ms.TimeZone))
 		query := fmt.Sprintf(rowsQuery, chunk.Where)
 		rows, err := ms.DBConn.QueryContext(ctx, query, chunk.Args...)
 		defer func() {
@@ -385,7 +385,8 @@ func NewMySQLSources(ctx context.Context, tableDiffs []*common.TableDiff, ds []*
 						OriginSchema: schema,
 						OriginTable:  table,
 					},
-					DBConn: sourceDB.Conn,
+					DBConn:   sourceDB.Conn,
+					TimeZone: sourceDB.TimeZone,
 				})
 			}
 		}
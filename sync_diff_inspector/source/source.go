@@ -126,6 +126,13 @@ type ChecksumCapableSource interface {
 	GetGlobalChecksumIterator(context.Context, int, *splitter.RangeInfo) (splitter.ChunkIterator, int, error)
 }
 
+// SnapshotSwitchableSource is an optional interface that Sources may implement
+// to read the data at another snapshot, which is used by the continuous verification.
+type SnapshotSwitchableSource interface {
+	// SwitchSnapshot switches the tidb_snapshot of the source.
+	SwitchSnapshot(string) error
+}
+
 // NewSources returns a new source
 func NewSources(ctx context.Context, cfg *config.Config) (downstream Source, upstream Source, err error) {
 	// init db connection for upstream / downstream.
@@ -151,6 +158,7 @@ func NewSources(ctx context.Context, cfg *config.Config) (downstream Source, ups
 			Range:               tableConfig.Range,
 			NeedUnifiedTimeZone: needUnifiedTimeZone,
 			Collation:           tableConfig.Collation,
+			CompareRules:        tableConfig.CompareRules.WithTargetTimeZone(cfg.Task.TargetInstance.TimeZone),
 			ChunkSize:           tableConfig.ChunkSize,
 			SplitterStrategy:    cfg.SplitterStrategy,
 		})
@@ -243,6 +251,13 @@ func buildSourceFromCfg(
 	if len(dbs) < 1 {
 		return nil, errors.Errorf("no db config detected")
 	}
+	if dbs[0].IsStorage() {
+		if len(dbs) == 1 {
+			return NewStorageSource(ctx, tableDiffs, dbs[0], f, skipNonExistingTable)
+		}
+
+		log.Fatal("Don't support check table in multiple storage instances, please specify one storage instance.")
+	}
 	ok, err := dbutil.IsTiDB(ctx, dbs[0].Conn)
 	if err != nil {
 		return nil, errors.Annotatef(err, "connect to db failed")
@@ -309,6 +324,10 @@ func initDBConn(_ context.Context, cfg *config.Config) error {
 		if source.IsAutoSnapshot() {
 			return errors.Errorf("'auto' snapshot should be set on both target and source")
 		}
+		if source.IsStorage() {
+			// the storage source reads the files, it has no db connection.
+			continue
+		}
 		// connect source db with target db time_zone
 		conn, err := common.ConnectMySQL(
 			&source.SessionConfig,
@@ -393,6 +412,7 @@ func initTables(ctx context.Context, cfg *config.Config) (cfgTables []*config.Ta
 				cfgTable.IgnoreColumns = table.IgnoreColumns
 				cfgTable.Fields = table.Fields
 				cfgTable.Collation = table.Collation
+				cfgTable.CompareRules = table.CompareRules
 				cfgTable.ChunkSize = table.ChunkSize
 				cfgTable.HasMatched = true
 			}
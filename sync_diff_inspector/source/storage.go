@@ -0,0 +1,1107 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"math/big"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/objstore/storeapi"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	tableFilter "github.com/pingcap/tidb/pkg/util/table-filter"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	sinkutil "github.com/pingcap/tiflow/cdc/sink/util"
+	cmdutil "github.com/pingcap/tiflow/pkg/cmd/util"
+	cdcconfig "github.com/pingcap/tiflow/pkg/config"
+	"github.com/pingcap/tiflow/pkg/sink/cloudstorage"
+	"github.com/pingcap/tiflow/pkg/sink/codec"
+	"github.com/pingcap/tiflow/pkg/sink/codec/canal"
+	codeccommon "github.com/pingcap/tiflow/pkg/sink/codec/common"
+	"github.com/pingcap/tiflow/pkg/sink/codec/csv"
+	putil "github.com/pingcap/tiflow/pkg/util"
+	"github.com/pingcap/tiflow/sync_diff_inspector/chunk"
+	"github.com/pingcap/tiflow/sync_diff_inspector/config"
+	"github.com/pingcap/tiflow/sync_diff_inspector/source/common"
+	"github.com/pingcap/tiflow/sync_diff_inspector/splitter"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// storageMetadataFile is the file which the storage sink writes its checkpoint-ts to.
+	storageMetadataFile = "metadata"
+	timeLayout          = "2006-01-02 15:04:05"
+	// rowOverhead and columnOverhead are the approximate memory overhead of a
+	// storageRow and a column in it, they are used to limit the memory.
+	rowOverhead    = 64
+	columnOverhead = 64
+)
+
+// StorageTableAnalyzer is used to analyze table in the storage source.
+// The storage source can't split chunks, the chunks are always split by the
+// other source, see #pickSource.
+type StorageTableAnalyzer struct{}
+
+// AnalyzeSplitter always returns an error.
+func (a *StorageTableAnalyzer) AnalyzeSplitter(context.Context, *common.TableDiff, *splitter.RangeInfo) (splitter.ChunkIterator, error) {
+	return nil, errors.New("the storage source doesn't support splitting chunks")
+}
+
+// StorageRowsIterator is used to iterate rows in the storage source.
+type StorageRowsIterator struct {
+	rows []*storageRow
+}
+
+// Close closes the iterator
+func (s *StorageRowsIterator) Close() {}
+
+// Next gets the next row
+func (s *StorageRowsIterator) Next() (map[string]*dbutil.ColumnData, error) {
+	if len(s.rows) == 0 {
+		return nil, nil
+	}
+	row := s.rows[0]
+	s.rows = s.rows[1:]
+	return row.data, nil
+}
+
+// storageRow is a row of the table materialized from the storage sink.
+// The values are the same as what TiDB returns in the text protocol, so
+// the checksum of the row is the same as the one computed by TiDB.
+type storageRow struct {
+	data     map[string]*dbutil.ColumnData
+	checksum uint64
+	// size is the approximate memory size of the row.
+	size int64
+}
+
+// storageMemory tracks the approximate memory of the rows materialized from the
+// storage, all the tables share the same limit. The limit is disabled if it's 0.
+type storageMemory struct {
+	used  int64
+	limit int64
+}
+
+func (m *storageMemory) grow(size int64) error {
+	m.used += size
+	if m.limit > 0 && m.used > m.limit {
+		return errors.Errorf("the rows materialized from the storage use more than %s memory, "+
+			"please increase `storage-memory-limit` or compare fewer tables at a time",
+			units.BytesSize(float64(m.limit)))
+	}
+	return nil
+}
+
+func (m *storageMemory) release(size int64) {
+	m.used -= size
+}
+
+// storageTable is the table state materialized from the storage sink.
+type storageTable struct {
+	tableDiff *common.TableDiff
+	// orderKeyCols are used to identify and sort the rows.
+	orderKeyCols []*model.ColumnInfo
+	// unique is true if orderKeyCols are the columns of a primary key or unique index.
+	unique bool
+	loc    *time.Location
+	// rules are the compare rules of the table with the time zone of the storage,
+	// datetimeLoc and targetLoc are the time zones to convert the DATETIME values.
+	rules       *utils.CompareRules
+	datetimeLoc *time.Location
+	targetLoc   *time.Location
+	memory      *storageMemory
+	// rowsByKey is used to apply the row changed events.
+	rowsByKey map[string][]*storageRow
+	// rows are sorted by orderKeyCols after all the events are applied.
+	rows []*storageRow
+}
+
+// StorageSource represents the table data in the files of a TiCDC storage sink.
+// It materializes the rows of the tables in memory up to the commit-ts, and computes
+// the checksum of the chunks the same way as TiDB does.
+type StorageSource struct {
+	tableDiffs     []*common.TableDiff
+	sourceTableMap map[string]*common.TableSource
+	snapshot       string
+	// tableDefs is the latest table definition of the source tables.
+	tableDefs map[string]*cloudstorage.TableDefinition
+	// tables is the materialized tables, the key is the unique id of the target table.
+	tables map[string]*storageTable
+}
+
+// storageDMLFile is a data file of the storage sink.
+type storageDMLFile struct {
+	key  cloudstorage.DmlPathKey
+	idx  uint64
+	path string
+}
+
+// GetTableAnalyzer gets the analyzer for current source
+func (s *StorageSource) GetTableAnalyzer() TableAnalyzer {
+	return &StorageTableAnalyzer{}
+}
+
+// GetRangeIterator returns a new iterator for storage table
+func (s *StorageSource) GetRangeIterator(ctx context.Context, r *splitter.RangeInfo, analyzer TableAnalyzer, splitThreadCount int) (RangeIterator, error) {
+	return NewChunksIterator(ctx, analyzer, s.tableDiffs, r, splitThreadCount)
+}
+
+// Close closes the source
+func (s *StorageSource) Close() {}
+
+// GetCountAndMD5 returns the checksum info
+func (s *StorageSource) GetCountAndMD5(_ context.Context, tableRange *splitter.RangeInfo) *ChecksumInfo {
+	beginTime := time.Now()
+	rows, err := s.getRows(tableRange)
+	var checksum uint64
+	for _, row := range rows {
+		checksum ^= row.checksum
+	}
+	return &ChecksumInfo{
+		Checksum: checksum,
+		Count:    int64(len(rows)),
+		Err:      err,
+		Cost:     time.Since(beginTime),
+	}
+}
+
+// GetCountForLackTable returns count for lack table
+func (s *StorageSource) GetCountForLackTable(_ context.Context, tableRange *splitter.RangeInfo) int64 {
+	table := s.tableDiffs[tableRange.GetTableIndex()]
+	if t, ok := s.tables[utils.UniqueID(table.Schema, table.Table)]; ok {
+		return int64(len(t.rows))
+	}
+	return 0
+}
+
+// GetTables returns all tables
+func (s *StorageSource) GetTables() []*common.TableDiff {
+	return s.tableDiffs
+}
+
+// GetSourceStructInfo get the table info from the table definition in the storage.
+func (s *StorageSource) GetSourceStructInfo(_ context.Context, tableIndex int) ([]*model.TableInfo, error) {
+	tableDiff := s.GetTables()[tableIndex]
+	source := getMatchSource(s.sourceTableMap, tableDiff)
+	tableDef, ok := s.tableDefs[utils.UniqueID(source.OriginSchema, source.OriginTable)]
+	if !ok {
+		return nil, errors.Errorf("table definition of %s is not found in the storage", dbutil.TableName(source.OriginSchema, source.OriginTable))
+	}
+	info, err := tableDef.ToTableInfo()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	tableInfo := info.TableInfo.Clone()
+	// The table definition of the storage sink doesn't record the indices,
+	// so we use the indices of the target table.
+	tableInfo.Indices = nil
+	for _, index := range tableDiff.Info.Indices {
+		newIndex := index.Clone()
+		found := true
+		for _, idxCol := range newIndex.Columns {
+			col := dbutil.FindColumnByName(tableInfo.Columns, idxCol.Name.O)
+			if col == nil {
+				found = false
+				break
+			}
+			idxCol.Offset = col.Offset
+		}
+		if found {
+			tableInfo.Indices = append(tableInfo.Indices, newIndex)
+		}
+	}
+	tableInfo, _ = utils.ResetColumns(tableInfo, tableDiff.IgnoreColumns)
+	return []*model.TableInfo{tableInfo}, nil
+}
+
+// GenerateFixSQL generate SQL
+func (s *StorageSource) GenerateFixSQL(t DMLType, upstreamData, downstreamData map[string]*dbutil.ColumnData, tableIndex int) string {
+	if t == Insert {
+		return utils.GenerateReplaceDML(upstreamData, s.tableDiffs[tableIndex].Info, s.tableDiffs[tableIndex].Schema)
+	}
+	if t == Delete {
+		return utils.GenerateDeleteDML(downstreamData, s.tableDiffs[tableIndex].Info, s.tableDiffs[tableIndex].Schema)
+	}
+	if t == Replace {
+		return utils.GenerateReplaceDMLWithAnnotation(upstreamData, downstreamData, s.tableDiffs[tableIndex].Info, s.tableDiffs[tableIndex].Schema)
+	}
+	log.Fatal("Don't support this type", zap.Any("dml type", t))
+	return ""
+}
+
+// GetRowsIterator returns a new iterator
+func (s *StorageSource) GetRowsIterator(_ context.Context, tableRange *splitter.RangeInfo) (RowDataIterator, error) {
+	rows, err := s.getRows(tableRange)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &StorageRowsIterator{rows: rows}, nil
+}
+
+// GetDB returns nil because the storage source has no db connection.
+func (s *StorageSource) GetDB() *sql.DB {
+	return nil
+}
+
+// GetSnapshot returns the commit-ts which the data is read up to.
+func (s *StorageSource) GetSnapshot() string {
+	return s.snapshot
+}
+
+func (s *StorageSource) getRows(tableRange *splitter.RangeInfo) ([]*storageRow, error) {
+	table := s.tableDiffs[tableRange.GetTableIndex()]
+	t, ok := s.tables[utils.UniqueID(table.Schema, table.Table)]
+	if !ok {
+		return nil, errors.Errorf("table %s is not found in the storage", dbutil.TableName(table.Schema, table.Table))
+	}
+	return t.rowsInRange(tableRange.GetChunk()), nil
+}
+
+// NewStorageSource return a new storage source
+func NewStorageSource(
+	ctx context.Context,
+	tableDiffs []*common.TableDiff, ds *config.DataSource,
+	f tableFilter.Filter, skipNonExistingTable bool,
+) (Source, error) {
+	sinkURI, err := url.Parse(ds.StorageURI)
+	if err != nil {
+		return nil, errors.Annotatef(err, "parse storage-uri")
+	}
+	replicaConfig := cdcconfig.GetDefaultReplicaConfig()
+	if len(ds.StorageConfig) > 0 {
+		if err := cmdutil.StrictDecodeFile(ds.StorageConfig, "sync diff inspector", replicaConfig); err != nil {
+			return nil, errors.Annotatef(err, "decode storage-config")
+		}
+	}
+	if err := replicaConfig.ValidateAndAdjust(sinkURI); err != nil {
+		return nil, errors.Annotatef(err, "validate storage-config")
+	}
+	protocol, err := cdcconfig.ParseSinkProtocolFromString(putil.GetOrZero(replicaConfig.Sink.Protocol))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if protocol != cdcconfig.ProtocolCsv && protocol != cdcconfig.ProtocolCanalJSON {
+		return nil, errors.Errorf("the storage source doesn't support protocol %s, only csv and canal-json are supported", protocol)
+	}
+	codecCfg := codeccommon.NewConfig(protocol)
+	if err := codecCfg.Apply(sinkURI, replicaConfig); err != nil {
+		return nil, errors.Trace(err)
+	}
+	// the commit ts of canal-json is in the tidb extension.
+	codecCfg.EnableTiDBExtension = true
+
+	loc := time.Local
+	if len(ds.StorageTimeZone) > 0 {
+		loc, err = time.LoadLocation(ds.StorageTimeZone)
+		if err != nil {
+			return nil, errors.Annotatef(err, "load storage-time-zone")
+		}
+	}
+
+	storage, err := putil.GetExternalStorageFromURI(ctx, ds.StorageURI)
+	if err != nil {
+		return nil, errors.Annotatef(err, "open storage")
+	}
+
+	commitTs, err := getStorageCommitTs(ctx, storage, ds.Snapshot)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if commitTs != math.MaxUint64 && protocol == cdcconfig.ProtocolCsv && !codecCfg.IncludeCommitTs {
+		return nil, errors.New("the csv files don't include the commit-ts, please set `include-commit-ts` in storage-config or don't set the snapshot")
+	}
+
+	tableDefs, dmlFiles, err := walkStorage(ctx, storage, putil.GetOrZero(replicaConfig.Sink.DateSeparator), sinkutil.GetFileExtension(protocol))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	sourceTableMap := make(map[string]*common.TableSource)
+	log.Info("find router for storage source")
+	targetUniqueTableMap := make(map[string]struct{})
+	for _, tableDiff := range tableDiffs {
+		targetUniqueTableMap[utils.UniqueID(tableDiff.Schema, tableDiff.Table)] = struct{}{}
+	}
+	sourceTablesAfterRoute := make(map[string]struct{})
+	latestTableDefs := make(map[string]*cloudstorage.TableDefinition)
+	for key, defs := range tableDefs {
+		latest := defs[len(defs)-1]
+		latestTableDefs[key] = latest
+		schema, table := latest.Schema, latest.Table
+		targetSchema, targetTable := schema, table
+		if ds.Router != nil {
+			targetSchema, targetTable, err = ds.Router.Route(schema, table)
+			if err != nil {
+				return nil, errors.Errorf("get route result for %s.%s failed, error %v", schema, table, err)
+			}
+		}
+
+		uniqueID := utils.UniqueID(targetSchema, targetTable)
+		isMatched := f.MatchTable(targetSchema, targetTable)
+		if isMatched {
+			sourceTablesAfterRoute[uniqueID] = struct{}{}
+		}
+		if _, ok := targetUniqueTableMap[uniqueID]; ok || (isMatched && skipNonExistingTable) {
+			if _, ok := sourceTableMap[uniqueID]; ok {
+				log.Error("storage source don't support compare multiple source tables with one downstream table")
+			}
+			sourceTableMap[uniqueID] = &common.TableSource{
+				OriginSchema: schema,
+				OriginTable:  table,
+			}
+		}
+	}
+
+	tableDiffs, err = checkTableMatched(tableDiffs, targetUniqueTableMap, sourceTablesAfterRoute, skipNonExistingTable)
+	if err != nil {
+		return nil, errors.Annotatef(err, "please make sure the filter is correct.")
+	}
+
+	memoryLimit, err := ds.GetStorageMemoryLimit()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	memory := &storageMemory{limit: memoryLimit}
+	tables := make(map[string]*storageTable)
+	for _, tableDiff := range tableDiffs {
+		if tableDiff.TableLack != 0 {
+			continue
+		}
+		if !utils.IsRangeTrivial(tableDiff.Range) {
+			return nil, errors.Errorf("the storage source doesn't support the range of table %s", dbutil.TableName(tableDiff.Schema, tableDiff.Table))
+		}
+		uniqueID := utils.UniqueID(tableDiff.Schema, tableDiff.Table)
+		source := sourceTableMap[uniqueID]
+		originID := utils.UniqueID(source.OriginSchema, source.OriginTable)
+		t, err := newStorageTable(tableDiff, loc, ds.TimeZone, memory)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, file := range dmlFiles[originID] {
+			if err := t.loadFile(ctx, storage, codecCfg, tableDefs[originID], file, commitTs); err != nil {
+				return nil, errors.Annotatef(err, "load %s", file.path)
+			}
+		}
+		t.sortRows()
+		log.Info("materialize table from storage",
+			zap.String("table", dbutil.TableName(tableDiff.Schema, tableDiff.Table)),
+			zap.Int("files", len(dmlFiles[originID])),
+			zap.Int("rows", len(t.rows)),
+			zap.String("total memory", units.BytesSize(float64(memory.used))))
+		tables[uniqueID] = t
+	}
+
+	snapshot := ""
+	if commitTs != math.MaxUint64 {
+		snapshot = strconv.FormatUint(commitTs, 10)
+	}
+	return &StorageSource{
+		tableDiffs:     tableDiffs,
+		sourceTableMap: sourceTableMap,
+		snapshot:       snapshot,
+		tableDefs:      latestTableDefs,
+		tables:         tables,
+	}, nil
+}
+
+// getStorageCommitTs returns the commit-ts to read the data up to. It returns
+// math.MaxUint64 if the snapshot is not set, which means all the data is read.
+func getStorageCommitTs(ctx context.Context, storage storeapi.Storage, snapshot string) (uint64, error) {
+	if len(snapshot) == 0 {
+		return math.MaxUint64, nil
+	}
+	commitTs, err := strconv.ParseUint(snapshot, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("the snapshot of the storage source should be a commit-ts, but got %s", snapshot)
+	}
+	exist, err := storage.FileExists(ctx, storageMetadataFile)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if !exist {
+		return 0, errors.Errorf("the %s file is not found in the storage, can't make sure the data up to the commit-ts is complete", storageMetadataFile)
+	}
+	content, err := storage.ReadFile(ctx, storageMetadataFile)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	var metadata struct {
+		CheckpointTs uint64 `json:"checkpoint-ts"`
+	}
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return 0, errors.Annotatef(err, "parse %s", storageMetadataFile)
+	}
+	if commitTs > metadata.CheckpointTs {
+		return 0, errors.Errorf("the commit-ts %d is greater than the checkpoint-ts %d of the storage sink, the data may be incomplete", commitTs, metadata.CheckpointTs)
+	}
+	return commitTs, nil
+}
+
+// walkStorage returns the table definitions sorted by the table version and the
+// data files sorted in the order they are written of every table.
+func walkStorage(
+	ctx context.Context, storage storeapi.Storage, dateSeparator, extension string,
+) (map[string][]*cloudstorage.TableDefinition, map[string][]*storageDMLFile, error) {
+	tableDefs := make(map[string][]*cloudstorage.TableDefinition)
+	dmlFiles := make(map[string][]*storageDMLFile)
+	err := storage.WalkDir(ctx, &storeapi.WalkOption{}, func(path string, _ int64) error {
+		if cloudstorage.IsSchemaFile(path) {
+			var schemaKey cloudstorage.SchemaPathKey
+			if _, err := schemaKey.ParseSchemaFilePath(path); err != nil {
+				return errors.Annotatef(err, "parse schema file path %s", path)
+			}
+			if len(schemaKey.Table) == 0 {
+				// it's the definition of a database.
+				return nil
+			}
+			content, err := storage.ReadFile(ctx, path)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			tableDef := &cloudstorage.TableDefinition{}
+			if err := json.Unmarshal(content, tableDef); err != nil {
+				return errors.Annotatef(err, "parse schema file %s", path)
+			}
+			if tableDef.TotalColumns == 0 {
+				return nil
+			}
+			key := utils.UniqueID(schemaKey.Schema, schemaKey.Table)
+			tableDefs[key] = append(tableDefs[key], tableDef)
+			return nil
+		}
+		if !strings.HasSuffix(path, extension) {
+			log.Debug("ignore file in storage", zap.String("path", path))
+			return nil
+		}
+		file := &storageDMLFile{path: path}
+		idx, err := file.key.ParseDMLFilePath(dateSeparator, path)
+		if err != nil {
+			return errors.Annotatef(err, "parse dml file path %s", path)
+		}
+		file.idx = idx
+		key := utils.UniqueID(file.key.Schema, file.key.Table)
+		dmlFiles[key] = append(dmlFiles[key], file)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	for _, defs := range tableDefs {
+		sort.Slice(defs, func(i, j int) bool {
+			return defs[i].TableVersion < defs[j].TableVersion
+		})
+	}
+	for _, files := range dmlFiles {
+		sort.Slice(files, func(i, j int) bool {
+			ki, kj := files[i].key, files[j].key
+			if ki.TableVersion != kj.TableVersion {
+				return ki.TableVersion < kj.TableVersion
+			}
+			if ki.PartitionNum != kj.PartitionNum {
+				return ki.PartitionNum < kj.PartitionNum
+			}
+			if ki.Date != kj.Date {
+				return ki.Date < kj.Date
+			}
+			return files[i].idx < files[j].idx
+		})
+	}
+	return tableDefs, dmlFiles, nil
+}
+
+func newStorageTable(tableDiff *common.TableDiff, loc *time.Location, timeZone string, memory *storageMemory) (*storageTable, error) {
+	_, orderKeyCols := dbutil.SelectUniqueOrderKey(tableDiff.Info)
+	unique := tableDiff.Info.PKIsHandle
+	for _, index := range dbutil.FindAllIndex(tableDiff.Info) {
+		if index.Primary || index.Unique {
+			unique = true
+			break
+		}
+	}
+	t := &storageTable{
+		tableDiff:    tableDiff,
+		orderKeyCols: orderKeyCols,
+		unique:       unique,
+		loc:          loc,
+		rules:        tableDiff.CompareRules.WithSourceTimeZone(timeZone),
+		memory:       memory,
+		rowsByKey:    make(map[string][]*storageRow),
+	}
+	if t.rules != nil && t.rules.TimestampToUTC {
+		var err error
+		if t.datetimeLoc, err = utils.ParseTimeZoneOffset(t.rules.SourceTimeZone); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if t.targetLoc, err = utils.ParseTimeZoneOffset(t.rules.TargetTimeZone); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return t, nil
+}
+
+// loadFile applies the row changed events in the data file whose commit ts
+// are not greater than commitTs.
+func (t *storageTable) loadFile(
+	ctx context.Context, storage storeapi.Storage, codecCfg *codeccommon.Config,
+	tableDefs []*cloudstorage.TableDefinition, file *storageDMLFile, commitTs uint64,
+) error {
+	var tableDef *cloudstorage.TableDefinition
+	for _, def := range tableDefs {
+		if def.TableVersion == file.key.TableVersion {
+			tableDef = def
+			break
+		}
+	}
+	if tableDef == nil {
+		return errors.Errorf("table definition of version %d is not found", file.key.TableVersion)
+	}
+	tableInfo, err := tableDef.ToTableInfo()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	content, err := storage.ReadFile(ctx, file.path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var decoder codec.RowEventDecoder
+	switch codecCfg.Protocol {
+	case cdcconfig.ProtocolCsv:
+		decoder, err = csv.NewBatchDecoder(ctx, codecCfg, tableInfo, content)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	default:
+		decoder = canal.NewCanalJSONTxnEventDecoder(codecCfg)
+		if err := decoder.AddKeyValue(nil, content); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	for {
+		tp, hasNext, err := decoder.HasNext()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !hasNext {
+			return nil
+		}
+		if tp != cdcmodel.MessageTypeRow {
+			continue
+		}
+		event, err := decoder.NextRowChangedEvent()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if commitTs != math.MaxUint64 {
+			if event.CommitTs == 0 {
+				return errors.New("the commit-ts is not found in the files, please set `enable-tidb-extension` for canal-json or don't set the snapshot")
+			}
+			if event.CommitTs > commitTs {
+				continue
+			}
+		}
+		if err := t.apply(event); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// apply applies a row changed event to the table.
+func (t *storageTable) apply(event *cdcmodel.RowChangedEvent) error {
+	if event.IsDelete() || event.IsUpdate() {
+		preCols := event.GetPreColumns()
+		if event.IsUpdate() {
+			// the old value may only contain the updated columns.
+			preCols = mergeColumns(preCols, event.GetColumns())
+		}
+		row, err := t.newRow(preCols)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		t.remove(row)
+	}
+	if event.IsInsert() || event.IsUpdate() {
+		row, err := t.newRow(event.GetColumns())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := t.insert(row); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func mergeColumns(preCols, cols []*cdcmodel.Column) []*cdcmodel.Column {
+	merged := make([]*cdcmodel.Column, 0, len(cols))
+	found := make(map[string]struct{}, len(preCols))
+	for _, col := range preCols {
+		if col != nil {
+			merged = append(merged, col)
+			found[col.Name] = struct{}{}
+		}
+	}
+	for _, col := range cols {
+		if col == nil {
+			continue
+		}
+		if _, ok := found[col.Name]; !ok {
+			merged = append(merged, col)
+		}
+	}
+	return merged
+}
+
+func (t *storageTable) insert(row *storageRow) error {
+	key, hasNull := t.rowKey(row)
+	if t.unique && !hasNull {
+		for _, r := range t.rowsByKey[key] {
+			t.memory.release(r.size)
+		}
+		t.rowsByKey[key] = []*storageRow{row}
+	} else {
+		t.rowsByKey[key] = append(t.rowsByKey[key], row)
+	}
+	return t.memory.grow(row.size)
+}
+
+func (t *storageTable) remove(row *storageRow) {
+	key, hasNull := t.rowKey(row)
+	if t.unique && !hasNull {
+		for _, r := range t.rowsByKey[key] {
+			t.memory.release(r.size)
+		}
+		delete(t.rowsByKey, key)
+		return
+	}
+	rows := t.rowsByKey[key]
+	for i, r := range rows {
+		if sameRow(r, row) {
+			t.memory.release(r.size)
+			rows = append(rows[:i], rows[i+1:]...)
+			break
+		}
+	}
+	if len(rows) == 0 {
+		delete(t.rowsByKey, key)
+	} else {
+		t.rowsByKey[key] = rows
+	}
+}
+
+func sameRow(row1, row2 *storageRow) bool {
+	if row1.checksum != row2.checksum {
+		return false
+	}
+	for name, data1 := range row1.data {
+		data2 := row2.data[name]
+		if data1.IsNull != data2.IsNull || !bytes.Equal(data1.Data, data2.Data) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *storageTable) rowKey(row *storageRow) (string, bool) {
+	var key strings.Builder
+	hasNull := false
+	for _, col := range t.orderKeyCols {
+		data := row.data[col.Name.O]
+		if data.IsNull {
+			hasNull = true
+			key.WriteString("-")
+			continue
+		}
+		key.WriteString(strconv.Itoa(len(data.Data)))
+		key.WriteString(":")
+		key.Write(data.Data)
+	}
+	return key.String(), hasNull
+}
+
+// newRow converts the columns of the event to a row of the table. The columns
+// which are not in the event are NULL.
+func (t *storageTable) newRow(cols []*cdcmodel.Column) (*storageRow, error) {
+	values := make(map[string]any, len(cols))
+	for _, col := range cols {
+		if col != nil {
+			values[strings.ToLower(col.Name)] = col.Value
+		}
+	}
+	data := make(map[string]*dbutil.ColumnData, len(t.tableDiff.Info.Columns))
+	// checksumData is the data with the compare rules applied, the same as
+	// the columns in the checksum query of TiDB.
+	checksumData := data
+	if t.rules != nil {
+		checksumData = make(map[string]*dbutil.ColumnData, len(t.tableDiff.Info.Columns))
+	}
+	size := int64(rowOverhead)
+	for _, col := range t.tableDiff.Info.Columns {
+		if col.Hidden {
+			continue
+		}
+		value := values[col.Name.L]
+		columnData, err := toColumnData(col, value, t.loc)
+		if err != nil {
+			return nil, errors.Annotatef(err, "convert column %s", col.Name.O)
+		}
+		if t.rules != nil {
+			if checksumData[col.Name.O], err = t.applyRules(col, value, columnData); err != nil {
+				return nil, errors.Annotatef(err, "convert column %s", col.Name.O)
+			}
+			// the DATETIME values are in the time zone of the target in the rows query.
+			if t.datetimeLoc != nil && col.GetType() == mysql.TypeDatetime && !columnData.IsNull {
+				columnData = &dbutil.ColumnData{Data: []byte(convertTime(string(columnData.Data), t.datetimeLoc, t.targetLoc))}
+			}
+		}
+		data[col.Name.O] = columnData
+		size += int64(columnOverhead + len(col.Name.O) + len(columnData.Data))
+	}
+	return &storageRow{
+		data:     data,
+		checksum: rowChecksum(t.tableDiff.Info.Columns, checksumData),
+		size:     size,
+	}, nil
+}
+
+// applyRules applies the compare rules to the column data the same way as the
+// checksum query of TiDB, see utils.GetCountAndMD5Checksum.
+func (t *storageTable) applyRules(col *model.ColumnInfo, value any, data *dbutil.ColumnData) (*dbutil.ColumnData, error) {
+	switch tp := col.GetType(); {
+	case (tp == mysql.TypeFloat || tp == mysql.TypeDouble) && t.rules.FloatEpsilon > 0:
+		// the float is rounded to the decimal places of the epsilon, so zero isn't NULL.
+		if value == nil {
+			return data, nil
+		}
+		v, err := strconv.ParseFloat(cdcmodel.ColumnValueString(value), 64)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		v = roundFloat(v, int(math.Ceil(-math.Log10(t.rules.FloatEpsilon))))
+		return &dbutil.ColumnData{Data: []byte(strconv.FormatFloat(v, 'f', -1, 64))}, nil
+	case data.IsNull:
+		return data, nil
+	case tp == mysql.TypeDatetime && t.datetimeLoc != nil:
+		return &dbutil.ColumnData{Data: []byte(convertTime(string(data.Data), t.datetimeLoc, time.UTC))}, nil
+	case utils.IsTextColumn(col):
+		str := string(data.Data)
+		if t.rules.IgnoreTrailingSpaces {
+			str = strings.TrimRight(str, " ")
+		}
+		if t.rules.IgnoreCase {
+			str = strings.ToLower(str)
+		}
+		return &dbutil.ColumnData{Data: []byte(str)}, nil
+	}
+	return data, nil
+}
+
+// toColumnData converts the value decoded from the storage sink to the value
+// TiDB returns in the rows query, see utils.GetTableRowsQueryFormat.
+func toColumnData(col *model.ColumnInfo, value any, loc *time.Location) (*dbutil.ColumnData, error) {
+	if value == nil {
+		return &dbutil.ColumnData{IsNull: true}, nil
+	}
+	switch col.GetType() {
+	case mysql.TypeEnum:
+		if idx, ok := toUint64(value); ok {
+			enum, err := types.ParseEnumValue(col.GetElems(), idx)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return &dbutil.ColumnData{Data: []byte(enum.Name)}, nil
+		}
+	case mysql.TypeSet:
+		if v, ok := toUint64(value); ok {
+			set, err := types.ParseSetValue(col.GetElems(), v)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return &dbutil.ColumnData{Data: []byte(set.Name)}, nil
+		}
+	case mysql.TypeBit:
+		if v, ok := toUint64(value); ok {
+			byteSize := (col.GetFlen() + 7) >> 3
+			return &dbutil.ColumnData{Data: types.NewBinaryLiteralFromUint(v, byteSize)}, nil
+		}
+	case mysql.TypeFloat, mysql.TypeDouble:
+		v, err := strconv.ParseFloat(cdcmodel.ColumnValueString(value), 64)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// The float is rounded in the query, and the rounded zero is NULL
+		// because of `log10(0)`.
+		if v == 0 {
+			return &dbutil.ColumnData{IsNull: true}, nil
+		}
+		digits := 14
+		if col.GetType() == mysql.TypeFloat {
+			digits = 5
+		}
+		v = roundFloat(v, digits-int(math.Floor(math.Log10(math.Abs(v)))))
+		return &dbutil.ColumnData{Data: []byte(strconv.FormatFloat(v, 'f', -1, 64))}, nil
+	case mysql.TypeTimestamp:
+		// The timestamp is in the time zone of TiCDC, while the connections
+		// of sync diff inspector use the unified time zone.
+		return &dbutil.ColumnData{Data: []byte(convertTime(cdcmodel.ColumnValueString(value), loc, time.UTC))}, nil
+	}
+	return &dbutil.ColumnData{Data: []byte(cdcmodel.ColumnValueString(value))}, nil
+}
+
+// convertTime converts the time string from the time zone to another one,
+// and keeps the fractional seconds as they are.
+func convertTime(str string, from, to *time.Location) string {
+	datetime, frac, _ := strings.Cut(str, ".")
+	ts, err := time.ParseInLocation(timeLayout, datetime, from)
+	if err != nil {
+		// e.g. the zero time.
+		return str
+	}
+	str = ts.In(to).Format(timeLayout)
+	if len(frac) > 0 {
+		str += "." + frac
+	}
+	return str
+}
+
+func toUint64(value any) (uint64, bool) {
+	switch v := value.(type) {
+	case uint64:
+		return v, true
+	case int64:
+		return uint64(v), true
+	}
+	return 0, false
+}
+
+func roundFloat(v float64, digits int) float64 {
+	pow := math.Pow10(digits)
+	if math.IsInf(pow, 0) || pow == 0 {
+		return v
+	}
+	return math.Round(v*pow) / pow
+}
+
+// rowChecksum computes the checksum of the row the same way as utils.GetCountAndMD5Checksum.
+func rowChecksum(columns []*model.ColumnInfo, data map[string]*dbutil.ColumnData) uint64 {
+	values := make([]string, 0, len(columns)+1)
+	isNull := make([]byte, 0, len(columns))
+	for _, col := range columns {
+		if col.Hidden {
+			continue
+		}
+		columnData := data[col.Name.O]
+		if columnData.IsNull {
+			isNull = append(isNull, '1')
+			continue
+		}
+		isNull = append(isNull, '0')
+		values = append(values, string(columnData.Data))
+	}
+	values = append(values, string(isNull))
+	sum := md5.Sum([]byte(strings.Join(values, ",")))
+	return binary.BigEndian.Uint64(sum[:8]) ^ binary.BigEndian.Uint64(sum[8:])
+}
+
+// sortRows sorts the rows by the order key as the rows query of TiDB does.
+func (t *storageTable) sortRows() {
+	rows := make([]*storageRow, 0, len(t.rowsByKey))
+	for _, rs := range t.rowsByKey {
+		rows = append(rows, rs...)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, col := range t.orderKeyCols {
+			cmp := t.compare(col, rows[i].data[col.Name.O], rows[j].data[col.Name.O])
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	t.rows = rows
+	t.rowsByKey = nil
+}
+
+// compare compares the column values, NULL is the smallest.
+func (t *storageTable) compare(col *model.ColumnInfo, data1, data2 *dbutil.ColumnData) int {
+	if data1.IsNull || data2.IsNull {
+		switch {
+		case data1.IsNull && data2.IsNull:
+			return 0
+		case data1.IsNull:
+			return -1
+		default:
+			return 1
+		}
+	}
+	return t.compareValue(col, data1.Data, data2.Data)
+}
+
+func (t *storageTable) compareValue(col *model.ColumnInfo, value1, value2 []byte) int {
+	if !utils.NeedQuotes(col.GetType()) {
+		num1, ok1 := new(big.Rat).SetString(string(value1))
+		num2, ok2 := new(big.Rat).SetString(string(value2))
+		if ok1 && ok2 {
+			return num1.Cmp(num2)
+		}
+	}
+	if col.GetCharset() == charset.CharsetBin {
+		return bytes.Compare(value1, value2)
+	}
+	collation := t.tableDiff.Collation
+	if len(collation) == 0 {
+		collation = col.GetCollate()
+	}
+	return collate.GetCollator(collation).Compare(string(value1), string(value2))
+}
+
+// rowsInRange returns the rows in the chunk, see chunk.Range.ToString.
+func (t *storageTable) rowsInRange(r *chunk.Range) []*storageRow {
+	begin, end := t.searchRange(r)
+	rows := make([]*storageRow, 0)
+	for _, row := range t.rows[begin:end] {
+		if t.inRange(r, row) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// searchRange returns the range of the sorted rows which may be in the chunk by binary search.
+// The bound columns which are a prefix of orderKeyCols are used, the rows in the chunk are not
+// less than the lower bounds and not greater than the upper bounds of the prefix. The rows in
+// the returned range still need to be checked by inRange.
+func (t *storageTable) searchRange(r *chunk.Range) (int, int) {
+	prefix := 0
+	for prefix < len(r.Bounds) && prefix < len(t.orderKeyCols) &&
+		strings.EqualFold(r.Bounds[prefix].Column, t.orderKeyCols[prefix].Name.O) {
+		prefix++
+	}
+	lowerLen, upperLen := 0, 0
+	for lowerLen < prefix && r.Bounds[lowerLen].HasLower {
+		lowerLen++
+	}
+	for upperLen < prefix && r.Bounds[upperLen].HasUpper {
+		upperLen++
+	}
+	begin := sort.Search(len(t.rows), func(i int) bool {
+		return t.compareWithBounds(t.rows[i], r.Bounds[:lowerLen], true) >= 0
+	})
+	end := sort.Search(len(t.rows), func(i int) bool {
+		return t.compareWithBounds(t.rows[i], r.Bounds[:upperLen], false) > 0
+	})
+	if end < begin {
+		end = begin
+	}
+	return begin, end
+}
+
+// compareWithBounds compares the order key of the row with the lower or upper bounds
+// in lexicographic order as sortRows does, NULL is the smallest.
+func (t *storageTable) compareWithBounds(row *storageRow, bounds []*chunk.Bound, lower bool) int {
+	for i, bound := range bounds {
+		value := bound.Lower
+		if !lower {
+			value = bound.Upper
+		}
+		col := t.orderKeyCols[i]
+		data := row.data[col.Name.O]
+		if data.IsNull {
+			return -1
+		}
+		if cmp := t.compareValue(col, data.Data, []byte(value)); cmp != 0 {
+			return cmp
+		}
+	}
+	return 0
+}
+
+func (t *storageTable) inRange(r *chunk.Range, row *storageRow) bool {
+	i := 0
+	for ; i < len(r.Bounds); i++ {
+		bound := r.Bounds[i]
+		if !(bound.HasLower && bound.HasUpper) || bound.Lower != bound.Upper {
+			break
+		}
+		if cmp, isNull := t.compareBound(row, bound.Column, bound.Lower); isNull || cmp != 0 {
+			return false
+		}
+	}
+	if i == len(r.Bounds) && i > 0 {
+		// All the columns are equal in bounds, the range is empty.
+		return false
+	}
+	return t.matchBounds(row, r.Bounds[i:], true) && t.matchBounds(row, r.Bounds[i:], false)
+}
+
+// matchBounds returns whether the row is greater than the lower bounds or not
+// greater than the upper bounds in lexicographic order.
+func (t *storageTable) matchBounds(row *storageRow, bounds []*chunk.Bound, lower bool) bool {
+	hasCondition := false
+	for i, bound := range bounds {
+		has, value := bound.HasLower, bound.Lower
+		if !lower {
+			has, value = bound.HasUpper, bound.Upper
+		}
+		if !has {
+			continue
+		}
+		hasCondition = true
+		cmp, isNull := t.compareBound(row, bound.Column, value)
+		if isNull {
+			// The comparison with NULL is never true.
+			return false
+		}
+		if lower && cmp > 0 || !lower && (cmp < 0 || cmp == 0 && i == len(bounds)-1) {
+			return true
+		}
+		if cmp != 0 {
+			return false
+		}
+	}
+	return !hasCondition
+}
+
+func (t *storageTable) compareBound(row *storageRow, column, value string) (int, bool) {
+	col := dbutil.FindColumnByName(t.tableDiff.Info.Columns, column)
+	if col == nil {
+		return 0, true
+	}
+	data, ok := row.data[col.Name.O]
+	if !ok || data.IsNull {
+		return 0, true
+	}
+	return t.compareValue(col, data.Data, []byte(value)), false
+}
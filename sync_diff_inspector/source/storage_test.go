@@ -0,0 +1,347 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	cdcmodel "github.com/pingcap/tiflow/cdc/model"
+	putil "github.com/pingcap/tiflow/pkg/util"
+	"github.com/pingcap/tiflow/sync_diff_inspector/chunk"
+	"github.com/pingcap/tiflow/sync_diff_inspector/source/common"
+	"github.com/pingcap/tiflow/sync_diff_inspector/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStorageTable(t *testing.T, createTableSQL string) *storageTable {
+	return newTestStorageTableWithRules(t, createTableSQL, nil, "")
+}
+
+func newTestStorageTableWithRules(t *testing.T, createTableSQL string, rules *utils.CompareRules, timeZone string) *storageTable {
+	tableInfo, err := utils.GetTableInfoBySQL(createTableSQL, parser.New())
+	require.NoError(t, err)
+	table, err := newStorageTable(&common.TableDiff{
+		Schema:       "test",
+		Table:        "t",
+		Info:         tableInfo,
+		CompareRules: rules,
+	}, time.UTC, timeZone, &storageMemory{})
+	require.NoError(t, err)
+	return table
+}
+
+func TestStorageRowChecksum(t *testing.T) {
+	table := newTestStorageTable(t, "create table t(id int primary key, name varchar(20))")
+
+	// the same as `CONCAT_WS(',', id, name, CONCAT(ISNULL(id), ISNULL(name)))` in TiDB.
+	row, err := table.newRow([]*cdcmodel.Column{{Name: "id", Value: int64(1)}, {Name: "name", Value: []byte("a")}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10465941167129498346), row.checksum)
+
+	row, err = table.newRow([]*cdcmodel.Column{{Name: "id", Value: int64(2)}})
+	require.NoError(t, err)
+	require.True(t, row.data["name"].IsNull)
+	require.Equal(t, uint64(4768598852511480552), row.checksum)
+}
+
+func TestStorageTableApply(t *testing.T) {
+	table := newTestStorageTable(t, "create table t(id int primary key, name varchar(20))")
+	newRow := func(id int64, name string) *storageRow {
+		row, err := table.newRow([]*cdcmodel.Column{{Name: "id", Value: id}, {Name: "name", Value: name}})
+		require.NoError(t, err)
+		return row
+	}
+
+	for i := int64(10); i > 0; i-- {
+		require.NoError(t, table.insert(newRow(i, "a")))
+	}
+	// the replicated event may be duplicated.
+	require.NoError(t, table.insert(newRow(3, "b")))
+	table.remove(newRow(5, "a"))
+	table.sortRows()
+	require.Len(t, table.rows, 9)
+	var size int64
+	for _, row := range table.rows {
+		size += row.size
+	}
+	require.Equal(t, size, table.memory.used)
+	for i, id := range []string{"1", "2", "3", "4", "6", "7", "8", "9", "10"} {
+		require.Equal(t, id, string(table.rows[i].data["id"].Data))
+	}
+	require.Equal(t, "b", string(table.rows[2].data["name"].Data))
+
+	// the rows of the table without unique key are identified by all the columns.
+	table = newTestStorageTable(t, "create table t(id int, name varchar(20))")
+	require.False(t, table.unique)
+	require.NoError(t, table.insert(newRow(1, "a")))
+	require.NoError(t, table.insert(newRow(1, "a")))
+	require.NoError(t, table.insert(newRow(1, "b")))
+	table.remove(newRow(1, "a"))
+	table.sortRows()
+	require.Len(t, table.rows, 2)
+	require.Equal(t, table.rows[0].size+table.rows[1].size, table.memory.used)
+
+	// the memory is limited.
+	table = newTestStorageTable(t, "create table t(id int primary key, name varchar(20))")
+	row := newRow(1, "a")
+	table.memory.limit = row.size * 2
+	require.NoError(t, table.insert(row))
+	require.NoError(t, table.insert(newRow(1, "b")))
+	require.NoError(t, table.insert(newRow(2, "a")))
+	require.ErrorContains(t, table.insert(newRow(3, "a")), "storage-memory-limit")
+}
+
+func TestStorageRowsInRange(t *testing.T) {
+	table := newTestStorageTable(t, "create table t(a int, b varchar(20), primary key(a, b))")
+	for a := 1; a <= 3; a++ {
+		for _, b := range []string{"x", "y", "z"} {
+			row, err := table.newRow([]*cdcmodel.Column{{Name: "a", Value: int64(a)}, {Name: "b", Value: b}})
+			require.NoError(t, err)
+			require.NoError(t, table.insert(row))
+		}
+	}
+	table.sortRows()
+
+	rowsString := func(r *chunk.Range) []string {
+		res := make([]string, 0)
+		for _, row := range table.rowsInRange(r) {
+			res = append(res, fmt.Sprintf("%s%s", row.data["a"].Data, row.data["b"].Data))
+		}
+		return res
+	}
+
+	// ((a > 1) OR (a = 1 AND b > 'y')) AND ((a < 3) OR (a = 3 AND b <= 'x'))
+	r := &chunk.Range{Bounds: []*chunk.Bound{
+		{Column: "a", Lower: "1", Upper: "3", HasLower: true, HasUpper: true},
+		{Column: "b", Lower: "y", Upper: "x", HasLower: true, HasUpper: true},
+	}}
+	require.Equal(t, []string{"1z", "2x", "2y", "2z", "3x"}, rowsString(r))
+
+	// (a = 2) AND ((b > 'x'))
+	r = &chunk.Range{Bounds: []*chunk.Bound{
+		{Column: "a", Lower: "2", Upper: "2", HasLower: true, HasUpper: true},
+		{Column: "b", Lower: "x", HasLower: true},
+	}}
+	require.Equal(t, []string{"2y", "2z"}, rowsString(r))
+
+	// the number is not compared as string.
+	r = &chunk.Range{Bounds: []*chunk.Bound{{Column: "a", Upper: "10", HasUpper: true}}}
+	require.Len(t, rowsString(r), 9)
+
+	// all the columns are equal in bounds.
+	r = &chunk.Range{Bounds: []*chunk.Bound{
+		{Column: "a", Lower: "2", Upper: "2", HasLower: true, HasUpper: true},
+		{Column: "b", Lower: "y", Upper: "y", HasLower: true, HasUpper: true},
+	}}
+	require.Empty(t, rowsString(r))
+
+	require.Len(t, rowsString(&chunk.Range{}), 9)
+
+	// the rows with NULL are never in the range, even if they are between the bounds.
+	table = newTestStorageTable(t, "create table t(a int, b int)")
+	for _, cols := range [][]any{{int64(1), int64(1)}, {int64(2), nil}, {int64(2), int64(1)}, {int64(2), int64(5)}, {nil, int64(1)}} {
+		row, err := table.newRow([]*cdcmodel.Column{{Name: "a", Value: cols[0]}, {Name: "b", Value: cols[1]}})
+		require.NoError(t, err)
+		require.NoError(t, table.insert(row))
+	}
+	table.sortRows()
+	// ((a > 1) OR (a = 1 AND b > 1)) AND ((a < 2) OR (a = 2 AND b <= 3))
+	r = &chunk.Range{Bounds: []*chunk.Bound{
+		{Column: "a", Lower: "1", Upper: "2", HasLower: true, HasUpper: true},
+		{Column: "b", Lower: "1", Upper: "3", HasLower: true, HasUpper: true},
+	}}
+	begin, end := table.searchRange(r)
+	require.Equal(t, 1, begin)
+	require.Equal(t, 4, end)
+	rows := table.rowsInRange(r)
+	require.Len(t, rows, 1)
+	require.Equal(t, "2", string(rows[0].data["a"].Data))
+	require.Equal(t, "1", string(rows[0].data["b"].Data))
+}
+
+func TestStorageToColumnData(t *testing.T) {
+	tableInfo, err := utils.GetTableInfoBySQL(`create table t(
+		e enum('a', 'b', 'c'), s set('a', 'b', 'c'), b bit(10), f float, d double, ts timestamp, i int unsigned)`, parser.New())
+	require.NoError(t, err)
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	convert := func(name string, value any) *dbutil.ColumnData {
+		data, err := toColumnData(dbutil.FindColumnByName(tableInfo.Columns, name), value, loc)
+		require.NoError(t, err)
+		return data
+	}
+
+	// canal-json
+	require.Equal(t, "b", string(convert("e", int64(2)).Data))
+	require.Equal(t, "a,c", string(convert("s", uint64(5)).Data))
+	require.Equal(t, []byte{0x01, 0x02}, convert("b", uint64(258)).Data)
+	// csv
+	require.Equal(t, "b", string(convert("e", "b").Data))
+	require.Equal(t, "a,c", string(convert("s", "a,c").Data))
+	require.Equal(t, "4294967295", string(convert("i", uint64(math.MaxUint32)).Data))
+
+	// the float is rounded, and zero is NULL.
+	require.Equal(t, "1.1", string(convert("f", float64(float32(1.1))).Data))
+	require.Equal(t, "123.456", string(convert("d", 123.456).Data))
+	require.True(t, convert("f", float64(0)).IsNull)
+	require.True(t, convert("d", nil).IsNull)
+
+	// the timestamp is converted to the unified time zone.
+	require.Equal(t, "2023-01-01 16:00:00.123", string(convert("ts", "2023-01-02 00:00:00.123").Data))
+	require.Equal(t, "0000-00-00 00:00:00", string(convert("ts", "0000-00-00 00:00:00").Data))
+}
+
+func TestGetStorageCommitTs(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage, err := putil.GetExternalStorageFromURI(ctx, "file://"+dir)
+	require.NoError(t, err)
+
+	commitTs, err := getStorageCommitTs(ctx, storage, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), commitTs)
+
+	_, err = getStorageCommitTs(ctx, storage, "2016-10-08 16:45:26")
+	require.ErrorContains(t, err, "should be a commit-ts")
+	_, err = getStorageCommitTs(ctx, storage, "100")
+	require.ErrorContains(t, err, "metadata file is not found")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, storageMetadataFile), []byte(`{"checkpoint-ts":100}`), 0o644))
+	commitTs, err = getStorageCommitTs(ctx, storage, "100")
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), commitTs)
+	_, err = getStorageCommitTs(ctx, storage, "101")
+	require.ErrorContains(t, err, "greater than the checkpoint-ts")
+}
+
+func TestStorageChecksumParity(t *testing.T) {
+	createTableSQL := `create table t(id int primary key, f float, d double, ts timestamp(3) null,
+		e enum('a', 'b', 'c'), s set('a', 'b', 'c'), b bit(10))`
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	// the connections of sync diff inspector use the unified time zone.
+	tk.MustExec("set @@session.time_zone = '+00:00'")
+	tk.MustExec(createTableSQL)
+	tk.MustExec(`insert into t values
+		(1, 1.1, 123.456, '2023-01-01 16:00:00.123', 'b', 'a,c', 258),
+		(2, 0, -0.5, null, 'a', '', 0),
+		(3, null, 1e20, '2023-06-01 00:00:00', null, 'b', null)`)
+
+	// the checksum query of TiDB is generated by utils.GetCountAndMD5Checksum.
+	var query string
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherFunc(func(_, actual string) error {
+		query = actual
+		return nil
+	})))
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows([]string{"CNT", "CHECKSUM"}).AddRow(0, 0))
+	table := newTestStorageTable(t, createTableSQL)
+	_, _, err = utils.GetCountAndMD5Checksum(context.Background(), db, "test", "t", table.tableDiff.Info, nil, "TRUE", "", nil)
+	require.NoError(t, err)
+	result := tk.MustQuery(query).Rows()
+	require.Len(t, result, 1)
+
+	// the values are decoded from canal-json, the timestamp is in the time zone of TiCDC.
+	for _, cols := range [][]any{
+		{int64(1), float64(float32(1.1)), 123.456, "2023-01-01 16:00:00.123", uint64(2), uint64(5), uint64(258)},
+		{int64(2), float64(0), -0.5, nil, uint64(1), uint64(0), uint64(0)},
+		{int64(3), nil, 1e20, "2023-06-01 00:00:00.000", nil, uint64(2), nil},
+	} {
+		columns := make([]*cdcmodel.Column, 0, len(cols))
+		for i, name := range []string{"id", "f", "d", "ts", "e", "s", "b"} {
+			columns = append(columns, &cdcmodel.Column{Name: name, Value: cols[i]})
+		}
+		row, err := table.newRow(columns)
+		require.NoError(t, err)
+		require.NoError(t, table.insert(row))
+	}
+	table.sortRows()
+	var checksum uint64
+	rows := table.rowsInRange(&chunk.Range{})
+	for _, row := range rows {
+		checksum ^= row.checksum
+	}
+	require.Equal(t, fmt.Sprintf("%d", len(rows)), result[0][0])
+	require.Equal(t, fmt.Sprintf("%d", checksum), result[0][1])
+}
+
+func TestStorageChecksumParityWithRules(t *testing.T) {
+	createTableSQL := `create table t(id int primary key, d double, dt datetime(3) null, v varchar(10))`
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@session.time_zone = '+00:00'")
+	tk.MustExec(createTableSQL)
+	tk.MustExec(`insert into t values
+		(1, 123.456, '2023-01-01 16:00:00.123', 'Abc  '),
+		(2, 0, null, null),
+		(3, -0.5, '2023-01-01 06:00:00', 'x')`)
+
+	// the DATETIME values of the storage are written in +08:00.
+	rules := (&utils.CompareRules{FloatEpsilon: 0.01, TimestampToUTC: true, IgnoreCase: true, IgnoreTrailingSpaces: true}).
+		WithTargetTimeZone("-05:00")
+	var query string
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherFunc(func(_, actual string) error {
+		query = actual
+		return nil
+	})))
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectQuery("").WillReturnRows(sqlmock.NewRows([]string{"CNT", "CHECKSUM"}).AddRow(0, 0))
+	table := newTestStorageTableWithRules(t, createTableSQL, rules, "+08:00")
+	_, _, err = utils.GetCountAndMD5Checksum(context.Background(), db, "test", "t", table.tableDiff.Info, rules.WithSourceTimeZone("+08:00"), "TRUE", "", nil)
+	require.NoError(t, err)
+	result := tk.MustQuery(query).Rows()
+	require.Len(t, result, 1)
+
+	for _, cols := range [][]any{
+		{int64(1), 123.456, "2023-01-01 16:00:00.123", []byte("Abc  ")},
+		{int64(2), float64(0), nil, nil},
+		{int64(3), -0.5, "2023-01-01 06:00:00.000", []byte("x")},
+	} {
+		columns := make([]*cdcmodel.Column, 0, len(cols))
+		for i, name := range []string{"id", "d", "dt", "v"} {
+			columns = append(columns, &cdcmodel.Column{Name: name, Value: cols[i]})
+		}
+		row, err := table.newRow(columns)
+		require.NoError(t, err)
+		require.NoError(t, table.insert(row))
+	}
+	table.sortRows()
+	var checksum uint64
+	rows := table.rowsInRange(&chunk.Range{})
+	for _, row := range rows {
+		checksum ^= row.checksum
+	}
+	require.Equal(t, fmt.Sprintf("%d", len(rows)), result[0][0])
+	require.Equal(t, fmt.Sprintf("%d", checksum), result[0][1])
+
+	// the rows keep the original values except the DATETIME values,
+	// which are converted to the time zone of the target.
+	require.Equal(t, "2023-01-01 03:00:00.123", string(rows[0].data["dt"].Data))
+	require.Equal(t, "Abc  ", string(rows[0].data["v"].Data))
+	require.True(t, rows[1].data["d"].IsNull)
+}
@@ -102,6 +102,8 @@ type TiDBSource struct {
 	// bucketSpliterPool is the shared pool to produce chunks using bucket
 	bucketSpliterPool *utils.WorkerPool
 	dbConn            *sql.DB
+	// ds is the config of the data source, it's used to reconnect with another snapshot.
+	ds *config.DataSource
 
 	version *semver.Version
 }
@@ -262,7 +264,7 @@ func (s *TiDBSource) GetCountAndMD5(ctx context.Context, tableRange *splitter.Ra
 	}
 
 	count, checksum, err := utils.GetCountAndMD5Checksum(
-		ctx, s.dbConn, matchSource.OriginSchema, matchSource.OriginTable, table.Info,
+		ctx, s.dbConn, matchSource.OriginSchema, matchSource.OriginTable, table.Info, table.CompareRules.WithSourceTimeZone(s.ds.TimeZone),
 		chunk.Where, indexHint, chunk.Args)
 
 	cost := time.Since(beginTime)
@@ -325,7 +327,7 @@ func (s *TiDBSource) GetRowsIterator(ctx context.Context, tableRange *splitter.R
 
 	table := s.tableDiffs[tableRange.GetTableIndex()]
 	matchedSource := getMatchSource(s.sourceTableMap, table)
-	rowsQuery, _ := utils.GetTableRowsQueryFormat(matchedSource.OriginSchema, matchedSource.OriginTable, table.Info, table.Collation)
+	rowsQuery, _ := utils.GetTableRowsQueryFormat(matchedSource.OriginSchema, matchedSource.OriginTable, table.Info, table.Collation, table.CompareRules.WithSourceTimeZone(s.ds.TimeZone))
 	query := fmt.Sprintf(rowsQuery, chunk.Where)
 
 	log.Debug("select data", zap.String("sql", query), zap.Reflect("args", chunk.Args))
@@ -353,6 +355,21 @@ func (s *TiDBSource) GetSnapshot() string {
 	return s.snapshot
 }
 
+// SwitchSnapshot reconnects the source with the given tidb_snapshot, the
+// connections of the previous snapshot are closed.
+func (s *TiDBSource) SwitchSnapshot(snapshot string) error {
+	s.ds.SetSnapshot(snapshot)
+	db, err := common.ConnectMySQL(&s.ds.SessionConfig, s.ds.ToDriverConfig(), s.dbConn.Stats().MaxOpenConnections)
+	if err != nil {
+		return errors.Annotatef(err, "connect with snapshot %s", snapshot)
+	}
+	s.dbConn.Close()
+	s.dbConn = db
+	s.ds.Conn = db
+	s.snapshot = snapshot
+	return nil
+}
+
 // NewTiDBSource return a new TiDB source
 func NewTiDBSource(
 	ctx context.Context,
@@ -427,6 +444,7 @@ func NewTiDBSource(
 		sourceTableMap:    sourceTableMap,
 		snapshot:          ds.Snapshot,
 		dbConn:            ds.Conn,
+		ds:                ds,
 		bucketSpliterPool: bucketSpliterPool,
 		version:           utils.TryToGetVersion(ctx, ds.Conn),
 		sqlHint:           ds.SQLHintUseIndex,
@@ -0,0 +1,258 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+)
+
+// defaultFloatEpsilon is the max difference of two equal float values in the row comparison.
+const defaultFloatEpsilon = 1e-6
+
+const timeLayout = "2006-01-02 15:04:05.999999999"
+
+// utcOffset is the time zone offset of UTC, which is also the unified time zone
+// of the connections, so the TIMESTAMP values are always compared in UTC.
+const utcOffset = "+00:00"
+
+var timeZoneOffsetRegexp = regexp.MustCompile(`^([+-])(\d{2}):(\d{2})$`)
+
+// CompareRules are the rules to tolerate the differences caused by the type handling of
+// different databases when comparing the data of a table. The rules are applied to both
+// the checksum and the row comparison, except that the JSON canonicalization can only be
+// done in the row comparison, so the chunk is always compared row by row if the JSON differs.
+type CompareRules struct {
+	// FloatEpsilon is the max difference of two equal float or double values,
+	// the values are rounded to the decimal places of it in the checksum.
+	FloatEpsilon float64 `toml:"float-epsilon" json:"float-epsilon,omitempty"`
+	// TimestampToUTC converts the DATETIME values from the `time-zone` of each data
+	// source to UTC, so the sources written in different time zones can be compared.
+	// The TIMESTAMP values are always compared in UTC.
+	TimestampToUTC bool `toml:"timestamp-to-utc" json:"timestamp-to-utc,omitempty"`
+	// CanonicalJSON compares the text values which are both valid JSON as JSON,
+	// so the key order and the spaces are ignored.
+	CanonicalJSON bool `toml:"canonical-json" json:"canonical-json,omitempty"`
+	// IgnoreCase compares the text values case-insensitively.
+	IgnoreCase bool `toml:"ignore-case" json:"ignore-case,omitempty"`
+	// IgnoreTrailingSpaces ignores the trailing spaces of the text values, which is
+	// the behavior of the PAD SPACE collations.
+	IgnoreTrailingSpaces bool `toml:"ignore-trailing-spaces" json:"ignore-trailing-spaces,omitempty"`
+
+	// SourceTimeZone is the time zone offset of the DATETIME values in the queried source.
+	SourceTimeZone string `toml:"-" json:"-"`
+	// TargetTimeZone is the time zone offset of the DATETIME values in the target, the
+	// DATETIME values in the rows query are converted to it to generate the fix SQL.
+	TargetTimeZone string `toml:"-" json:"-"`
+}
+
+// WithSourceTimeZone returns a copy of the rules for the source with the time zone offset.
+func (r *CompareRules) WithSourceTimeZone(timeZone string) *CompareRules {
+	if r == nil {
+		return nil
+	}
+	rules := *r
+	rules.SourceTimeZone = timeZone
+	return &rules
+}
+
+// WithTargetTimeZone returns a copy of the rules for the target with the time zone offset.
+func (r *CompareRules) WithTargetTimeZone(timeZone string) *CompareRules {
+	if r == nil {
+		return nil
+	}
+	rules := *r
+	rules.TargetTimeZone = timeZone
+	return &rules
+}
+
+// convertsDatetime returns the time zone offsets to convert the DATETIME values
+// from, and whether the values need to be converted.
+func (r *CompareRules) convertsDatetime(col *model.ColumnInfo) (from string, ok bool) {
+	if r == nil || !r.TimestampToUTC || col.GetType() != mysql.TypeDatetime {
+		return "", false
+	}
+	return timeZoneOffset(r.SourceTimeZone), true
+}
+
+// ParseTimeZoneOffset parses the time zone offset like "+08:00", the empty offset is UTC.
+func ParseTimeZoneOffset(offset string) (*time.Location, error) {
+	if len(offset) == 0 {
+		return time.UTC, nil
+	}
+	matches := timeZoneOffsetRegexp.FindStringSubmatch(offset)
+	if matches == nil {
+		return nil, errors.Errorf("invalid time zone offset %s, it should be like +08:00", offset)
+	}
+	hours, _ := strconv.Atoi(matches[2])
+	minutes, _ := strconv.Atoi(matches[3])
+	total := hours*60 + minutes
+	if matches[1] == "-" {
+		total = -total
+	}
+	// the same range as MySQL.
+	if minutes >= 60 || total > 14*60 || total < -(13*60+59) {
+		return nil, errors.Errorf("invalid time zone offset %s, it should be between -13:59 and +14:00", offset)
+	}
+	return time.FixedZone(offset, total*60), nil
+}
+
+func timeZoneOffset(offset string) string {
+	if len(offset) == 0 {
+		return utcOffset
+	}
+	return offset
+}
+
+// Validate checks whether the rules are valid.
+func (r *CompareRules) Validate() error {
+	if r.FloatEpsilon < 0 || math.IsNaN(r.FloatEpsilon) || math.IsInf(r.FloatEpsilon, 0) {
+		return errors.Errorf("float-epsilon must be a non-negative number, but got %v", r.FloatEpsilon)
+	}
+	return nil
+}
+
+func (r *CompareRules) floatEpsilon() float64 {
+	if r == nil || r.FloatEpsilon == 0 {
+		return defaultFloatEpsilon
+	}
+	return r.FloatEpsilon
+}
+
+// IsTextColumn returns true if the column saves the non-binary string.
+func IsTextColumn(col *model.ColumnInfo) bool {
+	switch col.GetType() {
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeBlob, mysql.TypeLongBlob:
+		return !mysql.HasBinaryFlag(col.GetFlag()) && col.GetCharset() != charset.CharsetBin
+	}
+	return false
+}
+
+// rowsColumnExpr returns the select expression of the column in the rows query.
+// The values are used to generate the fix SQL, so the DATETIME values are converted
+// to the time zone of the target and the other rules are only applied in the comparison.
+func rowsColumnExpr(col *model.ColumnInfo, rules *CompareRules) string {
+	name := dbutil.ColumnName(col.Name.O)
+	switch col.GetType() {
+	case mysql.TypeFloat:
+		return fmt.Sprintf("round(%s, 5-floor(log10(abs(%s)))) as %s", name, name, name)
+	case mysql.TypeDouble:
+		return fmt.Sprintf("round(%s, 14-floor(log10(abs(%s)))) as %s", name, name, name)
+	}
+	if from, ok := rules.convertsDatetime(col); ok {
+		if to := timeZoneOffset(rules.TargetTimeZone); from != to {
+			return fmt.Sprintf("CONVERT_TZ(%s, '%s', '%s') as %s", name, from, to, name)
+		}
+	}
+	return name
+}
+
+// checksumColumnExpr returns the expression of the column used to calculate the checksum.
+func checksumColumnExpr(col *model.ColumnInfo, rules *CompareRules) string {
+	name := dbutil.ColumnName(col.Name.O)
+	tp := col.GetType()
+	if tp == mysql.TypeFloat || tp == mysql.TypeDouble {
+		if rules != nil && rules.FloatEpsilon > 0 {
+			return fmt.Sprintf("round(%s, %d)", name, int(math.Ceil(-math.Log10(rules.FloatEpsilon))))
+		}
+		// When col value is 0, the result is NULL.
+		// But we can use ISNULL to distinguish between null and 0.
+		if tp == mysql.TypeFloat {
+			return fmt.Sprintf("round(%s, 5-floor(log10(abs(%s))))", name, name)
+		}
+		return fmt.Sprintf("round(%s, 14-floor(log10(abs(%s))))", name, name)
+	}
+	if rules == nil {
+		return name
+	}
+	if from, ok := rules.convertsDatetime(col); ok {
+		if from != utcOffset {
+			return fmt.Sprintf("CONVERT_TZ(%s, '%s', '%s')", name, from, utcOffset)
+		}
+		return name
+	}
+	if IsTextColumn(col) {
+		if rules.IgnoreTrailingSpaces {
+			name = fmt.Sprintf("RTRIM(%s)", name)
+		}
+		if rules.IgnoreCase {
+			name = fmt.Sprintf("LOWER(%s)", name)
+		}
+	}
+	return name
+}
+
+// columnDataEqual returns whether the data of the column are equal under the rules.
+func columnDataEqual(col *model.ColumnInfo, data1, data2 *dbutil.ColumnData, rules *CompareRules) (bool, error) {
+	if data1.IsNull || data2.IsNull {
+		return data1.IsNull == data2.IsNull, nil
+	}
+	str1, str2 := string(data1.Data), string(data2.Data)
+	switch tp := col.GetType(); {
+	case tp == mysql.TypeFloat || tp == mysql.TypeDouble:
+		num1, err1 := strconv.ParseFloat(str1, 64)
+		num2, err2 := strconv.ParseFloat(str2, 64)
+		if err1 != nil || err2 != nil {
+			return false, errors.Errorf("convert %s, %s to float failed, err1: %v, err2: %v", str1, str2, err1, err2)
+		}
+		return math.Abs(num1-num2) <= rules.floatEpsilon(), nil
+	case tp == mysql.TypeJSON:
+		if str1 == str2 {
+			return true, nil
+		}
+		return jsonEqual(data1.Data, data2.Data)
+	case str1 == str2 || rules == nil:
+		return str1 == str2, nil
+	case (tp == mysql.TypeTimestamp || tp == mysql.TypeDatetime) && rules.TimestampToUTC:
+		// the fractional seconds may be formatted differently.
+		t1, err1 := time.Parse(timeLayout, str1)
+		t2, err2 := time.Parse(timeLayout, str2)
+		return err1 == nil && err2 == nil && t1.Equal(t2), nil
+	case IsTextColumn(col):
+		if rules.IgnoreTrailingSpaces {
+			str1, str2 = strings.TrimRight(str1, " "), strings.TrimRight(str2, " ")
+		}
+		if str1 == str2 || (rules.IgnoreCase && strings.EqualFold(str1, str2)) {
+			return true, nil
+		}
+		if rules.CanonicalJSON && json.Valid(data1.Data) && json.Valid(data2.Data) {
+			return jsonEqual(data1.Data, data2.Data)
+		}
+	}
+	return false, nil
+}
+
+func jsonEqual(data1, data2 []byte) (bool, error) {
+	var v1, v2 any
+	if err := json.Unmarshal(data1, &v1); err != nil {
+		return false, errors.Errorf("unmarshal json %s failed, error %v", data1, err)
+	}
+	if err := json.Unmarshal(data2, &v2); err != nil {
+		return false, errors.Errorf("unmarshal json %s failed, error %v", data2, err)
+	}
+	return reflect.DeepEqual(v1, v2), nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2022 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareRulesValidate(t *testing.T) {
+	require.NoError(t, (&CompareRules{}).Validate())
+	require.NoError(t, (&CompareRules{FloatEpsilon: 0.01}).Validate())
+	require.Error(t, (&CompareRules{FloatEpsilon: -0.01}).Validate())
+	require.Error(t, (&CompareRules{FloatEpsilon: math.NaN()}).Validate())
+}
+
+func TestCompareRulesQuery(t *testing.T) {
+	tableInfo, err := GetTableInfoBySQL("create table `test`.`t`(`a` int primary key, `f` double, `ts` timestamp, `dt` datetime, `s` varchar(10), `bin` varbinary(10))", parser.New())
+	require.NoError(t, err)
+	rules := &CompareRules{FloatEpsilon: 0.005, TimestampToUTC: true, IgnoreCase: true, IgnoreTrailingSpaces: true}
+	rules = rules.WithTargetTimeZone("-05:00").WithSourceTimeZone("+08:00")
+
+	// only the DATETIME values are converted to the time zone of the target in the rows query.
+	query, _ := GetTableRowsQueryFormat("test", "t", tableInfo, "", rules)
+	require.Equal(t, "SELECT /*!40001 SQL_NO_CACHE */ `a`, round(`f`, 14-floor(log10(abs(`f`)))) as `f`, `ts`, "+
+		"CONVERT_TZ(`dt`, '+08:00', '-05:00') as `dt`, `s`, `bin` FROM `test`.`t` WHERE %s ORDER BY `a`", query)
+	// the target doesn't convert the values.
+	query, _ = GetTableRowsQueryFormat("test", "t", tableInfo, "", rules.WithSourceTimeZone("-05:00"))
+	require.Contains(t, query, "`ts`, `dt`, `s`")
+
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer conn.Close()
+	columns := "`a`, round(`f`, 3), `ts`, CONVERT_TZ(`dt`, '+08:00', '+00:00'), LOWER(RTRIM(`s`)), `bin`"
+	mock.ExpectQuery(regexp.QuoteMeta("MD5(CONCAT_WS(',', " + columns + ", CONCAT(")).
+		WillReturnRows(sqlmock.NewRows([]string{"CNT", "CHECKSUM"}).AddRow(1, 2))
+	_, _, err = GetCountAndMD5Checksum(context.Background(), conn, "test", "t", tableInfo, rules, "TRUE", "", nil)
+	require.NoError(t, err)
+	// the DATETIME values in UTC aren't converted.
+	columns = "`a`, round(`f`, 3), `ts`, `dt`, LOWER(RTRIM(`s`)), `bin`"
+	mock.ExpectQuery(regexp.QuoteMeta("MD5(CONCAT_WS(',', " + columns + ", CONCAT(")).
+		WillReturnRows(sqlmock.NewRows([]string{"CNT", "CHECKSUM"}).AddRow(1, 2))
+	_, _, err = GetCountAndMD5Checksum(context.Background(), conn, "test", "t", tableInfo, rules.WithSourceTimeZone(""), "TRUE", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestParseTimeZoneOffset(t *testing.T) {
+	loc, err := ParseTimeZoneOffset("")
+	require.NoError(t, err)
+	require.Equal(t, time.UTC, loc)
+	for offset, seconds := range map[string]int{"+08:00": 8 * 3600, "-05:30": -(5*3600 + 30*60), "+14:00": 14 * 3600, "-13:59": -(13*3600 + 59*60)} {
+		loc, err = ParseTimeZoneOffset(offset)
+		require.NoError(t, err)
+		_, actual := time.Date(2023, 1, 1, 0, 0, 0, 0, loc).Zone()
+		require.Equal(t, seconds, actual, offset)
+	}
+	for _, offset := range []string{"Asia/Shanghai", "+8:00", "+14:01", "-14:00", "+08:60"} {
+		_, err = ParseTimeZoneOffset(offset)
+		require.Error(t, err, offset)
+	}
+}
+
+func TestCompareDataWithRules(t *testing.T) {
+	tableInfo, err := GetTableInfoBySQL("create table `test`.`t`(`a` int primary key, `f` double, `ts` timestamp(3), `s` varchar(10), `j` text, `bin` varbinary(10))", parser.New())
+	require.NoError(t, err)
+	_, orderKeyCols := dbutil.SelectUniqueOrderKey(tableInfo)
+	newRow := func(f, ts, s, j, bin string) map[string]*dbutil.ColumnData {
+		return map[string]*dbutil.ColumnData{
+			"a":   {Data: []byte("1")},
+			"f":   {Data: []byte(f)},
+			"ts":  {Data: []byte(ts)},
+			"s":   {Data: []byte(s)},
+			"j":   {Data: []byte(j)},
+			"bin": {Data: []byte(bin)},
+		}
+	}
+	row := newRow("1.5", "2023-01-01 00:00:00", "abc", `{"a":1,"b":2}`, "x")
+	compare := func(rules *CompareRules, other map[string]*dbutil.ColumnData) bool {
+		equal, _, err := CompareData(row, other, orderKeyCols, tableInfo.Columns, "", rules)
+		require.NoError(t, err)
+		return equal
+	}
+
+	cases := []struct {
+		other  map[string]*dbutil.ColumnData
+		rules  *CompareRules
+		result bool
+	}{
+		{newRow("1.501", "2023-01-01 00:00:00", "abc", `{"a":1,"b":2}`, "x"), nil, false},
+		{newRow("1.501", "2023-01-01 00:00:00", "abc", `{"a":1,"b":2}`, "x"), &CompareRules{FloatEpsilon: 0.01}, true},
+		{newRow("1.5", "2023-01-01 00:00:00.000", "abc", `{"a":1,"b":2}`, "x"), nil, false},
+		{newRow("1.5", "2023-01-01 00:00:00.000", "abc", `{"a":1,"b":2}`, "x"), &CompareRules{TimestampToUTC: true}, true},
+		{newRow("1.5", "2023-01-01 00:00:00.001", "abc", `{"a":1,"b":2}`, "x"), &CompareRules{TimestampToUTC: true}, false},
+		{newRow("1.5", "2023-01-01 00:00:00", "ABC  ", `{"a":1,"b":2}`, "x"), &CompareRules{IgnoreCase: true}, false},
+		{newRow("1.5", "2023-01-01 00:00:00", "ABC  ", `{"a":1,"b":2}`, "x"), &CompareRules{IgnoreCase: true, IgnoreTrailingSpaces: true}, true},
+		{newRow("1.5", "2023-01-01 00:00:00", "abc", `{"b": 2, "a": 1}`, "x"), &CompareRules{}, false},
+		{newRow("1.5", "2023-01-01 00:00:00", "abc", `{"b": 2, "a": 1}`, "x"), &CompareRules{CanonicalJSON: true}, true},
+		// the binary values are always compared exactly.
+		{newRow("1.5", "2023-01-01 00:00:00", "abc", `{"a":1,"b":2}`, "X"), &CompareRules{IgnoreCase: true}, false},
+	}
+	for i, c := range cases {
+		require.Equal(t, c.result, compare(c.rules, c.other), "case %d", i)
+	}
+}
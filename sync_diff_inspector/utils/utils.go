@@ -16,10 +16,7 @@ package utils
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
-	"math"
-	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -154,7 +151,7 @@ func GetColumnsFromIndex(index *model.IndexInfo, tableInfo *model.TableInfo) []*
 // GetTableRowsQueryFormat returns a rowsQuerySQL template for the specific table.
 //
 //	e.g. SELECT /*!40001 SQL_NO_CACHE */ `a`, `b` FROM `schema`.`table` WHERE %s ORDER BY `a`.
-func GetTableRowsQueryFormat(schema, table string, tableInfo *model.TableInfo, collation string) (string, []*model.ColumnInfo) {
+func GetTableRowsQueryFormat(schema, table string, tableInfo *model.TableInfo, collation string, rules *CompareRules) (string, []*model.ColumnInfo) {
 	_, orderByCols := dbutil.SelectUniqueOrderKey(tableInfo)
 
 	columnNames := make([]string, 0, len(tableInfo.Columns))
@@ -162,16 +159,7 @@ func GetTableRowsQueryFormat(schema, table string, tableInfo *model.TableInfo, c
 		if col.Hidden {
 			continue
 		}
-
-		name := dbutil.ColumnName(col.Name.O)
-		// When col value is 0, the result is NULL.
-		// But we can use ISNULL to distinguish between null and 0.
-		if col.FieldType.GetType() == mysql.TypeFloat {
-			name = fmt.Sprintf("round(%s, 5-floor(log10(abs(%s)))) as %s", name, name, name)
-		} else if col.FieldType.GetType() == mysql.TypeDouble {
-			name = fmt.Sprintf("round(%s, 14-floor(log10(abs(%s)))) as %s", name, name, name)
-		}
-		columnNames = append(columnNames, name)
+		columnNames = append(columnNames, rowsColumnExpr(col, rules))
 	}
 
 	columns := strings.Join(columnNames, ", ")
@@ -611,10 +599,10 @@ func NeedQuotes(tp byte) bool {
 //
 // If a case-insensitive collation is specified in the row iterator,
 // the cmp comparison should also be performed in a case-insensitive manner.
-func CompareData(map1, map2 map[string]*dbutil.ColumnData, orderKeyCols, columns []*model.ColumnInfo, collation string) (equal bool, cmp int, err error) {
+// The columns are compared under the rules, the rules can be nil.
+func CompareData(map1, map2 map[string]*dbutil.ColumnData, orderKeyCols, columns []*model.ColumnInfo, collation string, rules *CompareRules) (equal bool, cmp int, err error) {
 	var (
 		data1, data2 *dbutil.ColumnData
-		str1, str2   string
 		key          string
 		ok           bool
 	)
@@ -650,44 +638,13 @@ func CompareData(map1, map2 map[string]*dbutil.ColumnData, orderKeyCols, columns
 		if data2, ok = map2[column.Name.O]; !ok {
 			return false, 0, errors.Errorf("downstream don't have key %s", column.Name.O)
 		}
-		str1 = string(data1.Data)
-		str2 = string(data2.Data)
-		if column.FieldType.GetType() == mysql.TypeFloat || column.FieldType.GetType() == mysql.TypeDouble {
-			if data1.IsNull && data2.IsNull {
-				continue
-			} else if !data1.IsNull && !data2.IsNull {
-				num1, err1 := strconv.ParseFloat(str1, 64)
-				num2, err2 := strconv.ParseFloat(str2, 64)
-				if err1 != nil || err2 != nil {
-					err = errors.Errorf("convert %s, %s to float failed, err1: %v, err2: %v", str1, str2, err1, err2)
-					return
-				}
-				if math.Abs(num1-num2) <= 1e-6 {
-					continue
-				}
-			}
-		} else if column.FieldType.GetType() == mysql.TypeJSON {
-			if (str1 == str2) || (data1.IsNull && data2.IsNull) {
-				continue
-			}
-			if !data1.IsNull && !data2.IsNull {
-				var v1, v2 any
-				err := json.Unmarshal(data1.Data, &v1)
-				if err != nil {
-					return false, 0, errors.Errorf("unmarshal json %s failed, error %v", str1, err)
-				}
-				err = json.Unmarshal(data2.Data, &v2)
-				if err != nil {
-					return false, 0, errors.Errorf("unmarshal json %s failed, error %v", str2, err)
-				}
-				if reflect.DeepEqual(v1, v2) {
-					continue
-				}
-			}
-		} else {
-			if (str1 == str2) && (data1.IsNull == data2.IsNull) {
-				continue
-			}
+		var eq bool
+		eq, err = columnDataEqual(column, data1, data2, rules)
+		if err != nil {
+			return false, 0, err
+		}
+		if eq {
+			continue
 		}
 
 		equal = false
@@ -858,10 +815,11 @@ func GetTableSize(ctx context.Context, db *sql.DB, schemaName, tableName string)
 }
 
 // GetCountAndMD5Checksum returns checksum code and count of some data by given condition
+// The columns are normalized by the rules before calculating the checksum, the rules can be nil.
 func GetCountAndMD5Checksum(
 	ctx context.Context,
 	db *sql.DB, schemaName, tableName string,
-	tbInfo *model.TableInfo, limitRange string, indexHint string, args []any,
+	tbInfo *model.TableInfo, rules *CompareRules, limitRange string, indexHint string, args []any,
 ) (int64, uint64, error) {
 	/*
 		calculate MD5 checksum and count example:
@@ -880,14 +838,7 @@ func GetCountAndMD5Checksum(
 		if col.Hidden {
 			continue
 		}
-		name := dbutil.ColumnName(col.Name.O)
-		// When col value is 0, the result is NULL.
-		// But we can use ISNULL to distinguish between null and 0.
-		if col.FieldType.GetType() == mysql.TypeFloat {
-			name = fmt.Sprintf("round(%s, 5-floor(log10(abs(%s))))", name, name)
-		} else if col.FieldType.GetType() == mysql.TypeDouble {
-			name = fmt.Sprintf("round(%s, 14-floor(log10(abs(%s))))", name, name)
-		}
+		name := checksumColumnExpr(col, rules)
 		columnNames = append(columnNames, name)
 		columnIsNull = append(columnIsNull, fmt.Sprintf("ISNULL(%s)", name))
 	}
@@ -1115,6 +1066,11 @@ func GetSQLFileName(index *chunk.CID) string {
 	return fmt.Sprintf("%d:%d-%d:%d", index.TableIndex, index.BucketIndexLeft, index.BucketIndexRight, index.ChunkIndex)
 }
 
+// GetFixSQLFileName returns the name of the fix-SQL file of the chunk in the table.
+func GetFixSQLFileName(schema, table string, index *chunk.CID) string {
+	return fmt.Sprintf("%s:%s:%s.sql", schema, table, GetSQLFileName(index))
+}
+
 // GetChunkIDFromSQLFileName convert the filename to chunk's `Index`.
 func GetChunkIDFromSQLFileName(fileIDStr string) (int, int, int, int, error) {
 	ids := strings.Split(fileIDStr, ":")
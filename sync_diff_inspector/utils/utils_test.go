@@ -82,7 +82,7 @@ func TestBasicTableUtilOperation(t *testing.T) {
 	tableInfo, err := GetTableInfoBySQL(createTableSQL, parser.New())
 	require.NoError(t, err)
 
-	query, orderKeyCols := GetTableRowsQueryFormat("test", "test", tableInfo, "123")
+	query, orderKeyCols := GetTableRowsQueryFormat("test", "test", tableInfo, "123", nil)
 	require.Equal(t, query, "SELECT /*!40001 SQL_NO_CACHE */ `a`, `b`, round(`c`, 5-floor(log10(abs(`c`)))) as `c`, `d` FROM `test`.`test` WHERE %s ORDER BY `a`,`b` COLLATE '123'")
 	expectName := []string{"a", "b"}
 	for i, col := range orderKeyCols {
@@ -162,79 +162,79 @@ func TestBasicTableUtilOperation(t *testing.T) {
 	require.Equal(t, GenerateDeleteDML(data1, tableInfo, "schema"), "DELETE FROM `schema`.`test` WHERE `a` = 1 AND `b` = 'a' AND `c` = 1.22 AND `d` = 'sdf' LIMIT 1;")
 
 	// same
-	equal, cmp, err := CompareData(data1, data1, orderKeyCols, columns, "")
+	equal, cmp, err := CompareData(data1, data1, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 0)
 	require.True(t, equal)
 
 	// orderkey same but other column different
-	equal, cmp, err = CompareData(data1, data3, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data1, data3, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, -1)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data3, data1, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data3, data1, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 1)
 	require.False(t, equal)
 
 	// orderKey different
-	equal, cmp, err = CompareData(data1, data2, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data1, data2, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, -1)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data2, data1, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data2, data1, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 1)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data4, data1, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data4, data1, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 0)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data1, data4, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data1, data4, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 0)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data5, data4, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data5, data4, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 1)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data4, data5, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data4, data5, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, -1)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data4, data6, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data4, data6, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 1)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data6, data4, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data6, data4, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, -1)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data6, data7, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data6, data7, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 0)
 	require.True(t, equal)
 
-	equal, cmp, err = CompareData(data1, data8, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data1, data8, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 0)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data8, data1, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data8, data1, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 0)
 	require.False(t, equal)
 
-	equal, cmp, err = CompareData(data8, data9, orderKeyCols, columns, "")
+	equal, cmp, err = CompareData(data8, data9, orderKeyCols, columns, "", nil)
 	require.NoError(t, err)
 	require.EqualValues(t, cmp, 0)
 	require.False(t, equal)
@@ -270,7 +270,7 @@ func TestGetCountAndMD5Checksum(t *testing.T) {
 
 	mock.ExpectQuery("SELECT COUNT.*FROM `test_schema`\\.`test_table` WHERE \\[23 45\\].*").WithArgs("123", "234").WillReturnRows(sqlmock.NewRows([]string{"CNT", "CHECKSUM"}).AddRow(123, 456))
 
-	count, checksum, err := GetCountAndMD5Checksum(ctx, conn, "test_schema", "test_table", tableInfo, "[23 45]", "", []any{"123", "234"})
+	count, checksum, err := GetCountAndMD5Checksum(ctx, conn, "test_schema", "test_table", tableInfo, nil, "[23 45]", "", []any{"123", "234"})
 	require.NoError(t, err)
 	require.Equal(t, count, int64(123))
 	require.Equal(t, checksum, uint64(0x1c8))
@@ -507,6 +507,7 @@ func TestGetSQLFileName(t *testing.T) {
 		ChunkCnt:         10,
 	}
 	require.Equal(t, GetSQLFileName(index), "1:2-3:4")
+	require.Equal(t, GetFixSQLFileName("db", "t", index), "db:t:1:2-3:4.sql")
 }
 
 func TestGetChunkIDFromSQLFileName(t *testing.T) {
@@ -549,7 +550,7 @@ func TestCompareBlob(t *testing.T) {
 	tableInfo, err := GetTableInfoBySQL(createTableSQL, parser.New())
 	require.NoError(t, err)
 
-	_, orderKeyCols := GetTableRowsQueryFormat("test", "test", tableInfo, "123")
+	_, orderKeyCols := GetTableRowsQueryFormat("test", "test", tableInfo, "123", nil)
 
 	data1 := map[string]*dbutil.ColumnData{
 		"a": {Data: []byte("1"), IsNull: false},
@@ -576,13 +577,13 @@ func TestCompareBlob(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		equal, cmp, err := CompareData(c.data1, c.data1, orderKeyCols, columns, "")
+		equal, cmp, err := CompareData(c.data1, c.data1, orderKeyCols, columns, "", nil)
 		require.NoError(t, err)
 		require.EqualValues(t, cmp, 0)
 		require.True(t, equal)
 
 		for _, data := range c.dataOthers {
-			equal, cmp, err = CompareData(c.data1, data, orderKeyCols, columns, "")
+			equal, cmp, err = CompareData(c.data1, data, orderKeyCols, columns, "", nil)
 			require.NoError(t, err)
 			require.EqualValues(t, cmp, 0)
 			require.False(t, equal)